@@ -0,0 +1,39 @@
+package main
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"musicclubbot/backend/internal/config"
+)
+
+// healthcheck probes /readyz of a backend running on this host and exits
+// non-zero unless it is ready. The runtime image has no curl, so Docker's
+// HEALTHCHECK runs "musicclubbot healthcheck".
+func healthcheck(configPath string) {
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	client := &http.Client{Timeout: 5 * time.Second}
+	scheme := "http"
+	if cfg.TLSEnabled() {
+		// The certificate names the public host, not 127.0.0.1.
+		scheme = "https"
+		client.Transport = &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
+	}
+	resp, err := client.Get(scheme + "://127.0.0.1" + cfg.GRPCAddr() + "/readyz")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		fmt.Fprintln(os.Stderr, resp.Status)
+		os.Exit(1)
+	}
+}
@@ -2,30 +2,112 @@ package main
 
 import (
 	"context"
+	"flag"
+	"log/slog"
 	"os/signal"
 	"syscall"
+	"time"
+	// Event timezones must resolve even where the image has no zoneinfo.
+	_ "time/tzdata"
 
 	"musicclubbot/backend/internal/app"
 	"musicclubbot/backend/internal/config"
 	"musicclubbot/backend/internal/db"
+	"musicclubbot/backend/internal/logging"
+	"musicclubbot/backend/internal/metrics"
+	"musicclubbot/backend/internal/pubsub"
+	"musicclubbot/backend/internal/storage"
+	"musicclubbot/backend/internal/tracing"
+	"musicclubbot/backend/internal/usage"
 
 	"os"
-
-	"github.com/apsdehal/go-logger"
 )
 
 func main() {
+	configPath := flag.String("config", os.Getenv("CONFIG_PATH"),
+		"YAML or TOML config file; environment variables override its values")
+	flag.Parse()
+	if flag.Arg(0) == "healthcheck" {
+		healthcheck(*configPath)
+		return
+	}
+
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
 
-	cfg := config.Load()
-	logger.SetDefaultFormat("%{time} %{lvl} %{message}")
-	log, _ := logger.New("", 1, os.Stdout)
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		slog.Error("load configuration", "error", err)
+		os.Exit(1)
+	}
+	log := logging.New(os.Stdout, cfg.LogLevel, cfg.LogFormat)
+	slog.SetDefault(log)
+	fatal := func(msg string, err error) {
+		log.Error(msg, "error", err)
+		os.Exit(1)
+	}
+	if err := cfg.Validate(); err != nil {
+		fatal("invalid configuration", err)
+	}
+	if cfg.DevMode {
+		log.Warn("DEV_MODE is on: bot token and JWT secret checks are skipped")
+	}
+	log.Info("loaded configuration", "config", cfg)
+	shutdownTracing, err := tracing.Setup(ctx)
+	if err != nil {
+		fatal("set up tracing", err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			log.Error("flush traces", "error", err)
+		}
+	}()
 	ctx = context.WithValue(ctx, "log", log)
 	ctx = context.WithValue(ctx, "cfg", cfg)
-	ctx = context.WithValue(ctx, "db", db.MustInitDb(ctx, cfg.DbUrl))
+	dbOptions := db.Options{
+		MaxOpenConns:    cfg.DbMaxOpenConns,
+		MaxIdleConns:    cfg.DbMaxIdleConns,
+		ConnMaxLifetime: cfg.DbConnMaxLifetime,
+		ConnectTimeout:  cfg.DbConnectTimeout,
+	}
+	database := db.MustInitDb(ctx, cfg.DbUrl, dbOptions)
+	// Closed after app.Run, which returns once the calls and jobs using it
+	// have stopped.
+	defer database.Close()
+	metrics.RegisterDB(database, "main")
+	router := db.NewRouter(database, nil)
+	if cfg.DbReplicaUrl != "" {
+		replica := db.MustInitDb(ctx, cfg.DbReplicaUrl, dbOptions)
+		defer replica.Close()
+		metrics.RegisterDB(replica, "replica")
+		router = db.NewRouter(database, replica)
+	}
+	if cfg.AutoMigrate {
+		applied, err := db.Migrate(ctx, database, cfg.MigrationsBaseline)
+		if err != nil {
+			fatal("migrate database", err)
+		}
+		if len(applied) > 0 {
+			log.Info("applied migrations", "versions", applied)
+		}
+	}
+	ctx = context.WithValue(ctx, "db", database)
+	ctx = context.WithValue(ctx, "db_router", router)
+	ctx = context.WithValue(ctx, "hub", pubsub.NewHub())
+	ctx = context.WithValue(ctx, "usage", usage.NewRecorder())
+	var store storage.Store = storage.NewLocal(cfg.StorageDir)
+	if cfg.S3Enabled() {
+		s3, err := storage.NewS3(cfg.S3Endpoint, cfg.S3Bucket, cfg.S3Region, cfg.S3AccessKey, cfg.S3SecretKey)
+		if err != nil {
+			fatal("set up storage", err)
+		}
+		store = s3
+	}
+	ctx = context.WithValue(ctx, "storage", store)
 
 	if err := app.Run(ctx); err != nil {
-		log.Fatalf("backend exited with error: %v", err)
+		fatal("backend exited", err)
 	}
 }
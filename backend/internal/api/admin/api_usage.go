@@ -0,0 +1,103 @@
+package admin
+
+import (
+	"context"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// apiUsageDefaultRange is what GetApiUsage covers without from.
+	apiUsageDefaultRange = 30 * 24 * time.Hour
+	apiUsageTopUsers     = 20
+)
+
+func (s *AdminService) GetApiUsage(ctx context.Context, req *proto.GetApiUsageRequest) (*proto.ApiUsage, error) {
+	_, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	to := time.Now()
+	if req.GetTo() != nil {
+		to = req.GetTo().AsTime()
+	}
+	from := to.Add(-apiUsageDefaultRange)
+	if req.GetFrom() != nil {
+		from = req.GetFrom().AsTime()
+	}
+	if !to.After(from) {
+		return nil, status.Error(codes.InvalidArgument, "to must be after from")
+	}
+
+	// Hours are stored by their start, so a partly covered hour counts
+	// whole.
+	const filter = `
+		WHERE h.hour > $1::timestamptz - INTERVAL '1 hour' AND h.hour < $2
+		  AND ($3 = '' OR h.user_id::text = $3)`
+	args := []any{from, to, req.GetUserId()}
+
+	usage := &proto.ApiUsage{From: timestamppb.New(from), To: timestamppb.New(to)}
+	if err := db.QueryRowContext(ctx, `
+		SELECT COALESCE(SUM(h.calls), 0), COALESCE(SUM(h.errors), 0), COUNT(DISTINCT h.user_id)
+		FROM api_usage_hourly h
+	`+filter, args...).Scan(&usage.TotalCalls, &usage.TotalErrors, &usage.ActiveUsers); err != nil {
+		return nil, status.Errorf(codes.Internal, "load usage totals: %v", err)
+	}
+
+	rows, err := db.QueryContext(ctx, `
+		SELECT h.method, SUM(h.calls), SUM(h.errors), SUM(h.total_ms), MAX(h.max_ms), COUNT(DISTINCT h.user_id)
+		FROM api_usage_hourly h
+	`+filter+`
+		GROUP BY h.method
+		ORDER BY SUM(h.calls) DESC, h.method
+	`, args...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load method usage: %v", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		m := &proto.MethodUsage{}
+		var totalMs int64
+		if err := rows.Scan(&m.Method, &m.Calls, &m.Errors, &totalMs, &m.MaxLatencyMs, &m.Users); err != nil {
+			return nil, status.Errorf(codes.Internal, "scan method usage: %v", err)
+		}
+		if m.Calls > 0 {
+			m.ErrorRate = float64(m.Errors) / float64(m.Calls)
+			m.AverageLatencyMs = float64(totalMs) / float64(m.Calls)
+		}
+		usage.Methods = append(usage.Methods, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "iterate method usage: %v", err)
+	}
+
+	userRows, err := db.QueryContext(ctx, `
+		SELECT u.id, u.display_name, u.username, COALESCE(u.avatar_url, ''), SUM(h.calls), COUNT(DISTINCT h.method)
+		FROM api_usage_hourly h
+		JOIN app_user u ON u.id = h.user_id
+	`+filter+`
+		GROUP BY u.id
+		ORDER BY SUM(h.calls) DESC, u.display_name
+		LIMIT $4
+	`, append(args, apiUsageTopUsers)...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load user usage: %v", err)
+	}
+	defer userRows.Close()
+	for userRows.Next() {
+		u := &proto.UserUsage{User: &proto.User{}}
+		if err := userRows.Scan(&u.User.Id, &u.User.DisplayName, &u.User.Username, &u.User.AvatarUrl, &u.Calls, &u.Methods); err != nil {
+			return nil, status.Errorf(codes.Internal, "scan user usage: %v", err)
+		}
+		usage.TopUsers = append(usage.TopUsers, u)
+	}
+	if err := userRows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "iterate user usage: %v", err)
+	}
+	return usage, nil
+}
@@ -0,0 +1,101 @@
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *AdminService) ListAuditEntries(ctx context.Context, req *proto.ListAuditEntriesRequest) (*proto.ListAuditEntriesResponse, error) {
+	_, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int(req.GetPageSize())
+	if limit == 0 {
+		limit = 50
+	}
+	offset := 0
+	if tok := req.GetPageToken(); tok != "" {
+		if v, err := strconv.Atoi(tok); err == nil && v >= 0 {
+			offset = v
+		}
+	}
+
+	args := []any{}
+	clauses := []string{}
+	add := func(cond string, arg any) {
+		args = append(args, arg)
+		clauses = append(clauses, strings.ReplaceAll(cond, "?", "$"+strconv.Itoa(len(args))))
+	}
+	if v := req.GetEntityType(); v != "" {
+		add("a.entity_type = ?", v)
+	}
+	if v := req.GetEntityId(); v != "" {
+		add("a.entity_id = ?", v)
+	}
+	if v := req.GetActorId(); v != "" {
+		add("a.actor_id::text = ?", v)
+	}
+	if req.GetFrom() != nil {
+		add("a.created_at >= ?", req.GetFrom().AsTime())
+	}
+	if req.GetTo() != nil {
+		add("a.created_at < ?", req.GetTo().AsTime())
+	}
+	where := ""
+	if len(clauses) > 0 {
+		where = "WHERE " + strings.Join(clauses, " AND ")
+	}
+	args = append(args, limit, offset)
+
+	rows, err := db.QueryContext(ctx, `
+		SELECT a.id, a.entity_type, a.entity_id, a.action, a.summary, a.created_at,
+		       u.id, COALESCE(u.display_name, ''), COALESCE(u.username, ''), COALESCE(u.avatar_url, ''), COALESCE(u.tg_user_id, 0)
+		FROM audit_entry a
+		LEFT JOIN app_user u ON u.id = a.actor_id
+	`+where+`
+		ORDER BY a.created_at DESC, a.id DESC
+		LIMIT $`+strconv.Itoa(len(args)-1)+`
+		OFFSET $`+strconv.Itoa(len(args)), args...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list audit entries: %v", err)
+	}
+	defer rows.Close()
+
+	resp := &proto.ListAuditEntriesResponse{}
+	for rows.Next() {
+		e := &proto.AuditEntry{}
+		u := &proto.User{}
+		var actorID sql.NullString
+		var created time.Time
+		var tgID int64
+		if err := rows.Scan(&e.Id, &e.EntityType, &e.EntityId, &e.Action, &e.Summary, &created,
+			&actorID, &u.DisplayName, &u.Username, &u.AvatarUrl, &tgID); err != nil {
+			return nil, status.Errorf(codes.Internal, "scan audit entry: %v", err)
+		}
+		if actorID.Valid {
+			u.Id = actorID.String
+			u.TelegramId = uint64(tgID)
+			e.Actor = u
+		}
+		e.CreatedAt = timestamppb.New(created)
+		resp.Entries = append(resp.Entries, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "iterate audit entries: %v", err)
+	}
+	if len(resp.Entries) == limit {
+		resp.NextPageToken = strconv.Itoa(offset + limit)
+	}
+	return resp, nil
+}
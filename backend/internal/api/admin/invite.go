@@ -0,0 +1,179 @@
+package admin
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// inviteAlphabet leaves out characters that are easy to mistype.
+const inviteAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+const inviteCodeLength = 10
+
+func newInviteCode() (string, error) {
+	b := make([]byte, inviteCodeLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	for i := range b {
+		b[i] = inviteAlphabet[int(b[i])%len(inviteAlphabet)]
+	}
+	return string(b), nil
+}
+
+func (s *AdminService) CreateInviteCode(ctx context.Context, req *proto.CreateInviteCodeRequest) (*proto.InviteCode, error) {
+	adminID, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	maxUses := req.GetMaxUses()
+	if maxUses == 0 {
+		maxUses = 1
+	}
+	var expires sql.NullTime
+	if req.GetExpiresAt() != nil {
+		expires = sql.NullTime{Valid: true, Time: req.GetExpiresAt().AsTime()}
+		if !expires.Time.After(time.Now()) {
+			return nil, status.Error(codes.InvalidArgument, "expiry must be in the future")
+		}
+	}
+	code, err := newInviteCode()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "generate code: %v", err)
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO invite_code (code, created_by, max_uses, expires_at, note)
+		VALUES ($1, $2, $3, $4, $5)
+	`, code, adminID, maxUses, expires, strings.TrimSpace(req.GetNote())); err != nil {
+		return nil, status.Errorf(codes.Internal, "create invite code: %v", err)
+	}
+	if err := helpers.RecordAudit(ctx, tx, helpers.AuditInvite, code, "create", strings.TrimSpace(req.GetNote())); err != nil {
+		return nil, status.Errorf(codes.Internal, "record audit: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit: %v", err)
+	}
+	return loadInviteCode(ctx, db, code)
+}
+
+func (s *AdminService) ListInviteCodes(ctx context.Context, _ *emptypb.Empty) (*proto.ListInviteCodesResponse, error) {
+	_, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := loadInviteCodes(ctx, db, "")
+	if err != nil {
+		return nil, err
+	}
+	return &proto.ListInviteCodesResponse{Codes: list}, nil
+}
+
+func (s *AdminService) RevokeInviteCode(ctx context.Context, req *proto.InviteCodeRef) (*proto.InviteCode, error) {
+	_, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+	res, err := tx.ExecContext(ctx, `
+		UPDATE invite_code SET revoked_at = COALESCE(revoked_at, NOW()) WHERE code = $1
+	`, req.GetCode())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "revoke invite code: %v", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return nil, status.Error(codes.NotFound, "invite code not found")
+	}
+	if err := helpers.RecordAudit(ctx, tx, helpers.AuditInvite, req.GetCode(), "revoke", ""); err != nil {
+		return nil, status.Errorf(codes.Internal, "record audit: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit: %v", err)
+	}
+	return loadInviteCode(ctx, db, req.GetCode())
+}
+
+func loadInviteCode(ctx context.Context, db *sql.DB, code string) (*proto.InviteCode, error) {
+	list, err := loadInviteCodes(ctx, db, "WHERE c.code = $1", code)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, status.Error(codes.NotFound, "invite code not found")
+	}
+	return list[0], nil
+}
+
+// loadInviteCodes lists codes matching where, newest first, with the users
+// they created.
+func loadInviteCodes(ctx context.Context, db *sql.DB, where string, args ...any) ([]*proto.InviteCode, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT c.code, c.max_uses, c.uses, c.expires_at, c.note, c.revoked_at, c.created_at,
+		       c.revoked_at IS NULL AND c.uses < c.max_uses AND (c.expires_at IS NULL OR c.expires_at > NOW()),
+		       cu.id, COALESCE(cu.display_name, ''), COALESCE(cu.username, ''), COALESCE(cu.avatar_url, ''),
+		       ARRAY(SELECT u.id::text FROM app_user u WHERE u.invite_code = c.code ORDER BY u.created_at),
+		       ARRAY(SELECT u.display_name FROM app_user u WHERE u.invite_code = c.code ORDER BY u.created_at),
+		       ARRAY(SELECT COALESCE(u.username, '') FROM app_user u WHERE u.invite_code = c.code ORDER BY u.created_at)
+		FROM invite_code c
+		LEFT JOIN app_user cu ON cu.id = c.created_by
+	`+where+`
+		ORDER BY c.created_at DESC
+	`, args...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list invite codes: %v", err)
+	}
+	defer rows.Close()
+	var out []*proto.InviteCode
+	for rows.Next() {
+		c := &proto.InviteCode{}
+		creator := &proto.User{}
+		var expires, revoked sql.NullTime
+		var created time.Time
+		var creatorID sql.NullString
+		var ids, names, usernames []string
+		if err := rows.Scan(&c.Code, &c.MaxUses, &c.Uses, &expires, &c.Note, &revoked, &created, &c.Active,
+			&creatorID, &creator.DisplayName, &creator.Username, &creator.AvatarUrl,
+			pq.Array(&ids), pq.Array(&names), pq.Array(&usernames)); err != nil {
+			return nil, status.Errorf(codes.Internal, "scan invite code: %v", err)
+		}
+		if creatorID.Valid {
+			creator.Id = creatorID.String
+			c.CreatedBy = creator
+		}
+		if expires.Valid {
+			c.ExpiresAt = timestamppb.New(expires.Time)
+		}
+		if revoked.Valid {
+			c.RevokedAt = timestamppb.New(revoked.Time)
+		}
+		c.CreatedAt = timestamppb.New(created)
+		for i, id := range ids {
+			c.Users = append(c.Users, &proto.User{Id: id, DisplayName: names[i], Username: usernames[i]})
+		}
+		out = append(out, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "iterate invite codes: %v", err)
+	}
+	return out, nil
+}
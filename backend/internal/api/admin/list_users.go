@@ -0,0 +1,120 @@
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *AdminService) ListUsers(ctx context.Context, req *proto.ListUsersRequest) (*proto.ListUsersResponse, error) {
+	_, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int(req.GetPageSize())
+	if limit == 0 {
+		limit = 50
+	}
+	offset := 0
+	if tok := req.GetPageToken(); tok != "" {
+		if v, err := strconv.Atoi(tok); err == nil && v >= 0 {
+			offset = v
+		}
+	}
+
+	args := []any{}
+	clauses := []string{}
+	if q := strings.TrimSpace(req.GetQuery()); q != "" {
+		args = append(args, "%"+q+"%")
+		clauses = append(clauses, "(u.username ILIKE $"+strconv.Itoa(len(args))+" OR u.display_name ILIKE $"+strconv.Itoa(len(args))+")")
+	}
+	if c := flagClause("u.is_chat_member", req.GetChatMember()); c != "" {
+		clauses = append(clauses, c)
+	}
+	if c := flagClause("u.tg_user_id IS NOT NULL", req.GetTelegramLinked()); c != "" {
+		clauses = append(clauses, c)
+	}
+	if p := req.GetPermission(); p != "" {
+		if !helpers.ValidPermissionName(p) {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown permission %q", p)
+		}
+		// The name is checked against the known columns above.
+		clauses = append(clauses, "EXISTS(SELECT 1 FROM effective_permissions p WHERE p.user_id = u.id AND p."+p+")")
+	}
+	where := ""
+	if len(clauses) > 0 {
+		where = "WHERE " + strings.Join(clauses, " AND ")
+	}
+	args = append(args, limit, offset)
+
+	rows, err := db.QueryContext(ctx, `
+		SELECT u.id, u.display_name, COALESCE(u.username, ''), COALESCE(u.avatar_url, ''), COALESCE(u.tg_user_id, 0),
+		       u.is_chat_member, u.tg_user_id IS NOT NULL,
+		       ARRAY(SELECT role FROM user_role WHERE user_id = u.id ORDER BY role),
+		       (SELECT COUNT(DISTINCT song_id) FROM song_role_assignment WHERE user_id = u.id),
+		       (SELECT COUNT(DISTINCT ep.event_id) FROM event_participant ep JOIN event e ON e.id = ep.event_id
+		        WHERE ep.user_id = u.id AND e.deleted_at IS NULL),
+		       u.last_seen_at, u.created_at, u.suspended, u.suspended_until, u.suspension_reason, COALESCE(u.invite_code, ''),
+		       COUNT(*) OVER ()
+		FROM app_user u
+	`+where+`
+		ORDER BY u.display_name, u.id
+		LIMIT $`+strconv.Itoa(len(args)-1)+`
+		OFFSET $`+strconv.Itoa(len(args)), args...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list users: %v", err)
+	}
+	defer rows.Close()
+
+	resp := &proto.ListUsersResponse{}
+	for rows.Next() {
+		u := &proto.User{}
+		sum := &proto.UserSummary{User: u}
+		var lastSeen, suspendedUntil sql.NullTime
+		var created time.Time
+		var tgID int64
+		var suspended bool
+		var reason string
+		if err := rows.Scan(&u.Id, &u.DisplayName, &u.Username, &u.AvatarUrl, &tgID, &sum.IsChatMember, &sum.TelegramLinked,
+			pq.Array(&sum.Roles), &sum.SongCount, &sum.EventCount, &lastSeen, &created,
+			&suspended, &suspendedUntil, &reason, &sum.InviteCode, &resp.TotalCount); err != nil {
+			return nil, status.Errorf(codes.Internal, "scan user: %v", err)
+		}
+		u.TelegramId = uint64(tgID)
+		if lastSeen.Valid {
+			sum.LastSeenAt = timestamppb.New(lastSeen.Time)
+		}
+		sum.CreatedAt = timestamppb.New(created)
+		sum.Suspension = userSuspension(u.Id, suspended, suspendedUntil, reason)
+		resp.Users = append(resp.Users, sum)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "iterate users: %v", err)
+	}
+	if len(resp.Users) == limit {
+		resp.NextPageToken = strconv.Itoa(offset + limit)
+	}
+	return resp, nil
+}
+
+// flagClause turns a yes/no filter on a boolean SQL expression into a WHERE
+// condition; empty for FLAG_FILTER_ANY.
+func flagClause(expr string, f proto.FlagFilter) string {
+	switch f {
+	case proto.FlagFilter_FLAG_FILTER_YES:
+		return expr
+	case proto.FlagFilter_FLAG_FILTER_NO:
+		return "NOT (" + expr + ")"
+	}
+	return ""
+}
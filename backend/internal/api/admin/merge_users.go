@@ -0,0 +1,190 @@
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// userRef is a column pointing at app_user. key lists the other columns of
+// a unique key that includes it: source rows clashing with one of the
+// target's are dropped instead of moved.
+type userRef struct {
+	table, column string
+	key           []string
+}
+
+// userRefs covers every reference to app_user apart from user_permissions,
+// whose grants are combined instead.
+var userRefs = []userRef{
+	{"user_role", "user_id", []string{"role"}},
+	{"song", "created_by", nil},
+	{"song_role_assignment", "user_id", []string{"song_id", "role"}},
+	{"song_favorite", "user_id", []string{"song_id"}},
+	{"song_difficulty_rating", "user_id", []string{"song_id", "role"}},
+	{"song_demo", "uploaded_by", nil},
+	{"event", "created_by", nil},
+	{"event", "deleted_by", nil},
+	{"event_participant", "user_id", []string{"event_id", "role", "track_item_id"}},
+	{"event_series", "created_by", nil},
+	{"event_rsvp", "user_id", []string{"event_id"}},
+	{"event_template", "created_by", nil},
+	{"rehearsal", "created_by", nil},
+	{"rehearsal_attendance", "user_id", []string{"rehearsal_id"}},
+	{"event_attendance", "user_id", []string{"event_id"}},
+	{"venue", "created_by", nil},
+	{"event_lineup_snapshot", "user_id", []string{"track_item_id", "role"}},
+	{"event_feedback_survey", "opened_by", nil},
+	{"event_feedback", "user_id", []string{"event_id"}},
+	{"season", "created_by", nil},
+	{"event_equipment_item", "bringer_id", nil},
+	{"event_equipment_item", "created_by", nil},
+	{"event_ride", "user_id", []string{"event_id", "kind"}},
+	{"event_ride_passenger", "user_id", []string{"ride_id"}},
+	{"event_expense", "payer_id", nil},
+	{"event_expense", "created_by", nil},
+	{"event_expense_share", "user_id", []string{"expense_id"}},
+	{"event_owner", "user_id", []string{"event_id"}},
+	{"tg_auth_user", "user_id", nil},
+	{"refresh_tokens", "user_id", nil},
+	{"permission_change", "user_id", nil},
+	{"permission_change", "actor_id", nil},
+	{"audit_entry", "actor_id", nil},
+	{"activity", "user_id", nil},
+	{"invite_code", "created_by", nil},
+	{"suggestion", "resolved_by", nil},
+	{"song_request", "reviewed_by", nil},
+	// A ballot is kept or dropped whole: ranks are unique per voter.
+	{"voting_ballot", "user_id", []string{"round_id"}},
+	{"voting_round", "created_by", nil},
+	{"dues_entry", "user_id", nil},
+	{"dues_entry", "recorded_by", nil},
+	{"pending_notification", "user_id", nil},
+	{"api_usage_hourly", "user_id", []string{"hour", "method"}},
+	{"username_history", "user_id", nil},
+	{"member_group", "created_by", nil},
+	{"member_group_member", "user_id", []string{"group_id"}},
+	{"song_change_notice", "actor_id", nil},
+}
+
+func (s *AdminService) MergeUsers(ctx context.Context, req *proto.MergeUsersRequest) (*proto.User, error) {
+	adminID, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+
+	var sourceID, targetID, sourceName string
+	var sourceTG sql.NullInt64
+	err = tx.QueryRowContext(ctx, `
+		SELECT id, username, tg_user_id FROM app_user WHERE id::text = $1 FOR UPDATE
+	`, req.GetSourceUserId()).Scan(&sourceID, &sourceName, &sourceTG)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "source user not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load source user: %v", err)
+	}
+	err = tx.QueryRowContext(ctx, `SELECT id FROM app_user WHERE id::text = $1 FOR UPDATE`, req.GetTargetUserId()).Scan(&targetID)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "target user not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load target user: %v", err)
+	}
+	if sourceID == targetID {
+		return nil, status.Error(codes.InvalidArgument, "can't merge a user into itself")
+	}
+	if sourceID == adminID {
+		return nil, status.Error(codes.InvalidArgument, "you can't merge away your own account")
+	}
+
+	if err := mergeGrants(ctx, tx, sourceID, targetID); err != nil {
+		return nil, status.Errorf(codes.Internal, "merge permissions: %v", err)
+	}
+	for _, ref := range userRefs {
+		if err := moveUserRef(ctx, tx, ref, sourceID, targetID); err != nil {
+			return nil, status.Errorf(codes.Internal, "merge %s.%s: %v", ref.table, ref.column, err)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE app_user u SET
+			avatar_url = COALESCE(NULLIF(u.avatar_url, ''), s.avatar_url),
+			is_chat_member = u.is_chat_member OR s.is_chat_member,
+			last_seen_at = GREATEST(u.last_seen_at, s.last_seen_at)
+		FROM app_user s
+		WHERE u.id = $2 AND s.id = $1
+	`, sourceID, targetID); err != nil {
+		return nil, status.Errorf(codes.Internal, "merge profile: %v", err)
+	}
+	// The Telegram link is unique, so the source gives it up before the
+	// target takes it over.
+	if sourceTG.Valid {
+		if _, err := tx.ExecContext(ctx, `UPDATE app_user SET tg_user_id = NULL WHERE id = $1`, sourceID); err != nil {
+			return nil, status.Errorf(codes.Internal, "unlink telegram: %v", err)
+		}
+		if _, err := tx.ExecContext(ctx, `UPDATE app_user SET tg_user_id = COALESCE(tg_user_id, $2) WHERE id = $1`, targetID, sourceTG); err != nil {
+			return nil, status.Errorf(codes.Internal, "link telegram: %v", err)
+		}
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM app_user WHERE id = $1`, sourceID); err != nil {
+		return nil, status.Errorf(codes.Internal, "delete source user: %v", err)
+	}
+	if err := helpers.RecordAudit(ctx, tx, helpers.AuditUser, targetID, "merge", "from "+sourceName+" ("+sourceID+")"); err != nil {
+		return nil, status.Errorf(codes.Internal, "record audit: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit: %v", err)
+	}
+	helpers.InvalidatePermissions(ctx, sourceID, targetID)
+
+	u, err := helpers.LoadUserById(ctx, db, targetID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load user: %v", err)
+	}
+	return u, nil
+}
+
+// mergeGrants gives the target every permission granted directly to either
+// user.
+func mergeGrants(ctx context.Context, tx *sql.Tx, sourceID, targetID string) error {
+	cols := strings.Join(helpers.PermissionNames, ", ")
+	set := make([]string, len(helpers.PermissionNames))
+	for i, name := range helpers.PermissionNames {
+		set[i] = name + " = user_permissions." + name + " OR EXCLUDED." + name
+	}
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO user_permissions (user_id, `+cols+`)
+		SELECT $2, `+cols+` FROM user_permissions WHERE user_id = $1
+		ON CONFLICT (user_id) DO UPDATE SET `+strings.Join(set, ", "), sourceID, targetID)
+	return err
+}
+
+func moveUserRef(ctx context.Context, tx *sql.Tx, ref userRef, sourceID, targetID string) error {
+	if ref.key != nil {
+		same := make([]string, len(ref.key))
+		for i, k := range ref.key {
+			same[i] = "t." + k + " IS NOT DISTINCT FROM s." + k
+		}
+		if _, err := tx.ExecContext(ctx, `
+			DELETE FROM `+ref.table+` s
+			WHERE s.`+ref.column+` = $1
+			  AND EXISTS (SELECT 1 FROM `+ref.table+` t WHERE t.`+ref.column+` = $2 AND `+strings.Join(same, " AND ")+`)
+		`, sourceID, targetID); err != nil {
+			return err
+		}
+	}
+	_, err := tx.ExecContext(ctx, `UPDATE `+ref.table+` SET `+ref.column+` = $2 WHERE `+ref.column+` = $1`, sourceID, targetID)
+	return err
+}
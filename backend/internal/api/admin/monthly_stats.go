@@ -0,0 +1,55 @@
+package admin
+
+import (
+	"context"
+	"musicclubbot/backend/internal/config"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/internal/logging"
+	"musicclubbot/backend/internal/stats"
+	"musicclubbot/backend/internal/telegram"
+	"musicclubbot/backend/proto"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *AdminService) PostMonthlyStats(ctx context.Context, req *proto.PostMonthlyStatsRequest) (*emptypb.Empty, error) {
+	_, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cfg := ctx.Value("cfg").(config.Config)
+	if cfg.ChatID == "" || cfg.BotToken == "" {
+		return nil, status.Error(codes.FailedPrecondition, "telegram chat is not configured")
+	}
+
+	loc := helpers.Location(cfg.DefaultTimezone)
+	month := stats.PreviousMonth(time.Now().In(loc))
+	if req.GetMonth() != "" {
+		month, err = time.ParseInLocation("2006-01", req.GetMonth(), loc)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, `month must look like "2026-09"`)
+		}
+	}
+
+	text, err := stats.MonthlyReport(ctx, db, month)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "compose report: %v", err)
+	}
+	if text == "" {
+		return nil, status.Error(codes.FailedPrecondition, "nothing happened that month")
+	}
+	if err := telegram.New(cfg.BotToken).SendMessage(ctx, cfg.ChatID, text); err != nil {
+		logging.FromContext(ctx).Error("post monthly stats to telegram", "error", err)
+		return nil, status.Error(codes.Unavailable, "couldn't send the report to telegram")
+	}
+	// Keeps the job from posting the same month again.
+	if _, err := db.ExecContext(ctx, `
+		INSERT INTO monthly_report (month) VALUES ($1) ON CONFLICT (month) DO UPDATE SET posted_at = NOW()
+	`, month.Format("2006-01-02")); err != nil {
+		return nil, status.Errorf(codes.Internal, "record report: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,10 @@
+package admin
+
+import (
+	"musicclubbot/backend/proto"
+)
+
+// AdminService implements club administration endpoints.
+type AdminService struct {
+	proto.UnimplementedAdminServiceServer
+}
@@ -0,0 +1,225 @@
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/api/song"
+	"musicclubbot/backend/internal/config"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/internal/logging"
+	"musicclubbot/backend/internal/telegram"
+	"musicclubbot/backend/proto"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// songRequestStatuses maps SongRequestStatus to the song_request.status
+// column.
+var songRequestStatuses = map[proto.SongRequestStatus]string{
+	proto.SongRequestStatus_SONG_REQUEST_STATUS_PENDING:  "pending",
+	proto.SongRequestStatus_SONG_REQUEST_STATUS_APPROVED: "approved",
+	proto.SongRequestStatus_SONG_REQUEST_STATUS_REJECTED: "rejected",
+}
+
+func (s *AdminService) ListSongRequests(ctx context.Context, req *proto.ListSongRequestsRequest) (*proto.ListSongRequestsResponse, error) {
+	_, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int(req.GetPageSize())
+	if limit == 0 {
+		limit = 50
+	}
+	offset := 0
+	if tok := req.GetPageToken(); tok != "" {
+		if v, err := strconv.Atoi(tok); err == nil && v >= 0 {
+			offset = v
+		}
+	}
+
+	list, total, err := loadSongRequests(ctx, db, "WHERE ($1 = '' OR r.status = $1)", "LIMIT $2 OFFSET $3",
+		songRequestStatuses[req.GetStatus()], limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	resp := &proto.ListSongRequestsResponse{Requests: list, TotalCount: total}
+	if offset+len(list) < int(total) {
+		resp.NextPageToken = strconv.Itoa(offset + len(list))
+	}
+	return resp, nil
+}
+
+func (s *AdminService) ApproveSongRequest(ctx context.Context, req *proto.ApproveSongRequestRequest) (*proto.SongRequest, error) {
+	adminID, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	request, err := loadPendingSongRequest(ctx, db, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	create := req.GetSong()
+	if strings.TrimSpace(create.GetTitle()) == "" || strings.TrimSpace(create.GetArtist()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "song title and artist are required")
+	}
+	if create.GetLink().GetUrl() == "" {
+		create.Link = request.GetLink()
+	}
+
+	// The catalog song is created like any other, by the approving admin.
+	details, err := (&song.SongService{}).CreateSong(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	if err := reviewSongRequest(ctx, db, adminID, request.GetId(), "approved", details.GetSong().GetId(), ""); err != nil {
+		return nil, err
+	}
+	notifyRequester(ctx, request.GetRequesterTelegramId(),
+		"🎵 Ваша заявка одобрена: «"+create.GetArtist()+" — "+create.GetTitle()+"» теперь в репертуаре клуба!")
+	return loadSongRequest(ctx, db, request.GetId())
+}
+
+func (s *AdminService) RejectSongRequest(ctx context.Context, req *proto.RejectSongRequestRequest) (*proto.SongRequest, error) {
+	adminID, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	request, err := loadPendingSongRequest(ctx, db, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	note := strings.TrimSpace(req.GetNote())
+	if err := reviewSongRequest(ctx, db, adminID, request.GetId(), "rejected", "", note); err != nil {
+		return nil, err
+	}
+	text := "Ваша заявка на песню " + request.GetLink().GetUrl() + " отклонена."
+	if note != "" {
+		text += "\nПричина: " + note
+	}
+	notifyRequester(ctx, request.GetRequesterTelegramId(), text)
+	return loadSongRequest(ctx, db, request.GetId())
+}
+
+func loadPendingSongRequest(ctx context.Context, db *sql.DB, id string) (*proto.SongRequest, error) {
+	request, err := loadSongRequest(ctx, db, id)
+	if err != nil {
+		return nil, err
+	}
+	if request.GetStatus() != proto.SongRequestStatus_SONG_REQUEST_STATUS_PENDING {
+		return nil, status.Error(codes.FailedPrecondition, "song request was already reviewed")
+	}
+	return request, nil
+}
+
+// reviewSongRequest records the decision; a request reviewed meanwhile by
+// someone else is reported as such.
+func reviewSongRequest(ctx context.Context, db *sql.DB, adminID, id, decision, songID, note string) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+	res, err := tx.ExecContext(ctx, `
+		UPDATE song_request
+		SET status = $2, song_id = NULLIF($3, '')::uuid, review_note = $4, reviewed_by = $5, reviewed_at = NOW()
+		WHERE id::text = $1 AND status = 'pending'
+	`, id, decision, songID, note, adminID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "review song request: %v", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return status.Error(codes.FailedPrecondition, "song request was already reviewed")
+	}
+	if err := helpers.RecordAudit(ctx, tx, helpers.AuditSongRequest, id, decision, note); err != nil {
+		return status.Errorf(codes.Internal, "record audit: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return status.Errorf(codes.Internal, "commit: %v", err)
+	}
+	return nil
+}
+
+// notifyRequester lets the requester know through the bot. They may have
+// never started a chat with it or blocked it since, so failures are only
+// logged.
+func notifyRequester(ctx context.Context, tgID uint64, text string) {
+	cfg, _ := ctx.Value("cfg").(config.Config)
+	if cfg.BotToken == "" || tgID == 0 {
+		return
+	}
+	if err := telegram.New(cfg.BotToken).SendMessage(ctx, strconv.FormatUint(tgID, 10), text); err != nil {
+		logging.FromContext(ctx).Warn("notify song requester failed", "tg_user_id", tgID, "error", err)
+	}
+}
+
+func loadSongRequest(ctx context.Context, db *sql.DB, id string) (*proto.SongRequest, error) {
+	list, _, err := loadSongRequests(ctx, db, "WHERE r.id::text = $1", "", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, status.Error(codes.NotFound, "song request not found")
+	}
+	return list[0], nil
+}
+
+// loadSongRequests lists requests matching where, newest first, limited by
+// page, and how many match in total.
+func loadSongRequests(ctx context.Context, db *sql.DB, where, page string, args ...any) ([]*proto.SongRequest, int32, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT r.id, r.link_url, r.comment, r.requester_name, r.requester_tg_id, r.status, r.created_at,
+		       r.reviewed_at, r.review_note, COALESCE(r.song_id::text, ''),
+		       ru.id, COALESCE(ru.display_name, ''), COALESCE(ru.username, ''), COALESCE(ru.avatar_url, ''),
+		       COUNT(*) OVER ()
+		FROM song_request r
+		LEFT JOIN app_user ru ON ru.id = r.reviewed_by
+	`+where+`
+		ORDER BY r.created_at DESC, r.id
+	`+page, args...)
+	if err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "list song requests: %v", err)
+	}
+	defer rows.Close()
+	var out []*proto.SongRequest
+	var total int32
+	for rows.Next() {
+		r := &proto.SongRequest{Link: &proto.SongLink{}}
+		reviewer := &proto.User{}
+		var tgID int64
+		var st string
+		var created time.Time
+		var reviewed sql.NullTime
+		var reviewerID sql.NullString
+		if err := rows.Scan(&r.Id, &r.Link.Url, &r.Comment, &r.RequesterName, &tgID, &st, &created,
+			&reviewed, &r.ReviewNote, &r.SongId,
+			&reviewerID, &reviewer.DisplayName, &reviewer.Username, &reviewer.AvatarUrl, &total); err != nil {
+			return nil, 0, status.Errorf(codes.Internal, "scan song request: %v", err)
+		}
+		r.Link.Kind = helpers.GuessSongLinkType(r.Link.Url)
+		r.RequesterTelegramId = uint64(tgID)
+		for k, v := range songRequestStatuses {
+			if v == st {
+				r.Status = k
+			}
+		}
+		r.CreatedAt = timestamppb.New(created)
+		if reviewed.Valid {
+			r.ReviewedAt = timestamppb.New(reviewed.Time)
+		}
+		if reviewerID.Valid {
+			reviewer.Id = reviewerID.String
+			r.ReviewedBy = reviewer
+		}
+		out = append(out, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "iterate song requests: %v", err)
+	}
+	return out, total, nil
+}
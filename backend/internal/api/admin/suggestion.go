@@ -0,0 +1,178 @@
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"html"
+	"musicclubbot/backend/internal/config"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/internal/logging"
+	"musicclubbot/backend/internal/telegram"
+	"musicclubbot/backend/proto"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *AdminService) ListSuggestions(ctx context.Context, req *proto.ListSuggestionsRequest) (*proto.ListSuggestionsResponse, error) {
+	_, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int(req.GetPageSize())
+	if limit == 0 {
+		limit = 50
+	}
+	offset := 0
+	if tok := req.GetPageToken(); tok != "" {
+		if v, err := strconv.Atoi(tok); err == nil && v >= 0 {
+			offset = v
+		}
+	}
+
+	where := "WHERE TRUE"
+	if c := flagClause("g.resolved_at IS NOT NULL", req.GetResolved()); c != "" {
+		where += " AND " + c
+	}
+	list, total, err := loadSuggestions(ctx, db, where, "LIMIT $1 OFFSET $2", limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	resp := &proto.ListSuggestionsResponse{Suggestions: list, TotalCount: total}
+	if offset+len(list) < int(total) {
+		resp.NextPageToken = strconv.Itoa(offset + len(list))
+	}
+	return resp, nil
+}
+
+func (s *AdminService) ResolveSuggestion(ctx context.Context, req *proto.ResolveSuggestionRequest) (*proto.Suggestion, error) {
+	adminID, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resolution := strings.TrimSpace(req.GetResolution())
+	if !req.GetResolved() {
+		resolution = ""
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+	res, err := tx.ExecContext(ctx, `
+		UPDATE suggestion
+		SET resolved_at = CASE WHEN $2 THEN COALESCE(resolved_at, NOW()) END,
+		    resolved_by = CASE WHEN $2 THEN $3::uuid END,
+		    resolution = $4
+		WHERE id::text = $1
+	`, req.GetId(), req.GetResolved(), adminID, resolution)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "resolve suggestion: %v", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return nil, status.Error(codes.NotFound, "suggestion not found")
+	}
+	action := "resolve"
+	if !req.GetResolved() {
+		action = "reopen"
+	}
+	if err := helpers.RecordAudit(ctx, tx, helpers.AuditSuggestion, req.GetId(), action, resolution); err != nil {
+		return nil, status.Errorf(codes.Internal, "record audit: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit: %v", err)
+	}
+	return loadSuggestion(ctx, db, req.GetId())
+}
+
+func (s *AdminService) PublishSuggestion(ctx context.Context, req *proto.SuggestionRef) (*proto.Suggestion, error) {
+	_, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cfg := ctx.Value("cfg").(config.Config)
+	if cfg.ChatID == "" || cfg.BotToken == "" {
+		return nil, status.Error(codes.FailedPrecondition, "telegram chat is not configured")
+	}
+	suggestion, err := loadSuggestion(ctx, db, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	text := "💡 <b>Анонимное предложение</b>\n\n" + html.EscapeString(suggestion.GetBody())
+	if err := telegram.New(cfg.BotToken).SendMessage(ctx, cfg.ChatID, text); err != nil {
+		logging.FromContext(ctx).Error("publish suggestion to telegram", "suggestion_id", req.GetId(), "error", err)
+		return nil, status.Error(codes.Unavailable, "couldn't send the suggestion to telegram")
+	}
+	if _, err := db.ExecContext(ctx, `UPDATE suggestion SET published_at = NOW() WHERE id::text = $1`, req.GetId()); err != nil {
+		return nil, status.Errorf(codes.Internal, "mark published: %v", err)
+	}
+	if err := helpers.RecordAudit(ctx, db, helpers.AuditSuggestion, req.GetId(), "publish", ""); err != nil {
+		return nil, status.Errorf(codes.Internal, "record audit: %v", err)
+	}
+	return loadSuggestion(ctx, db, req.GetId())
+}
+
+func loadSuggestion(ctx context.Context, db *sql.DB, id string) (*proto.Suggestion, error) {
+	list, _, err := loadSuggestions(ctx, db, "WHERE g.id::text = $1", "", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, status.Error(codes.NotFound, "suggestion not found")
+	}
+	return list[0], nil
+}
+
+// loadSuggestions lists suggestions matching where, newest first, limited
+// by page, and how many match in total.
+func loadSuggestions(ctx context.Context, db *sql.DB, where, page string, args ...any) ([]*proto.Suggestion, int32, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT g.id, g.body, g.created_at, g.resolved_at, g.resolution, g.published_at,
+		       ru.id, COALESCE(ru.display_name, ''), COALESCE(ru.username, ''), COALESCE(ru.avatar_url, ''),
+		       COUNT(*) OVER ()
+		FROM suggestion g
+		LEFT JOIN app_user ru ON ru.id = g.resolved_by
+	`+where+`
+		ORDER BY g.created_at DESC, g.id
+	`+page, args...)
+	if err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "list suggestions: %v", err)
+	}
+	defer rows.Close()
+	var out []*proto.Suggestion
+	var total int32
+	for rows.Next() {
+		g := &proto.Suggestion{}
+		resolver := &proto.User{}
+		var created time.Time
+		var resolved, published sql.NullTime
+		var resolverID sql.NullString
+		if err := rows.Scan(&g.Id, &g.Body, &created, &resolved, &g.Resolution, &published,
+			&resolverID, &resolver.DisplayName, &resolver.Username, &resolver.AvatarUrl, &total); err != nil {
+			return nil, 0, status.Errorf(codes.Internal, "scan suggestion: %v", err)
+		}
+		g.CreatedAt = timestamppb.New(created)
+		if resolved.Valid {
+			g.ResolvedAt = timestamppb.New(resolved.Time)
+		}
+		if published.Valid {
+			g.PublishedAt = timestamppb.New(published.Time)
+		}
+		if resolverID.Valid {
+			resolver.Id = resolverID.String
+			g.ResolvedBy = resolver
+		}
+		out = append(out, g)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "iterate suggestions: %v", err)
+	}
+	return out, total, nil
+}
@@ -0,0 +1,121 @@
+package auth
+
+import (
+	"context"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// requirement is a club-wide permission a method needs before its handler
+// runs. Checks that depend on the record (own songs, event owners) stay in
+// the handlers.
+type requirement struct {
+	allowed func(*proto.PermissionSet) bool
+	denied  string
+}
+
+var (
+	needAdmin       = requirement{helpers.PermissionAllowsPermissionManagement, "no rights to administer the club"}
+	needEventEdit   = requirement{helpers.PermissionAllowsEventEdit, "no rights to manage events"}
+	needEventCreate = requirement{helpers.PermissionAllowsEventCreate, "no rights to create events"}
+	needSongCreate  = requirement{helpers.PermissionAllowsSongCreate, "no rights to create songs"}
+	needSongPin     = requirement{helpers.PermissionAllowsEventEdit, "no rights to pin songs"}
+	needRoleMerge   = requirement{helpers.PermissionAllowsAnySongEdit, "no rights to merge roles"}
+)
+
+// methodRequirements maps full method names to what they require. An entry
+// ending in "/" covers every method of the service. Only the called method is
+// checked, so methods that delegate to a guarded handler need an entry too.
+var methodRequirements = map[string]requirement{
+	"/musicclub.admin.AdminService/":             needAdmin,
+	"/musicclub.permissions.PermissionsService/": needAdmin,
+
+	"/musicclub.song.SongService/CreateSong": needSongCreate,
+	"/musicclub.song.SongService/PinSong":    needSongPin,
+	"/musicclub.song.SongService/UnpinSong":  needSongPin,
+	"/musicclub.song.SongService/MergeRoles": needRoleMerge,
+
+	"/musicclub.event.EventService/CreateEvent":             needEventCreate,
+	"/musicclub.event.EventService/CreateEventFromTemplate": needEventCreate,
+	"/musicclub.event.EventService/DuplicateEvent":          needEventCreate,
+	"/musicclub.event.EventService/RestoreEvent":            needEventEdit,
+	"/musicclub.event.EventService/SaveEventTemplate":       needEventEdit,
+	"/musicclub.event.EventService/DeleteEventTemplate":     needEventEdit,
+
+	"/musicclub.venue.VenueService/CreateVenue": needEventEdit,
+	"/musicclub.venue.VenueService/UpdateVenue": needEventEdit,
+	"/musicclub.venue.VenueService/DeleteVenue": needEventEdit,
+
+	"/musicclub.season.SeasonService/CreateSeason": needEventEdit,
+	"/musicclub.season.SeasonService/UpdateSeason": needEventEdit,
+	"/musicclub.season.SeasonService/DeleteSeason": needEventEdit,
+
+	"/musicclub.stats.StatsService/GetAttendanceStats": needAdmin,
+
+	"/musicclub.voting.VotingService/CreateVotingRound": needAdmin,
+	"/musicclub.voting.VotingService/CloseVotingRound":  needAdmin,
+	"/musicclub.voting.VotingService/DeleteVotingRound": needAdmin,
+
+	"/musicclub.dues.DuesService/RecordDuesEntry":      needAdmin,
+	"/musicclub.dues.DuesService/DeleteDuesEntry":      needAdmin,
+	"/musicclub.dues.DuesService/ListDuesBalances":     needAdmin,
+	"/musicclub.dues.DuesService/GetSeasonDuesSummary": needAdmin,
+
+	"/musicclub.group.GroupService/CreateGroup":        needAdmin,
+	"/musicclub.group.GroupService/UpdateGroup":        needAdmin,
+	"/musicclub.group.GroupService/DeleteGroup":        needAdmin,
+	"/musicclub.group.GroupService/AddGroupMembers":    needAdmin,
+	"/musicclub.group.GroupService/RemoveGroupMembers": needAdmin,
+}
+
+func requirementFor(method string) (requirement, bool) {
+	if r, ok := methodRequirements[method]; ok {
+		return r, true
+	}
+	if i := strings.LastIndex(method, "/"); i > 0 {
+		r, ok := methodRequirements[method[:i+1]]
+		return r, ok
+	}
+	return requirement{}, false
+}
+
+// authorize enforces methodRequirements for the authenticated user.
+func authorize(ctx context.Context, method string) error {
+	req, ok := requirementFor(method)
+	if !ok {
+		return nil
+	}
+	userID, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+	perms, err := helpers.LoadPermissions(ctx, db, userID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "load permissions: %v", err)
+	}
+	if !req.allowed(perms) {
+		return status.Error(codes.PermissionDenied, req.denied)
+	}
+	return nil
+}
+
+// Authorization middleware, runs after AuthInterceptor
+func AuthorizationInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := authorize(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// Authorization middleware for streaming RPCs
+func AuthorizationStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := authorize(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
@@ -0,0 +1,39 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/config"
+	"musicclubbot/backend/internal/helpers"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// grantBootstrapAdmin gives the admin role to users listed in
+// ADMIN_USERNAMES or ADMIN_TG_IDS, once: an admin who later takes the role
+// away from them doesn't see it come back at their next sign-in.
+func grantBootstrapAdmin(ctx context.Context, tx *sql.Tx, userID uuid.UUID) error {
+	cfg, _ := ctx.Value("cfg").(config.Config)
+	if len(cfg.AdminUsernames) == 0 && len(cfg.AdminTgIDs) == 0 {
+		return nil
+	}
+	res, err := tx.ExecContext(ctx, `
+		INSERT INTO user_role (user_id, role)
+		SELECT u.id, 'admin' FROM app_user u
+		WHERE u.id = $1
+		  AND (u.username = ANY($2) OR u.tg_user_id = ANY($3))
+		  AND NOT EXISTS (
+		      SELECT 1 FROM audit_entry a
+		      WHERE a.entity_type = $4 AND a.entity_id = u.id::text AND a.action = 'bootstrap_admin')
+		ON CONFLICT DO NOTHING
+	`, userID, pq.Array(cfg.AdminUsernames), pq.Array(cfg.AdminTgIDs), helpers.AuditPermissions)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return nil
+	}
+	helpers.InvalidatePermissions(ctx, userID.String())
+	return helpers.RecordAudit(ctx, tx, helpers.AuditPermissions, userID.String(), "bootstrap_admin", "roles: admin")
+}
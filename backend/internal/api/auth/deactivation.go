@@ -0,0 +1,46 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// reactivateAccount undoes a deactivation when the user signs in within the
+// grace period; after it the sign-in is refused. Unknown users pass so the
+// caller reports them its own way.
+func reactivateAccount(ctx context.Context, db *sql.DB, userID uuid.UUID) error {
+	var deactivatedAt sql.NullTime
+	err := db.QueryRowContext(ctx, `SELECT deactivated_at FROM app_user WHERE id = $1`, userID).Scan(&deactivatedAt)
+	if err == sql.ErrNoRows || (err == nil && !deactivatedAt.Valid) {
+		return nil
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "load user: %v", err)
+	}
+	if time.Since(deactivatedAt.Time) > helpers.DeactivationGrace {
+		return status.Error(codes.PermissionDenied, "account deactivated")
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+	if _, err := tx.ExecContext(ctx, `UPDATE app_user SET deactivated_at = NULL WHERE id = $1`, userID); err != nil {
+		return status.Errorf(codes.Internal, "reactivate: %v", err)
+	}
+	actorCtx := context.WithValue(ctx, "user_id", userID.String())
+	if err := helpers.RecordAudit(actorCtx, tx, helpers.AuditUser, userID.String(), "reactivate", ""); err != nil {
+		return status.Errorf(codes.Internal, "record audit: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return status.Errorf(codes.Internal, "commit: %v", err)
+	}
+	return nil
+}
@@ -31,18 +31,18 @@ func (s *AuthService) GetProfile(ctx context.Context, req *emptypb.Empty) (*prot
 	}
 
 	// Get user profile
-	var username, displayName string
+	var username, displayName, bio string
 	var avatarUrl sql.NullString
 	var tgUserID sql.NullInt64
 	var isChatMember bool
 	var createdAt time.Time
 
 	err = db.QueryRowContext(ctx, `
-		SELECT username, display_name, avatar_url, tg_user_id, is_chat_member, created_at
+		SELECT username, display_name, avatar_url, tg_user_id, is_chat_member, created_at, bio
 		FROM app_user 
 		WHERE id = $1`,
 		userID,
-	).Scan(&username, &displayName, &avatarUrl, &tgUserID, &isChatMember, &createdAt)
+	).Scan(&username, &displayName, &avatarUrl, &tgUserID, &isChatMember, &createdAt, &bio)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -73,5 +73,6 @@ func (s *AuthService) GetProfile(ctx context.Context, req *emptypb.Empty) (*prot
 	return &proto.ProfileResponse{
 		Profile:     profile,
 		Permissions: permissions,
+		Bio:         bio,
 	}, nil
 }
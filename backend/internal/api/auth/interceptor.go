@@ -18,6 +18,28 @@ func AuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServe
 		return handler(ctx, req)
 	}
 
+	ctx, err := authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Streams outlive permission changes, so only unary calls memoize.
+	return handler(helpers.WithPermissionCache(ctx), req)
+}
+
+// Authentication middleware for streaming RPCs
+func AuthStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if helpers.PublicMethods[info.FullMethod] {
+		return handler(srv, ss)
+	}
+
+	ctx, err := authenticate(ss.Context())
+	if err != nil {
+		return err
+	}
+	return handler(srv, &helpers.ServerStreamWithContext{ServerStream: ss, Ctx: ctx})
+}
+
+func authenticate(ctx context.Context) (context.Context, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return nil, status.Error(codes.Unauthenticated, "missing metadata")
@@ -42,22 +64,21 @@ func AuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServe
 
 	db, err := helpers.DbFromCtx(ctx)
 	if err == nil {
-		var exists bool
 		userID, parseErr := uuid.Parse(claims.UserID)
 		if parseErr == nil {
-			err = db.QueryRowContext(ctx,
-				`SELECT EXISTS(SELECT 1 FROM app_user WHERE id = $1)`,
-				userID,
-			).Scan(&exists)
-
-			if err == nil && !exists {
-				return nil, status.Error(codes.Unauthenticated, "user no longer exists")
+			if err := checkSuspension(ctx, db, userID); err != nil {
+				return nil, err
 			}
+			// Throttled so most requests only read the row.
+			db.ExecContext(ctx, `
+				UPDATE app_user SET last_seen_at = NOW()
+				WHERE id = $1 AND (last_seen_at IS NULL OR last_seen_at < NOW() - INTERVAL '5 minutes')
+			`, userID)
 		}
 	}
 
 	ctx = context.WithValue(ctx, "user_claims", claims)
 	ctx = context.WithValue(ctx, "user_id", claims.UserID)
 
-	return handler(ctx, req)
+	return ctx, nil
 }
@@ -21,10 +21,6 @@ func (s *AuthService) Login(ctx context.Context, req *proto.Credentials) (*proto
 	username := req.GetUsername()
 	password := req.GetPassword()
 
-	if username == "" || password == "" {
-		return nil, status.Error(codes.InvalidArgument, "username and password are required")
-	}
-
 	// Get user from database
 	var userID uuid.UUID
 	var hashedPassword string
@@ -52,6 +48,13 @@ func (s *AuthService) Login(ctx context.Context, req *proto.Credentials) (*proto
 		return nil, status.Error(codes.Unauthenticated, "invalid credentials")
 	}
 
+	if err := reactivateAccount(ctx, db, userID); err != nil {
+		return nil, err
+	}
+	if err := checkSuspension(ctx, db, userID); err != nil {
+		return nil, err
+	}
+
 	// Generate new tokens
 	accessToken, err := GenerateAccessToken(ctx, userID, username)
 	if err != nil {
@@ -91,6 +94,10 @@ func (s *AuthService) Login(ctx context.Context, req *proto.Credentials) (*proto
 		return nil, status.Errorf(codes.Internal, "store refresh token: %v", err)
 	}
 
+	if err := grantBootstrapAdmin(ctx, tx, userID); err != nil {
+		return nil, status.Errorf(codes.Internal, "grant admin: %v", err)
+	}
+
 	// Get user permissions
 	permissions, err := helpers.GetUserPermissions(ctx, tx, userID)
 	if err != nil {
@@ -19,9 +19,6 @@ func (s *AuthService) Refresh(ctx context.Context, req *proto.RefreshRequest) (*
 	}
 
 	refreshToken := req.GetRefreshToken()
-	if refreshToken == "" {
-		return nil, status.Error(codes.InvalidArgument, "refresh token is required")
-	}
 
 	// Verify refresh token exists and is valid
 	var userID uuid.UUID
@@ -41,6 +38,10 @@ func (s *AuthService) Refresh(ctx context.Context, req *proto.RefreshRequest) (*
 		return nil, status.Errorf(codes.Internal, "query refresh token: %v", err)
 	}
 
+	if err := checkSuspension(ctx, db, userID); err != nil {
+		return nil, err
+	}
+
 	// Get user info for new token
 	var username string
 	err = db.QueryRowContext(ctx, `
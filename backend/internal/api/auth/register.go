@@ -2,8 +2,11 @@ package auth
 
 import (
 	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/config"
 	"musicclubbot/backend/internal/helpers"
 	"musicclubbot/backend/proto"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -18,21 +21,18 @@ func (s *AuthService) Register(ctx context.Context, req *proto.RegisterUserReque
 	}
 
 	username := req.GetCredentials().GetUsername()
-	if username == "" {
-		return nil, status.Error(codes.InvalidArgument, "username is required")
-	}
 
-	var exists bool
-	err = db.QueryRowContext(ctx,
-		`SELECT EXISTS(SELECT 1 FROM app_user WHERE username = $1)`,
-		username,
-	).Scan(&exists)
+	inviteCode := strings.ToUpper(strings.TrimSpace(req.GetInviteCode()))
+	if cfg, _ := ctx.Value("cfg").(config.Config); cfg.InviteOnly && inviteCode == "" {
+		return nil, status.Error(codes.PermissionDenied, "registration requires an invite code")
+	}
 
+	taken, err := usernameTaken(ctx, db, username, "")
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "check existing username: %v", err)
 	}
-	if exists {
-		return nil, status.Error(codes.AlreadyExists, "username already taken")
+	if taken != "" {
+		return nil, status.Error(codes.AlreadyExists, taken)
 	}
 
 	password := req.GetCredentials().GetPassword()
@@ -68,14 +68,33 @@ func (s *AuthService) Register(ctx context.Context, req *proto.RegisterUserReque
 		displayName = username
 	}
 
+	// Taking a use under the row lock keeps concurrent sign-ups within
+	// max_uses.
+	if inviteCode != "" {
+		err = tx.QueryRowContext(ctx, `
+			UPDATE invite_code SET uses = uses + 1
+			WHERE code = $1 AND revoked_at IS NULL AND uses < max_uses
+			  AND (expires_at IS NULL OR expires_at > NOW())
+			RETURNING code`,
+			inviteCode,
+		).Scan(&inviteCode)
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.InvalidArgument, "invite code is invalid, used up or expired")
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "use invite code: %v", err)
+		}
+	}
+
 	err = tx.QueryRowContext(ctx, `
-		INSERT INTO app_user (username, password_hash, display_name, avatar_url, is_chat_member) 
-		VALUES ($1, $2, $3, $4, FALSE)
+		INSERT INTO app_user (username, password_hash, display_name, avatar_url, is_chat_member, invite_code) 
+		VALUES ($1, $2, $3, $4, FALSE, NULLIF($5, ''))
 		RETURNING id, display_name, avatar_url`,
 		username,
 		hashedPassword,
 		displayName,
 		avatarUrl,
+		inviteCode,
 	).Scan(&userID, &displayName, &avatarUrl)
 
 	if err != nil {
@@ -116,6 +135,10 @@ func (s *AuthService) Register(ctx context.Context, req *proto.RegisterUserReque
 		return nil, status.Errorf(codes.Internal, "store refresh token: %v", err)
 	}
 
+	if err := grantBootstrapAdmin(ctx, tx, userID); err != nil {
+		return nil, status.Errorf(codes.Internal, "grant admin: %v", err)
+	}
+
 	// Get permissions for response
 	permissions, err := helpers.GetUserPermissions(ctx, tx, userID)
 	if err != nil {
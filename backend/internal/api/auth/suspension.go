@@ -0,0 +1,37 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// checkSuspension fails for users that are gone or deactivated
+// (Unauthenticated) or currently suspended (PermissionDenied). Database
+// errors let the user through, as authentication did before.
+func checkSuspension(ctx context.Context, q helpers.QueryRower, userID uuid.UUID) error {
+	var suspended, deactivated bool
+	var reason string
+	err := q.QueryRowContext(ctx, `
+		SELECT suspended AND (suspended_until IS NULL OR suspended_until > NOW()), suspension_reason,
+		       deactivated_at IS NOT NULL
+		FROM app_user WHERE id = $1
+	`, userID).Scan(&suspended, &reason, &deactivated)
+	if err == sql.ErrNoRows {
+		return status.Error(codes.Unauthenticated, "user no longer exists")
+	}
+	if err == nil && deactivated {
+		return status.Error(codes.Unauthenticated, "account deactivated")
+	}
+	if err != nil || !suspended {
+		return nil
+	}
+	if reason != "" {
+		return status.Errorf(codes.PermissionDenied, "account suspended: %s", reason)
+	}
+	return status.Error(codes.PermissionDenied, "account suspended")
+}
@@ -7,11 +7,12 @@ import (
 	"database/sql"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
-	"log"
 	"musicclubbot/backend/internal/config"
 	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/internal/logging"
 	"musicclubbot/backend/proto"
 	"net/http"
 	"net/url"
@@ -44,34 +45,30 @@ type ChatMemberResponse struct {
 
 func (s *AuthService) TelegramWebAppAuth(ctx context.Context, req *proto.TelegramWebAppAuthRequest) (*proto.AuthSession, error) {
 	cfg := ctx.Value("cfg").(config.Config)
+	log := logging.FromContext(ctx)
 
-	// 1. Verify Telegram WebApp initData
-	log.Printf("[DEBUG] TelegramWebAppAuth called with initData: %s", req.InitData)
+	// 1. Verify Telegram WebApp initData. initData is a credential, so it
+	// is never logged.
 	user, err := verifyTelegramWebAppData(req.InitData, cfg.BotToken)
 	if err != nil {
-		log.Printf("[ERROR] Failed to verify Telegram WebApp data: %v, initData: %s", err, req.InitData)
+		log.Warn("invalid Telegram WebApp data", "error", err)
 		return nil, status.Error(codes.Unauthenticated, "invalid Telegram data")
 	}
 
 	// 2. Check chat membership
 	isMember := true // Default to true if check is skipped
 	if cfg.SkipChatMembershipCheck {
-		log.Printf("[INFO] Chat membership check skipped for user %d (@%s) due to SKIP_CHAT_MEMBERSHIP_CHECK=true",
-			user.ID, user.Username)
+		log.Debug("chat membership check skipped", "tg_user_id", user.ID)
 	} else {
 		var err error
-		isMember, err = checkChatMembership(user.ID, cfg.BotToken, cfg.ChatID)
+		isMember, err = checkChatMembership(ctx, user.ID, cfg.BotToken, cfg.ChatID)
 		if err != nil {
-			log.Printf("[ERROR] Failed to check chat membership for user %d: %v", user.ID, err)
+			log.Error("check chat membership", "tg_user_id", user.ID, "error", err)
 			return nil, status.Error(codes.Internal, "failed to check chat membership")
 		}
 
-		log.Printf("[DEBUG] Chat membership check for user %d (@%s): isMember=%v, chatID=%s",
-			user.ID, user.Username, isMember, cfg.ChatID)
-
 		if !isMember {
-			log.Printf("[WARN] User %d (@%s) attempted to access but is not a member of chat %s",
-				user.ID, user.Username, cfg.ChatID)
+			log.Warn("sign-in by non-member", "tg_user_id", user.ID, "chat_id", cfg.ChatID)
 			return nil, status.Error(codes.PermissionDenied, "you must be a member of the Music Club chat to use this app")
 		}
 	}
@@ -85,6 +82,7 @@ func (s *AuthService) TelegramWebAppAuth(ctx context.Context, req *proto.Telegra
 	var userID uuid.UUID
 	var displayName string
 	var username string
+	avatarURL := user.PhotoURL
 
 	// Try to find existing user by Telegram ID
 	err = db.QueryRowContext(ctx, `
@@ -100,6 +98,12 @@ func (s *AuthService) TelegramWebAppAuth(ctx context.Context, req *proto.Telegra
 		}
 
 		username = user.Username
+		if username != "" {
+			// Someone else may hold or have just given up the name.
+			if taken, err := usernameTaken(ctx, db, username, ""); err != nil || taken != "" {
+				username = ""
+			}
+		}
 		if username == "" {
 			username = fmt.Sprintf("tg_%d", user.ID)
 		}
@@ -115,14 +119,13 @@ func (s *AuthService) TelegramWebAppAuth(ctx context.Context, req *proto.Telegra
 		).Scan(&userID)
 
 		if err != nil {
-			log.Printf("[ERROR] Failed to create user: %v, username: %s, tg_user_id: %d", err, username, user.ID)
 			return nil, status.Errorf(codes.Internal, "failed to create user: %v", err)
 		}
 
-		// Create default permissions
+		// New club members get the member role
 		_, err = db.ExecContext(ctx, `
-			INSERT INTO user_permissions (user_id, edit_own_participation, edit_own_songs)
-			VALUES ($1, TRUE, TRUE)`,
+			INSERT INTO user_role (user_id, role)
+			VALUES ($1, 'member')`,
 			userID,
 		)
 
@@ -132,11 +135,13 @@ func (s *AuthService) TelegramWebAppAuth(ctx context.Context, req *proto.Telegra
 	} else if err != nil {
 		return nil, status.Error(codes.Internal, "database error")
 	} else {
-		// Update existing user info
-		_, err = db.ExecContext(ctx, `
+		// Sync existing user info, except what they set themselves
+		err = db.QueryRowContext(ctx, `
 			UPDATE app_user
-			SET display_name = $1, avatar_url = $2
-			WHERE id = $3`,
+			SET display_name = CASE WHEN display_name_custom THEN display_name ELSE $1 END,
+			    avatar_url = CASE WHEN avatar_custom THEN avatar_url ELSE $2 END
+			WHERE id = $3
+			RETURNING display_name, COALESCE(avatar_url, '')`,
 			func() string {
 				name := user.FirstName
 				if user.LastName != "" {
@@ -146,13 +151,20 @@ func (s *AuthService) TelegramWebAppAuth(ctx context.Context, req *proto.Telegra
 			}(),
 			user.PhotoURL,
 			userID,
-		)
+		).Scan(&displayName, &avatarURL)
 
 		if err != nil {
 			// Ignore update errors
 		}
 	}
 
+	if err := reactivateAccount(ctx, db, userID); err != nil {
+		return nil, err
+	}
+	if err := checkSuspension(ctx, db, userID); err != nil {
+		return nil, err
+	}
+
 	// 4. Generate JWT tokens
 	accessToken, err := GenerateAccessToken(ctx, userID, username)
 	if err != nil {
@@ -187,6 +199,10 @@ func (s *AuthService) TelegramWebAppAuth(ctx context.Context, req *proto.Telegra
 		return nil, status.Errorf(codes.Internal, "store refresh token: %v", err)
 	}
 
+	if err := grantBootstrapAdmin(ctx, tx, userID); err != nil {
+		return nil, status.Errorf(codes.Internal, "grant admin: %v", err)
+	}
+
 	// 5. Get user permissions
 	permissions, err := helpers.GetUserPermissions(ctx, tx, userID)
 	if err != nil {
@@ -202,7 +218,7 @@ func (s *AuthService) TelegramWebAppAuth(ctx context.Context, req *proto.Telegra
 		Id:          userID.String(),
 		Username:    username,
 		DisplayName: displayName,
-		AvatarUrl:   user.PhotoURL,
+		AvatarUrl:   avatarURL,
 		TelegramId:  uint64(user.ID),
 	}
 
@@ -256,8 +272,6 @@ func verifyTelegramWebAppData(initData, botToken string) (*TelegramUser, error)
 	h.Write([]byte(dataCheckString))
 	computedHash := hex.EncodeToString(h.Sum(nil))
 
-	log.Printf("[DEBUG] Computed hash: %s, Received hash: %s", computedHash, hash)
-
 	// Verify hash
 	if computedHash != hash {
 		return nil, fmt.Errorf("hash verification failed")
@@ -278,46 +292,48 @@ func verifyTelegramWebAppData(initData, botToken string) (*TelegramUser, error)
 }
 
 // checkChatMembership checks if user is a member of the specified chat
-func checkChatMembership(userID int64, botToken, chatID string) (bool, error) {
-	url := fmt.Sprintf(
+func checkChatMembership(ctx context.Context, userID int64, botToken, chatID string) (bool, error) {
+	endpoint := fmt.Sprintf(
 		"https://api.telegram.org/bot%s/getChatMember?chat_id=%s&user_id=%d",
 		botToken,
 		chatID,
 		userID,
 	)
 
-	log.Printf("[DEBUG] Checking chat membership: userID=%d, chatID=%s", userID, chatID)
-
-	resp, err := http.Get(url)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return false, err
+	}
+	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
-		log.Printf("[ERROR] Telegram API request failed: %v", err)
+		// The error carries the URL and with it the bot token.
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
 		return false, fmt.Errorf("failed to call Telegram API: %w", err)
 	}
 	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		log.Printf("[ERROR] Failed to read Telegram API response: %v", err)
 		return false, fmt.Errorf("failed to read response: %w", err)
 	}
 
-	log.Printf("[DEBUG] Telegram API response: %s", string(body))
-
 	var result ChatMemberResponse
 	if err := json.Unmarshal(body, &result); err != nil {
-		log.Printf("[ERROR] Failed to parse Telegram API response: %v", err)
 		return false, fmt.Errorf("failed to parse response: %w", err)
 	}
 
 	if !result.Ok {
-		log.Printf("[WARN] Telegram API returned ok=false for user %d in chat %s", userID, chatID)
+		logging.FromContext(ctx).Warn("getChatMember failed", "tg_user_id", userID, "chat_id", chatID)
 		return false, nil
 	}
 
 	// Check if user is a member (not left, kicked, or restricted)
 	status := result.Result.Status
 	isMember := status == "creator" || status == "administrator" || status == "member"
-	log.Printf("[DEBUG] User %d status in chat %s: %s (isMember=%v)", userID, chatID, status, isMember)
+	logging.FromContext(ctx).Debug("chat membership", "tg_user_id", userID, "status", status)
 
 	return isMember, nil
-}
\ No newline at end of file
+}
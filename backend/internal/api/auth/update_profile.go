@@ -0,0 +1,116 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	maxDisplayName = 64
+	maxBio         = 500
+)
+
+var profileMaskFields = []string{"display_name", "avatar_url", "bio", "username"}
+
+func (s *AuthService) UpdateProfile(ctx context.Context, req *proto.UpdateProfileRequest) (*proto.ProfileResponse, error) {
+	userID, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]bool{}
+	for _, path := range req.GetUpdateMask().GetPaths() {
+		if !slices.Contains(profileMaskFields, path) {
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported update_mask path %q", path)
+		}
+		fields[path] = true
+	}
+	if len(fields) == 0 {
+		for _, f := range profileMaskFields {
+			fields[f] = true
+		}
+	}
+
+	args := []any{userID}
+	sets := []string{}
+	set := func(column string, value any) {
+		args = append(args, value)
+		sets = append(sets, column+" = $"+strconv.Itoa(len(args)))
+	}
+	if fields["display_name"] {
+		name := strings.TrimSpace(req.GetDisplayName())
+		if name == "" {
+			return nil, status.Error(codes.InvalidArgument, "display name is required")
+		}
+		if utf8.RuneCountInString(name) > maxDisplayName {
+			return nil, status.Errorf(codes.InvalidArgument, "display name must be at most %d characters", maxDisplayName)
+		}
+		set("display_name", name)
+		sets = append(sets, "display_name_custom = TRUE")
+	}
+	if fields["avatar_url"] {
+		avatar := strings.TrimSpace(req.GetAvatarUrl())
+		if avatar != "" {
+			if u, err := url.Parse(avatar); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return nil, status.Error(codes.InvalidArgument, "avatar_url must be an http(s) URL")
+			}
+		}
+		set("avatar_url", avatar)
+		// An empty avatar hands it back to Telegram sign-in. Either way an
+		// uploaded one is replaced.
+		sets = append(sets, "avatar_custom = "+strconv.FormatBool(avatar != ""), "avatar_id = NULL")
+	}
+	if fields["bio"] {
+		bio := strings.TrimSpace(req.GetBio())
+		if utf8.RuneCountInString(bio) > maxBio {
+			return nil, status.Errorf(codes.InvalidArgument, "bio must be at most %d characters", maxBio)
+		}
+		set("bio", bio)
+	}
+
+	// Without a mask an empty username keeps the current one.
+	username := strings.TrimSpace(req.GetUsername())
+	renaming := fields["username"] && (len(req.GetUpdateMask().GetPaths()) > 0 || username != "")
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+	if renaming {
+		if err := changeUsername(ctx, tx, userID, username); err != nil {
+			return nil, err
+		}
+	}
+	var oldAvatarID sql.NullString
+	if len(sets) > 0 {
+		err = tx.QueryRowContext(ctx, `
+			UPDATE app_user u SET `+strings.Join(sets, ", ")+`
+			FROM (SELECT avatar_id FROM app_user WHERE id = $1) old
+			WHERE u.id = $1
+			RETURNING old.avatar_id`, args...).Scan(&oldAvatarID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "update profile: %v", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit: %v", err)
+	}
+	if fields["avatar_url"] && oldAvatarID.Valid {
+		if store, err := helpers.StorageFromCtx(ctx); err == nil {
+			deleteAvatar(ctx, store, oldAvatarID.String)
+		}
+	}
+	return s.GetProfile(ctx, &emptypb.Empty{})
+}
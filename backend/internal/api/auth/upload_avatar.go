@@ -0,0 +1,114 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/internal/storage"
+	"musicclubbot/backend/proto"
+
+	"github.com/google/uuid"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// maxAvatarBytes bounds the uploaded original; only the resized copies are
+// kept.
+const maxAvatarBytes = 5 << 20
+
+func (s *AuthService) UploadAvatar(stream grpc.ClientStreamingServer[proto.UploadAvatarRequest, proto.ProfileResponse]) error {
+	ctx := stream.Context()
+	userID, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+	store, err := helpers.StorageFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if buf.Len()+len(msg.GetChunk()) > maxAvatarBytes {
+			return status.Errorf(codes.ResourceExhausted, "avatar exceeds %d MB", maxAvatarBytes>>20)
+		}
+		buf.Write(msg.GetChunk())
+	}
+	img, _, err := image.Decode(&buf)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "avatar must be a JPEG, PNG, GIF or WebP image")
+	}
+
+	avatarID := uuid.NewString()
+	if err := storeAvatar(ctx, store, avatarID, img); err != nil {
+		deleteAvatar(ctx, store, avatarID)
+		return status.Errorf(codes.Internal, "store avatar: %v", err)
+	}
+
+	var oldID sql.NullString
+	err = db.QueryRowContext(ctx, `
+		UPDATE app_user u SET avatar_id = $2, avatar_url = $3, avatar_custom = TRUE
+		FROM (SELECT avatar_id FROM app_user WHERE id = $1) old
+		WHERE u.id = $1
+		RETURNING old.avatar_id
+	`, userID, avatarID, helpers.AvatarURL(ctx, avatarID)).Scan(&oldID)
+	if err != nil {
+		deleteAvatar(ctx, store, avatarID)
+		return status.Errorf(codes.Internal, "save avatar: %v", err)
+	}
+	if oldID.Valid {
+		deleteAvatar(ctx, store, oldID.String)
+	}
+
+	profile, err := s.GetProfile(ctx, &emptypb.Empty{})
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(profile)
+}
+
+// storeAvatar crops the image to a centered square and stores it as JPEG in
+// every AvatarSizes size.
+func storeAvatar(ctx context.Context, store storage.Store, avatarID string, img image.Image) error {
+	b := img.Bounds()
+	side := min(b.Dx(), b.Dy())
+	x0 := b.Min.X + (b.Dx()-side)/2
+	y0 := b.Min.Y + (b.Dy()-side)/2
+	square := image.Rect(x0, y0, x0+side, y0+side)
+	for _, size := range helpers.AvatarSizes {
+		scaled := image.NewRGBA(image.Rect(0, 0, size, size))
+		draw.CatmullRom.Scale(scaled, scaled.Bounds(), img, square, draw.Over, nil)
+		var out bytes.Buffer
+		if err := jpeg.Encode(&out, scaled, &jpeg.Options{Quality: 85}); err != nil {
+			return err
+		}
+		if _, err := store.Put(ctx, helpers.AvatarStorageKey(avatarID, size), &out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteAvatar removes every size of a stored avatar, best effort.
+func deleteAvatar(ctx context.Context, store storage.Store, avatarID string) {
+	for _, size := range helpers.AvatarSizes {
+		_ = store.Delete(ctx, helpers.AvatarStorageKey(avatarID, size))
+	}
+}
@@ -0,0 +1,93 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"musicclubbot/backend/internal/helpers"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// usernameReservation is how long a username someone gave up stays theirs
+// before anyone else may take it.
+const usernameReservation = 30 * 24 * time.Hour
+
+// usernamePattern follows Telegram's rules, minus its 5 character minimum.
+var usernamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]{2,31}$`)
+
+// validateUsername checks a username a user picked; "tg_" names are left
+// for accounts created from Telegram without one.
+func validateUsername(username string) error {
+	if !usernamePattern.MatchString(username) {
+		return status.Error(codes.InvalidArgument, "username must be 3-32 latin letters, digits or underscores, starting with a letter")
+	}
+	if strings.HasPrefix(strings.ToLower(username), "tg_") {
+		return status.Error(codes.InvalidArgument, "usernames starting with tg_ are reserved")
+	}
+	return nil
+}
+
+// usernameTaken reports why userID (empty for a new account) can't have the
+// username: it belongs to someone else, ignoring case, or someone else gave
+// it up less than usernameReservation ago. Empty means it's free.
+func usernameTaken(ctx context.Context, q helpers.QueryRower, username, userID string) (string, error) {
+	var inUse, reserved bool
+	err := q.QueryRowContext(ctx, `
+		SELECT EXISTS (SELECT 1 FROM app_user WHERE lower(username) = lower($1) AND id::text <> $2),
+		       EXISTS (SELECT 1 FROM username_history
+		               WHERE lower(username) = lower($1) AND user_id::text <> $2 AND reserved_until > NOW())
+	`, username, userID).Scan(&inUse, &reserved)
+	switch {
+	case err != nil:
+		return "", err
+	case inUse:
+		return "username already taken", nil
+	case reserved:
+		return "username was recently used by another member", nil
+	}
+	return "", nil
+}
+
+// changeUsername renames the user within tx, reserving the old name for
+// them. Tokens carry the new name from the next refresh on.
+func changeUsername(ctx context.Context, tx *sql.Tx, userID, username string) error {
+	if err := validateUsername(username); err != nil {
+		return err
+	}
+	var current string
+	if err := tx.QueryRowContext(ctx, `SELECT username FROM app_user WHERE id::text = $1 FOR UPDATE`, userID).Scan(&current); err != nil {
+		return status.Errorf(codes.Internal, "load username: %v", err)
+	}
+	if current == username {
+		return nil
+	}
+	reason, err := usernameTaken(ctx, tx, username, userID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "check username: %v", err)
+	}
+	if reason != "" {
+		return status.Error(codes.AlreadyExists, reason)
+	}
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO username_history (user_id, username, reserved_until) VALUES ($1, $2, $3)
+	`, userID, current, time.Now().Add(usernameReservation)); err != nil {
+		return status.Errorf(codes.Internal, "record username history: %v", err)
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE app_user SET username = $2 WHERE id::text = $1`, userID, username); err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return status.Error(codes.AlreadyExists, "username already taken")
+		}
+		return status.Errorf(codes.Internal, "update username: %v", err)
+	}
+	if err := helpers.RecordAudit(ctx, tx, helpers.AuditUser, userID, "rename", current+" → "+username); err != nil {
+		return status.Errorf(codes.Internal, "record audit: %v", err)
+	}
+	return nil
+}
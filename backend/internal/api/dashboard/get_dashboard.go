@@ -0,0 +1,184 @@
+package dashboard
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// dashboardVacantRoles caps Dashboard.vacant_roles.
+	dashboardVacantRoles = 50
+	// dashboardRecentSongs is how many new songs the home screen shows.
+	dashboardRecentSongs = 5
+	// dashboardUnreadActivity caps Dashboard.unread_activity.
+	dashboardUnreadActivity = 10
+)
+
+func (s *DashboardService) GetDashboard(ctx context.Context, _ *emptypb.Empty) (*proto.Dashboard, error) {
+	userID, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &proto.Dashboard{}
+	if err := loadNextEvent(ctx, db, d); err != nil {
+		return nil, err
+	}
+	if d.VacantRoles, err = loadVacantRoles(ctx, db); err != nil {
+		return nil, status.Errorf(codes.Internal, "load vacant roles: %v", err)
+	}
+
+	songs, err := recentSongs(ctx, db, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load recent songs: %v", err)
+	}
+	d.RecentSongs = songs
+
+	if err := loadUnreadActivity(ctx, db, userID, d); err != nil {
+		return nil, status.Errorf(codes.Internal, "load activity: %v", err)
+	}
+	return d, nil
+}
+
+func loadNextEvent(ctx context.Context, db *sql.DB, d *proto.Dashboard) error {
+	e, err := helpers.ScanEvent(db.QueryRowContext(ctx, `
+		SELECT `+helpers.EventColumns+` FROM `+helpers.EventFrom+`
+		WHERE e.start_at > NOW() AND e.cancelled_at IS NULL AND e.deleted_at IS NULL
+		ORDER BY e.start_at, e.id
+		LIMIT 1
+	`))
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "load next event: %v", err)
+	}
+	d.NextEvent = e
+
+	r := &proto.TracklistReadiness{}
+	if err := db.QueryRowContext(ctx, `
+		SELECT COUNT(*),
+		       COUNT(*) FILTER (WHERE rehearsal_status = 'ready'),
+		       COUNT(*) FILTER (WHERE rehearsal_status = 'in_progress'),
+		       COUNT(*) FILTER (WHERE rehearsal_status = 'not_started'),
+		       (SELECT COUNT(*) FROM (
+		            SELECT DISTINCT sr.song_id, sr.role
+		            FROM event_track_item ti JOIN song_role sr ON sr.song_id = ti.song_id
+		            WHERE ti.event_id = $1
+		              AND NOT EXISTS (SELECT 1 FROM song_role_assignment a WHERE a.song_id = sr.song_id AND a.role = sr.role)
+		        ) v)
+		FROM event_track_item WHERE event_id = $1
+	`, e.GetId()).Scan(&r.Items, &r.Ready, &r.InProgress, &r.NotStarted, &r.VacantRoles); err != nil {
+		return status.Errorf(codes.Internal, "load readiness: %v", err)
+	}
+	d.NextEventReadiness = r
+	return nil
+}
+
+// loadVacantRoles lists the event's required roles nobody signed up for and
+// the roles of its tracklist songs nobody plays.
+func loadVacantRoles(ctx context.Context, db *sql.DB) ([]*proto.VacantRole, error) {
+	rows, err := db.QueryContext(ctx, `
+		WITH upcoming AS (
+		    SELECT id, title, start_at, required_roles FROM event
+		    WHERE start_at > NOW() AND cancelled_at IS NULL AND deleted_at IS NULL
+		)
+		SELECT u.id, u.title, u.start_at, '' AS song_id, '' AS song_title, r.role
+		FROM upcoming u, unnest(u.required_roles) AS r(role)
+		WHERE NOT EXISTS (SELECT 1 FROM event_participant p WHERE p.event_id = u.id AND p.role = r.role)
+		UNION
+		SELECT u.id, u.title, u.start_at, s.id::text, s.title, sr.role
+		FROM upcoming u
+		JOIN event_track_item ti ON ti.event_id = u.id
+		JOIN song s ON s.id = ti.song_id
+		JOIN song_role sr ON sr.song_id = s.id
+		WHERE NOT EXISTS (SELECT 1 FROM song_role_assignment a WHERE a.song_id = s.id AND a.role = sr.role)
+		ORDER BY start_at, id, song_title, role
+		LIMIT $1
+	`, dashboardVacantRoles)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var roles []*proto.VacantRole
+	for rows.Next() {
+		v := &proto.VacantRole{}
+		var start time.Time
+		if err := rows.Scan(&v.EventId, &v.EventTitle, &start, &v.SongId, &v.SongTitle, &v.Role); err != nil {
+			return nil, err
+		}
+		v.StartAt = timestamppb.New(start)
+		roles = append(roles, v)
+	}
+	return roles, rows.Err()
+}
+
+func recentSongs(ctx context.Context, db *sql.DB, userID string) ([]*proto.Song, error) {
+	rows, err := db.QueryContext(ctx, `SELECT id FROM song ORDER BY created_at DESC, id LIMIT $1`, dashboardRecentSongs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	details, err := helpers.LoadSongDetailsBatch(ctx, db, ids, userID)
+	if err != nil {
+		return nil, err
+	}
+	songs := make([]*proto.Song, 0, len(details))
+	for _, d := range details {
+		songs = append(songs, d.GetSong())
+	}
+	return songs, nil
+}
+
+// loadUnreadActivity counts other members' activity since the user last
+// marked the feed seen; never marking it makes everything unread.
+func loadUnreadActivity(ctx context.Context, db *sql.DB, userID string, d *proto.Dashboard) error {
+	const unread = `
+		a.user_id::text <> $1
+		AND a.created_at > COALESCE((SELECT activity_seen_at FROM app_user WHERE id::text = $1), '-infinity')
+		AND (a.event_id IS NULL OR e.deleted_at IS NULL)`
+	if err := db.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM `+helpers.ActivityFrom+` WHERE `+unread, userID).Scan(&d.UnreadActivityCount); err != nil {
+		return err
+	}
+	if d.UnreadActivityCount == 0 {
+		return nil
+	}
+	rows, err := db.QueryContext(ctx, `
+		SELECT `+helpers.ActivityColumns+` FROM `+helpers.ActivityFrom+`
+		WHERE `+unread+`
+		ORDER BY a.created_at DESC, a.id DESC
+		LIMIT $2
+	`, userID, dashboardUnreadActivity)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		a, err := helpers.ScanActivity(rows)
+		if err != nil {
+			return err
+		}
+		d.UnreadActivity = append(d.UnreadActivity, a)
+	}
+	return rows.Err()
+}
@@ -0,0 +1,10 @@
+package dashboard
+
+import (
+	"musicclubbot/backend/proto"
+)
+
+// DashboardService implements the Mini App home screen.
+type DashboardService struct {
+	proto.UnimplementedDashboardServiceServer
+}
@@ -0,0 +1,105 @@
+package dues
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *DuesService) GetMyDuesBalance(ctx context.Context, _ *emptypb.Empty) (*proto.DuesBalance, error) {
+	userID, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	balances, err := loadBalances(ctx, db, "WHERE d.user_id::text = $1", userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(balances) > 0 {
+		return balances[0], nil
+	}
+	user, err := helpers.LoadUserById(ctx, db, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load user: %v", err)
+	}
+	return &proto.DuesBalance{User: user}, nil
+}
+
+func (s *DuesService) ListDuesBalances(ctx context.Context, _ *emptypb.Empty) (*proto.ListDuesBalancesResponse, error) {
+	_, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	balances, err := loadBalances(ctx, db, "")
+	if err != nil {
+		return nil, err
+	}
+	resp := &proto.ListDuesBalancesResponse{Balances: balances}
+	for _, b := range balances {
+		resp.ContributedCents += b.GetContributedCents()
+		resp.ChargedCents += b.GetChargedCents()
+	}
+	return resp, nil
+}
+
+func (s *DuesService) GetSeasonDuesSummary(ctx context.Context, req *proto.SeasonDuesSummaryRequest) (*proto.SeasonDuesSummary, error) {
+	_, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	season, err := helpers.LoadSeason(ctx, db, req.GetSeasonId())
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "season not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load season: %v", err)
+	}
+	balances, err := loadBalances(ctx, db, "WHERE d.season_id = $1", season.GetId())
+	if err != nil {
+		return nil, err
+	}
+	summary := &proto.SeasonDuesSummary{Season: season, Balances: balances}
+	for _, b := range balances {
+		summary.ContributedCents += b.GetContributedCents()
+		summary.ChargedCents += b.GetChargedCents()
+	}
+	return summary, nil
+}
+
+// loadBalances sums the entries matching where per member, lowest balance
+// first.
+func loadBalances(ctx context.Context, db *sql.DB, where string, args ...any) ([]*proto.DuesBalance, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT u.id, u.display_name, u.username, COALESCE(u.avatar_url, ''),
+		       COALESCE(SUM(d.amount_cents) FILTER (WHERE d.kind = 'contribution'), 0),
+		       COALESCE(SUM(d.amount_cents) FILTER (WHERE d.kind = 'charge'), 0)
+		FROM dues_entry d
+		JOIN app_user u ON u.id = d.user_id
+	`+where+`
+		GROUP BY u.id
+		ORDER BY SUM(CASE WHEN d.kind = 'contribution' THEN d.amount_cents ELSE -d.amount_cents END), u.display_name, u.id
+	`, args...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load dues balances: %v", err)
+	}
+	defer rows.Close()
+	var out []*proto.DuesBalance
+	for rows.Next() {
+		b := &proto.DuesBalance{User: &proto.User{}}
+		if err := rows.Scan(&b.User.Id, &b.User.DisplayName, &b.User.Username, &b.User.AvatarUrl,
+			&b.ContributedCents, &b.ChargedCents); err != nil {
+			return nil, status.Errorf(codes.Internal, "scan dues balance: %v", err)
+		}
+		b.BalanceCents = b.ContributedCents - b.ChargedCents
+		out = append(out, b)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "iterate dues balances: %v", err)
+	}
+	return out, nil
+}
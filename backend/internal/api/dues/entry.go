@@ -0,0 +1,243 @@
+package dues
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	maxDuesDescription = 200
+	// maxDuesCents keeps sums far away from overflowing.
+	maxDuesCents = 10_000_000_000
+)
+
+func (s *DuesService) RecordDuesEntry(ctx context.Context, req *proto.RecordDuesEntryRequest) (*proto.DuesEntry, error) {
+	adminID, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	kind, ok := kindToDB[req.GetKind()]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "kind is required")
+	}
+	if req.GetAmountCents() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
+	}
+	if req.GetAmountCents() > maxDuesCents {
+		return nil, status.Error(codes.InvalidArgument, "amount is too large")
+	}
+	description := strings.TrimSpace(req.GetDescription())
+	if utf8.RuneCountInString(description) > maxDuesDescription {
+		return nil, status.Errorf(codes.InvalidArgument, "description must be at most %d characters", maxDuesDescription)
+	}
+	occurredAt := time.Now()
+	if req.GetOccurredAt() != nil {
+		occurredAt = req.GetOccurredAt().AsTime()
+	}
+	if _, err := helpers.LoadUserById(ctx, db, req.GetUserId()); err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "user not found")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "load user: %v", err)
+	}
+	if req.GetSeasonId() != "" {
+		if _, err := helpers.LoadSeason(ctx, db, req.GetSeasonId()); err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "season not found")
+		} else if err != nil {
+			return nil, status.Errorf(codes.Internal, "load season: %v", err)
+		}
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+	var id string
+	if err := tx.QueryRowContext(ctx, `
+		INSERT INTO dues_entry (user_id, kind, amount_cents, description, season_id, occurred_at, recorded_by)
+		VALUES ($1, $2, $3, $4,
+		        COALESCE(NULLIF($5, '')::uuid, (
+		            SELECT id FROM season
+		            WHERE (starts_at IS NULL OR starts_at <= $6) AND (ends_at IS NULL OR ends_at > $6)
+		            ORDER BY starts_at DESC NULLS LAST
+		            LIMIT 1)),
+		        $6, $7)
+		RETURNING id
+	`, req.GetUserId(), kind, req.GetAmountCents(), description, req.GetSeasonId(), occurredAt, adminID).Scan(&id); err != nil {
+		return nil, status.Errorf(codes.Internal, "insert dues entry: %v", err)
+	}
+	summary := fmt.Sprintf("%s %s", kind, formatCents(req.GetAmountCents()))
+	if err := helpers.RecordAudit(ctx, tx, helpers.AuditDues, id, "record", summary); err != nil {
+		return nil, status.Errorf(codes.Internal, "record audit: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit: %v", err)
+	}
+	return loadEntry(ctx, db, id)
+}
+
+func (s *DuesService) DeleteDuesEntry(ctx context.Context, req *proto.DuesEntryId) (*emptypb.Empty, error) {
+	_, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+	var kind string
+	var amount int64
+	err = tx.QueryRowContext(ctx, `
+		DELETE FROM dues_entry WHERE id::text = $1 RETURNING kind, amount_cents
+	`, req.GetId()).Scan(&kind, &amount)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "dues entry not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "delete dues entry: %v", err)
+	}
+	if err := helpers.RecordAudit(ctx, tx, helpers.AuditDues, req.GetId(), "delete", fmt.Sprintf("%s %s", kind, formatCents(amount))); err != nil {
+		return nil, status.Errorf(codes.Internal, "record audit: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *DuesService) ListDuesEntries(ctx context.Context, req *proto.ListDuesEntriesRequest) (*proto.ListDuesEntriesResponse, error) {
+	userID, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	memberID := req.GetUserId()
+	if memberID == "" {
+		memberID = userID
+	}
+	if memberID != userID {
+		perms, err := helpers.LoadPermissions(ctx, db, userID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "load permissions: %v", err)
+		}
+		if !helpers.PermissionAllowsPermissionManagement(perms) {
+			return nil, status.Error(codes.PermissionDenied, "no rights to see other members' dues")
+		}
+	}
+
+	limit := int(req.GetPageSize())
+	if limit == 0 {
+		limit = 50
+	}
+	offset := 0
+	if tok := req.GetPageToken(); tok != "" {
+		if v, err := strconv.Atoi(tok); err == nil && v >= 0 {
+			offset = v
+		}
+	}
+
+	where := "WHERE d.user_id::text = $1"
+	args := []any{memberID}
+	if req.GetSeasonId() != "" {
+		args = append(args, req.GetSeasonId())
+		where += fmt.Sprintf(" AND d.season_id::text = $%d", len(args))
+	}
+	page := fmt.Sprintf("LIMIT $%d OFFSET $%d", len(args)+1, len(args)+2)
+	list, total, err := loadEntries(ctx, db, where, page, append(args, limit, offset)...)
+	if err != nil {
+		return nil, err
+	}
+	resp := &proto.ListDuesEntriesResponse{Entries: list, TotalCount: total}
+	if offset+len(list) < int(total) {
+		resp.NextPageToken = strconv.Itoa(offset + len(list))
+	}
+	return resp, nil
+}
+
+var kindToDB = map[proto.DuesEntryKind]string{
+	proto.DuesEntryKind_DUES_ENTRY_KIND_CONTRIBUTION: "contribution",
+	proto.DuesEntryKind_DUES_ENTRY_KIND_CHARGE:       "charge",
+}
+
+func kindFromDB(kind string) proto.DuesEntryKind {
+	for k, v := range kindToDB {
+		if v == kind {
+			return k
+		}
+	}
+	return proto.DuesEntryKind_DUES_ENTRY_KIND_UNSPECIFIED
+}
+
+// formatCents renders an amount for the audit log, e.g. "1500.00".
+func formatCents(cents int64) string {
+	return fmt.Sprintf("%d.%02d", cents/100, cents%100)
+}
+
+func loadEntry(ctx context.Context, db *sql.DB, id string) (*proto.DuesEntry, error) {
+	list, _, err := loadEntries(ctx, db, "WHERE d.id::text = $1", "", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, status.Error(codes.NotFound, "dues entry not found")
+	}
+	return list[0], nil
+}
+
+// loadEntries lists entries matching where, latest first, limited by page,
+// and how many match in total.
+func loadEntries(ctx context.Context, db *sql.DB, where, page string, args ...any) ([]*proto.DuesEntry, int32, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT d.id, d.kind, d.amount_cents, d.description, COALESCE(d.season_id::text, ''), d.occurred_at, d.created_at,
+		       u.id, u.display_name, u.username, COALESCE(u.avatar_url, ''),
+		       ru.id, COALESCE(ru.display_name, ''), COALESCE(ru.username, ''), COALESCE(ru.avatar_url, ''),
+		       COUNT(*) OVER ()
+		FROM dues_entry d
+		JOIN app_user u ON u.id = d.user_id
+		LEFT JOIN app_user ru ON ru.id = d.recorded_by
+	`+where+`
+		ORDER BY d.occurred_at DESC, d.id
+	`+page, args...)
+	if err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "list dues entries: %v", err)
+	}
+	defer rows.Close()
+	var out []*proto.DuesEntry
+	var total int32
+	for rows.Next() {
+		e := &proto.DuesEntry{User: &proto.User{}}
+		recorder := &proto.User{}
+		var kind string
+		var occurred, created time.Time
+		var recorderID sql.NullString
+		if err := rows.Scan(&e.Id, &kind, &e.AmountCents, &e.Description, &e.SeasonId, &occurred, &created,
+			&e.User.Id, &e.User.DisplayName, &e.User.Username, &e.User.AvatarUrl,
+			&recorderID, &recorder.DisplayName, &recorder.Username, &recorder.AvatarUrl, &total); err != nil {
+			return nil, 0, status.Errorf(codes.Internal, "scan dues entry: %v", err)
+		}
+		e.Kind = kindFromDB(kind)
+		e.OccurredAt = timestamppb.New(occurred)
+		e.CreatedAt = timestamppb.New(created)
+		if recorderID.Valid {
+			recorder.Id = recorderID.String
+			e.RecordedBy = recorder
+		}
+		out = append(out, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "iterate dues entries: %v", err)
+	}
+	return out, total, nil
+}
@@ -0,0 +1,10 @@
+package dues
+
+import (
+	"musicclubbot/backend/proto"
+)
+
+// DuesService implements dues ledger endpoints.
+type DuesService struct {
+	proto.UnimplementedDuesServiceServer
+}
@@ -0,0 +1,181 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"html"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"sort"
+	"strings"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultAnnouncementHighlights = 5
+
+// markup formats announcement pieces for one Telegram parse mode.
+type markup struct {
+	escape func(string) string
+	bold   func(string) string
+	link   func(text, url string) string
+}
+
+var htmlMarkup = markup{
+	escape: html.EscapeString,
+	bold:   func(s string) string { return "<b>" + s + "</b>" },
+	link: func(text, url string) string {
+		return `<a href="` + html.EscapeString(url) + `">` + text + "</a>"
+	},
+}
+
+// markdownV2Escaper escapes everything MarkdownV2 treats as markup.
+var markdownV2Escaper = strings.NewReplacer(
+	`\`, `\\`, "_", `\_`, "*", `\*`, "[", `\[`, "]", `\]`, "(", `\(`, ")", `\)`, "~", `\~`, "`", "\\`",
+	">", `\>`, "#", `\#`, "+", `\+`, "-", `\-`, "=", `\=`, "|", `\|`, "{", `\{`, "}", `\}`, ".", `\.`, "!", `\!`,
+)
+
+var markdownMarkup = markup{
+	escape: markdownV2Escaper.Replace,
+	bold:   func(s string) string { return "*" + s + "*" },
+	link: func(text, url string) string {
+		return "[" + text + "](" + strings.NewReplacer(`\`, `\\`, ")", `\)`).Replace(url) + ")"
+	},
+}
+
+func (s *EventService) RenderEventAnnouncement(ctx context.Context, req *proto.RenderEventAnnouncementRequest) (*proto.EventAnnouncement, error) {
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	currentUserID, _ := helpers.UserIDFromCtx(ctx)
+	details, err := helpers.LoadEventDetails(ctx, db, req.GetEventId(), currentUserID)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "event not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load event: %v", err)
+	}
+
+	m := htmlMarkup
+	if req.GetFormat() == proto.AnnouncementFormat_ANNOUNCEMENT_FORMAT_MARKDOWN {
+		m = markdownMarkup
+	}
+	highlights := int(req.GetHighlights())
+	if highlights == 0 {
+		highlights = defaultAnnouncementHighlights
+	}
+
+	text, err := renderAnnouncement(ctx, db, details, m, highlights)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "render announcement: %v", err)
+	}
+	return &proto.EventAnnouncement{Text: text, Format: req.GetFormat()}, nil
+}
+
+func renderAnnouncement(ctx context.Context, db *sql.DB, details *proto.EventDetails, m markup, highlights int) (string, error) {
+	e := details.GetEvent()
+	var lines []string
+	lines = append(lines, "🎸 "+m.bold(m.escape(e.GetTitle())))
+	if ts := e.GetStartAt(); ts != nil {
+		lines = append(lines, "📅 "+m.escape(helpers.FormatDateTimeRU(ts.AsTime().In(helpers.Location(e.GetTimezone())))))
+	}
+	if v := details.GetVenue(); v != nil {
+		place := m.escape(v.GetName())
+		if v.GetMapUrl() != "" {
+			place = m.link(place, v.GetMapUrl())
+		}
+		if v.GetAddress() != "" {
+			place += m.escape(", " + v.GetAddress())
+		}
+		lines = append(lines, "📍 "+place)
+	} else if e.GetLocation() != "" {
+		lines = append(lines, "📍 "+m.escape(e.GetLocation()))
+	}
+
+	items := details.GetTracklist().GetItems()
+	var songIDs []string
+	for _, item := range items {
+		if item.GetSongId() != "" {
+			songIDs = append(songIDs, item.GetSongId())
+		}
+	}
+
+	if lineup := announcementLineup(details.GetLineups()); len(lineup) > 0 {
+		lines = append(lines, "", m.bold(m.escape("Состав:")))
+		for _, performer := range lineup {
+			lines = append(lines, m.escape("• "+performer))
+		}
+	}
+
+	if len(items) > 0 {
+		titles, err := loadTrackTitles(ctx, db, songIDs)
+		if err != nil {
+			return "", err
+		}
+		lines = append(lines, "", m.bold(m.escape("В программе:")))
+		for i, item := range items {
+			if i == highlights {
+				lines = append(lines, m.escape(fmt.Sprintf("…и ещё %d", len(items)-highlights)))
+				break
+			}
+			title, ok := titles[item.GetSongId()]
+			if !ok {
+				title = item.GetCustomTitle()
+				if item.GetCustomArtist() != "" {
+					title = item.GetCustomArtist() + " — " + title
+				}
+			}
+			lines = append(lines, m.escape("• "+title))
+		}
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// announcementLineup lists performers as "Name (role, role)" by name.
+func announcementLineup(lineups []*proto.TrackLineup) []string {
+	roles := map[string]map[string]bool{}
+	names := map[string]string{}
+	for _, lineup := range lineups {
+		for _, a := range lineup.GetAssignments() {
+			id := a.GetUser().GetId()
+			if roles[id] == nil {
+				roles[id] = map[string]bool{}
+				names[id] = a.GetUser().GetDisplayName()
+			}
+			roles[id][a.GetRole()] = true
+		}
+	}
+	var out []string
+	for id, set := range roles {
+		var list []string
+		for role := range set {
+			list = append(list, role)
+		}
+		sort.Strings(list)
+		out = append(out, names[id]+" ("+strings.Join(list, ", ")+")")
+	}
+	sort.Strings(out)
+	return out
+}
+
+// loadTrackTitles returns "Artist — Title" per song id.
+func loadTrackTitles(ctx context.Context, db *sql.DB, songIDs []string) (map[string]string, error) {
+	rows, err := db.QueryContext(ctx, `SELECT id, artist, title FROM song WHERE id = ANY($1)`, pq.Array(songIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := map[string]string{}
+	for rows.Next() {
+		var id, artist, title string
+		if err := rows.Scan(&id, &artist, &title); err != nil {
+			return nil, err
+		}
+		out[id] = artist + " — " + title
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,17 @@
+package event
+
+import (
+	"context"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *EventService) GetCalendarFeed(ctx context.Context, _ *emptypb.Empty) (*proto.CalendarFeed, error) {
+	userID, err := helpers.UserIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.CalendarFeed{Url: helpers.CalendarFeedURL(ctx, userID)}, nil
+}
@@ -0,0 +1,57 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CancelEvent only marks the event; the notification job tells participants.
+func (s *EventService) CancelEvent(ctx context.Context, req *proto.CancelEventRequest) (*proto.EventDetails, error) {
+	userID, db, err := requireEventManager(ctx, req.GetEventId())
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+
+	var completed, cancelled sql.NullTime
+	err = tx.QueryRowContext(ctx, `
+		SELECT completed_at, cancelled_at FROM event WHERE id = $1 AND deleted_at IS NULL FOR UPDATE
+	`, req.GetEventId()).Scan(&completed, &cancelled)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "event not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load event: %v", err)
+	}
+	if completed.Valid {
+		return nil, status.Error(codes.FailedPrecondition, "event is already completed")
+	}
+	if !cancelled.Valid {
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE event SET cancelled_at = NOW(), cancel_reason = $2, updated_at = NOW(), version = version + 1
+			WHERE id = $1
+		`, req.GetEventId(), nullIfEmpty(strings.TrimSpace(req.GetReason()))); err != nil {
+			return nil, status.Errorf(codes.Internal, "cancel event: %v", err)
+		}
+		if err := helpers.RecordAudit(ctx, tx, helpers.AuditEvent, req.GetEventId(), "cancel", strings.TrimSpace(req.GetReason())); err != nil {
+			return nil, status.Errorf(codes.Internal, "record audit: %v", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit: %v", err)
+	}
+	helpers.PublishEventChanged(ctx, req.GetEventId())
+	return helpers.LoadEventDetails(ctx, db, req.GetEventId(), userID)
+}
@@ -0,0 +1,98 @@
+package event
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"musicclubbot/backend/internal/config"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"strings"
+	"time"
+
+	"github.com/skip2/go-qrcode"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Check-ins are accepted from a bit before the start until the night is over.
+const (
+	checkInOpensBefore = 3 * time.Hour
+	checkInClosesAfter = 12 * time.Hour
+)
+
+func (s *EventService) GetCheckInCode(ctx context.Context, req *proto.GetCheckInCodeRequest) (*proto.CheckInCode, error) {
+	_, db, err := requireEventManager(ctx, req.GetEventId())
+	if err != nil {
+		return nil, err
+	}
+
+	var code sql.NullString
+	err = db.QueryRowContext(ctx, `SELECT check_in_code FROM event WHERE id = $1 AND deleted_at IS NULL`, req.GetEventId()).Scan(&code)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "event not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load event: %v", err)
+	}
+
+	if !code.Valid || req.GetRotate() {
+		buf := make([]byte, 12)
+		if _, err := rand.Read(buf); err != nil {
+			return nil, status.Errorf(codes.Internal, "generate code: %v", err)
+		}
+		code = sql.NullString{Valid: true, String: hex.EncodeToString(buf)}
+		if _, err := db.ExecContext(ctx, `UPDATE event SET check_in_code = $2 WHERE id = $1`, req.GetEventId(), code.String); err != nil {
+			return nil, status.Errorf(codes.Internal, "save code: %v", err)
+		}
+	}
+
+	cfg := ctx.Value("cfg").(config.Config)
+	url := "https://t.me/" + strings.TrimPrefix(cfg.BotUsername, "@") + "?startapp=checkin_" + code.String
+	png, err := qrcode.Encode(url, qrcode.Medium, 512)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "render qr: %v", err)
+	}
+	return &proto.CheckInCode{Code: code.String, Url: url, QrPng: png}, nil
+}
+
+func (s *EventService) CheckIn(ctx context.Context, req *proto.CheckInRequest) (*proto.EventDetails, error) {
+	userID, err := helpers.UserIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	code := strings.TrimPrefix(strings.TrimSpace(req.GetCode()), "checkin_")
+	if code == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+
+	var eventID string
+	var startAt sql.NullTime
+	err = db.QueryRowContext(ctx, `SELECT id, start_at FROM event WHERE check_in_code = $1 AND deleted_at IS NULL`, code).Scan(&eventID, &startAt)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "unknown check-in code")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load event: %v", err)
+	}
+	if startAt.Valid {
+		now := time.Now()
+		if now.Before(startAt.Time.Add(-checkInOpensBefore)) || now.After(startAt.Time.Add(checkInClosesAfter)) {
+			return nil, status.Error(codes.FailedPrecondition, "check-in is not open for this event")
+		}
+	}
+
+	if _, err := db.ExecContext(ctx, `
+		INSERT INTO event_attendance (event_id, user_id) VALUES ($1, $2)
+		ON CONFLICT (event_id, user_id) DO NOTHING
+	`, eventID, userID); err != nil {
+		return nil, status.Errorf(codes.Internal, "check in: %v", err)
+	}
+	helpers.PublishEventChanged(ctx, eventID)
+	return helpers.LoadEventDetails(ctx, db, eventID, userID)
+}
@@ -0,0 +1,55 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *EventService) CompleteEvent(ctx context.Context, req *proto.EventId) (*proto.EventDetails, error) {
+	userID, db, err := requireEventManager(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+
+	var completed, cancelled sql.NullTime
+	err = tx.QueryRowContext(ctx, `SELECT completed_at, cancelled_at FROM event WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`, req.GetId()).Scan(&completed, &cancelled)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "event not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load event: %v", err)
+	}
+	if cancelled.Valid {
+		return nil, status.Error(codes.FailedPrecondition, "event is cancelled")
+	}
+	if !completed.Valid {
+		if err := helpers.SnapshotEventLineups(ctx, tx, req.GetId()); err != nil {
+			return nil, status.Errorf(codes.Internal, "snapshot lineups: %v", err)
+		}
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE event SET completed_at = NOW(), updated_at = NOW(), version = version + 1 WHERE id = $1
+		`, req.GetId()); err != nil {
+			return nil, status.Errorf(codes.Internal, "complete event: %v", err)
+		}
+		if err := helpers.RecordAudit(ctx, tx, helpers.AuditEvent, req.GetId(), "complete", ""); err != nil {
+			return nil, status.Errorf(codes.Internal, "record audit: %v", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit: %v", err)
+	}
+	helpers.PublishEventChanged(ctx, req.GetId())
+	return helpers.LoadEventDetails(ctx, db, req.GetId(), userID)
+}
@@ -0,0 +1,94 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *EventService) CopyTracklist(ctx context.Context, req *proto.CopyTracklistRequest) (*proto.EventDetails, error) {
+	if req.GetSourceEventId() == req.GetTargetEventId() {
+		return nil, status.Error(codes.InvalidArgument, "source and target events must differ")
+	}
+	return editTracklist(ctx, req.GetTargetEventId(), func(tx *sql.Tx, order *trackOrder) error {
+		var exists bool
+		err := tx.QueryRowContext(ctx, `SELECT TRUE FROM event WHERE id::text = $1 AND deleted_at IS NULL`, req.GetSourceEventId()).Scan(&exists)
+		if err == sql.ErrNoRows {
+			return status.Error(codes.NotFound, "source event not found")
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "load source event: %v", err)
+		}
+
+		rows, err := tx.QueryContext(ctx, `
+			SELECT COALESCE(ti.set_id::text, ''), COALESCE(ts.name, ''),
+			       COALESCE(ti.song_id::text, ''), COALESCE(ti.custom_title, ''), COALESCE(ti.custom_artist, ''), COALESCE(ti.duration_sec, 0),
+			       COALESCE(ti.notes, ''), COALESCE(ti.musical_key, '')
+			FROM event_track_item ti
+			LEFT JOIN event_track_set ts ON ts.id = ti.set_id
+			WHERE ti.event_id = $1 AND (ti.performed OR NOT $2)
+			ORDER BY ts.position NULLS FIRST, ti.position
+		`, req.GetSourceEventId(), req.GetOnlyPerformed())
+		if err != nil {
+			return status.Errorf(codes.Internal, "load source tracklist: %v", err)
+		}
+		type sourceItem struct {
+			setID, setName        string
+			songID, title, artist string
+			duration              uint32
+			notes, key            string
+		}
+		var items []sourceItem
+		for rows.Next() {
+			var it sourceItem
+			if err := rows.Scan(&it.setID, &it.setName, &it.songID, &it.title, &it.artist, &it.duration, &it.notes, &it.key); err != nil {
+				rows.Close()
+				return status.Errorf(codes.Internal, "scan source tracklist: %v", err)
+			}
+			items = append(items, it)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return status.Errorf(codes.Internal, "iterate source tracklist: %v", err)
+		}
+
+		// Source sets become new sets after the target's own ones; items
+		// outside of sets stay outside.
+		newSets := map[string]string{}
+		for _, it := range items {
+			if it.setID == "" || newSets[it.setID] != "" {
+				continue
+			}
+			var setID string
+			if err := tx.QueryRowContext(ctx, `
+				INSERT INTO event_track_set (event_id, position, name)
+				VALUES ($1, (SELECT COALESCE(MAX(position), 0) + 1 FROM event_track_set WHERE event_id = $1), $2)
+				RETURNING id
+			`, req.GetTargetEventId(), it.setName).Scan(&setID); err != nil {
+				return status.Errorf(codes.Internal, "copy track set: %v", err)
+			}
+			newSets[it.setID] = setID
+			order.sets = append(order.sets, setID)
+		}
+		for _, it := range items {
+			var id string
+			if err := tx.QueryRowContext(ctx, `
+				INSERT INTO event_track_item (event_id, position, song_id, custom_title, custom_artist, duration_sec, notes, musical_key)
+				VALUES ($1, (SELECT COALESCE(MAX(position), 0) + 1 FROM event_track_item WHERE event_id = $1),
+				        NULLIF($2, '')::uuid, NULLIF($3, ''), NULLIF($4, ''), NULLIF($5, 0), NULLIF($6, ''), NULLIF($7, ''))
+				RETURNING id
+			`, req.GetTargetEventId(), it.songID, it.title, it.artist, it.duration, it.notes, it.key).Scan(&id); err != nil {
+				return status.Errorf(codes.Internal, "copy track item: %v", err)
+			}
+			order.place(newSets[it.setID], id, 0)
+		}
+		if err := helpers.RecordAudit(ctx, tx, helpers.AuditTracklist, req.GetTargetEventId(), "copy", "from "+req.GetSourceEventId()); err != nil {
+			return status.Errorf(codes.Internal, "record audit: %v", err)
+		}
+		return nil
+	})
+}
@@ -4,28 +4,42 @@ import (
 	"context"
 	"database/sql"
 	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/internal/recurrence"
 	"musicclubbot/backend/proto"
+	"time"
 
+	"github.com/lib/pq"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 func (s *EventService) CreateEvent(ctx context.Context, req *proto.CreateEventRequest) (*proto.EventDetails, error) {
-	userID, err := helpers.UserIDFromCtx(ctx)
+	userID, db, err := helpers.UserAndDbFromCtx(ctx)
 	if err != nil {
 		return nil, err
 	}
-	db, err := helpers.DbFromCtx(ctx)
+	if req.GetMaxParticipants() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "max_participants must not be negative")
+	}
+	if req.GetTimeSlotMinutes() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "time_slot_minutes must not be negative")
+	}
+	if err := checkTrackGap(req.GetTrackGapSeconds()); err != nil {
+		return nil, err
+	}
+	offsets, err := notifyOffsets(req.GetNotifyOffsetsMinutes(), req.GetNotifyDayBefore(), req.GetNotifyHourBefore())
 	if err != nil {
 		return nil, err
 	}
-	perms, err := helpers.LoadPermissions(ctx, db, userID)
+	timezone, err := resolveTimezone(ctx, req.GetTimezone())
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "load permissions: %v", err)
+		return nil, err
 	}
-	if !helpers.PermissionAllowsEventEdit(perms) {
-		return nil, status.Error(codes.PermissionDenied, "no rights to create events")
+	point, err := eventCoordinates(ctx, req.GetCoordinates(), req.GetMapUrl(), req.GetLocation(), req.GetVenueId())
+	if err != nil {
+		return nil, err
 	}
+	theme, themeTag := normalizeTheme(req.GetTheme(), req.GetThemeTag())
 
 	tx, err := db.BeginTx(ctx, nil)
 	if err != nil {
@@ -33,24 +47,55 @@ func (s *EventService) CreateEvent(ctx context.Context, req *proto.CreateEventRe
 	}
 	defer tx.Rollback()
 
+	roles, err := normalizeRoles(ctx, tx, req.GetRequiredRoles())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "normalize roles: %v", err)
+	}
+	if err := checkVenue(ctx, tx, req.GetVenueId()); err != nil {
+		return nil, err
+	}
+	if err := checkSeason(ctx, tx, req.GetSeasonId()); err != nil {
+		return nil, err
+	}
+
 	var eventID string
 	var startAt sql.NullTime
 	if ts := req.GetStartAt(); ts != nil {
 		startAt = sql.NullTime{Valid: true, Time: ts.AsTime()}
 	}
 
-	err = tx.QueryRowContext(ctx, `
-		INSERT INTO event (title, start_at, location, notify_day_before, notify_hour_before, created_by)
-		VALUES ($1, $2, $3, $4, $5, $6)
-		RETURNING id
-	`, req.GetTitle(), startAt, nullIfEmpty(req.GetLocation()), req.GetNotifyDayBefore(), req.GetNotifyHourBefore(), userID).Scan(&eventID)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "insert event: %v", err)
+	if req.GetRecurrence() != "" {
+		eventID, err = createSeries(ctx, tx, req, startAt, roles, offsets, timezone, userID, point, theme, themeTag)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		lat, lon := helpers.GeoArgs(point)
+		err = tx.QueryRowContext(ctx, `
+			INSERT INTO event (title, start_at, location, notify_day_before, notify_hour_before, created_by, max_participants, required_roles, venue_id, time_slot_minutes, timezone, season_id,
+			                   track_gap_seconds, latitude, longitude, theme, theme_tag)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+			RETURNING id
+		`, req.GetTitle(), startAt, nullIfEmpty(req.GetLocation()), hasOffset(offsets, dayBeforeMinutes), hasOffset(offsets, hourBeforeMinutes), userID,
+			req.GetMaxParticipants(), pq.Array(roles), nullIfEmpty(req.GetVenueId()), req.GetTimeSlotMinutes(), timezone, nullIfEmpty(req.GetSeasonId()),
+			req.GetTrackGapSeconds(), lat, lon, nullIfEmpty(theme), nullIfEmpty(themeTag)).Scan(&eventID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "insert event: %v", err)
+		}
+		if err := setEventNotifications(ctx, tx, []string{eventID}, offsets, false); err != nil {
+			return nil, status.Errorf(codes.Internal, "set notifications: %v", err)
+		}
+		if _, err := tx.ExecContext(ctx, `INSERT INTO event_owner (event_id, user_id) VALUES ($1, $2)`, eventID, userID); err != nil {
+			return nil, status.Errorf(codes.Internal, "add owner: %v", err)
+		}
 	}
 
 	if err := helpers.ReplaceTracklist(ctx, tx, eventID, req.GetTracklist()); err != nil {
 		return nil, status.Errorf(codes.Internal, "set tracklist: %v", err)
 	}
+	if err := helpers.RecordAudit(ctx, tx, helpers.AuditEvent, eventID, "create", req.GetTitle()); err != nil {
+		return nil, status.Errorf(codes.Internal, "record audit: %v", err)
+	}
 
 	if err := tx.Commit(); err != nil {
 		return nil, status.Errorf(codes.Internal, "commit: %v", err)
@@ -58,3 +103,51 @@ func (s *EventService) CreateEvent(ctx context.Context, req *proto.CreateEventRe
 
 	return helpers.LoadEventDetails(ctx, db, eventID, userID)
 }
+
+// createSeries stores a recurring series, materializes its first occurrences
+// and returns the id of the earliest one.
+func createSeries(ctx context.Context, tx *sql.Tx, req *proto.CreateEventRequest, startAt sql.NullTime, roles []string, offsets []int32, timezone, userID string, point *proto.GeoPoint, theme, themeTag string) (string, error) {
+	rule, err := recurrence.Normalize(req.GetRecurrence())
+	if err != nil {
+		return "", status.Error(codes.InvalidArgument, err.Error())
+	}
+	if !startAt.Valid {
+		return "", status.Error(codes.InvalidArgument, "recurring events need start_at")
+	}
+
+	// Past occurrences of a rule that started earlier are not backfilled.
+	now := time.Now()
+	from := startAt.Time
+	if from.Before(now) {
+		from = now
+	}
+
+	lat, lon := helpers.GeoArgs(point)
+	var seriesID string
+	if err := tx.QueryRowContext(ctx, `
+		INSERT INTO event_series (rrule, dtstart, materialized_until, title, location, notify_day_before, notify_hour_before, created_by, max_participants, required_roles, venue_id, time_slot_minutes, notify_offsets, timezone, season_id, track_gap_seconds,
+		                          latitude, longitude, theme, theme_tag)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20)
+		RETURNING id
+	`, rule, startAt.Time, from.Add(-time.Second), req.GetTitle(), nullIfEmpty(req.GetLocation()),
+		hasOffset(offsets, dayBeforeMinutes), hasOffset(offsets, hourBeforeMinutes), userID, req.GetMaxParticipants(), pq.Array(roles),
+		nullIfEmpty(req.GetVenueId()), req.GetTimeSlotMinutes(), pq.Array(offsets), timezone, nullIfEmpty(req.GetSeasonId()), req.GetTrackGapSeconds(),
+		lat, lon, nullIfEmpty(theme), nullIfEmpty(themeTag)).Scan(&seriesID); err != nil {
+		return "", status.Errorf(codes.Internal, "insert series: %v", err)
+	}
+	if err := recurrence.Materialize(ctx, tx, seriesID, from); err != nil {
+		return "", status.Errorf(codes.Internal, "materialize series: %v", err)
+	}
+
+	var eventID string
+	err = tx.QueryRowContext(ctx, `
+		SELECT id FROM event WHERE series_id = $1 ORDER BY occurrence_at LIMIT 1
+	`, seriesID).Scan(&eventID)
+	if err == sql.ErrNoRows {
+		return "", status.Error(codes.InvalidArgument, "recurrence has no upcoming occurrences")
+	}
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "load first occurrence: %v", err)
+	}
+	return eventID, nil
+}
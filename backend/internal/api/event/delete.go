@@ -2,6 +2,7 @@ package event
 
 import (
 	"context"
+	"database/sql"
 	"musicclubbot/backend/internal/helpers"
 	"musicclubbot/backend/proto"
 
@@ -19,21 +20,69 @@ func (s *EventService) DeleteEvent(ctx context.Context, req *proto.EventId) (*em
 	if err != nil {
 		return nil, err
 	}
-	perms, err := helpers.LoadPermissions(ctx, db, userID)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "load permissions: %v", err)
+	if err := requireEventAccess(ctx, db, userID, req.GetId(), false); err != nil {
+		return nil, err
 	}
-	if !helpers.PermissionAllowsEventEdit(perms) {
-		return nil, status.Error(codes.PermissionDenied, "no rights to delete events")
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
 	}
+	defer tx.Rollback()
 
-	res, err := db.ExecContext(ctx, `DELETE FROM event WHERE id = $1`, req.GetId())
+	// The row stays with its tracklist and history so it can be restored.
+	var title string
+	err = tx.QueryRowContext(ctx, `
+		UPDATE event SET deleted_at = NOW(), deleted_by = $2, updated_at = NOW(), version = version + 1
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING title
+	`, req.GetId(), userID).Scan(&title)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "event not found")
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "delete event: %v", err)
 	}
-	affected, _ := res.RowsAffected()
-	if affected == 0 {
-		return nil, status.Error(codes.NotFound, "event not found")
+	if err := helpers.RecordAudit(ctx, tx, helpers.AuditEvent, req.GetId(), "delete", title); err != nil {
+		return nil, status.Errorf(codes.Internal, "record audit: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit: %v", err)
 	}
+	helpers.PublishEventChanged(ctx, req.GetId())
 	return &emptypb.Empty{}, nil
 }
+
+func (s *EventService) RestoreEvent(ctx context.Context, req *proto.EventId) (*proto.EventDetails, error) {
+	userID, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+
+	var title string
+	err = tx.QueryRowContext(ctx, `
+		UPDATE event SET deleted_at = NULL, deleted_by = NULL, updated_at = NOW(), version = version + 1
+		WHERE id::text = $1 AND deleted_at IS NOT NULL
+		RETURNING title
+	`, req.GetId()).Scan(&title)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "archived event not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "restore event: %v", err)
+	}
+	if err := helpers.RecordAudit(ctx, tx, helpers.AuditEvent, req.GetId(), "restore", title); err != nil {
+		return nil, status.Errorf(codes.Internal, "record audit: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit: %v", err)
+	}
+	helpers.PublishEventChanged(ctx, req.GetId())
+	return helpers.LoadEventDetails(ctx, db, req.GetId(), userID)
+}
@@ -0,0 +1,70 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DuplicateEvent needs create_events like CreateEvent and goes through
+// CopyTracklist, so its access check and CreateEvent's validation apply to
+// the copy.
+func (s *EventService) DuplicateEvent(ctx context.Context, req *proto.DuplicateEventRequest) (*proto.EventDetails, error) {
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	src, err := helpers.ScanEvent(db.QueryRowContext(ctx, `SELECT `+helpers.EventColumns+` FROM `+helpers.EventFrom+` WHERE e.id::text = $1`, req.GetEventId()))
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "event not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load event: %v", err)
+	}
+
+	title := strings.TrimSpace(req.GetTitle())
+	if title == "" {
+		title = src.GetTitle()
+	}
+	// Location falls back to the venue name when scanned; only an explicit
+	// one is copied.
+	location := src.GetLocation()
+	if src.GetVenueId() != "" {
+		if err := db.QueryRowContext(ctx, `SELECT COALESCE(location, '') FROM event WHERE id = $1`, src.GetId()).Scan(&location); err != nil {
+			return nil, status.Errorf(codes.Internal, "load event: %v", err)
+		}
+	}
+	details, err := s.CreateEvent(ctx, &proto.CreateEventRequest{
+		Title:                title,
+		StartAt:              req.GetStartAt(),
+		Location:             location,
+		NotifyDayBefore:      src.GetNotifyDayBefore(),
+		NotifyHourBefore:     src.GetNotifyHourBefore(),
+		MaxParticipants:      src.GetMaxParticipants(),
+		RequiredRoles:        src.GetRequiredRoles(),
+		VenueId:              src.GetVenueId(),
+		TimeSlotMinutes:      src.GetTimeSlotMinutes(),
+		NotifyOffsetsMinutes: src.GetNotifyOffsetsMinutes(),
+		Timezone:             src.GetTimezone(),
+		SeasonId:             src.GetSeasonId(),
+		TrackGapSeconds:      src.GetTrackGapSeconds(),
+		Coordinates:          src.GetCoordinates(),
+		Theme:                src.GetTheme(),
+		ThemeTag:             src.GetThemeTag(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !req.GetCopyTracklist() || src.GetTrackCount() == 0 {
+		return details, nil
+	}
+	return s.CopyTracklist(ctx, &proto.CopyTracklistRequest{
+		SourceEventId: src.GetId(),
+		TargetEventId: details.GetEvent().GetId(),
+	})
+}
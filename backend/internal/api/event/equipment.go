@@ -0,0 +1,200 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxEquipmentName     = 200
+	maxEquipmentNotes    = 500
+	maxEquipmentQuantity = 99
+)
+
+func (s *EventService) AddEquipmentItem(ctx context.Context, req *proto.AddEquipmentItemRequest) (*proto.EventDetails, error) {
+	userID, err := helpers.UserIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	name, quantity, notes, err := normalizeEquipment(req.GetName(), req.GetQuantity(), req.GetNotes())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := db.ExecContext(ctx, `
+		INSERT INTO event_equipment_item (event_id, name, quantity, notes, created_by)
+		SELECT id, $2, $3, $4, $5 FROM event WHERE id::text = $1 AND deleted_at IS NULL
+	`, req.GetEventId(), name, quantity, nullIfEmpty(notes), userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "insert equipment item: %v", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return nil, status.Error(codes.NotFound, "event not found")
+	}
+	helpers.PublishEventChanged(ctx, req.GetEventId())
+	return helpers.LoadEventDetails(ctx, db, req.GetEventId(), userID)
+}
+
+func (s *EventService) UpdateEquipmentItem(ctx context.Context, req *proto.UpdateEquipmentItemRequest) (*proto.EventDetails, error) {
+	userID, db, item, err := loadEquipmentItem(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if err := requireAuthorOrEditor(ctx, db, userID, item.createdBy, item.eventID); err != nil {
+		return nil, err
+	}
+	name, quantity, notes, err := normalizeEquipment(req.GetName(), req.GetQuantity(), req.GetNotes())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := db.ExecContext(ctx, `
+		UPDATE event_equipment_item SET name = $2, quantity = $3, notes = $4 WHERE id::text = $1
+	`, req.GetId(), name, quantity, nullIfEmpty(notes)); err != nil {
+		return nil, status.Errorf(codes.Internal, "update equipment item: %v", err)
+	}
+	helpers.PublishEventChanged(ctx, item.eventID)
+	return helpers.LoadEventDetails(ctx, db, item.eventID, userID)
+}
+
+func (s *EventService) RemoveEquipmentItem(ctx context.Context, req *proto.EquipmentItemId) (*proto.EventDetails, error) {
+	userID, db, item, err := loadEquipmentItem(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if err := requireAuthorOrEditor(ctx, db, userID, item.createdBy, item.eventID); err != nil {
+		return nil, err
+	}
+
+	if _, err := db.ExecContext(ctx, `DELETE FROM event_equipment_item WHERE id::text = $1`, req.GetId()); err != nil {
+		return nil, status.Errorf(codes.Internal, "delete equipment item: %v", err)
+	}
+	helpers.PublishEventChanged(ctx, item.eventID)
+	return helpers.LoadEventDetails(ctx, db, item.eventID, userID)
+}
+
+func (s *EventService) SetEquipmentBringer(ctx context.Context, req *proto.SetEquipmentBringerRequest) (*proto.EventDetails, error) {
+	userID, db, item, err := loadEquipmentItem(ctx, req.GetItemId())
+	if err != nil {
+		return nil, err
+	}
+	// Taking a free item or dropping one's own needs no rights.
+	self := req.GetUserId() == userID && item.bringer == "" ||
+		req.GetUserId() == "" && item.bringer == userID
+	if !self && req.GetUserId() != item.bringer {
+		if err := requireAuthorOrEditor(ctx, db, userID, "", item.eventID); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.GetUserId() != "" {
+		var exists bool
+		err := db.QueryRowContext(ctx, `SELECT TRUE FROM app_user WHERE id::text = $1`, req.GetUserId()).Scan(&exists)
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "load user: %v", err)
+		}
+	}
+
+	if _, err := db.ExecContext(ctx, `
+		UPDATE event_equipment_item
+		SET checked_at = CASE WHEN bringer_id IS NOT DISTINCT FROM NULLIF($2, '')::uuid THEN checked_at END,
+		    bringer_id = NULLIF($2, '')::uuid
+		WHERE id::text = $1
+	`, req.GetItemId(), req.GetUserId()); err != nil {
+		return nil, status.Errorf(codes.Internal, "set bringer: %v", err)
+	}
+	helpers.PublishEventChanged(ctx, item.eventID)
+	return helpers.LoadEventDetails(ctx, db, item.eventID, userID)
+}
+
+func (s *EventService) SetEquipmentChecked(ctx context.Context, req *proto.SetEquipmentCheckedRequest) (*proto.EventDetails, error) {
+	userID, db, item, err := loadEquipmentItem(ctx, req.GetItemId())
+	if err != nil {
+		return nil, err
+	}
+	if item.bringer != userID {
+		if err := requireAuthorOrEditor(ctx, db, userID, "", item.eventID); err != nil {
+			return nil, err
+		}
+	}
+
+	// Ticking an already ticked item keeps the original time.
+	if _, err := db.ExecContext(ctx, `
+		UPDATE event_equipment_item
+		SET checked_at = CASE WHEN $2 THEN COALESCE(checked_at, NOW()) END
+		WHERE id::text = $1
+	`, req.GetItemId(), req.GetChecked()); err != nil {
+		return nil, status.Errorf(codes.Internal, "check equipment item: %v", err)
+	}
+	helpers.PublishEventChanged(ctx, item.eventID)
+	return helpers.LoadEventDetails(ctx, db, item.eventID, userID)
+}
+
+type equipmentRef struct {
+	eventID   string
+	createdBy string
+	bringer   string
+}
+
+func loadEquipmentItem(ctx context.Context, itemID string) (string, *sql.DB, equipmentRef, error) {
+	var item equipmentRef
+	userID, err := helpers.UserIDFromCtx(ctx)
+	if err != nil {
+		return "", nil, item, err
+	}
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return "", nil, item, err
+	}
+	err = db.QueryRowContext(ctx, `
+		SELECT event_id, COALESCE(created_by::text, ''), COALESCE(bringer_id::text, '')
+		FROM event_equipment_item WHERE id::text = $1
+	`, itemID).Scan(&item.eventID, &item.createdBy, &item.bringer)
+	if err == sql.ErrNoRows {
+		return "", nil, item, status.Error(codes.NotFound, "equipment item not found")
+	}
+	if err != nil {
+		return "", nil, item, status.Errorf(codes.Internal, "load equipment item: %v", err)
+	}
+	return userID, db, item, nil
+}
+
+// requireAuthorOrEditor lets the author of an item through, everyone else
+// needs edit_events or to own the event.
+func requireAuthorOrEditor(ctx context.Context, db *sql.DB, userID, authorID, eventID string) error {
+	if authorID != "" && authorID == userID {
+		return nil
+	}
+	return requireEventAccess(ctx, db, userID, eventID, false)
+}
+
+func normalizeEquipment(name string, quantity uint32, notes string) (string, uint32, string, error) {
+	name, notes = strings.TrimSpace(name), strings.TrimSpace(notes)
+	if utf8.RuneCountInString(name) > maxEquipmentName {
+		return "", 0, "", status.Errorf(codes.InvalidArgument, "name must be at most %d characters", maxEquipmentName)
+	}
+	if utf8.RuneCountInString(notes) > maxEquipmentNotes {
+		return "", 0, "", status.Errorf(codes.InvalidArgument, "notes must be at most %d characters", maxEquipmentNotes)
+	}
+	if quantity == 0 {
+		quantity = 1
+	}
+	if quantity > maxEquipmentQuantity {
+		return "", 0, "", status.Errorf(codes.InvalidArgument, "quantity must be at most %d", maxEquipmentQuantity)
+	}
+	return name, quantity, notes, nil
+}
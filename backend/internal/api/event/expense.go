@@ -0,0 +1,320 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	maxExpenseDescription = 200
+	// maxExpenseCents keeps sums far away from overflowing.
+	maxExpenseCents = 10_000_000_000
+)
+
+func (s *EventService) AddExpense(ctx context.Context, req *proto.AddExpenseRequest) (*proto.ExpenseSummary, error) {
+	userID, err := helpers.UserIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	description := strings.TrimSpace(req.GetDescription())
+	if utf8.RuneCountInString(description) > maxExpenseDescription {
+		return nil, status.Errorf(codes.InvalidArgument, "description must be at most %d characters", maxExpenseDescription)
+	}
+	if req.GetAmountCents() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
+	}
+	if req.GetAmountCents() > maxExpenseCents {
+		return nil, status.Error(codes.InvalidArgument, "amount is too large")
+	}
+	payerID := req.GetPayerId()
+	if payerID == "" {
+		payerID = userID
+	}
+	if payerID != userID {
+		if err := requireAuthorOrEditor(ctx, db, userID, "", req.GetEventId()); err != nil {
+			return nil, err
+		}
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+
+	var exists bool
+	err = tx.QueryRowContext(ctx, `SELECT TRUE FROM event WHERE id::text = $1 AND deleted_at IS NULL`, req.GetEventId()).Scan(&exists)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "event not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load event: %v", err)
+	}
+
+	split := req.GetSplitUserIds()
+	if len(split) == 0 {
+		rows, err := tx.QueryContext(ctx, `
+			SELECT DISTINCT user_id FROM event_participant WHERE event_id = $1
+		`, req.GetEventId())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "load participants: %v", err)
+		}
+		for rows.Next() {
+			var id string
+			if err := rows.Scan(&id); err != nil {
+				rows.Close()
+				return nil, status.Errorf(codes.Internal, "scan participant: %v", err)
+			}
+			split = append(split, id)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, status.Errorf(codes.Internal, "iterate participants: %v", err)
+		}
+		split = append(split, payerID)
+	}
+	split = slices.Compact(slices.Sorted(slices.Values(split)))
+
+	involved := slices.Compact(slices.Sorted(slices.Values(append([]string{payerID}, split...))))
+	var found int
+	if err := tx.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM app_user WHERE id::text = ANY($1)
+	`, pq.Array(involved)).Scan(&found); err != nil {
+		return nil, status.Errorf(codes.Internal, "check users: %v", err)
+	}
+	if found != len(involved) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	var expenseID string
+	if err := tx.QueryRowContext(ctx, `
+		INSERT INTO event_expense (event_id, payer_id, description, amount_cents, created_by)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id
+	`, req.GetEventId(), payerID, description, req.GetAmountCents(), userID).Scan(&expenseID); err != nil {
+		return nil, status.Errorf(codes.Internal, "insert expense: %v", err)
+	}
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO event_expense_share (expense_id, user_id)
+		SELECT $1, unnest($2::uuid[])
+	`, expenseID, pq.Array(split)); err != nil {
+		return nil, status.Errorf(codes.Internal, "insert expense shares: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit: %v", err)
+	}
+	return loadExpenseSummary(ctx, db, req.GetEventId())
+}
+
+func (s *EventService) RemoveExpense(ctx context.Context, req *proto.ExpenseId) (*proto.ExpenseSummary, error) {
+	userID, err := helpers.UserIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var eventID, authorID string
+	err = db.QueryRowContext(ctx, `
+		SELECT event_id, COALESCE(created_by::text, '') FROM event_expense WHERE id::text = $1
+	`, req.GetId()).Scan(&eventID, &authorID)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "expense not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load expense: %v", err)
+	}
+	if err := requireAuthorOrEditor(ctx, db, userID, authorID, eventID); err != nil {
+		return nil, err
+	}
+
+	if _, err := db.ExecContext(ctx, `DELETE FROM event_expense WHERE id::text = $1`, req.GetId()); err != nil {
+		return nil, status.Errorf(codes.Internal, "delete expense: %v", err)
+	}
+	return loadExpenseSummary(ctx, db, eventID)
+}
+
+func (s *EventService) GetExpenseSummary(ctx context.Context, req *proto.EventId) (*proto.ExpenseSummary, error) {
+	if _, err := helpers.UserIDFromCtx(ctx); err != nil {
+		return nil, err
+	}
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var exists bool
+	err = db.QueryRowContext(ctx, `SELECT TRUE FROM event WHERE id::text = $1`, req.GetId()).Scan(&exists)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "event not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load event: %v", err)
+	}
+	return loadExpenseSummary(ctx, db, req.GetId())
+}
+
+func loadExpenseSummary(ctx context.Context, db *sql.DB, eventID string) (*proto.ExpenseSummary, error) {
+	summary := &proto.ExpenseSummary{EventId: eventID}
+	users := map[string]*proto.User{}
+	user := func(u *proto.User) *proto.User {
+		if known, ok := users[u.Id]; ok {
+			return known
+		}
+		users[u.Id] = u
+		return u
+	}
+
+	rows, err := db.QueryContext(ctx, `
+		SELECT x.id, x.description, x.amount_cents, COALESCE(x.created_by::text, ''), x.created_at,
+		       au.id, au.display_name, COALESCE(au.username, ''), COALESCE(au.avatar_url, '')
+		FROM event_expense x
+		JOIN app_user au ON au.id = x.payer_id
+		WHERE x.event_id = $1
+		ORDER BY x.created_at, x.id
+	`, eventID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load expenses: %v", err)
+	}
+	defer rows.Close()
+	byID := map[string]*proto.Expense{}
+	for rows.Next() {
+		var x proto.Expense
+		var created time.Time
+		var u proto.User
+		if err := rows.Scan(&x.Id, &x.Description, &x.AmountCents, &x.CreatedById, &created,
+			&u.Id, &u.DisplayName, &u.Username, &u.AvatarUrl); err != nil {
+			return nil, status.Errorf(codes.Internal, "scan expense: %v", err)
+		}
+		x.Payer = user(&u)
+		x.CreatedAt = timestamppb.New(created)
+		summary.Expenses = append(summary.Expenses, &x)
+		summary.TotalCents += x.AmountCents
+		byID[x.Id] = &x
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "iterate expenses: %v", err)
+	}
+
+	shareRows, err := db.QueryContext(ctx, `
+		SELECT s.expense_id, au.id, au.display_name, COALESCE(au.username, ''), COALESCE(au.avatar_url, '')
+		FROM event_expense_share s
+		JOIN event_expense x ON x.id = s.expense_id
+		JOIN app_user au ON au.id = s.user_id
+		WHERE x.event_id = $1
+		ORDER BY s.expense_id, au.id
+	`, eventID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load expense shares: %v", err)
+	}
+	defer shareRows.Close()
+	for shareRows.Next() {
+		var expenseID string
+		var u proto.User
+		if err := shareRows.Scan(&expenseID, &u.Id, &u.DisplayName, &u.Username, &u.AvatarUrl); err != nil {
+			return nil, status.Errorf(codes.Internal, "scan expense share: %v", err)
+		}
+		if x, ok := byID[expenseID]; ok {
+			x.SplitBetween = append(x.SplitBetween, user(&u))
+		}
+	}
+	if err := shareRows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "iterate expense shares: %v", err)
+	}
+
+	summary.Balances = expenseBalances(summary.Expenses)
+	summary.Settlements = settleBalances(summary.Balances)
+	return summary, nil
+}
+
+// expenseBalances splits every expense equally between its sharers; the
+// cents that don't divide go to the first sharers by id.
+func expenseBalances(expenses []*proto.Expense) []*proto.ExpenseBalance {
+	byUser := map[string]*proto.ExpenseBalance{}
+	balance := func(u *proto.User) *proto.ExpenseBalance {
+		b, ok := byUser[u.GetId()]
+		if !ok {
+			b = &proto.ExpenseBalance{User: u}
+			byUser[u.GetId()] = b
+		}
+		return b
+	}
+	for _, x := range expenses {
+		balance(x.GetPayer()).PaidCents += x.GetAmountCents()
+		n := int64(len(x.GetSplitBetween()))
+		if n == 0 {
+			continue
+		}
+		for i, u := range x.GetSplitBetween() {
+			share := x.GetAmountCents() / n
+			if int64(i) < x.GetAmountCents()%n {
+				share++
+			}
+			balance(u).ShareCents += share
+		}
+	}
+
+	out := make([]*proto.ExpenseBalance, 0, len(byUser))
+	for _, b := range byUser {
+		b.BalanceCents = b.PaidCents - b.ShareCents
+		out = append(out, b)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].BalanceCents != out[j].BalanceCents {
+			return out[i].BalanceCents > out[j].BalanceCents
+		}
+		return out[i].GetUser().GetDisplayName() < out[j].GetUser().GetDisplayName()
+	})
+	return out
+}
+
+// settleBalances pairs the largest debtor with the largest creditor until
+// everything is paid back; balances must be sorted largest first.
+func settleBalances(balances []*proto.ExpenseBalance) []*proto.Settlement {
+	type party struct {
+		user   *proto.User
+		amount int64
+	}
+	var creditors, debtors []*party
+	for _, b := range balances {
+		switch {
+		case b.BalanceCents > 0:
+			creditors = append(creditors, &party{b.GetUser(), b.BalanceCents})
+		case b.BalanceCents < 0:
+			debtors = append(debtors, &party{b.GetUser(), -b.BalanceCents})
+		}
+	}
+	slices.Reverse(debtors)
+
+	var out []*proto.Settlement
+	for i, j := 0, 0; i < len(debtors) && j < len(creditors); {
+		amount := min(debtors[i].amount, creditors[j].amount)
+		out = append(out, &proto.Settlement{From: debtors[i].user, To: creditors[j].user, AmountCents: amount})
+		debtors[i].amount -= amount
+		creditors[j].amount -= amount
+		if debtors[i].amount == 0 {
+			i++
+		}
+		if creditors[j].amount == 0 {
+			j++
+		}
+	}
+	return out
+}
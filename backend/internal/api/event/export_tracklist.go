@@ -0,0 +1,161 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/internal/setlist"
+	"musicclubbot/backend/proto"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *EventService) ExportTracklist(ctx context.Context, req *proto.ExportTracklistRequest) (*proto.ExportedTracklist, error) {
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	currentUserID, _ := helpers.UserIDFromCtx(ctx)
+	details, err := helpers.LoadEventDetails(ctx, db, req.GetEventId(), currentUserID)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "event not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load event: %v", err)
+	}
+
+	sheet, err := loadSetlistSheet(ctx, db, details)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load setlist: %v", err)
+	}
+
+	var start time.Time
+	if ts := details.GetEvent().GetStartAt(); ts != nil {
+		start = ts.AsTime()
+	}
+	switch req.GetFormat() {
+	case proto.TracklistExportFormat_TRACKLIST_EXPORT_FORMAT_PDF:
+		content, err := setlist.PDF(sheet)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "render pdf: %v", err)
+		}
+		return &proto.ExportedTracklist{
+			Filename:    helpers.EventFilename(details.GetEvent().GetTitle(), start, ".pdf"),
+			ContentType: "application/pdf",
+			Content:     content,
+		}, nil
+	case proto.TracklistExportFormat_TRACKLIST_EXPORT_FORMAT_TEXT:
+		return &proto.ExportedTracklist{
+			Filename:    helpers.EventFilename(details.GetEvent().GetTitle(), start, ".txt"),
+			ContentType: "text/plain; charset=utf-8",
+			Content:     []byte(setlist.Text(sheet)),
+		}, nil
+	default:
+		return nil, status.Error(codes.InvalidArgument, "unknown export format")
+	}
+}
+
+// loadSetlistSheet combines the event's tracklist with song titles, keys and
+// lineups.
+func loadSetlistSheet(ctx context.Context, db *sql.DB, details *proto.EventDetails) (setlist.Sheet, error) {
+	e := details.GetEvent()
+	sheet := setlist.Sheet{Title: e.GetTitle()}
+	var sub []string
+	if ts := e.GetStartAt(); ts != nil {
+		sub = append(sub, ts.AsTime().In(helpers.Location(e.GetTimezone())).Format("02.01.2006 15:04"))
+	}
+	if e.GetLocation() != "" {
+		sub = append(sub, e.GetLocation())
+	}
+	sheet.Subtitle = strings.Join(sub, " · ")
+
+	tracklist := details.GetTracklist()
+	var songIDs []string
+	for _, item := range tracklist.GetItems() {
+		if item.GetSongId() != "" {
+			songIDs = append(songIDs, item.GetSongId())
+		}
+	}
+	songs := map[string]setlist.Track{}
+	rows, err := db.QueryContext(ctx, `
+		SELECT id, title, artist, COALESCE(musical_key, '') FROM song WHERE id = ANY($1)
+	`, pq.Array(songIDs))
+	if err != nil {
+		return sheet, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id string
+		var t setlist.Track
+		if err := rows.Scan(&id, &t.Title, &t.Artist, &t.Key); err != nil {
+			return sheet, err
+		}
+		songs[id] = t
+	}
+	if err := rows.Err(); err != nil {
+		return sheet, err
+	}
+	lineups := lineupLines(details.GetLineups())
+
+	track := func(item *proto.TrackItem) setlist.Track {
+		t, ok := songs[item.GetSongId()]
+		if !ok {
+			t = setlist.Track{Title: item.GetCustomTitle(), Artist: item.GetCustomArtist()}
+		}
+		t.Duration = item.GetEffectiveDurationSeconds()
+		t.Notes = item.GetNotes()
+		if ts := item.GetScheduledStartAt(); ts != nil {
+			t.StartsAt = ts.AsTime().In(helpers.Location(e.GetTimezone())).Format("15:04")
+		}
+		if item.GetKey() != "" {
+			t.Key = item.GetKey()
+		}
+		t.Lineup = lineups[item.GetId()]
+		return t
+	}
+	if len(tracklist.GetSets()) == 0 {
+		section := setlist.Section{}
+		for _, item := range tracklist.GetItems() {
+			section.Tracks = append(section.Tracks, track(item))
+		}
+		sheet.Sections = append(sheet.Sections, section)
+		return sheet, nil
+	}
+	named := 0
+	for _, set := range tracklist.GetSets() {
+		section := setlist.Section{Name: set.GetName()}
+		if set.GetId() != "" {
+			named++
+			if section.Name == "" {
+				section.Name = fmt.Sprintf("Сет %d", named)
+			}
+		}
+		for _, item := range set.GetItems() {
+			section.Tracks = append(section.Tracks, track(item))
+		}
+		sheet.Sections = append(sheet.Sections, section)
+	}
+	return sheet, nil
+}
+
+// lineupLines returns "Role: Name, Name" lines per track item.
+func lineupLines(lineups []*proto.TrackLineup) map[string][]string {
+	out := map[string][]string{}
+	for _, lineup := range lineups {
+		var lines []string
+		for _, a := range lineup.GetAssignments() {
+			if n := len(lines); n > 0 && strings.HasPrefix(lines[n-1], a.GetRole()+": ") {
+				lines[n-1] += ", " + a.GetUser().GetDisplayName()
+				continue
+			}
+			lines = append(lines, a.GetRole()+": "+a.GetUser().GetDisplayName())
+		}
+		out[lineup.GetTrackItemId()] = lines
+	}
+	return out
+}
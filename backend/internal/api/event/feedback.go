@@ -0,0 +1,149 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	feedbackDefaultWindow = 7 * 24 * time.Hour
+	maxFeedbackComment    = 2000
+)
+
+func (s *EventService) OpenFeedbackSurvey(ctx context.Context, req *proto.OpenFeedbackSurveyRequest) (*proto.EventDetails, error) {
+	userID, db, err := requireEventManager(ctx, req.GetEventId())
+	if err != nil {
+		return nil, err
+	}
+	closesAt := time.Now().Add(feedbackDefaultWindow)
+	if ts := req.GetClosesAt(); ts != nil {
+		closesAt = ts.AsTime()
+	}
+	if !closesAt.After(time.Now()) {
+		return nil, status.Error(codes.InvalidArgument, "closes_at must be in the future")
+	}
+
+	var completed sql.NullTime
+	err = db.QueryRowContext(ctx, `SELECT completed_at FROM event WHERE id = $1 AND deleted_at IS NULL`, req.GetEventId()).Scan(&completed)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "event not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load event: %v", err)
+	}
+	if !completed.Valid {
+		return nil, status.Error(codes.FailedPrecondition, "feedback can only be collected for completed events")
+	}
+
+	// Reopening only moves the deadline; invites aren't sent twice.
+	if _, err := db.ExecContext(ctx, `
+		INSERT INTO event_feedback_survey (event_id, opened_by, closes_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (event_id) DO UPDATE SET closes_at = EXCLUDED.closes_at
+	`, req.GetEventId(), userID, closesAt); err != nil {
+		return nil, status.Errorf(codes.Internal, "open survey: %v", err)
+	}
+	return helpers.LoadEventDetails(ctx, db, req.GetEventId(), userID)
+}
+
+func (s *EventService) SubmitFeedback(ctx context.Context, req *proto.SubmitFeedbackRequest) (*proto.EventDetails, error) {
+	userID, err := helpers.UserIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetRating() < 1 || req.GetRating() > 5 {
+		return nil, status.Error(codes.InvalidArgument, "rating must be between 1 and 5")
+	}
+	comment := strings.TrimSpace(req.GetComment())
+	if utf8.RuneCountInString(comment) > maxFeedbackComment {
+		return nil, status.Errorf(codes.InvalidArgument, "comment must be at most %d characters", maxFeedbackComment)
+	}
+
+	var closesAt time.Time
+	err = db.QueryRowContext(ctx, `SELECT closes_at FROM event_feedback_survey WHERE event_id = $1`, req.GetEventId()).Scan(&closesAt)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "feedback survey not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load survey: %v", err)
+	}
+	if !time.Now().Before(closesAt) {
+		return nil, status.Error(codes.FailedPrecondition, "feedback survey is closed")
+	}
+
+	if _, err := db.ExecContext(ctx, `
+		INSERT INTO event_feedback (event_id, user_id, rating, comment)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (event_id, user_id) DO UPDATE
+		SET rating = EXCLUDED.rating, comment = EXCLUDED.comment, submitted_at = NOW()
+	`, req.GetEventId(), userID, req.GetRating(), nullIfEmpty(comment)); err != nil {
+		return nil, status.Errorf(codes.Internal, "save feedback: %v", err)
+	}
+	return helpers.LoadEventDetails(ctx, db, req.GetEventId(), userID)
+}
+
+func (s *EventService) GetFeedbackResults(ctx context.Context, req *proto.EventId) (*proto.FeedbackResults, error) {
+	userID, db, err := requireEventManager(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	survey, err := helpers.LoadFeedbackSurvey(ctx, db, req.GetId(), userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load survey: %v", err)
+	}
+	if survey == nil {
+		return nil, status.Error(codes.NotFound, "feedback survey not found")
+	}
+
+	rows, err := db.QueryContext(ctx, `
+		SELECT au.id, au.display_name, COALESCE(au.username, ''), COALESCE(au.avatar_url, ''),
+		       f.rating, COALESCE(f.comment, ''), f.submitted_at
+		FROM event_feedback f
+		JOIN app_user au ON au.id = f.user_id
+		WHERE f.event_id = $1
+		ORDER BY f.submitted_at DESC
+	`, req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load feedback: %v", err)
+	}
+	defer rows.Close()
+	results := &proto.FeedbackResults{
+		EventId:      req.GetId(),
+		Survey:       survey,
+		RatingCounts: make([]uint32, 5),
+	}
+	var sum uint32
+	for rows.Next() {
+		var u proto.User
+		var submitted time.Time
+		answer := &proto.FeedbackAnswer{User: &u}
+		if err := rows.Scan(&u.Id, &u.DisplayName, &u.Username, &u.AvatarUrl, &answer.Rating, &answer.Comment, &submitted); err != nil {
+			return nil, status.Errorf(codes.Internal, "scan feedback: %v", err)
+		}
+		answer.SubmittedAt = timestamppb.New(submitted)
+		results.Answers = append(results.Answers, answer)
+		results.RatingCounts[answer.Rating-1]++
+		sum += answer.Rating
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "iterate feedback: %v", err)
+	}
+	results.Responses = uint32(len(results.Answers))
+	if results.Responses > 0 {
+		results.AverageRating = float64(sum) / float64(results.Responses)
+	}
+	return results, nil
+}
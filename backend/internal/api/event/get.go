@@ -11,7 +11,7 @@ import (
 )
 
 func (s *EventService) GetEvent(ctx context.Context, req *proto.EventId) (*proto.EventDetails, error) {
-	db, err := helpers.DbFromCtx(ctx)
+	db, err := helpers.ReadDbFromCtx(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -23,5 +23,9 @@ func (s *EventService) GetEvent(ctx context.Context, req *proto.EventId) (*proto
 		}
 		return nil, status.Errorf(codes.Internal, "get event: %v", err)
 	}
+	// Archived events stay visible to editors only, so they can restore them.
+	if details.GetEvent().GetDeletedAt() != nil && !helpers.PermissionAllowsEventEdit(details.GetPermissions()) {
+		return nil, status.Error(codes.NotFound, "event not found")
+	}
 	return details, nil
 }
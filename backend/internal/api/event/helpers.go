@@ -1,6 +1,30 @@
 package event
 
-import "database/sql"
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"musicclubbot/backend/internal/config"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// eventMaskFields lists UpdateEventRequest fields that may appear in an update mask.
+var eventMaskFields = []string{
+	"title", "start_at", "location", "notify_day_before", "notify_hour_before", "max_participants", "required_roles",
+	"venue_id", "time_slot_minutes", "notify_offsets_minutes", "timezone", "season_id", "track_gap_seconds",
+	"coordinates", "map_url", "theme", "theme_tag",
+}
+
+// eventFullUpdateFields are replaced when the mask is empty: the fields the
+// request had before the rest were added.
+var eventFullUpdateFields = []string{"title", "start_at", "location", "notify_day_before", "notify_hour_before"}
 
 func nullIfEmpty(s string) interface{} {
 	if s == "" {
@@ -8,3 +32,127 @@ func nullIfEmpty(s string) interface{} {
 	}
 	return s
 }
+
+// normalizeRoles canonicalizes role names and drops blanks and duplicates.
+func normalizeRoles(ctx context.Context, q helpers.QueryRower, roles []string) ([]string, error) {
+	out := []string{}
+	seen := map[string]bool{}
+	for _, r := range roles {
+		role, err := helpers.NormalizeRole(ctx, q, r)
+		if err != nil {
+			return nil, err
+		}
+		if role == "" || seen[role] {
+			continue
+		}
+		seen[role] = true
+		out = append(out, role)
+	}
+	return out, nil
+}
+
+// checkVenue reports InvalidArgument for a venue id that does not exist;
+// an empty id means the event has no venue.
+func checkVenue(ctx context.Context, q helpers.QueryRower, venueID string) error {
+	if venueID == "" {
+		return nil
+	}
+	var exists bool
+	if err := q.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM venue WHERE id::text = $1)`, venueID).Scan(&exists); err != nil {
+		return status.Errorf(codes.Internal, "check venue: %v", err)
+	}
+	if !exists {
+		return status.Error(codes.InvalidArgument, "venue not found")
+	}
+	return nil
+}
+
+func checkSeason(ctx context.Context, q helpers.QueryRower, seasonID string) error {
+	if seasonID == "" {
+		return nil
+	}
+	var exists bool
+	if err := q.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM season WHERE id::text = $1)`, seasonID).Scan(&exists); err != nil {
+		return status.Errorf(codes.Internal, "check season: %v", err)
+	}
+	if !exists {
+		return status.Error(codes.InvalidArgument, "season not found")
+	}
+	return nil
+}
+
+// maxTrackGapSeconds keeps the pause between songs to something sensible.
+const maxTrackGapSeconds = 3600
+
+func checkTrackGap(seconds int32) error {
+	if seconds < 0 || seconds > maxTrackGapSeconds {
+		return status.Errorf(codes.InvalidArgument, "track_gap_seconds must be between 0 and %d", maxTrackGapSeconds)
+	}
+	return nil
+}
+
+// resolveTimezone validates an IANA timezone name, defaulting to the club's.
+func resolveTimezone(ctx context.Context, name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		cfg, _ := ctx.Value("cfg").(config.Config)
+		name = cfg.DefaultTimezone
+	}
+	// "Local" and "" would depend on the server's own zone.
+	if name == "" || name == "Local" {
+		return "", status.Error(codes.InvalidArgument, "timezone is required")
+	}
+	if _, err := time.LoadLocation(name); err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "unknown timezone %q", name)
+	}
+	return name, nil
+}
+
+// eventCoordinates locates the event itself. Events at a venue are found by
+// the venue's coordinates, so their location text isn't geocoded.
+func eventCoordinates(ctx context.Context, point *proto.GeoPoint, mapURL, location, venueID string) (*proto.GeoPoint, error) {
+	if venueID != "" {
+		location = ""
+	}
+	return helpers.ResolveCoordinates(ctx, point, mapURL, location)
+}
+
+// trackItemMaskFields lists TrackItem fields that may appear in an
+// UpdateTrackItem mask.
+var trackItemMaskFields = []string{"song_id", "custom_title", "custom_artist", "duration_seconds", "performed", "notes", "key", "planned_start_at"}
+
+// trackItemFullUpdateFields are replaced when the mask is empty; performed is
+// only changed when named, so editing an item doesn't unmark it.
+var trackItemFullUpdateFields = []string{"song_id", "custom_title", "custom_artist", "duration_seconds", "notes", "key", "planned_start_at"}
+
+// updateFields returns the set of fields named by an update mask, which may
+// only name allowed ones. An empty mask selects full to keep full-replace
+// clients working.
+func updateFields(mask *fieldmaskpb.FieldMask, allowed, full []string) (map[string]bool, error) {
+	fields := map[string]bool{}
+	if len(mask.GetPaths()) == 0 {
+		for _, f := range full {
+			fields[f] = true
+		}
+		return fields, nil
+	}
+	for _, path := range mask.GetPaths() {
+		known := false
+		for _, f := range allowed {
+			if path == f {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return nil, fmt.Errorf("unsupported update_mask path %q", path)
+		}
+		fields[path] = true
+	}
+	return fields, nil
+}
+
+// normalizeTheme trims the theme and lowercases its tag like song tags.
+func normalizeTheme(theme, tag string) (string, string) {
+	return strings.TrimSpace(theme), strings.ToLower(strings.TrimSpace(tag))
+}
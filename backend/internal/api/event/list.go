@@ -2,7 +2,6 @@ package event
 
 import (
 	"context"
-	"database/sql"
 	"musicclubbot/backend/internal/helpers"
 	"musicclubbot/backend/proto"
 	"strconv"
@@ -11,7 +10,6 @@ import (
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (s *EventService) ListEvents(ctx context.Context, req *proto.ListEventsRequest) (*proto.ListEventsResponse, error) {
@@ -20,33 +18,75 @@ func (s *EventService) ListEvents(ctx context.Context, req *proto.ListEventsRequ
 		return nil, err
 	}
 
+	limit := int(req.GetPageSize())
+	if limit == 0 {
+		limit = int(req.GetLimit())
+	}
+	if limit == 0 {
+		limit = 50
+	}
+	offset := 0
+	if tok := req.GetPageToken(); tok != "" {
+		if v, err := strconv.Atoi(tok); err == nil && v >= 0 {
+			offset = v
+		}
+	}
+
 	args := []any{}
-	clauses := []string{}
+	clauses := []string{"e.deleted_at IS NULL"}
+	// Only the archive needs edit_events, so the method registry can't
+	// cover it.
+	if req.GetArchived() {
+		userID, err := helpers.UserIDFromCtx(ctx)
+		if err != nil {
+			return nil, err
+		}
+		perms, err := helpers.LoadPermissions(ctx, db, userID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "load permissions: %v", err)
+		}
+		if !helpers.PermissionAllowsEventEdit(perms) {
+			return nil, status.Error(codes.PermissionDenied, "no rights to manage events")
+		}
+		clauses[0] = "e.deleted_at IS NOT NULL"
+	}
 	if req.GetFrom() != nil {
-		clauses = append(clauses, "start_at >= $"+strconv.Itoa(len(args)+1))
+		clauses = append(clauses, "e.start_at >= $"+strconv.Itoa(len(args)+1))
 		args = append(args, time.Unix(req.GetFrom().Seconds, int64(req.GetFrom().Nanos)))
 	}
 	if req.GetTo() != nil {
-		clauses = append(clauses, "start_at <= $"+strconv.Itoa(len(args)+1))
+		clauses = append(clauses, "e.start_at <= $"+strconv.Itoa(len(args)+1))
 		args = append(args, time.Unix(req.GetTo().Seconds, int64(req.GetTo().Nanos)))
 	}
+	if req.GetVenueId() != "" {
+		clauses = append(clauses, "e.venue_id::text = $"+strconv.Itoa(len(args)+1))
+		args = append(args, req.GetVenueId())
+	}
+	if req.GetSeasonId() != "" {
+		clauses = append(clauses, "e.season_id::text = $"+strconv.Itoa(len(args)+1))
+		args = append(args, req.GetSeasonId())
+	}
+	order := "e.start_at NULLS LAST"
+	switch req.GetTimeFilter() {
+	case proto.EventTimeFilter_EVENT_TIME_FILTER_UPCOMING:
+		clauses = append(clauses, "e.start_at >= NOW()")
+	case proto.EventTimeFilter_EVENT_TIME_FILTER_PAST:
+		clauses = append(clauses, "e.start_at < NOW()")
+		order = "e.start_at DESC"
+	}
 	where := ""
 	if len(clauses) > 0 {
 		where = "WHERE " + strings.Join(clauses, " AND ")
 	}
-
-	limit := req.GetLimit()
-	if limit == 0 || limit > 200 {
-		limit = 50
-	}
-	args = append(args, limit)
+	args = append(args, limit, offset)
 
 	rows, err := db.QueryContext(ctx, `
-		SELECT id, title, start_at, location, notify_day_before, notify_hour_before
-		FROM event
+		SELECT `+helpers.EventColumns+`
+		FROM `+helpers.EventFrom+`
 	`+where+`
-		ORDER BY start_at NULLS LAST
-		LIMIT $`+strconv.Itoa(len(args)), args...)
+		ORDER BY `+order+`, e.id
+		LIMIT $`+strconv.Itoa(len(args)-1)+`
+		OFFSET $`+strconv.Itoa(len(args)), args...)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "list events: %v", err)
 	}
@@ -54,19 +94,23 @@ func (s *EventService) ListEvents(ctx context.Context, req *proto.ListEventsRequ
 
 	var events []*proto.Event
 	for rows.Next() {
-		var ev proto.Event
-		var start sql.NullTime
-		if err := rows.Scan(&ev.Id, &ev.Title, &start, &ev.Location, &ev.NotifyDayBefore, &ev.NotifyHourBefore); err != nil {
+		ev, err := helpers.ScanEvent(rows)
+		if err != nil {
 			return nil, status.Errorf(codes.Internal, "scan event: %v", err)
 		}
-		if start.Valid {
-			ev.StartAt = timestamppb.New(start.Time)
-		}
-		events = append(events, &ev)
+		events = append(events, ev)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, status.Errorf(codes.Internal, "iterate events: %v", err)
 	}
 
-	return &proto.ListEventsResponse{Events: events}, nil
+	nextToken := ""
+	if len(events) == limit {
+		nextToken = strconv.Itoa(offset + limit)
+	}
+
+	return &proto.ListEventsResponse{
+		Events:        events,
+		NextPageToken: nextToken,
+	}, nil
 }
@@ -0,0 +1,86 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *EventService) SetCurrentTrack(ctx context.Context, req *proto.SetCurrentTrackRequest) (*proto.EventDetails, error) {
+	userID, err := helpers.UserIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireEventAccess(ctx, db, userID, req.GetEventId(), true); err != nil {
+		return nil, err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+
+	var current string
+	var cancelled sql.NullTime
+	err = tx.QueryRowContext(ctx, `
+		SELECT COALESCE(current_track_item_id::text, ''), cancelled_at FROM event WHERE id::text = $1 AND deleted_at IS NULL FOR UPDATE
+	`, req.GetEventId()).Scan(&current, &cancelled)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "event not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load event: %v", err)
+	}
+	if cancelled.Valid {
+		return nil, status.Error(codes.FailedPrecondition, "event is cancelled")
+	}
+
+	order, err := loadTrackOrder(ctx, tx, req.GetEventId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load tracklist: %v", err)
+	}
+	var ids []string
+	for _, setID := range order.sets {
+		ids = append(ids, order.items[setID]...)
+	}
+	itemID := req.GetItemId()
+	if req.GetNext() {
+		// Index of the current item plus one: 0 when nothing is playing.
+		next := slices.Index(ids, current) + 1
+		itemID = ""
+		if next < len(ids) {
+			itemID = ids[next]
+		}
+	} else if itemID != "" && !slices.Contains(ids, itemID) {
+		return nil, status.Error(codes.NotFound, "track item not found")
+	}
+
+	// Re-selecting the playing item keeps its start time.
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE event
+		SET current_track_started_at = CASE
+		        WHEN $2 = '' THEN NULL
+		        WHEN current_track_item_id::text IS DISTINCT FROM $2 THEN NOW()
+		        ELSE current_track_started_at END,
+		    current_track_item_id = NULLIF($2, '')::uuid
+		WHERE id::text = $1
+	`, req.GetEventId(), itemID); err != nil {
+		return nil, status.Errorf(codes.Internal, "update current track: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit: %v", err)
+	}
+	helpers.PublishEventChanged(ctx, req.GetEventId())
+	return helpers.LoadEventDetails(ctx, db, req.GetEventId(), userID)
+}
@@ -0,0 +1,96 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	dayBeforeMinutes  = 24 * 60
+	hourBeforeMinutes = 60
+	// maxNotifyOffsetMinutes keeps reminders within a month of the event.
+	maxNotifyOffsetMinutes = 30 * 24 * 60
+)
+
+// notifyOffsets validates reminder offsets and returns them deduplicated,
+// largest first. Without offsets the legacy day/hour flags are used.
+func notifyOffsets(offsets []int32, dayBefore, hourBefore bool) ([]int32, error) {
+	if len(offsets) == 0 {
+		offsets = []int32{}
+		if dayBefore {
+			offsets = append(offsets, dayBeforeMinutes)
+		}
+		if hourBefore {
+			offsets = append(offsets, hourBeforeMinutes)
+		}
+	}
+	seen := map[int32]bool{}
+	out := []int32{}
+	for _, o := range offsets {
+		if o <= 0 || o > maxNotifyOffsetMinutes {
+			return nil, status.Errorf(codes.InvalidArgument, "notification offset must be between 1 and %d minutes", maxNotifyOffsetMinutes)
+		}
+		if !seen[o] {
+			seen[o] = true
+			out = append(out, o)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] > out[j] })
+	return out, nil
+}
+
+func hasOffset(offsets []int32, minutes int32) bool {
+	for _, o := range offsets {
+		if o == minutes {
+			return true
+		}
+	}
+	return false
+}
+
+// withOffset adds minutes to offsets or removes it from them.
+func withOffset(offsets []int32, minutes int32, on bool) []int32 {
+	out := []int32{}
+	for _, o := range offsets {
+		if o != minutes {
+			out = append(out, o)
+		}
+	}
+	if on {
+		out = append(out, minutes)
+	}
+	return out
+}
+
+// setEventNotifications makes the reminders of the events match offsets;
+// nil offsets keep the reminders they have. resetSent re-arms reminders
+// already sent, e.g. after the start moved.
+func setEventNotifications(ctx context.Context, tx *sql.Tx, eventIDs []string, offsets []int32, resetSent bool) error {
+	if offsets != nil {
+		if _, err := tx.ExecContext(ctx, `
+			DELETE FROM event_notification
+			WHERE event_id = ANY($1::uuid[]) AND NOT (offset_minutes = ANY($2::int[]))
+		`, pq.Array(eventIDs), pq.Array(offsets)); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO event_notification (event_id, offset_minutes)
+			SELECT e, o FROM unnest($1::uuid[]) AS e, unnest($2::int[]) AS o
+			ON CONFLICT DO NOTHING
+		`, pq.Array(eventIDs), pq.Array(offsets)); err != nil {
+			return err
+		}
+	}
+	if !resetSent {
+		return nil
+	}
+	_, err := tx.ExecContext(ctx, `
+		UPDATE event_notification SET sent_at = NULL WHERE event_id = ANY($1::uuid[])
+	`, pq.Array(eventIDs))
+	return err
+}
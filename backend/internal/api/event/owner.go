@@ -0,0 +1,91 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *EventService) AddEventOwner(ctx context.Context, req *proto.EventOwnerRequest) (*proto.EventDetails, error) {
+	userID, db, err := requireEventManager(ctx, req.GetEventId())
+	if err != nil {
+		return nil, err
+	}
+	var exists bool
+	err = db.QueryRowContext(ctx, `SELECT TRUE FROM app_user WHERE id::text = $1`, req.GetUserId()).Scan(&exists)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load user: %v", err)
+	}
+
+	if _, err := db.ExecContext(ctx, `
+		INSERT INTO event_owner (event_id, user_id) VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+	`, req.GetEventId(), req.GetUserId()); err != nil {
+		return nil, status.Errorf(codes.Internal, "add owner: %v", err)
+	}
+	helpers.PublishEventChanged(ctx, req.GetEventId())
+	return helpers.LoadEventDetails(ctx, db, req.GetEventId(), userID)
+}
+
+// RemoveEventOwner may leave an event without owners; club-wide editors
+// still manage it then.
+func (s *EventService) RemoveEventOwner(ctx context.Context, req *proto.EventOwnerRequest) (*proto.EventDetails, error) {
+	userID, db, err := requireEventManager(ctx, req.GetEventId())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := db.ExecContext(ctx, `
+		DELETE FROM event_owner WHERE event_id::text = $1 AND user_id::text = $2
+	`, req.GetEventId(), req.GetUserId()); err != nil {
+		return nil, status.Errorf(codes.Internal, "remove owner: %v", err)
+	}
+	helpers.PublishEventChanged(ctx, req.GetEventId())
+	return helpers.LoadEventDetails(ctx, db, req.GetEventId(), userID)
+}
+
+// requireEventManager loads the current user and checks they may manage the
+// event: club-wide edit_events or ownership.
+func requireEventManager(ctx context.Context, eventID string) (string, *sql.DB, error) {
+	userID, err := helpers.UserIDFromCtx(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	if err := requireEventAccess(ctx, db, userID, eventID, false); err != nil {
+		return "", nil, err
+	}
+	return userID, db, nil
+}
+
+// requireEventAccess fails unless the user has club-wide edit_events (or
+// edit_tracklists, when tracklist is set) or owns the event.
+func requireEventAccess(ctx context.Context, db *sql.DB, userID, eventID string, tracklist bool) error {
+	perms, err := helpers.LoadPermissions(ctx, db, userID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "load permissions: %v", err)
+	}
+	if helpers.PermissionAllowsEventEdit(perms) || tracklist && helpers.PermissionAllowsTracklistEdit(perms) {
+		return nil
+	}
+	owner, err := helpers.IsEventOwner(ctx, db, eventID, userID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "check event owner: %v", err)
+	}
+	if owner {
+		return nil
+	}
+	if tracklist {
+		return status.Error(codes.PermissionDenied, "no rights to edit this tracklist")
+	}
+	return status.Error(codes.PermissionDenied, "no rights to manage this event")
+}
@@ -0,0 +1,60 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"html"
+	"musicclubbot/backend/internal/config"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/internal/logging"
+	"musicclubbot/backend/internal/telegram"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *EventService) PublishTracklist(ctx context.Context, req *proto.EventId) (*emptypb.Empty, error) {
+	userID, err := helpers.UserIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireEventAccess(ctx, db, userID, req.GetId(), true); err != nil {
+		return nil, err
+	}
+	cfg := ctx.Value("cfg").(config.Config)
+	if cfg.ChatID == "" || cfg.BotToken == "" {
+		return nil, status.Error(codes.FailedPrecondition, "telegram chat is not configured")
+	}
+
+	var title string
+	err = db.QueryRowContext(ctx, `SELECT title FROM event WHERE id = $1 AND deleted_at IS NULL`, req.GetId()).Scan(&title)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "event not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load event: %v", err)
+	}
+	tracks, err := helpers.LoadTracklistSections(ctx, db, []string{req.GetId()})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load tracklist: %v", err)
+	}
+	sections := tracks[req.GetId()]
+	if len(sections) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "tracklist is empty")
+	}
+
+	bold := func(s string) string { return "<b>" + s + "</b>" }
+	text := "<b>Треклист: " + html.EscapeString(title) + "</b>\n\n" +
+		helpers.FormatTracklist(sections, bold, html.EscapeString)
+	if err := telegram.New(cfg.BotToken).SendMessage(ctx, cfg.ChatID, text); err != nil {
+		logging.FromContext(ctx).Error("publish tracklist to telegram", "event_id", req.GetId(), "error", err)
+		return nil, status.Error(codes.Unavailable, "couldn't send the tracklist to telegram")
+	}
+	return &emptypb.Empty{}, nil
+}
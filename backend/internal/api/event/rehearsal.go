@@ -0,0 +1,203 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *EventService) CreateRehearsal(ctx context.Context, req *proto.RehearsalInput) (*proto.EventDetails, error) {
+	userID, db, err := requireEventManager(ctx, req.GetEventId())
+	if err != nil {
+		return nil, err
+	}
+	if err := validateRehearsal(req); err != nil {
+		return nil, err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+
+	var rehearsalID string
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO rehearsal (event_id, start_at, end_at, location, notes, notify_day_before, notify_hour_before, created_by)
+		SELECT id, $2, $3, $4, $5, $6, $7, $8 FROM event WHERE id = $1 AND deleted_at IS NULL
+		RETURNING id
+	`, req.GetEventId(), req.GetStartAt().AsTime(), rehearsalEnd(req), nullIfEmpty(req.GetLocation()), nullIfEmpty(req.GetNotes()),
+		req.GetNotifyDayBefore(), req.GetNotifyHourBefore(), userID).Scan(&rehearsalID)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "event not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "insert rehearsal: %v", err)
+	}
+	if err := replaceRehearsalTracks(ctx, tx, rehearsalID, req.GetEventId(), req.GetTrackItemIds()); err != nil {
+		return nil, status.Errorf(codes.Internal, "set rehearsal tracks: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit: %v", err)
+	}
+	return helpers.LoadEventDetails(ctx, db, req.GetEventId(), userID)
+}
+
+func (s *EventService) UpdateRehearsal(ctx context.Context, req *proto.UpdateRehearsalRequest) (*proto.EventDetails, error) {
+	userID, db, err := requireRehearsalManager(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	in := req.GetRehearsal()
+	if err := validateRehearsal(in); err != nil {
+		return nil, err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+
+	var eventID string
+	err = tx.QueryRowContext(ctx, `
+		UPDATE rehearsal
+		SET start_at = $2, end_at = $3, location = $4, notes = $5, notify_day_before = $6, notify_hour_before = $7,
+		    day_reminder_sent_at = CASE WHEN start_at = $2 THEN day_reminder_sent_at END,
+		    hour_reminder_sent_at = CASE WHEN start_at = $2 THEN hour_reminder_sent_at END
+		WHERE id = $1
+		RETURNING event_id
+	`, req.GetId(), in.GetStartAt().AsTime(), rehearsalEnd(in), nullIfEmpty(in.GetLocation()), nullIfEmpty(in.GetNotes()),
+		in.GetNotifyDayBefore(), in.GetNotifyHourBefore()).Scan(&eventID)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "rehearsal not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "update rehearsal: %v", err)
+	}
+	if err := replaceRehearsalTracks(ctx, tx, req.GetId(), eventID, in.GetTrackItemIds()); err != nil {
+		return nil, status.Errorf(codes.Internal, "set rehearsal tracks: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit: %v", err)
+	}
+	return helpers.LoadEventDetails(ctx, db, eventID, userID)
+}
+
+func (s *EventService) DeleteRehearsal(ctx context.Context, req *proto.RehearsalId) (*emptypb.Empty, error) {
+	_, db, err := requireRehearsalManager(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	res, err := db.ExecContext(ctx, `DELETE FROM rehearsal WHERE id = $1`, req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "delete rehearsal: %v", err)
+	}
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		return nil, status.Error(codes.NotFound, "rehearsal not found")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *EventService) SetRehearsalAttendance(ctx context.Context, req *proto.SetRehearsalAttendanceRequest) (*proto.EventDetails, error) {
+	userID, err := helpers.UserIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var eventID string
+	err = db.QueryRowContext(ctx, `SELECT event_id FROM rehearsal WHERE id = $1`, req.GetRehearsalId()).Scan(&eventID)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "rehearsal not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load rehearsal: %v", err)
+	}
+
+	st := helpers.MapRsvpStatusToDB(req.GetStatus())
+	switch st {
+	case "":
+		_, err = db.ExecContext(ctx, `DELETE FROM rehearsal_attendance WHERE rehearsal_id = $1 AND user_id = $2`, req.GetRehearsalId(), userID)
+	case "waitlisted":
+		return nil, status.Error(codes.InvalidArgument, "rehearsals have no waitlist")
+	default:
+		_, err = db.ExecContext(ctx, `
+			INSERT INTO rehearsal_attendance (rehearsal_id, user_id, status)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (rehearsal_id, user_id) DO UPDATE SET status = EXCLUDED.status, updated_at = NOW()
+		`, req.GetRehearsalId(), userID, st)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "set attendance: %v", err)
+	}
+	return helpers.LoadEventDetails(ctx, db, eventID, userID)
+}
+
+// requireRehearsalManager is requireEventManager for the rehearsal's event.
+func requireRehearsalManager(ctx context.Context, rehearsalID string) (string, *sql.DB, error) {
+	userID, err := helpers.UserIDFromCtx(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	var eventID string
+	err = db.QueryRowContext(ctx, `SELECT event_id FROM rehearsal WHERE id::text = $1`, rehearsalID).Scan(&eventID)
+	if err == sql.ErrNoRows {
+		return "", nil, status.Error(codes.NotFound, "rehearsal not found")
+	}
+	if err != nil {
+		return "", nil, status.Errorf(codes.Internal, "load rehearsal: %v", err)
+	}
+	if err := requireEventAccess(ctx, db, userID, eventID, false); err != nil {
+		return "", nil, err
+	}
+	return userID, db, nil
+}
+
+func validateRehearsal(in *proto.RehearsalInput) error {
+	if in.GetStartAt() == nil {
+		return status.Error(codes.InvalidArgument, "start_at is required")
+	}
+	if in.GetEndAt() != nil && !in.GetEndAt().AsTime().After(in.GetStartAt().AsTime()) {
+		return status.Error(codes.InvalidArgument, "end_at must be after start_at")
+	}
+	return nil
+}
+
+func rehearsalEnd(in *proto.RehearsalInput) sql.NullTime {
+	if in.GetEndAt() == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Valid: true, Time: in.GetEndAt().AsTime()}
+}
+
+// replaceRehearsalTracks links the rehearsal to tracklist items of its event;
+// ids of other events' items are ignored.
+func replaceRehearsalTracks(ctx context.Context, tx *sql.Tx, rehearsalID, eventID string, itemIDs []string) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM rehearsal_track WHERE rehearsal_id = $1`, rehearsalID); err != nil {
+		return err
+	}
+	if len(itemIDs) == 0 {
+		return nil
+	}
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO rehearsal_track (rehearsal_id, track_item_id)
+		SELECT $1, id FROM event_track_item WHERE event_id = $2 AND id::text = ANY($3)
+	`, rehearsalID, eventID, pq.Array(itemIDs))
+	return err
+}
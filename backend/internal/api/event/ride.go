@@ -0,0 +1,193 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxRideSeats = 8
+	maxRideFrom  = 200
+	maxRideNotes = 500
+)
+
+func (s *EventService) PostRide(ctx context.Context, req *proto.PostRideRequest) (*proto.EventDetails, error) {
+	userID, err := helpers.UserIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	kind := helpers.MapRideKindToDB(req.GetKind())
+	if kind == "" {
+		return nil, status.Error(codes.InvalidArgument, "kind is required")
+	}
+	seats := req.GetSeats()
+	if seats == 0 {
+		seats = 1
+	}
+	if seats > maxRideSeats {
+		return nil, status.Errorf(codes.InvalidArgument, "seats must be at most %d", maxRideSeats)
+	}
+	from, notes := strings.TrimSpace(req.GetFromLocation()), strings.TrimSpace(req.GetNotes())
+	if utf8.RuneCountInString(from) > maxRideFrom {
+		return nil, status.Errorf(codes.InvalidArgument, "from_location must be at most %d characters", maxRideFrom)
+	}
+	if utf8.RuneCountInString(notes) > maxRideNotes {
+		return nil, status.Errorf(codes.InvalidArgument, "notes must be at most %d characters", maxRideNotes)
+	}
+	var depart sql.NullTime
+	if req.GetDepartAt() != nil {
+		depart = sql.NullTime{Valid: true, Time: req.GetDepartAt().AsTime()}
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+
+	var cancelled sql.NullTime
+	err = tx.QueryRowContext(ctx, `SELECT cancelled_at FROM event WHERE id::text = $1 AND deleted_at IS NULL`, req.GetEventId()).Scan(&cancelled)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "event not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load event: %v", err)
+	}
+	if cancelled.Valid {
+		return nil, status.Error(codes.FailedPrecondition, "event is cancelled")
+	}
+
+	var taken uint32
+	if err := tx.QueryRowContext(ctx, `
+		INSERT INTO event_ride (event_id, user_id, kind, seats, from_location, depart_at, notes)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (event_id, user_id, kind) DO UPDATE
+		SET seats = EXCLUDED.seats, from_location = EXCLUDED.from_location,
+		    depart_at = EXCLUDED.depart_at, notes = EXCLUDED.notes
+		RETURNING (SELECT COUNT(*) FROM event_ride_passenger p WHERE p.ride_id = event_ride.id)
+	`, req.GetEventId(), userID, kind, seats, nullIfEmpty(from), depart, nullIfEmpty(notes)).Scan(&taken); err != nil {
+		return nil, status.Errorf(codes.Internal, "save ride: %v", err)
+	}
+	if taken > seats {
+		return nil, status.Errorf(codes.FailedPrecondition, "%d seats are already taken", taken)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit: %v", err)
+	}
+	helpers.PublishEventChanged(ctx, req.GetEventId())
+	return helpers.LoadEventDetails(ctx, db, req.GetEventId(), userID)
+}
+
+func (s *EventService) CancelRide(ctx context.Context, req *proto.RideId) (*proto.EventDetails, error) {
+	userID, err := helpers.UserIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var eventID, authorID string
+	err = db.QueryRowContext(ctx, `SELECT event_id, user_id FROM event_ride WHERE id::text = $1`, req.GetId()).Scan(&eventID, &authorID)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "ride not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load ride: %v", err)
+	}
+	if err := requireAuthorOrEditor(ctx, db, userID, authorID, eventID); err != nil {
+		return nil, err
+	}
+
+	if _, err := db.ExecContext(ctx, `DELETE FROM event_ride WHERE id::text = $1`, req.GetId()); err != nil {
+		return nil, status.Errorf(codes.Internal, "delete ride: %v", err)
+	}
+	helpers.PublishEventChanged(ctx, eventID)
+	return helpers.LoadEventDetails(ctx, db, eventID, userID)
+}
+
+func (s *EventService) SetRidePassenger(ctx context.Context, req *proto.SetRidePassengerRequest) (*proto.EventDetails, error) {
+	userID, err := helpers.UserIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+
+	// Locking the offer keeps two passengers from taking the last seat.
+	var eventID, driverID, kind string
+	var seats uint32
+	err = tx.QueryRowContext(ctx, `
+		SELECT event_id, user_id, kind, seats FROM event_ride WHERE id::text = $1 FOR UPDATE
+	`, req.GetRideId()).Scan(&eventID, &driverID, &kind, &seats)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "ride not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load ride: %v", err)
+	}
+	if kind != "offer" {
+		return nil, status.Error(codes.FailedPrecondition, "only ride offers have seats")
+	}
+
+	if !req.GetRiding() {
+		if _, err := tx.ExecContext(ctx, `
+			DELETE FROM event_ride_passenger WHERE ride_id::text = $1 AND user_id = $2
+		`, req.GetRideId(), userID); err != nil {
+			return nil, status.Errorf(codes.Internal, "leave ride: %v", err)
+		}
+	} else {
+		if driverID == userID {
+			return nil, status.Error(codes.InvalidArgument, "you drive this ride")
+		}
+		var taken uint32
+		var riding bool
+		if err := tx.QueryRowContext(ctx, `
+			SELECT COUNT(*), COALESCE(BOOL_OR(user_id = $2), FALSE) FROM event_ride_passenger WHERE ride_id::text = $1
+		`, req.GetRideId(), userID).Scan(&taken, &riding); err != nil {
+			return nil, status.Errorf(codes.Internal, "count passengers: %v", err)
+		}
+		if !riding {
+			if taken >= seats {
+				return nil, status.Error(codes.FailedPrecondition, "no seats left")
+			}
+			if _, err := tx.ExecContext(ctx, `
+				INSERT INTO event_ride_passenger (ride_id, user_id) VALUES ($1, $2)
+			`, req.GetRideId(), userID); err != nil {
+				return nil, status.Errorf(codes.Internal, "join ride: %v", err)
+			}
+			// The passenger's own request is settled by the match.
+			if _, err := tx.ExecContext(ctx, `
+				DELETE FROM event_ride WHERE event_id = $1 AND user_id = $2 AND kind = 'request'
+			`, eventID, userID); err != nil {
+				return nil, status.Errorf(codes.Internal, "close ride request: %v", err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit: %v", err)
+	}
+	helpers.PublishEventChanged(ctx, eventID)
+	return helpers.LoadEventDetails(ctx, db, eventID, userID)
+}
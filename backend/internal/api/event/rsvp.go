@@ -0,0 +1,94 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *EventService) SetRsvp(ctx context.Context, req *proto.SetRsvpRequest) (*proto.EventDetails, error) {
+	userID, err := helpers.UserIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+
+	// Locking the event serializes RSVPs so the limit can't be overshot.
+	var maxParticipants int32
+	err = tx.QueryRowContext(ctx, `SELECT max_participants FROM event WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`, req.GetEventId()).Scan(&maxParticipants)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "event not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load event: %v", err)
+	}
+
+	st := helpers.MapRsvpStatusToDB(req.GetStatus())
+	if st == "waitlisted" {
+		return nil, status.Error(codes.InvalidArgument, "waitlist is assigned automatically")
+	}
+	if st == "going" && maxParticipants > 0 {
+		var going int32
+		if err := tx.QueryRowContext(ctx, `
+			SELECT COUNT(*) FROM event_rsvp WHERE event_id = $1 AND status = 'going' AND user_id <> $2
+		`, req.GetEventId(), userID).Scan(&going); err != nil {
+			return nil, status.Errorf(codes.Internal, "count rsvps: %v", err)
+		}
+		if going >= maxParticipants {
+			st = "waitlisted"
+		}
+	}
+
+	var previous sql.NullString
+	if err := tx.QueryRowContext(ctx, `
+		SELECT status FROM event_rsvp WHERE event_id = $1 AND user_id = $2
+	`, req.GetEventId(), userID).Scan(&previous); err != nil && err != sql.ErrNoRows {
+		return nil, status.Errorf(codes.Internal, "load rsvp: %v", err)
+	}
+
+	if st == "" {
+		_, err = tx.ExecContext(ctx, `DELETE FROM event_rsvp WHERE event_id = $1 AND user_id = $2`, req.GetEventId(), userID)
+	} else {
+		// Repeating the same answer keeps the original timestamp, and with it
+		// the place on the waitlist.
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO event_rsvp (event_id, user_id, status)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (event_id, user_id) DO UPDATE
+			SET status = EXCLUDED.status,
+			    updated_at = CASE WHEN event_rsvp.status = EXCLUDED.status THEN event_rsvp.updated_at ELSE NOW() END
+		`, req.GetEventId(), userID, st)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "set rsvp: %v", err)
+	}
+
+	if st != "" && st != previous.String {
+		if err := helpers.RecordActivity(ctx, tx, proto.ActivityKind_ACTIVITY_KIND_EVENT_RSVP, "", req.GetEventId(), st); err != nil {
+			return nil, status.Errorf(codes.Internal, "record activity: %v", err)
+		}
+	}
+
+	if err := helpers.PromoteWaitlist(ctx, tx, req.GetEventId()); err != nil {
+		return nil, status.Errorf(codes.Internal, "promote waitlist: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit: %v", err)
+	}
+
+	helpers.PublishEventChanged(ctx, req.GetEventId())
+	return helpers.LoadEventDetails(ctx, db, req.GetEventId(), userID)
+}
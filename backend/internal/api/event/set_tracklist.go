@@ -2,6 +2,8 @@ package event
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
 	"musicclubbot/backend/internal/helpers"
 	"musicclubbot/backend/proto"
 
@@ -18,12 +20,8 @@ func (s *EventService) SetTracklist(ctx context.Context, req *proto.SetTracklist
 	if err != nil {
 		return nil, err
 	}
-	perms, err := helpers.LoadPermissions(ctx, db, userID)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "load permissions: %v", err)
-	}
-	if !helpers.PermissionAllowsTracklistEdit(perms) {
-		return nil, status.Error(codes.PermissionDenied, "no rights to edit tracklists")
+	if err := requireEventAccess(ctx, db, userID, req.GetEventId(), true); err != nil {
+		return nil, err
 	}
 
 	tx, err := db.BeginTx(ctx, nil)
@@ -32,13 +30,26 @@ func (s *EventService) SetTracklist(ctx context.Context, req *proto.SetTracklist
 	}
 	defer tx.Rollback()
 
+	var exists bool
+	err = tx.QueryRowContext(ctx, `SELECT TRUE FROM event WHERE id::text = $1 AND deleted_at IS NULL FOR UPDATE`, req.GetEventId()).Scan(&exists)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "event not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "lock event: %v", err)
+	}
+
 	if err := helpers.ReplaceTracklist(ctx, tx, req.GetEventId(), req.GetTracklist()); err != nil {
 		return nil, status.Errorf(codes.Internal, "set tracklist: %v", err)
 	}
+	if err := helpers.RecordAudit(ctx, tx, helpers.AuditTracklist, req.GetEventId(), "set", fmt.Sprintf("%d items", len(req.GetTracklist().GetItems()))); err != nil {
+		return nil, status.Errorf(codes.Internal, "record audit: %v", err)
+	}
 
 	if err := tx.Commit(); err != nil {
 		return nil, status.Errorf(codes.Internal, "commit: %v", err)
 	}
 
+	helpers.PublishEventChanged(ctx, req.GetEventId())
 	return helpers.LoadEventDetails(ctx, db, req.GetEventId(), userID)
 }
@@ -0,0 +1,54 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/config"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	shareLinkDefaultTTL = 30 * 24 * time.Hour
+	shareLinkMaxTTL     = 365 * 24 * time.Hour
+)
+
+func (s *EventService) CreateEventShareLink(ctx context.Context, req *proto.CreateEventShareLinkRequest) (*proto.EventShareLink, error) {
+	_, db, err := requireEventManager(ctx, req.GetEventId())
+	if err != nil {
+		return nil, err
+	}
+	var eventID string
+	err = db.QueryRowContext(ctx, `SELECT id FROM event WHERE id::text = $1 AND deleted_at IS NULL`, req.GetEventId()).Scan(&eventID)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "event not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load event: %v", err)
+	}
+
+	now := time.Now()
+	expires := now.Add(shareLinkDefaultTTL)
+	if ts := req.GetExpiresAt(); ts != nil {
+		expires = ts.AsTime()
+	}
+	if !expires.After(now) {
+		return nil, status.Error(codes.InvalidArgument, "expires_at must be in the future")
+	}
+	if expires.After(now.Add(shareLinkMaxTTL)) {
+		return nil, status.Error(codes.InvalidArgument, "expires_at must be within a year")
+	}
+	expires = expires.Truncate(time.Second)
+
+	cfg := ctx.Value("cfg").(config.Config)
+	token := helpers.EventShareToken(cfg.JwtSecretKey, eventID, expires)
+	return &proto.EventShareLink{
+		Url:       helpers.EventShareURL(ctx, token),
+		ExpiresAt: timestamppb.New(expires),
+	}, nil
+}
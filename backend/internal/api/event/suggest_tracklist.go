@@ -0,0 +1,126 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// suggestMaxSongs caps suggestions without an explicit limit.
+	suggestMaxSongs = 50
+	// suggestFreshnessMonths is how long a song has to rest to get the full
+	// last-performed bonus; songs never played get it too.
+	suggestFreshnessMonths = 6
+)
+
+type suggestCandidate struct {
+	songID     string
+	title      string
+	duration   uint32
+	votes      int
+	lastPlayed sql.NullTime
+	score      float64
+}
+
+func (s *EventService) SuggestTracklist(ctx context.Context, req *proto.SuggestTracklistRequest) (*proto.Tracklist, error) {
+	userID, err := helpers.UserIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireEventAccess(ctx, db, userID, req.GetEventId(), true); err != nil {
+		return nil, err
+	}
+
+	var slotMinutes int32
+	err = db.QueryRowContext(ctx, `SELECT time_slot_minutes FROM event WHERE id = $1 AND deleted_at IS NULL`, req.GetEventId()).Scan(&slotMinutes)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "event not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load event: %v", err)
+	}
+
+	// A song is staffed when it has roles and each of them has someone.
+	rows, err := db.QueryContext(ctx, `
+		SELECT s.id, s.title, COALESCE(s.duration_sec, 0),
+		       (SELECT COUNT(*) FROM song_favorite f WHERE f.song_id = s.id),
+		       (SELECT MAX(e.start_at)
+		        FROM event_track_item ti JOIN event e ON e.id = ti.event_id
+		        WHERE ti.song_id = s.id AND e.start_at < NOW())
+		FROM song s
+		WHERE EXISTS (SELECT 1 FROM song_role r WHERE r.song_id = s.id)
+		  AND NOT EXISTS (
+		      SELECT 1 FROM song_role r
+		      WHERE r.song_id = s.id
+		        AND NOT EXISTS (SELECT 1 FROM song_role_assignment a WHERE a.song_id = s.id AND a.role = r.role))
+	`)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load songs: %v", err)
+	}
+	defer rows.Close()
+	var candidates []*suggestCandidate
+	now := time.Now()
+	for rows.Next() {
+		c := &suggestCandidate{}
+		if err := rows.Scan(&c.songID, &c.title, &c.duration, &c.votes, &c.lastPlayed); err != nil {
+			return nil, status.Errorf(codes.Internal, "scan song: %v", err)
+		}
+		if req.GetWeightByVotes() {
+			c.score += float64(c.votes)
+		}
+		if req.GetWeightByLastPerformed() {
+			months := float64(suggestFreshnessMonths)
+			if c.lastPlayed.Valid {
+				months = min(now.Sub(c.lastPlayed.Time).Hours()/24/30, months)
+			}
+			c.score += months
+		}
+		candidates = append(candidates, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "iterate songs: %v", err)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].score != candidates[j].score {
+			return candidates[i].score > candidates[j].score
+		}
+		return candidates[i].title < candidates[j].title
+	})
+
+	limit := int(req.GetLimit())
+	if limit == 0 {
+		limit = suggestMaxSongs
+	}
+	slot := uint32(slotMinutes) * 60
+	tracklist := &proto.Tracklist{}
+	for _, c := range candidates {
+		if len(tracklist.Items) >= limit {
+			break
+		}
+		// Without an explicit limit the slot decides; songs that don't fit
+		// are skipped so shorter ones can still fill the gap.
+		if req.GetLimit() == 0 && slot > 0 && tracklist.TotalSeconds+c.duration > slot {
+			continue
+		}
+		tracklist.TotalSeconds += c.duration
+		tracklist.Items = append(tracklist.Items, &proto.TrackItem{
+			Order:                    uint32(len(tracklist.Items) + 1),
+			SongId:                   c.songID,
+			EffectiveDurationSeconds: c.duration,
+			EndsAtSeconds:            tracklist.TotalSeconds,
+			ExceedsTimeSlot:          slot > 0 && tracklist.TotalSeconds > slot,
+		})
+	}
+	return tracklist, nil
+}
@@ -0,0 +1,140 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"strconv"
+	"strings"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const templateColumns = `id, name, title_pattern, COALESCE(location, ''), notify_day_before, notify_hour_before, max_participants, required_roles, uses,
+	COALESCE(venue_id::text, ''), time_slot_minutes, notify_offsets, timezone, track_gap_seconds`
+
+func scanTemplate(row interface{ Scan(...any) error }) (*proto.EventTemplate, error) {
+	var t proto.EventTemplate
+	if err := row.Scan(&t.Id, &t.Name, &t.TitlePattern, &t.Location, &t.NotifyDayBefore, &t.NotifyHourBefore,
+		&t.MaxParticipants, pq.Array(&t.RequiredRoles), &t.Uses, &t.VenueId, &t.TimeSlotMinutes,
+		pq.Array(&t.NotifyOffsetsMinutes), &t.Timezone, &t.TrackGapSeconds); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (s *EventService) SaveEventTemplate(ctx context.Context, req *proto.SaveEventTemplateRequest) (*proto.EventTemplate, error) {
+	userID, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	name := strings.TrimSpace(req.GetName())
+
+	row := db.QueryRowContext(ctx, `
+		INSERT INTO event_template (name, title_pattern, location, notify_day_before, notify_hour_before, max_participants, required_roles, venue_id, time_slot_minutes, notify_offsets, timezone, track_gap_seconds, created_by)
+		SELECT $2, COALESCE(NULLIF($3, ''), title), location, notify_day_before, notify_hour_before, max_participants, required_roles, venue_id,
+		       time_slot_minutes,
+		       ARRAY(SELECT offset_minutes FROM event_notification WHERE event_id = event.id ORDER BY offset_minutes DESC), timezone, track_gap_seconds, $4
+		FROM event WHERE id = $1
+		RETURNING `+templateColumns,
+		req.GetEventId(), name, strings.TrimSpace(req.GetTitlePattern()), userID)
+	t, err := scanTemplate(row)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "event not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "save template: %v", err)
+	}
+	return t, nil
+}
+
+func (s *EventService) ListEventTemplates(ctx context.Context, _ *emptypb.Empty) (*proto.ListEventTemplatesResponse, error) {
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := db.QueryContext(ctx, `SELECT `+templateColumns+` FROM event_template ORDER BY name`)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list templates: %v", err)
+	}
+	defer rows.Close()
+	var templates []*proto.EventTemplate
+	for rows.Next() {
+		t, err := scanTemplate(rows)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "scan template: %v", err)
+		}
+		templates = append(templates, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "iterate templates: %v", err)
+	}
+	return &proto.ListEventTemplatesResponse{Templates: templates}, nil
+}
+
+func (s *EventService) DeleteEventTemplate(ctx context.Context, req *proto.EventTemplateId) (*emptypb.Empty, error) {
+	_, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := db.ExecContext(ctx, `DELETE FROM event_template WHERE id = $1`, req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "delete template: %v", err)
+	}
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		return nil, status.Error(codes.NotFound, "template not found")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// CreateEventFromTemplate needs create_events like CreateEvent, so members
+// who may create events can use templates too.
+func (s *EventService) CreateEventFromTemplate(ctx context.Context, req *proto.CreateEventFromTemplateRequest) (*proto.EventDetails, error) {
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t, err := scanTemplate(db.QueryRowContext(ctx, `SELECT `+templateColumns+` FROM event_template WHERE id = $1`, req.GetTemplateId()))
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "template not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load template: %v", err)
+	}
+
+	title := strings.TrimSpace(req.GetTitle())
+	if title == "" {
+		date := ""
+		if req.GetStartAt() != nil {
+			date = req.GetStartAt().AsTime().In(helpers.Location(t.GetTimezone())).Format("02.01.2006")
+		}
+		title = strings.NewReplacer("{date}", date, "{n}", strconv.Itoa(int(t.GetUses())+1)).Replace(t.GetTitlePattern())
+	}
+
+	details, err := s.CreateEvent(ctx, &proto.CreateEventRequest{
+		Title:            title,
+		StartAt:          req.GetStartAt(),
+		Location:         t.GetLocation(),
+		NotifyDayBefore:  t.GetNotifyDayBefore(),
+		NotifyHourBefore: t.GetNotifyHourBefore(),
+		MaxParticipants:  t.GetMaxParticipants(),
+		RequiredRoles:    t.GetRequiredRoles(),
+		VenueId:          t.GetVenueId(),
+		TimeSlotMinutes:  t.GetTimeSlotMinutes(),
+		// Templates saved before offsets existed fall back to the flags.
+		NotifyOffsetsMinutes: t.GetNotifyOffsetsMinutes(),
+		Timezone:             t.GetTimezone(),
+		TrackGapSeconds:      t.GetTrackGapSeconds(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := db.ExecContext(ctx, `UPDATE event_template SET uses = uses + 1 WHERE id = $1`, t.GetId()); err != nil {
+		return nil, status.Errorf(codes.Internal, "count template use: %v", err)
+	}
+	return details, nil
+}
@@ -0,0 +1,333 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *EventService) InsertTrackItem(ctx context.Context, req *proto.InsertTrackItemRequest) (*proto.EventDetails, error) {
+	item := req.GetItem()
+	if err := validateTrackItem(item); err != nil {
+		return nil, err
+	}
+	return editTracklist(ctx, req.GetEventId(), func(tx *sql.Tx, order *trackOrder) error {
+		if !order.hasSet(req.GetSetId()) {
+			return status.Error(codes.InvalidArgument, "track set not found")
+		}
+		var id string
+		if err := tx.QueryRowContext(ctx, `
+			INSERT INTO event_track_item (event_id, position, song_id, custom_title, custom_artist, duration_sec, notes, musical_key, planned_start_at)
+			VALUES ($1, (SELECT COALESCE(MAX(position), 0) + 1 FROM event_track_item WHERE event_id = $1),
+			        NULLIF($2, '')::uuid, NULLIF($3, ''), NULLIF($4, ''), NULLIF($5, 0), NULLIF($6, ''), NULLIF($7, ''), $8)
+			RETURNING id
+		`, req.GetEventId(), item.GetSongId(), strings.TrimSpace(item.GetCustomTitle()), strings.TrimSpace(item.GetCustomArtist()),
+			item.GetDurationSeconds(), strings.TrimSpace(item.GetNotes()), strings.TrimSpace(item.GetKey()), helpers.PlannedStart(item)).Scan(&id); err != nil {
+			return status.Errorf(codes.Internal, "insert track item: %v", err)
+		}
+		order.place(req.GetSetId(), id, req.GetPosition())
+		if err := helpers.RecordAudit(ctx, tx, helpers.AuditTracklist, req.GetEventId(), "insert_item", trackItemTitle(ctx, tx, id)); err != nil {
+			return status.Errorf(codes.Internal, "record audit: %v", err)
+		}
+		return nil
+	})
+}
+
+func (s *EventService) MoveTrackItem(ctx context.Context, req *proto.MoveTrackItemRequest) (*proto.EventDetails, error) {
+	return editTracklist(ctx, req.GetEventId(), func(tx *sql.Tx, order *trackOrder) error {
+		setID, ok := order.remove(req.GetItemId())
+		if !ok {
+			return status.Error(codes.NotFound, "track item not found")
+		}
+		if req.GetSetId() != "" {
+			if !order.hasSet(req.GetSetId()) {
+				return status.Error(codes.InvalidArgument, "track set not found")
+			}
+			setID = req.GetSetId()
+		}
+		position := req.GetPosition()
+		if position == 0 {
+			position = 1
+		}
+		order.place(setID, req.GetItemId(), position)
+		if err := helpers.RecordAudit(ctx, tx, helpers.AuditTracklist, req.GetEventId(), "move_item", trackItemTitle(ctx, tx, req.GetItemId())); err != nil {
+			return status.Errorf(codes.Internal, "record audit: %v", err)
+		}
+		return nil
+	})
+}
+
+func (s *EventService) RemoveTrackItem(ctx context.Context, req *proto.TrackItemRef) (*proto.EventDetails, error) {
+	return editTracklist(ctx, req.GetEventId(), func(tx *sql.Tx, order *trackOrder) error {
+		if _, ok := order.remove(req.GetItemId()); !ok {
+			return status.Error(codes.NotFound, "track item not found")
+		}
+		title := trackItemTitle(ctx, tx, req.GetItemId())
+		if _, err := tx.ExecContext(ctx, `
+			DELETE FROM event_track_item WHERE event_id = $1 AND id::text = $2
+		`, req.GetEventId(), req.GetItemId()); err != nil {
+			return status.Errorf(codes.Internal, "delete track item: %v", err)
+		}
+		if err := helpers.RecordAudit(ctx, tx, helpers.AuditTracklist, req.GetEventId(), "remove_item", title); err != nil {
+			return status.Errorf(codes.Internal, "record audit: %v", err)
+		}
+		return nil
+	})
+}
+
+func (s *EventService) UpdateTrackItem(ctx context.Context, req *proto.UpdateTrackItemRequest) (*proto.EventDetails, error) {
+	item := req.GetItem()
+	fields, err := updateFields(req.GetUpdateMask(), trackItemMaskFields, trackItemFullUpdateFields)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := checkTrackNotes(item.GetNotes()); err != nil {
+		return nil, err
+	}
+
+	args := []any{req.GetEventId(), item.GetId()}
+	sets := []string{}
+	set := func(column string, value any) {
+		args = append(args, value)
+		sets = append(sets, column+" = $"+strconv.Itoa(len(args)))
+	}
+	if fields["song_id"] {
+		set("song_id", nullIfEmpty(item.GetSongId()))
+		// A different song starts rehearsals over.
+		sets = append(sets, "rehearsal_status = CASE WHEN song_id IS DISTINCT FROM $"+strconv.Itoa(len(args))+"::uuid THEN 'not_started' ELSE rehearsal_status END")
+	}
+	if fields["custom_title"] {
+		set("custom_title", nullIfEmpty(strings.TrimSpace(item.GetCustomTitle())))
+	}
+	if fields["custom_artist"] {
+		set("custom_artist", nullIfEmpty(strings.TrimSpace(item.GetCustomArtist())))
+	}
+	if fields["duration_seconds"] {
+		set("duration_sec", sql.NullInt32{Int32: int32(item.GetDurationSeconds()), Valid: item.GetDurationSeconds() > 0})
+	}
+	if fields["performed"] {
+		set("performed", item.GetPerformed())
+	}
+	if fields["notes"] {
+		set("notes", nullIfEmpty(strings.TrimSpace(item.GetNotes())))
+	}
+	if fields["key"] {
+		set("musical_key", nullIfEmpty(strings.TrimSpace(item.GetKey())))
+	}
+	if fields["planned_start_at"] {
+		set("planned_start_at", helpers.PlannedStart(item))
+	}
+	if len(sets) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "update_mask names no fields")
+	}
+
+	return editTracklist(ctx, req.GetEventId(), func(tx *sql.Tx, _ *trackOrder) error {
+		var named bool
+		err := tx.QueryRowContext(ctx, `
+			UPDATE event_track_item SET `+strings.Join(sets, ", ")+`
+			WHERE event_id = $1 AND id::text = $2
+			RETURNING song_id IS NOT NULL OR custom_title IS NOT NULL
+		`, args...).Scan(&named)
+		if err == sql.ErrNoRows {
+			return status.Error(codes.NotFound, "track item not found")
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "update track item: %v", err)
+		}
+		if !named {
+			return status.Error(codes.InvalidArgument, "track item needs song_id or custom_title")
+		}
+		if err := helpers.RecordAudit(ctx, tx, helpers.AuditTracklist, req.GetEventId(), "update_item", trackItemTitle(ctx, tx, item.GetId())); err != nil {
+			return status.Errorf(codes.Internal, "record audit: %v", err)
+		}
+		return nil
+	})
+}
+
+// editTracklist checks rights, locks the event and its tracklist, lets edit
+// rearrange the current order and stores the result as positions 1..n.
+func editTracklist(ctx context.Context, eventID string, edit func(tx *sql.Tx, order *trackOrder) error) (*proto.EventDetails, error) {
+	userID, err := helpers.UserIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireEventAccess(ctx, db, userID, eventID, true); err != nil {
+		return nil, err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+
+	// Locking the event serializes concurrent edits of the same tracklist.
+	var exists bool
+	err = tx.QueryRowContext(ctx, `SELECT TRUE FROM event WHERE id::text = $1 AND deleted_at IS NULL FOR UPDATE`, eventID).Scan(&exists)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "event not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "lock event: %v", err)
+	}
+
+	order, err := loadTrackOrder(ctx, tx, eventID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load tracklist: %v", err)
+	}
+	if err := edit(tx, order); err != nil {
+		return nil, err
+	}
+	if err := order.apply(ctx, tx, eventID); err != nil {
+		return nil, status.Errorf(codes.Internal, "reorder tracklist: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit: %v", err)
+	}
+	helpers.PublishEventChanged(ctx, eventID)
+	return helpers.LoadEventDetails(ctx, db, eventID, userID)
+}
+
+// trackOrder holds item ids per set in play order; items outside of any set
+// are kept under the empty set id, which always comes first.
+type trackOrder struct {
+	sets  []string
+	items map[string][]string
+}
+
+func loadTrackOrder(ctx context.Context, tx *sql.Tx, eventID string) (*trackOrder, error) {
+	order := &trackOrder{sets: []string{""}, items: map[string][]string{}}
+	rows, err := tx.QueryContext(ctx, `
+		SELECT id FROM event_track_set WHERE event_id = $1 ORDER BY position
+	`, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		order.sets = append(order.sets, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	itemRows, err := tx.QueryContext(ctx, `
+		SELECT id, COALESCE(set_id::text, '') FROM event_track_item WHERE event_id = $1 ORDER BY position
+	`, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer itemRows.Close()
+	for itemRows.Next() {
+		var id, setID string
+		if err := itemRows.Scan(&id, &setID); err != nil {
+			return nil, err
+		}
+		order.items[setID] = append(order.items[setID], id)
+	}
+	return order, itemRows.Err()
+}
+
+func (o *trackOrder) hasSet(setID string) bool {
+	for _, id := range o.sets {
+		if id == setID {
+			return true
+		}
+	}
+	return false
+}
+
+// remove takes the item out of its set and reports which set that was.
+func (o *trackOrder) remove(itemID string) (string, bool) {
+	for setID, ids := range o.items {
+		for i, id := range ids {
+			if id == itemID {
+				o.items[setID] = append(ids[:i:i], ids[i+1:]...)
+				return setID, true
+			}
+		}
+	}
+	return "", false
+}
+
+// place inserts the item at the 1-based position of the set; 0 or past the
+// end appends.
+func (o *trackOrder) place(setID, itemID string, position uint32) {
+	ids := o.items[setID]
+	idx := len(ids)
+	if position > 0 && int(position) <= len(ids) {
+		idx = int(position) - 1
+	}
+	out := make([]string, 0, len(ids)+1)
+	out = append(out, ids[:idx]...)
+	out = append(out, itemID)
+	o.items[setID] = append(out, ids[idx:]...)
+}
+
+// apply renumbers the items set by set, parking them on negative positions
+// first so the unique (event_id, position) never collides.
+func (o *trackOrder) apply(ctx context.Context, tx *sql.Tx, eventID string) error {
+	ids, setIDs := []string{}, []string{}
+	for _, setID := range o.sets {
+		for _, id := range o.items[setID] {
+			ids = append(ids, id)
+			setIDs = append(setIDs, setID)
+		}
+	}
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE event_track_item SET position = -position - 1 WHERE event_id = $1
+	`, eventID); err != nil {
+		return err
+	}
+	_, err := tx.ExecContext(ctx, `
+		UPDATE event_track_item t SET position = o.n, set_id = NULLIF(o.set_id, '')::uuid
+		FROM unnest($2::uuid[], $3::text[]) WITH ORDINALITY AS o(id, set_id, n)
+		WHERE t.event_id = $1 AND t.id = o.id
+	`, eventID, pq.Array(ids), pq.Array(setIDs))
+	return err
+}
+
+// trackItemTitle names an item for the audit log; empty if it's gone.
+func trackItemTitle(ctx context.Context, tx *sql.Tx, itemID string) string {
+	var title string
+	tx.QueryRowContext(ctx, `
+		SELECT COALESCE(s.artist || ' — ' || s.title, ti.custom_title, '')
+		FROM event_track_item ti LEFT JOIN song s ON s.id = ti.song_id
+		WHERE ti.id::text = $1
+	`, itemID).Scan(&title)
+	return title
+}
+
+// maxTrackNotes bounds TrackItem.notes so it still fits on a printed setlist.
+const maxTrackNotes = 500
+
+func validateTrackItem(item *proto.TrackItem) error {
+	if item.GetSongId() == "" && strings.TrimSpace(item.GetCustomTitle()) == "" {
+		return status.Error(codes.InvalidArgument, "track item needs song_id or custom_title")
+	}
+	return checkTrackNotes(item.GetNotes())
+}
+
+func checkTrackNotes(notes string) error {
+	if utf8.RuneCountInString(strings.TrimSpace(notes)) > maxTrackNotes {
+		return status.Errorf(codes.InvalidArgument, "track notes must be at most %d characters", maxTrackNotes)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *EventService) SetTrackRehearsalStatus(ctx context.Context, req *proto.SetTrackRehearsalStatusRequest) (*proto.EventDetails, error) {
+	userID, err := helpers.UserIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	value := helpers.MapTrackRehearsalStatusToDB(req.GetStatus())
+	if value == "" {
+		return nil, status.Error(codes.InvalidArgument, "status is required")
+	}
+
+	// Besides tracklist editors, the people playing the item decide when
+	// it is ready.
+	var plays bool
+	err = db.QueryRowContext(ctx, `
+		SELECT EXISTS (SELECT 1 FROM song_role_assignment a WHERE a.song_id = ti.song_id AND a.user_id::text = $3)
+		    OR EXISTS (SELECT 1 FROM event_participant p WHERE p.track_item_id = ti.id AND p.user_id::text = $3)
+		FROM event_track_item ti
+		WHERE ti.event_id::text = $1 AND ti.id::text = $2
+	`, req.GetEventId(), req.GetItemId(), userID).Scan(&plays)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "track item not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load track item: %v", err)
+	}
+	if !plays {
+		if err := requireEventAccess(ctx, db, userID, req.GetEventId(), true); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := db.ExecContext(ctx, `
+		UPDATE event_track_item SET rehearsal_status = $3 WHERE event_id::text = $1 AND id::text = $2
+	`, req.GetEventId(), req.GetItemId(), value); err != nil {
+		return nil, status.Errorf(codes.Internal, "update status: %v", err)
+	}
+	helpers.PublishEventChanged(ctx, req.GetEventId())
+	return helpers.LoadEventDetails(ctx, db, req.GetEventId(), userID)
+}
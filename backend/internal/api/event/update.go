@@ -5,11 +5,32 @@ import (
 	"database/sql"
 	"musicclubbot/backend/internal/helpers"
 	"musicclubbot/backend/proto"
+	"strconv"
+	"strings"
 
+	"github.com/lib/pq"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// eventColumn is a column UpdateEvent writes, with its new value.
+type eventColumn struct {
+	name  string
+	value any
+}
+
+// assignments renders cols as SET items whose placeholders follow the first
+// n parameters of the statement, along with their arguments.
+func assignments(cols []eventColumn, n int) ([]string, []any) {
+	sets := make([]string, 0, len(cols))
+	args := make([]any, 0, len(cols))
+	for _, c := range cols {
+		args = append(args, c.value)
+		sets = append(sets, c.name+" = $"+strconv.Itoa(n+len(args)))
+	}
+	return sets, args
+}
+
 func (s *EventService) UpdateEvent(ctx context.Context, req *proto.UpdateEventRequest) (*proto.EventDetails, error) {
 	userID, err := helpers.UserIDFromCtx(ctx)
 	if err != nil {
@@ -19,30 +40,256 @@ func (s *EventService) UpdateEvent(ctx context.Context, req *proto.UpdateEventRe
 	if err != nil {
 		return nil, err
 	}
-	perms, err := helpers.LoadPermissions(ctx, db, userID)
+	if err := requireEventAccess(ctx, db, userID, req.GetId(), false); err != nil {
+		return nil, err
+	}
+
+	if req.GetExpectedVersion() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "expected_version is required")
+	}
+	if req.GetMaxParticipants() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "max_participants must not be negative")
+	}
+	if req.GetTimeSlotMinutes() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "time_slot_minutes must not be negative")
+	}
+	fields, err := updateFields(req.GetUpdateMask(), eventMaskFields, eventFullUpdateFields)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "load permissions: %v", err)
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
 	}
-	if !helpers.PermissionAllowsEventEdit(perms) {
-		return nil, status.Error(codes.PermissionDenied, "no rights to update events")
+	defer tx.Rollback()
+
+	var title string
+	var location, venueID, seriesID sql.NullString
+	var oldStart, occurrenceAt sql.NullTime
+	var dayBefore, hourBefore bool
+	var storedOffsets pq.Int32Array
+	err = tx.QueryRowContext(ctx, `
+		SELECT title, start_at, location, venue_id, notify_day_before, notify_hour_before, series_id, occurrence_at,
+		       ARRAY(SELECT offset_minutes FROM event_notification n WHERE n.event_id = e.id)
+		FROM event e WHERE id = $1 AND deleted_at IS NULL FOR UPDATE
+	`, req.GetId()).Scan(&title, &oldStart, &location, &venueID, &dayBefore, &hourBefore, &seriesID, &occurrenceAt, &storedOffsets)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "event not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load event: %v", err)
 	}
 
-	var startAt sql.NullTime
-	if ts := req.GetStartAt(); ts != nil {
-		startAt = sql.NullTime{Valid: true, Time: ts.AsTime()}
+	// cols are written to this event; the ones that make sense for a whole
+	// series are copied to later occurrences too.
+	var cols, shared []eventColumn
+	add := func(name string, value any, series bool) {
+		cols = append(cols, eventColumn{name, value})
+		if series {
+			shared = append(shared, eventColumn{name, value})
+		}
 	}
 
-	res, err := db.ExecContext(ctx, `
+	if fields["title"] {
+		title = req.GetTitle()
+		add("title", title, true)
+	}
+	startAt := oldStart
+	if fields["start_at"] {
+		startAt = sql.NullTime{}
+		if ts := req.GetStartAt(); ts != nil {
+			startAt = sql.NullTime{Valid: true, Time: ts.AsTime()}
+		}
+		add("start_at", startAt, false)
+	}
+	if fields["location"] {
+		add("location", nullIfEmpty(req.GetLocation()), true)
+	}
+	if fields["max_participants"] {
+		add("max_participants", req.GetMaxParticipants(), true)
+	}
+	if fields["required_roles"] {
+		roles, err := normalizeRoles(ctx, tx, req.GetRequiredRoles())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "normalize roles: %v", err)
+		}
+		add("required_roles", pq.Array(roles), true)
+	}
+	if fields["venue_id"] {
+		if err := checkVenue(ctx, tx, req.GetVenueId()); err != nil {
+			return nil, err
+		}
+		add("venue_id", nullIfEmpty(req.GetVenueId()), true)
+	}
+	if fields["time_slot_minutes"] {
+		add("time_slot_minutes", req.GetTimeSlotMinutes(), true)
+	}
+	if fields["timezone"] {
+		timezone, err := resolveTimezone(ctx, req.GetTimezone())
+		if err != nil {
+			return nil, err
+		}
+		add("timezone", timezone, true)
+	}
+	if fields["season_id"] {
+		if err := checkSeason(ctx, tx, req.GetSeasonId()); err != nil {
+			return nil, err
+		}
+		add("season_id", nullIfEmpty(req.GetSeasonId()), true)
+	}
+	if fields["track_gap_seconds"] {
+		if err := checkTrackGap(req.GetTrackGapSeconds()); err != nil {
+			return nil, err
+		}
+		add("track_gap_seconds", req.GetTrackGapSeconds(), true)
+	}
+	theme, themeTag := normalizeTheme(req.GetTheme(), req.GetThemeTag())
+	if fields["theme"] {
+		add("theme", nullIfEmpty(theme), true)
+	}
+	if fields["theme_tag"] {
+		add("theme_tag", nullIfEmpty(themeTag), true)
+	}
+
+	// The coordinates follow a new place unless they are given as well.
+	relocated := fields["location"] && req.GetLocation() != location.String
+	relocated = relocated || fields["venue_id"] && req.GetVenueId() != venueID.String
+	if relocated || fields["coordinates"] || fields["map_url"] {
+		if fields["location"] {
+			location.String = req.GetLocation()
+		}
+		if fields["venue_id"] {
+			venueID.String = req.GetVenueId()
+		}
+		point, err := eventCoordinates(ctx, req.GetCoordinates(), req.GetMapUrl(), location.String, venueID.String)
+		if err != nil {
+			return nil, err
+		}
+		lat, lon := helpers.GeoArgs(point)
+		add("latitude", lat, true)
+		add("longitude", lon, true)
+	}
+
+	// nil offsets leave the reminders alone. Without explicit offsets the
+	// day/hour flags switch their reminder on or off among the stored ones.
+	var offsets []int32
+	if fields["notify_offsets_minutes"] || fields["notify_day_before"] || fields["notify_hour_before"] {
+		if fields["notify_day_before"] {
+			dayBefore = req.GetNotifyDayBefore()
+		}
+		if fields["notify_hour_before"] {
+			hourBefore = req.GetNotifyHourBefore()
+		}
+		next := req.GetNotifyOffsetsMinutes()
+		if !fields["notify_offsets_minutes"] {
+			next = withOffset(withOffset(storedOffsets, dayBeforeMinutes, dayBefore), hourBeforeMinutes, hourBefore)
+		}
+		if offsets, err = notifyOffsets(next, dayBefore, hourBefore); err != nil {
+			return nil, err
+		}
+		add("notify_day_before", hasOffset(offsets, dayBeforeMinutes), true)
+		add("notify_hour_before", hasOffset(offsets, hourBeforeMinutes), true)
+	}
+
+	sets, vals := assignments(cols, 2)
+	sets = append(sets, "updated_at = NOW()", "version = version + 1")
+	res, err := tx.ExecContext(ctx, `
 		UPDATE event
-		SET title = $1, start_at = $2, location = $3, notify_day_before = $4, notify_hour_before = $5, updated_at = NOW()
-		WHERE id = $6
-	`, req.GetTitle(), startAt, nullIfEmpty(req.GetLocation()), req.GetNotifyDayBefore(), req.GetNotifyHourBefore(), req.GetId())
+		SET `+strings.Join(sets, ", ")+`
+		WHERE id = $1 AND version = $2
+	`, append([]any{req.GetId(), req.GetExpectedVersion()}, vals...)...)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "update event: %v", err)
 	}
 	affected, _ := res.RowsAffected()
 	if affected == 0 {
-		return nil, status.Error(codes.NotFound, "event not found")
+		return nil, status.Error(codes.Aborted, "event was modified concurrently")
+	}
+	// Reminders already sent for the old start go out again for the new one.
+	moved := oldStart.Valid != startAt.Valid || (startAt.Valid && !startAt.Time.Equal(oldStart.Time))
+	if err := setEventNotifications(ctx, tx, []string{req.GetId()}, offsets, moved); err != nil {
+		return nil, status.Errorf(codes.Internal, "set notifications: %v", err)
+	}
+
+	if req.GetScope() == proto.RecurrenceScope_RECURRENCE_SCOPE_ALL_FUTURE {
+		if !seriesID.Valid {
+			return nil, status.Error(codes.InvalidArgument, "event is not part of a series")
+		}
+		var shift float64
+		if oldStart.Valid && startAt.Valid {
+			shift = startAt.Time.Sub(oldStart.Time).Seconds()
+		}
+		if err := updateFutureOccurrences(ctx, tx, req.GetId(), shared, offsets, seriesID.String, occurrenceAt, shift); err != nil {
+			return nil, status.Errorf(codes.Internal, "update series: %v", err)
+		}
+	}
+
+	// A raised limit may free spots for people on the waitlist.
+	if err := helpers.PromoteWaitlist(ctx, tx, req.GetId()); err != nil {
+		return nil, status.Errorf(codes.Internal, "promote waitlist: %v", err)
+	}
+	if err := helpers.RecordAudit(ctx, tx, helpers.AuditEvent, req.GetId(), "update", title); err != nil {
+		return nil, status.Errorf(codes.Internal, "record audit: %v", err)
 	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit: %v", err)
+	}
+	helpers.PublishEventChanged(ctx, req.GetId())
 	return helpers.LoadEventDetails(ctx, db, req.GetId(), userID)
 }
+
+// updateFutureOccurrences copies the edited columns to later occurrences and
+// the series template, moving their start times by the same shift (in
+// seconds). nil offsets leave their reminders alone.
+func updateFutureOccurrences(ctx context.Context, tx *sql.Tx, eventID string, cols []eventColumn, offsets []int32, seriesID string, from sql.NullTime, shift float64) error {
+	// Shifting by a multiple of the interval swaps occurrence slots mid-statement.
+	if _, err := tx.ExecContext(ctx, `SET CONSTRAINTS uniq_event_series_occurrence DEFERRED`); err != nil {
+		return err
+	}
+	sets, vals := assignments(cols, 4)
+	sets = append(sets,
+		"start_at = CASE WHEN id = $4 THEN start_at ELSE start_at + make_interval(secs => $1) END",
+		"occurrence_at = occurrence_at + make_interval(secs => $1)",
+		"updated_at = NOW()", "version = version + CASE WHEN id = $4 THEN 0 ELSE 1 END")
+	rows, err := tx.QueryContext(ctx, `
+		UPDATE event
+		SET `+strings.Join(sets, ", ")+`
+		WHERE series_id = $2 AND (occurrence_at > $3 OR id = $4) AND deleted_at IS NULL
+		RETURNING id
+	`, append([]any{shift, seriesID, from, eventID}, vals...)...)
+	if err != nil {
+		return err
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return err
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if err := setEventNotifications(ctx, tx, ids, offsets, shift != 0); err != nil {
+		return err
+	}
+
+	if offsets != nil {
+		cols = append(cols, eventColumn{"notify_offsets", pq.Array(offsets)})
+	}
+	sets, vals = assignments(cols, 2)
+	sets = append(sets,
+		"dtstart = dtstart + make_interval(secs => $1)",
+		"materialized_until = materialized_until + make_interval(secs => $1)")
+	_, err = tx.ExecContext(ctx, `
+		UPDATE event_series
+		SET `+strings.Join(sets, ", ")+`
+		WHERE id = $2
+	`, append([]any{shift, seriesID}, vals...)...)
+	return err
+}
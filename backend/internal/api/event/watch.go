@@ -0,0 +1,47 @@
+package event
+
+import (
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *EventService) WatchEvent(req *proto.EventId, stream grpc.ServerStreamingServer[proto.EventDetails]) error {
+	ctx := stream.Context()
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+	hub := helpers.HubFromCtx(ctx)
+	if hub == nil {
+		return status.Error(codes.Unavailable, "live updates are not available")
+	}
+	currentUserID, _ := helpers.UserIDFromCtx(ctx)
+
+	// Subscribe before the first load so no change between the two is missed.
+	changes, unsubscribe := hub.Subscribe(helpers.EventTopic(req.GetId()))
+	defer unsubscribe()
+
+	for {
+		details, err := helpers.LoadEventDetails(ctx, db, req.GetId(), currentUserID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return status.Error(codes.NotFound, "event not found")
+			}
+			return status.Errorf(codes.Internal, "get event: %v", err)
+		}
+		if err := stream.Send(details); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-changes:
+		}
+	}
+}
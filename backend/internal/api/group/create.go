@@ -0,0 +1,49 @@
+package group
+
+import (
+	"context"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GroupService) CreateGroup(ctx context.Context, req *proto.GroupInput) (*proto.GroupDetails, error) {
+	userID, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	in, err := normalizeInput(req)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+
+	taken, err := nameTaken(ctx, tx, in.GetName(), "")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "check name: %v", err)
+	}
+	if taken {
+		return nil, status.Error(codes.AlreadyExists, "a group with this name already exists")
+	}
+	var id string
+	if err := tx.QueryRowContext(ctx, `
+		INSERT INTO member_group (name, description, mention_on_song_changes, created_by)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id
+	`, in.GetName(), in.GetDescription(), in.GetMentionOnSongChanges(), userID).Scan(&id); err != nil {
+		return nil, status.Errorf(codes.Internal, "insert group: %v", err)
+	}
+	if err := helpers.RecordAudit(ctx, tx, helpers.AuditGroup, id, "create", in.GetName()); err != nil {
+		return nil, status.Errorf(codes.Internal, "record audit: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit: %v", err)
+	}
+	return loadGroup(ctx, db, id)
+}
@@ -0,0 +1,40 @@
+package group
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *GroupService) DeleteGroup(ctx context.Context, req *proto.GroupId) (*emptypb.Empty, error) {
+	_, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+
+	var name string
+	err = tx.QueryRowContext(ctx, `DELETE FROM member_group WHERE id::text = $1 RETURNING name`, req.GetId()).Scan(&name)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "group not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "delete group: %v", err)
+	}
+	if err := helpers.RecordAudit(ctx, tx, helpers.AuditGroup, req.GetId(), "delete", name); err != nil {
+		return nil, status.Errorf(codes.Internal, "record audit: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
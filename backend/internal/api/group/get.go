@@ -0,0 +1,15 @@
+package group
+
+import (
+	"context"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+)
+
+func (s *GroupService) GetGroup(ctx context.Context, req *proto.GroupId) (*proto.GroupDetails, error) {
+	_, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return loadGroup(ctx, db, req.GetId())
+}
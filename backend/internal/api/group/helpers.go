@@ -0,0 +1,91 @@
+package group
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/proto"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxGroupName        = 64
+	maxGroupDescription = 500
+)
+
+// groupColumns selects everything scanGroup expects from "member_group g".
+const groupColumns = `g.id, g.name, g.description, g.mention_on_song_changes,
+	(SELECT COUNT(*) FROM member_group_member WHERE group_id = g.id)`
+
+func scanGroup(row interface{ Scan(...any) error }) (*proto.Group, error) {
+	var g proto.Group
+	if err := row.Scan(&g.Id, &g.Name, &g.Description, &g.MentionOnSongChanges, &g.MemberCount); err != nil {
+		return nil, err
+	}
+	return &g, nil
+}
+
+// normalizeInput trims the fields and checks the lengths.
+func normalizeInput(in *proto.GroupInput) (*proto.GroupInput, error) {
+	out := &proto.GroupInput{
+		Name:                 strings.TrimSpace(in.GetName()),
+		Description:          strings.TrimSpace(in.GetDescription()),
+		MentionOnSongChanges: in.GetMentionOnSongChanges(),
+	}
+	if utf8.RuneCountInString(out.Name) > maxGroupName {
+		return nil, status.Errorf(codes.InvalidArgument, "name must be at most %d characters", maxGroupName)
+	}
+	if utf8.RuneCountInString(out.Description) > maxGroupDescription {
+		return nil, status.Errorf(codes.InvalidArgument, "description must be at most %d characters", maxGroupDescription)
+	}
+	return out, nil
+}
+
+// nameTaken reports whether another group than id already has the name,
+// ignoring case.
+func nameTaken(ctx context.Context, tx *sql.Tx, name, id string) (bool, error) {
+	var taken bool
+	err := tx.QueryRowContext(ctx, `
+		SELECT EXISTS(SELECT 1 FROM member_group WHERE lower(name) = lower($1) AND id::text <> $2)
+	`, name, id).Scan(&taken)
+	return taken, err
+}
+
+// loadGroup returns the group with its members, or NotFound.
+func loadGroup(ctx context.Context, db *sql.DB, id string) (*proto.GroupDetails, error) {
+	g, err := scanGroup(db.QueryRowContext(ctx, `SELECT `+groupColumns+` FROM member_group g WHERE g.id::text = $1`, id))
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "group not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load group: %v", err)
+	}
+	rows, err := db.QueryContext(ctx, `
+		SELECT u.id, u.display_name, COALESCE(u.username, ''), COALESCE(u.avatar_url, ''), COALESCE(u.tg_user_id, 0)
+		FROM member_group_member m
+		JOIN app_user u ON u.id = m.user_id
+		WHERE m.group_id = $1
+		ORDER BY u.display_name, u.id
+	`, g.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load group members: %v", err)
+	}
+	defer rows.Close()
+	details := &proto.GroupDetails{Group: g}
+	for rows.Next() {
+		u := &proto.User{}
+		var tgID int64
+		if err := rows.Scan(&u.Id, &u.DisplayName, &u.Username, &u.AvatarUrl, &tgID); err != nil {
+			return nil, status.Errorf(codes.Internal, "scan group member: %v", err)
+		}
+		u.TelegramId = uint64(tgID)
+		details.Members = append(details.Members, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "iterate group members: %v", err)
+	}
+	return details, nil
+}
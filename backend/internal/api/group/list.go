@@ -0,0 +1,35 @@
+package group
+
+import (
+	"context"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *GroupService) ListGroups(ctx context.Context, _ *emptypb.Empty) (*proto.ListGroupsResponse, error) {
+	_, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := db.QueryContext(ctx, `SELECT `+groupColumns+` FROM member_group g ORDER BY lower(g.name), g.id`)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list groups: %v", err)
+	}
+	defer rows.Close()
+	resp := &proto.ListGroupsResponse{}
+	for rows.Next() {
+		g, err := scanGroup(rows)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "scan group: %v", err)
+		}
+		resp.Groups = append(resp.Groups, g)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "iterate groups: %v", err)
+	}
+	return resp, nil
+}
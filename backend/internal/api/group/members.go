@@ -0,0 +1,74 @@
+package group
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GroupService) AddGroupMembers(ctx context.Context, req *proto.GroupMembersRequest) (*proto.GroupDetails, error) {
+	return changeMembers(ctx, req, "add_members", `
+		INSERT INTO member_group_member (group_id, user_id)
+		SELECT $1, u.id FROM app_user u WHERE u.id::text = ANY($2)
+		ON CONFLICT DO NOTHING`)
+}
+
+func (s *GroupService) RemoveGroupMembers(ctx context.Context, req *proto.GroupMembersRequest) (*proto.GroupDetails, error) {
+	return changeMembers(ctx, req, "remove_members", `
+		DELETE FROM member_group_member WHERE group_id = $1 AND user_id::text = ANY($2)`)
+}
+
+// changeMembers runs query with the group id and the user ids, failing with
+// NotFound when the group or any of the users doesn't exist.
+func changeMembers(ctx context.Context, req *proto.GroupMembersRequest, action, query string) (*proto.GroupDetails, error) {
+	_, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.GetUserIds()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_ids are required")
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+
+	var groupID string
+	err = tx.QueryRowContext(ctx, `SELECT id FROM member_group WHERE id::text = $1 FOR UPDATE`, req.GetGroupId()).Scan(&groupID)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "group not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load group: %v", err)
+	}
+	var missing int
+	if err := tx.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM unnest($1::text[]) AS wanted(uid)
+		WHERE NOT EXISTS (SELECT 1 FROM app_user u WHERE u.id::text = wanted.uid)
+	`, pq.Array(req.GetUserIds())).Scan(&missing); err != nil {
+		return nil, status.Errorf(codes.Internal, "check users: %v", err)
+	}
+	if missing > 0 {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	res, err := tx.ExecContext(ctx, query, groupID, pq.Array(req.GetUserIds()))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "update group members: %v", err)
+	}
+	if affected, _ := res.RowsAffected(); affected > 0 {
+		if err := helpers.RecordAudit(ctx, tx, helpers.AuditGroup, groupID, action, fmt.Sprintf("%d members", affected)); err != nil {
+			return nil, status.Errorf(codes.Internal, "record audit: %v", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit: %v", err)
+	}
+	return loadGroup(ctx, db, groupID)
+}
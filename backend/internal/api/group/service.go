@@ -0,0 +1,10 @@
+package group
+
+import (
+	"musicclubbot/backend/proto"
+)
+
+// GroupService implements member group endpoints.
+type GroupService struct {
+	proto.UnimplementedGroupServiceServer
+}
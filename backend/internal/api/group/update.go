@@ -0,0 +1,51 @@
+package group
+
+import (
+	"context"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GroupService) UpdateGroup(ctx context.Context, req *proto.UpdateGroupRequest) (*proto.GroupDetails, error) {
+	_, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	in, err := normalizeInput(req.GetGroup())
+	if err != nil {
+		return nil, err
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+
+	taken, err := nameTaken(ctx, tx, in.GetName(), req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "check name: %v", err)
+	}
+	if taken {
+		return nil, status.Error(codes.AlreadyExists, "a group with this name already exists")
+	}
+	res, err := tx.ExecContext(ctx, `
+		UPDATE member_group SET name = $2, description = $3, mention_on_song_changes = $4
+		WHERE id::text = $1
+	`, req.GetId(), in.GetName(), in.GetDescription(), in.GetMentionOnSongChanges())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "update group: %v", err)
+	}
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		return nil, status.Error(codes.NotFound, "group not found")
+	}
+	if err := helpers.RecordAudit(ctx, tx, helpers.AuditGroup, req.GetId(), "update", in.GetName()); err != nil {
+		return nil, status.Errorf(codes.Internal, "record audit: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit: %v", err)
+	}
+	return loadGroup(ctx, db, req.GetId())
+}
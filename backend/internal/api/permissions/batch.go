@@ -0,0 +1,133 @@
+package permissions
+
+import (
+	"context"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"slices"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxBatchUsers = 200
+
+func (s *PermissionsService) BatchUpdatePermissions(ctx context.Context, req *proto.BatchUpdatePermissionsRequest) (*proto.BatchUpdatePermissionsResponse, error) {
+	actorID, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var userIDs []string
+	for _, id := range req.GetUserIds() {
+		if !slices.Contains(userIDs, id) {
+			userIDs = append(userIDs, id)
+		}
+	}
+	if len(userIDs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_ids are required")
+	}
+	if len(userIDs) > maxBatchUsers {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d users at once", maxBatchUsers)
+	}
+	patch := req.GetPatch()
+	addRoles := normalizeRoles(patch.GetAddRoles())
+	removeRoles := normalizeRoles(patch.GetRemoveRoles())
+	grant := helpers.PermissionSetNames(patch.GetGrant())
+	revoke := helpers.PermissionSetNames(patch.GetRevoke())
+	if len(addRoles)+len(removeRoles)+len(grant)+len(revoke) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "patch changes nothing")
+	}
+	for _, r := range addRoles {
+		if slices.Contains(removeRoles, r) {
+			return nil, status.Errorf(codes.InvalidArgument, "role %q is both added and removed", r)
+		}
+	}
+	for _, p := range grant {
+		if slices.Contains(revoke, p) {
+			return nil, status.Errorf(codes.InvalidArgument, "permission %q is both granted and revoked", p)
+		}
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+
+	// Locking in id order keeps overlapping batches from deadlocking.
+	rows, err := tx.QueryContext(ctx, `
+		SELECT id::text FROM app_user WHERE id::text = ANY($1) ORDER BY id FOR UPDATE
+	`, pq.Array(userIDs))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load users: %v", err)
+	}
+	found := map[string]bool{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, status.Errorf(codes.Internal, "scan user: %v", err)
+		}
+		found[id] = true
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "iterate users: %v", err)
+	}
+	for _, id := range userIDs {
+		if !found[id] {
+			return nil, status.Errorf(codes.NotFound, "user %s not found", id)
+		}
+	}
+	if err := checkRoles(ctx, tx, addRoles); err != nil {
+		return nil, err
+	}
+
+	var changed []string
+	for _, userID := range userIDs {
+		rolesBefore, err := helpers.LoadUserRoles(ctx, tx, userID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "load roles: %v", err)
+		}
+		grantedBefore, err := helpers.LoadPermissionFlags(ctx, tx, "user_permissions", userID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "load permissions: %v", err)
+		}
+		roles := normalizeRoles(append(slices.DeleteFunc(rolesBefore, func(r string) bool {
+			return slices.Contains(removeRoles, r)
+		}), addRoles...))
+		for _, name := range grant {
+			*helpers.PermissionFlag(grantedBefore, name) = true
+		}
+		for _, name := range revoke {
+			*helpers.PermissionFlag(grantedBefore, name) = false
+		}
+		ok, err := setPermissions(ctx, tx, actorID, userID, roles, helpers.PermissionSetNames(grantedBefore), req.GetReason())
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			changed = append(changed, userID)
+		}
+	}
+	if len(changed) > 0 {
+		if err := checkManaged(ctx, tx); err != nil {
+			return nil, err
+		}
+		if err := tx.Commit(); err != nil {
+			return nil, status.Errorf(codes.Internal, "commit: %v", err)
+		}
+		helpers.InvalidatePermissions(ctx, changed...)
+	}
+
+	resp := &proto.BatchUpdatePermissionsResponse{}
+	for _, userID := range userIDs {
+		up, err := loadResult(ctx, db, userID)
+		if err != nil {
+			return nil, err
+		}
+		resp.Users = append(resp.Users, up)
+	}
+	return resp, nil
+}
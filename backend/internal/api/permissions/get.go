@@ -0,0 +1,26 @@
+package permissions
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *PermissionsService) GetUserPermissions(ctx context.Context, req *proto.UserPermissionsRequest) (*proto.UserPermissions, error) {
+	_, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	up, err := loadUserPermissions(ctx, db, req.GetUserId())
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load permissions: %v", err)
+	}
+	return up, nil
+}
@@ -0,0 +1,28 @@
+package permissions
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+)
+
+// loadUserPermissions returns sql.ErrNoRows for unknown users.
+func loadUserPermissions(ctx context.Context, db *sql.DB, userID string) (*proto.UserPermissions, error) {
+	var u proto.User
+	if err := db.QueryRowContext(ctx, `
+		SELECT id, display_name, COALESCE(username, ''), COALESCE(avatar_url, '')
+		FROM app_user WHERE id::text = $1
+	`, userID).Scan(&u.Id, &u.DisplayName, &u.Username, &u.AvatarUrl); err != nil {
+		return nil, err
+	}
+	effective, err := helpers.LoadPermissions(ctx, db, u.Id)
+	if err != nil {
+		return nil, err
+	}
+	granted, err := helpers.LoadPermissionFlags(ctx, db, "user_permissions", u.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.UserPermissions{User: &u, Roles: effective.GetRoles(), Granted: granted, Effective: effective}, nil
+}
@@ -0,0 +1,69 @@
+package permissions
+
+import (
+	"context"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *PermissionsService) ListUsersWithPermission(ctx context.Context, req *proto.ListUsersWithPermissionRequest) (*proto.ListUsersWithPermissionResponse, error) {
+	_, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var query string
+	var args []any
+	switch {
+	case req.GetPermission() != "" && req.GetRole() != "":
+		return nil, status.Error(codes.InvalidArgument, "set either permission or role")
+	case req.GetPermission() != "":
+		if !helpers.ValidPermissionName(req.GetPermission()) {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown permission %q", req.GetPermission())
+		}
+		// The name is checked against the known columns above.
+		query = `
+			SELECT u.id FROM effective_permissions p JOIN app_user u ON u.id = p.user_id
+			WHERE p.` + req.GetPermission() + `
+			ORDER BY u.display_name, u.id`
+	case req.GetRole() != "":
+		query = `
+			SELECT u.id FROM user_role r JOIN app_user u ON u.id = r.user_id
+			WHERE r.role = $1
+			ORDER BY u.display_name, u.id`
+		args = append(args, req.GetRole())
+	default:
+		return nil, status.Error(codes.InvalidArgument, "permission or role is required")
+	}
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list users: %v", err)
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, status.Errorf(codes.Internal, "scan user: %v", err)
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "iterate users: %v", err)
+	}
+
+	resp := &proto.ListUsersWithPermissionResponse{}
+	for _, id := range ids {
+		up, err := loadUserPermissions(ctx, db, id)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "load permissions: %v", err)
+		}
+		resp.Users = append(resp.Users, up)
+	}
+	return resp, nil
+}
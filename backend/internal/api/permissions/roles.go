@@ -0,0 +1,41 @@
+package permissions
+
+import (
+	"context"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *PermissionsService) ListRoles(ctx context.Context, _ *emptypb.Empty) (*proto.ListRolesResponse, error) {
+	_, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := db.QueryContext(ctx, `SELECT name, description, `+strings.Join(helpers.PermissionNames, ", ")+` FROM role ORDER BY name`)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list roles: %v", err)
+	}
+	defer rows.Close()
+
+	resp := &proto.ListRolesResponse{}
+	for rows.Next() {
+		r := &proto.Role{Permissions: helpers.NewPermissionSet()}
+		dest := []any{&r.Name, &r.Description}
+		for _, name := range helpers.PermissionNames {
+			dest = append(dest, helpers.PermissionFlag(r.Permissions, name))
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, status.Errorf(codes.Internal, "scan role: %v", err)
+		}
+		resp.Roles = append(resp.Roles, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "iterate roles: %v", err)
+	}
+	return resp, nil
+}
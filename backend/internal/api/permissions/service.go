@@ -0,0 +1,10 @@
+package permissions
+
+import (
+	"musicclubbot/backend/proto"
+)
+
+// PermissionsService implements permission management endpoints.
+type PermissionsService struct {
+	proto.UnimplementedPermissionsServiceServer
+}
@@ -0,0 +1,170 @@
+package permissions
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *PermissionsService) UpdateUserPermissions(ctx context.Context, req *proto.UpdateUserPermissionsRequest) (*proto.UserPermissions, error) {
+	actorID, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	roles := normalizeRoles(req.GetRoles())
+	granted := helpers.PermissionSetNames(req.GetGranted())
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+
+	var userID string
+	err = tx.QueryRowContext(ctx, `SELECT id FROM app_user WHERE id::text = $1 FOR UPDATE`, req.GetUserId()).Scan(&userID)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load user: %v", err)
+	}
+	if err := checkRoles(ctx, tx, roles); err != nil {
+		return nil, err
+	}
+	changed, err := setPermissions(ctx, tx, actorID, userID, roles, granted, req.GetReason())
+	if err != nil {
+		return nil, err
+	}
+	if !changed {
+		return loadResult(ctx, db, userID)
+	}
+	if err := checkManaged(ctx, tx); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit: %v", err)
+	}
+	helpers.InvalidatePermissions(ctx, userID)
+	return loadResult(ctx, db, userID)
+}
+
+// checkRoles fails with InvalidArgument unless every role exists.
+func checkRoles(ctx context.Context, tx *sql.Tx, roles []string) error {
+	var known int
+	if err := tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM role WHERE name = ANY($1)`, pq.Array(roles)).Scan(&known); err != nil {
+		return status.Errorf(codes.Internal, "check roles: %v", err)
+	}
+	if known != len(roles) {
+		return status.Error(codes.InvalidArgument, "unknown role")
+	}
+	return nil
+}
+
+// checkManaged fails unless someone is left who can manage permissions.
+func checkManaged(ctx context.Context, tx *sql.Tx) error {
+	var managed bool
+	if err := tx.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM effective_permissions WHERE manage_permissions)`).Scan(&managed); err != nil {
+		return status.Errorf(codes.Internal, "check admins: %v", err)
+	}
+	if !managed {
+		return status.Error(codes.FailedPrecondition, "someone has to keep manage_permissions")
+	}
+	return nil
+}
+
+// setPermissions replaces the roles and granted permissions of a user locked
+// in tx and records the change. It reports whether anything changed; the
+// caller checks manage_permissions and invalidates the cache after commit.
+func setPermissions(ctx context.Context, tx *sql.Tx, actorID, userID string, roles, granted []string, reason string) (bool, error) {
+	rolesBefore, err := helpers.LoadUserRoles(ctx, tx, userID)
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "load roles: %v", err)
+	}
+	grantedBefore, err := helpers.LoadPermissionFlags(ctx, tx, "user_permissions", userID)
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "load permissions: %v", err)
+	}
+	before := helpers.PermissionSetNames(grantedBefore)
+	if slices.Equal(rolesBefore, roles) && slices.Equal(before, granted) {
+		return false, nil
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM user_role WHERE user_id = $1`, userID); err != nil {
+		return false, status.Errorf(codes.Internal, "clear roles: %v", err)
+	}
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO user_role (user_id, role) SELECT $1, unnest($2::text[])
+	`, userID, pq.Array(roles)); err != nil {
+		return false, status.Errorf(codes.Internal, "assign roles: %v", err)
+	}
+	if err := storeGranted(ctx, tx, userID, granted); err != nil {
+		return false, status.Errorf(codes.Internal, "store permissions: %v", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO permission_change (user_id, actor_id, roles_before, roles_after, granted_before, granted_after, reason)
+		VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, ''))
+	`, userID, actorID, pq.Array(nonNil(rolesBefore)), pq.Array(roles), pq.Array(nonNil(before)), pq.Array(nonNil(granted)),
+		strings.TrimSpace(reason)); err != nil {
+		return false, status.Errorf(codes.Internal, "record change: %v", err)
+	}
+	summary := "roles: " + strings.Join(roles, ", ") + "; granted: " + strings.Join(granted, ", ")
+	if err := helpers.RecordAudit(ctx, tx, helpers.AuditPermissions, userID, "update", summary); err != nil {
+		return false, status.Errorf(codes.Internal, "record audit: %v", err)
+	}
+	return true, nil
+}
+
+func loadResult(ctx context.Context, db *sql.DB, userID string) (*proto.UserPermissions, error) {
+	up, err := loadUserPermissions(ctx, db, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load permissions: %v", err)
+	}
+	return up, nil
+}
+
+// storeGranted replaces the user's individually granted permissions.
+func storeGranted(ctx context.Context, tx *sql.Tx, userID string, granted []string) error {
+	args := []any{userID}
+	params := make([]string, len(helpers.PermissionNames))
+	updates := make([]string, len(helpers.PermissionNames))
+	for i, name := range helpers.PermissionNames {
+		args = append(args, slices.Contains(granted, name))
+		params[i] = "$" + strconv.Itoa(i+2)
+		updates[i] = name + " = EXCLUDED." + name
+	}
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO user_permissions (user_id, `+strings.Join(helpers.PermissionNames, ", ")+`)
+		VALUES ($1, `+strings.Join(params, ", ")+`)
+		ON CONFLICT (user_id) DO UPDATE SET `+strings.Join(updates, ", "), args...)
+	return err
+}
+
+// normalizeRoles lowercases and trims role names, dropping empty ones and
+// duplicates, sorted like LoadUserRoles returns them.
+func normalizeRoles(roles []string) []string {
+	out := []string{}
+	for _, r := range roles {
+		r = strings.ToLower(strings.TrimSpace(r))
+		if r != "" && !slices.Contains(out, r) {
+			out = append(out, r)
+		}
+	}
+	slices.Sort(out)
+	return out
+}
+
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
@@ -1,15 +1,39 @@
 package api
 
 import (
+	"context"
+	"errors"
+
+	"musicclubbot/backend/internal/api/admin"
 	"musicclubbot/backend/internal/api/auth"
+	"musicclubbot/backend/internal/api/dashboard"
+	"musicclubbot/backend/internal/api/dues"
 	"musicclubbot/backend/internal/api/event"
+	"musicclubbot/backend/internal/api/group"
+	"musicclubbot/backend/internal/api/permissions"
+	"musicclubbot/backend/internal/api/season"
 	"musicclubbot/backend/internal/api/song"
+	"musicclubbot/backend/internal/api/stats"
+	"musicclubbot/backend/internal/api/user"
+	"musicclubbot/backend/internal/api/venue"
+	"musicclubbot/backend/internal/api/voting"
 
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
 
+	adminpb "musicclubbot/backend/proto"
 	authpb "musicclubbot/backend/proto"
+	dashboardpb "musicclubbot/backend/proto"
+	duespb "musicclubbot/backend/proto"
 	eventpb "musicclubbot/backend/proto"
+	grouppb "musicclubbot/backend/proto"
+	permissionspb "musicclubbot/backend/proto"
+	seasonpb "musicclubbot/backend/proto"
 	songpb "musicclubbot/backend/proto"
+	statspb "musicclubbot/backend/proto"
+	userpb "musicclubbot/backend/proto"
+	venuepb "musicclubbot/backend/proto"
+	votingpb "musicclubbot/backend/proto"
 )
 
 // Register wires all service handlers to the gRPC server.
@@ -17,4 +41,34 @@ func Register(server *grpc.Server) {
 	authpb.RegisterAuthServiceServer(server, &auth.AuthService{})
 	songpb.RegisterSongServiceServer(server, &song.SongService{})
 	eventpb.RegisterEventServiceServer(server, &event.EventService{})
+	venuepb.RegisterVenueServiceServer(server, &venue.VenueService{})
+	seasonpb.RegisterSeasonServiceServer(server, &season.SeasonService{})
+	permissionspb.RegisterPermissionsServiceServer(server, &permissions.PermissionsService{})
+	adminpb.RegisterAdminServiceServer(server, &admin.AdminService{})
+	userpb.RegisterUserServiceServer(server, &user.UserService{})
+	statspb.RegisterStatsServiceServer(server, &stats.StatsService{})
+	dashboardpb.RegisterDashboardServiceServer(server, &dashboard.DashboardService{})
+	votingpb.RegisterVotingServiceServer(server, &voting.VotingService{})
+	duespb.RegisterDuesServiceServer(server, &dues.DuesService{})
+	grouppb.RegisterGroupServiceServer(server, &group.GroupService{})
+}
+
+// RegisterGateway exposes every service on mux as JSON over HTTP, calling
+// them through conn.
+func RegisterGateway(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return errors.Join(
+		authpb.RegisterAuthServiceHandler(ctx, mux, conn),
+		songpb.RegisterSongServiceHandler(ctx, mux, conn),
+		eventpb.RegisterEventServiceHandler(ctx, mux, conn),
+		venuepb.RegisterVenueServiceHandler(ctx, mux, conn),
+		seasonpb.RegisterSeasonServiceHandler(ctx, mux, conn),
+		permissionspb.RegisterPermissionsServiceHandler(ctx, mux, conn),
+		adminpb.RegisterAdminServiceHandler(ctx, mux, conn),
+		userpb.RegisterUserServiceHandler(ctx, mux, conn),
+		statspb.RegisterStatsServiceHandler(ctx, mux, conn),
+		dashboardpb.RegisterDashboardServiceHandler(ctx, mux, conn),
+		votingpb.RegisterVotingServiceHandler(ctx, mux, conn),
+		duespb.RegisterDuesServiceHandler(ctx, mux, conn),
+		grouppb.RegisterGroupServiceHandler(ctx, mux, conn),
+	)
 }
@@ -0,0 +1,34 @@
+package season
+
+import (
+	"context"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *SeasonService) CreateSeason(ctx context.Context, req *proto.SeasonInput) (*proto.Season, error) {
+	userID, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	in, err := normalizeInput(req)
+	if err != nil {
+		return nil, err
+	}
+	var id string
+	if err := db.QueryRowContext(ctx, `
+		INSERT INTO season (name, starts_at, ends_at, created_by)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id
+	`, in.name, in.startsAt, in.endsAt, userID).Scan(&id); err != nil {
+		return nil, status.Errorf(codes.Internal, "insert season: %v", err)
+	}
+	season, err := helpers.LoadSeason(ctx, db, id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load season: %v", err)
+	}
+	return season, nil
+}
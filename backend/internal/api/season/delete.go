@@ -0,0 +1,26 @@
+package season
+
+import (
+	"context"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *SeasonService) DeleteSeason(ctx context.Context, req *proto.SeasonId) (*emptypb.Empty, error) {
+	_, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := db.ExecContext(ctx, `DELETE FROM season WHERE id = $1`, req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "delete season: %v", err)
+	}
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		return nil, status.Error(codes.NotFound, "season not found")
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,26 @@
+package season
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *SeasonService) GetSeason(ctx context.Context, req *proto.SeasonId) (*proto.Season, error) {
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	season, err := helpers.LoadSeason(ctx, db, req.GetId())
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "season not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load season: %v", err)
+	}
+	return season, nil
+}
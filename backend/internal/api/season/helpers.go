@@ -0,0 +1,31 @@
+package season
+
+import (
+	"database/sql"
+	"musicclubbot/backend/proto"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// seasonInput is a validated SeasonInput with optional bounds.
+type seasonInput struct {
+	name     string
+	startsAt sql.NullTime
+	endsAt   sql.NullTime
+}
+
+func normalizeInput(in *proto.SeasonInput) (seasonInput, error) {
+	out := seasonInput{name: strings.TrimSpace(in.GetName())}
+	if ts := in.GetStartsAt(); ts != nil {
+		out.startsAt = sql.NullTime{Valid: true, Time: ts.AsTime()}
+	}
+	if ts := in.GetEndsAt(); ts != nil {
+		out.endsAt = sql.NullTime{Valid: true, Time: ts.AsTime()}
+	}
+	if out.startsAt.Valid && out.endsAt.Valid && !out.endsAt.Time.After(out.startsAt.Time) {
+		return out, status.Error(codes.InvalidArgument, "ends_at must be after starts_at")
+	}
+	return out, nil
+}
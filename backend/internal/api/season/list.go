@@ -0,0 +1,39 @@
+package season
+
+import (
+	"context"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *SeasonService) ListSeasons(ctx context.Context, _ *emptypb.Empty) (*proto.ListSeasonsResponse, error) {
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := db.QueryContext(ctx, `
+		SELECT `+helpers.SeasonColumns+`
+		FROM season s
+		ORDER BY COALESCE(s.starts_at, s.created_at) DESC
+	`)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list seasons: %v", err)
+	}
+	defer rows.Close()
+	var seasons []*proto.Season
+	for rows.Next() {
+		season, err := helpers.ScanSeason(rows)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "scan season: %v", err)
+		}
+		seasons = append(seasons, season)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "iterate seasons: %v", err)
+	}
+	return &proto.ListSeasonsResponse{Seasons: seasons}, nil
+}
@@ -0,0 +1,10 @@
+package season
+
+import (
+	"musicclubbot/backend/proto"
+)
+
+// SeasonService implements season endpoints.
+type SeasonService struct {
+	proto.UnimplementedSeasonServiceServer
+}
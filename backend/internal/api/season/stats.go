@@ -0,0 +1,74 @@
+package season
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// topSongsLimit bounds SeasonStats.top_songs.
+const topSongsLimit = 10
+
+func (s *SeasonService) GetSeasonStats(ctx context.Context, req *proto.SeasonId) (*proto.SeasonStats, error) {
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	season, err := helpers.LoadSeason(ctx, db, req.GetId())
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "season not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load season: %v", err)
+	}
+
+	stats := &proto.SeasonStats{Season: season}
+	if err := db.QueryRowContext(ctx, `
+		SELECT
+			COUNT(*) FILTER (WHERE e.completed_at IS NOT NULL),
+			COUNT(*) FILTER (WHERE e.cancelled_at IS NOT NULL),
+			(SELECT COUNT(*) FROM event_track_item ti JOIN event e2 ON e2.id = ti.event_id
+			 WHERE e2.season_id = $1 AND e2.deleted_at IS NULL AND ti.performed),
+			(SELECT COUNT(DISTINCT ti.song_id) FROM event_track_item ti JOIN event e2 ON e2.id = ti.event_id
+			 WHERE e2.season_id = $1 AND e2.deleted_at IS NULL AND ti.performed),
+			(SELECT COUNT(*) FROM event_attendance a JOIN event e2 ON e2.id = a.event_id WHERE e2.season_id = $1 AND e2.deleted_at IS NULL),
+			(SELECT COUNT(DISTINCT a.user_id) FROM event_attendance a JOIN event e2 ON e2.id = a.event_id WHERE e2.season_id = $1 AND e2.deleted_at IS NULL),
+			(SELECT COUNT(*) FROM event_feedback f JOIN event e2 ON e2.id = f.event_id WHERE e2.season_id = $1 AND e2.deleted_at IS NULL),
+			(SELECT COALESCE(AVG(f.rating), 0) FROM event_feedback f JOIN event e2 ON e2.id = f.event_id WHERE e2.season_id = $1 AND e2.deleted_at IS NULL)
+		FROM event e
+		WHERE e.season_id = $1 AND e.deleted_at IS NULL
+	`, req.GetId()).Scan(&stats.CompletedEvents, &stats.CancelledEvents, &stats.TracksPerformed, &stats.UniqueSongs,
+		&stats.Attendance, &stats.UniqueAttendees, &stats.FeedbackResponses, &stats.AverageRating); err != nil {
+		return nil, status.Errorf(codes.Internal, "load season totals: %v", err)
+	}
+
+	rows, err := db.QueryContext(ctx, `
+		SELECT s.id, s.title, s.artist, COUNT(*) AS performed
+		FROM event_track_item ti
+		JOIN event e ON e.id = ti.event_id
+		JOIN song s ON s.id = ti.song_id
+		WHERE e.season_id = $1 AND e.deleted_at IS NULL AND ti.performed
+		GROUP BY s.id, s.title, s.artist
+		ORDER BY performed DESC, s.title
+		LIMIT $2
+	`, req.GetId(), topSongsLimit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load top songs: %v", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		song := &proto.SeasonSongStat{}
+		if err := rows.Scan(&song.SongId, &song.Title, &song.Artist, &song.Performed); err != nil {
+			return nil, status.Errorf(codes.Internal, "scan top song: %v", err)
+		}
+		stats.TopSongs = append(stats.TopSongs, song)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "iterate top songs: %v", err)
+	}
+	return stats, nil
+}
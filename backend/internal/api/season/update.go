@@ -0,0 +1,36 @@
+package season
+
+import (
+	"context"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *SeasonService) UpdateSeason(ctx context.Context, req *proto.UpdateSeasonRequest) (*proto.Season, error) {
+	_, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	in, err := normalizeInput(req.GetSeason())
+	if err != nil {
+		return nil, err
+	}
+	res, err := db.ExecContext(ctx, `
+		UPDATE season SET name = $2, starts_at = $3, ends_at = $4
+		WHERE id = $1
+	`, req.GetId(), in.name, in.startsAt, in.endsAt)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "update season: %v", err)
+	}
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		return nil, status.Error(codes.NotFound, "season not found")
+	}
+	season, err := helpers.LoadSeason(ctx, db, req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load season: %v", err)
+	}
+	return season, nil
+}
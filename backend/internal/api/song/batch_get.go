@@ -0,0 +1,35 @@
+package song
+
+import (
+	"context"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *SongService) BatchGetSongs(ctx context.Context, req *proto.BatchGetSongsRequest) (*proto.BatchGetSongsResponse, error) {
+	// Parsing gives the ids the database's form.
+	ids := make([]string, 0, len(req.GetIds()))
+	for _, id := range req.GetIds() {
+		parsed, err := uuid.Parse(id)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid song id %q", id)
+		}
+		ids = append(ids, parsed.String())
+	}
+
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	currentUserID, _ := helpers.UserIDFromCtx(ctx)
+
+	songs, err := helpers.LoadSongDetailsBatch(ctx, db, ids, currentUserID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "batch get songs: %v", err)
+	}
+	return &proto.BatchGetSongsResponse{Songs: songs}, nil
+}
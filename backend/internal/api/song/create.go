@@ -4,27 +4,17 @@ import (
 	"context"
 	"musicclubbot/backend/internal/helpers"
 	"musicclubbot/backend/proto"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 func (s *SongService) CreateSong(ctx context.Context, req *proto.CreateSongRequest) (*proto.SongDetails, error) {
-	userID, err := helpers.UserIDFromCtx(ctx)
+	userID, db, err := helpers.UserAndDbFromCtx(ctx)
 	if err != nil {
 		return nil, err
 	}
-	db, err := helpers.DbFromCtx(ctx)
-	if err != nil {
-		return nil, err
-	}
-	perms, err := helpers.LoadPermissions(ctx, db, userID)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "load permissions: %v", err)
-	}
-	if perms.Songs == nil || (!perms.Songs.EditOwnSongs && !perms.Songs.EditAnySongs) {
-		return nil, status.Error(codes.PermissionDenied, "no rights to create songs")
-	}
 
 	linkKind, err := helpers.MapSongLinkKindToDB(req.GetLink().GetKind())
 	if err != nil {
@@ -42,10 +32,11 @@ func (s *SongService) CreateSong(ctx context.Context, req *proto.CreateSongReque
 	defer tx.Rollback()
 
 	err = tx.QueryRowContext(ctx, `
-		INSERT INTO song (title, artist, description, link_kind, link_url, created_by, thumbnail_url)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		INSERT INTO song (title, artist, description, link_kind, link_url, created_by, thumbnail_url, duration_sec, musical_key)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 		RETURNING id
-	`, req.GetTitle(), req.GetArtist(), req.GetDescription(), linkKind, req.GetLink().GetUrl(), userID, thumbnailURL).Scan(&songID)
+	`, req.GetTitle(), req.GetArtist(), req.GetDescription(), linkKind, req.GetLink().GetUrl(), userID, thumbnailURL,
+		nullIfZero(req.GetDurationSeconds()), nullIfEmpty(strings.TrimSpace(req.GetKey()))).Scan(&songID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "insert song: %v", err)
 	}
@@ -54,6 +45,16 @@ func (s *SongService) CreateSong(ctx context.Context, req *proto.CreateSongReque
 		return nil, status.Errorf(codes.Internal, "set roles: %v", err)
 	}
 
+	if err := replaceSongTags(ctx, tx, songID, req.GetTags()); err != nil {
+		return nil, status.Errorf(codes.Internal, "set tags: %v", err)
+	}
+	if err := helpers.RecordAudit(ctx, tx, helpers.AuditSong, songID, "create", req.GetArtist()+" — "+req.GetTitle()); err != nil {
+		return nil, status.Errorf(codes.Internal, "record audit: %v", err)
+	}
+	if err := helpers.RecordActivity(ctx, tx, proto.ActivityKind_ACTIVITY_KIND_SONG_ADDED, songID, "", ""); err != nil {
+		return nil, status.Errorf(codes.Internal, "record activity: %v", err)
+	}
+
 	if err := tx.Commit(); err != nil {
 		return nil, status.Errorf(codes.Internal, "commit: %v", err)
 	}
@@ -26,8 +26,9 @@ func (s *SongService) DeleteSong(ctx context.Context, req *proto.SongId) (*empty
 	}
 
 	var creatorID sql.NullString
-	row := db.QueryRowContext(ctx, `SELECT COALESCE(created_by, NULL) FROM song WHERE id = $1`, req.GetId())
-	if err := row.Scan(&creatorID); err != nil {
+	var title, artist string
+	row := db.QueryRowContext(ctx, `SELECT COALESCE(created_by, NULL), title, artist FROM song WHERE id = $1`, req.GetId())
+	if err := row.Scan(&creatorID, &title, &artist); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, status.Error(codes.NotFound, "song not found")
 		}
@@ -37,8 +38,20 @@ func (s *SongService) DeleteSong(ctx context.Context, req *proto.SongId) (*empty
 		return nil, status.Error(codes.PermissionDenied, "no rights to delete song")
 	}
 
-	if _, err := db.ExecContext(ctx, `DELETE FROM song WHERE id = $1`, req.GetId()); err != nil {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+	if _, err := tx.ExecContext(ctx, `DELETE FROM song WHERE id = $1`, req.GetId()); err != nil {
 		return nil, status.Errorf(codes.Internal, "delete song: %v", err)
 	}
+	if err := helpers.RecordAudit(ctx, tx, helpers.AuditSong, req.GetId(), "delete", artist+" — "+title); err != nil {
+		return nil, status.Errorf(codes.Internal, "record audit: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit: %v", err)
+	}
+	helpers.PublishSongChanged(ctx, req.GetId())
 	return &emptypb.Empty{}, nil
 }
@@ -0,0 +1,60 @@
+package song
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *SongService) DeleteDemo(ctx context.Context, req *proto.DemoId) (*emptypb.Empty, error) {
+	userID, err := helpers.UserIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	store, err := helpers.StorageFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var songID, storageKey string
+	var uploaderID, creatorID sql.NullString
+	err = db.QueryRowContext(ctx, `
+		SELECT d.song_id, d.storage_key, d.uploaded_by, s.created_by
+		FROM song_demo d JOIN song s ON s.id = d.song_id
+		WHERE d.id = $1
+	`, req.GetId()).Scan(&songID, &storageKey, &uploaderID, &creatorID)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "demo not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load demo: %v", err)
+	}
+
+	if !uploaderID.Valid || uploaderID.String != userID {
+		perms, err := helpers.LoadPermissions(ctx, db, userID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "load permissions: %v", err)
+		}
+		if !helpers.PermissionAllowsSongEdit(perms, creatorID, userID) {
+			return nil, status.Error(codes.PermissionDenied, "no rights to delete demo")
+		}
+	}
+
+	if _, err := db.ExecContext(ctx, `DELETE FROM song_demo WHERE id = $1`, req.GetId()); err != nil {
+		return nil, status.Errorf(codes.Internal, "delete demo: %v", err)
+	}
+	if err := store.Delete(ctx, storageKey); err != nil {
+		return nil, status.Errorf(codes.Internal, "delete demo file: %v", err)
+	}
+	helpers.PublishSongChanged(ctx, songID)
+	return &emptypb.Empty{}, nil
+}
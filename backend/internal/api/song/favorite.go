@@ -0,0 +1,63 @@
+package song
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *SongService) FavoriteSong(ctx context.Context, req *proto.SongId) (*proto.SongDetails, error) {
+	userID, err := helpers.UserIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Selecting from song turns a missing song into a no-op; loading reports NotFound.
+	if _, err := db.ExecContext(ctx, `
+		INSERT INTO song_favorite (user_id, song_id)
+		SELECT $1, id FROM song WHERE id = $2
+		ON CONFLICT (user_id, song_id) DO NOTHING
+	`, userID, req.GetId()); err != nil {
+		return nil, status.Errorf(codes.Internal, "favorite song: %v", err)
+	}
+
+	return loadFavoriteResult(ctx, db, req.GetId(), userID)
+}
+
+func (s *SongService) UnfavoriteSong(ctx context.Context, req *proto.SongId) (*proto.SongDetails, error) {
+	userID, err := helpers.UserIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := db.ExecContext(ctx, `
+		DELETE FROM song_favorite WHERE user_id = $1 AND song_id = $2
+	`, userID, req.GetId()); err != nil {
+		return nil, status.Errorf(codes.Internal, "unfavorite song: %v", err)
+	}
+
+	return loadFavoriteResult(ctx, db, req.GetId(), userID)
+}
+
+func loadFavoriteResult(ctx context.Context, db *sql.DB, songID, userID string) (*proto.SongDetails, error) {
+	details, err := helpers.LoadSongDetails(ctx, db, songID, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "song not found")
+		}
+		return nil, status.Errorf(codes.Internal, "get song: %v", err)
+	}
+	return details, nil
+}
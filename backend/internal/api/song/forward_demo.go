@@ -0,0 +1,76 @@
+package song
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"html"
+	"mime"
+	"musicclubbot/backend/internal/config"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/internal/logging"
+	"musicclubbot/backend/internal/telegram"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *SongService) ForwardDemoToChat(ctx context.Context, req *proto.DemoId) (*emptypb.Empty, error) {
+	userID, err := helpers.UserIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	store, err := helpers.StorageFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cfg := ctx.Value("cfg").(config.Config)
+	if cfg.ChatID == "" || cfg.BotToken == "" {
+		return nil, status.Error(codes.FailedPrecondition, "telegram chat is not configured")
+	}
+
+	var songID, songTitle, artist, demoTitle, contentType, storageKey string
+	err = db.QueryRowContext(ctx, `
+		SELECT s.id, s.title, s.artist, d.title, d.content_type, d.storage_key
+		FROM song_demo d JOIN song s ON s.id = d.song_id
+		WHERE d.id = $1
+	`, req.GetId()).Scan(&songID, &songTitle, &artist, &demoTitle, &contentType, &storageKey)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "demo not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load demo: %v", err)
+	}
+
+	allowed, err := canUploadDemos(ctx, db, songID, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "check permissions: %v", err)
+	}
+	if !allowed {
+		return nil, status.Error(codes.PermissionDenied, "only song editors and participants can share demos")
+	}
+
+	audio, err := store.Open(ctx, storageKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "open demo: %v", err)
+	}
+	defer audio.Close()
+
+	filename := demoTitle
+	if exts, _ := mime.ExtensionsByType(contentType); len(exts) > 0 {
+		filename += exts[0]
+	}
+	caption := fmt.Sprintf("<b>%s — %s</b>\n%s",
+		html.EscapeString(artist), html.EscapeString(songTitle), html.EscapeString(demoTitle))
+	if err := telegram.New(cfg.BotToken).SendAudio(ctx, cfg.ChatID, filename, audio, caption); err != nil {
+		logging.FromContext(ctx).Error("forward demo to telegram", "demo_id", req.GetId(), "error", err)
+		return nil, status.Error(codes.Unavailable, "couldn't send the demo to telegram")
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -11,7 +11,7 @@ import (
 )
 
 func (s *SongService) GetSong(ctx context.Context, req *proto.SongId) (*proto.SongDetails, error) {
-	db, err := helpers.DbFromCtx(ctx)
+	db, err := helpers.ReadDbFromCtx(ctx)
 	if err != nil {
 		return nil, err
 	}
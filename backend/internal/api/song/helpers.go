@@ -3,16 +3,157 @@ package song
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"musicclubbot/backend/internal/helpers"
+	"strings"
+
+	"github.com/lib/pq"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
+// songMaskFields lists UpdateSongRequest fields that may appear in an update mask.
+var songMaskFields = []string{"title", "artist", "link", "description", "available_roles", "thumbnail_url", "tags", "duration_seconds", "key"}
+
+// songFullUpdateFields are replaced when the mask is empty. Tags, duration and
+// key came later, so clients that don't know about them must name them
+// explicitly.
+var songFullUpdateFields = []string{"title", "artist", "link", "description", "available_roles", "thumbnail_url"}
+
 func replaceSongRoles(ctx context.Context, tx *sql.Tx, songID string, roles []string) error {
 	if _, err := tx.ExecContext(ctx, `DELETE FROM song_role WHERE song_id = $1`, songID); err != nil {
 		return err
 	}
 	for _, r := range roles {
-		if _, err := tx.ExecContext(ctx, `INSERT INTO song_role (song_id, role) VALUES ($1, $2)`, songID, r); err != nil {
+		role, err := helpers.NormalizeRole(ctx, tx, r)
+		if err != nil {
+			return err
+		}
+		if role == "" {
+			continue
+		}
+		// Different spellings may collapse into the same canonical role.
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO song_role (song_id, role) VALUES ($1, $2)
+			ON CONFLICT (song_id, role) DO NOTHING
+		`, songID, role); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func replaceSongTags(ctx context.Context, tx *sql.Tx, songID string, tags []string) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM song_tag WHERE song_id = $1`, songID); err != nil {
+		return err
+	}
+	for _, t := range normalizeTags(tags) {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO song_tag (song_id, tag) VALUES ($1, $2)`, songID, t); err != nil {
 			return err
 		}
 	}
 	return nil
 }
+
+// normalizeTags lowercases and trims tags, dropping empty ones and duplicates.
+func normalizeTags(tags []string) []string {
+	seen := map[string]bool{}
+	var out []string
+	for _, t := range tags {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if t == "" || seen[t] {
+			continue
+		}
+		seen[t] = true
+		out = append(out, t)
+	}
+	return out
+}
+
+// songUpdateFields resolves an update mask into the set of fields to overwrite.
+// An empty mask selects songFullUpdateFields to keep full-replace clients working.
+func songUpdateFields(mask *fieldmaskpb.FieldMask) (map[string]bool, error) {
+	fields := map[string]bool{}
+	if len(mask.GetPaths()) == 0 {
+		for _, f := range songFullUpdateFields {
+			fields[f] = true
+		}
+		return fields, nil
+	}
+	for _, path := range mask.GetPaths() {
+		known := false
+		for _, f := range songMaskFields {
+			if path == f {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return nil, fmt.Errorf("unsupported update_mask path %q", path)
+		}
+		fields[path] = true
+	}
+	return fields, nil
+}
+
+// canUploadDemos reports whether the user may attach demos to the song: its
+// editors and anyone assigned to one of its roles.
+func canUploadDemos(ctx context.Context, db *sql.DB, songID, userID string) (bool, error) {
+	perms, err := helpers.LoadPermissions(ctx, db, userID)
+	if err != nil {
+		return false, err
+	}
+	var creatorID sql.NullString
+	var assigned bool
+	if err := db.QueryRowContext(ctx, `
+		SELECT created_by,
+		       EXISTS(SELECT 1 FROM song_role_assignment WHERE song_id = s.id AND user_id = $2)
+		FROM song s WHERE s.id = $1
+	`, songID, userID).Scan(&creatorID, &assigned); err != nil {
+		return false, err
+	}
+	return assigned || helpers.PermissionAllowsSongEdit(perms, creatorID, userID), nil
+}
+
+func nullIfZero(n uint32) interface{} {
+	if n == 0 {
+		return sql.NullInt64{}
+	}
+	return int64(n)
+}
+
+func nullIfEmpty(s string) interface{} {
+	if s == "" {
+		return sql.NullString{}
+	}
+	return s
+}
+
+// changedFields lists the fields of an update mask for the audit log.
+func changedFields(fields map[string]bool) string {
+	return strings.Join(changedFieldNames(fields), ", ")
+}
+
+func changedFieldNames(fields map[string]bool) []string {
+	names := []string{}
+	for _, name := range songMaskFields {
+		if fields[name] {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// queueSongChangeNotice has the edit announced to groups whose members play
+// in the song, merging it with edits not announced yet. Nothing is queued
+// while no group wants the mentions.
+func queueSongChangeNotice(ctx context.Context, tx *sql.Tx, songID, actorID string, fields []string) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO song_change_notice (song_id, actor_id, fields)
+		SELECT $1, $2, $3 WHERE EXISTS (SELECT 1 FROM member_group WHERE mention_on_song_changes)
+		ON CONFLICT (song_id) DO UPDATE
+		SET actor_id = EXCLUDED.actor_id,
+		    fields = ARRAY(SELECT DISTINCT unnest(song_change_notice.fields || EXCLUDED.fields) ORDER BY 1),
+		    changed_at = NOW()
+	`, songID, actorID, pq.Array(fields))
+	return err
+}
@@ -26,13 +26,25 @@ func (s *SongService) JoinRole(ctx context.Context, req *proto.JoinRoleRequest)
 		return nil, status.Error(codes.PermissionDenied, "no rights to join roles")
 	}
 
-	if _, err := db.ExecContext(ctx, `
+	role, err := helpers.NormalizeRole(ctx, db, req.GetRole())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "normalize role: %v", err)
+	}
+
+	res, err := db.ExecContext(ctx, `
 		INSERT INTO song_role_assignment (song_id, role, user_id)
 		VALUES ($1, $2, $3)
 		ON CONFLICT (song_id, role, user_id) DO NOTHING
-	`, req.GetSongId(), req.GetRole(), userID); err != nil {
+	`, req.GetSongId(), role, userID)
+	if err != nil {
 		return nil, status.Errorf(codes.Internal, "join role: %v", err)
 	}
+	if n, _ := res.RowsAffected(); n > 0 {
+		if err := helpers.RecordActivity(ctx, db, proto.ActivityKind_ACTIVITY_KIND_ROLE_JOINED, req.GetSongId(), "", role); err != nil {
+			return nil, status.Errorf(codes.Internal, "record activity: %v", err)
+		}
+	}
 
+	helpers.PublishSongChanged(ctx, req.GetSongId())
 	return helpers.LoadSongDetails(ctx, db, req.GetSongId(), userID)
 }
@@ -26,11 +26,18 @@ func (s *SongService) LeaveRole(ctx context.Context, req *proto.LeaveRoleRequest
 		return nil, status.Error(codes.PermissionDenied, "no rights to leave roles")
 	}
 
+	// Assignments made before normalization may still use the raw spelling.
+	role, err := helpers.NormalizeRole(ctx, db, req.GetRole())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "normalize role: %v", err)
+	}
+
 	if _, err := db.ExecContext(ctx, `
-		DELETE FROM song_role_assignment WHERE song_id = $1 AND role = $2 AND user_id = $3
-	`, req.GetSongId(), req.GetRole(), userID); err != nil {
+		DELETE FROM song_role_assignment WHERE song_id = $1 AND role IN ($2, $4) AND user_id = $3
+	`, req.GetSongId(), role, userID, req.GetRole()); err != nil {
 		return nil, status.Errorf(codes.Internal, "leave role: %v", err)
 	}
 
+	helpers.PublishSongChanged(ctx, req.GetSongId())
 	return helpers.LoadSongDetails(ctx, db, req.GetSongId(), userID)
 }
@@ -6,13 +6,14 @@ import (
 	"musicclubbot/backend/internal/helpers"
 	"musicclubbot/backend/proto"
 	"strconv"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 func (s *SongService) ListSongs(ctx context.Context, req *proto.ListSongsRequest) (*proto.ListSongsResponse, error) {
-	db, err := helpers.DbFromCtx(ctx)
+	db, err := helpers.ReadDbFromCtx(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -20,7 +21,7 @@ func (s *SongService) ListSongs(ctx context.Context, req *proto.ListSongsRequest
 	currentUserID, _ := helpers.UserIDFromCtx(ctx) // best effort; anonymous users just see editable=false
 
 	limit := int(req.GetPageSize())
-	if limit <= 0 || limit > 100 {
+	if limit == 0 {
 		limit = 20
 	}
 	offset := 0
@@ -30,18 +31,31 @@ func (s *SongService) ListSongs(ctx context.Context, req *proto.ListSongsRequest
 		}
 	}
 
-	args := []any{}
-	where := ""
+	args := []any{currentUserID}
+	clauses := []string{}
 	if q := req.GetQuery(); q != "" {
-		where = "WHERE title ILIKE $1 OR artist ILIKE $1"
 		args = append(args, "%"+q+"%")
+		clauses = append(clauses, "(title ILIKE $"+strconv.Itoa(len(args))+" OR artist ILIKE $"+strconv.Itoa(len(args))+")")
+	}
+	if req.GetFavoritesOnly() {
+		clauses = append(clauses, helpers.SongIsFavoriteExpr("$1"))
+	}
+	if max := req.GetMaxDifficulty(); max > 0 {
+		// Unrated songs have difficulty 0 and therefore always pass.
+		args = append(args, float64(max))
+		clauses = append(clauses, helpers.SongDifficultyExpr+" <= $"+strconv.Itoa(len(args)))
+	}
+	where := ""
+	if len(clauses) > 0 {
+		where = "WHERE " + strings.Join(clauses, " AND ")
 	}
 
 	query := `
-		SELECT id, title, artist, description, link_kind, link_url, COALESCE(created_by, NULL), COALESCE(thumbnail_url, '')
+		SELECT id, title, artist, description, link_kind, link_url, COALESCE(created_by, NULL), COALESCE(thumbnail_url, ''), version,
+		       ` + helpers.SongIsFavoriteExpr("$1") + `, pinned_at IS NOT NULL, ` + helpers.SongDifficultyExpr + `, COALESCE(duration_sec, 0), COALESCE(musical_key, '')
 		FROM song
 	` + where + `
-		ORDER BY created_at DESC
+		ORDER BY pinned_at DESC NULLS LAST, created_at DESC
 		LIMIT $` + strconv.Itoa(len(args)+1) + `
 		OFFSET $` + strconv.Itoa(len(args)+2)
 	args = append(args, limit, offset)
@@ -59,16 +73,21 @@ func (s *SongService) ListSongs(ctx context.Context, req *proto.ListSongsRequest
 		var sng proto.Song
 		var linkKind, linkURL, thumbnailURL string
 		var creatorID sql.NullString
-		if err := rows.Scan(&sng.Id, &sng.Title, &sng.Artist, &sng.Description, &linkKind, &linkURL, &creatorID, &thumbnailURL); err != nil {
+		if err := rows.Scan(&sng.Id, &sng.Title, &sng.Artist, &sng.Description, &linkKind, &linkURL, &creatorID, &thumbnailURL, &sng.Version, &sng.IsFavorite, &sng.IsPinned, &sng.Difficulty, &sng.DurationSeconds, &sng.Key); err != nil {
 			return nil, status.Errorf(codes.Internal, "scan song: %v", err)
 		}
 		sng.Link = &proto.SongLink{Kind: helpers.MapSongLinkType(linkKind), Url: linkURL}
-		sng.ThumbnailUrl = thumbnailURL
+		sng.ThumbnailUrl = helpers.ThumbnailProxyURL(ctx, sng.Id, thumbnailURL)
 		roles, err := helpers.LoadSongRoles(ctx, db, sng.Id)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "load roles: %v", err)
 		}
 		sng.AvailableRoles = roles
+		tags, err := helpers.LoadSongTags(ctx, db, sng.Id)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "load tags: %v", err)
+		}
+		sng.Tags = tags
 		sng.EditableByMe = helpers.PermissionAllowsSongEdit(perms, creatorID, currentUserID)
 
 		// Count participants assigned to this song
@@ -0,0 +1,129 @@
+package song
+
+import (
+	"context"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *SongService) MergeRoles(ctx context.Context, req *proto.MergeRolesRequest) (*proto.MergeRolesResponse, error) {
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	from := strings.ToLower(strings.TrimSpace(req.GetFrom()))
+	into := strings.ToLower(strings.TrimSpace(req.GetInto()))
+	if from == into {
+		return nil, status.Error(codes.InvalidArgument, "cannot merge a role into itself")
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+
+	// Remember the spelling so future writes normalize it, and repoint aliases
+	// that used the merged name as canonical.
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO role_alias (alias, canonical) VALUES ($1, $2)
+		ON CONFLICT (alias) DO UPDATE SET canonical = EXCLUDED.canonical
+	`, from, into); err != nil {
+		return nil, status.Errorf(codes.Internal, "store alias: %v", err)
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE role_alias SET canonical = $2 WHERE canonical = $1`, from, into); err != nil {
+		return nil, status.Errorf(codes.Internal, "repoint aliases: %v", err)
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM role_alias WHERE alias = $1`, into); err != nil {
+		return nil, status.Errorf(codes.Internal, "drop canonical alias: %v", err)
+	}
+
+	var songsUpdated int32
+	if err := tx.QueryRowContext(ctx, `
+		SELECT COUNT(DISTINCT song_id) FROM song_role WHERE lower(role) = $1
+	`, from).Scan(&songsUpdated); err != nil {
+		return nil, status.Errorf(codes.Internal, "count affected songs: %v", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO song_role (song_id, role)
+		SELECT DISTINCT song_id, $2 FROM song_role WHERE lower(role) = $1
+		ON CONFLICT (song_id, role) DO NOTHING
+	`, from, into); err != nil {
+		return nil, status.Errorf(codes.Internal, "copy roles: %v", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO song_role_assignment (song_id, role, user_id, joined_at)
+		SELECT song_id, $2, user_id, joined_at FROM song_role_assignment WHERE lower(role) = $1
+		ON CONFLICT (song_id, role, user_id) DO NOTHING
+	`, from, into); err != nil {
+		return nil, status.Errorf(codes.Internal, "copy assignments: %v", err)
+	}
+
+	// A member who rated both roles keeps the newer rating.
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO song_difficulty_rating (song_id, role, user_id, rating, updated_at)
+		SELECT DISTINCT ON (song_id, user_id) song_id, $2, user_id, rating, updated_at
+		FROM song_difficulty_rating WHERE lower(role) = $1
+		ORDER BY song_id, user_id, updated_at DESC
+		ON CONFLICT (song_id, role, user_id) DO UPDATE
+		SET rating = EXCLUDED.rating, updated_at = EXCLUDED.updated_at
+		WHERE song_difficulty_rating.updated_at < EXCLUDED.updated_at
+	`, from, into); err != nil {
+		return nil, status.Errorf(codes.Internal, "copy difficulty ratings: %v", err)
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM song_difficulty_rating WHERE lower(role) = $1`, from); err != nil {
+		return nil, status.Errorf(codes.Internal, "delete merged ratings: %v", err)
+	}
+
+	// Old assignments go away with their role through the cascade.
+	if _, err := tx.ExecContext(ctx, `DELETE FROM song_role WHERE lower(role) = $1`, from); err != nil {
+		return nil, status.Errorf(codes.Internal, "delete merged roles: %v", err)
+	}
+
+	// Rename the role in the lineups events ask for, keeping the order and
+	// dropping the duplicate when both names were listed.
+	for _, table := range []string{"event", "event_series", "event_template"} {
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE `+table+` SET required_roles = ARRAY(
+				SELECT role FROM (
+					SELECT CASE WHEN lower(r) = $1 THEN $2 ELSE r END AS role, MIN(ord) AS ord
+					FROM unnest(required_roles) WITH ORDINALITY AS t(r, ord)
+					GROUP BY 1
+				) renamed ORDER BY ord
+			)
+			WHERE EXISTS (SELECT 1 FROM unnest(required_roles) AS r WHERE lower(r) = $1)
+		`, from, into); err != nil {
+			return nil, status.Errorf(codes.Internal, "rename required roles in %s: %v", table, err)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM event_participant ep
+		WHERE lower(ep.role) = $1
+		  AND EXISTS (
+		      SELECT 1 FROM event_participant other
+		      WHERE other.event_id = ep.event_id
+		        AND other.user_id = ep.user_id
+		        AND other.track_item_id IS NOT DISTINCT FROM ep.track_item_id
+		        AND other.role = $2
+		  )
+	`, from, into); err != nil {
+		return nil, status.Errorf(codes.Internal, "drop duplicate participants: %v", err)
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE event_participant SET role = $2 WHERE lower(role) = $1`, from, into); err != nil {
+		return nil, status.Errorf(codes.Internal, "rename participant roles: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit: %v", err)
+	}
+
+	return &proto.MergeRolesResponse{SongsUpdated: songsUpdated}, nil
+}
@@ -0,0 +1,69 @@
+package song
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxPinnedSongs keeps the pinned block short enough to stay a highlight.
+const maxPinnedSongs = 10
+
+func (s *SongService) PinSong(ctx context.Context, req *proto.SongId) (*proto.SongDetails, error) {
+	return setSongPinned(ctx, req.GetId(), true)
+}
+
+func (s *SongService) UnpinSong(ctx context.Context, req *proto.SongId) (*proto.SongDetails, error) {
+	return setSongPinned(ctx, req.GetId(), false)
+}
+
+func setSongPinned(ctx context.Context, songID string, pinned bool) (*proto.SongDetails, error) {
+	userID, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+
+	var alreadyPinned bool
+	err = tx.QueryRowContext(ctx, `SELECT pinned_at IS NOT NULL FROM song WHERE id = $1 FOR UPDATE`, songID).Scan(&alreadyPinned)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "song not found")
+		}
+		return nil, status.Errorf(codes.Internal, "load song: %v", err)
+	}
+
+	if pinned && !alreadyPinned {
+		var count int
+		if err := tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM song WHERE pinned_at IS NOT NULL`).Scan(&count); err != nil {
+			return nil, status.Errorf(codes.Internal, "count pinned songs: %v", err)
+		}
+		if count >= maxPinnedSongs {
+			return nil, status.Errorf(codes.FailedPrecondition, "at most %d songs can be pinned", maxPinnedSongs)
+		}
+		if _, err := tx.ExecContext(ctx, `UPDATE song SET pinned_at = NOW() WHERE id = $1`, songID); err != nil {
+			return nil, status.Errorf(codes.Internal, "pin song: %v", err)
+		}
+	}
+	if !pinned && alreadyPinned {
+		if _, err := tx.ExecContext(ctx, `UPDATE song SET pinned_at = NULL WHERE id = $1`, songID); err != nil {
+			return nil, status.Errorf(codes.Internal, "unpin song: %v", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit: %v", err)
+	}
+
+	helpers.PublishSongChanged(ctx, songID)
+	return helpers.LoadSongDetails(ctx, db, songID, userID)
+}
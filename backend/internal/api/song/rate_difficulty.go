@@ -0,0 +1,53 @@
+package song
+
+import (
+	"context"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *SongService) RateDifficulty(ctx context.Context, req *proto.RateDifficultyRequest) (*proto.SongDetails, error) {
+	userID, err := helpers.UserIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	role, err := helpers.NormalizeRole(ctx, db, req.GetRole())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "normalize role: %v", err)
+	}
+
+	var assigned bool
+	if err := db.QueryRowContext(ctx, `
+		SELECT EXISTS(SELECT 1 FROM song_role_assignment WHERE song_id = $1 AND role = $2 AND user_id = $3)
+	`, req.GetSongId(), role, userID).Scan(&assigned); err != nil {
+		return nil, status.Errorf(codes.Internal, "check assignment: %v", err)
+	}
+	if !assigned {
+		return nil, status.Error(codes.PermissionDenied, "only participants of the role can rate it")
+	}
+
+	if req.GetRating() == 0 {
+		_, err = db.ExecContext(ctx, `
+			DELETE FROM song_difficulty_rating WHERE song_id = $1 AND role = $2 AND user_id = $3
+		`, req.GetSongId(), role, userID)
+	} else {
+		_, err = db.ExecContext(ctx, `
+			INSERT INTO song_difficulty_rating (song_id, role, user_id, rating)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (song_id, role, user_id) DO UPDATE SET rating = EXCLUDED.rating, updated_at = NOW()
+		`, req.GetSongId(), role, userID, req.GetRating())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "rate difficulty: %v", err)
+	}
+
+	helpers.PublishSongChanged(ctx, req.GetSongId())
+	return helpers.LoadSongDetails(ctx, db, req.GetSongId(), userID)
+}
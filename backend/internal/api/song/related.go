@@ -0,0 +1,95 @@
+package song
+
+import (
+	"context"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *SongService) GetRelatedSongs(ctx context.Context, req *proto.GetRelatedSongsRequest) (*proto.GetRelatedSongsResponse, error) {
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	currentUserID, _ := helpers.UserIDFromCtx(ctx)
+
+	limit := req.GetLimit()
+	if limit == 0 {
+		limit = 10
+	}
+	if limit > 50 {
+		limit = 50
+	}
+
+	var exists bool
+	if err := db.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM song WHERE id = $1)`, req.GetSongId()).Scan(&exists); err != nil {
+		return nil, status.Errorf(codes.Internal, "load song: %v", err)
+	}
+	if !exists {
+		return nil, status.Error(codes.NotFound, "song not found")
+	}
+
+	// Same artist weighs most, then each shared tag, then each shared member.
+	rows, err := db.QueryContext(ctx, `
+		SELECT id, same_artist, shared_tags, shared_members
+		FROM (
+			SELECT s.id,
+			       s.created_at,
+			       lower(s.artist) = lower(t.artist) AS same_artist,
+			       ARRAY(
+			           SELECT st.tag FROM song_tag st
+			           WHERE st.song_id = s.id
+			             AND st.tag IN (SELECT tag FROM song_tag WHERE song_id = t.id)
+			           ORDER BY st.tag
+			       ) AS shared_tags,
+			       (
+			           SELECT COUNT(DISTINCT a.user_id) FROM song_role_assignment a
+			           WHERE a.song_id = s.id
+			             AND a.user_id IN (SELECT user_id FROM song_role_assignment WHERE song_id = t.id)
+			       ) AS shared_members
+			FROM song s
+			JOIN song t ON t.id = $1
+			WHERE s.id <> t.id
+		) r
+		WHERE same_artist OR cardinality(shared_tags) > 0 OR shared_members > 0
+		ORDER BY (CASE WHEN same_artist THEN 3 ELSE 0 END) + 2 * cardinality(shared_tags) + shared_members DESC,
+		         created_at DESC
+		LIMIT $2
+	`, req.GetSongId(), limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "find related songs: %v", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	related := map[string]*proto.RelatedSong{}
+	for rows.Next() {
+		var id string
+		var r proto.RelatedSong
+		if err := rows.Scan(&id, &r.SameArtist, pq.Array(&r.SharedTags), &r.SharedMembers); err != nil {
+			return nil, status.Errorf(codes.Internal, "scan related song: %v", err)
+		}
+		ids = append(ids, id)
+		related[id] = &r
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "iterate related songs: %v", err)
+	}
+
+	details, err := helpers.LoadSongDetailsBatch(ctx, db, ids, currentUserID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load related songs: %v", err)
+	}
+
+	resp := &proto.GetRelatedSongsResponse{}
+	for _, d := range details {
+		r := related[d.GetSong().GetId()]
+		r.Song = d.GetSong()
+		resp.Songs = append(resp.Songs, r)
+	}
+	return resp, nil
+}
@@ -5,6 +5,8 @@ import (
 	"database/sql"
 	"musicclubbot/backend/internal/helpers"
 	"musicclubbot/backend/proto"
+	"strconv"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -24,9 +26,17 @@ func (s *SongService) UpdateSong(ctx context.Context, req *proto.UpdateSongReque
 		return nil, status.Errorf(codes.Internal, "load permissions: %v", err)
 	}
 
+	fields, err := songUpdateFields(req.GetUpdateMask())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	var creatorID sql.NullString
-	row := db.QueryRowContext(ctx, `SELECT COALESCE(created_by, NULL) FROM song WHERE id = $1`, req.GetId())
-	if err := row.Scan(&creatorID); err != nil {
+	var linkKind, linkURL string
+	var thumbnailURL sql.NullString
+	var version int64
+	row := db.QueryRowContext(ctx, `SELECT COALESCE(created_by, NULL), link_kind, link_url, thumbnail_url, version FROM song WHERE id = $1`, req.GetId())
+	if err := row.Scan(&creatorID, &linkKind, &linkURL, &thumbnailURL, &version); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, status.Error(codes.NotFound, "song not found")
 		}
@@ -35,14 +45,53 @@ func (s *SongService) UpdateSong(ctx context.Context, req *proto.UpdateSongReque
 	if !helpers.PermissionAllowsSongEdit(perms, creatorID, userID) {
 		return nil, status.Error(codes.PermissionDenied, "no rights to edit song")
 	}
+	if version != req.GetExpectedVersion() {
+		return nil, status.Error(codes.Aborted, "song was modified concurrently")
+	}
 
-	linkKind, err := helpers.MapSongLinkKindToDB(req.GetLink().GetKind())
-	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+	args := []any{}
+	sets := []string{}
+	set := func(column string, value any) {
+		args = append(args, value)
+		sets = append(sets, column+" = $"+strconv.Itoa(len(args)))
 	}
 
-	// Auto-extract or use custom thumbnail URL
-	thumbnailURL := helpers.NormalizeThumbnailURL(req.GetThumbnailUrl(), linkKind, req.GetLink().GetUrl())
+	if fields["title"] {
+		set("title", req.GetTitle())
+	}
+	if fields["artist"] {
+		set("artist", req.GetArtist())
+	}
+	if fields["description"] {
+		set("description", req.GetDescription())
+	}
+	derivedThumbnail := thumbnailURL.String == helpers.ExtractThumbnailURL(linkKind, linkURL)
+	if fields["link"] {
+		linkKind, err = helpers.MapSongLinkKindToDB(req.GetLink().GetKind())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		linkURL = req.GetLink().GetUrl()
+		set("link_kind", linkKind)
+		set("link_url", linkURL)
+	}
+	// Clients echoing our proxied URL back keep the stored source untouched.
+	switch {
+	case fields["thumbnail_url"] && !helpers.IsThumbnailProxyURL(ctx, req.GetThumbnailUrl()):
+		// Auto-extract or use custom thumbnail URL
+		set("thumbnail_url", helpers.NormalizeThumbnailURL(req.GetThumbnailUrl(), linkKind, linkURL))
+	case fields["link"] && derivedThumbnail:
+		// A thumbnail taken from the old link follows it to the new one.
+		set("thumbnail_url", helpers.ExtractThumbnailURL(linkKind, linkURL))
+	}
+	if fields["duration_seconds"] {
+		set("duration_sec", nullIfZero(req.GetDurationSeconds()))
+	}
+	if fields["key"] {
+		set("musical_key", nullIfEmpty(strings.TrimSpace(req.GetKey())))
+	}
+	sets = append(sets, "updated_at = NOW()", "version = version + 1")
+	args = append(args, req.GetId(), req.GetExpectedVersion())
 
 	tx, err := db.BeginTx(ctx, nil)
 	if err != nil {
@@ -50,21 +99,40 @@ func (s *SongService) UpdateSong(ctx context.Context, req *proto.UpdateSongReque
 	}
 	defer tx.Rollback()
 
-	if _, err := tx.ExecContext(ctx, `
+	res, err := tx.ExecContext(ctx, `
 		UPDATE song
-		SET title = $1, artist = $2, description = $3, link_kind = $4, link_url = $5, thumbnail_url = $6, updated_at = NOW()
-		WHERE id = $7
-	`, req.GetTitle(), req.GetArtist(), req.GetDescription(), linkKind, req.GetLink().GetUrl(), thumbnailURL, req.GetId()); err != nil {
+		SET `+strings.Join(sets, ", ")+`
+		WHERE id = $`+strconv.Itoa(len(args)-1)+` AND version = $`+strconv.Itoa(len(args)), args...)
+	if err != nil {
 		return nil, status.Errorf(codes.Internal, "update song: %v", err)
 	}
+	// Another writer may have bumped the version between the check above and this update.
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		return nil, status.Error(codes.Aborted, "song was modified concurrently")
+	}
 
-	if err := replaceSongRoles(ctx, tx, req.GetId(), req.GetAvailableRoles()); err != nil {
-		return nil, status.Errorf(codes.Internal, "set roles: %v", err)
+	if fields["available_roles"] {
+		if err := replaceSongRoles(ctx, tx, req.GetId(), req.GetAvailableRoles()); err != nil {
+			return nil, status.Errorf(codes.Internal, "set roles: %v", err)
+		}
+	}
+
+	if fields["tags"] {
+		if err := replaceSongTags(ctx, tx, req.GetId(), req.GetTags()); err != nil {
+			return nil, status.Errorf(codes.Internal, "set tags: %v", err)
+		}
+	}
+	if err := helpers.RecordAudit(ctx, tx, helpers.AuditSong, req.GetId(), "update", changedFields(fields)); err != nil {
+		return nil, status.Errorf(codes.Internal, "record audit: %v", err)
+	}
+	if err := queueSongChangeNotice(ctx, tx, req.GetId(), userID, changedFieldNames(fields)); err != nil {
+		return nil, status.Errorf(codes.Internal, "queue group mentions: %v", err)
 	}
 
 	if err := tx.Commit(); err != nil {
 		return nil, status.Errorf(codes.Internal, "commit: %v", err)
 	}
 
+	helpers.PublishSongChanged(ctx, req.GetId())
 	return helpers.LoadSongDetails(ctx, db, req.GetId(), userID)
 }
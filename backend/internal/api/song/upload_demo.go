@@ -0,0 +1,123 @@
+package song
+
+import (
+	"database/sql"
+	"errors"
+	"io"
+	"musicclubbot/backend/internal/config"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *SongService) UploadDemo(stream grpc.ClientStreamingServer[proto.UploadDemoRequest, proto.Demo]) error {
+	ctx := stream.Context()
+	userID, err := helpers.UserIDFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+	store, err := helpers.StorageFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+	cfg := ctx.Value("cfg").(config.Config)
+
+	first, err := stream.Recv()
+	if err != nil {
+		// Rule violations and cancellations already carry their code.
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return status.Errorf(codes.InvalidArgument, "receive metadata: %v", err)
+	}
+	meta := first.GetMetadata()
+	if meta == nil {
+		return status.Error(codes.InvalidArgument, "first message must carry metadata")
+	}
+	title := strings.TrimSpace(meta.GetTitle())
+	contentType := strings.ToLower(strings.TrimSpace(meta.GetContentType()))
+
+	allowed, err := canUploadDemos(ctx, db, meta.GetSongId(), userID)
+	if err == sql.ErrNoRows {
+		return status.Error(codes.NotFound, "song not found")
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "check permissions: %v", err)
+	}
+	if !allowed {
+		return status.Error(codes.PermissionDenied, "only song editors and participants can upload demos")
+	}
+
+	demoID := uuid.NewString()
+	key := helpers.DemoStorageKey(demoID)
+
+	// Chunks are piped straight into storage so the whole file never sits in memory.
+	pr, pw := io.Pipe()
+	recvDone := make(chan error, 1)
+	go func() {
+		err := receiveDemoChunks(stream, pw, cfg.MaxDemoBytes)
+		pw.CloseWithError(err)
+		recvDone <- err
+	}()
+	size, putErr := store.Put(ctx, key, pr)
+	pr.CloseWithError(errors.New("storage stopped reading"))
+	if recvErr := <-recvDone; recvErr != nil {
+		_ = store.Delete(ctx, key)
+		return recvErr
+	}
+	if putErr != nil {
+		_ = store.Delete(ctx, key)
+		return status.Errorf(codes.Internal, "store demo: %v", putErr)
+	}
+	if size == 0 {
+		_ = store.Delete(ctx, key)
+		return status.Error(codes.InvalidArgument, "recording is empty")
+	}
+
+	if _, err := db.ExecContext(ctx, `
+		INSERT INTO song_demo (id, song_id, uploaded_by, title, content_type, size_bytes, storage_key)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`, demoID, meta.GetSongId(), userID, title, contentType, size, key); err != nil {
+		_ = store.Delete(ctx, key)
+		return status.Errorf(codes.Internal, "save demo: %v", err)
+	}
+
+	demo, err := helpers.LoadDemo(ctx, db, demoID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "load demo: %v", err)
+	}
+	helpers.PublishSongChanged(ctx, meta.GetSongId())
+	return stream.SendAndClose(demo)
+}
+
+// receiveDemoChunks copies audio chunks from the stream into w until the
+// client closes its side, failing once more than limit bytes arrive.
+func receiveDemoChunks(stream grpc.ClientStreamingServer[proto.UploadDemoRequest, proto.Demo], w io.Writer, limit int64) error {
+	var total int64
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		chunk := msg.GetChunk()
+		total += int64(len(chunk))
+		if total > limit {
+			return status.Errorf(codes.ResourceExhausted, "recording exceeds %d MB", limit>>20)
+		}
+		if _, err := w.Write(chunk); err != nil {
+			return status.Errorf(codes.Internal, "store demo: %v", err)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package song
+
+import (
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *SongService) WatchSong(req *proto.SongId, stream grpc.ServerStreamingServer[proto.SongDetails]) error {
+	ctx := stream.Context()
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+	hub := helpers.HubFromCtx(ctx)
+	if hub == nil {
+		return status.Error(codes.Unavailable, "live updates are not available")
+	}
+	currentUserID, _ := helpers.UserIDFromCtx(ctx)
+
+	// Subscribe before the first load so no change between the two is missed.
+	changes, unsubscribe := hub.Subscribe(helpers.SongTopic(req.GetId()))
+	defer unsubscribe()
+
+	for {
+		details, err := helpers.LoadSongDetails(ctx, db, req.GetId(), currentUserID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return status.Error(codes.NotFound, "song not found")
+			}
+			return status.Errorf(codes.Internal, "get song: %v", err)
+		}
+		if err := stream.Send(details); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-changes:
+		}
+	}
+}
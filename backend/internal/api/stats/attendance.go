@@ -0,0 +1,92 @@
+package stats
+
+import (
+	"context"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *StatsService) GetAttendanceStats(ctx context.Context, req *proto.GetAttendanceStatsRequest) (*proto.AttendanceStats, error) {
+	_, db, err := helpers.UserAndReadDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	from, to, err := statsRange(ctx, db, req.GetFrom(), req.GetTo(), req.GetSeasonId())
+	if err != nil {
+		return nil, err
+	}
+
+	// Cancelled and upcoming events have nothing to compare yet.
+	rows, err := db.QueryContext(ctx, `
+		SELECT e.id, e.title, e.start_at, to_char(e.start_at AT TIME ZONE e.timezone, 'YYYY-MM'),
+		       COUNT(*) FILTER (WHERE r.status = 'going'),
+		       COUNT(*) FILTER (WHERE r.status = 'maybe'),
+		       COUNT(*) FILTER (WHERE r.status = 'declined'),
+		       COUNT(*) FILTER (WHERE r.status = 'waitlisted'),
+		       (SELECT COUNT(*) FROM event_attendance a WHERE a.event_id = e.id),
+		       COUNT(*) FILTER (WHERE r.status = 'going'
+		                        AND NOT EXISTS (SELECT 1 FROM event_attendance a WHERE a.event_id = e.id AND a.user_id = r.user_id)),
+		       (SELECT COUNT(*) FROM event_attendance a WHERE a.event_id = e.id
+		        AND NOT EXISTS (SELECT 1 FROM event_rsvp g WHERE g.event_id = e.id AND g.user_id = a.user_id AND g.status = 'going'))
+		FROM event e
+		LEFT JOIN event_rsvp r ON r.event_id = e.id
+		WHERE e.start_at <= NOW() AND e.cancelled_at IS NULL AND e.deleted_at IS NULL
+		  AND ($1::timestamptz IS NULL OR e.start_at >= $1)
+		  AND ($2::timestamptz IS NULL OR e.start_at < $2)
+		GROUP BY e.id
+		ORDER BY e.start_at DESC, e.id
+	`, from, to)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load attendance: %v", err)
+	}
+	defer rows.Close()
+
+	stats := &proto.AttendanceStats{}
+	months := map[string]*proto.MonthlyAttendance{}
+	for rows.Next() {
+		e := &proto.EventAttendance{}
+		var start time.Time
+		var month string
+		if err := rows.Scan(&e.EventId, &e.Title, &start, &month, &e.Going, &e.Maybe, &e.Declined, &e.Waitlisted,
+			&e.CheckedIn, &e.NoShows, &e.WalkIns); err != nil {
+			return nil, status.Errorf(codes.Internal, "scan attendance: %v", err)
+		}
+		e.StartAt = timestamppb.New(start)
+		stats.Events = append(stats.Events, e)
+		stats.Going += e.Going
+		stats.CheckedIn += e.CheckedIn
+
+		m, ok := months[month]
+		if !ok {
+			m = &proto.MonthlyAttendance{Month: month}
+			months[month] = m
+			stats.Months = append(stats.Months, m)
+		}
+		m.Events++
+		m.Going += e.Going
+		m.CheckedIn += e.CheckedIn
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "iterate attendance: %v", err)
+	}
+
+	sort.Slice(stats.Months, func(i, j int) bool { return stats.Months[i].Month < stats.Months[j].Month })
+	for _, m := range stats.Months {
+		m.Turnout = turnout(m.CheckedIn, m.Going)
+	}
+	stats.Turnout = turnout(stats.CheckedIn, stats.Going)
+	return stats, nil
+}
+
+func turnout(checkedIn, going int32) float64 {
+	if going == 0 {
+		return 0
+	}
+	return float64(checkedIn) / float64(going)
+}
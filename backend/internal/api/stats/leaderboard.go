@@ -0,0 +1,112 @@
+package stats
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// leaderboardScores maps metrics to their expression over the totals.
+var leaderboardScores = map[proto.LeaderboardMetric]string{
+	proto.LeaderboardMetric_LEADERBOARD_METRIC_UNSPECIFIED:     "t.songs_joined + t.events_attended + t.songs_proposed",
+	proto.LeaderboardMetric_LEADERBOARD_METRIC_SONGS_JOINED:    "t.songs_joined",
+	proto.LeaderboardMetric_LEADERBOARD_METRIC_EVENTS_ATTENDED: "t.events_attended",
+	proto.LeaderboardMetric_LEADERBOARD_METRIC_SONGS_PROPOSED:  "t.songs_proposed",
+}
+
+func (s *StatsService) GetLeaderboard(ctx context.Context, req *proto.GetLeaderboardRequest) (*proto.Leaderboard, error) {
+	_, db, err := helpers.UserAndReadDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	score, ok := leaderboardScores[req.GetMetric()]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "unknown metric")
+	}
+	limit := int(req.GetLimit())
+	if limit == 0 {
+		limit = 10
+	}
+	limit = min(limit, 100)
+
+	from, to, err := statsRange(ctx, db, req.GetFrom(), req.GetTo(), req.GetSeasonId())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.QueryContext(ctx, `
+		WITH t AS (
+		    SELECT user_id, SUM(songs_joined)::int AS songs_joined, SUM(events_attended)::int AS events_attended,
+		           SUM(songs_proposed)::int AS songs_proposed
+		    FROM member_stats_daily
+		    WHERE ($1::timestamptz IS NULL OR day >= ($1::timestamptz AT TIME ZONE 'UTC')::date)
+		      AND ($2::timestamptz IS NULL OR day < ($2::timestamptz AT TIME ZONE 'UTC')::date)
+		    GROUP BY user_id
+		)
+		SELECT RANK() OVER (ORDER BY `+score+` DESC),
+		       u.id, u.display_name, COALESCE(u.username, ''), COALESCE(u.avatar_url, ''), COALESCE(u.tg_user_id, 0),
+		       t.songs_joined, t.events_attended, t.songs_proposed
+		FROM t JOIN app_user u ON u.id = t.user_id
+		WHERE `+score+` > 0 AND u.deactivated_at IS NULL
+		ORDER BY 1, u.display_name, u.id
+		LIMIT $3
+	`, from, to, limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load leaderboard: %v", err)
+	}
+	defer rows.Close()
+
+	resp := &proto.Leaderboard{}
+	if from.Valid {
+		resp.From = timestamppb.New(from.Time)
+	}
+	if to.Valid {
+		resp.To = timestamppb.New(to.Time)
+	}
+	for rows.Next() {
+		u := &proto.User{}
+		e := &proto.LeaderboardEntry{User: u}
+		var tgID int64
+		if err := rows.Scan(&e.Rank, &u.Id, &u.DisplayName, &u.Username, &u.AvatarUrl, &tgID,
+			&e.SongsJoined, &e.EventsAttended, &e.SongsProposed); err != nil {
+			return nil, status.Errorf(codes.Internal, "scan entry: %v", err)
+		}
+		u.TelegramId = uint64(tgID)
+		resp.Entries = append(resp.Entries, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "iterate leaderboard: %v", err)
+	}
+	return resp, nil
+}
+
+// statsRange resolves a request's time range; a season replaces from and
+// to with its own bounds. Unset bounds stay NULL.
+func statsRange(ctx context.Context, db *sql.DB, from, to *timestamppb.Timestamp, seasonID string) (sql.NullTime, sql.NullTime, error) {
+	var start, end sql.NullTime
+	if seasonID != "" {
+		err := db.QueryRowContext(ctx, `SELECT starts_at, ends_at FROM season WHERE id::text = $1`, seasonID).Scan(&start, &end)
+		if err == sql.ErrNoRows {
+			return start, end, status.Error(codes.NotFound, "season not found")
+		}
+		if err != nil {
+			return start, end, status.Errorf(codes.Internal, "load season: %v", err)
+		}
+		return start, end, nil
+	}
+	if from != nil {
+		start = sql.NullTime{Valid: true, Time: from.AsTime()}
+	}
+	if to != nil {
+		end = sql.NullTime{Valid: true, Time: to.AsTime()}
+	}
+	if start.Valid && end.Valid && !end.Time.After(start.Time) {
+		return start, end, status.Error(codes.InvalidArgument, "to must be after from")
+	}
+	return start, end, nil
+}
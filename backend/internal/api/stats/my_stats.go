@@ -0,0 +1,48 @@
+package stats
+
+import (
+	"context"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *StatsService) GetMyStats(ctx context.Context, _ *emptypb.Empty) (*proto.MyStats, error) {
+	userID, db, err := helpers.UserAndReadDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// An event counts as attended when the user played in it or checked in.
+	// The streak counts past events latest first until the first one missed.
+	stats := &proto.MyStats{}
+	if err := db.QueryRowContext(ctx, `
+		WITH season AS (
+		    SELECT id, name FROM season
+		    WHERE (starts_at IS NULL OR starts_at <= NOW()) AND (ends_at IS NULL OR ends_at > NOW())
+		    ORDER BY starts_at DESC NULLS LAST
+		    LIMIT 1
+		), past AS (
+		    SELECT e.season_id, e.start_at,
+		           EXISTS (SELECT 1 FROM event_participant p WHERE p.event_id = e.id AND p.user_id::text = $1)
+		           OR EXISTS (SELECT 1 FROM event_attendance a WHERE a.event_id = e.id AND a.user_id::text = $1) AS attended
+		    FROM event e
+		    WHERE e.start_at <= NOW() AND e.cancelled_at IS NULL AND e.deleted_at IS NULL
+		)
+		SELECT (SELECT COUNT(*) FROM song WHERE created_by::text = $1),
+		       (SELECT COUNT(*) FROM song_role_assignment WHERE user_id::text = $1),
+		       COALESCE((SELECT id::text FROM season), ''),
+		       COALESCE((SELECT name FROM season), ''),
+		       (SELECT COUNT(*) FROM past WHERE attended AND season_id = (SELECT id FROM season)),
+		       (SELECT COUNT(*) FROM (
+		            SELECT COUNT(*) FILTER (WHERE NOT attended) OVER (ORDER BY start_at DESC) AS missed FROM past
+		        ) streak WHERE missed = 0)
+	`, userID).Scan(&stats.SongsProposed, &stats.RolesHeld, &stats.SeasonId, &stats.SeasonName,
+		&stats.EventsAttendedThisSeason, &stats.CurrentStreak); err != nil {
+		return nil, status.Errorf(codes.Internal, "load stats: %v", err)
+	}
+	return stats, nil
+}
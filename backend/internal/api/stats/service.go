@@ -0,0 +1,10 @@
+package stats
+
+import (
+	"musicclubbot/backend/proto"
+)
+
+// StatsService implements club statistics.
+type StatsService struct {
+	proto.UnimplementedStatsServiceServer
+}
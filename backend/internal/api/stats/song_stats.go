@@ -0,0 +1,96 @@
+package stats
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// songStatsOrder maps sorts to ORDER BY clauses over the result columns.
+var songStatsOrder = map[proto.SongStatsSort]string{
+	proto.SongStatsSort_SONG_STATS_SORT_UNSPECIFIED:    "times_performed DESC",
+	proto.SongStatsSort_SONG_STATS_SORT_VOTES:          "votes DESC",
+	proto.SongStatsSort_SONG_STATS_SORT_PARTICIPANTS:   "participants DESC",
+	proto.SongStatsSort_SONG_STATS_SORT_LAST_PERFORMED: "last_performed DESC NULLS LAST",
+	proto.SongStatsSort_SONG_STATS_SORT_LEAST_RECENT:   "last_performed ASC NULLS FIRST",
+}
+
+func (s *StatsService) ListSongStats(ctx context.Context, req *proto.ListSongStatsRequest) (*proto.ListSongStatsResponse, error) {
+	_, db, err := helpers.UserAndReadDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	order, ok := songStatsOrder[req.GetSort()]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "unknown sort")
+	}
+
+	limit := int(req.GetPageSize())
+	if limit == 0 {
+		limit = 50
+	}
+	offset := 0
+	if tok := req.GetPageToken(); tok != "" {
+		if v, err := strconv.Atoi(tok); err == nil && v >= 0 {
+			offset = v
+		}
+	}
+
+	from, to, err := statsRange(ctx, db, req.GetFrom(), req.GetTo(), req.GetSeasonId())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.QueryContext(ctx, `
+		WITH perf AS (
+		    SELECT ti.id, ti.song_id, e.start_at,
+		           ($1::timestamptz IS NULL OR e.start_at >= $1) AND ($2::timestamptz IS NULL OR e.start_at < $2) AS in_range
+		    FROM event_track_item ti JOIN event e ON e.id = ti.event_id
+		    WHERE ti.performed AND ti.song_id IS NOT NULL AND e.deleted_at IS NULL
+		)
+		SELECT s.id, s.title, s.artist,
+		       (SELECT COUNT(*) FROM perf p WHERE p.song_id = s.id AND p.in_range) AS times_performed,
+		       (SELECT COUNT(DISTINCT ep.user_id) FROM perf p JOIN event_participant ep ON ep.track_item_id = p.id
+		        WHERE p.song_id = s.id AND p.in_range) AS participants,
+		       (SELECT COUNT(*) FROM song_favorite f WHERE f.song_id = s.id) AS votes,
+		       (SELECT MAX(p.start_at) FROM perf p WHERE p.song_id = s.id) AS last_performed,
+		       COUNT(*) OVER ()
+		FROM song s
+		ORDER BY `+order+`, s.title, s.id
+		LIMIT $3 OFFSET $4
+	`, from, to, limit, offset)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load song stats: %v", err)
+	}
+	defer rows.Close()
+
+	resp := &proto.ListSongStatsResponse{}
+	now := time.Now()
+	for rows.Next() {
+		st := &proto.SongStat{}
+		var last sql.NullTime
+		if err := rows.Scan(&st.SongId, &st.Title, &st.Artist, &st.TimesPerformed, &st.UniqueParticipants,
+			&st.Votes, &last, &resp.TotalCount); err != nil {
+			return nil, status.Errorf(codes.Internal, "scan song stats: %v", err)
+		}
+		if last.Valid {
+			st.LastPerformedAt = timestamppb.New(last.Time)
+			st.DaysSinceLastPerformed = int32(max(now.Sub(last.Time), 0) / (24 * time.Hour))
+		}
+		resp.Songs = append(resp.Songs, st)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "iterate song stats: %v", err)
+	}
+	if offset+len(resp.Songs) < int(resp.TotalCount) {
+		resp.NextPageToken = strconv.Itoa(offset + len(resp.Songs))
+	}
+	return resp, nil
+}
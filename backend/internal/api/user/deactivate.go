@@ -0,0 +1,96 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *UserService) DeactivateAccount(ctx context.Context, _ *emptypb.Empty) (*proto.DeactivateAccountResponse, error) {
+	userID, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+
+	var deactivatedAt time.Time
+	err = tx.QueryRowContext(ctx, `
+		UPDATE app_user SET deactivated_at = COALESCE(deactivated_at, NOW())
+		WHERE id::text = $1
+		RETURNING deactivated_at
+	`, userID).Scan(&deactivatedAt)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "deactivate: %v", err)
+	}
+
+	// Upcoming events lose the member's slots and answers; past ones keep
+	// them as history.
+	rows, err := tx.QueryContext(ctx, `
+		WITH upcoming AS (
+		    SELECT id FROM event WHERE start_at > NOW() AND deleted_at IS NULL
+		), participants AS (
+		    DELETE FROM event_participant
+		    WHERE user_id::text = $1 AND event_id IN (SELECT id FROM upcoming)
+		    RETURNING event_id
+		), rsvps AS (
+		    DELETE FROM event_rsvp
+		    WHERE user_id::text = $1 AND event_id IN (SELECT id FROM upcoming)
+		    RETURNING event_id
+		)
+		SELECT event_id FROM participants UNION SELECT event_id FROM rsvps
+	`, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "leave upcoming events: %v", err)
+	}
+	var eventIDs []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, status.Errorf(codes.Internal, "scan event: %v", err)
+		}
+		eventIDs = append(eventIDs, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "iterate events: %v", err)
+	}
+	for _, id := range eventIDs {
+		if err := helpers.PromoteWaitlist(ctx, tx, id); err != nil {
+			return nil, status.Errorf(codes.Internal, "promote waitlist: %v", err)
+		}
+	}
+
+	// Open sessions end now; the next login is what reactivates.
+	if _, err := tx.ExecContext(ctx, `DELETE FROM refresh_tokens WHERE user_id::text = $1`, userID); err != nil {
+		return nil, status.Errorf(codes.Internal, "revoke sessions: %v", err)
+	}
+	if err := helpers.RecordAudit(ctx, tx, helpers.AuditUser, userID, "deactivate", ""); err != nil {
+		return nil, status.Errorf(codes.Internal, "record audit: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit: %v", err)
+	}
+
+	for _, id := range eventIDs {
+		helpers.PublishEventChanged(ctx, id)
+	}
+	return &proto.DeactivateAccountResponse{
+		ReactivateBefore: timestamppb.New(deactivatedAt.Add(helpers.DeactivationGrace)),
+	}, nil
+}
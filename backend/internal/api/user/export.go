@@ -0,0 +1,152 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// exportSections lists what ExportMyData gathers, each as a query over the
+// user id ($1). Secrets (password hash, token values) are left out.
+var exportSections = []struct {
+	name, query string
+}{
+	{"profile", `
+		SELECT id, username, display_name, avatar_url, bio, tg_user_id, is_chat_member, invite_code,
+		       suspended, suspended_until, suspension_reason, last_seen_at, notification_digest, created_at
+		FROM app_user WHERE id = $1`},
+	{"roles", `SELECT role, assigned_at FROM user_role WHERE user_id = $1 ORDER BY role`},
+	{"granted_permissions", `SELECT * FROM user_permissions WHERE user_id = $1`},
+	{"effective_permissions", `SELECT * FROM effective_permissions WHERE user_id = $1`},
+	{"songs_created", `
+		SELECT id, title, artist, description, link_url, created_at
+		FROM song WHERE created_by = $1 ORDER BY created_at`},
+	{"song_roles", `
+		SELECT a.song_id, s.title, s.artist, a.role, a.joined_at
+		FROM song_role_assignment a JOIN song s ON s.id = a.song_id
+		WHERE a.user_id = $1 ORDER BY a.joined_at`},
+	{"favorite_songs", `
+		SELECT f.song_id, s.title, s.artist, f.created_at
+		FROM song_favorite f JOIN song s ON s.id = f.song_id
+		WHERE f.user_id = $1 ORDER BY f.created_at`},
+	{"difficulty_ratings", `SELECT * FROM song_difficulty_rating WHERE user_id = $1`},
+	{"demos_uploaded", `
+		SELECT id, song_id, title, content_type, size_bytes, created_at
+		FROM song_demo WHERE uploaded_by = $1 ORDER BY created_at`},
+	{"event_participation", `
+		SELECT ep.event_id, e.title, e.start_at, ep.role, ep.track_item_id, ep.joined_at
+		FROM event_participant ep JOIN event e ON e.id = ep.event_id
+		WHERE ep.user_id = $1 ORDER BY e.start_at`},
+	{"rsvps", `
+		SELECT r.event_id, e.title, r.status, r.updated_at
+		FROM event_rsvp r JOIN event e ON e.id = r.event_id
+		WHERE r.user_id = $1 ORDER BY r.updated_at`},
+	{"attendance", `SELECT event_id, checked_in_at FROM event_attendance WHERE user_id = $1 ORDER BY checked_in_at`},
+	{"rehearsal_attendance", `SELECT * FROM rehearsal_attendance WHERE user_id = $1`},
+	{"event_feedback", `SELECT * FROM event_feedback WHERE user_id = $1 ORDER BY submitted_at`},
+	{"rides", `SELECT * FROM event_ride WHERE user_id = $1`},
+	{"ride_seats", `SELECT * FROM event_ride_passenger WHERE user_id = $1`},
+	{"expenses_paid", `SELECT * FROM event_expense WHERE payer_id = $1`},
+	{"expense_shares", `SELECT * FROM event_expense_share WHERE user_id = $1`},
+	{"events_owned", `SELECT * FROM event_owner WHERE user_id = $1`},
+	{"sessions", `SELECT created_at, expires_at FROM refresh_tokens WHERE user_id = $1 ORDER BY created_at`},
+	{"permission_changes", `
+		SELECT roles_before, roles_after, granted_before, granted_after, reason, actor_id, changed_at
+		FROM permission_change WHERE user_id = $1 ORDER BY changed_at`},
+	{"audit_entries", `
+		SELECT entity_type, entity_id, action, summary, actor_id, created_at
+		FROM audit_entry
+		WHERE actor_id::text = $1 OR (entity_type IN ('user', 'permissions') AND entity_id = $1)
+		ORDER BY created_at`},
+	{"activity", `SELECT kind, song_id, event_id, detail, created_at FROM activity WHERE user_id = $1 ORDER BY created_at`},
+	{"voting_ballots", `SELECT round_id, song_id, rank FROM voting_ballot WHERE user_id = $1 ORDER BY round_id, rank`},
+	{"dues", `
+		SELECT kind, amount_cents, description, season_id, occurred_at, created_at
+		FROM dues_entry WHERE user_id = $1 ORDER BY occurred_at`},
+	{"groups", `
+		SELECT g.name, m.added_at
+		FROM member_group_member m JOIN member_group g ON g.id = m.group_id
+		WHERE m.user_id = $1 ORDER BY m.added_at`},
+	{"username_history", `
+		SELECT username, changed_at, reserved_until
+		FROM username_history WHERE user_id = $1 ORDER BY changed_at`},
+	{"pending_notifications", `SELECT text, expires_at, created_at FROM pending_notification WHERE user_id = $1 ORDER BY created_at`},
+}
+
+func (s *UserService) ExportMyData(ctx context.Context, _ *emptypb.Empty) (*proto.DataExport, error) {
+	userID, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	export := map[string]any{"exported_at": time.Now().UTC()}
+	for _, section := range exportSections {
+		rows, err := queryMaps(ctx, db, section.query, userID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "export %s: %v", section.name, err)
+		}
+		export[section.name] = rows
+	}
+	// The profile is a single row.
+	if profile, _ := export["profile"].([]map[string]any); len(profile) == 1 {
+		export["profile"] = profile[0]
+	}
+
+	content, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode export: %v", err)
+	}
+	name := userID
+	if p, ok := export["profile"].(map[string]any); ok {
+		if u, ok := p["username"].(string); ok && u != "" {
+			name = u
+		}
+	}
+	return &proto.DataExport{
+		Filename:    "musicclub-export-" + name + "-" + time.Now().Format("2006-01-02") + ".json",
+		Content:     content,
+		ContentType: "application/json",
+	}, nil
+}
+
+// queryMaps returns each row as a column → value map, always non-nil so
+// empty sections encode as [].
+func queryMaps(ctx context.Context, db *sql.DB, query string, args ...any) ([]map[string]any, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	out := []map[string]any{}
+	for rows.Next() {
+		values := make([]any, len(cols))
+		ptrs := make([]any, len(cols))
+		for i := range values {
+			ptrs[i] = &values[i]
+		}
+		if err := rows.Scan(ptrs...); err != nil {
+			return nil, err
+		}
+		row := make(map[string]any, len(cols))
+		for i, col := range cols {
+			// lib/pq hands text, UUIDs and arrays over as bytes.
+			if b, ok := values[i].([]byte); ok {
+				values[i] = string(b)
+			}
+			row[col] = values[i]
+		}
+		out = append(out, row)
+	}
+	return out, rows.Err()
+}
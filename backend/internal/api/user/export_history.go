@@ -0,0 +1,81 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"musicclubbot/backend/internal/config"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *UserService) ExportMyHistory(ctx context.Context, _ *emptypb.Empty) (*proto.DataExport, error) {
+	userID, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cfg, _ := ctx.Value("cfg").(config.Config)
+	tz := cfg.DefaultTimezone
+	if tz == "" {
+		tz = "UTC"
+	}
+
+	var username string
+	if err := db.QueryRowContext(ctx, `SELECT COALESCE(username, id::text) FROM app_user WHERE id::text = $1`, userID).Scan(&username); err != nil {
+		return nil, status.Errorf(codes.Internal, "load user: %v", err)
+	}
+
+	// Events are dated in their own timezone, song roles in the club's.
+	// Cancelled, archived and upcoming events aren't performances yet.
+	rows, err := db.QueryContext(ctx, `
+		SELECT date, kind, event, song, artist, role FROM (
+		    SELECT a.joined_at AS at, to_char(a.joined_at AT TIME ZONE $2, 'YYYY-MM-DD') AS date,
+		           'song_role' AS kind, '' AS event, s.title AS song, s.artist, a.role
+		    FROM song_role_assignment a JOIN song s ON s.id = a.song_id
+		    WHERE a.user_id::text = $1
+		    UNION ALL
+		    SELECT e.start_at, to_char(e.start_at AT TIME ZONE e.timezone, 'YYYY-MM-DD HH24:MI'),
+		           'event', e.title, COALESCE(s.title, ti.custom_title, ''), COALESCE(s.artist, ti.custom_artist, ''), ep.role
+		    FROM event_participant ep
+		    JOIN event e ON e.id = ep.event_id
+		    LEFT JOIN event_track_item ti ON ti.id = ep.track_item_id
+		    LEFT JOIN song s ON s.id = ti.song_id
+		    WHERE ep.user_id::text = $1 AND e.start_at <= NOW()
+		      AND e.cancelled_at IS NULL AND e.deleted_at IS NULL
+		) h
+		ORDER BY at, kind, event, song
+	`, userID, tz)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load history: %v", err)
+	}
+	defer rows.Close()
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write([]string{"date", "type", "event", "song", "artist", "role"})
+	for rows.Next() {
+		record := make([]string, 6)
+		if err := rows.Scan(&record[0], &record[1], &record[2], &record[3], &record[4], &record[5]); err != nil {
+			return nil, status.Errorf(codes.Internal, "scan history: %v", err)
+		}
+		w.Write(record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "iterate history: %v", err)
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, status.Errorf(codes.Internal, "encode history: %v", err)
+	}
+
+	return &proto.DataExport{
+		Filename:    "musicclub-history-" + username + "-" + time.Now().Format("2006-01-02") + ".csv",
+		Content:     buf.Bytes(),
+		ContentType: "text/csv",
+	}, nil
+}
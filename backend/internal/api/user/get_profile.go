@@ -0,0 +1,86 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"time"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *UserService) GetUserProfile(ctx context.Context, req *proto.GetUserProfileRequest) (*proto.UserProfile, error) {
+	_, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	u := &proto.User{}
+	p := &proto.UserProfile{User: u}
+	var tgID int64
+	var created time.Time
+	err = db.QueryRowContext(ctx, `
+		SELECT id, display_name, COALESCE(username, ''), COALESCE(avatar_url, ''), COALESCE(tg_user_id, 0),
+		       bio, is_chat_member, created_at
+		FROM app_user WHERE id::text = $1 AND deactivated_at IS NULL
+	`, req.GetUserId()).Scan(&u.Id, &u.DisplayName, &u.Username, &u.AvatarUrl, &tgID, &p.Bio, &p.IsChatMember, &created)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load user: %v", err)
+	}
+	u.TelegramId = uint64(tgID)
+	p.MemberSince = timestamppb.New(created)
+
+	rows, err := db.QueryContext(ctx, `
+		SELECT s.id, s.title, s.artist, a.role
+		FROM song_role_assignment a JOIN song s ON s.id = a.song_id
+		WHERE a.user_id = $1
+		ORDER BY s.artist, s.title, a.role
+	`, u.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load song roles: %v", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		r := &proto.ProfileSongRole{}
+		if err := rows.Scan(&r.SongId, &r.Title, &r.Artist, &r.Role); err != nil {
+			return nil, status.Errorf(codes.Internal, "scan song role: %v", err)
+		}
+		p.SongRoles = append(p.SongRoles, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "iterate song roles: %v", err)
+	}
+
+	eventRows, err := db.QueryContext(ctx, `
+		SELECT e.id, e.title, e.start_at, ARRAY_AGG(DISTINCT ep.role ORDER BY ep.role)
+		FROM event_participant ep JOIN event e ON e.id = ep.event_id
+		WHERE ep.user_id = $1 AND e.start_at > NOW()
+		  AND e.deleted_at IS NULL AND e.cancelled_at IS NULL
+		GROUP BY e.id
+		ORDER BY e.start_at
+	`, u.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load events: %v", err)
+	}
+	defer eventRows.Close()
+	for eventRows.Next() {
+		e := &proto.ProfileEvent{}
+		var start time.Time
+		if err := eventRows.Scan(&e.EventId, &e.Title, &start, pq.Array(&e.Roles)); err != nil {
+			return nil, status.Errorf(codes.Internal, "scan event: %v", err)
+		}
+		e.StartAt = timestamppb.New(start)
+		p.UpcomingEvents = append(p.UpcomingEvents, e)
+	}
+	if err := eventRows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "iterate events: %v", err)
+	}
+	return p, nil
+}
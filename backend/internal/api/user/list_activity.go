@@ -0,0 +1,59 @@
+package user
+
+import (
+	"context"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *UserService) ListActivity(ctx context.Context, req *proto.ListActivityRequest) (*proto.ListActivityResponse, error) {
+	_, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int(req.GetPageSize())
+	if limit == 0 {
+		limit = 50
+	}
+	offset := 0
+	if tok := req.GetPageToken(); tok != "" {
+		if v, err := strconv.Atoi(tok); err == nil && v >= 0 {
+			offset = v
+		}
+	}
+
+	// Activity on archived events stays hidden, like the events themselves.
+	rows, err := db.QueryContext(ctx, `
+		SELECT `+helpers.ActivityColumns+`
+		FROM `+helpers.ActivityFrom+`
+		WHERE ($1 = '' OR a.user_id::text = $1)
+		  AND (a.event_id IS NULL OR e.deleted_at IS NULL)
+		ORDER BY a.created_at DESC, a.id DESC
+		LIMIT $2 OFFSET $3
+	`, req.GetUserId(), limit, offset)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list activity: %v", err)
+	}
+	defer rows.Close()
+
+	resp := &proto.ListActivityResponse{}
+	for rows.Next() {
+		a, err := helpers.ScanActivity(rows)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "scan activity: %v", err)
+		}
+		resp.Activities = append(resp.Activities, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "iterate activity: %v", err)
+	}
+	if len(resp.Activities) == limit {
+		resp.NextPageToken = strconv.Itoa(offset + limit)
+	}
+	return resp, nil
+}
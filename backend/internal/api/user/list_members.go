@@ -0,0 +1,93 @@
+package user
+
+import (
+	"context"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"strconv"
+	"strings"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *UserService) ListMembers(ctx context.Context, req *proto.ListMembersRequest) (*proto.ListMembersResponse, error) {
+	_, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int(req.GetPageSize())
+	if limit == 0 {
+		limit = 50
+	}
+	offset := 0
+	if tok := req.GetPageToken(); tok != "" {
+		if v, err := strconv.Atoi(tok); err == nil && v >= 0 {
+			offset = v
+		}
+	}
+
+	// Suspended members are left out of the directory until reinstated,
+	// deactivated ones until they log in again.
+	args := []any{}
+	clauses := []string{
+		"u.is_chat_member",
+		"u.deactivated_at IS NULL",
+		"NOT (u.suspended AND (u.suspended_until IS NULL OR u.suspended_until > NOW()))",
+	}
+	if q := strings.TrimSpace(req.GetQuery()); q != "" {
+		args = append(args, "%"+q+"%")
+		clauses = append(clauses, "(u.username ILIKE $"+strconv.Itoa(len(args))+" OR u.display_name ILIKE $"+strconv.Itoa(len(args))+")")
+	}
+	if inst := strings.TrimSpace(req.GetInstrument()); inst != "" {
+		args = append(args, inst)
+		clauses = append(clauses, "EXISTS(SELECT 1 FROM song_role_assignment a WHERE a.user_id = u.id AND lower(a.role) = lower($"+strconv.Itoa(len(args))+"))")
+	}
+	if groupID := req.GetGroupId(); groupID != "" {
+		args = append(args, groupID)
+		clauses = append(clauses, "EXISTS(SELECT 1 FROM member_group_member gm WHERE gm.user_id = u.id AND gm.group_id::text = $"+strconv.Itoa(len(args))+")")
+	}
+	args = append(args, limit, offset)
+
+	rows, err := db.QueryContext(ctx, `
+		SELECT u.id, u.display_name, COALESCE(u.username, ''), COALESCE(u.avatar_url, ''), COALESCE(u.tg_user_id, 0),
+		       ARRAY(SELECT a.role FROM song_role_assignment a WHERE a.user_id = u.id
+		             GROUP BY a.role ORDER BY COUNT(*) DESC, a.role),
+		       (SELECT COUNT(DISTINCT song_id) FROM song_role_assignment WHERE user_id = u.id),
+		       (SELECT COUNT(DISTINCT ep.event_id) FROM event_participant ep JOIN event e ON e.id = ep.event_id
+		        WHERE ep.user_id = u.id AND e.deleted_at IS NULL),
+		       ARRAY(SELECT g.name FROM member_group_member gm JOIN member_group g ON g.id = gm.group_id
+		             WHERE gm.user_id = u.id ORDER BY lower(g.name)),
+		       COUNT(*) OVER ()
+		FROM app_user u
+		WHERE `+strings.Join(clauses, " AND ")+`
+		ORDER BY u.display_name, u.id
+		LIMIT $`+strconv.Itoa(len(args)-1)+`
+		OFFSET $`+strconv.Itoa(len(args)), args...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list members: %v", err)
+	}
+	defer rows.Close()
+
+	resp := &proto.ListMembersResponse{}
+	for rows.Next() {
+		u := &proto.User{}
+		m := &proto.Member{User: u}
+		var tgID int64
+		if err := rows.Scan(&u.Id, &u.DisplayName, &u.Username, &u.AvatarUrl, &tgID,
+			pq.Array(&m.Instruments), &m.SongCount, &m.EventCount, pq.Array(&m.Groups), &resp.TotalCount); err != nil {
+			return nil, status.Errorf(codes.Internal, "scan member: %v", err)
+		}
+		u.TelegramId = uint64(tgID)
+		resp.Members = append(resp.Members, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "iterate members: %v", err)
+	}
+	if len(resp.Members) == limit {
+		resp.NextPageToken = strconv.Itoa(offset + limit)
+	}
+	return resp, nil
+}
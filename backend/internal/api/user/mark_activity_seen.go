@@ -0,0 +1,21 @@
+package user
+
+import (
+	"context"
+	"musicclubbot/backend/internal/helpers"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *UserService) MarkActivitySeen(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	userID, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := db.ExecContext(ctx, `UPDATE app_user SET activity_seen_at = NOW() WHERE id::text = $1`, userID); err != nil {
+		return nil, status.Errorf(codes.Internal, "mark activity seen: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
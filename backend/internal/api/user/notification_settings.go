@@ -0,0 +1,53 @@
+package user
+
+import (
+	"context"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var digestToDB = map[proto.NotificationDigest]string{
+	proto.NotificationDigest_NOTIFICATION_DIGEST_UNSPECIFIED: "immediate",
+	proto.NotificationDigest_NOTIFICATION_DIGEST_IMMEDIATE:   "immediate",
+	proto.NotificationDigest_NOTIFICATION_DIGEST_DAILY:       "daily",
+	proto.NotificationDigest_NOTIFICATION_DIGEST_WEEKLY:      "weekly",
+}
+
+func (s *UserService) GetNotificationSettings(ctx context.Context, _ *emptypb.Empty) (*proto.NotificationSettings, error) {
+	userID, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var digest string
+	if err := db.QueryRowContext(ctx, `SELECT notification_digest FROM app_user WHERE id::text = $1`, userID).Scan(&digest); err != nil {
+		return nil, status.Errorf(codes.Internal, "load notification settings: %v", err)
+	}
+	settings := &proto.NotificationSettings{Digest: proto.NotificationDigest_NOTIFICATION_DIGEST_IMMEDIATE}
+	for k, v := range digestToDB {
+		if v == digest && k != proto.NotificationDigest_NOTIFICATION_DIGEST_UNSPECIFIED {
+			settings.Digest = k
+		}
+	}
+	return settings, nil
+}
+
+func (s *UserService) UpdateNotificationSettings(ctx context.Context, req *proto.NotificationSettings) (*proto.NotificationSettings, error) {
+	userID, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	digest, ok := digestToDB[req.GetDigest()]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "unknown digest")
+	}
+	// Notifications held for a digest go out with the next run after
+	// switching back to immediate delivery.
+	if _, err := db.ExecContext(ctx, `UPDATE app_user SET notification_digest = $2 WHERE id::text = $1`, userID, digest); err != nil {
+		return nil, status.Errorf(codes.Internal, "update notification settings: %v", err)
+	}
+	return s.GetNotificationSettings(ctx, &emptypb.Empty{})
+}
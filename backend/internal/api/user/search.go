@@ -0,0 +1,61 @@
+package user
+
+import (
+	"context"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// likeEscaper keeps LIKE wildcards typed by the user literal.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func (s *UserService) SearchUsers(ctx context.Context, req *proto.SearchUsersRequest) (*proto.SearchUsersResponse, error) {
+	_, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := strings.TrimPrefix(strings.TrimSpace(req.GetPrefix()), "@")
+	resp := &proto.SearchUsersResponse{}
+	if prefix == "" {
+		return resp, nil
+	}
+	limit := int(req.GetLimit())
+	if limit == 0 {
+		limit = 10
+	}
+	limit = min(limit, 50)
+
+	// Same people as the member directory.
+	rows, err := db.QueryContext(ctx, `
+		SELECT u.id, u.display_name, COALESCE(u.username, ''), COALESCE(u.avatar_url, ''), COALESCE(u.tg_user_id, 0)
+		FROM app_user u
+		WHERE u.is_chat_member
+		  AND u.deactivated_at IS NULL
+		  AND NOT (u.suspended AND (u.suspended_until IS NULL OR u.suspended_until > NOW()))
+		  AND (u.username ILIKE $1 || '%' OR u.display_name ILIKE $1 || '%' OR u.display_name ILIKE '% ' || $1 || '%')
+		ORDER BY u.username ILIKE $1 || '%' DESC, u.display_name ILIKE $1 || '%' DESC, u.display_name, u.id
+		LIMIT $2
+	`, likeEscaper.Replace(prefix), limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "search users: %v", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		u := &proto.User{}
+		var tgID int64
+		if err := rows.Scan(&u.Id, &u.DisplayName, &u.Username, &u.AvatarUrl, &tgID); err != nil {
+			return nil, status.Errorf(codes.Internal, "scan user: %v", err)
+		}
+		u.TelegramId = uint64(tgID)
+		resp.Users = append(resp.Users, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "iterate users: %v", err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,10 @@
+package user
+
+import (
+	"musicclubbot/backend/proto"
+)
+
+// UserService implements the member directory.
+type UserService struct {
+	proto.UnimplementedUserServiceServer
+}
@@ -0,0 +1,36 @@
+package venue
+
+import (
+	"context"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *VenueService) CreateVenue(ctx context.Context, req *proto.VenueInput) (*proto.Venue, error) {
+	userID, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	in := normalizeInput(req)
+	point, err := helpers.ResolveCoordinates(ctx, in.GetCoordinates(), in.GetMapUrl(), in.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	lat, lon := helpers.GeoArgs(point)
+	var id string
+	if err := db.QueryRowContext(ctx, `
+		INSERT INTO venue (name, address, map_url, notes, created_by, latitude, longitude)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`, in.GetName(), nullIfEmpty(in.GetAddress()), nullIfEmpty(in.GetMapUrl()), nullIfEmpty(in.GetNotes()), userID, lat, lon).Scan(&id); err != nil {
+		return nil, status.Errorf(codes.Internal, "insert venue: %v", err)
+	}
+	v, err := helpers.LoadVenue(ctx, db, id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load venue: %v", err)
+	}
+	return v, nil
+}
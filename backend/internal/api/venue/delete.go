@@ -0,0 +1,45 @@
+package venue
+
+import (
+	"context"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *VenueService) DeleteVenue(ctx context.Context, req *proto.VenueId) (*emptypb.Empty, error) {
+	_, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+
+	// Events that only referenced the venue keep its name as their location.
+	for _, table := range []string{"event", "event_series", "event_template"} {
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE `+table+` t SET location = v.name
+			FROM venue v
+			WHERE v.id = $1 AND t.venue_id = v.id AND COALESCE(t.location, '') = ''
+		`, req.GetId()); err != nil {
+			return nil, status.Errorf(codes.Internal, "keep %s locations: %v", table, err)
+		}
+	}
+	res, err := tx.ExecContext(ctx, `DELETE FROM venue WHERE id = $1`, req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "delete venue: %v", err)
+	}
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		return nil, status.Error(codes.NotFound, "venue not found")
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,26 @@
+package venue
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *VenueService) GetVenue(ctx context.Context, req *proto.VenueId) (*proto.Venue, error) {
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	v, err := helpers.LoadVenue(ctx, db, req.GetId())
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "venue not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load venue: %v", err)
+	}
+	return v, nil
+}
@@ -0,0 +1,25 @@
+package venue
+
+import (
+	"database/sql"
+	"musicclubbot/backend/proto"
+	"strings"
+)
+
+// normalizeInput trims the fields.
+func normalizeInput(in *proto.VenueInput) *proto.VenueInput {
+	return &proto.VenueInput{
+		Name:        strings.TrimSpace(in.GetName()),
+		Address:     strings.TrimSpace(in.GetAddress()),
+		MapUrl:      strings.TrimSpace(in.GetMapUrl()),
+		Notes:       strings.TrimSpace(in.GetNotes()),
+		Coordinates: in.GetCoordinates(),
+	}
+}
+
+func nullIfEmpty(s string) interface{} {
+	if s == "" {
+		return sql.NullString{}
+	}
+	return s
+}
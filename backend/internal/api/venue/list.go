@@ -0,0 +1,40 @@
+package venue
+
+import (
+	"context"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *VenueService) ListVenues(ctx context.Context, req *proto.ListVenuesRequest) (*proto.ListVenuesResponse, error) {
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := db.QueryContext(ctx, `
+		SELECT `+helpers.VenueColumns+`
+		FROM venue v
+		WHERE $1 = '' OR v.name ILIKE '%' || $1 || '%' OR v.address ILIKE '%' || $1 || '%'
+		ORDER BY v.name
+	`, strings.TrimSpace(req.GetQuery()))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list venues: %v", err)
+	}
+	defer rows.Close()
+	var venues []*proto.Venue
+	for rows.Next() {
+		v, err := helpers.ScanVenue(rows)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "scan venue: %v", err)
+		}
+		venues = append(venues, v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "iterate venues: %v", err)
+	}
+	return &proto.ListVenuesResponse{Venues: venues}, nil
+}
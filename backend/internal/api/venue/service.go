@@ -0,0 +1,10 @@
+package venue
+
+import (
+	"musicclubbot/backend/proto"
+)
+
+// VenueService implements venue endpoints.
+type VenueService struct {
+	proto.UnimplementedVenueServiceServer
+}
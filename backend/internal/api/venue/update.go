@@ -0,0 +1,38 @@
+package venue
+
+import (
+	"context"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *VenueService) UpdateVenue(ctx context.Context, req *proto.UpdateVenueRequest) (*proto.Venue, error) {
+	_, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	in := normalizeInput(req.GetVenue())
+	point, err := helpers.ResolveCoordinates(ctx, in.GetCoordinates(), in.GetMapUrl(), in.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	lat, lon := helpers.GeoArgs(point)
+	res, err := db.ExecContext(ctx, `
+		UPDATE venue SET name = $2, address = $3, map_url = $4, notes = $5, latitude = $6, longitude = $7
+		WHERE id = $1
+	`, req.GetId(), in.GetName(), nullIfEmpty(in.GetAddress()), nullIfEmpty(in.GetMapUrl()), nullIfEmpty(in.GetNotes()), lat, lon)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "update venue: %v", err)
+	}
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		return nil, status.Error(codes.NotFound, "venue not found")
+	}
+	v, err := helpers.LoadVenue(ctx, db, req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load venue: %v", err)
+	}
+	return v, nil
+}
@@ -0,0 +1,78 @@
+package voting
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *VotingService) CastBallot(ctx context.Context, req *proto.CastBallotRequest) (*proto.VotingRound, error) {
+	userID, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+
+	// Sharing the round row keeps an admin from closing it mid-ballot.
+	var closed bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT `+roundClosedExpr+` FROM voting_round r WHERE r.id::text = $1 FOR SHARE
+	`, req.GetRoundId()).Scan(&closed)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "voting round not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load voting round: %v", err)
+	}
+	if closed {
+		return nil, status.Error(codes.FailedPrecondition, "voting round is closed")
+	}
+
+	ranking := req.GetSongIds()
+	seen := map[string]bool{}
+	for _, id := range ranking {
+		if seen[id] {
+			return nil, status.Error(codes.InvalidArgument, "a song can only be ranked once")
+		}
+		seen[id] = true
+	}
+	if len(ranking) > 0 {
+		var found int
+		if err := tx.QueryRowContext(ctx, `
+			SELECT COUNT(*) FROM voting_candidate WHERE round_id::text = $1 AND song_id::text = ANY($2)
+		`, req.GetRoundId(), pq.Array(ranking)).Scan(&found); err != nil {
+			return nil, status.Errorf(codes.Internal, "check candidates: %v", err)
+		}
+		if found != len(ranking) {
+			return nil, status.Error(codes.InvalidArgument, "song is not a candidate in this round")
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM voting_ballot WHERE round_id::text = $1 AND user_id = $2
+	`, req.GetRoundId(), userID); err != nil {
+		return nil, status.Errorf(codes.Internal, "clear ballot: %v", err)
+	}
+	if len(ranking) > 0 {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO voting_ballot (round_id, user_id, song_id, rank)
+			SELECT $1::uuid, $2, b.id, b.n FROM unnest($3::uuid[]) WITH ORDINALITY AS b(id, n)
+		`, req.GetRoundId(), userID, pq.Array(ranking)); err != nil {
+			return nil, status.Errorf(codes.Internal, "save ballot: %v", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit: %v", err)
+	}
+	return loadRound(ctx, db, req.GetRoundId(), userID)
+}
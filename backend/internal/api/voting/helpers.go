@@ -0,0 +1,142 @@
+package voting
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// roundClosedExpr tells whether round r no longer takes ballots.
+const roundClosedExpr = "(r.closed_at IS NOT NULL OR r.closes_at <= NOW())"
+
+func loadRound(ctx context.Context, db *sql.DB, id, userID string) (*proto.VotingRound, error) {
+	list, _, err := loadRounds(ctx, db, userID, "WHERE r.id::text = $1", "", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, status.Error(codes.NotFound, "voting round not found")
+	}
+	return list[0], nil
+}
+
+// loadRounds lists rounds matching where, open ones first, limited by page,
+// and how many match in total. Candidates and ballots are seen as userID.
+func loadRounds(ctx context.Context, db *sql.DB, userID, where, page string, args ...any) ([]*proto.VotingRound, int32, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT r.id, r.title, COALESCE(r.event_id::text, ''), r.seats, r.closes_at, `+roundClosedExpr+`, r.created_at,
+		       (SELECT COUNT(DISTINCT b.user_id) FROM voting_ballot b WHERE b.round_id = r.id),
+		       COUNT(*) OVER ()
+		FROM voting_round r
+	`+where+`
+		ORDER BY `+roundClosedExpr+`,
+		         CASE WHEN NOT `+roundClosedExpr+` THEN r.closes_at END,
+		         r.closes_at DESC, r.id
+	`+page, args...)
+	if err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "list voting rounds: %v", err)
+	}
+	defer rows.Close()
+	var out []*proto.VotingRound
+	byID := map[string]*proto.VotingRound{}
+	var ids []string
+	var total int32
+	for rows.Next() {
+		r := &proto.VotingRound{}
+		var closesAt, createdAt sql.NullTime
+		if err := rows.Scan(&r.Id, &r.Title, &r.EventId, &r.Seats, &closesAt, &r.Closed, &createdAt, &r.BallotCount, &total); err != nil {
+			return nil, 0, status.Errorf(codes.Internal, "scan voting round: %v", err)
+		}
+		r.ClosesAt = timestamppb.New(closesAt.Time)
+		r.CreatedAt = timestamppb.New(createdAt.Time)
+		out = append(out, r)
+		byID[r.Id] = r
+		ids = append(ids, r.Id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "iterate voting rounds: %v", err)
+	}
+	if len(ids) == 0 {
+		return out, total, nil
+	}
+
+	candidates, err := loadCandidates(ctx, db, ids)
+	if err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "load candidates: %v", err)
+	}
+	var songIDs []string
+	seen := map[string]bool{}
+	for _, list := range candidates {
+		for _, id := range list {
+			if !seen[id] {
+				seen[id] = true
+				songIDs = append(songIDs, id)
+			}
+		}
+	}
+	details, err := helpers.LoadSongDetailsBatch(ctx, db, songIDs, userID)
+	if err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "load candidate songs: %v", err)
+	}
+	songs := map[string]*proto.Song{}
+	for _, d := range details {
+		songs[d.GetSong().GetId()] = d.GetSong()
+	}
+	for roundID, list := range candidates {
+		for _, id := range list {
+			if s := songs[id]; s != nil {
+				byID[roundID].Candidates = append(byID[roundID].Candidates, s)
+			}
+		}
+	}
+
+	ballotRows, err := db.QueryContext(ctx, `
+		SELECT round_id, song_id FROM voting_ballot
+		WHERE round_id = ANY($1) AND user_id::text = $2
+		ORDER BY rank
+	`, pq.Array(ids), userID)
+	if err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "load ballots: %v", err)
+	}
+	defer ballotRows.Close()
+	for ballotRows.Next() {
+		var roundID, songID string
+		if err := ballotRows.Scan(&roundID, &songID); err != nil {
+			return nil, 0, status.Errorf(codes.Internal, "scan ballot: %v", err)
+		}
+		byID[roundID].MyRanking = append(byID[roundID].MyRanking, songID)
+	}
+	if err := ballotRows.Err(); err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "iterate ballots: %v", err)
+	}
+	return out, total, nil
+}
+
+// loadCandidates returns the candidate song ids of each round in listed
+// order.
+func loadCandidates(ctx context.Context, db *sql.DB, roundIDs []string) (map[string][]string, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT round_id, song_id FROM voting_candidate
+		WHERE round_id = ANY($1)
+		ORDER BY position
+	`, pq.Array(roundIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := map[string][]string{}
+	for rows.Next() {
+		var roundID, songID string
+		if err := rows.Scan(&roundID, &songID); err != nil {
+			return nil, err
+		}
+		out[roundID] = append(out[roundID], songID)
+	}
+	return out, rows.Err()
+}
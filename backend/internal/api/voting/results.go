@@ -0,0 +1,163 @@
+package voting
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *VotingService) GetVotingResults(ctx context.Context, req *proto.VotingRoundId) (*proto.VotingResults, error) {
+	userID, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return countRound(ctx, db, req.GetId(), userID)
+}
+
+// countRound counts a closed round's ballots seat by seat.
+func countRound(ctx context.Context, db *sql.DB, roundID, userID string) (*proto.VotingResults, error) {
+	round, err := loadRound(ctx, db, roundID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !round.GetClosed() {
+		return nil, status.Error(codes.FailedPrecondition, "voting round is still open")
+	}
+
+	candidates, err := loadCandidates(ctx, db, []string{round.GetId()})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load candidates: %v", err)
+	}
+	rows, err := db.QueryContext(ctx, `
+		SELECT user_id, song_id FROM voting_ballot WHERE round_id = $1 ORDER BY user_id, rank
+	`, round.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load ballots: %v", err)
+	}
+	defer rows.Close()
+	var ballots [][]string
+	lastVoter := ""
+	for rows.Next() {
+		var voterID, songID string
+		if err := rows.Scan(&voterID, &songID); err != nil {
+			return nil, status.Errorf(codes.Internal, "scan ballot: %v", err)
+		}
+		if voterID != lastVoter {
+			ballots = append(ballots, nil)
+			lastVoter = voterID
+		}
+		ballots[len(ballots)-1] = append(ballots[len(ballots)-1], songID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "iterate ballots: %v", err)
+	}
+
+	c := newCount(candidates[round.GetId()], ballots)
+	results := &proto.VotingResults{Round: round, FirstChoices: c.tallies(c.candidates, c.firstChoices)}
+	remaining := c.candidates
+	for seat := uint32(1); seat <= round.GetSeats() && len(remaining) > 0; seat++ {
+		winner, steps := c.runoff(remaining)
+		results.Seats = append(results.Seats, &proto.VotingSeat{Seat: seat, SongId: winner, Steps: steps})
+		remaining = without(remaining, winner)
+	}
+	return results, nil
+}
+
+// count runs instant-runoff counts over a round's ballots.
+type count struct {
+	// candidates in listed order, which breaks ties that first choices
+	// don't: earlier listed wins.
+	candidates []string
+	position   map[string]int
+	ballots    [][]string
+	// firstChoices are the all-candidate first-choice votes.
+	firstChoices map[string]uint32
+}
+
+func newCount(candidates []string, ballots [][]string) *count {
+	c := &count{candidates: candidates, position: map[string]int{}, ballots: ballots}
+	for i, id := range candidates {
+		c.position[id] = i
+	}
+	c.firstChoices, _ = c.votes(candidates)
+	return c
+}
+
+// votes gives each ballot to its highest ranked song among remaining and
+// reports the ballots ranking none of them.
+func (c *count) votes(remaining []string) (map[string]uint32, uint32) {
+	in := map[string]bool{}
+	for _, id := range remaining {
+		in[id] = true
+	}
+	votes := map[string]uint32{}
+	var exhausted uint32
+	for _, ballot := range c.ballots {
+		counted := false
+		for _, id := range ballot {
+			if in[id] {
+				votes[id]++
+				counted = true
+				break
+			}
+		}
+		if !counted {
+			exhausted++
+		}
+	}
+	return votes, exhausted
+}
+
+// tallies orders remaining best first: by votes, then first choices, then
+// listed order.
+func (c *count) tallies(remaining []string, votes map[string]uint32) []*proto.VotingTally {
+	out := make([]*proto.VotingTally, 0, len(remaining))
+	for _, id := range remaining {
+		out = append(out, &proto.VotingTally{SongId: id, Votes: votes[id]})
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		a, b := out[i], out[j]
+		if a.Votes != b.Votes {
+			return a.Votes > b.Votes
+		}
+		if c.firstChoices[a.SongId] != c.firstChoices[b.SongId] {
+			return c.firstChoices[a.SongId] > c.firstChoices[b.SongId]
+		}
+		return c.position[a.SongId] < c.position[b.SongId]
+	})
+	return out
+}
+
+// runoff picks one winner among remaining: a song ranked highest on a
+// majority of the ballots still in play wins, otherwise the last placed song
+// drops out and its ballots move to their next choice.
+func (c *count) runoff(remaining []string) (string, []*proto.RunoffStep) {
+	var steps []*proto.RunoffStep
+	for {
+		votes, exhausted := c.votes(remaining)
+		tallies := c.tallies(remaining, votes)
+		step := &proto.RunoffStep{Tallies: tallies, Exhausted: exhausted}
+		steps = append(steps, step)
+		active := uint32(len(c.ballots)) - exhausted
+		if len(tallies) == 1 || tallies[0].Votes*2 > active {
+			return tallies[0].SongId, steps
+		}
+		step.EliminatedSongId = tallies[len(tallies)-1].SongId
+		remaining = without(remaining, step.EliminatedSongId)
+	}
+}
+
+func without(ids []string, id string) []string {
+	out := make([]string, 0, len(ids))
+	for _, other := range ids {
+		if other != id {
+			out = append(out, other)
+		}
+	}
+	return out
+}
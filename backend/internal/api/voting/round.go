@@ -0,0 +1,188 @@
+package voting
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *VotingService) ListVotingRounds(ctx context.Context, req *proto.ListVotingRoundsRequest) (*proto.ListVotingRoundsResponse, error) {
+	userID, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int(req.GetPageSize())
+	if limit == 0 {
+		limit = 50
+	}
+	offset := 0
+	if tok := req.GetPageToken(); tok != "" {
+		if v, err := strconv.Atoi(tok); err == nil && v >= 0 {
+			offset = v
+		}
+	}
+
+	where := "WHERE TRUE"
+	if req.GetOnlyOpen() {
+		where += " AND NOT " + roundClosedExpr
+	}
+	list, total, err := loadRounds(ctx, db, userID, where, "LIMIT $1 OFFSET $2", limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	resp := &proto.ListVotingRoundsResponse{Rounds: list, TotalCount: total}
+	if offset+len(list) < int(total) {
+		resp.NextPageToken = strconv.Itoa(offset + len(list))
+	}
+	return resp, nil
+}
+
+func (s *VotingService) GetVotingRound(ctx context.Context, req *proto.VotingRoundId) (*proto.VotingRound, error) {
+	userID, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return loadRound(ctx, db, req.GetId(), userID)
+}
+
+func (s *VotingService) CreateVotingRound(ctx context.Context, req *proto.CreateVotingRoundRequest) (*proto.VotingRound, error) {
+	userID, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	title := strings.TrimSpace(req.GetTitle())
+	if req.GetClosesAt() == nil || !req.GetClosesAt().AsTime().After(time.Now()) {
+		return nil, status.Error(codes.InvalidArgument, "closes_at must be in the future")
+	}
+	var candidates []string
+	seen := map[string]bool{}
+	for _, id := range req.GetCandidateSongIds() {
+		if !seen[id] {
+			seen[id] = true
+			candidates = append(candidates, id)
+		}
+	}
+	if len(candidates) < 2 {
+		return nil, status.Error(codes.InvalidArgument, "a round needs at least two candidate songs")
+	}
+	seats := req.GetSeats()
+	if seats == 0 {
+		seats = 1
+	}
+	if int(seats) > len(candidates) {
+		return nil, status.Error(codes.InvalidArgument, "seats must not exceed the number of candidates")
+	}
+
+	var found int
+	if err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM song WHERE id::text = ANY($1)`, pq.Array(candidates)).Scan(&found); err != nil {
+		return nil, status.Errorf(codes.Internal, "check songs: %v", err)
+	}
+	if found != len(candidates) {
+		return nil, status.Error(codes.InvalidArgument, "candidate song not found")
+	}
+	if req.GetEventId() != "" {
+		var exists bool
+		err := db.QueryRowContext(ctx, `SELECT TRUE FROM event WHERE id::text = $1 AND deleted_at IS NULL`, req.GetEventId()).Scan(&exists)
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "event not found")
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "load event: %v", err)
+		}
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+	var id string
+	if err := tx.QueryRowContext(ctx, `
+		INSERT INTO voting_round (title, event_id, seats, closes_at, created_by)
+		VALUES ($1, NULLIF($2, '')::uuid, $3, $4, $5)
+		RETURNING id
+	`, title, req.GetEventId(), seats, req.GetClosesAt().AsTime(), userID).Scan(&id); err != nil {
+		return nil, status.Errorf(codes.Internal, "insert voting round: %v", err)
+	}
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO voting_candidate (round_id, song_id, position)
+		SELECT $1, c.id, c.n FROM unnest($2::uuid[]) WITH ORDINALITY AS c(id, n)
+	`, id, pq.Array(candidates)); err != nil {
+		return nil, status.Errorf(codes.Internal, "insert candidates: %v", err)
+	}
+	if err := helpers.RecordAudit(ctx, tx, helpers.AuditVotingRound, id, "create", fmt.Sprintf("%s, %d candidates", title, len(candidates))); err != nil {
+		return nil, status.Errorf(codes.Internal, "record audit: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit: %v", err)
+	}
+	return loadRound(ctx, db, id, userID)
+}
+
+func (s *VotingService) CloseVotingRound(ctx context.Context, req *proto.VotingRoundId) (*proto.VotingRound, error) {
+	userID, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	round, err := loadRound(ctx, db, req.GetId(), userID)
+	if err != nil {
+		return nil, err
+	}
+	if round.GetClosed() {
+		return nil, status.Error(codes.FailedPrecondition, "voting round is already closed")
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+	if _, err := tx.ExecContext(ctx, `UPDATE voting_round SET closed_at = NOW() WHERE id::text = $1 AND closed_at IS NULL`, req.GetId()); err != nil {
+		return nil, status.Errorf(codes.Internal, "close voting round: %v", err)
+	}
+	if err := helpers.RecordAudit(ctx, tx, helpers.AuditVotingRound, req.GetId(), "close", round.GetTitle()); err != nil {
+		return nil, status.Errorf(codes.Internal, "record audit: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit: %v", err)
+	}
+	return loadRound(ctx, db, req.GetId(), userID)
+}
+
+func (s *VotingService) DeleteVotingRound(ctx context.Context, req *proto.VotingRoundId) (*emptypb.Empty, error) {
+	_, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "begin tx: %v", err)
+	}
+	defer tx.Rollback()
+	var title string
+	err = tx.QueryRowContext(ctx, `DELETE FROM voting_round WHERE id::text = $1 RETURNING title`, req.GetId()).Scan(&title)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "voting round not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "delete voting round: %v", err)
+	}
+	if err := helpers.RecordAudit(ctx, tx, helpers.AuditVotingRound, req.GetId(), "delete", title); err != nil {
+		return nil, status.Errorf(codes.Internal, "record audit: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
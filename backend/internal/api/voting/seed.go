@@ -0,0 +1,69 @@
+package voting
+
+import (
+	"context"
+	"musicclubbot/backend/internal/api/event"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *VotingService) SeedTracklist(ctx context.Context, req *proto.SeedTracklistRequest) (*proto.EventDetails, error) {
+	userID, db, err := helpers.UserAndDbFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	results, err := countRound(ctx, db, req.GetRoundId(), userID)
+	if err != nil {
+		return nil, err
+	}
+	eventID := req.GetEventId()
+	if eventID == "" {
+		eventID = results.GetRound().GetEventId()
+	}
+	if eventID == "" {
+		return nil, status.Error(codes.InvalidArgument, "event_id is required for rounds without an event")
+	}
+
+	rows, err := db.QueryContext(ctx, `
+		SELECT song_id FROM event_track_item WHERE event_id::text = $1 AND song_id IS NOT NULL
+	`, eventID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load tracklist: %v", err)
+	}
+	defer rows.Close()
+	listed := map[string]bool{}
+	for rows.Next() {
+		var songID string
+		if err := rows.Scan(&songID); err != nil {
+			return nil, status.Errorf(codes.Internal, "scan tracklist: %v", err)
+		}
+		listed[songID] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "iterate tracklist: %v", err)
+	}
+
+	// Going through the event service keeps its rights checks, audit entries
+	// and change notifications.
+	events := &event.EventService{}
+	var details *proto.EventDetails
+	for _, seat := range results.GetSeats() {
+		if listed[seat.GetSongId()] {
+			continue
+		}
+		details, err = events.InsertTrackItem(ctx, &proto.InsertTrackItemRequest{
+			EventId: eventID,
+			Item:    &proto.TrackItem{SongId: seat.GetSongId()},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if details == nil {
+		return events.GetEvent(ctx, &proto.EventId{Id: eventID})
+	}
+	return details, nil
+}
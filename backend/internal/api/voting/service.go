@@ -0,0 +1,10 @@
+package voting
+
+import (
+	"musicclubbot/backend/proto"
+)
+
+// VotingService implements ranked-choice voting endpoints.
+type VotingService struct {
+	proto.UnimplementedVotingServiceServer
+}
@@ -2,23 +2,35 @@ package app
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"log/slog"
 	"net"
 	"net/http"
+	"strings"
 
-	"github.com/apsdehal/go-logger"
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 	"google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 
 	"musicclubbot/backend/internal/api"
 	"musicclubbot/backend/internal/api/auth"
 	"musicclubbot/backend/internal/config"
+	"musicclubbot/backend/internal/gateway"
+	"musicclubbot/backend/internal/health"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/internal/httpapi"
+	"musicclubbot/backend/internal/jobs"
+	"musicclubbot/backend/internal/metrics"
+	"musicclubbot/backend/internal/telegram"
 )
 
-var propagatedCtxKeys = []string{"cfg", "log", "db"}
+var propagatedCtxKeys = []string{"cfg", "log", "db", "db_router", "hub", "storage", "usage"}
 
 func Run(ctx context.Context) error {
 	cfg := mustCfg(ctx)
@@ -29,45 +41,146 @@ func Run(ctx context.Context) error {
 		return fmt.Errorf("listen on %s: %w", cfg.GRPCAddr(), err)
 	}
 
-	grpcServer := newGrpcServer(ctx)
+	var tg *telegram.Client
+	if cfg.HealthCheckTelegram && cfg.BotToken != "" {
+		tg = telegram.New(cfg.BotToken)
+	}
+	checker := health.New(ctx.Value("db").(*sql.DB), tg)
+	healthServer := grpchealth.NewServer()
+
+	// Calls in flight outlive ctx, which is done at SIGTERM, until the
+	// server has drained.
+	serveCtx, stopServing := context.WithCancel(context.WithoutCancel(ctx))
+	defer stopServing()
+	calls := newCallTracker()
+	grpcServer := newGrpcServer(serveCtx, calls)
 	api.Register(grpcServer)
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
 	reflection.Register(grpcServer)
 
-	httpServer := &http.Server{
-		Handler: newHTTPHandler(grpcServer),
+	handler, err := newHTTPHandler(serveCtx, grpcServer, checker, calls)
+	if err != nil {
+		return err
+	}
+	httpServer := &http.Server{Handler: handler}
+	tlsConfig, acmeHandler, err := tlsSetup(cfg)
+	if err != nil {
+		return err
+	}
+	httpServer.TLSConfig = tlsConfig
+	if acmeHandler != nil && cfg.TLSHTTPPort != "" {
+		go serveACME(ctx, log, ":"+cfg.TLSHTTPPort, acmeHandler)
 	}
 
-	go gracefulShutdown(ctx, grpcServer, httpServer)
+	go checker.Watch(ctx, healthServer, func(status healthpb.HealthCheckResponse_ServingStatus, err error) {
+		if err != nil {
+			log.Error("health changed", "status", status.String(), "error", err)
+		} else {
+			log.Info("health changed", "status", status.String())
+		}
+	})
+	// Jobs stop after the server has drained, so they still handle what the
+	// last calls left behind, such as API usage and notifications.
+	jobsCtx, cancelJobs := context.WithCancel(serveCtx)
+	defer cancelJobs()
+	jobsDone := jobs.Start(jobsCtx)
 
-	log.Infof("Starting gRPC server on %s", cfg.GRPCAddr())
-	if err := httpServer.Serve(lis); err != nil && err != http.ErrServerClosed {
+	log.Info("starting gRPC server", "addr", cfg.GRPCAddr(), "tls", tlsConfig != nil)
+	serveErr := make(chan error, 1)
+	go func() {
+		if tlsConfig != nil {
+			serveErr <- httpServer.ServeTLS(lis, "", "")
+		} else {
+			serveErr <- httpServer.Serve(lis)
+		}
+	}()
+	select {
+	case err := <-serveErr:
 		return fmt.Errorf("serve gRPC/gRPC-Web: %w", err)
+	case <-ctx.Done():
 	}
 
+	log.Info("shutting down", "timeout", cfg.ShutdownTimeout)
+	drainServer(log, cfg.ShutdownTimeout, calls, grpcServer, httpServer, checker)
+	stopJobs(log, cancelJobs, jobsDone)
+	log.Info("shutdown complete")
 	return nil
 }
 
 /* -------------------- helpers -------------------- */
 
-func newGrpcServer(baseCtx context.Context) *grpc.Server {
+func newGrpcServer(baseCtx context.Context, calls *callTracker) *grpc.Server {
 	return grpc.NewServer(
+		// Starts a span per call, continuing the caller's trace; handlers
+		// pass ctx on, so database and Telegram spans nest under it.
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 		grpc.ChainUnaryInterceptor(
 			withBaseContext(baseCtx),
+			requestIDInterceptor,
+			metricsInterceptor,
 			loggingInterceptor,
+			calls.unaryInterceptor,
+			recoveryInterceptor,
 			auth.AuthInterceptor,
+			userLoggingInterceptor,
+			usageInterceptor,
+			auth.AuthorizationInterceptor,
+			validationInterceptor,
+		),
+		grpc.ChainStreamInterceptor(
+			withBaseContextStream(baseCtx),
+			streamRequestIDInterceptor,
+			streamMetricsInterceptor,
+			streamLoggingInterceptor,
+			calls.streamInterceptor,
+			streamRecoveryInterceptor,
+			auth.AuthStreamInterceptor,
+			streamUserLoggingInterceptor,
+			streamUsageInterceptor,
+			auth.AuthorizationStreamInterceptor,
+			streamValidationInterceptor,
 		),
 	)
 }
 
-func newHTTPHandler(grpcServer *grpc.Server) http.Handler {
+func newHTTPHandler(baseCtx context.Context, grpcServer *grpc.Server, checker *health.Checker, calls *callTracker) (http.Handler, error) {
+	allowOrigin := originMatcher(mustCfg(baseCtx).AllowedOrigins)
 	grpcWeb := grpcweb.WrapServer(
 		grpcServer,
-		grpcweb.WithOriginFunc(func(string) bool { return true }),
+		grpcweb.WithOriginFunc(allowOrigin),
+		// Client-streaming uploads (UploadDemo) need the websocket transport.
+		grpcweb.WithWebsockets(true),
+		grpcweb.WithWebsocketOriginFunc(func(r *http.Request) bool {
+			// Only browsers send Origin, and only browsers need protecting.
+			origin := r.Header.Get("Origin")
+			return origin == "" || allowOrigin(origin)
+		}),
 	)
 
+	gw, err := gateway.New(baseCtx, grpcServer)
+	if err != nil {
+		return nil, fmt.Errorf("start REST gateway: %w", err)
+	}
+	openAPI, err := gateway.OpenAPIHandler()
+	if err != nil {
+		return nil, fmt.Errorf("load OpenAPI document: %w", err)
+	}
+
+	mux := http.NewServeMux()
+	httpapi.Register(mux)
+	mux.Handle(gateway.Prefix+"/", gw)
+	mux.Handle("GET /openapi.json", openAPI)
+	mux.Handle("GET /healthz", checker.LiveHandler())
+	mux.Handle("GET /readyz", checker.ReadyHandler())
+	// Not proxied by nginx, so only reachable inside the deployment.
+	mux.Handle("GET /metrics", metrics.Handler())
+	plain := withBaseContextHTTP(baseCtx, withRequestIDHTTP(mux))
+
+	// Tracked inside h2c, which serves a whole HTTP/2 connection as a
+	// single request.
 	return h2c.NewHandler(
-		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			if handlePreflight(w, r) {
+		calls.track(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if handlePreflight(w, r, allowOrigin) {
 				return
 			}
 
@@ -76,16 +189,16 @@ func newHTTPHandler(grpcServer *grpc.Server) http.Handler {
 				return
 			}
 
-			http.NotFound(w, r)
-		}),
-		&http2.Server{},
-	)
-}
+			// Native gRPC clients, such as grpc_health_probe.
+			if r.ProtoMajor == 2 && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+				grpcServer.ServeHTTP(w, r)
+				return
+			}
 
-func gracefulShutdown(ctx context.Context, grpcServer *grpc.Server, httpServer *http.Server) {
-	<-ctx.Done()
-	grpcServer.GracefulStop()
-	_ = httpServer.Shutdown(context.Background())
+			plain.ServeHTTP(w, r)
+		})),
+		&http2.Server{},
+	), nil
 }
 
 func withBaseContext(base context.Context) grpc.UnaryServerInterceptor {
@@ -105,24 +218,59 @@ func withBaseContext(base context.Context) grpc.UnaryServerInterceptor {
 
 }
 
+func withBaseContextStream(base context.Context) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		_ *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx := ss.Context()
+		for _, key := range propagatedCtxKeys {
+			if v := base.Value(key); v != nil {
+				ctx = context.WithValue(ctx, key, v)
+			}
+		}
+		return handler(srv, &helpers.ServerStreamWithContext{ServerStream: ss, Ctx: ctx})
+	}
+}
+
+func withBaseContextHTTP(base context.Context, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		for _, key := range propagatedCtxKeys {
+			if v := base.Value(key); v != nil {
+				ctx = context.WithValue(ctx, key, v)
+			}
+		}
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
 func mustCfg(ctx context.Context) config.Config {
 	return ctx.Value("cfg").(config.Config)
 }
 
-func mustLog(ctx context.Context) *logger.Logger {
-	return ctx.Value("log").(*logger.Logger)
+func mustLog(ctx context.Context) *slog.Logger {
+	return ctx.Value("log").(*slog.Logger)
 }
 
-func handlePreflight(w http.ResponseWriter, r *http.Request) bool {
+func handlePreflight(w http.ResponseWriter, r *http.Request, allowOrigin func(string) bool) bool {
 	if r.Method != http.MethodOptions {
 		return false
 	}
 
-	w.Header().Set("Access-Control-Allow-Origin", "*")
+	origin := r.Header.Get("Origin")
+	w.Header().Add("Vary", "Origin")
+	if !allowOrigin(origin) {
+		w.WriteHeader(http.StatusForbidden)
+		return true
+	}
+	w.Header().Set("Access-Control-Allow-Origin", origin)
 	w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
 	w.Header().Set(
 		"Access-Control-Allow-Headers",
-		"Content-Type, X-Grpc-Web, X-User-Agent, Authorization",
+		"Content-Type, X-Grpc-Web, X-User-Agent, Authorization, X-Request-Id, Grpc-Timeout",
 	)
 	w.WriteHeader(http.StatusNoContent)
 	return true
@@ -0,0 +1,40 @@
+package app
+
+import (
+	"net/url"
+	"strings"
+)
+
+// originMatcher reports whether browsers on origin may call the API.
+// Patterns are origins ("https://club.example.com"), origins with a
+// wildcard subdomain ("https://*.example.com", which doesn't match
+// example.com itself) or "*". No patterns allows every origin.
+func originMatcher(patterns []string) func(origin string) bool {
+	if len(patterns) == 0 {
+		return func(string) bool { return true }
+	}
+	return func(origin string) bool {
+		o, err := url.Parse(origin)
+		if err != nil || o.Scheme == "" || o.Host == "" {
+			return false
+		}
+		for _, pattern := range patterns {
+			if pattern == "*" {
+				return true
+			}
+			p, err := url.Parse(pattern)
+			if err != nil || p.Scheme != o.Scheme || p.Port() != o.Port() {
+				continue
+			}
+			host, want := strings.ToLower(o.Hostname()), strings.ToLower(p.Hostname())
+			if suffix, ok := strings.CutPrefix(want, "*."); ok {
+				if strings.HasSuffix(host, "."+suffix) {
+					return true
+				}
+			} else if host == want {
+				return true
+			}
+		}
+		return false
+	}
+}
@@ -2,43 +2,111 @@ package app
 
 import (
 	"context"
+	"log/slog"
 	"time"
 
-	"github.com/apsdehal/go-logger"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/internal/logging"
 )
 
+// requestLog gathers fields learned further down the chain, such as the
+// user, for the line logged when the call ends.
+type requestLog struct {
+	userID string
+}
+
+// loggingInterceptor gives the handler a logger carrying the method and
+// client address and logs one line per call.
 func loggingInterceptor(
 	ctx context.Context,
 	req any,
 	info *grpc.UnaryServerInfo,
 	handler grpc.UnaryHandler,
 ) (any, error) {
-	log := ctx.Value("log").(*logger.Logger)
-
+	ctx, rl := startRequestLog(ctx, info.FullMethod)
 	start := time.Now()
 	resp, err := handler(ctx, req)
-	duration := time.Since(start)
+	logCall(ctx, rl, "call handled", time.Since(start), err)
+	return resp, err
+}
 
-	ip := realIPFromContext(ctx)
+func streamLoggingInterceptor(
+	srv any,
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	ctx, rl := startRequestLog(ss.Context(), info.FullMethod)
+	start := time.Now()
+	err := handler(srv, &helpers.ServerStreamWithContext{ServerStream: ss, Ctx: ctx})
+	logCall(ctx, rl, "stream closed", time.Since(start), err)
+	return err
+}
 
-	if err != nil {
-		if ip != "" {
-			log.Errorf("[%s] %s failed: %v", ip, info.FullMethod, err)
-		} else {
-			log.Errorf("%s failed: %v", info.FullMethod, err)
-		}
-		return resp, err
+// userLoggingInterceptor runs after authentication and adds the user to the
+// request's logs.
+func userLoggingInterceptor(
+	ctx context.Context,
+	req any,
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	return handler(withUserLog(ctx), req)
+}
+
+func streamUserLoggingInterceptor(
+	srv any,
+	ss grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	return handler(srv, &helpers.ServerStreamWithContext{ServerStream: ss, Ctx: withUserLog(ss.Context())})
+}
+
+func startRequestLog(ctx context.Context, method string) (context.Context, *requestLog) {
+	args := []any{"method", method}
+	if ip := realIPFromContext(ctx); ip != "" {
+		args = append(args, "ip", ip)
 	}
+	rl := &requestLog{}
+	ctx = context.WithValue(logging.With(ctx, args...), "request_log", rl)
+	return ctx, rl
+}
 
-	if ip != "" {
-		log.Infof("[%s] %s handled in %s", ip, info.FullMethod, duration)
-	} else {
-		log.Infof("%s handled in %s", info.FullMethod, duration)
+func withUserLog(ctx context.Context) context.Context {
+	userID, _ := ctx.Value("user_id").(string)
+	if userID == "" {
+		return ctx
 	}
+	if rl, ok := ctx.Value("request_log").(*requestLog); ok {
+		rl.userID = userID
+	}
+	return logging.With(ctx, "user_id", userID)
+}
 
-	return resp, nil
+// logCall logs a finished call: failures the client caused as warnings,
+// the server's own as errors.
+func logCall(ctx context.Context, rl *requestLog, msg string, took time.Duration, err error) {
+	code := status.Code(err)
+	args := []any{"code", code.String(), "duration", took}
+	if rl.userID != "" {
+		args = append(args, "user_id", rl.userID)
+	}
+	level := slog.LevelInfo
+	if err != nil {
+		args = append(args, "error", status.Convert(err).Message())
+		level = slog.LevelWarn
+		switch code {
+		case codes.Unknown, codes.Internal, codes.DataLoss, codes.Unavailable, codes.DeadlineExceeded:
+			level = slog.LevelError
+		}
+	}
+	logging.FromContext(ctx).Log(ctx, level, msg, args...)
 }
 
 func realIPFromContext(ctx context.Context) string {
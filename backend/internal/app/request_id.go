@@ -0,0 +1,91 @@
+package app
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/internal/logging"
+)
+
+const (
+	requestIDHeader = "x-request-id"
+	// maxRequestIDLength bounds ids taken from clients, which end up in
+	// every log line of the call.
+	maxRequestIDLength = 128
+)
+
+// requestIDInterceptor tags the call with the client's x-request-id, or a
+// new one, and sends it back in the response headers so an error the
+// client reports can be found in the logs.
+func requestIDInterceptor(
+	ctx context.Context,
+	req any,
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	ctx, id := withRequestID(ctx, incomingRequestID(ctx))
+	_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDHeader, id))
+	return handler(ctx, req)
+}
+
+func streamRequestIDInterceptor(
+	srv any,
+	ss grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	ctx, id := withRequestID(ss.Context(), incomingRequestID(ss.Context()))
+	_ = ss.SetHeader(metadata.Pairs(requestIDHeader, id))
+	return handler(srv, &helpers.ServerStreamWithContext{ServerStream: ss, Ctx: ctx})
+}
+
+// withRequestIDHTTP does the same for the plain HTTP routes.
+func withRequestIDHTTP(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, id := withRequestID(r.Context(), r.Header.Get(requestIDHeader))
+		// The REST gateway passes it on to the call it makes.
+		r.Header.Set(requestIDHeader, id)
+		w.Header().Set(requestIDHeader, id)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func incomingRequestID(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(requestIDHeader); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// withRequestID stores id, or a new one when it is missing or unusable,
+// under "request_id" and in the logger.
+func withRequestID(ctx context.Context, id string) (context.Context, string) {
+	if !validRequestID(id) {
+		id = uuid.NewString()
+	}
+	ctx = context.WithValue(ctx, "request_id", id)
+	return logging.With(ctx, "request_id", id), id
+}
+
+// validRequestID accepts short ids of printable ASCII without spaces, so a
+// client can't forge log lines or headers with it.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] <= ' ' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,188 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"musicclubbot/backend/internal/health"
+	"musicclubbot/backend/internal/helpers"
+)
+
+// jobsStopTimeout is how long the background jobs get to return once the
+// server has drained.
+const jobsStopTimeout = 10 * time.Second
+
+var errShuttingDown = status.Error(codes.Unavailable, "server is shutting down")
+
+// callTracker counts the requests in flight so shutdown can wait for them.
+// grpc.Server.GracefulStop can't be used for that: it panics on calls
+// served through ServeHTTP, which every gRPC-Web call is. Requests are
+// counted by the HTTP handler rather than an interceptor, which returns
+// before the response is written.
+type callTracker struct {
+	mu       sync.Mutex
+	active   int
+	draining bool
+	// idle is closed once draining with no requests left.
+	idle     chan struct{}
+	idleOnce sync.Once
+	// watches cancels the server-streaming calls, which otherwise only end
+	// when the client goes away.
+	watches map[int]context.CancelCauseFunc
+	nextID  int
+}
+
+func newCallTracker() *callTracker {
+	return &callTracker{
+		idle:    make(chan struct{}),
+		watches: map[int]context.CancelCauseFunc{},
+	}
+}
+
+// track counts the requests next serves.
+func (t *callTracker) track(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.mu.Lock()
+		t.active++
+		t.mu.Unlock()
+		defer t.leave()
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (t *callTracker) leave() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.active--
+	if t.draining && t.active == 0 {
+		t.idleOnce.Do(func() { close(t.idle) })
+	}
+}
+
+func (t *callTracker) isDraining() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.draining
+}
+
+func (t *callTracker) watch(cancel context.CancelCauseFunc) (unwatch func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.draining {
+		cancel(errShuttingDown)
+		return func() {}
+	}
+	id := t.nextID
+	t.nextID++
+	t.watches[id] = cancel
+	return func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		delete(t.watches, id)
+	}
+}
+
+// drain refuses new calls, ends the watches and waits for the other
+// requests until ctx is done. It returns how many are still running.
+func (t *callTracker) drain(ctx context.Context) int {
+	t.mu.Lock()
+	t.draining = true
+	if t.active == 0 {
+		t.idleOnce.Do(func() { close(t.idle) })
+	}
+	for _, cancel := range t.watches {
+		cancel(errShuttingDown)
+	}
+	t.mu.Unlock()
+
+	select {
+	case <-t.idle:
+		return 0
+	case <-ctx.Done():
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		return t.active
+	}
+}
+
+func (t *callTracker) unaryInterceptor(
+	ctx context.Context,
+	req any,
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	if t.isDraining() {
+		return nil, errShuttingDown
+	}
+	return handler(ctx, req)
+}
+
+func (t *callTracker) streamInterceptor(
+	srv any,
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	if t.isDraining() {
+		return errShuttingDown
+	}
+	if info.IsClientStream || !info.IsServerStream {
+		return handler(srv, ss)
+	}
+
+	ctx, cancel := context.WithCancelCause(ss.Context())
+	defer cancel(nil)
+	defer t.watch(cancel)()
+	err := handler(srv, &helpers.ServerStreamWithContext{ServerStream: ss, Ctx: ctx})
+	// Watch handlers end quietly when their context is done; tell the
+	// client to reconnect instead.
+	if errors.Is(context.Cause(ctx), errShuttingDown) {
+		return errShuttingDown
+	}
+	return err
+}
+
+// drainServer stops the server: the readiness check fails and new calls are
+// refused, the calls in flight get up to timeout to finish, and whatever is
+// left after that is cancelled.
+func drainServer(
+	log *slog.Logger,
+	timeout time.Duration,
+	calls *callTracker,
+	grpcServer *grpc.Server,
+	httpServer *http.Server,
+	checker *health.Checker,
+) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	checker.ShuttingDown()
+
+	httpDone := make(chan error, 1)
+	go func() { httpDone <- httpServer.Shutdown(ctx) }()
+	if left := calls.drain(ctx); left > 0 {
+		log.Warn("shutdown timeout reached, cancelling requests", "requests", left, "timeout", timeout)
+	}
+	// Cancels the calls left and closes the gateway's in-process listener.
+	grpcServer.Stop()
+	if err := <-httpDone; err != nil {
+		_ = httpServer.Close()
+	}
+}
+
+// stopJobs cancels the background jobs and waits for them to return.
+func stopJobs(log *slog.Logger, cancel context.CancelFunc, done <-chan struct{}) {
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(jobsStopTimeout):
+		log.Warn("background jobs still running at shutdown", "timeout", jobsStopTimeout)
+	}
+}
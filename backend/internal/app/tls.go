@@ -0,0 +1,101 @@
+package app
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/acme/autocert"
+
+	"musicclubbot/backend/internal/config"
+)
+
+// tlsSetup returns the TLS config for the server, nil when TLS is off, and
+// for Let's Encrypt the handler the plain HTTP port serves: ACME challenges
+// and redirects to HTTPS.
+func tlsSetup(cfg config.Config) (*tls.Config, http.Handler, error) {
+	switch {
+	case len(cfg.TLSAutocertDomains) > 0:
+		m := &autocert.Manager{
+			Prompt:     autocert.AcceptTOS,
+			HostPolicy: autocert.HostWhitelist(cfg.TLSAutocertDomains...),
+			Cache:      autocert.DirCache(cfg.TLSAutocertDir),
+			Email:      cfg.TLSAutocertEmail,
+		}
+		// Answers tls-alpn-01 challenges itself, so the HTTP port is only
+		// needed for http-01.
+		return m.TLSConfig(), m.HTTPHandler(nil), nil
+	case cfg.TLSCertFile != "":
+		kp := &keyPair{certFile: cfg.TLSCertFile, keyFile: cfg.TLSKeyFile}
+		if _, err := kp.certificate(nil); err != nil {
+			return nil, nil, err
+		}
+		return &tls.Config{
+			GetCertificate: kp.certificate,
+			NextProtos:     []string{"h2", "http/1.1"},
+			MinVersion:     tls.VersionTLS12,
+		}, nil, nil
+	}
+	return nil, nil, nil
+}
+
+// keyPair serves a certificate from files, reloading them once they change
+// so a renewed certificate is picked up without a restart.
+type keyPair struct {
+	certFile, keyFile string
+
+	mu      sync.Mutex
+	cert    *tls.Certificate
+	modTime time.Time
+	checked time.Time
+}
+
+// keyPairCheckInterval is how often the files are checked for changes.
+const keyPairCheckInterval = time.Minute
+
+func (k *keyPair) certificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if k.cert != nil && time.Since(k.checked) < keyPairCheckInterval {
+		return k.cert, nil
+	}
+	k.checked = time.Now()
+	info, err := os.Stat(k.certFile)
+	if err != nil {
+		if k.cert != nil {
+			return k.cert, nil
+		}
+		return nil, fmt.Errorf("load TLS certificate: %w", err)
+	}
+	if k.cert != nil && info.ModTime().Equal(k.modTime) {
+		return k.cert, nil
+	}
+	cert, err := tls.LoadX509KeyPair(k.certFile, k.keyFile)
+	if err != nil {
+		// Mid-renewal the files may not match yet; keep the old pair.
+		if k.cert != nil {
+			return k.cert, nil
+		}
+		return nil, fmt.Errorf("load TLS certificate: %w", err)
+	}
+	k.cert, k.modTime = &cert, info.ModTime()
+	return k.cert, nil
+}
+
+// serveACME serves Let's Encrypt http-01 challenges on addr, redirecting
+// everything else to HTTPS, until ctx is done.
+func serveACME(ctx context.Context, log *slog.Logger, addr string, handler http.Handler) {
+	srv := &http.Server{Addr: addr, Handler: handler, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		<-ctx.Done()
+		_ = srv.Close()
+	}()
+	if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		log.Error("serve ACME challenges", "addr", addr, "error", err)
+	}
+}
@@ -0,0 +1,47 @@
+package app
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"musicclubbot/backend/internal/usage"
+)
+
+// usageInterceptor counts calls for the admin usage report. It runs after
+// authentication, so calls are attributed to their user.
+func usageInterceptor(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	recordUsage(ctx, info.FullMethod, start, err)
+	return resp, err
+}
+
+func streamUsageInterceptor(
+	srv any,
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	recordUsage(ss.Context(), info.FullMethod, start, err)
+	return err
+}
+
+func recordUsage(ctx context.Context, method string, start time.Time, err error) {
+	recorder, ok := ctx.Value("usage").(*usage.Recorder)
+	// Health probes aren't club usage.
+	if !ok || strings.HasPrefix(method, "/grpc.health.v1.") {
+		return
+	}
+	userID, _ := ctx.Value("user_id").(string)
+	recorder.Record(method, userID, start, time.Since(start), err != nil)
+}
@@ -0,0 +1,91 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"buf.build/go/protovalidate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// validator checks requests against the buf.validate rules in the protos.
+var validator = func() protovalidate.Validator {
+	v, err := protovalidate.New()
+	if err != nil {
+		panic(err)
+	}
+	return v
+}()
+
+// validationInterceptor rejects requests breaking their proto's rules with
+// InvalidArgument before they reach the handler.
+func validationInterceptor(
+	ctx context.Context,
+	req any,
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	if err := validateMessage(req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func streamValidationInterceptor(
+	srv any,
+	ss grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	return handler(srv, &validatingStream{ServerStream: ss})
+}
+
+// validatingStream checks every message the client streams in.
+type validatingStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return validateMessage(m)
+}
+
+func validateMessage(m any) error {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return nil
+	}
+	err := validator.Validate(msg)
+	var verr *protovalidate.ValidationError
+	if !errors.As(err, &verr) {
+		if err != nil {
+			return status.Errorf(codes.Internal, "validate request: %v", err)
+		}
+		return nil
+	}
+	messages := make([]string, len(verr.Violations))
+	details := &errdetails.BadRequest{}
+	for i, v := range verr.Violations {
+		field := protovalidate.FieldPathString(v.Proto.GetField())
+		messages[i] = v.Proto.GetMessage()
+		if field != "" {
+			messages[i] = field + ": " + messages[i]
+		}
+		details.FieldViolations = append(details.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       field,
+			Description: v.Proto.GetMessage(),
+		})
+	}
+	st := status.New(codes.InvalidArgument, strings.Join(messages, "; "))
+	if withDetails, err := st.WithDetails(details); err == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
@@ -0,0 +1,88 @@
+// Package atom renders Atom (RFC 4287) feeds.
+package atom
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// Entry is a single feed item.
+type Entry struct {
+	ID        string
+	Title     string
+	Summary   string
+	Link      string
+	Updated   time.Time
+	Published time.Time
+}
+
+// Feed is the whole document; SelfLink is the feed's own URL.
+type Feed struct {
+	ID       string
+	Title    string
+	Link     string
+	SelfLink string
+	Updated  time.Time
+	Entries  []Entry
+}
+
+type xmlLink struct {
+	Rel  string `xml:"rel,attr,omitempty"`
+	Href string `xml:"href,attr"`
+}
+
+type xmlText struct {
+	Type string `xml:"type,attr"`
+	Body string `xml:",chardata"`
+}
+
+type xmlEntry struct {
+	ID        string   `xml:"id"`
+	Title     string   `xml:"title"`
+	Updated   string   `xml:"updated"`
+	Published string   `xml:"published,omitempty"`
+	Link      *xmlLink `xml:"link,omitempty"`
+	Summary   *xmlText `xml:"summary,omitempty"`
+}
+
+type xmlFeed struct {
+	XMLName xml.Name   `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string     `xml:"id"`
+	Title   string     `xml:"title"`
+	Updated string     `xml:"updated"`
+	Links   []xmlLink  `xml:"link"`
+	Entries []xmlEntry `xml:"entry"`
+}
+
+// Render returns the feed as an XML document.
+func Render(f Feed) ([]byte, error) {
+	out := xmlFeed{ID: f.ID, Title: f.Title, Updated: stamp(f.Updated)}
+	if f.Link != "" {
+		out.Links = append(out.Links, xmlLink{Href: f.Link})
+	}
+	if f.SelfLink != "" {
+		out.Links = append(out.Links, xmlLink{Rel: "self", Href: f.SelfLink})
+	}
+	for _, e := range f.Entries {
+		entry := xmlEntry{ID: e.ID, Title: e.Title, Updated: stamp(e.Updated)}
+		if !e.Published.IsZero() {
+			entry.Published = stamp(e.Published)
+		}
+		if e.Link != "" {
+			entry.Link = &xmlLink{Rel: "alternate", Href: e.Link}
+		}
+		if e.Summary != "" {
+			entry.Summary = &xmlText{Type: "text", Body: e.Summary}
+		}
+		out.Entries = append(out.Entries, entry)
+	}
+	body, err := xml.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), body...), nil
+}
+
+func stamp(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,152 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// minJwtSecretLength is the shortest JWT_SECRET accepted outside dev mode.
+const minJwtSecretLength = 32
+
+var (
+	// placeholderSecrets are the JWT_SECRET values shipped in defaults and
+	// examples.
+	placeholderSecrets = map[string]bool{
+		"change-this-in-prod":              true,
+		"change-this-secret-in-production": true,
+		"сгенерируйте_случайную_строку":    true,
+	}
+	botTokenPattern = regexp.MustCompile(`^[0-9]+:[A-Za-z0-9_-]{30,}$`)
+	// chatIDPattern matches numeric chat ids and public @usernames, the two
+	// forms the Bot API takes.
+	chatIDPattern = regexp.MustCompile(`^(-?[0-9]+|@[A-Za-z][A-Za-z0-9_]{4,31})$`)
+)
+
+// Validate reports every setting the backend can't safely run with. In dev
+// mode a missing bot token and a weak JWT secret are allowed.
+func (c Config) Validate() error {
+	var errs []error
+	if !c.DevMode {
+		if c.BotToken == "" {
+			errs = append(errs, errors.New("BOT_TOKEN is required"))
+		} else if !botTokenPattern.MatchString(c.BotToken) {
+			errs = append(errs, errors.New("BOT_TOKEN is not a bot token from @BotFather"))
+		}
+		if placeholderSecrets[string(c.JwtSecretKey)] {
+			errs = append(errs, errors.New("JWT_SECRET is still the example value"))
+		} else if len(c.JwtSecretKey) < minJwtSecretLength {
+			errs = append(errs, fmt.Errorf("JWT_SECRET must be at least %d bytes", minJwtSecretLength))
+		}
+	}
+	if c.ChatID == "" {
+		if !c.SkipChatMembershipCheck {
+			errs = append(errs, errors.New("CHAT_ID is required unless SKIP_CHAT_MEMBERSHIP_CHECK=true"))
+		}
+	} else if !chatIDPattern.MatchString(c.ChatID) {
+		errs = append(errs, fmt.Errorf("CHAT_ID %q is neither a numeric chat id nor an @username", c.ChatID))
+	}
+	if port, err := strconv.Atoi(c.GRPCPort); err != nil || port <= 0 || port > 65535 {
+		errs = append(errs, fmt.Errorf("GRPC_PORT %q is not a port", c.GRPCPort))
+	}
+	if _, err := time.LoadLocation(c.DefaultTimezone); err != nil {
+		errs = append(errs, fmt.Errorf("DEFAULT_TIMEZONE: %v", err))
+	}
+	for _, origin := range c.AllowedOrigins {
+		if u, err := url.Parse(origin); origin != "*" && (err != nil || u.Scheme == "" || u.Host == "" || u.Path != "") {
+			errs = append(errs, fmt.Errorf("ALLOWED_ORIGINS: %q is not an origin like https://club.example.com", origin))
+		}
+	}
+	if (c.TLSCertFile == "") != (c.TLSKeyFile == "") {
+		errs = append(errs, errors.New("TLS_CERT_FILE and TLS_KEY_FILE go together"))
+	}
+	if c.TLSCertFile != "" && len(c.TLSAutocertDomains) > 0 {
+		errs = append(errs, errors.New("set either TLS_CERT_FILE or TLS_AUTOCERT_DOMAINS, not both"))
+	}
+	if c.ShutdownTimeout <= 0 {
+		errs = append(errs, errors.New("SHUTDOWN_TIMEOUT must be positive"))
+	}
+	if c.MigrationsBaseline < 0 {
+		errs = append(errs, errors.New("DB_MIGRATIONS_BASELINE can't be negative"))
+	}
+	if c.DbMaxOpenConns < 0 || c.DbMaxIdleConns < 0 {
+		errs = append(errs, errors.New("DB_MAX_OPEN_CONNS and DB_MAX_IDLE_CONNS can't be negative"))
+	}
+	if c.DbConnMaxLifetime < 0 {
+		errs = append(errs, errors.New("DB_CONN_MAX_LIFETIME can't be negative"))
+	}
+	if c.DbConnectTimeout <= 0 {
+		errs = append(errs, errors.New("DB_CONNECT_TIMEOUT must be positive"))
+	}
+	return errors.Join(errs...)
+}
+
+// LogValue lets the config be logged with secrets redacted.
+func (c Config) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("grpc_port", c.GRPCPort),
+		slog.String("db_url", redactURL(c.DbUrl)),
+		slog.String("db_replica_url", redactURL(c.DbReplicaUrl)),
+		slog.Bool("auto_migrate", c.AutoMigrate),
+		slog.Int64("migrations_baseline", c.MigrationsBaseline),
+		slog.Int("db_max_open_conns", c.DbMaxOpenConns),
+		slog.Int("db_max_idle_conns", c.DbMaxIdleConns),
+		slog.Duration("db_conn_max_lifetime", c.DbConnMaxLifetime),
+		slog.Duration("db_connect_timeout", c.DbConnectTimeout),
+		slog.String("jwt_secret", redact(string(c.JwtSecretKey))),
+		slog.String("bot_username", c.BotUsername),
+		slog.String("bot_token", redact(c.BotToken)),
+		slog.String("chat_id", c.ChatID),
+		slog.Bool("skip_chat_membership_check", c.SkipChatMembershipCheck),
+		slog.String("public_url", c.PublicURL),
+		slog.String("thumbnail_cache_dir", c.ThumbnailCacheDir),
+		slog.String("storage_dir", c.StorageDir),
+		slog.Int64("max_demo_bytes", c.MaxDemoBytes),
+		slog.String("google_calendar_id", c.GoogleCalendarID),
+		slog.String("google_credentials_file", c.GoogleCredentialsFile),
+		slog.String("default_timezone", c.DefaultTimezone),
+		slog.String("geocoder_url", c.GeocoderURL),
+		slog.Bool("invite_only", c.InviteOnly),
+		slog.Bool("health_check_telegram", c.HealthCheckTelegram),
+		slog.Any("admin_usernames", c.AdminUsernames),
+		slog.Any("admin_tg_ids", c.AdminTgIDs),
+		slog.String("s3_endpoint", c.S3Endpoint),
+		slog.String("s3_bucket", c.S3Bucket),
+		slog.String("s3_region", c.S3Region),
+		slog.String("s3_access_key", redact(c.S3AccessKey)),
+		slog.String("s3_secret_key", redact(c.S3SecretKey)),
+		slog.String("log_level", c.LogLevel),
+		slog.String("log_format", c.LogFormat),
+		slog.Any("allowed_origins", c.AllowedOrigins),
+		slog.String("tls_cert_file", c.TLSCertFile),
+		slog.String("tls_key_file", c.TLSKeyFile),
+		slog.Any("tls_autocert_domains", c.TLSAutocertDomains),
+		slog.String("tls_autocert_dir", c.TLSAutocertDir),
+		slog.String("tls_autocert_email", c.TLSAutocertEmail),
+		slog.String("tls_http_port", c.TLSHTTPPort),
+		slog.Duration("shutdown_timeout", c.ShutdownTimeout),
+		slog.Bool("dev_mode", c.DevMode),
+	)
+}
+
+// redact tells only whether a secret is set.
+func redact(secret string) string {
+	if secret == "" {
+		return ""
+	}
+	return "[redacted]"
+}
+
+// redactURL hides the password of a connection URL; anything that doesn't
+// parse as one is hidden whole.
+func redactURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || u.Scheme == "" {
+		return redact(raw)
+	}
+	return u.Redacted()
+}
@@ -3,16 +3,75 @@ package db
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"os"
 	"time"
 
+	"github.com/XSAM/otelsql"
 	_ "github.com/lib/pq"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+
+	"musicclubbot/backend/internal/logging"
+)
+
+// Options tunes the connection pool and how long to wait for Postgres at
+// startup.
+type Options struct {
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+	// ConnectTimeout bounds the retries while Postgres isn't up yet, as on
+	// every docker-compose cold start.
+	ConnectTimeout time.Duration
+}
+
+const (
+	retryInitialDelay = 500 * time.Millisecond
+	retryMaxDelay     = 10 * time.Second
 )
 
-func MustInitDb(ctx context.Context, dbUrl string) *sql.DB {
-	db, err := sql.Open("postgres", dbUrl)
+// MustInitDb opens the pool and waits for Postgres to accept connections,
+// exiting when it doesn't within opts.ConnectTimeout. Queries show up as
+// spans of the request that ran them when tracing is on.
+func MustInitDb(ctx context.Context, dbUrl string, opts Options) *sql.DB {
+	db, err := otelsql.Open("postgres", dbUrl,
+		otelsql.WithAttributes(semconv.DBSystemNamePostgreSQL),
+		otelsql.WithSpanOptions(otelsql.SpanOptions{DisableErrSkip: true, OmitConnResetSession: true, OmitRows: true}))
 	if err != nil {
 		panic("Failed to open database: " + err.Error())
 	}
+	db.SetMaxOpenConns(opts.MaxOpenConns)
+	db.SetMaxIdleConns(opts.MaxIdleConns)
+	db.SetConnMaxLifetime(opts.ConnMaxLifetime)
+	if err := waitForDb(ctx, db, opts.ConnectTimeout); err != nil {
+		logging.FromContext(ctx).Error("connect to database", "error", err)
+		os.Exit(1)
+	}
 	go MonitorDbConnection(db, time.Second*time.Duration(100))
 	return db
 }
+
+// waitForDb pings db with exponential backoff until it answers or timeout
+// passes.
+func waitForDb(ctx context.Context, db *sql.DB, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	log := logging.FromContext(ctx)
+	delay := retryInitialDelay
+	for attempt := 1; ; attempt++ {
+		err := db.PingContext(ctx)
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return fmt.Errorf("database not ready after %d attempts: %w", attempt, err)
+		}
+		log.Warn("database not ready, retrying", "attempt", attempt, "retry_in", delay, "error", err)
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("database not ready after %d attempts: %w", attempt, err)
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, retryMaxDelay)
+	}
+}
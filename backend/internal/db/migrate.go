@@ -0,0 +1,110 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+
+	"github.com/pressly/goose/v3"
+	"github.com/pressly/goose/v3/database"
+	"github.com/pressly/goose/v3/lock"
+)
+
+// migrations holds the schema as goose migrations, versioned by their
+// numeric prefix. Add a new file for every change instead of editing an
+// applied one.
+//
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// Migrate applies pending migrations and returns the versions it applied. A
+// session lock, held from the adoption below until the last migration, keeps
+// several backends starting at once from racing.
+//
+// Databases set up by the old postgres-init-scripts have the schema but no
+// goose version table. They are adopted by recording every migration up to
+// baseline, the number of the last init script applied, without running it;
+// baseline 0 refuses to touch such a database.
+func Migrate(ctx context.Context, db *sql.DB, baseline int64) ([]int64, error) {
+	fsys, err := fs.Sub(migrations, "migrations")
+	if err != nil {
+		return nil, err
+	}
+	store, err := database.NewStore(database.DialectPostgres, goose.DefaultTablename)
+	if err != nil {
+		return nil, err
+	}
+	locker, err := lock.NewPostgresSessionLocker()
+	if err != nil {
+		return nil, err
+	}
+	// The provider doesn't lock itself: the lock is taken here, on a
+	// connection of its own, so the adoption runs under it too.
+	provider, err := goose.NewProvider(goose.DialectCustom, db, fsys, goose.WithStore(store))
+	if err != nil {
+		return nil, err
+	}
+	if db.Stats().MaxOpenConnections == 1 {
+		return nil, fmt.Errorf("migrations need a second connection besides the lock; raise DB_MAX_OPEN_CONNS")
+	}
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if err := locker.SessionLock(ctx, conn); err != nil {
+		return nil, fmt.Errorf("lock migrations: %w", err)
+	}
+	defer locker.SessionUnlock(context.WithoutCancel(ctx), conn)
+
+	if err := adoptLegacySchema(ctx, conn, store, provider, baseline); err != nil {
+		return nil, err
+	}
+	results, err := provider.Up(ctx)
+	if err != nil {
+		return nil, err
+	}
+	applied := make([]int64, len(results))
+	for i, r := range results {
+		applied[i] = r.Source.Version
+	}
+	return applied, nil
+}
+
+func adoptLegacySchema(ctx context.Context, db *sql.Conn, store database.Store, provider *goose.Provider, baseline int64) error {
+	var versioned, legacy bool
+	if err := db.QueryRowContext(ctx, `
+		SELECT to_regclass($1) IS NOT NULL, to_regclass('app_user') IS NOT NULL
+	`, store.Tablename()).Scan(&versioned, &legacy); err != nil {
+		return fmt.Errorf("inspect schema: %w", err)
+	}
+	if versioned || !legacy {
+		return nil
+	}
+	if baseline <= 0 {
+		return fmt.Errorf("database was set up without migrations; set DB_MIGRATIONS_BASELINE to the number of the last postgres-init-script applied to it")
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if err := store.CreateVersionTable(ctx, tx); err != nil {
+		return fmt.Errorf("create version table: %w", err)
+	}
+	// goose records version 0 when it creates the table itself.
+	if err := store.Insert(ctx, tx, database.InsertRequest{Version: 0}); err != nil {
+		return fmt.Errorf("record baseline: %w", err)
+	}
+	for _, src := range provider.ListSources() {
+		if src.Version > baseline {
+			break
+		}
+		if err := store.Insert(ctx, tx, database.InsertRequest{Version: src.Version}); err != nil {
+			return fmt.Errorf("record baseline: %w", err)
+		}
+	}
+	return tx.Commit()
+}
@@ -1,3 +1,5 @@
+-- +goose Up
+-- +goose StatementBegin
 -- Core extensions.
 CREATE EXTENSION IF NOT EXISTS pgcrypto;
 -- Enumerations.
@@ -120,4 +122,5 @@ CREATE TABLE IF NOT EXISTS refresh_tokens (
     created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
 );
 CREATE INDEX IF NOT EXISTS idx_refresh_tokens_token ON refresh_tokens(token);
-CREATE INDEX IF NOT EXISTS idx_refresh_tokens_user_id ON refresh_tokens(user_id);
\ No newline at end of file
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_user_id ON refresh_tokens(user_id);
+-- +goose StatementEnd
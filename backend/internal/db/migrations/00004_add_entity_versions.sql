@@ -0,0 +1,6 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Row versions for optimistic concurrency control on updates
+ALTER TABLE song ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE event ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Personal song bookmarks
+CREATE TABLE IF NOT EXISTS song_favorite (
+    user_id UUID NOT NULL REFERENCES app_user(id) ON DELETE CASCADE,
+    song_id UUID NOT NULL REFERENCES song(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, song_id)
+);
+CREATE INDEX IF NOT EXISTS idx_song_favorite_song ON song_favorite (song_id);
+-- +goose StatementEnd
@@ -0,0 +1,6 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Songs pinned to the top of the catalog
+ALTER TABLE song ADD COLUMN IF NOT EXISTS pinned_at TIMESTAMPTZ;
+CREATE INDEX IF NOT EXISTS idx_song_pinned_at ON song (pinned_at) WHERE pinned_at IS NOT NULL;
+-- +goose StatementEnd
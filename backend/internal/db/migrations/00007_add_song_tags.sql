@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Free-form song tags (genre, era, mood)
+CREATE TABLE IF NOT EXISTS song_tag (
+    song_id UUID NOT NULL REFERENCES song(id) ON DELETE CASCADE,
+    tag TEXT NOT NULL,
+    PRIMARY KEY (song_id, tag)
+);
+CREATE INDEX IF NOT EXISTS idx_song_tag_tag ON song_tag (tag);
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Alternative spellings of song roles mapped to a canonical name
+CREATE TABLE IF NOT EXISTS role_alias (
+    alias TEXT PRIMARY KEY,
+    canonical TEXT NOT NULL
+);
+INSERT INTO role_alias (alias, canonical) VALUES
+    ('vox', 'вокал'),
+    ('vocal', 'вокал'),
+    ('vocals', 'вокал'),
+    ('voice', 'вокал'),
+    ('guitar', 'гитара'),
+    ('gtr', 'гитара'),
+    ('bass', 'бас'),
+    ('бас-гитара', 'бас'),
+    ('drums', 'барабаны'),
+    ('ударные', 'барабаны'),
+    ('keys', 'клавиши'),
+    ('keyboard', 'клавиши'),
+    ('клавишные', 'клавиши')
+ON CONFLICT (alias) DO NOTHING;
+-- +goose StatementEnd
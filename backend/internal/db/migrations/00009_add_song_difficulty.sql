@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Per-instrument difficulty ratings left by role participants
+CREATE TABLE IF NOT EXISTS song_difficulty_rating (
+    song_id UUID NOT NULL REFERENCES song(id) ON DELETE CASCADE,
+    role TEXT NOT NULL,
+    user_id UUID NOT NULL REFERENCES app_user(id) ON DELETE CASCADE,
+    rating SMALLINT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (song_id, role, user_id)
+);
+-- +goose StatementEnd
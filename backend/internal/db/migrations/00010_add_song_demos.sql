@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Short rehearsal/demo recordings attached to songs; audio lives in file storage
+CREATE TABLE IF NOT EXISTS song_demo (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    song_id UUID NOT NULL REFERENCES song(id) ON DELETE CASCADE,
+    uploaded_by UUID REFERENCES app_user(id) ON DELETE SET NULL,
+    title TEXT NOT NULL,
+    content_type TEXT NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    storage_key TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_song_demo_song ON song_demo(song_id, created_at DESC);
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Recurring events: a series stores the rule and template, occurrences are
+-- materialized as regular events a few weeks ahead
+CREATE TABLE IF NOT EXISTS event_series (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    rrule TEXT NOT NULL,
+    dtstart TIMESTAMPTZ NOT NULL,
+    materialized_until TIMESTAMPTZ NOT NULL,
+    title TEXT NOT NULL,
+    location TEXT,
+    notify_day_before BOOLEAN NOT NULL DEFAULT FALSE,
+    notify_hour_before BOOLEAN NOT NULL DEFAULT FALSE,
+    created_by UUID REFERENCES app_user(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE event ADD COLUMN IF NOT EXISTS series_id UUID REFERENCES event_series(id) ON DELETE SET NULL;
+ALTER TABLE event ADD COLUMN IF NOT EXISTS occurrence_at TIMESTAMPTZ;
+
+-- Deferrable so "all future" edits can shift every occurrence in one statement
+ALTER TABLE event DROP CONSTRAINT IF EXISTS uniq_event_series_occurrence;
+ALTER TABLE event ADD CONSTRAINT uniq_event_series_occurrence
+    UNIQUE (series_id, occurrence_at) DEFERRABLE INITIALLY IMMEDIATE;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Attendance answers, independent of role participation
+CREATE TABLE IF NOT EXISTS event_rsvp (
+    event_id UUID NOT NULL REFERENCES event(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES app_user(id) ON DELETE CASCADE,
+    status TEXT NOT NULL CHECK (status IN ('going', 'maybe', 'declined')),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (event_id, user_id)
+);
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Optional capacity limit with a waitlist for RSVPs over the limit
+ALTER TABLE event ADD COLUMN IF NOT EXISTS max_participants INTEGER NOT NULL DEFAULT 0 CHECK (max_participants >= 0);
+ALTER TABLE event_series ADD COLUMN IF NOT EXISTS max_participants INTEGER NOT NULL DEFAULT 0 CHECK (max_participants >= 0);
+
+ALTER TABLE event_rsvp DROP CONSTRAINT IF EXISTS event_rsvp_status_check;
+ALTER TABLE event_rsvp ADD CONSTRAINT event_rsvp_status_check
+    CHECK (status IN ('going', 'maybe', 'declined', 'waitlisted'));
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Events mirrored to Google Calendar; no FK so deleted events can be cleaned up there
+CREATE TABLE IF NOT EXISTS google_calendar_event (
+    event_id UUID PRIMARY KEY,
+    content_hash TEXT NOT NULL,
+    synced_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Roles an event needs covered, and reusable event templates
+ALTER TABLE event ADD COLUMN IF NOT EXISTS required_roles TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE TABLE IF NOT EXISTS event_template (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name TEXT NOT NULL,
+    title_pattern TEXT NOT NULL,
+    location TEXT,
+    notify_day_before BOOLEAN NOT NULL DEFAULT FALSE,
+    notify_hour_before BOOLEAN NOT NULL DEFAULT FALSE,
+    max_participants INTEGER NOT NULL DEFAULT 0 CHECK (max_participants >= 0),
+    required_roles TEXT[] NOT NULL DEFAULT '{}',
+    uses INTEGER NOT NULL DEFAULT 0,
+    created_by UUID REFERENCES app_user(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE event_series ADD COLUMN IF NOT EXISTS required_roles TEXT[] NOT NULL DEFAULT '{}';
+-- +goose StatementEnd
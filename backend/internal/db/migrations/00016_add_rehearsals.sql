@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Rehearsals preparing for an event, the tracks they cover and who comes
+CREATE TABLE IF NOT EXISTS rehearsal (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES event(id) ON DELETE CASCADE,
+    start_at TIMESTAMPTZ NOT NULL,
+    end_at TIMESTAMPTZ,
+    location TEXT,
+    notes TEXT,
+    notify_day_before BOOLEAN NOT NULL DEFAULT FALSE,
+    notify_hour_before BOOLEAN NOT NULL DEFAULT FALSE,
+    created_by UUID REFERENCES app_user(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT rehearsal_ends_after_start CHECK (end_at IS NULL OR end_at > start_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_rehearsal_event ON rehearsal(event_id, start_at);
+
+CREATE TABLE IF NOT EXISTS rehearsal_track (
+    rehearsal_id UUID NOT NULL REFERENCES rehearsal(id) ON DELETE CASCADE,
+    track_item_id UUID NOT NULL REFERENCES event_track_item(id) ON DELETE CASCADE,
+    PRIMARY KEY (rehearsal_id, track_item_id)
+);
+
+CREATE TABLE IF NOT EXISTS rehearsal_attendance (
+    rehearsal_id UUID NOT NULL REFERENCES rehearsal(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES app_user(id) ON DELETE CASCADE,
+    status TEXT NOT NULL CHECK (status IN ('going', 'maybe', 'declined')),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (rehearsal_id, user_id)
+);
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Actual attendance recorded by scanning the event's check-in QR code
+ALTER TABLE event ADD COLUMN IF NOT EXISTS check_in_code TEXT UNIQUE;
+
+CREATE TABLE IF NOT EXISTS event_attendance (
+    event_id UUID NOT NULL REFERENCES event(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES app_user(id) ON DELETE CASCADE,
+    checked_in_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (event_id, user_id)
+);
+-- +goose StatementEnd
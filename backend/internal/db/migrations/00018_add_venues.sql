@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Reusable venues referenced by events, series and templates
+CREATE TABLE IF NOT EXISTS venue (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name TEXT NOT NULL,
+    address TEXT,
+    map_url TEXT,
+    notes TEXT,
+    created_by UUID REFERENCES app_user(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE event ADD COLUMN IF NOT EXISTS venue_id UUID REFERENCES venue(id) ON DELETE SET NULL;
+ALTER TABLE event_series ADD COLUMN IF NOT EXISTS venue_id UUID REFERENCES venue(id) ON DELETE SET NULL;
+ALTER TABLE event_template ADD COLUMN IF NOT EXISTS venue_id UUID REFERENCES venue(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_event_venue ON event(venue_id);
+-- +goose StatementEnd
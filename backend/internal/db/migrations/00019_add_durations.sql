@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Song and tracklist item lengths, and the time slot an event has to fit in
+ALTER TABLE song ADD COLUMN IF NOT EXISTS duration_sec INTEGER CHECK (duration_sec > 0);
+ALTER TABLE event_track_item ADD COLUMN IF NOT EXISTS duration_sec INTEGER CHECK (duration_sec > 0);
+
+ALTER TABLE event ADD COLUMN IF NOT EXISTS time_slot_minutes INTEGER NOT NULL DEFAULT 0 CHECK (time_slot_minutes >= 0);
+ALTER TABLE event_series ADD COLUMN IF NOT EXISTS time_slot_minutes INTEGER NOT NULL DEFAULT 0 CHECK (time_slot_minutes >= 0);
+ALTER TABLE event_template ADD COLUMN IF NOT EXISTS time_slot_minutes INTEGER NOT NULL DEFAULT 0 CHECK (time_slot_minutes >= 0);
+-- +goose StatementEnd
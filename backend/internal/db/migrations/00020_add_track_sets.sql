@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Named sets (Set 1, Set 2, Encore) splitting an event's tracklist
+CREATE TABLE IF NOT EXISTS event_track_set (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES event(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    name TEXT NOT NULL DEFAULT ''
+);
+CREATE INDEX IF NOT EXISTS idx_event_track_set_event ON event_track_set(event_id, position);
+
+-- Items outside of any set (set_id NULL) are played before the first set.
+ALTER TABLE event_track_item ADD COLUMN IF NOT EXISTS set_id UUID REFERENCES event_track_set(id) ON DELETE SET NULL;
+-- +goose StatementEnd
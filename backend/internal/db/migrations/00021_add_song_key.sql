@@ -0,0 +1,5 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Musical key a song is played in, printed on setlists
+ALTER TABLE song ADD COLUMN IF NOT EXISTS musical_key TEXT;
+-- +goose StatementEnd
@@ -0,0 +1,5 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Whether a tracklist item was actually played at the event
+ALTER TABLE event_track_item ADD COLUMN IF NOT EXISTS performed BOOLEAN NOT NULL DEFAULT FALSE;
+-- +goose StatementEnd
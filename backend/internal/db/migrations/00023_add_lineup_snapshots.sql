@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Completed events keep the lineup of every tracklist item as it was at the
+-- show; song role assignments keep changing afterwards.
+ALTER TABLE event ADD COLUMN IF NOT EXISTS completed_at TIMESTAMPTZ;
+
+CREATE TABLE IF NOT EXISTS event_lineup_snapshot (
+    event_id UUID NOT NULL REFERENCES event(id) ON DELETE CASCADE,
+    track_item_id UUID NOT NULL REFERENCES event_track_item(id) ON DELETE CASCADE,
+    role TEXT NOT NULL,
+    user_id UUID NOT NULL REFERENCES app_user(id) ON DELETE CASCADE,
+    joined_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (track_item_id, role, user_id)
+);
+CREATE INDEX IF NOT EXISTS idx_event_lineup_snapshot_event ON event_lineup_snapshot(event_id);
+-- +goose StatementEnd
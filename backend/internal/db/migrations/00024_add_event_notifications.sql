@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Reminder offsets per event (minutes before start) replacing the two
+-- notify_* flags; sent_at marks reminders already delivered.
+CREATE TABLE IF NOT EXISTS event_notification (
+    event_id UUID NOT NULL REFERENCES event(id) ON DELETE CASCADE,
+    offset_minutes INTEGER NOT NULL CHECK (offset_minutes > 0),
+    sent_at TIMESTAMPTZ,
+    PRIMARY KEY (event_id, offset_minutes)
+);
+
+INSERT INTO event_notification (event_id, offset_minutes)
+SELECT id, 1440 FROM event WHERE notify_day_before
+ON CONFLICT DO NOTHING;
+INSERT INTO event_notification (event_id, offset_minutes)
+SELECT id, 60 FROM event WHERE notify_hour_before
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE event_series ADD COLUMN IF NOT EXISTS notify_offsets INTEGER[] NOT NULL DEFAULT '{}';
+UPDATE event_series SET notify_offsets = array_remove(ARRAY[
+    CASE WHEN notify_day_before THEN 1440 END,
+    CASE WHEN notify_hour_before THEN 60 END
+], NULL);
+
+ALTER TABLE event_template ADD COLUMN IF NOT EXISTS notify_offsets INTEGER[] NOT NULL DEFAULT '{}';
+UPDATE event_template SET notify_offsets = array_remove(ARRAY[
+    CASE WHEN notify_day_before THEN 1440 END,
+    CASE WHEN notify_hour_before THEN 60 END
+], NULL);
+-- +goose StatementEnd
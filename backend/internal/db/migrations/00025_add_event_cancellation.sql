@@ -0,0 +1,8 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Cancelled events stay listed; cancellation_sent_at marks that participants
+-- were told about it.
+ALTER TABLE event ADD COLUMN IF NOT EXISTS cancelled_at TIMESTAMPTZ;
+ALTER TABLE event ADD COLUMN IF NOT EXISTS cancel_reason TEXT;
+ALTER TABLE event ADD COLUMN IF NOT EXISTS cancellation_sent_at TIMESTAMPTZ;
+-- +goose StatementEnd
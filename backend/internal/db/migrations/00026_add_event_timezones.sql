@@ -0,0 +1,8 @@
+-- +goose Up
+-- +goose StatementBegin
+-- IANA timezone events are planned in; existing ones were all in the club's
+-- home city.
+ALTER TABLE event ADD COLUMN IF NOT EXISTS timezone TEXT NOT NULL DEFAULT 'Europe/Moscow';
+ALTER TABLE event_series ADD COLUMN IF NOT EXISTS timezone TEXT NOT NULL DEFAULT 'Europe/Moscow';
+ALTER TABLE event_template ADD COLUMN IF NOT EXISTS timezone TEXT NOT NULL DEFAULT 'Europe/Moscow';
+-- +goose StatementEnd
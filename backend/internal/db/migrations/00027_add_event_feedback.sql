@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Rating surveys organizers open after an event; invites_sent_at marks that
+-- the bot asked participants to answer.
+CREATE TABLE IF NOT EXISTS event_feedback_survey (
+    event_id UUID PRIMARY KEY REFERENCES event(id) ON DELETE CASCADE,
+    opened_by UUID REFERENCES app_user(id) ON DELETE SET NULL,
+    opened_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    closes_at TIMESTAMPTZ NOT NULL,
+    invites_sent_at TIMESTAMPTZ
+);
+
+CREATE TABLE IF NOT EXISTS event_feedback (
+    event_id UUID NOT NULL REFERENCES event_feedback_survey(event_id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES app_user(id) ON DELETE CASCADE,
+    rating SMALLINT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    comment TEXT,
+    submitted_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (event_id, user_id)
+);
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Seasons ("Осень 2025") grouping events for end-of-season retrospectives
+CREATE TABLE IF NOT EXISTS season (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name TEXT NOT NULL,
+    starts_at TIMESTAMPTZ,
+    ends_at TIMESTAMPTZ,
+    created_by UUID REFERENCES app_user(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT season_ends_after_start CHECK (ends_at IS NULL OR starts_at IS NULL OR ends_at > starts_at)
+);
+
+ALTER TABLE event ADD COLUMN IF NOT EXISTS season_id UUID REFERENCES season(id) ON DELETE SET NULL;
+ALTER TABLE event_series ADD COLUMN IF NOT EXISTS season_id UUID REFERENCES season(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_event_season ON event(season_id);
+-- +goose StatementEnd
@@ -0,0 +1,6 @@
+-- +goose Up
+-- +goose StatementBegin
+-- How far the lineup got with each tracklist item before the gig
+ALTER TABLE event_track_item ADD COLUMN IF NOT EXISTS rehearsal_status TEXT NOT NULL DEFAULT 'not_started'
+    CHECK (rehearsal_status IN ('not_started', 'in_progress', 'ready'));
+-- +goose StatementEnd
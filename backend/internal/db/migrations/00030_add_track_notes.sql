@@ -0,0 +1,6 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Per-item performance notes and a key overriding the song's own
+ALTER TABLE event_track_item ADD COLUMN IF NOT EXISTS notes TEXT;
+ALTER TABLE event_track_item ADD COLUMN IF NOT EXISTS musical_key TEXT;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Planned stage times: an optional fixed start per item, otherwise computed
+-- from the event start, durations and the pause between songs.
+ALTER TABLE event_track_item ADD COLUMN IF NOT EXISTS planned_start_at TIMESTAMPTZ;
+ALTER TABLE event ADD COLUMN IF NOT EXISTS track_gap_seconds INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE event_series ADD COLUMN IF NOT EXISTS track_gap_seconds INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE event_template ADD COLUMN IF NOT EXISTS track_gap_seconds INTEGER NOT NULL DEFAULT 0;
+-- +goose StatementEnd
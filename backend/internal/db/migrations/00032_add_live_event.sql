@@ -0,0 +1,6 @@
+-- +goose Up
+-- +goose StatementBegin
+-- "Now playing" pointer moved by organizers during the show.
+ALTER TABLE event ADD COLUMN IF NOT EXISTS current_track_item_id UUID REFERENCES event_track_item(id) ON DELETE SET NULL;
+ALTER TABLE event ADD COLUMN IF NOT EXISTS current_track_started_at TIMESTAMPTZ;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Gear to bring to an event: who brings each item and whether it is packed.
+CREATE TABLE IF NOT EXISTS event_equipment_item (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES event(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    quantity INTEGER NOT NULL DEFAULT 1,
+    notes TEXT,
+    bringer_id UUID REFERENCES app_user(id) ON DELETE SET NULL,
+    checked_at TIMESTAMPTZ,
+    created_by UUID REFERENCES app_user(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_equipment_item_event ON event_equipment_item (event_id);
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Carpooling: members offer seats or ask for a ride; passengers claim seats
+-- of offers. *_notified_at / announced_at mark what the bot already sent.
+CREATE TABLE IF NOT EXISTS event_ride (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES event(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES app_user(id) ON DELETE CASCADE,
+    kind TEXT NOT NULL CHECK (kind IN ('offer', 'request')),
+    seats INTEGER NOT NULL DEFAULT 1,
+    from_location TEXT,
+    depart_at TIMESTAMPTZ,
+    notes TEXT,
+    announced_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (event_id, user_id, kind)
+);
+
+CREATE TABLE IF NOT EXISTS event_ride_passenger (
+    ride_id UUID NOT NULL REFERENCES event_ride(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES app_user(id) ON DELETE CASCADE,
+    claimed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    notified_at TIMESTAMPTZ,
+    PRIMARY KEY (ride_id, user_id)
+);
+-- +goose StatementEnd
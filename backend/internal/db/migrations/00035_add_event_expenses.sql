@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Costs paid by members for an event and who shares each of them; shares
+-- are fixed when the expense is recorded.
+CREATE TABLE IF NOT EXISTS event_expense (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES event(id) ON DELETE CASCADE,
+    payer_id UUID NOT NULL REFERENCES app_user(id) ON DELETE CASCADE,
+    description TEXT NOT NULL,
+    amount_cents BIGINT NOT NULL CHECK (amount_cents > 0),
+    created_by UUID REFERENCES app_user(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_expense_event ON event_expense (event_id);
+
+CREATE TABLE IF NOT EXISTS event_expense_share (
+    expense_id UUID NOT NULL REFERENCES event_expense(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES app_user(id) ON DELETE CASCADE,
+    PRIMARY KEY (expense_id, user_id)
+);
+-- +goose StatementEnd
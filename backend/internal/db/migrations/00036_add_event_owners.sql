@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Organizers of single events: they manage the event and its tracklist
+-- without club-wide edit_events. create_events lets a member start events,
+-- becoming their first owner.
+ALTER TABLE user_permissions ADD COLUMN IF NOT EXISTS create_events BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS event_owner (
+    event_id UUID NOT NULL REFERENCES event(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES app_user(id) ON DELETE CASCADE,
+    added_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (event_id, user_id)
+);
+
+INSERT INTO event_owner (event_id, user_id)
+SELECT id, created_by FROM event WHERE created_by IS NOT NULL
+ON CONFLICT DO NOTHING;
+-- +goose StatementEnd
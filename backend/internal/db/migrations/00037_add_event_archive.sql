@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Deleting an event archives it: the row, its tracklist and participation
+-- history stay and can be restored by an admin.
+ALTER TABLE event ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+ALTER TABLE event ADD COLUMN IF NOT EXISTS deleted_by UUID REFERENCES app_user(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_event_deleted_at ON event(deleted_at) WHERE deleted_at IS NOT NULL;
+-- +goose StatementEnd
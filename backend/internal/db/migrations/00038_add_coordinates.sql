@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Coordinates for "get directions": taken from the map link, geocoded from
+-- the address or entered directly. Events fall back to their venue's.
+ALTER TABLE venue ADD COLUMN IF NOT EXISTS latitude DOUBLE PRECISION;
+ALTER TABLE venue ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION;
+
+ALTER TABLE event ADD COLUMN IF NOT EXISTS latitude DOUBLE PRECISION;
+ALTER TABLE event ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION;
+
+ALTER TABLE event_series ADD COLUMN IF NOT EXISTS latitude DOUBLE PRECISION;
+ALTER TABLE event_series ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION;
+-- +goose StatementEnd
@@ -0,0 +1,7 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Themed rounds ("90s covers night"): tracklist songs are expected to carry
+-- theme_tag, and those that don't are flagged.
+ALTER TABLE event ADD COLUMN IF NOT EXISTS theme TEXT;
+ALTER TABLE event ADD COLUMN IF NOT EXISTS theme_tag TEXT;
+-- +goose StatementEnd
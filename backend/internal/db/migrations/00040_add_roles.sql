@@ -0,0 +1,90 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Named roles bundle permissions. A user's effective permissions are the
+-- union of their roles' bundles and any flags still granted one by one in
+-- user_permissions.
+CREATE TABLE IF NOT EXISTS role (
+    name TEXT PRIMARY KEY,
+    description TEXT NOT NULL DEFAULT '',
+    edit_own_participation BOOLEAN NOT NULL DEFAULT FALSE,
+    edit_any_participation BOOLEAN NOT NULL DEFAULT FALSE,
+    edit_own_songs BOOLEAN NOT NULL DEFAULT FALSE,
+    edit_any_songs BOOLEAN NOT NULL DEFAULT FALSE,
+    edit_events BOOLEAN NOT NULL DEFAULT FALSE,
+    edit_tracklists BOOLEAN NOT NULL DEFAULT FALSE,
+    create_events BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+INSERT INTO role (name, description, edit_own_participation, edit_any_participation, edit_own_songs, edit_any_songs, edit_events, edit_tracklists, create_events)
+VALUES
+    ('admin', 'Full access', TRUE, TRUE, TRUE, TRUE, TRUE, TRUE, TRUE),
+    ('moderator', 'Curates songs, lineups and tracklists', TRUE, TRUE, TRUE, TRUE, FALSE, TRUE, TRUE),
+    ('member', 'Joins songs and adds their own', TRUE, FALSE, TRUE, FALSE, FALSE, FALSE, FALSE)
+ON CONFLICT (name) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS user_role (
+    user_id UUID NOT NULL REFERENCES app_user(id) ON DELETE CASCADE,
+    role TEXT NOT NULL REFERENCES role(name) ON DELETE CASCADE,
+    assigned_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, role)
+);
+CREATE INDEX IF NOT EXISTS idx_user_role_role ON user_role(role);
+
+-- Move existing flags into roles: every user gets the roles their flags fully
+-- cover, and only flags no assigned role grants stay individual.
+INSERT INTO user_role (user_id, role)
+SELECT up.user_id, r.name
+FROM user_permissions up
+JOIN role r ON (up.edit_own_participation OR NOT r.edit_own_participation)
+           AND (up.edit_any_participation OR NOT r.edit_any_participation)
+           AND (up.edit_own_songs OR NOT r.edit_own_songs)
+           AND (up.edit_any_songs OR NOT r.edit_any_songs)
+           AND (up.edit_events OR NOT r.edit_events)
+           AND (up.edit_tracklists OR NOT r.edit_tracklists)
+           AND (up.create_events OR NOT r.create_events)
+WHERE up.edit_own_participation OR up.edit_any_participation OR up.edit_own_songs OR up.edit_any_songs
+   OR up.edit_events OR up.edit_tracklists OR up.create_events
+ON CONFLICT DO NOTHING;
+
+UPDATE user_permissions up SET
+    edit_own_participation = up.edit_own_participation AND NOT COALESCE(r.edit_own_participation, FALSE),
+    edit_any_participation = up.edit_any_participation AND NOT COALESCE(r.edit_any_participation, FALSE),
+    edit_own_songs = up.edit_own_songs AND NOT COALESCE(r.edit_own_songs, FALSE),
+    edit_any_songs = up.edit_any_songs AND NOT COALESCE(r.edit_any_songs, FALSE),
+    edit_events = up.edit_events AND NOT COALESCE(r.edit_events, FALSE),
+    edit_tracklists = up.edit_tracklists AND NOT COALESCE(r.edit_tracklists, FALSE),
+    create_events = up.create_events AND NOT COALESCE(r.create_events, FALSE)
+FROM (
+    SELECT ur.user_id,
+           bool_or(r.edit_own_participation) AS edit_own_participation,
+           bool_or(r.edit_any_participation) AS edit_any_participation,
+           bool_or(r.edit_own_songs) AS edit_own_songs,
+           bool_or(r.edit_any_songs) AS edit_any_songs,
+           bool_or(r.edit_events) AS edit_events,
+           bool_or(r.edit_tracklists) AS edit_tracklists,
+           bool_or(r.create_events) AS create_events
+    FROM user_role ur JOIN role r ON r.name = ur.role
+    GROUP BY ur.user_id
+) r
+WHERE r.user_id = up.user_id;
+
+CREATE OR REPLACE VIEW effective_permissions AS
+SELECT user_id,
+       bool_or(edit_own_participation) AS edit_own_participation,
+       bool_or(edit_any_participation) AS edit_any_participation,
+       bool_or(edit_own_songs) AS edit_own_songs,
+       bool_or(edit_any_songs) AS edit_any_songs,
+       bool_or(edit_events) AS edit_events,
+       bool_or(edit_tracklists) AS edit_tracklists,
+       bool_or(create_events) AS create_events
+FROM (
+    SELECT user_id, edit_own_participation, edit_any_participation, edit_own_songs, edit_any_songs,
+           edit_events, edit_tracklists, create_events
+    FROM user_permissions
+    UNION ALL
+    SELECT ur.user_id, r.edit_own_participation, r.edit_any_participation, r.edit_own_songs, r.edit_any_songs,
+           r.edit_events, r.edit_tracklists, r.create_events
+    FROM user_role ur JOIN role r ON r.name = ur.role
+) p
+GROUP BY user_id;
+-- +goose StatementEnd
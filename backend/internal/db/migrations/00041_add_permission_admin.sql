@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+-- manage_permissions lets admins grant and revoke permissions over the API;
+-- every change is kept in permission_change.
+ALTER TABLE user_permissions ADD COLUMN IF NOT EXISTS manage_permissions BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE role ADD COLUMN IF NOT EXISTS manage_permissions BOOLEAN NOT NULL DEFAULT FALSE;
+UPDATE role SET manage_permissions = TRUE WHERE name = 'admin';
+
+CREATE OR REPLACE VIEW effective_permissions AS
+SELECT user_id,
+       bool_or(edit_own_participation) AS edit_own_participation,
+       bool_or(edit_any_participation) AS edit_any_participation,
+       bool_or(edit_own_songs) AS edit_own_songs,
+       bool_or(edit_any_songs) AS edit_any_songs,
+       bool_or(edit_events) AS edit_events,
+       bool_or(edit_tracklists) AS edit_tracklists,
+       bool_or(create_events) AS create_events,
+       bool_or(manage_permissions) AS manage_permissions
+FROM (
+    SELECT user_id, edit_own_participation, edit_any_participation, edit_own_songs, edit_any_songs,
+           edit_events, edit_tracklists, create_events, manage_permissions
+    FROM user_permissions
+    UNION ALL
+    SELECT ur.user_id, r.edit_own_participation, r.edit_any_participation, r.edit_own_songs, r.edit_any_songs,
+           r.edit_events, r.edit_tracklists, r.create_events, r.manage_permissions
+    FROM user_role ur JOIN role r ON r.name = ur.role
+) p
+GROUP BY user_id;
+
+CREATE TABLE IF NOT EXISTS permission_change (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES app_user(id) ON DELETE CASCADE,
+    actor_id UUID REFERENCES app_user(id) ON DELETE SET NULL,
+    roles_before TEXT[] NOT NULL,
+    roles_after TEXT[] NOT NULL,
+    granted_before TEXT[] NOT NULL,
+    granted_after TEXT[] NOT NULL,
+    reason TEXT,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_permission_change_user ON permission_change(user_id, changed_at DESC);
+-- +goose StatementEnd
@@ -0,0 +1,5 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Last authenticated request, refreshed at most every few minutes.
+ALTER TABLE app_user ADD COLUMN IF NOT EXISTS last_seen_at TIMESTAMPTZ;
+-- +goose StatementEnd
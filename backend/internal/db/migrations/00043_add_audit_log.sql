@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Who changed what: songs, events, tracklists and permissions. entity_id is
+-- text so entries outlive the rows they describe.
+CREATE TABLE IF NOT EXISTS audit_entry (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    actor_id UUID REFERENCES app_user(id) ON DELETE SET NULL,
+    entity_type TEXT NOT NULL,
+    entity_id TEXT NOT NULL,
+    action TEXT NOT NULL,
+    summary TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_audit_entry_entity ON audit_entry(entity_type, entity_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_entry_actor ON audit_entry(actor_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_entry_created ON audit_entry(created_at DESC);
+-- +goose StatementEnd
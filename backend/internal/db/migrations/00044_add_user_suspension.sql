@@ -0,0 +1,8 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Suspended users can't sign in or call the API; suspended_until NULL keeps
+-- the suspension until it is lifted by hand.
+ALTER TABLE app_user ADD COLUMN IF NOT EXISTS suspended BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE app_user ADD COLUMN IF NOT EXISTS suspended_until TIMESTAMPTZ;
+ALTER TABLE app_user ADD COLUMN IF NOT EXISTS suspension_reason TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Invite codes for password registration; required when INVITE_ONLY is on.
+CREATE TABLE IF NOT EXISTS invite_code (
+    code TEXT PRIMARY KEY,
+    created_by UUID REFERENCES app_user(id) ON DELETE SET NULL,
+    max_uses INT NOT NULL DEFAULT 1 CHECK (max_uses > 0),
+    uses INT NOT NULL DEFAULT 0,
+    expires_at TIMESTAMPTZ,
+    note TEXT NOT NULL DEFAULT '',
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- The invite the user registered with.
+ALTER TABLE app_user ADD COLUMN IF NOT EXISTS invite_code TEXT REFERENCES invite_code(code) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_app_user_invite_code ON app_user(invite_code);
+-- +goose StatementEnd
@@ -0,0 +1,8 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Profile fields users edit themselves. The *_custom flags keep Telegram
+-- sign-in from overwriting a name or avatar the user chose.
+ALTER TABLE app_user ADD COLUMN IF NOT EXISTS bio TEXT NOT NULL DEFAULT '';
+ALTER TABLE app_user ADD COLUMN IF NOT EXISTS display_name_custom BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE app_user ADD COLUMN IF NOT EXISTS avatar_custom BOOLEAN NOT NULL DEFAULT FALSE;
+-- +goose StatementEnd
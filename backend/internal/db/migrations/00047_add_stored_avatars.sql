@@ -0,0 +1,5 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Avatar uploaded to our own storage; avatar_url then points at /avatars/<id>.
+ALTER TABLE app_user ADD COLUMN IF NOT EXISTS avatar_id UUID;
+-- +goose StatementEnd
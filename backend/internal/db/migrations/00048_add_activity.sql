@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Club activity for the home screen feed. Rows go away with the song or
+-- event they mention.
+CREATE TABLE IF NOT EXISTS activity (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES app_user(id) ON DELETE CASCADE,
+    kind TEXT NOT NULL,
+    song_id UUID REFERENCES song(id) ON DELETE CASCADE,
+    event_id UUID REFERENCES event(id) ON DELETE CASCADE,
+    detail TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_activity_created ON activity(created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_activity_user ON activity(user_id, created_at DESC);
+-- +goose StatementEnd
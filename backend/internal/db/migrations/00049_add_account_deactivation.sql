@@ -0,0 +1,6 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Set when a member deactivates their account; logging in again within the
+-- grace period clears it.
+ALTER TABLE app_user ADD COLUMN IF NOT EXISTS deactivated_at TIMESTAMPTZ;
+-- +goose StatementEnd
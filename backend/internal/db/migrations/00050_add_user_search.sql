@@ -0,0 +1,8 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Trigram indexes back the @-mention autocomplete; they serve the
+-- ILIKE 'prefix%' and '% prefix%' lookups of UserService.SearchUsers.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+CREATE INDEX IF NOT EXISTS idx_app_user_username_trgm ON app_user USING GIN (username gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_app_user_display_name_trgm ON app_user USING GIN (display_name gin_trgm_ops);
+-- +goose StatementEnd
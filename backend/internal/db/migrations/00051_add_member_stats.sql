@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Daily participation counts per member behind StatsService.GetLeaderboard.
+-- The backend refreshes the view every few minutes; days are in UTC.
+CREATE MATERIALIZED VIEW IF NOT EXISTS member_stats_daily AS
+SELECT user_id, day,
+       COUNT(*) FILTER (WHERE kind = 'song_joined')::int AS songs_joined,
+       COUNT(*) FILTER (WHERE kind = 'event_attended')::int AS events_attended,
+       COUNT(*) FILTER (WHERE kind = 'song_proposed')::int AS songs_proposed
+FROM (
+    -- A song counts once, however many roles the member plays in it.
+    SELECT user_id, 'song_joined' AS kind, (MIN(joined_at) AT TIME ZONE 'UTC')::date AS day
+    FROM song_role_assignment
+    GROUP BY user_id, song_id
+    UNION ALL
+    SELECT p.user_id, 'event_attended', (e.start_at AT TIME ZONE 'UTC')::date
+    FROM (SELECT user_id, event_id FROM event_participant
+          UNION
+          SELECT user_id, event_id FROM event_attendance) p
+    JOIN event e ON e.id = p.event_id
+    WHERE e.start_at <= NOW() AND e.cancelled_at IS NULL AND e.deleted_at IS NULL
+    UNION ALL
+    SELECT created_by, 'song_proposed', (created_at AT TIME ZONE 'UTC')::date
+    FROM song
+    WHERE created_by IS NOT NULL
+) x
+GROUP BY user_id, day;
+
+-- Unique so the view can be refreshed concurrently.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_member_stats_daily ON member_stats_daily(user_id, day);
+-- +goose StatementEnd
@@ -0,0 +1,5 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Feed entries newer than this are unread on the dashboard.
+ALTER TABLE app_user ADD COLUMN IF NOT EXISTS activity_seen_at TIMESTAMPTZ;
+-- +goose StatementEnd
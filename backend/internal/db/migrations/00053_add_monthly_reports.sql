@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Months whose wrap-up was posted to the club chat, so the job posts each
+-- one once.
+CREATE TABLE IF NOT EXISTS monthly_report (
+    month DATE PRIMARY KEY,
+    posted_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+-- +goose StatementEnd
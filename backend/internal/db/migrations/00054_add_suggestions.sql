@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Anonymous suggestions members DM to the bot. Nothing identifies the
+-- sender; the bot only checks they are a club member.
+CREATE TABLE IF NOT EXISTS suggestion (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    body TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    resolved_at TIMESTAMPTZ,
+    resolved_by UUID REFERENCES app_user(id) ON DELETE SET NULL,
+    resolution TEXT NOT NULL DEFAULT '',
+    published_at TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS idx_suggestion_created ON suggestion(created_at DESC);
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Songs people outside the club asked for through the bot. Admins approve
+-- a request into a catalog song or reject it.
+CREATE TABLE IF NOT EXISTS song_request (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    link_url TEXT NOT NULL,
+    comment TEXT NOT NULL DEFAULT '',
+    requester_tg_id BIGINT NOT NULL,
+    requester_name TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected')),
+    song_id UUID REFERENCES song(id) ON DELETE SET NULL,
+    reviewed_by UUID REFERENCES app_user(id) ON DELETE SET NULL,
+    reviewed_at TIMESTAMPTZ,
+    review_note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_song_request_status ON song_request(status, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_song_request_requester ON song_request(requester_tg_id) WHERE status = 'pending';
+-- +goose StatementEnd
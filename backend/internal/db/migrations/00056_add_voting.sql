@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Ranked-choice voting rounds for picking songs, usually an event's setlist.
+CREATE TABLE IF NOT EXISTS voting_round (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    title TEXT NOT NULL,
+    -- Event whose tracklist the winners are meant for, if any.
+    event_id UUID REFERENCES event(id) ON DELETE SET NULL,
+    -- How many songs win the round.
+    seats INT NOT NULL DEFAULT 1 CHECK (seats > 0),
+    closes_at TIMESTAMPTZ NOT NULL,
+    -- Set when an admin ends voting before the deadline.
+    closed_at TIMESTAMPTZ,
+    created_by UUID REFERENCES app_user(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_voting_round_closes ON voting_round(closes_at DESC);
+
+CREATE TABLE IF NOT EXISTS voting_candidate (
+    round_id UUID NOT NULL REFERENCES voting_round(id) ON DELETE CASCADE,
+    song_id UUID NOT NULL REFERENCES song(id) ON DELETE CASCADE,
+    position INT NOT NULL,
+    PRIMARY KEY (round_id, song_id)
+);
+
+-- One row per ranked song; rank 1 is the voter's first choice.
+CREATE TABLE IF NOT EXISTS voting_ballot (
+    round_id UUID NOT NULL REFERENCES voting_round(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES app_user(id) ON DELETE CASCADE,
+    song_id UUID NOT NULL,
+    rank INT NOT NULL CHECK (rank > 0),
+    PRIMARY KEY (round_id, user_id, song_id),
+    UNIQUE (round_id, user_id, rank),
+    FOREIGN KEY (round_id, song_id) REFERENCES voting_candidate(round_id, song_id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Money members paid into the club (cash for the rehearsal space etc.) and
+-- what they were charged, recorded by admins by hand.
+CREATE TABLE IF NOT EXISTS dues_entry (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES app_user(id) ON DELETE CASCADE,
+    kind TEXT NOT NULL CHECK (kind IN ('contribution', 'charge')),
+    amount_cents BIGINT NOT NULL CHECK (amount_cents > 0),
+    description TEXT NOT NULL DEFAULT '',
+    season_id UUID REFERENCES season(id) ON DELETE SET NULL,
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    recorded_by UUID REFERENCES app_user(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_dues_entry_user ON dues_entry(user_id, occurred_at DESC);
+CREATE INDEX IF NOT EXISTS idx_dues_entry_season ON dues_entry(season_id);
+-- +goose StatementEnd
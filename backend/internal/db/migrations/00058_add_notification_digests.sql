@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- How users get non-urgent notifications: one message each right away, or
+-- collected into a daily or weekly digest.
+ALTER TABLE app_user ADD COLUMN IF NOT EXISTS notification_digest TEXT NOT NULL DEFAULT 'immediate'
+    CHECK (notification_digest IN ('immediate', 'daily', 'weekly'));
+
+-- Rendered notifications waiting for the user's next digest; expires_at
+-- drops ones that are pointless by then (e.g. a closed survey).
+CREATE TABLE IF NOT EXISTS pending_notification (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES app_user(id) ON DELETE CASCADE,
+    text TEXT NOT NULL,
+    expires_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_pending_notification_user ON pending_notification(user_id, created_at);
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- RPC calls per hour, method and user (NULL for unauthenticated calls),
+-- flushed from the backend's in-memory counters every minute.
+CREATE TABLE IF NOT EXISTS api_usage_hourly (
+    hour TIMESTAMPTZ NOT NULL,
+    method TEXT NOT NULL,
+    user_id UUID REFERENCES app_user(id) ON DELETE CASCADE,
+    calls BIGINT NOT NULL DEFAULT 0,
+    errors BIGINT NOT NULL DEFAULT 0,
+    total_ms BIGINT NOT NULL DEFAULT 0,
+    max_ms BIGINT NOT NULL DEFAULT 0,
+    UNIQUE NULLS NOT DISTINCT (hour, method, user_id)
+);
+CREATE INDEX IF NOT EXISTS idx_api_usage_hourly_user ON api_usage_hourly(user_id, hour);
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Usernames members gave up. Nobody else can take one until reserved_until,
+-- so links and mentions don't suddenly point at someone new.
+CREATE TABLE IF NOT EXISTS username_history (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES app_user(id) ON DELETE CASCADE,
+    username TEXT NOT NULL,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    reserved_until TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_username_history_name ON username_history(lower(username), reserved_until);
+CREATE INDEX IF NOT EXISTS idx_username_history_user ON username_history(user_id, changed_at DESC);
+-- +goose StatementEnd
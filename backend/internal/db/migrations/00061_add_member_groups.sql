@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Sections and teams such as "vocals section" or "organizers". Members can
+-- be in several groups.
+CREATE TABLE IF NOT EXISTS member_group (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    -- Mention the group's members in the club chat when songs they play in
+    -- are edited.
+    mention_on_song_changes BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by UUID REFERENCES app_user(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_member_group_name ON member_group(lower(name));
+
+CREATE TABLE IF NOT EXISTS member_group_member (
+    group_id UUID NOT NULL REFERENCES member_group(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES app_user(id) ON DELETE CASCADE,
+    added_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (group_id, user_id)
+);
+CREATE INDEX IF NOT EXISTS idx_member_group_member_user ON member_group_member(user_id);
+
+-- Song edits waiting to be announced to groups. Edits in quick succession
+-- merge into one row, so a song gets one message per burst of edits.
+CREATE TABLE IF NOT EXISTS song_change_notice (
+    song_id UUID PRIMARY KEY REFERENCES song(id) ON DELETE CASCADE,
+    -- Whoever edited last; they aren't mentioned.
+    actor_id UUID REFERENCES app_user(id) ON DELETE SET NULL,
+    fields TEXT[] NOT NULL DEFAULT '{}',
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+-- +goose StatementEnd
@@ -0,0 +1,7 @@
+-- +goose Up
+-- +goose StatementBegin
+-- When the day and hour reminders of a rehearsal went out; cleared when it
+-- moves so they go out again for the new time.
+ALTER TABLE rehearsal ADD COLUMN IF NOT EXISTS day_reminder_sent_at TIMESTAMPTZ;
+ALTER TABLE rehearsal ADD COLUMN IF NOT EXISTS hour_reminder_sent_at TIMESTAMPTZ;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A series hands its theme down to the occurrences it creates.
+ALTER TABLE event_series ADD COLUMN IF NOT EXISTS theme TEXT;
+ALTER TABLE event_series ADD COLUMN IF NOT EXISTS theme_tag TEXT;
+
+-- Older series kept their theme on the first occurrence only.
+UPDATE event_series s SET theme = e.theme, theme_tag = e.theme_tag
+FROM (
+    SELECT DISTINCT ON (series_id) series_id, theme, theme_tag
+    FROM event WHERE series_id IS NOT NULL
+    ORDER BY series_id, occurrence_at
+) e
+WHERE e.series_id = s.id AND s.theme IS NULL AND s.theme_tag IS NULL;
+-- +goose StatementEnd
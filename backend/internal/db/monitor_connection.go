@@ -2,7 +2,8 @@ package db
 
 import (
 	"database/sql"
-	"log"
+	"log/slog"
+	"os"
 	"time"
 )
 
@@ -19,6 +20,7 @@ func MonitorDbConnection(db *sql.DB, delay time.Duration) {
 
 func TestDbConnection(db *sql.DB) {
 	if err := db.Ping(); err != nil {
-		log.Fatalf("Failed to ping database: %v", err)
+		slog.Error("ping database", "error", err)
+		os.Exit(1)
 	}
 }
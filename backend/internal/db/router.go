@@ -0,0 +1,31 @@
+package db
+
+import "database/sql"
+
+// Router picks the pool a query runs on: read-only queries that can live
+// with replication lag go to the replica when one is configured, everything
+// else to the primary.
+type Router struct {
+	primary *sql.DB
+	replica *sql.DB
+}
+
+// NewRouter routes between primary and replica; with a nil replica every
+// query goes to the primary.
+func NewRouter(primary, replica *sql.DB) *Router {
+	return &Router{primary: primary, replica: replica}
+}
+
+// Primary is the pool for writes and for reads that must see them.
+func (r *Router) Primary() *sql.DB {
+	return r.primary
+}
+
+// Read is the pool for read-only queries. Its data may trail the primary
+// by the replication delay.
+func (r *Router) Read() *sql.DB {
+	if r.replica != nil {
+		return r.replica
+	}
+	return r.primary
+}
@@ -0,0 +1,111 @@
+// Package gateway serves every RPC as JSON over HTTP for scripts and tools
+// without protobuf support, and the OpenAPI document describing it. Calls
+// go through the gRPC server in-process, so they pass the same
+// interceptors as gRPC-Web ones.
+package gateway
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/textproto"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"musicclubbot/backend/internal/api"
+)
+
+// Prefix is where the gateway is mounted: POST
+// /api/musicclub.song.SongService/ListSongs with the request as JSON calls
+// ListSongs.
+const Prefix = "/api"
+
+// api.swagger.json is generated with the Go code, see the Makefile.
+//
+//go:embed api.swagger.json
+var swagger []byte
+
+const bufSize = 1 << 20
+
+// New starts serving server in-process and returns the gateway calling it.
+// It stops with server.
+func New(ctx context.Context, server *grpc.Server) (http.Handler, error) {
+	lis := bufconn.Listen(bufSize)
+	go func() { _ = server.Serve(lis) }()
+	conn, err := grpc.NewClient("passthrough:///gateway",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		<-ctx.Done()
+		_ = conn.Close()
+	}()
+
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(incomingHeader),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeader),
+	)
+	if err := api.RegisterGateway(ctx, mux, conn); err != nil {
+		return nil, err
+	}
+	return http.StripPrefix(Prefix, mux), nil
+}
+
+// incomingHeader passes on the headers the interceptors read besides
+// Authorization, which the gateway always forwards.
+func incomingHeader(key string) (string, bool) {
+	switch textproto.CanonicalMIMEHeaderKey(key) {
+	case "X-Real-Ip", "X-Request-Id":
+		return strings.ToLower(key), true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// outgoingHeader drops x-request-id, which the HTTP server already sets,
+// and prefixes the other response metadata as the gateway does by default.
+func outgoingHeader(key string) (string, bool) {
+	if key == "x-request-id" {
+		return "", false
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
+// OpenAPIHandler serves the OpenAPI (Swagger 2.0) document of the gateway.
+func OpenAPIHandler() (http.Handler, error) {
+	var doc map[string]any
+	if err := json.Unmarshal(swagger, &doc); err != nil {
+		return nil, err
+	}
+	// The generator names the document after the first proto file and
+	// knows nothing of the prefix or the bearer tokens.
+	doc["info"] = map[string]any{"title": "Music Club API", "version": "1"}
+	doc["basePath"] = Prefix
+	doc["securityDefinitions"] = map[string]any{
+		"bearer": map[string]any{
+			"type":        "apiKey",
+			"in":          "header",
+			"name":        "Authorization",
+			"description": `Access token as "Bearer <token>", from AuthService/Login or AuthService/Refresh.`,
+		},
+	}
+	doc["security"] = []any{map[string]any{"bearer": []any{}}}
+	body, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+	}), nil
+}
@@ -0,0 +1,136 @@
+package gcal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"musicclubbot/backend/internal/ical"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+)
+
+const calendarScope = "https://www.googleapis.com/auth/calendar.events"
+
+// Client mirrors events into a single Google Calendar using a service
+// account. The calendar has to be shared with the service account's email.
+type Client struct {
+	calendarID string
+	http       *http.Client
+}
+
+func New(ctx context.Context, credentialsFile, calendarID string) (*Client, error) {
+	data, err := os.ReadFile(credentialsFile)
+	if err != nil {
+		return nil, fmt.Errorf("read google credentials: %w", err)
+	}
+	jwtCfg, err := google.JWTConfigFromJSON(data, calendarScope)
+	if err != nil {
+		return nil, fmt.Errorf("parse google service account: %w", err)
+	}
+	base := context.WithValue(ctx, oauth2.HTTPClient, &http.Client{Timeout: 30 * time.Second})
+	client := jwtCfg.Client(base)
+	client.Timeout = 30 * time.Second
+	return &Client{calendarID: calendarID, http: client}, nil
+}
+
+type eventTime struct {
+	DateTime string `json:"dateTime"`
+}
+
+type calendarEvent struct {
+	ID          string    `json:"id,omitempty"`
+	Summary     string    `json:"summary"`
+	Location    string    `json:"location,omitempty"`
+	Description string    `json:"description,omitempty"`
+	Start       eventTime `json:"start"`
+	End         eventTime `json:"end"`
+	Status      string    `json:"status,omitempty"`
+}
+
+// Upsert creates or replaces the calendar entry for an event.
+func (c *Client) Upsert(ctx context.Context, eventID string, e ical.Event) error {
+	end := e.End
+	if end.IsZero() {
+		end = e.Start.Add(ical.DefaultDuration)
+	}
+	summary := e.Title
+	// A cancelled status would hide the entry; members should still see it.
+	if e.Cancelled {
+		summary = "Отменено: " + summary
+	}
+	body := calendarEvent{
+		ID:          CalendarEventID(eventID),
+		Summary:     summary,
+		Location:    e.Location,
+		Description: e.Description,
+		Start:       eventTime{DateTime: e.Start.UTC().Format("2006-01-02T15:04:05Z")},
+		End:         eventTime{DateTime: end.UTC().Format("2006-01-02T15:04:05Z")},
+		// Entries deleted earlier linger as cancelled and must be revived.
+		Status: "confirmed",
+	}
+	status, err := c.do(ctx, http.MethodPut, c.eventsURL()+"/"+body.ID, body)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusNotFound {
+		return nil
+	}
+	_, err = c.do(ctx, http.MethodPost, c.eventsURL(), body)
+	return err
+}
+
+// Delete removes the calendar entry; missing entries are not an error.
+func (c *Client) Delete(ctx context.Context, eventID string) error {
+	status, err := c.do(ctx, http.MethodDelete, c.eventsURL()+"/"+CalendarEventID(eventID), nil)
+	if status == http.StatusNotFound || status == http.StatusGone {
+		return nil
+	}
+	return err
+}
+
+// CalendarEventID maps our UUIDs onto Google's id alphabet (base32hex).
+func CalendarEventID(eventID string) string {
+	return strings.ReplaceAll(eventID, "-", "")
+}
+
+func (c *Client) eventsURL() string {
+	return "https://www.googleapis.com/calendar/v3/calendars/" + url.PathEscape(c.calendarID) + "/events"
+}
+
+// do returns the response status; 404 and 410 are reported without error so
+// callers can decide what they mean.
+func (c *Client) do(ctx context.Context, method, endpoint string, payload any) (int, error) {
+	var body io.Reader
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return 0, err
+		}
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
+	if err != nil {
+		return 0, err
+	}
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("google calendar %s: %w", method, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone || resp.StatusCode < 300 {
+		return resp.StatusCode, nil
+	}
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return resp.StatusCode, fmt.Errorf("google calendar %s: %s: %s", method, resp.Status, strings.TrimSpace(string(msg)))
+}
@@ -0,0 +1,114 @@
+package gcal
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/internal/ical"
+	"strings"
+	"time"
+)
+
+// syncWindow limits mirroring to recent and upcoming events.
+const syncWindow = 30 * 24 * time.Hour
+
+// Sync pushes new and changed events to Google Calendar and removes entries
+// of events that were deleted or lost their date. A content hash per event
+// tells what changed since the last run.
+func Sync(ctx context.Context, db *sql.DB, c *Client) error {
+	rows, err := db.QueryContext(ctx, `SELECT id FROM event WHERE start_at >= $1 AND deleted_at IS NULL`, time.Now().Add(-syncWindow))
+	if err != nil {
+		return err
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return err
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	events, err := helpers.LoadCalendarEvents(ctx, db, ids)
+	if err != nil {
+		return err
+	}
+	for _, e := range events {
+		eventID := strings.TrimSuffix(e.UID, helpers.CalendarUIDSuffix)
+		hash := contentHash(e)
+		var synced string
+		err := db.QueryRowContext(ctx, `SELECT content_hash FROM google_calendar_event WHERE event_id = $1`, eventID).Scan(&synced)
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+		if synced == hash {
+			continue
+		}
+		if err := c.Upsert(ctx, eventID, e); err != nil {
+			return fmt.Errorf("sync event %s: %w", eventID, err)
+		}
+		if _, err := db.ExecContext(ctx, `
+			INSERT INTO google_calendar_event (event_id, content_hash, synced_at)
+			VALUES ($1, $2, NOW())
+			ON CONFLICT (event_id) DO UPDATE SET content_hash = EXCLUDED.content_hash, synced_at = NOW()
+		`, eventID, hash); err != nil {
+			return err
+		}
+	}
+
+	return removeStale(ctx, db, c)
+}
+
+func removeStale(ctx context.Context, db *sql.DB, c *Client) error {
+	rows, err := db.QueryContext(ctx, `
+		SELECT g.event_id
+		FROM google_calendar_event g
+		LEFT JOIN event e ON e.id = g.event_id
+		WHERE e.id IS NULL OR e.start_at IS NULL OR e.deleted_at IS NOT NULL
+	`)
+	if err != nil {
+		return err
+	}
+	var stale []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return err
+		}
+		stale = append(stale, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, id := range stale {
+		if err := c.Delete(ctx, id); err != nil {
+			return fmt.Errorf("remove event %s: %w", id, err)
+		}
+		if _, err := db.ExecContext(ctx, `DELETE FROM google_calendar_event WHERE event_id = $1`, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func contentHash(e ical.Event) string {
+	content := e.Title + "\x00" + e.Location + "\x00" + e.Description + "\x00" +
+		e.Start.UTC().Format(time.RFC3339) + "\x00" + e.End.UTC().Format(time.RFC3339)
+	// Appended only when set so hashes of synced events stay valid.
+	if e.Cancelled {
+		content += "\x00cancelled"
+	}
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
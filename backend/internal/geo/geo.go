@@ -0,0 +1,152 @@
+// Package geo reads coordinates from map links and addresses and builds
+// directions links for the Mini App.
+package geo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Point is a WGS 84 position.
+type Point struct {
+	Lat, Lon float64
+}
+
+// Valid reports whether the point lies within latitude/longitude bounds.
+func (p Point) Valid() bool {
+	return p.Lat >= -90 && p.Lat <= 90 && p.Lon >= -180 && p.Lon <= 180
+}
+
+var (
+	// Google Maps puts the viewport into the path: /@55.75,37.61,17z.
+	atPattern = regexp.MustCompile(`@(-?\d+(?:\.\d+)?),(-?\d+(?:\.\d+)?)`)
+	// OpenStreetMap keeps it in the fragment: #map=17/55.75/37.61.
+	osmFragment = regexp.MustCompile(`^map=\d+/(-?\d+(?:\.\d+)?)/(-?\d+(?:\.\d+)?)`)
+)
+
+// ParseMapURL reads coordinates from a Google Maps, Yandex Maps, 2GIS or
+// OpenStreetMap link. Links that only name a place report false.
+func ParseMapURL(raw string) (Point, bool) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || u.Host == "" {
+		return Point{}, false
+	}
+	host := strings.ToLower(u.Host)
+	q := u.Query()
+	var p Point
+	var ok bool
+	switch {
+	case strings.Contains(host, "yandex."):
+		// Yandex puts longitude first, pt is the marker and ll the viewport.
+		if p, ok = pair(q.Get("pt"), true); !ok {
+			p, ok = pair(q.Get("ll"), true)
+		}
+	case strings.Contains(host, "2gis."):
+		// 2GIS: ?m=37.61,55.75/17, longitude first as well.
+		m, _, _ := strings.Cut(q.Get("m"), "/")
+		p, ok = pair(m, true)
+	case strings.Contains(host, "openstreetmap."):
+		if lat, lon := q.Get("mlat"), q.Get("mlon"); lat != "" && lon != "" {
+			p, ok = pair(lat+","+lon, false)
+		} else if m := osmFragment.FindStringSubmatch(u.Fragment); m != nil {
+			p, ok = pair(m[1]+","+m[2], false)
+		}
+	default:
+		for _, key := range []string{"q", "query", "destination", "ll"} {
+			if p, ok = pair(q.Get(key), false); ok {
+				break
+			}
+		}
+		if !ok {
+			if m := atPattern.FindStringSubmatch(u.Path); m != nil {
+				p, ok = pair(m[1]+","+m[2], false)
+			}
+		}
+	}
+	if !ok || !p.Valid() {
+		return Point{}, false
+	}
+	return p, true
+}
+
+// pair parses "a,b" as latitude and longitude, or the other way round when
+// lonFirst is set.
+func pair(s string, lonFirst bool) (Point, bool) {
+	a, b, found := strings.Cut(s, ",")
+	if !found {
+		return Point{}, false
+	}
+	x, err := strconv.ParseFloat(strings.TrimSpace(a), 64)
+	if err != nil {
+		return Point{}, false
+	}
+	y, err := strconv.ParseFloat(strings.TrimSpace(b), 64)
+	if err != nil {
+		return Point{}, false
+	}
+	if lonFirst {
+		return Point{Lat: y, Lon: x}, true
+	}
+	return Point{Lat: x, Lon: y}, true
+}
+
+var geocodeClient = &http.Client{Timeout: 10 * time.Second}
+
+// Geocode looks the address up with a Nominatim-compatible search API at
+// baseURL. An address nothing was found for reports false without an error.
+func Geocode(ctx context.Context, baseURL, address string) (Point, bool, error) {
+	u := strings.TrimRight(baseURL, "/") + "/search?" + url.Values{
+		"q":      {address},
+		"format": {"jsonv2"},
+		"limit":  {"1"},
+	}.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return Point{}, false, err
+	}
+	// Nominatim's usage policy asks for an identifying User-Agent.
+	req.Header.Set("User-Agent", "musicclubbot")
+	resp, err := geocodeClient.Do(req)
+	if err != nil {
+		return Point{}, false, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return Point{}, false, fmt.Errorf("geocode: %s", resp.Status)
+	}
+	var results []struct {
+		Lat string `json:"lat"`
+		Lon string `json:"lon"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&results); err != nil {
+		return Point{}, false, fmt.Errorf("geocode: %w", err)
+	}
+	if len(results) == 0 {
+		return Point{}, false, nil
+	}
+	p, ok := pair(results[0].Lat+","+results[0].Lon, false)
+	if !ok || !p.Valid() {
+		return Point{}, false, fmt.Errorf("geocode: bad coordinates %q,%q", results[0].Lat, results[0].Lon)
+	}
+	return p, true, nil
+}
+
+// DirectionsURL links to route planning to the point. Google's universal
+// link opens the installed maps app on phones and the website elsewhere.
+func DirectionsURL(p Point) string {
+	return "https://www.google.com/maps/dir/?api=1&destination=" +
+		strconv.FormatFloat(p.Lat, 'f', 6, 64) + "," + strconv.FormatFloat(p.Lon, 'f', 6, 64)
+}
+
+// DirectionsURLForAddress is DirectionsURL for a place known only by its
+// address.
+func DirectionsURLForAddress(address string) string {
+	return "https://www.google.com/maps/dir/?api=1&destination=" + url.QueryEscape(address)
+}
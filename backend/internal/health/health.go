@@ -0,0 +1,161 @@
+// Package health reports whether the backend can serve requests, for
+// container and orchestrator probes over HTTP (/healthz, /readyz) and the
+// standard grpc.health.v1 service.
+package health
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"musicclubbot/backend/internal/telegram"
+)
+
+const (
+	// checkTimeout bounds a single dependency check.
+	checkTimeout = 3 * time.Second
+	// telegramCacheFor keeps probes from calling the Bot API every few
+	// seconds.
+	telegramCacheFor = time.Minute
+	// watchEvery is how often the gRPC health status is refreshed.
+	watchEvery = 10 * time.Second
+)
+
+// Checker probes the database and, when given a client, the Telegram Bot
+// API.
+type Checker struct {
+	db *sql.DB
+	tg *telegram.Client
+
+	mu           sync.Mutex
+	tgCheckedAt  time.Time
+	tgErr        error
+	shuttingDown bool
+}
+
+// New returns a checker; tg may be nil to leave Telegram out of readiness.
+func New(db *sql.DB, tg *telegram.Client) *Checker {
+	return &Checker{db: db, tg: tg}
+}
+
+// Live checks what a restart could fix: the database must answer.
+func (c *Checker) Live(ctx context.Context) map[string]error {
+	return map[string]error{"database": c.pingDB(ctx)}
+}
+
+// Ready checks everything needed to take traffic. It fails once shutdown
+// has begun so load balancers stop sending requests.
+func (c *Checker) Ready(ctx context.Context) map[string]error {
+	checks := c.Live(ctx)
+	if c.tg != nil {
+		checks["telegram"] = c.checkTelegram(ctx)
+	}
+	c.mu.Lock()
+	if c.shuttingDown {
+		checks["shutdown"] = errors.New("shutting down")
+	}
+	c.mu.Unlock()
+	return checks
+}
+
+// ShuttingDown marks the backend as no longer ready.
+func (c *Checker) ShuttingDown() {
+	c.mu.Lock()
+	c.shuttingDown = true
+	c.mu.Unlock()
+}
+
+func (c *Checker) pingDB(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+	return c.db.PingContext(ctx)
+}
+
+func (c *Checker) checkTelegram(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if time.Since(c.tgCheckedAt) < telegramCacheFor {
+		return c.tgErr
+	}
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+	c.tgErr = c.tg.GetMe(ctx)
+	c.tgCheckedAt = time.Now()
+	return c.tgErr
+}
+
+// LiveHandler serves /healthz.
+func (c *Checker) LiveHandler() http.Handler {
+	return checkHandler(c.Live)
+}
+
+// ReadyHandler serves /readyz.
+func (c *Checker) ReadyHandler() http.Handler {
+	return checkHandler(c.Ready)
+}
+
+// checkHandler answers 200 or 503 with each check's result as JSON.
+func checkHandler(check func(context.Context) map[string]error) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		results := map[string]string{}
+		code := http.StatusOK
+		for name, err := range check(r.Context()) {
+			results[name] = "ok"
+			if err != nil {
+				results[name] = err.Error()
+				code = http.StatusServiceUnavailable
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		w.WriteHeader(code)
+		_ = json.NewEncoder(w).Encode(results)
+	})
+}
+
+// Watch keeps the overall ("") status of srv in line with Ready until ctx
+// is done, then marks it NOT_SERVING for good.
+func (c *Checker) Watch(ctx context.Context, srv *health.Server, onChange func(healthpb.HealthCheckResponse_ServingStatus, error)) {
+	ticker := time.NewTicker(watchEvery)
+	defer ticker.Stop()
+	last := healthpb.HealthCheckResponse_UNKNOWN
+	for {
+		status := healthpb.HealthCheckResponse_SERVING
+		err := failed(c.Ready(ctx))
+		if err != nil {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		if status != last {
+			srv.SetServingStatus("", status)
+			if onChange != nil {
+				onChange(status, err)
+			}
+			last = status
+		}
+		select {
+		case <-ctx.Done():
+			srv.Shutdown()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// failed joins the failed checks, nil when all passed.
+func failed(checks map[string]error) error {
+	var errs []error
+	for name, err := range checks {
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,66 @@
+package helpers
+
+import (
+	"context"
+	"musicclubbot/backend/proto"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// activityKinds maps ActivityKind to the activity.kind column.
+var activityKinds = map[proto.ActivityKind]string{
+	proto.ActivityKind_ACTIVITY_KIND_SONG_ADDED:  "song_added",
+	proto.ActivityKind_ACTIVITY_KIND_ROLE_JOINED: "role_joined",
+	proto.ActivityKind_ACTIVITY_KIND_EVENT_RSVP:  "event_rsvp",
+}
+
+// MapActivityKind reads the activity.kind column.
+func MapActivityKind(dbValue string) proto.ActivityKind {
+	for k, v := range activityKinds {
+		if v == dbValue {
+			return k
+		}
+	}
+	return proto.ActivityKind_ACTIVITY_KIND_UNSPECIFIED
+}
+
+// RecordActivity adds an entry to the club feed for the current user. detail
+// carries the role joined or the RSVP answer.
+func RecordActivity(ctx context.Context, q Execer, kind proto.ActivityKind, songID, eventID, detail string) error {
+	userID, err := UserIDFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = q.ExecContext(ctx, `
+		INSERT INTO activity (user_id, kind, song_id, event_id, detail)
+		VALUES ($1, $2, NULLIF($3, '')::uuid, NULLIF($4, '')::uuid, $5)
+	`, userID, activityKinds[kind], songID, eventID, detail)
+	return err
+}
+
+// ActivityColumns selects everything ScanActivity expects; use with
+// ActivityFrom.
+const ActivityColumns = `
+	a.id, a.kind, COALESCE(a.song_id::text, ''), COALESCE(s.title, ''), COALESCE(s.artist, ''),
+	COALESCE(a.event_id::text, ''), COALESCE(e.title, ''), a.detail, a.created_at,
+	u.id, u.display_name, COALESCE(u.username, ''), COALESCE(u.avatar_url, '')`
+
+const ActivityFrom = `activity a
+	JOIN app_user u ON u.id = a.user_id
+	LEFT JOIN song s ON s.id = a.song_id
+	LEFT JOIN event e ON e.id = a.event_id`
+
+func ScanActivity(row interface{ Scan(...any) error }) (*proto.Activity, error) {
+	u := &proto.User{}
+	a := &proto.Activity{User: u}
+	var kind string
+	var created time.Time
+	if err := row.Scan(&a.Id, &kind, &a.SongId, &a.SongTitle, &a.SongArtist, &a.EventId, &a.EventTitle, &a.Detail, &created,
+		&u.Id, &u.DisplayName, &u.Username, &u.AvatarUrl); err != nil {
+		return nil, err
+	}
+	a.Kind = MapActivityKind(kind)
+	a.CreatedAt = timestamppb.New(created)
+	return a, nil
+}
@@ -0,0 +1,37 @@
+package helpers
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/proto"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// LoadEventAttendance fills who checked in and whether the current user did.
+func LoadEventAttendance(ctx context.Context, db *sql.DB, details *proto.EventDetails, currentUserID string) error {
+	rows, err := db.QueryContext(ctx, `
+		SELECT a.checked_in_at, au.id, au.display_name, COALESCE(au.username, ''), COALESCE(au.avatar_url, '')
+		FROM event_attendance a
+		JOIN app_user au ON au.id = a.user_id
+		WHERE a.event_id = $1
+		ORDER BY a.checked_in_at
+	`, details.GetEvent().GetId())
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var at time.Time
+		var u proto.User
+		if err := rows.Scan(&at, &u.Id, &u.DisplayName, &u.Username, &u.AvatarUrl); err != nil {
+			return err
+		}
+		if u.Id == currentUserID {
+			details.CheckedIn = true
+		}
+		details.Attendance = append(details.Attendance, &proto.Attendance{User: &u, CheckedInAt: timestamppb.New(at)})
+	}
+	return rows.Err()
+}
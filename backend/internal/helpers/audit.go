@@ -0,0 +1,37 @@
+package helpers
+
+import (
+	"context"
+	"database/sql"
+)
+
+// Entity types recorded in the audit log.
+const (
+	AuditSong        = "song"
+	AuditEvent       = "event"
+	AuditTracklist   = "tracklist"
+	AuditPermissions = "permissions"
+	AuditUser        = "user"
+	AuditInvite      = "invite"
+	AuditSuggestion  = "suggestion"
+	AuditSongRequest = "song_request"
+	AuditVotingRound = "voting_round"
+	AuditDues        = "dues"
+	AuditGroup       = "group"
+)
+
+type Execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// RecordAudit notes that the current user did action to the entity. Pass the
+// mutation's transaction so the entry commits or rolls back with it.
+// Tracklist entries use the event id.
+func RecordAudit(ctx context.Context, q Execer, entityType, entityID, action, summary string) error {
+	actorID, _ := ctx.Value("user_id").(string)
+	_, err := q.ExecContext(ctx, `
+		INSERT INTO audit_entry (actor_id, entity_type, entity_id, action, summary)
+		VALUES (NULLIF($1, '')::uuid, $2, $3, $4, $5)
+	`, actorID, entityType, entityID, action, summary)
+	return err
+}
@@ -0,0 +1,38 @@
+package helpers
+
+import (
+	"context"
+	"musicclubbot/backend/internal/config"
+	"strconv"
+	"strings"
+)
+
+// AvatarSizes are the square sizes an uploaded avatar is rendered in.
+var AvatarSizes = []int{64, 128, 256, 512}
+
+// AvatarStorageKey is where one size of an uploaded avatar is kept.
+func AvatarStorageKey(avatarID string, size int) string {
+	return "avatars/" + avatarID + "/" + strconv.Itoa(size) + ".jpg"
+}
+
+// AvatarURL returns the public URL of an uploaded avatar; clients add
+// ?size=N to pick a smaller rendition.
+func AvatarURL(ctx context.Context, avatarID string) string {
+	cfg, _ := ctx.Value("cfg").(config.Config)
+	return strings.TrimRight(cfg.PublicURL, "/") + "/avatars/" + avatarID
+}
+
+// AvatarSize picks the smallest rendition at least as large as requested,
+// 256 by default.
+func AvatarSize(raw string) int {
+	requested, err := strconv.Atoi(raw)
+	if err != nil || requested <= 0 {
+		return 256
+	}
+	for _, s := range AvatarSizes {
+		if requested <= s {
+			return s
+		}
+	}
+	return AvatarSizes[len(AvatarSizes)-1]
+}
@@ -0,0 +1,110 @@
+package helpers
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"musicclubbot/backend/internal/config"
+	"musicclubbot/backend/internal/ical"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// CalendarUIDSuffix turns event ids into globally unique calendar UIDs.
+const CalendarUIDSuffix = "@musicclubbot"
+
+// icsLinkTTL is how long a download link of an .ics file stays valid.
+const icsLinkTTL = 30 * 24 * time.Hour
+
+// EventICSURL returns the download URL of the .ics file of an event. The
+// link carries a share token, since the file is served without a login.
+func EventICSURL(ctx context.Context, eventID string) string {
+	cfg, _ := ctx.Value("cfg").(config.Config)
+	token := EventShareToken(cfg.JwtSecretKey, eventID, time.Now().Add(icsLinkTTL))
+	return EventShareURL(ctx, token) + "/calendar.ics"
+}
+
+// LoadCalendarEvents turns events into calendar entries with the tracklist in
+// the description. Events without a start time are skipped.
+func LoadCalendarEvents(ctx context.Context, db *sql.DB, eventIDs []string) ([]ical.Event, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT id, title, start_at, COALESCE(location, ''), updated_at, cancelled_at IS NOT NULL
+		FROM event
+		WHERE id = ANY($1) AND start_at IS NOT NULL AND deleted_at IS NULL
+		ORDER BY start_at
+	`, pq.Array(eventIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var events []ical.Event
+	index := map[string]int{}
+	for rows.Next() {
+		var id string
+		var e ical.Event
+		if err := rows.Scan(&id, &e.Title, &e.Start, &e.Location, &e.Updated, &e.Cancelled); err != nil {
+			return nil, err
+		}
+		e.UID = id + CalendarUIDSuffix
+		index[id] = len(events)
+		events = append(events, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	tracks, err := LoadTracklistSections(ctx, db, eventIDs)
+	if err != nil {
+		return nil, err
+	}
+	plain := func(s string) string { return s }
+	for id, i := range index {
+		if sections := tracks[id]; len(sections) > 0 {
+			events[i].Description = "Треклист:\n" + FormatTracklist(sections, plain, plain)
+		}
+	}
+	return events, nil
+}
+
+// CalendarFilename makes a safe attachment name out of an event title.
+func CalendarFilename(title string, start time.Time) string {
+	return EventFilename(title, start, ".ics")
+}
+
+// EventFilename makes a safe attachment name with the given extension out of
+// an event title and start date.
+func EventFilename(title string, start time.Time, ext string) string {
+	name := strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '\\', '"', ':', '*', '?', '<', '>', '|':
+			return '-'
+		}
+		return r
+	}, strings.TrimSpace(title))
+	if name == "" {
+		name = "event"
+	}
+	if !start.IsZero() {
+		name += " " + start.Format("2006-01-02")
+	}
+	return name + ext
+}
+
+// CalendarFeedSignature signs a user id for the personal calendar feed URL.
+// It is derived from the JWT secret, so it is stable until the secret rotates.
+func CalendarFeedSignature(secret []byte, userID string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte("calendar-feed:" + userID))
+	return hex.EncodeToString(mac.Sum(nil)[:16])
+}
+
+// CalendarFeedURL returns the personal iCal feed URL of a user.
+func CalendarFeedURL(ctx context.Context, userID string) string {
+	cfg, _ := ctx.Value("cfg").(config.Config)
+	return strings.TrimRight(cfg.PublicURL, "/") + "/calendar/" + userID + "/" +
+		CalendarFeedSignature(cfg.JwtSecretKey, userID) + "/feed.ics"
+}
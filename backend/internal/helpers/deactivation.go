@@ -0,0 +1,7 @@
+package helpers
+
+import "time"
+
+// DeactivationGrace is how long a deactivated account can still be restored
+// by logging in again.
+const DeactivationGrace = 30 * 24 * time.Hour
@@ -0,0 +1,96 @@
+package helpers
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/config"
+	"musicclubbot/backend/internal/storage"
+	"musicclubbot/backend/proto"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const demoColumns = `
+	d.id, d.song_id, d.title, d.content_type, d.size_bytes, d.created_at,
+	COALESCE(au.id::text, ''), COALESCE(au.display_name, ''), COALESCE(au.username, ''), COALESCE(au.avatar_url, '')`
+
+func StorageFromCtx(ctx context.Context) (storage.Store, error) {
+	store, ok := ctx.Value("storage").(storage.Store)
+	if !ok || store == nil {
+		return nil, status.Error(codes.Internal, "file storage not available in context")
+	}
+	return store, nil
+}
+
+// DemoStorageKey is where the audio of a demo recording is kept.
+func DemoStorageKey(demoID string) string {
+	return "demos/" + demoID
+}
+
+// DemoURL returns the public download URL of a demo recording.
+func DemoURL(ctx context.Context, demoID string) string {
+	cfg, _ := ctx.Value("cfg").(config.Config)
+	return strings.TrimRight(cfg.PublicURL, "/") + "/demos/" + demoID
+}
+
+func LoadDemo(ctx context.Context, db *sql.DB, demoID string) (*proto.Demo, error) {
+	row := db.QueryRowContext(ctx, `
+		SELECT `+demoColumns+`
+		FROM song_demo d
+		LEFT JOIN app_user au ON d.uploaded_by = au.id
+		WHERE d.id = $1
+	`, demoID)
+	return scanDemo(ctx, row)
+}
+
+func LoadSongDemos(ctx context.Context, db *sql.DB, songID string) ([]*proto.Demo, error) {
+	return loadDemos(ctx, db, `d.song_id = $1`, songID)
+}
+
+func loadSongDemosBatch(ctx context.Context, db *sql.DB, songIDs []string) ([]*proto.Demo, error) {
+	return loadDemos(ctx, db, `d.song_id = ANY($1)`, pq.Array(songIDs))
+}
+
+func loadDemos(ctx context.Context, db *sql.DB, where string, arg any) ([]*proto.Demo, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT `+demoColumns+`
+		FROM song_demo d
+		LEFT JOIN app_user au ON d.uploaded_by = au.id
+		WHERE `+where+`
+		ORDER BY d.created_at DESC
+	`, arg)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*proto.Demo
+	for rows.Next() {
+		d, err := scanDemo(ctx, rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, d)
+	}
+	return items, rows.Err()
+}
+
+func scanDemo(ctx context.Context, row interface{ Scan(...any) error }) (*proto.Demo, error) {
+	var d proto.Demo
+	var created time.Time
+	var uploader proto.User
+	if err := row.Scan(&d.Id, &d.SongId, &d.Title, &d.ContentType, &d.SizeBytes, &created,
+		&uploader.Id, &uploader.DisplayName, &uploader.Username, &uploader.AvatarUrl); err != nil {
+		return nil, err
+	}
+	d.CreatedAt = timestamppb.New(created)
+	d.Url = DemoURL(ctx, d.Id)
+	if uploader.Id != "" {
+		d.UploadedBy = &uploader
+	}
+	return &d, nil
+}
@@ -0,0 +1,44 @@
+package helpers
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// LoadEventEquipment returns the event's equipment checklist in the order
+// items were added.
+func LoadEventEquipment(ctx context.Context, db *sql.DB, eventID string) ([]*proto.EquipmentItem, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT i.id, i.name, i.quantity, COALESCE(i.notes, ''), i.checked_at, COALESCE(i.created_by::text, ''),
+		       COALESCE(au.id::text, ''), COALESCE(au.display_name, ''), COALESCE(au.username, ''), COALESCE(au.avatar_url, '')
+		FROM event_equipment_item i
+		LEFT JOIN app_user au ON au.id = i.bringer_id
+		WHERE i.event_id = $1
+		ORDER BY i.created_at, i.id
+	`, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*proto.EquipmentItem
+	for rows.Next() {
+		var it proto.EquipmentItem
+		var checked sql.NullTime
+		var u proto.User
+		if err := rows.Scan(&it.Id, &it.Name, &it.Quantity, &it.Notes, &checked, &it.CreatedById,
+			&u.Id, &u.DisplayName, &u.Username, &u.AvatarUrl); err != nil {
+			return nil, err
+		}
+		if checked.Valid {
+			it.CheckedAt = timestamppb.New(checked.Time)
+		}
+		if u.Id != "" {
+			it.Bringer = &u
+		}
+		items = append(items, &it)
+	}
+	return items, rows.Err()
+}
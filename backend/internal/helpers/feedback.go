@@ -0,0 +1,37 @@
+package helpers
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/proto"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// LoadFeedbackSurvey returns the event's survey with the current user's
+// answer, or nil when no survey was opened.
+func LoadFeedbackSurvey(ctx context.Context, db *sql.DB, eventID, currentUserID string) (*proto.FeedbackSurvey, error) {
+	var opened, closes time.Time
+	var rating sql.NullInt32
+	var comment sql.NullString
+	err := db.QueryRowContext(ctx, `
+		SELECT s.opened_at, s.closes_at, f.rating, f.comment
+		FROM event_feedback_survey s
+		LEFT JOIN event_feedback f ON f.event_id = s.event_id AND f.user_id::text = $2
+		WHERE s.event_id = $1
+	`, eventID, currentUserID).Scan(&opened, &closes, &rating, &comment)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &proto.FeedbackSurvey{
+		OpenedAt:  timestamppb.New(opened),
+		ClosesAt:  timestamppb.New(closes),
+		Open:      time.Now().Before(closes),
+		MyRating:  uint32(rating.Int32),
+		MyComment: comment.String,
+	}, nil
+}
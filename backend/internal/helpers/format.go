@@ -0,0 +1,32 @@
+package helpers
+
+import (
+	"fmt"
+	"time"
+)
+
+var ruMonthsGenitive = [...]string{
+	"января", "февраля", "марта", "апреля", "мая", "июня",
+	"июля", "августа", "сентября", "октября", "ноября", "декабря",
+}
+
+var ruMonths = [...]string{
+	"январь", "февраль", "март", "апрель", "май", "июнь",
+	"июль", "август", "сентябрь", "октябрь", "ноябрь", "декабрь",
+}
+
+var ruWeekdays = [...]string{
+	"воскресенье", "понедельник", "вторник", "среда", "четверг", "пятница", "суббота",
+}
+
+// FormatDateTimeRU renders t as "суббота, 15 марта в 19:00" for messages
+// to members.
+func FormatDateTimeRU(t time.Time) string {
+	return fmt.Sprintf("%s, %d %s в %s",
+		ruWeekdays[t.Weekday()], t.Day(), ruMonthsGenitive[t.Month()-1], t.Format("15:04"))
+}
+
+// FormatMonthRU renders t's month as "сентябрь 2026".
+func FormatMonthRU(t time.Time) string {
+	return fmt.Sprintf("%s %d", ruMonths[t.Month()-1], t.Year())
+}
@@ -0,0 +1,78 @@
+package helpers
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/internal/config"
+	"musicclubbot/backend/internal/geo"
+	"musicclubbot/backend/internal/logging"
+	"musicclubbot/backend/proto"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ResolveCoordinates locates a place: the explicit point wins, then the map
+// link, then the geocoded address. nil when none of them locates it; a
+// failing geocoder only leaves the place without coordinates.
+func ResolveCoordinates(ctx context.Context, point *proto.GeoPoint, mapURL, address string) (*proto.GeoPoint, error) {
+	if point != nil {
+		if !(geo.Point{Lat: point.GetLatitude(), Lon: point.GetLongitude()}).Valid() {
+			return nil, status.Error(codes.InvalidArgument, "coordinates are out of range")
+		}
+		return point, nil
+	}
+	if p, ok := geo.ParseMapURL(mapURL); ok {
+		return &proto.GeoPoint{Latitude: p.Lat, Longitude: p.Lon}, nil
+	}
+	address = strings.TrimSpace(address)
+	cfg, _ := ctx.Value("cfg").(config.Config)
+	if address == "" || cfg.GeocoderURL == "" {
+		return nil, nil
+	}
+	p, ok, err := geo.Geocode(ctx, cfg.GeocoderURL, address)
+	if err != nil {
+		logging.FromContext(ctx).Warn("geocode failed", "address", address, "error", err)
+		return nil, nil
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &proto.GeoPoint{Latitude: p.Lat, Longitude: p.Lon}, nil
+}
+
+// GeoArgs turns an optional point into latitude and longitude query
+// arguments, NULL when unset.
+func GeoArgs(p *proto.GeoPoint) (sql.NullFloat64, sql.NullFloat64) {
+	if p == nil {
+		return sql.NullFloat64{}, sql.NullFloat64{}
+	}
+	return sql.NullFloat64{Valid: true, Float64: p.GetLatitude()}, sql.NullFloat64{Valid: true, Float64: p.GetLongitude()}
+}
+
+// GeoPointFromNull is GeoArgs in reverse, for scanned columns.
+func GeoPointFromNull(lat, lon sql.NullFloat64) *proto.GeoPoint {
+	if !lat.Valid || !lon.Valid {
+		return nil
+	}
+	return &proto.GeoPoint{Latitude: lat.Float64, Longitude: lon.Float64}
+}
+
+// DirectionsURL routes to the event's own coordinates, then its venue's,
+// then whatever address text there is.
+func DirectionsURL(e *proto.Event, v *proto.Venue) string {
+	for _, p := range []*proto.GeoPoint{e.GetCoordinates(), v.GetCoordinates()} {
+		if p != nil {
+			return geo.DirectionsURL(geo.Point{Lat: p.GetLatitude(), Lon: p.GetLongitude()})
+		}
+	}
+	address := v.GetAddress()
+	if address == "" {
+		address = e.GetLocation()
+	}
+	if address == "" {
+		return ""
+	}
+	return geo.DirectionsURLForAddress(address)
+}
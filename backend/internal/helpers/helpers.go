@@ -5,11 +5,15 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"musicclubbot/backend/internal/db"
 	"musicclubbot/backend/proto"
+	"net/url"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -23,6 +27,17 @@ func DbFromCtx(ctx context.Context) (*sql.DB, error) {
 	return db, nil
 }
 
+// ReadDbFromCtx returns the pool for read-only queries: the read replica
+// when one is configured, the primary otherwise. Writes made a moment ago
+// may not be visible there yet, so only reads that can show slightly stale
+// data use it.
+func ReadDbFromCtx(ctx context.Context) (*sql.DB, error) {
+	if router, ok := ctx.Value("db_router").(*db.Router); ok && router != nil {
+		return router.Read(), nil
+	}
+	return DbFromCtx(ctx)
+}
+
 func UserIDFromCtx(ctx context.Context) (string, error) {
 	userID, ok := ctx.Value("user_id").(string)
 	if !ok || userID == "" {
@@ -31,6 +46,34 @@ func UserIDFromCtx(ctx context.Context) (string, error) {
 	return userID, nil
 }
 
+// UserAndDbFromCtx is UserIDFromCtx and DbFromCtx together, for handlers
+// whose permissions the authorization interceptor already checked.
+func UserAndDbFromCtx(ctx context.Context) (string, *sql.DB, error) {
+	userID, err := UserIDFromCtx(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	db, err := DbFromCtx(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	return userID, db, nil
+}
+
+// UserAndReadDbFromCtx is UserAndDbFromCtx for read-only queries, see
+// ReadDbFromCtx.
+func UserAndReadDbFromCtx(ctx context.Context) (string, *sql.DB, error) {
+	userID, err := UserIDFromCtx(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	db, err := ReadDbFromCtx(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	return userID, db, nil
+}
+
 func LoadUserById(ctx context.Context, db *sql.DB, userID string) (*proto.User, error) {
 	row := db.QueryRowContext(ctx, `
 		SELECT id, display_name, username, COALESCE(avatar_url, '')
@@ -55,37 +98,33 @@ func LoadUserByUsername(ctx context.Context, db *sql.DB, username string) (*prot
 	return &u, nil
 }
 
+// LoadPermissions returns the user's effective permissions, served from the
+// request and process caches when possible (see WithPermissionCache).
+// They are always read from the primary, even when db is the read replica:
+// a lagging replica would keep revoked rights in the shared cache.
 func LoadPermissions(ctx context.Context, db *sql.DB, userID string) (*proto.PermissionSet, error) {
-	row := db.QueryRowContext(ctx, `
-		SELECT edit_own_participation, edit_any_participation,
-		       edit_own_songs, edit_any_songs,
-		       edit_events, edit_tracklists
-		FROM user_permissions WHERE user_id = $1
-	`, userID)
-	var p proto.PermissionSet
-	var joinOwn, joinAny, songsOwn, songsAny, events, tracks bool
-	switch err := row.Scan(&joinOwn, &joinAny, &songsOwn, &songsAny, &events, &tracks); err {
-	case nil:
-		// ok
-	case sql.ErrNoRows:
-		// default permissions are all false
-	default:
-		return nil, err
+	if p := cachedPermissionsFor(ctx, userID); p != nil {
+		return clonePermissions(p), nil
 	}
-
-	p.Join = &proto.JoinPermissions{
-		EditOwnParticipation: joinOwn,
-		EditAnyParticipation: joinAny,
+	if primary, err := DbFromCtx(ctx); err == nil {
+		db = primary
 	}
-	p.Songs = &proto.SongPermissions{
-		EditOwnSongs: songsOwn,
-		EditAnySongs: songsAny,
+	p, err := LoadPermissionFlags(ctx, db, "effective_permissions", userID)
+	if err != nil {
+		return nil, err
 	}
-	p.Events = &proto.EventPermissions{
-		EditEvents:     events,
-		EditTracklists: tracks,
+	if p.Roles, err = LoadUserRoles(ctx, db, userID); err != nil {
+		return nil, err
 	}
-	return &p, nil
+	rememberPermissions(ctx, userID, clonePermissions(p), true)
+	return p, nil
+}
+
+// LoadUserRoles returns the names of the user's roles, sorted.
+func LoadUserRoles(ctx context.Context, q QueryRower, userID string) ([]string, error) {
+	var roles []string
+	err := q.QueryRowContext(ctx, `SELECT ARRAY(SELECT role FROM user_role WHERE user_id::text = $1 ORDER BY role)`, userID).Scan(pq.Array(&roles))
+	return roles, err
 }
 
 func MapSongLinkType(dbValue string) proto.SongLinkType {
@@ -114,6 +153,25 @@ func MapSongLinkKindToDB(kind proto.SongLinkType) (string, error) {
 	}
 }
 
+// GuessSongLinkType tells the link kind from the URL's host; UNKNOWN for
+// other sites.
+func GuessSongLinkType(rawURL string) proto.SongLinkType {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return proto.SongLinkType_SONG_LINK_TYPE_UNKNOWN
+	}
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	switch {
+	case host == "youtu.be" || host == "youtube.com" || strings.HasSuffix(host, ".youtube.com"):
+		return proto.SongLinkType_SONG_LINK_TYPE_YOUTUBE
+	case strings.HasPrefix(host, "music.yandex."):
+		return proto.SongLinkType_SONG_LINK_TYPE_YANDEX_MUSIC
+	case host == "soundcloud.com" || strings.HasSuffix(host, ".soundcloud.com"):
+		return proto.SongLinkType_SONG_LINK_TYPE_SOUNDCLOUD
+	}
+	return proto.SongLinkType_SONG_LINK_TYPE_UNKNOWN
+}
+
 func PermissionAllowsSongEdit(perms *proto.PermissionSet, ownerID sql.NullString, currentID string) bool {
 	if perms == nil || perms.Songs == nil {
 		return false
@@ -124,6 +182,14 @@ func PermissionAllowsSongEdit(perms *proto.PermissionSet, ownerID sql.NullString
 	return perms.Songs.EditOwnSongs && ownerID.String != "" && ownerID.String == currentID
 }
 
+func PermissionAllowsSongCreate(perms *proto.PermissionSet) bool {
+	return perms != nil && perms.Songs != nil && (perms.Songs.EditOwnSongs || perms.Songs.EditAnySongs)
+}
+
+func PermissionAllowsAnySongEdit(perms *proto.PermissionSet) bool {
+	return perms != nil && perms.Songs != nil && perms.Songs.EditAnySongs
+}
+
 func PermissionAllowsJoinEdit(perms *proto.PermissionSet, ownerID, currentID string) bool {
 	if perms == nil || perms.Join == nil {
 		return false
@@ -142,19 +208,37 @@ func PermissionAllowsTracklistEdit(perms *proto.PermissionSet) bool {
 	return perms != nil && perms.Events != nil && (perms.Events.EditTracklists || perms.Events.EditEvents)
 }
 
+func PermissionAllowsEventCreate(perms *proto.PermissionSet) bool {
+	return perms != nil && perms.Events != nil && (perms.Events.CreateEvents || perms.Events.EditEvents)
+}
+
+func PermissionAllowsPermissionManagement(perms *proto.PermissionSet) bool {
+	return perms != nil && perms.Admin != nil && perms.Admin.ManagePermissions
+}
+
+// SongIsFavoriteExpr builds a SQL expression telling whether the user bound to
+// userParam (e.g. "$2", may be empty) bookmarked the song row in scope.
+func SongIsFavoriteExpr(userParam string) string {
+	return `EXISTS (SELECT 1 FROM song_favorite f WHERE f.song_id = song.id AND f.user_id = NULLIF(` + userParam + `, '')::uuid)`
+}
+
+// SongDifficultyExpr averages difficulty ratings of the song row in scope.
+const SongDifficultyExpr = `COALESCE((SELECT AVG(r.rating)::float8 FROM song_difficulty_rating r WHERE r.song_id = song.id), 0)`
+
 func LoadSongDetails(ctx context.Context, db *sql.DB, songID, currentUserID string) (*proto.SongDetails, error) {
 	row := db.QueryRowContext(ctx, `
-		SELECT id, title, artist, description, link_kind, link_url, COALESCE(created_by, NULL), COALESCE(thumbnail_url, '')
+		SELECT id, title, artist, description, link_kind, link_url, COALESCE(created_by, NULL), COALESCE(thumbnail_url, ''), version,
+		       `+SongIsFavoriteExpr("$2")+`, pinned_at IS NOT NULL, `+SongDifficultyExpr+`, COALESCE(duration_sec, 0), COALESCE(musical_key, '')
 		FROM song WHERE id = $1
-	`, songID)
+	`, songID, currentUserID)
 	var s proto.Song
 	var linkKind, linkURL, thumbnailURL string
 	var creatorID sql.NullString
-	if err := row.Scan(&s.Id, &s.Title, &s.Artist, &s.Description, &linkKind, &linkURL, &creatorID, &thumbnailURL); err != nil {
+	if err := row.Scan(&s.Id, &s.Title, &s.Artist, &s.Description, &linkKind, &linkURL, &creatorID, &thumbnailURL, &s.Version, &s.IsFavorite, &s.IsPinned, &s.Difficulty, &s.DurationSeconds, &s.Key); err != nil {
 		return nil, err
 	}
 	s.Link = &proto.SongLink{Kind: MapSongLinkType(linkKind), Url: linkURL}
-	s.ThumbnailUrl = thumbnailURL
+	s.ThumbnailUrl = ThumbnailProxyURL(ctx, s.Id, thumbnailURL)
 
 	roles, err := LoadSongRoles(ctx, db, songID)
 	if err != nil {
@@ -162,6 +246,12 @@ func LoadSongDetails(ctx context.Context, db *sql.DB, songID, currentUserID stri
 	}
 	s.AvailableRoles = roles
 
+	tags, err := LoadSongTags(ctx, db, songID)
+	if err != nil {
+		return nil, err
+	}
+	s.Tags = tags
+
 	perms, err := LoadPermissions(ctx, db, currentUserID)
 	if err != nil {
 		return nil, err
@@ -173,13 +263,213 @@ func LoadSongDetails(ctx context.Context, db *sql.DB, songID, currentUserID stri
 		return nil, err
 	}
 
+	difficulty, err := LoadSongRoleDifficulty(ctx, db, songID, currentUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	demos, err := LoadSongDemos(ctx, db, songID)
+	if err != nil {
+		return nil, err
+	}
+
 	return &proto.SongDetails{
-		Song:        &s,
-		Assignments: assignments,
-		Permissions: perms,
+		Song:           &s,
+		Assignments:    assignments,
+		Permissions:    perms,
+		RoleDifficulty: difficulty,
+		Demos:          demos,
 	}, nil
 }
 
+func LoadSongRoleDifficulty(ctx context.Context, db *sql.DB, songID, currentUserID string) ([]*proto.RoleDifficulty, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT role, AVG(rating)::float8, COUNT(*),
+		       COALESCE(MAX(rating) FILTER (WHERE user_id = NULLIF($2, '')::uuid), 0)
+		FROM song_difficulty_rating
+		WHERE song_id = $1
+		GROUP BY role
+		ORDER BY role
+	`, songID, currentUserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*proto.RoleDifficulty
+	for rows.Next() {
+		var d proto.RoleDifficulty
+		if err := rows.Scan(&d.Role, &d.Average, &d.Ratings, &d.MyRating); err != nil {
+			return nil, err
+		}
+		items = append(items, &d)
+	}
+	return items, rows.Err()
+}
+
+// LoadSongDetailsBatch loads details for several songs with a fixed number of
+// queries. Results follow the order of songIDs; unknown ids are skipped.
+func LoadSongDetailsBatch(ctx context.Context, db *sql.DB, songIDs []string, currentUserID string) ([]*proto.SongDetails, error) {
+	if len(songIDs) == 0 {
+		return nil, nil
+	}
+
+	perms, err := LoadPermissions(ctx, db, currentUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.QueryContext(ctx, `
+		SELECT id, title, artist, description, link_kind, link_url, COALESCE(created_by, NULL), COALESCE(thumbnail_url, ''), version,
+		       `+SongIsFavoriteExpr("$2")+`, pinned_at IS NOT NULL, `+SongDifficultyExpr+`, COALESCE(duration_sec, 0), COALESCE(musical_key, '')
+		FROM song WHERE id = ANY($1)
+	`, pq.Array(songIDs), currentUserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	byID := map[string]*proto.SongDetails{}
+	for rows.Next() {
+		var s proto.Song
+		var linkKind, linkURL, thumbnailURL string
+		var creatorID sql.NullString
+		if err := rows.Scan(&s.Id, &s.Title, &s.Artist, &s.Description, &linkKind, &linkURL, &creatorID, &thumbnailURL, &s.Version, &s.IsFavorite, &s.IsPinned, &s.Difficulty, &s.DurationSeconds, &s.Key); err != nil {
+			return nil, err
+		}
+		s.Link = &proto.SongLink{Kind: MapSongLinkType(linkKind), Url: linkURL}
+		s.ThumbnailUrl = ThumbnailProxyURL(ctx, s.Id, thumbnailURL)
+		s.EditableByMe = PermissionAllowsSongEdit(perms, creatorID, currentUserID)
+		byID[s.Id] = &proto.SongDetails{Song: &s, Permissions: perms}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	roleRows, err := db.QueryContext(ctx, `
+		SELECT song_id, role FROM song_role WHERE song_id = ANY($1) ORDER BY role
+	`, pq.Array(songIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer roleRows.Close()
+	for roleRows.Next() {
+		var songID, role string
+		if err := roleRows.Scan(&songID, &role); err != nil {
+			return nil, err
+		}
+		if d, ok := byID[songID]; ok {
+			d.Song.AvailableRoles = append(d.Song.AvailableRoles, role)
+		}
+	}
+	if err := roleRows.Err(); err != nil {
+		return nil, err
+	}
+
+	tagRows, err := db.QueryContext(ctx, `
+		SELECT song_id, tag FROM song_tag WHERE song_id = ANY($1) ORDER BY tag
+	`, pq.Array(songIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer tagRows.Close()
+	for tagRows.Next() {
+		var songID, tag string
+		if err := tagRows.Scan(&songID, &tag); err != nil {
+			return nil, err
+		}
+		if d, ok := byID[songID]; ok {
+			d.Song.Tags = append(d.Song.Tags, tag)
+		}
+	}
+	if err := tagRows.Err(); err != nil {
+		return nil, err
+	}
+
+	assignmentRows, err := db.QueryContext(ctx, `
+		SELECT sra.song_id, sra.role,
+		       au.id, au.display_name, COALESCE(au.username, ''), COALESCE(au.avatar_url, ''),
+		       sra.joined_at
+		FROM song_role_assignment sra
+		JOIN app_user au ON sra.user_id = au.id
+		WHERE sra.song_id = ANY($1)
+		ORDER BY sra.joined_at ASC
+	`, pq.Array(songIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer assignmentRows.Close()
+	for assignmentRows.Next() {
+		var songID, role, uid, display, username, avatar string
+		var joined time.Time
+		if err := assignmentRows.Scan(&songID, &role, &uid, &display, &username, &avatar, &joined); err != nil {
+			return nil, err
+		}
+		d, ok := byID[songID]
+		if !ok {
+			continue
+		}
+		d.Assignments = append(d.Assignments, &proto.RoleAssignment{
+			Role: role,
+			User: &proto.User{
+				Id:          uid,
+				DisplayName: display,
+				Username:    username,
+				AvatarUrl:   avatar,
+			},
+			JoinedAt: timestamppb.New(joined),
+		})
+		d.Song.AssignmentCount++
+	}
+	if err := assignmentRows.Err(); err != nil {
+		return nil, err
+	}
+
+	difficultyRows, err := db.QueryContext(ctx, `
+		SELECT song_id, role, AVG(rating)::float8, COUNT(*),
+		       COALESCE(MAX(rating) FILTER (WHERE user_id = NULLIF($2, '')::uuid), 0)
+		FROM song_difficulty_rating
+		WHERE song_id = ANY($1)
+		GROUP BY song_id, role
+		ORDER BY role
+	`, pq.Array(songIDs), currentUserID)
+	if err != nil {
+		return nil, err
+	}
+	defer difficultyRows.Close()
+	for difficultyRows.Next() {
+		var songID string
+		var d proto.RoleDifficulty
+		if err := difficultyRows.Scan(&songID, &d.Role, &d.Average, &d.Ratings, &d.MyRating); err != nil {
+			return nil, err
+		}
+		if details, ok := byID[songID]; ok {
+			details.RoleDifficulty = append(details.RoleDifficulty, &d)
+		}
+	}
+	if err := difficultyRows.Err(); err != nil {
+		return nil, err
+	}
+
+	demos, err := loadSongDemosBatch(ctx, db, songIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, demo := range demos {
+		if details, ok := byID[demo.SongId]; ok {
+			details.Demos = append(details.Demos, demo)
+		}
+	}
+
+	result := make([]*proto.SongDetails, 0, len(byID))
+	seen := map[string]bool{}
+	for _, id := range songIDs {
+		if d, ok := byID[id]; ok && !seen[id] {
+			seen[id] = true
+			result = append(result, d)
+		}
+	}
+	return result, nil
+}
+
 func LoadSongRoles(ctx context.Context, db *sql.DB, songID string) ([]string, error) {
 	rows, err := db.QueryContext(ctx, `SELECT role FROM song_role WHERE song_id = $1 ORDER BY role`, songID)
 	if err != nil {
@@ -197,6 +487,23 @@ func LoadSongRoles(ctx context.Context, db *sql.DB, songID string) ([]string, er
 	return roles, rows.Err()
 }
 
+func LoadSongTags(ctx context.Context, db *sql.DB, songID string) ([]string, error) {
+	rows, err := db.QueryContext(ctx, `SELECT tag FROM song_tag WHERE song_id = $1 ORDER BY tag`, songID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var tags []string
+	for rows.Next() {
+		var t string
+		if err := rows.Scan(&t); err != nil {
+			return nil, err
+		}
+		tags = append(tags, t)
+	}
+	return tags, rows.Err()
+}
+
 func LoadSongAssignments(ctx context.Context, db *sql.DB, songID string) ([]*proto.RoleAssignment, error) {
 	rows, err := db.QueryContext(ctx, `
 		SELECT sra.role,
@@ -232,18 +539,57 @@ func LoadSongAssignments(ctx context.Context, db *sql.DB, songID string) ([]*pro
 	return items, rows.Err()
 }
 
-func LoadEventDetails(ctx context.Context, db *sql.DB, eventID, currentUserID string) (*proto.EventDetails, error) {
-	row := db.QueryRowContext(ctx, `
-		SELECT id, title, start_at, location, notify_day_before, notify_hour_before
-		FROM event WHERE id = $1
-	`, eventID)
+// EventColumns selects everything ScanEvent expects; use with EventFrom.
+const EventColumns = `
+	e.id, e.title, e.start_at, COALESCE(e.location, v.name, ''), e.notify_day_before, e.notify_hour_before, e.version,
+	(SELECT COUNT(DISTINCT user_id) FROM event_participant WHERE event_id = e.id),
+	(SELECT COUNT(*) FROM event_track_item WHERE event_id = e.id),
+	COALESCE(e.series_id::text, ''), COALESCE(es.rrule, ''), e.max_participants, e.required_roles,
+	(SELECT COUNT(*) FROM event_attendance WHERE event_id = e.id),
+	COALESCE(e.venue_id::text, ''), e.time_slot_minutes, e.completed_at,
+	ARRAY(SELECT offset_minutes FROM event_notification WHERE event_id = e.id ORDER BY offset_minutes DESC),
+	e.cancelled_at, COALESCE(e.cancel_reason, ''), e.timezone,
+	COALESCE(e.season_id::text, ''), e.track_gap_seconds,
+	COALESCE(e.current_track_item_id::text, ''), e.current_track_started_at, e.deleted_at,
+	e.latitude, e.longitude, COALESCE(e.theme, ''), COALESCE(e.theme_tag, '')`
+
+const EventFrom = `event e LEFT JOIN event_series es ON es.id = e.series_id LEFT JOIN venue v ON v.id = e.venue_id`
+
+func ScanEvent(row interface{ Scan(...any) error }) (*proto.Event, error) {
 	var e proto.Event
-	var start sql.NullTime
-	if err := row.Scan(&e.Id, &e.Title, &start, &e.Location, &e.NotifyDayBefore, &e.NotifyHourBefore); err != nil {
+	var start, completed, cancelled, trackStarted, deleted sql.NullTime
+	var lat, lon sql.NullFloat64
+	if err := row.Scan(&e.Id, &e.Title, &start, &e.Location, &e.NotifyDayBefore, &e.NotifyHourBefore, &e.Version,
+		&e.ParticipantCount, &e.TrackCount, &e.SeriesId, &e.Recurrence, &e.MaxParticipants, pq.Array(&e.RequiredRoles), &e.AttendanceCount,
+		&e.VenueId, &e.TimeSlotMinutes, &completed, pq.Array(&e.NotifyOffsetsMinutes),
+		&cancelled, &e.CancelReason, &e.Timezone, &e.SeasonId, &e.TrackGapSeconds,
+		&e.CurrentTrackItemId, &trackStarted, &deleted, &lat, &lon, &e.Theme, &e.ThemeTag); err != nil {
 		return nil, err
 	}
 	if start.Valid {
 		e.StartAt = timestamppb.New(start.Time)
+		e.LocalStartAt = start.Time.In(Location(e.Timezone)).Format(time.RFC3339)
+	}
+	if completed.Valid {
+		e.CompletedAt = timestamppb.New(completed.Time)
+	}
+	if cancelled.Valid {
+		e.CancelledAt = timestamppb.New(cancelled.Time)
+	}
+	if trackStarted.Valid {
+		e.CurrentTrackStartedAt = timestamppb.New(trackStarted.Time)
+	}
+	if deleted.Valid {
+		e.DeletedAt = timestamppb.New(deleted.Time)
+	}
+	e.Coordinates = GeoPointFromNull(lat, lon)
+	return &e, nil
+}
+
+func LoadEventDetails(ctx context.Context, db *sql.DB, eventID, currentUserID string) (*proto.EventDetails, error) {
+	e, err := ScanEvent(db.QueryRowContext(ctx, `SELECT `+EventColumns+` FROM `+EventFrom+` WHERE e.id = $1`, eventID))
+	if err != nil {
+		return nil, err
 	}
 
 	tracklist, err := LoadTracklist(ctx, db, eventID)
@@ -261,40 +607,159 @@ func LoadEventDetails(ctx context.Context, db *sql.DB, eventID, currentUserID st
 		return nil, err
 	}
 
-	return &proto.EventDetails{
-		Event:        &e,
+	details := &proto.EventDetails{
+		Event:        e,
 		Tracklist:    tracklist,
 		Participants: participants,
 		Permissions:  perms,
-	}, nil
+	}
+	if e.GetStartAt() != nil {
+		details.IcsUrl = EventICSURL(ctx, e.GetId())
+	}
+	if err := LoadEventRsvps(ctx, db, details, currentUserID); err != nil {
+		return nil, err
+	}
+	if details.Rehearsals, err = LoadEventRehearsals(ctx, db, e.GetId(), currentUserID); err != nil {
+		return nil, err
+	}
+	if err := LoadEventAttendance(ctx, db, details, currentUserID); err != nil {
+		return nil, err
+	}
+	if e.GetVenueId() != "" {
+		if details.Venue, err = LoadVenue(ctx, db, e.GetVenueId()); err != nil {
+			return nil, err
+		}
+	}
+	details.DirectionsUrl = DirectionsURL(e, details.Venue)
+	if details.Lineups, err = LoadEventLineups(ctx, db, eventID, e.GetCompletedAt() != nil); err != nil {
+		return nil, err
+	}
+	tracklist.Warnings = TracklistWarnings(tracklist.GetItems(), details.Lineups)
+	themeWarnings, err := ThemeWarnings(ctx, db, tracklist.GetItems(), e.GetThemeTag())
+	if err != nil {
+		return nil, err
+	}
+	tracklist.Warnings = append(tracklist.Warnings, themeWarnings...)
+	if details.FeedbackSurvey, err = LoadFeedbackSurvey(ctx, db, eventID, currentUserID); err != nil {
+		return nil, err
+	}
+	if details.Equipment, err = LoadEventEquipment(ctx, db, eventID); err != nil {
+		return nil, err
+	}
+	if details.Rides, err = LoadEventRides(ctx, db, eventID); err != nil {
+		return nil, err
+	}
+	if details.Owners, err = LoadEventOwners(ctx, db, eventID); err != nil {
+		return nil, err
+	}
+	details.CanEdit = PermissionAllowsEventEdit(perms) || slices.ContainsFunc(details.Owners, func(u *proto.User) bool {
+		return u.GetId() == currentUserID
+	})
+	return details, nil
 }
 
+// LoadTracklist loads the items in play order, grouped into sets, with their
+// running time and scheduled start; items ending after the event's time slot
+// are flagged.
 func LoadTracklist(ctx context.Context, db *sql.DB, eventID string) (*proto.Tracklist, error) {
+	var slotMinutes, gapSeconds int32
+	var clock sql.NullTime
+	if err := db.QueryRowContext(ctx, `
+		SELECT time_slot_minutes, track_gap_seconds, start_at FROM event WHERE id = $1
+	`, eventID).Scan(&slotMinutes, &gapSeconds, &clock); err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	tracklist := &proto.Tracklist{}
+	setRows, err := db.QueryContext(ctx, `
+		SELECT id, name FROM event_track_set WHERE event_id = $1 ORDER BY position
+	`, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer setRows.Close()
+	sets := map[string]*proto.TrackSet{}
+	for setRows.Next() {
+		set := &proto.TrackSet{}
+		if err := setRows.Scan(&set.Id, &set.Name); err != nil {
+			return nil, err
+		}
+		sets[set.Id] = set
+		tracklist.Sets = append(tracklist.Sets, set)
+	}
+	if err := setRows.Err(); err != nil {
+		return nil, err
+	}
+
 	rows, err := db.QueryContext(ctx, `
-		SELECT position, COALESCE(song_id, ''), COALESCE(custom_title, ''), COALESCE(custom_artist, '')
-		FROM event_track_item
-		WHERE event_id = $1
-		ORDER BY position
+		SELECT ti.id, ti.position, COALESCE(ti.song_id::text, ''), COALESCE(ti.custom_title, ''), COALESCE(ti.custom_artist, ''),
+		       COALESCE(ti.duration_sec, 0), COALESCE(ti.duration_sec, s.duration_sec, 0), COALESCE(ti.set_id::text, ''),
+		       ti.performed, ti.rehearsal_status, COALESCE(ti.notes, ''), COALESCE(ti.musical_key, ''), ti.planned_start_at
+		FROM event_track_item ti
+		LEFT JOIN song s ON s.id = ti.song_id
+		LEFT JOIN event_track_set ts ON ts.id = ti.set_id
+		WHERE ti.event_id = $1
+		ORDER BY ts.position NULLS FIRST, ti.position
 	`, eventID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []*proto.TrackItem
+	var loose *proto.TrackSet
 	for rows.Next() {
 		var pos int32
-		var songID, customTitle, customArtist string
-		if err := rows.Scan(&pos, &songID, &customTitle, &customArtist); err != nil {
+		var id, songID, customTitle, customArtist, setID, rehearsalStatus, notes, key string
+		var duration, effective uint32
+		var performed bool
+		var planned sql.NullTime
+		if err := rows.Scan(&id, &pos, &songID, &customTitle, &customArtist, &duration, &effective, &setID, &performed,
+			&rehearsalStatus, &notes, &key, &planned); err != nil {
 			return nil, err
 		}
-		items = append(items, &proto.TrackItem{
-			Order:        uint32(pos),
-			SongId:       songID,
-			CustomTitle:  customTitle,
-			CustomArtist: customArtist,
-		})
+		tracklist.TotalSeconds += effective
+		item := &proto.TrackItem{
+			Id:                       id,
+			Order:                    uint32(pos),
+			SongId:                   songID,
+			CustomTitle:              customTitle,
+			CustomArtist:             customArtist,
+			DurationSeconds:          duration,
+			EffectiveDurationSeconds: effective,
+			EndsAtSeconds:            tracklist.TotalSeconds,
+			ExceedsTimeSlot:          slotMinutes > 0 && tracklist.TotalSeconds > uint32(slotMinutes)*60,
+			SetId:                    setID,
+			Performed:                performed,
+			RehearsalStatus:          MapTrackRehearsalStatus(rehearsalStatus),
+			Notes:                    notes,
+			Key:                      key,
+		}
+		// A fixed start resets the running clock for the items after it.
+		if planned.Valid {
+			item.PlannedStartAt = timestamppb.New(planned.Time)
+			clock = planned
+		}
+		if clock.Valid {
+			item.ScheduledStartAt = timestamppb.New(clock.Time)
+			clock.Time = clock.Time.Add(time.Duration(int64(effective)+int64(gapSeconds)) * time.Second)
+		}
+		tracklist.Items = append(tracklist.Items, item)
+
+		set := sets[setID]
+		if set == nil && len(sets) > 0 {
+			if loose == nil {
+				loose = &proto.TrackSet{}
+			}
+			set = loose
+		}
+		if set != nil {
+			set.Items = append(set.Items, item)
+			set.TotalSeconds += effective
+		}
+	}
+	if loose != nil {
+		tracklist.Sets = append([]*proto.TrackSet{loose}, tracklist.Sets...)
 	}
-	return &proto.Tracklist{Items: items}, rows.Err()
+	return tracklist, rows.Err()
 }
 
 func LoadEventParticipants(ctx context.Context, db *sql.DB, eventID string) ([]*proto.RoleAssignment, error) {
@@ -332,18 +797,120 @@ func LoadEventParticipants(ctx context.Context, db *sql.DB, eventID string) ([]*
 	return items, rows.Err()
 }
 
+// ReplaceTracklist makes the event's tracklist match the given one. Items
+// that carry the id of an existing item are updated in place, so everything
+// linked to them survives; the rest is deleted and inserted anew. When the
+// tracklist has sets, they replace the stored sets and their items the
+// stored items; otherwise existing items stay in their sets.
 func ReplaceTracklist(ctx context.Context, tx *sql.Tx, eventID string, tracklist *proto.Tracklist) error {
-	if _, err := tx.ExecContext(ctx, `DELETE FROM event_track_item WHERE event_id = $1`, eventID); err != nil {
+	if len(tracklist.GetSets()) == 0 {
+		return replaceTrackItems(ctx, tx, eventID, tracklist.GetItems(), nil)
+	}
+
+	keepSets := []string{}
+	for _, set := range tracklist.GetSets() {
+		if _, err := uuid.Parse(set.GetId()); err == nil {
+			keepSets = append(keepSets, set.GetId())
+		}
+	}
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM event_track_set WHERE event_id = $1 AND NOT (id = ANY($2::uuid[]))
+	`, eventID, pq.Array(keepSets)); err != nil {
 		return err
 	}
-	if tracklist == nil {
-		return nil
+
+	var items []*proto.TrackItem
+	var itemSets []string
+	for i, set := range tracklist.GetSets() {
+		name := strings.TrimSpace(set.GetName())
+		setID := ""
+		if set.GetId() != "" {
+			err := tx.QueryRowContext(ctx, `
+				UPDATE event_track_set SET position = $3, name = $4
+				WHERE event_id = $1 AND id::text = $2
+				RETURNING id
+			`, eventID, set.GetId(), i+1, name).Scan(&setID)
+			if err != nil && err != sql.ErrNoRows {
+				return err
+			}
+		}
+		// A set without id and name holds the items outside any set.
+		if setID == "" && (set.GetId() != "" || name != "") {
+			if err := tx.QueryRowContext(ctx, `
+				INSERT INTO event_track_set (event_id, position, name) VALUES ($1, $2, $3)
+				RETURNING id
+			`, eventID, i+1, name).Scan(&setID); err != nil {
+				return err
+			}
+		}
+		for _, item := range set.GetItems() {
+			items = append(items, &proto.TrackItem{
+				Id:              item.GetId(),
+				Order:           uint32(len(items) + 1),
+				SongId:          item.GetSongId(),
+				CustomTitle:     item.GetCustomTitle(),
+				CustomArtist:    item.GetCustomArtist(),
+				DurationSeconds: item.GetDurationSeconds(),
+				Performed:       item.GetPerformed(),
+				Notes:           item.GetNotes(),
+				Key:             item.GetKey(),
+				PlannedStartAt:  item.GetPlannedStartAt(),
+			})
+			itemSets = append(itemSets, setID)
+		}
 	}
-	for _, item := range tracklist.Items {
+	return replaceTrackItems(ctx, tx, eventID, items, itemSets)
+}
+
+// replaceTrackItems stores items at their order. With setIDs (one per item)
+// the items are moved to those sets; without, existing items keep theirs.
+func replaceTrackItems(ctx context.Context, tx *sql.Tx, eventID string, items []*proto.TrackItem, setIDs []string) error {
+	keep := []string{}
+	for _, item := range items {
+		if _, err := uuid.Parse(item.GetId()); err == nil {
+			keep = append(keep, item.GetId())
+		}
+	}
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM event_track_item WHERE event_id = $1 AND NOT (id = ANY($2::uuid[]))
+	`, eventID, pq.Array(keep)); err != nil {
+		return err
+	}
+	// Park kept items on negative positions so reordering can't collide.
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE event_track_item SET position = -position - 1 WHERE event_id = $1
+	`, eventID); err != nil {
+		return err
+	}
+	for i, item := range items {
+		setID := ""
+		if setIDs != nil {
+			setID = setIDs[i]
+		}
+		if item.GetId() != "" {
+			res, err := tx.ExecContext(ctx, `
+				UPDATE event_track_item
+				SET position = $3, song_id = NULLIF($4, '')::uuid, custom_title = NULLIF($5, ''), custom_artist = NULLIF($6, ''),
+				    duration_sec = NULLIF($7, 0), set_id = CASE WHEN $8 THEN NULLIF($9, '')::uuid ELSE set_id END, performed = $10,
+				    rehearsal_status = CASE WHEN song_id IS DISTINCT FROM NULLIF($4, '')::uuid THEN 'not_started' ELSE rehearsal_status END,
+				    notes = NULLIF($11, ''), musical_key = NULLIF($12, ''), planned_start_at = $13
+				WHERE event_id = $1 AND id::text = $2
+			`, eventID, item.GetId(), item.GetOrder(), item.GetSongId(), item.GetCustomTitle(), item.GetCustomArtist(), item.GetDurationSeconds(),
+				setIDs != nil, setID, item.GetPerformed(), strings.TrimSpace(item.GetNotes()), strings.TrimSpace(item.GetKey()),
+				PlannedStart(item))
+			if err != nil {
+				return err
+			}
+			if n, _ := res.RowsAffected(); n > 0 {
+				continue
+			}
+		}
 		if _, err := tx.ExecContext(ctx, `
-			INSERT INTO event_track_item (event_id, position, song_id, custom_title, custom_artist)
-			VALUES ($1, $2, NULLIF($3, ''), NULLIF($4, ''), NULLIF($5, ''))
-		`, eventID, item.GetOrder(), item.GetSongId(), item.GetCustomTitle(), item.GetCustomArtist()); err != nil {
+			INSERT INTO event_track_item (event_id, position, song_id, custom_title, custom_artist, duration_sec, set_id, performed, notes, musical_key,
+			                              planned_start_at)
+			VALUES ($1, $2, NULLIF($3, '')::uuid, NULLIF($4, ''), NULLIF($5, ''), NULLIF($6, 0), NULLIF($7, '')::uuid, $8, NULLIF($9, ''), NULLIF($10, ''), $11)
+		`, eventID, item.GetOrder(), item.GetSongId(), item.GetCustomTitle(), item.GetCustomArtist(), item.GetDurationSeconds(), setID,
+			item.GetPerformed(), strings.TrimSpace(item.GetNotes()), strings.TrimSpace(item.GetKey()), PlannedStart(item)); err != nil {
 			return err
 		}
 	}
@@ -384,36 +951,11 @@ func GetUserPermissions(
 		return nil, fmt.Errorf("unsupported db type %T", db)
 	}
 
-	permissions := &proto.PermissionSet{
-		Join:   &proto.JoinPermissions{},
-		Songs:  &proto.SongPermissions{},
-		Events: &proto.EventPermissions{},
-	}
-
-	err := q.QueryRowContext(ctx, `
-		SELECT
-			edit_own_participation,
-			edit_any_participation,
-			edit_own_songs,
-			edit_any_songs,
-			edit_events,
-			edit_tracklists
-		FROM user_permissions
-		WHERE user_id = $1
-	`, userID).Scan(
-		&permissions.Join.EditOwnParticipation,
-		&permissions.Join.EditAnyParticipation,
-		&permissions.Songs.EditOwnSongs,
-		&permissions.Songs.EditAnySongs,
-		&permissions.Events.EditEvents,
-		&permissions.Events.EditTracklists,
-	)
-
+	permissions, err := LoadPermissionFlags(ctx, q, "effective_permissions", userID.String())
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			// user has no explicit permissions → return defaults
-			return permissions, nil
-		}
+		return nil, err
+	}
+	if permissions.Roles, err = LoadUserRoles(ctx, q, userID.String()); err != nil {
 		return nil, err
 	}
 
@@ -425,4 +967,7 @@ var PublicMethods = map[string]bool{
 	"/musicclub.auth.AuthService/Register":           true,
 	"/musicclub.auth.AuthService/Refresh":            true,
 	"/musicclub.auth.AuthService/TelegramWebAppAuth": true,
+	"/grpc.health.v1.Health/Check":                   true,
+	"/grpc.health.v1.Health/List":                    true,
+	"/grpc.health.v1.Health/Watch":                   true,
 }
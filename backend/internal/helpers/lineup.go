@@ -0,0 +1,152 @@
+package helpers
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"musicclubbot/backend/proto"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// LoadEventLineups returns who plays each tracklist item, in tracklist order.
+// Completed events read the snapshot taken at completion, others the current
+// song role assignments.
+func LoadEventLineups(ctx context.Context, db *sql.DB, eventID string, completed bool) ([]*proto.TrackLineup, error) {
+	query := `
+		SELECT ti.id, a.role, au.id, au.display_name, COALESCE(au.username, ''), COALESCE(au.avatar_url, ''), a.joined_at
+		FROM event_track_item ti
+		JOIN song_role_assignment a ON a.song_id = ti.song_id
+		JOIN app_user au ON au.id = a.user_id
+		WHERE ti.event_id = $1
+		ORDER BY ti.position, a.role, a.joined_at`
+	if completed {
+		query = `
+			SELECT ti.id, ls.role, au.id, au.display_name, COALESCE(au.username, ''), COALESCE(au.avatar_url, ''), ls.joined_at
+			FROM event_lineup_snapshot ls
+			JOIN event_track_item ti ON ti.id = ls.track_item_id
+			JOIN app_user au ON au.id = ls.user_id
+			WHERE ls.event_id = $1
+			ORDER BY ti.position, ls.role, ls.joined_at`
+	}
+	rows, err := db.QueryContext(ctx, query, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var lineups []*proto.TrackLineup
+	for rows.Next() {
+		var itemID, role string
+		var u proto.User
+		var joined time.Time
+		if err := rows.Scan(&itemID, &role, &u.Id, &u.DisplayName, &u.Username, &u.AvatarUrl, &joined); err != nil {
+			return nil, err
+		}
+		if len(lineups) == 0 || lineups[len(lineups)-1].TrackItemId != itemID {
+			lineups = append(lineups, &proto.TrackLineup{TrackItemId: itemID})
+		}
+		last := lineups[len(lineups)-1]
+		last.Assignments = append(last.Assignments, &proto.RoleAssignment{
+			Role:     role,
+			User:     &u,
+			JoinedAt: timestamppb.New(joined),
+		})
+	}
+	return lineups, rows.Err()
+}
+
+// SnapshotEventLineups freezes the current song role assignments of every
+// tracklist item of the event.
+func SnapshotEventLineups(ctx context.Context, tx *sql.Tx, eventID string) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO event_lineup_snapshot (event_id, track_item_id, role, user_id, joined_at)
+		SELECT ti.event_id, ti.id, a.role, a.user_id, a.joined_at
+		FROM event_track_item ti
+		JOIN song_role_assignment a ON a.song_id = ti.song_id
+		WHERE ti.event_id = $1
+		ON CONFLICT DO NOTHING
+	`, eventID)
+	return err
+}
+
+// TracklistWarnings flags members switching instruments between back-to-back
+// items and members booked in items whose scheduled times overlap.
+func TracklistWarnings(items []*proto.TrackItem, lineups []*proto.TrackLineup) []*proto.TracklistWarning {
+	type member struct {
+		user  *proto.User
+		roles []string
+	}
+	// Members per item in lineup order, so warnings come out stable.
+	members := map[string][]*member{}
+	for _, lineup := range lineups {
+		var list []*member
+		for _, a := range lineup.GetAssignments() {
+			idx := slices.IndexFunc(list, func(m *member) bool { return m.user.GetId() == a.GetUser().GetId() })
+			if idx < 0 {
+				list = append(list, &member{user: a.GetUser()})
+				idx = len(list) - 1
+			}
+			list[idx].roles = append(list[idx].roles, a.GetRole())
+		}
+		members[lineup.GetTrackItemId()] = list
+	}
+	shared := func(a, b *proto.TrackItem, visit func(ma, mb *member)) {
+		for _, mb := range members[b.GetId()] {
+			for _, ma := range members[a.GetId()] {
+				if ma.user.GetId() == mb.user.GetId() {
+					visit(ma, mb)
+				}
+			}
+		}
+	}
+	warning := func(kind proto.TracklistWarningKind, a, b *proto.TrackItem, ma, mb *member) *proto.TracklistWarning {
+		ra, rb := strings.Join(ma.roles, ", "), strings.Join(mb.roles, ", ")
+		return &proto.TracklistWarning{
+			Kind:         kind,
+			User:         ma.user,
+			TrackItemIds: []string{a.GetId(), b.GetId()},
+			Roles:        []string{ra, rb},
+			Message:      fmt.Sprintf("%s: %s в №%d, %s в №%d", ma.user.GetDisplayName(), ra, a.GetOrder(), rb, b.GetOrder()),
+		}
+	}
+
+	var out []*proto.TracklistWarning
+	for i := 1; i < len(items); i++ {
+		a, b := items[i-1], items[i]
+		shared(a, b, func(ma, mb *member) {
+			for _, r := range mb.roles {
+				if slices.Contains(ma.roles, r) {
+					return
+				}
+			}
+			out = append(out, warning(proto.TracklistWarningKind_TRACKLIST_WARNING_KIND_INSTRUMENT_CHANGE, a, b, ma, mb))
+		})
+	}
+	// Items without a start or a length have no time window to overlap.
+	window := func(item *proto.TrackItem) (time.Time, time.Time, bool) {
+		if item.GetScheduledStartAt() == nil || item.GetEffectiveDurationSeconds() == 0 {
+			return time.Time{}, time.Time{}, false
+		}
+		start := item.GetScheduledStartAt().AsTime()
+		return start, start.Add(time.Duration(item.GetEffectiveDurationSeconds()) * time.Second), true
+	}
+	for i, a := range items {
+		startA, endA, ok := window(a)
+		if !ok {
+			continue
+		}
+		for _, b := range items[i+1:] {
+			startB, endB, ok := window(b)
+			if !ok || !startB.Before(endA) || !startA.Before(endB) {
+				continue
+			}
+			shared(a, b, func(ma, mb *member) {
+				out = append(out, warning(proto.TracklistWarningKind_TRACKLIST_WARNING_KIND_OVERLAP, a, b, ma, mb))
+			})
+		}
+	}
+	return out
+}
@@ -0,0 +1,40 @@
+package helpers
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/proto"
+)
+
+// LoadEventOwners returns the event's organizers, earliest first.
+func LoadEventOwners(ctx context.Context, db *sql.DB, eventID string) ([]*proto.User, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT au.id, au.display_name, COALESCE(au.username, ''), COALESCE(au.avatar_url, '')
+		FROM event_owner o
+		JOIN app_user au ON au.id = o.user_id
+		WHERE o.event_id = $1
+		ORDER BY o.added_at, au.display_name
+	`, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var owners []*proto.User
+	for rows.Next() {
+		var u proto.User
+		if err := rows.Scan(&u.Id, &u.DisplayName, &u.Username, &u.AvatarUrl); err != nil {
+			return nil, err
+		}
+		owners = append(owners, &u)
+	}
+	return owners, rows.Err()
+}
+
+// IsEventOwner reports whether the user organizes the event.
+func IsEventOwner(ctx context.Context, db *sql.DB, eventID, userID string) (bool, error) {
+	var owner bool
+	err := db.QueryRowContext(ctx, `
+		SELECT EXISTS (SELECT 1 FROM event_owner WHERE event_id::text = $1 AND user_id::text = $2)
+	`, eventID, userID).Scan(&owner)
+	return owner, err
+}
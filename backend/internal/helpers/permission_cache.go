@@ -0,0 +1,101 @@
+package helpers
+
+import (
+	"context"
+	"musicclubbot/backend/proto"
+	"sync"
+	"time"
+
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// permissionCacheTTL bounds how stale permissions can get on instances that
+// didn't see the update themselves.
+const permissionCacheTTL = 30 * time.Second
+
+type cachedPermissions struct {
+	perms   *proto.PermissionSet
+	expires time.Time
+}
+
+// permissionCache is shared by all requests of this process.
+var permissionCache = struct {
+	sync.Mutex
+	m map[string]cachedPermissions
+}{m: map[string]cachedPermissions{}}
+
+// requestPermissions memoizes permissions for a single request.
+type requestPermissions struct {
+	sync.Mutex
+	m map[string]*proto.PermissionSet
+}
+
+// WithPermissionCache prepares ctx so LoadPermissions hits the database at
+// most once per user for the rest of the request.
+func WithPermissionCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, "permissions", &requestPermissions{m: map[string]*proto.PermissionSet{}})
+}
+
+func cachedPermissionsFor(ctx context.Context, userID string) *proto.PermissionSet {
+	if memo, ok := ctx.Value("permissions").(*requestPermissions); ok {
+		memo.Lock()
+		p := memo.m[userID]
+		memo.Unlock()
+		if p != nil {
+			return p
+		}
+	}
+	permissionCache.Lock()
+	c, ok := permissionCache.m[userID]
+	permissionCache.Unlock()
+	if !ok || time.Now().After(c.expires) {
+		return nil
+	}
+	rememberPermissions(ctx, userID, c.perms, false)
+	return c.perms
+}
+
+func rememberPermissions(ctx context.Context, userID string, p *proto.PermissionSet, shared bool) {
+	if memo, ok := ctx.Value("permissions").(*requestPermissions); ok {
+		memo.Lock()
+		memo.m[userID] = p
+		memo.Unlock()
+	}
+	if shared {
+		permissionCache.Lock()
+		permissionCache.m[userID] = cachedPermissions{perms: p, expires: time.Now().Add(permissionCacheTTL)}
+		permissionCache.Unlock()
+	}
+}
+
+// InvalidatePermissions drops cached permissions of the given users, or of
+// everyone when none are given (e.g. after a role changed). Call it after
+// the change is committed.
+func InvalidatePermissions(ctx context.Context, userIDs ...string) {
+	memo, _ := ctx.Value("permissions").(*requestPermissions)
+	if memo != nil {
+		memo.Lock()
+	}
+	permissionCache.Lock()
+	if len(userIDs) == 0 {
+		clear(permissionCache.m)
+		if memo != nil {
+			clear(memo.m)
+		}
+	}
+	for _, id := range userIDs {
+		delete(permissionCache.m, id)
+		if memo != nil {
+			delete(memo.m, id)
+		}
+	}
+	permissionCache.Unlock()
+	if memo != nil {
+		memo.Unlock()
+	}
+}
+
+// clonePermissions keeps callers from modifying a cached set in place.
+func clonePermissions(p *proto.PermissionSet) *proto.PermissionSet {
+	return protobuf.Clone(p).(*proto.PermissionSet)
+}
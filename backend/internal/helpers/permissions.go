@@ -0,0 +1,98 @@
+package helpers
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/proto"
+	"slices"
+	"strings"
+)
+
+// PermissionNames lists the permission flags as named in user_permissions,
+// role and effective_permissions.
+var PermissionNames = []string{
+	"edit_own_participation",
+	"edit_any_participation",
+	"edit_own_songs",
+	"edit_any_songs",
+	"edit_events",
+	"edit_tracklists",
+	"create_events",
+	"manage_permissions",
+}
+
+// NewPermissionSet returns a set with everything denied and all groups
+// present, so flags can be set through PermissionFlag.
+func NewPermissionSet() *proto.PermissionSet {
+	return &proto.PermissionSet{
+		Join:   &proto.JoinPermissions{},
+		Songs:  &proto.SongPermissions{},
+		Events: &proto.EventPermissions{},
+		Admin:  &proto.AdminPermissions{},
+	}
+}
+
+// PermissionFlag points at the field of a set made by NewPermissionSet that
+// holds the named permission; nil for unknown names.
+func PermissionFlag(p *proto.PermissionSet, name string) *bool {
+	switch name {
+	case "edit_own_participation":
+		return &p.Join.EditOwnParticipation
+	case "edit_any_participation":
+		return &p.Join.EditAnyParticipation
+	case "edit_own_songs":
+		return &p.Songs.EditOwnSongs
+	case "edit_any_songs":
+		return &p.Songs.EditAnySongs
+	case "edit_events":
+		return &p.Events.EditEvents
+	case "edit_tracklists":
+		return &p.Events.EditTracklists
+	case "create_events":
+		return &p.Events.CreateEvents
+	case "manage_permissions":
+		return &p.Admin.ManagePermissions
+	}
+	return nil
+}
+
+// PermissionSetNames returns the names of the permissions the set allows, in
+// PermissionNames order.
+func PermissionSetNames(p *proto.PermissionSet) []string {
+	full := NewPermissionSet()
+	full.Join.EditOwnParticipation = p.GetJoin().GetEditOwnParticipation()
+	full.Join.EditAnyParticipation = p.GetJoin().GetEditAnyParticipation()
+	full.Songs.EditOwnSongs = p.GetSongs().GetEditOwnSongs()
+	full.Songs.EditAnySongs = p.GetSongs().GetEditAnySongs()
+	full.Events.EditEvents = p.GetEvents().GetEditEvents()
+	full.Events.EditTracklists = p.GetEvents().GetEditTracklists()
+	full.Events.CreateEvents = p.GetEvents().GetCreateEvents()
+	full.Admin.ManagePermissions = p.GetAdmin().GetManagePermissions()
+	var names []string
+	for _, name := range PermissionNames {
+		if *PermissionFlag(full, name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// LoadPermissionFlags reads the user's row of a permissions table or view
+// (user_permissions, effective_permissions); no row denies everything.
+func LoadPermissionFlags(ctx context.Context, q QueryRower, table, userID string) (*proto.PermissionSet, error) {
+	p := NewPermissionSet()
+	dest := make([]any, len(PermissionNames))
+	for i, name := range PermissionNames {
+		dest[i] = PermissionFlag(p, name)
+	}
+	err := q.QueryRowContext(ctx, `SELECT `+strings.Join(PermissionNames, ", ")+` FROM `+table+` WHERE user_id::text = $1`, userID).Scan(dest...)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	return p, nil
+}
+
+// ValidPermissionName reports whether name is one of PermissionNames.
+func ValidPermissionName(name string) bool {
+	return slices.Contains(PermissionNames, name)
+}
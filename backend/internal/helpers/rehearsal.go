@@ -0,0 +1,85 @@
+package helpers
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/proto"
+	"time"
+
+	"github.com/lib/pq"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// LoadEventRehearsals loads rehearsals of an event with their tracks and
+// attendance, soonest first.
+func LoadEventRehearsals(ctx context.Context, db *sql.DB, eventID, currentUserID string) ([]*proto.Rehearsal, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT r.id, r.event_id, r.start_at, r.end_at, COALESCE(r.location, ''), COALESCE(r.notes, ''),
+		       r.notify_day_before, r.notify_hour_before,
+		       ARRAY(SELECT rt.track_item_id::text
+		             FROM rehearsal_track rt JOIN event_track_item ti ON ti.id = rt.track_item_id
+		             WHERE rt.rehearsal_id = r.id ORDER BY ti.position)
+		FROM rehearsal r
+		WHERE r.event_id = $1
+		ORDER BY r.start_at
+	`, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var rehearsals []*proto.Rehearsal
+	byID := map[string]*proto.Rehearsal{}
+	for rows.Next() {
+		var r proto.Rehearsal
+		var start time.Time
+		var end sql.NullTime
+		if err := rows.Scan(&r.Id, &r.EventId, &start, &end, &r.Location, &r.Notes,
+			&r.NotifyDayBefore, &r.NotifyHourBefore, pq.Array(&r.TrackItemIds)); err != nil {
+			return nil, err
+		}
+		r.StartAt = timestamppb.New(start)
+		if end.Valid {
+			r.EndAt = timestamppb.New(end.Time)
+		}
+		rehearsals = append(rehearsals, &r)
+		byID[r.Id] = &r
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(rehearsals) == 0 {
+		return nil, nil
+	}
+
+	attendanceRows, err := db.QueryContext(ctx, `
+		SELECT a.rehearsal_id, a.status, a.updated_at,
+		       au.id, au.display_name, COALESCE(au.username, ''), COALESCE(au.avatar_url, '')
+		FROM rehearsal_attendance a
+		JOIN rehearsal r ON r.id = a.rehearsal_id
+		JOIN app_user au ON au.id = a.user_id
+		WHERE r.event_id = $1
+		ORDER BY a.updated_at DESC
+	`, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer attendanceRows.Close()
+	for attendanceRows.Next() {
+		var rehearsalID, st string
+		var updated time.Time
+		var u proto.User
+		if err := attendanceRows.Scan(&rehearsalID, &st, &updated, &u.Id, &u.DisplayName, &u.Username, &u.AvatarUrl); err != nil {
+			return nil, err
+		}
+		r, ok := byID[rehearsalID]
+		if !ok {
+			continue
+		}
+		status := MapRsvpStatus(st)
+		if u.Id == currentUserID {
+			r.MyAttendance = status
+		}
+		r.Attendance = append(r.Attendance, &proto.Rsvp{User: &u, Status: status, UpdatedAt: timestamppb.New(updated)})
+	}
+	return rehearsals, attendanceRows.Err()
+}
@@ -0,0 +1,101 @@
+package helpers
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func MapRideKind(dbValue string) proto.RideKind {
+	switch dbValue {
+	case "offer":
+		return proto.RideKind_RIDE_KIND_OFFER
+	case "request":
+		return proto.RideKind_RIDE_KIND_REQUEST
+	default:
+		return proto.RideKind_RIDE_KIND_UNSPECIFIED
+	}
+}
+
+// MapRideKindToDB returns "" for RIDE_KIND_UNSPECIFIED.
+func MapRideKindToDB(k proto.RideKind) string {
+	switch k {
+	case proto.RideKind_RIDE_KIND_OFFER:
+		return "offer"
+	case proto.RideKind_RIDE_KIND_REQUEST:
+		return "request"
+	default:
+		return ""
+	}
+}
+
+// LoadEventRides returns the event's ride offers and requests, oldest first,
+// with the passengers of each offer.
+func LoadEventRides(ctx context.Context, db *sql.DB, eventID string) ([]*proto.Ride, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT r.id, r.kind, r.seats, COALESCE(r.from_location, ''), r.depart_at, COALESCE(r.notes, ''),
+		       au.id, au.display_name, COALESCE(au.username, ''), COALESCE(au.avatar_url, '')
+		FROM event_ride r
+		JOIN app_user au ON au.id = r.user_id
+		WHERE r.event_id = $1
+		ORDER BY r.created_at, r.id
+	`, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var rides []*proto.Ride
+	byID := map[string]*proto.Ride{}
+	for rows.Next() {
+		var r proto.Ride
+		var kind string
+		var depart sql.NullTime
+		u := &proto.User{}
+		if err := rows.Scan(&r.Id, &kind, &r.Seats, &r.FromLocation, &depart, &r.Notes,
+			&u.Id, &u.DisplayName, &u.Username, &u.AvatarUrl); err != nil {
+			return nil, err
+		}
+		r.Kind = MapRideKind(kind)
+		r.User = u
+		if depart.Valid {
+			r.DepartAt = timestamppb.New(depart.Time)
+		}
+		if r.Kind == proto.RideKind_RIDE_KIND_OFFER {
+			r.SeatsLeft = r.Seats
+		}
+		rides = append(rides, &r)
+		byID[r.Id] = &r
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	passengerRows, err := db.QueryContext(ctx, `
+		SELECT p.ride_id, au.id, au.display_name, COALESCE(au.username, ''), COALESCE(au.avatar_url, '')
+		FROM event_ride_passenger p
+		JOIN event_ride r ON r.id = p.ride_id
+		JOIN app_user au ON au.id = p.user_id
+		WHERE r.event_id = $1
+		ORDER BY p.claimed_at
+	`, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer passengerRows.Close()
+	for passengerRows.Next() {
+		var rideID string
+		u := &proto.User{}
+		if err := passengerRows.Scan(&rideID, &u.Id, &u.DisplayName, &u.Username, &u.AvatarUrl); err != nil {
+			return nil, err
+		}
+		if r, ok := byID[rideID]; ok {
+			r.Passengers = append(r.Passengers, u)
+			if r.SeatsLeft > 0 {
+				r.SeatsLeft--
+			}
+		}
+	}
+	return rides, passengerRows.Err()
+}
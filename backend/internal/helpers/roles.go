@@ -0,0 +1,31 @@
+package helpers
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+)
+
+// QueryRower is satisfied by both *sql.DB and *sql.Tx.
+type QueryRower interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// NormalizeRole lowercases a role name and resolves known aliases ("vox",
+// "vocals") to their canonical spelling.
+func NormalizeRole(ctx context.Context, q QueryRower, role string) (string, error) {
+	role = strings.ToLower(strings.TrimSpace(role))
+	if role == "" {
+		return role, nil
+	}
+	var canonical string
+	err := q.QueryRowContext(ctx, `SELECT canonical FROM role_alias WHERE alias = $1`, role).Scan(&canonical)
+	switch err {
+	case nil:
+		return canonical, nil
+	case sql.ErrNoRows:
+		return role, nil
+	default:
+		return "", err
+	}
+}
@@ -0,0 +1,113 @@
+package helpers
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/proto"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func MapRsvpStatus(dbValue string) proto.RsvpStatus {
+	switch dbValue {
+	case "going":
+		return proto.RsvpStatus_RSVP_STATUS_GOING
+	case "maybe":
+		return proto.RsvpStatus_RSVP_STATUS_MAYBE
+	case "declined":
+		return proto.RsvpStatus_RSVP_STATUS_DECLINED
+	case "waitlisted":
+		return proto.RsvpStatus_RSVP_STATUS_WAITLISTED
+	default:
+		return proto.RsvpStatus_RSVP_STATUS_UNSPECIFIED
+	}
+}
+
+// MapRsvpStatusToDB returns "" for RSVP_STATUS_UNSPECIFIED.
+func MapRsvpStatusToDB(s proto.RsvpStatus) string {
+	switch s {
+	case proto.RsvpStatus_RSVP_STATUS_GOING:
+		return "going"
+	case proto.RsvpStatus_RSVP_STATUS_MAYBE:
+		return "maybe"
+	case proto.RsvpStatus_RSVP_STATUS_DECLINED:
+		return "declined"
+	case proto.RsvpStatus_RSVP_STATUS_WAITLISTED:
+		return "waitlisted"
+	default:
+		return ""
+	}
+}
+
+// LoadEventRsvps fills RSVP counts, the full answer list, remaining spots and
+// the current user's answer into details.
+func LoadEventRsvps(ctx context.Context, db *sql.DB, details *proto.EventDetails, currentUserID string) error {
+	rows, err := db.QueryContext(ctx, `
+		SELECT r.status, r.updated_at,
+		       au.id, au.display_name, COALESCE(au.username, ''), COALESCE(au.avatar_url, '')
+		FROM event_rsvp r
+		JOIN app_user au ON r.user_id = au.id
+		WHERE r.event_id = $1
+		ORDER BY r.updated_at DESC
+	`, details.GetEvent().GetId())
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	summary := &proto.RsvpSummary{}
+	var rsvps []*proto.Rsvp
+	for rows.Next() {
+		var st string
+		var updated time.Time
+		var u proto.User
+		if err := rows.Scan(&st, &updated, &u.Id, &u.DisplayName, &u.Username, &u.AvatarUrl); err != nil {
+			return err
+		}
+		status := MapRsvpStatus(st)
+		switch status {
+		case proto.RsvpStatus_RSVP_STATUS_GOING:
+			summary.Going++
+		case proto.RsvpStatus_RSVP_STATUS_MAYBE:
+			summary.Maybe++
+		case proto.RsvpStatus_RSVP_STATUS_DECLINED:
+			summary.Declined++
+		case proto.RsvpStatus_RSVP_STATUS_WAITLISTED:
+			summary.Waitlisted++
+		}
+		if u.Id == currentUserID {
+			details.MyRsvp = status
+		}
+		rsvps = append(rsvps, &proto.Rsvp{User: &u, Status: status, UpdatedAt: timestamppb.New(updated)})
+	}
+	details.RsvpSummary = summary
+	details.Rsvps = rsvps
+	details.SpotsLeft = -1
+	if limit := details.GetEvent().GetMaxParticipants(); limit > 0 {
+		details.SpotsLeft = max(limit-summary.Going, 0)
+	}
+	return rows.Err()
+}
+
+// PromoteWaitlist moves waitlisted RSVPs to going, oldest first, while the
+// event has free spots (or all of them if the event has no limit).
+func PromoteWaitlist(ctx context.Context, q Execer, eventID string) error {
+	_, err := q.ExecContext(ctx, `
+		UPDATE event_rsvp r
+		SET status = 'going', updated_at = NOW()
+		FROM (
+			SELECT w.user_id
+			FROM (
+				SELECT user_id, ROW_NUMBER() OVER (ORDER BY updated_at) AS pos
+				FROM event_rsvp
+				WHERE event_id = $1 AND status = 'waitlisted'
+			) w
+			JOIN event e ON e.id = $1
+			WHERE e.max_participants = 0
+			   OR w.pos <= e.max_participants - (SELECT COUNT(*) FROM event_rsvp WHERE event_id = $1 AND status = 'going')
+		) p
+		WHERE r.event_id = $1 AND r.user_id = p.user_id
+	`, eventID)
+	return err
+}
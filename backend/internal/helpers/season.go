@@ -0,0 +1,31 @@
+package helpers
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/proto"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SeasonColumns selects everything ScanSeason expects from "season s".
+const SeasonColumns = `s.id, s.name, s.starts_at, s.ends_at, (SELECT COUNT(*) FROM event WHERE season_id = s.id AND deleted_at IS NULL)`
+
+func ScanSeason(row interface{ Scan(...any) error }) (*proto.Season, error) {
+	var s proto.Season
+	var starts, ends sql.NullTime
+	if err := row.Scan(&s.Id, &s.Name, &starts, &ends, &s.EventCount); err != nil {
+		return nil, err
+	}
+	if starts.Valid {
+		s.StartsAt = timestamppb.New(starts.Time)
+	}
+	if ends.Valid {
+		s.EndsAt = timestamppb.New(ends.Time)
+	}
+	return &s, nil
+}
+
+func LoadSeason(ctx context.Context, db *sql.DB, seasonID string) (*proto.Season, error) {
+	return ScanSeason(db.QueryRowContext(ctx, `SELECT `+SeasonColumns+` FROM season s WHERE s.id = $1`, seasonID))
+}
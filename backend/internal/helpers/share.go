@@ -0,0 +1,54 @@
+package helpers
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"musicclubbot/backend/internal/config"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EventShareToken signs an event id with an expiry time for the public
+// event page. Like the calendar feed it is derived from the JWT secret, so
+// rotating the secret revokes every link.
+func EventShareToken(secret []byte, eventID string, expires time.Time) string {
+	payload := eventID + "." + strconv.FormatInt(expires.Unix(), 36)
+	return payload + "." + shareSignature(secret, payload)
+}
+
+// ParseEventShareToken returns the event id of a valid token that has not
+// expired by now.
+func ParseEventShareToken(secret []byte, token string, now time.Time) (string, bool) {
+	i := strings.LastIndexByte(token, '.')
+	if i < 0 {
+		return "", false
+	}
+	payload, sig := token[:i], token[i+1:]
+	if !hmac.Equal([]byte(shareSignature(secret, payload)), []byte(sig)) {
+		return "", false
+	}
+	eventID, exp, ok := strings.Cut(payload, ".")
+	if !ok {
+		return "", false
+	}
+	unix, err := strconv.ParseInt(exp, 36, 64)
+	if err != nil || !now.Before(time.Unix(unix, 0)) {
+		return "", false
+	}
+	return eventID, true
+}
+
+// EventShareURL returns the public page URL for a share token.
+func EventShareURL(ctx context.Context, token string) string {
+	cfg, _ := ctx.Value("cfg").(config.Config)
+	return strings.TrimRight(cfg.PublicURL, "/") + "/share/events/" + token
+}
+
+func shareSignature(secret []byte, payload string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte("event-share:" + payload))
+	return hex.EncodeToString(mac.Sum(nil)[:16])
+}
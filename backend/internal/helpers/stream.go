@@ -0,0 +1,46 @@
+package helpers
+
+import (
+	"context"
+	"musicclubbot/backend/internal/pubsub"
+
+	"google.golang.org/grpc"
+)
+
+// ServerStreamWithContext overrides the context of a wrapped server stream so
+// stream interceptors can pass values down to handlers.
+type ServerStreamWithContext struct {
+	grpc.ServerStream
+	Ctx context.Context
+}
+
+func (s *ServerStreamWithContext) Context() context.Context {
+	return s.Ctx
+}
+
+func HubFromCtx(ctx context.Context) *pubsub.Hub {
+	hub, _ := ctx.Value("hub").(*pubsub.Hub)
+	return hub
+}
+
+func SongTopic(songID string) string {
+	return "song:" + songID
+}
+
+// PublishSongChanged wakes up WatchSong streams of the song, if any.
+func PublishSongChanged(ctx context.Context, songID string) {
+	if hub := HubFromCtx(ctx); hub != nil {
+		hub.Publish(SongTopic(songID))
+	}
+}
+
+func EventTopic(eventID string) string {
+	return "event:" + eventID
+}
+
+// PublishEventChanged wakes up WatchEvent streams of the event, if any.
+func PublishEventChanged(ctx context.Context, eventID string) {
+	if hub := HubFromCtx(ctx); hub != nil {
+		hub.Publish(EventTopic(eventID))
+	}
+}
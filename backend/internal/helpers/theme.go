@@ -0,0 +1,63 @@
+package helpers
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"musicclubbot/backend/proto"
+
+	"github.com/lib/pq"
+)
+
+// ThemeWarnings flags catalog songs of the tracklist that lack the theme tag.
+// Custom items have no tags to check and pass.
+func ThemeWarnings(ctx context.Context, db *sql.DB, items []*proto.TrackItem, tag string) ([]*proto.TracklistWarning, error) {
+	if tag == "" {
+		return nil, nil
+	}
+	var songIDs []string
+	for _, item := range items {
+		if item.GetSongId() != "" {
+			songIDs = append(songIDs, item.GetSongId())
+		}
+	}
+	if len(songIDs) == 0 {
+		return nil, nil
+	}
+	rows, err := db.QueryContext(ctx, `
+		SELECT s.id, s.title, EXISTS(SELECT 1 FROM song_tag t WHERE t.song_id = s.id AND t.tag = $2)
+		FROM song s WHERE s.id = ANY($1)
+	`, pq.Array(songIDs), tag)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	offTheme := map[string]string{}
+	for rows.Next() {
+		var id, title string
+		var tagged bool
+		if err := rows.Scan(&id, &title, &tagged); err != nil {
+			return nil, err
+		}
+		if !tagged {
+			offTheme[id] = title
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var out []*proto.TracklistWarning
+	for _, item := range items {
+		title, ok := offTheme[item.GetSongId()]
+		if !ok {
+			continue
+		}
+		out = append(out, &proto.TracklistWarning{
+			Kind:         proto.TracklistWarningKind_TRACKLIST_WARNING_KIND_OFF_THEME,
+			TrackItemIds: []string{item.GetId()},
+			Message:      fmt.Sprintf("№%d «%s»: нет тега «%s»", item.GetOrder(), title, tag),
+		})
+	}
+	return out, nil
+}
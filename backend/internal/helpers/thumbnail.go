@@ -1,6 +1,10 @@
 package helpers
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"musicclubbot/backend/internal/config"
 	"regexp"
 	"strings"
 )
@@ -68,3 +72,34 @@ func NormalizeThumbnailURL(customURL, linkKind, linkURL string) string {
 	}
 	return ExtractThumbnailURL(linkKind, linkURL)
 }
+
+// ThumbnailProxyURL returns the backend URL that serves a cached, resized copy
+// of the song thumbnail. The source hash busts client caches when it changes.
+func ThumbnailProxyURL(ctx context.Context, songID, sourceURL string) string {
+	if sourceURL == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(sourceURL))
+	return thumbnailProxyPrefix(ctx) + songID + "?v=" + hex.EncodeToString(sum[:4])
+}
+
+// IsThumbnailProxyURL reports whether url points at our own thumbnail proxy,
+// which happens when clients echo a song's thumbnail back on update.
+func IsThumbnailProxyURL(ctx context.Context, url string) bool {
+	return strings.HasPrefix(strings.TrimSpace(url), thumbnailProxyPrefix(ctx))
+}
+
+// ThumbnailSourceCandidates lists URLs to try when fetching a thumbnail.
+// YouTube has no maxresdefault for many videos, so hqdefault is tried next.
+func ThumbnailSourceCandidates(sourceURL string) []string {
+	candidates := []string{sourceURL}
+	if strings.Contains(sourceURL, "/maxresdefault.jpg") {
+		candidates = append(candidates, strings.Replace(sourceURL, "/maxresdefault.jpg", "/hqdefault.jpg", 1))
+	}
+	return candidates
+}
+
+func thumbnailProxyPrefix(ctx context.Context) string {
+	cfg, _ := ctx.Value("cfg").(config.Config)
+	return strings.TrimRight(cfg.PublicURL, "/") + "/thumbnails/"
+}
@@ -0,0 +1,22 @@
+package helpers
+
+import (
+	"sync"
+	"time"
+)
+
+var locations sync.Map
+
+// Location resolves an IANA timezone name; names that don't load (stored
+// ones are validated, so this is a safety net) fall back to UTC.
+func Location(name string) *time.Location {
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location)
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return time.UTC
+	}
+	locations.Store(name, loc)
+	return loc
+}
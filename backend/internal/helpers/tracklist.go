@@ -0,0 +1,115 @@
+package helpers
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"musicclubbot/backend/proto"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+func MapTrackRehearsalStatus(dbValue string) proto.TrackRehearsalStatus {
+	switch dbValue {
+	case "not_started":
+		return proto.TrackRehearsalStatus_TRACK_REHEARSAL_STATUS_NOT_STARTED
+	case "in_progress":
+		return proto.TrackRehearsalStatus_TRACK_REHEARSAL_STATUS_IN_PROGRESS
+	case "ready":
+		return proto.TrackRehearsalStatus_TRACK_REHEARSAL_STATUS_READY
+	default:
+		return proto.TrackRehearsalStatus_TRACK_REHEARSAL_STATUS_UNSPECIFIED
+	}
+}
+
+// MapTrackRehearsalStatusToDB returns "" for TRACK_REHEARSAL_STATUS_UNSPECIFIED.
+func MapTrackRehearsalStatusToDB(s proto.TrackRehearsalStatus) string {
+	switch s {
+	case proto.TrackRehearsalStatus_TRACK_REHEARSAL_STATUS_NOT_STARTED:
+		return "not_started"
+	case proto.TrackRehearsalStatus_TRACK_REHEARSAL_STATUS_IN_PROGRESS:
+		return "in_progress"
+	case proto.TrackRehearsalStatus_TRACK_REHEARSAL_STATUS_READY:
+		return "ready"
+	default:
+		return ""
+	}
+}
+
+// TracklistSection is a titled part of a rendered tracklist; Name is empty
+// for lists that are not split into sets and for items outside of sets.
+type TracklistSection struct {
+	Name   string
+	Tracks []string
+}
+
+// LoadTracklistSections loads the tracklists of the events as
+// "Artist — Title" lines grouped into sets, keyed by event id.
+func LoadTracklistSections(ctx context.Context, db *sql.DB, eventIDs []string) (map[string][]TracklistSection, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT ti.event_id, COALESCE(ts.id::text, ''), COALESCE(ts.name, ''), ts.position,
+		       COALESCE(s.artist, ti.custom_artist, ''), COALESCE(s.title, ti.custom_title, '')
+		FROM event_track_item ti
+		LEFT JOIN song s ON s.id = ti.song_id
+		LEFT JOIN event_track_set ts ON ts.id = ti.set_id
+		WHERE ti.event_id = ANY($1)
+		ORDER BY ti.event_id, ts.position NULLS FIRST, ti.position
+	`, pq.Array(eventIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := map[string][]TracklistSection{}
+	lastSet := map[string]string{}
+	for rows.Next() {
+		var eventID, setID, setName, artist, title string
+		var setPos sql.NullInt64
+		if err := rows.Scan(&eventID, &setID, &setName, &setPos, &artist, &title); err != nil {
+			return nil, err
+		}
+		sections := out[eventID]
+		if len(sections) == 0 || lastSet[eventID] != setID {
+			name := strings.TrimSpace(setName)
+			if name == "" && setPos.Valid {
+				name = fmt.Sprintf("Сет %d", setPos.Int64)
+			}
+			sections = append(sections, TracklistSection{Name: name})
+			lastSet[eventID] = setID
+		}
+		entry := title
+		if artist != "" {
+			entry = artist + " — " + title
+		}
+		last := &sections[len(sections)-1]
+		last.Tracks = append(last.Tracks, entry)
+		out[eventID] = sections
+	}
+	return out, rows.Err()
+}
+
+// FormatTracklist renders sections as numbered lines, restarting the
+// numbering in every set. heading wraps set names (e.g. to make them bold)
+// and escape is applied to track lines and names.
+func FormatTracklist(sections []TracklistSection, heading, escape func(string) string) string {
+	var parts []string
+	for _, section := range sections {
+		var lines []string
+		if section.Name != "" {
+			lines = append(lines, heading(escape(section.Name)))
+		}
+		for i, track := range section.Tracks {
+			lines = append(lines, fmt.Sprintf("%d. %s", i+1, escape(track)))
+		}
+		parts = append(parts, strings.Join(lines, "\n"))
+	}
+	return strings.Join(parts, "\n\n")
+}
+
+// PlannedStart returns the item's fixed stage time for storing; NULL when unset.
+func PlannedStart(item *proto.TrackItem) sql.NullTime {
+	if ts := item.GetPlannedStartAt(); ts != nil {
+		return sql.NullTime{Valid: true, Time: ts.AsTime()}
+	}
+	return sql.NullTime{}
+}
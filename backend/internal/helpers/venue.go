@@ -0,0 +1,25 @@
+package helpers
+
+import (
+	"context"
+	"database/sql"
+	"musicclubbot/backend/proto"
+)
+
+// VenueColumns selects everything ScanVenue expects from "venue v".
+const VenueColumns = `v.id, v.name, COALESCE(v.address, ''), COALESCE(v.map_url, ''), COALESCE(v.notes, ''),
+	(SELECT COUNT(*) FROM event WHERE venue_id = v.id AND deleted_at IS NULL), v.latitude, v.longitude`
+
+func ScanVenue(row interface{ Scan(...any) error }) (*proto.Venue, error) {
+	var v proto.Venue
+	var lat, lon sql.NullFloat64
+	if err := row.Scan(&v.Id, &v.Name, &v.Address, &v.MapUrl, &v.Notes, &v.EventCount, &lat, &lon); err != nil {
+		return nil, err
+	}
+	v.Coordinates = GeoPointFromNull(lat, lon)
+	return &v, nil
+}
+
+func LoadVenue(ctx context.Context, db *sql.DB, venueID string) (*proto.Venue, error) {
+	return ScanVenue(db.QueryRowContext(ctx, `SELECT `+VenueColumns+` FROM venue v WHERE v.id = $1`, venueID))
+}
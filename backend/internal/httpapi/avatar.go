@@ -0,0 +1,41 @@
+package httpapi
+
+import (
+	"errors"
+	"io"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/internal/storage"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// serveAvatar streams an uploaded avatar. A new upload gets a new id, so
+// each URL always shows the same picture.
+func serveAvatar(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	avatarID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	store, err := helpers.StorageFromCtx(ctx)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	img, err := store.Open(ctx, helpers.AvatarStorageKey(avatarID.String(), helpers.AvatarSize(r.URL.Query().Get("size"))))
+	if errors.Is(err, storage.ErrNotFound) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		http.Error(w, "open avatar", http.StatusInternalServerError)
+		return
+	}
+	defer img.Close()
+
+	w.Header().Set("Content-Type", "image/jpeg")
+	w.Header().Set("Cache-Control", "public, max-age=604800, immutable")
+	_, _ = io.Copy(w, img)
+}
@@ -0,0 +1,106 @@
+package httpapi
+
+import (
+	"crypto/hmac"
+	"mime"
+	"musicclubbot/backend/internal/config"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/internal/ical"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// serveEventICS renders a single event as an .ics attachment so it can be
+// added to any calendar app. Like the share page it needs a signed token,
+// and deleted events are skipped.
+func serveEventICS(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	cfg := ctx.Value("cfg").(config.Config)
+	eventID, ok := helpers.ParseEventShareToken(cfg.JwtSecretKey, r.PathValue("token"), time.Now())
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	events, err := helpers.LoadCalendarEvents(ctx, db, []string{eventID})
+	if err != nil {
+		http.Error(w, "load event", http.StatusInternalServerError)
+		return
+	}
+	if len(events) == 0 {
+		// Deleted events and events without a date can't go into a calendar.
+		http.NotFound(w, r)
+		return
+	}
+
+	filename := helpers.CalendarFilename(events[0].Title, events[0].Start)
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+	_, _ = w.Write(ical.Render("", events))
+}
+
+// feedHistory is how far back the personal feed keeps past events.
+const feedHistory = 30 * 24 * time.Hour
+
+// serveCalendarFeed serves the personal feed of events a user takes part in
+// or plans to attend. The signature in the URL stands in for authentication
+// because calendar apps can't send tokens.
+func serveCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	cfg := ctx.Value("cfg").(config.Config)
+	userID, err := uuid.Parse(r.PathValue("user"))
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	expected := helpers.CalendarFeedSignature(cfg.JwtSecretKey, userID.String())
+	if !hmac.Equal([]byte(expected), []byte(r.PathValue("sig"))) {
+		http.NotFound(w, r)
+		return
+	}
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rows, err := db.QueryContext(ctx, `
+		SELECT e.id
+		FROM event e
+		WHERE e.start_at >= $2 AND e.deleted_at IS NULL
+		  AND (EXISTS(SELECT 1 FROM event_participant WHERE event_id = e.id AND user_id = $1)
+		       OR EXISTS(SELECT 1 FROM event_rsvp WHERE event_id = e.id AND user_id = $1 AND status IN ('going', 'maybe', 'waitlisted')))
+	`, userID, time.Now().Add(-feedHistory))
+	if err != nil {
+		http.Error(w, "load events", http.StatusInternalServerError)
+		return
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			http.Error(w, "load events", http.StatusInternalServerError)
+			return
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+
+	events, err := helpers.LoadCalendarEvents(ctx, db, ids)
+	if err != nil {
+		http.Error(w, "load events", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Cache-Control", "private, max-age=900")
+	_, _ = w.Write(ical.Render("Музыкальный клуб", events))
+}
@@ -0,0 +1,65 @@
+package httpapi
+
+import (
+	"database/sql"
+	"io"
+	"musicclubbot/backend/internal/helpers"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// serveDemo streams a demo recording. Demo ids are random UUIDs, so the URL
+// itself is the capability, which lets <audio> elements play it directly.
+func serveDemo(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	demoID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	store, err := helpers.StorageFromCtx(ctx)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var contentType, storageKey string
+	var size int64
+	var created time.Time
+	err = db.QueryRowContext(ctx, `
+		SELECT content_type, storage_key, size_bytes, created_at FROM song_demo WHERE id = $1
+	`, demoID).Scan(&contentType, &storageKey, &size, &created)
+	if err == sql.ErrNoRows {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		http.Error(w, "load demo", http.StatusInternalServerError)
+		return
+	}
+
+	audio, err := store.Open(ctx, storageKey)
+	if err != nil {
+		http.Error(w, "open demo", http.StatusInternalServerError)
+		return
+	}
+	defer audio.Close()
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Cache-Control", "private, max-age=86400")
+	// Local files support range requests, which lets players seek.
+	if rs, ok := audio.(io.ReadSeeker); ok {
+		http.ServeContent(w, r, "", created, rs)
+		return
+	}
+	w.Header().Set("Content-Length", strconv.FormatInt(size, 10))
+	_, _ = io.Copy(w, audio)
+}
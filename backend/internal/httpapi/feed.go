@@ -0,0 +1,117 @@
+package httpapi
+
+import (
+	"musicclubbot/backend/internal/atom"
+	"musicclubbot/backend/internal/config"
+	"musicclubbot/backend/internal/helpers"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// feedMaxEvents caps the public feed of upcoming events.
+	feedMaxEvents = 50
+	// feedLinkValidity is how long after the event its share link from the
+	// feed keeps working.
+	feedLinkValidity = 30 * 24 * time.Hour
+)
+
+// serveEventsFeed publishes upcoming events as an Atom feed for feed readers
+// and external sites. It only shows what share links show, so it is public.
+func serveEventsFeed(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	cfg := ctx.Value("cfg").(config.Config)
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rows, err := db.QueryContext(ctx, `
+		SELECT e.id, e.title, e.start_at, e.timezone, COALESCE(e.location, v.name, ''), e.created_at, e.updated_at,
+		       e.cancelled_at IS NOT NULL
+		FROM event e
+		LEFT JOIN venue v ON v.id = e.venue_id
+		WHERE e.start_at >= NOW() AND e.deleted_at IS NULL
+		ORDER BY e.start_at
+		LIMIT $1
+	`, feedMaxEvents)
+	if err != nil {
+		http.Error(w, "load events", http.StatusInternalServerError)
+		return
+	}
+	type upcoming struct {
+		id, title, timezone, location string
+		start, created, updated       time.Time
+		cancelled                     bool
+	}
+	var events []upcoming
+	var ids []string
+	for rows.Next() {
+		var e upcoming
+		if err := rows.Scan(&e.id, &e.title, &e.start, &e.timezone, &e.location, &e.created, &e.updated, &e.cancelled); err != nil {
+			rows.Close()
+			http.Error(w, "load events", http.StatusInternalServerError)
+			return
+		}
+		events = append(events, e)
+		ids = append(ids, e.id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		http.Error(w, "load events", http.StatusInternalServerError)
+		return
+	}
+	tracks, err := helpers.LoadTracklistSections(ctx, db, ids)
+	if err != nil {
+		http.Error(w, "load tracklists", http.StatusInternalServerError)
+		return
+	}
+
+	base := strings.TrimRight(cfg.PublicURL, "/")
+	feed := atom.Feed{
+		ID:       base + "/events/feed.atom",
+		Title:    "Музыкальный клуб: ближайшие события",
+		Link:     base,
+		SelfLink: base + "/events/feed.atom",
+	}
+	plain := func(s string) string { return s }
+	for _, e := range events {
+		title := e.title
+		if e.cancelled {
+			title = "Отменено: " + title
+		}
+		summary := helpers.FormatDateTimeRU(e.start.In(helpers.Location(e.timezone)))
+		if e.location != "" {
+			summary += "\n" + e.location
+		}
+		if sections := tracks[e.id]; len(sections) > 0 {
+			summary += "\n\nТреклист:\n" + helpers.FormatTracklist(sections, plain, plain)
+		}
+		token := helpers.EventShareToken(cfg.JwtSecretKey, e.id, e.start.Add(feedLinkValidity).Truncate(time.Second))
+		feed.Entries = append(feed.Entries, atom.Entry{
+			ID:        "urn:uuid:" + e.id,
+			Title:     title,
+			Summary:   summary,
+			Link:      helpers.EventShareURL(ctx, token),
+			Updated:   e.updated,
+			Published: e.created,
+		})
+		if e.updated.After(feed.Updated) {
+			feed.Updated = e.updated
+		}
+	}
+	if feed.Updated.IsZero() {
+		feed.Updated = time.Now()
+	}
+
+	body, err := atom.Render(feed)
+	if err != nil {
+		http.Error(w, "render feed", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/atom+xml; charset=utf-8")
+	w.Header().Set("Cache-Control", "public, max-age=900")
+	_, _ = w.Write(body)
+}
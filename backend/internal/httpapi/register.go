@@ -0,0 +1,14 @@
+package httpapi
+
+import "net/http"
+
+// Register wires plain HTTP handlers served alongside gRPC-Web.
+func Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /thumbnails/{id}", serveThumbnail)
+	mux.HandleFunc("GET /demos/{id}", serveDemo)
+	mux.HandleFunc("GET /avatars/{id}", serveAvatar)
+	mux.HandleFunc("GET /events/feed.atom", serveEventsFeed)
+	mux.HandleFunc("GET /calendar/{user}/{sig}/feed.ics", serveCalendarFeed)
+	mux.HandleFunc("GET /share/events/{token}", serveEventShare)
+	mux.HandleFunc("GET /share/events/{token}/calendar.ics", serveEventICS)
+}
@@ -0,0 +1,102 @@
+package httpapi
+
+import (
+	"database/sql"
+	"html/template"
+	"musicclubbot/backend/internal/config"
+	"musicclubbot/backend/internal/helpers"
+	"net/http"
+	"time"
+)
+
+var sharePage = template.Must(template.New("share").Parse(`<!DOCTYPE html>
+<html lang="ru">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}}</title>
+<style>
+body { font-family: system-ui, sans-serif; max-width: 40rem; margin: 2rem auto; padding: 0 1rem; line-height: 1.5; }
+.muted { color: #666; }
+.cancelled { color: #b00020; font-weight: bold; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+{{if .Cancelled}}<p class="cancelled">Отменено{{if .CancelReason}}: {{.CancelReason}}{{end}}</p>{{end}}
+{{if .When}}<p>🗓 {{.When}}</p>{{end}}
+{{if .Place}}<p>📍 {{if .MapURL}}<a href="{{.MapURL}}">{{.Place}}</a>{{else}}{{.Place}}{{end}}{{if .Address}}<br><span class="muted">{{.Address}}</span>{{end}}</p>{{end}}
+{{range .Sections}}
+{{if .Name}}<h2>{{.Name}}</h2>{{else}}<h2>Треклист</h2>{{end}}
+<ol>{{range .Tracks}}<li>{{.}}</li>{{end}}</ol>
+{{end}}
+</body>
+</html>
+`))
+
+type sharePageData struct {
+	Title        string
+	When         string
+	Place        string
+	Address      string
+	MapURL       string
+	Cancelled    bool
+	CancelReason string
+	Sections     []helpers.TracklistSection
+}
+
+// serveEventShare renders the public read-only page of an event. The signed
+// token in the URL is the capability; nothing personal is shown.
+func serveEventShare(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	cfg := ctx.Value("cfg").(config.Config)
+	eventID, ok := helpers.ParseEventShareToken(cfg.JwtSecretKey, r.PathValue("token"), time.Now())
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	e, err := helpers.ScanEvent(db.QueryRowContext(ctx, `SELECT `+helpers.EventColumns+` FROM `+helpers.EventFrom+` WHERE e.id::text = $1 AND e.deleted_at IS NULL`, eventID))
+	if err == sql.ErrNoRows {
+		// Deleted events look like any unknown link.
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		http.Error(w, "load event", http.StatusInternalServerError)
+		return
+	}
+	data := sharePageData{
+		Title:        e.GetTitle(),
+		Place:        e.GetLocation(),
+		Cancelled:    e.GetCancelledAt() != nil,
+		CancelReason: e.GetCancelReason(),
+	}
+	if ts := e.GetStartAt(); ts != nil {
+		data.When = helpers.FormatDateTimeRU(ts.AsTime().In(helpers.Location(e.GetTimezone())))
+	}
+	if e.GetVenueId() != "" {
+		venue, err := helpers.LoadVenue(ctx, db, e.GetVenueId())
+		if err != nil {
+			http.Error(w, "load venue", http.StatusInternalServerError)
+			return
+		}
+		data.Address, data.MapURL = venue.GetAddress(), venue.GetMapUrl()
+	}
+	sections, err := helpers.LoadTracklistSections(ctx, db, []string{e.GetId()})
+	if err != nil {
+		http.Error(w, "load tracklist", http.StatusInternalServerError)
+		return
+	}
+	data.Sections = sections[e.GetId()]
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	w.Header().Set("X-Robots-Tag", "noindex")
+	_ = sharePage.Execute(w, data)
+}
@@ -0,0 +1,217 @@
+package httpapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+	"musicclubbot/backend/internal/config"
+	"musicclubbot/backend/internal/helpers"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/image/draw"
+)
+
+const (
+	defaultThumbnailWidth = 320
+	maxThumbnailBytes     = 10 << 20
+	// maxThumbnailPixels bounds the decoded size: a small file can declare
+	// a huge image.
+	maxThumbnailPixels = 40_000_000
+)
+
+// thumbnailWidths are the only sizes we render, to keep the cache bounded.
+var thumbnailWidths = []int{160, 320, 640, 1280}
+
+// thumbnailClient fetches the user-set thumbnail_url, so it only goes to
+// public addresses over http(s). The address is checked once resolved, which
+// covers redirects and DNS answers that change between lookups.
+var thumbnailClient = &http.Client{
+	Timeout: 10 * time.Second,
+	Transport: &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout: 5 * time.Second,
+			Control: publicAddressOnly,
+		}).DialContext,
+		TLSHandshakeTimeout: 5 * time.Second,
+	},
+	CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		if len(via) >= 5 {
+			return errors.New("too many redirects")
+		}
+		return checkScheme(req.URL)
+	},
+}
+
+func checkScheme(u *url.URL) error {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("unsupported scheme %q", u.Scheme)
+	}
+	return nil
+}
+
+// publicAddressOnly refuses connections to loopback, private, link-local
+// (cloud metadata) and other non-public addresses.
+func publicAddressOnly(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	ip = ip.Unmap()
+	if !ip.IsGlobalUnicast() || ip.IsPrivate() || sharedAddressSpace.Contains(ip) {
+		return fmt.Errorf("address %s is not public", ip)
+	}
+	return nil
+}
+
+// sharedAddressSpace is the carrier-grade NAT range, not covered by
+// IsPrivate.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+func serveThumbnail(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	songID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	width := thumbnailWidth(r.URL.Query().Get("w"))
+
+	db, err := helpers.DbFromCtx(ctx)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	var source string
+	err = db.QueryRowContext(ctx, `SELECT COALESCE(thumbnail_url, '') FROM song WHERE id = $1`, songID).Scan(&source)
+	if err == sql.ErrNoRows || (err == nil && source == "") {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		http.Error(w, "load song", http.StatusInternalServerError)
+		return
+	}
+
+	cfg := ctx.Value("cfg").(config.Config)
+	sum := sha256.Sum256([]byte(source))
+	path := filepath.Join(cfg.ThumbnailCacheDir, hex.EncodeToString(sum[:16])+"_"+strconv.Itoa(width)+".jpg")
+
+	if _, err := os.Stat(path); err != nil {
+		if err := renderThumbnail(ctx, source, width, path); err != nil {
+			http.Error(w, "thumbnail unavailable", http.StatusBadGateway)
+			return
+		}
+	}
+
+	// The URL carries a source hash, so a cached copy never goes stale.
+	w.Header().Set("Cache-Control", "public, max-age=604800, immutable")
+	w.Header().Set("Content-Type", "image/jpeg")
+	http.ServeFile(w, r, path)
+}
+
+func thumbnailWidth(raw string) int {
+	requested, err := strconv.Atoi(raw)
+	if err != nil || requested <= 0 {
+		return defaultThumbnailWidth
+	}
+	for _, w := range thumbnailWidths {
+		if requested <= w {
+			return w
+		}
+	}
+	return thumbnailWidths[len(thumbnailWidths)-1]
+}
+
+// renderThumbnail fetches the first available source candidate, scales it down
+// to width and atomically stores it as JPEG at path.
+func renderThumbnail(ctx context.Context, source string, width int, path string) error {
+	var img image.Image
+	var lastErr error
+	for _, candidate := range helpers.ThumbnailSourceCandidates(source) {
+		img, lastErr = fetchImage(ctx, candidate)
+		if lastErr == nil {
+			break
+		}
+	}
+	if img == nil {
+		return lastErr
+	}
+
+	bounds := img.Bounds()
+	if bounds.Dx() > width {
+		height := bounds.Dy() * width / bounds.Dx()
+		scaled := image.NewRGBA(image.Rect(0, 0, width, height))
+		draw.CatmullRom.Scale(scaled, scaled.Bounds(), img, bounds, draw.Over, nil)
+		img = scaled
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), "thumb-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if err := jpeg.Encode(tmp, img, &jpeg.Options{Quality: 85}); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func fetchImage(ctx context.Context, url string) (image.Image, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkScheme(req.URL); err != nil {
+		return nil, err
+	}
+	resp, err := thumbnailClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch %s: status %d", url, resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxThumbnailBytes))
+	if err != nil {
+		return nil, err
+	}
+	imgConfig, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if imgConfig.Width*imgConfig.Height > maxThumbnailPixels {
+		return nil, fmt.Errorf("fetch %s: image is %dx%d, too large", url, imgConfig.Width, imgConfig.Height)
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	return img, err
+}
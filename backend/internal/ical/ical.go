@@ -0,0 +1,96 @@
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"time"
+)
+
+// DefaultDuration is used for events that don't say how long they last.
+const DefaultDuration = 2 * time.Hour
+
+// Event is a single VEVENT.
+type Event struct {
+	UID         string
+	Title       string
+	Start       time.Time
+	End         time.Time
+	Location    string
+	Description string
+	URL         string
+	Updated     time.Time
+	Cancelled   bool
+}
+
+// Render returns an iCalendar (RFC 5545) document with the given events.
+func Render(name string, events []Event) []byte {
+	var b bytes.Buffer
+	line(&b, "BEGIN:VCALENDAR")
+	line(&b, "VERSION:2.0")
+	line(&b, "PRODID:-//musicclubbot//events//RU")
+	line(&b, "CALSCALE:GREGORIAN")
+	line(&b, "METHOD:PUBLISH")
+	if name != "" {
+		line(&b, "X-WR-CALNAME:"+escape(name))
+	}
+	for _, e := range events {
+		end := e.End
+		if end.IsZero() || !end.After(e.Start) {
+			end = e.Start.Add(DefaultDuration)
+		}
+		updated := e.Updated
+		if updated.IsZero() {
+			updated = time.Now()
+		}
+		line(&b, "BEGIN:VEVENT")
+		line(&b, "UID:"+e.UID)
+		line(&b, "DTSTAMP:"+stamp(updated))
+		line(&b, "DTSTART:"+stamp(e.Start))
+		line(&b, "DTEND:"+stamp(end))
+		line(&b, "SUMMARY:"+escape(e.Title))
+		if e.Cancelled {
+			line(&b, "STATUS:CANCELLED")
+		}
+		if e.Location != "" {
+			line(&b, "LOCATION:"+escape(e.Location))
+		}
+		if e.Description != "" {
+			line(&b, "DESCRIPTION:"+escape(e.Description))
+		}
+		if e.URL != "" {
+			line(&b, "URL:"+e.URL)
+		}
+		line(&b, "END:VEVENT")
+	}
+	line(&b, "END:VCALENDAR")
+	return b.Bytes()
+}
+
+func stamp(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+var escaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+func escape(s string) string {
+	return escaper.Replace(s)
+}
+
+// line writes a content line folded at 75 octets without splitting UTF-8
+// sequences, as the RFC requires.
+func line(b *bytes.Buffer, s string) {
+	limit := 75
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && s[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		b.WriteString(s[:cut])
+		b.WriteString("\r\n ")
+		s = s[cut:]
+		// Continuation lines start with a space, which counts too.
+		limit = 74
+	}
+	b.WriteString(s)
+	b.WriteString("\r\n")
+}
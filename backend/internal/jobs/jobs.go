@@ -0,0 +1,177 @@
+package jobs
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"sync"
+	"time"
+
+	"musicclubbot/backend/internal/config"
+	"musicclubbot/backend/internal/gcal"
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/internal/recurrence"
+	"musicclubbot/backend/internal/reminders"
+	"musicclubbot/backend/internal/stats"
+	"musicclubbot/backend/internal/telegram"
+	"musicclubbot/backend/internal/usage"
+)
+
+// Job is a periodic background task run by the backend process.
+type Job struct {
+	Name  string
+	Every time.Duration
+	Run   func(ctx context.Context) error
+	// Final runs the job once more after ctx is cancelled, so what it
+	// would have picked up on the next tick isn't lost.
+	Final bool
+}
+
+// finalRunTimeout bounds the last run of Final jobs.
+const finalRunTimeout = 5 * time.Second
+
+// Start runs all jobs until ctx is cancelled. Each job runs once right away
+// and then on its own ticker. The returned channel is closed once every job
+// has returned.
+func Start(ctx context.Context) <-chan struct{} {
+	log := ctx.Value("log").(*slog.Logger)
+	var wg sync.WaitGroup
+	for _, job := range registered(ctx, log) {
+		wg.Go(func() { loop(ctx, log, job) })
+	}
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	return done
+}
+
+func registered(ctx context.Context, log *slog.Logger) []Job {
+	db := ctx.Value("db").(*sql.DB)
+	cfg := ctx.Value("cfg").(config.Config)
+	jobs := []Job{
+		{
+			Name:  "materialize recurring events",
+			Every: time.Hour,
+			Run: func(ctx context.Context) error {
+				return recurrence.MaterializeAll(ctx, db, time.Now())
+			},
+		},
+		{
+			Name:  "refresh statistics",
+			Every: 10 * time.Minute,
+			Run: func(ctx context.Context) error {
+				return stats.Refresh(ctx, db)
+			},
+		},
+	}
+	if recorder, ok := ctx.Value("usage").(*usage.Recorder); ok {
+		jobs = append(jobs, Job{
+			Name:  "flush API usage",
+			Every: time.Minute,
+			Run: func(ctx context.Context) error {
+				return recorder.Flush(ctx, db)
+			},
+			Final: true,
+		})
+	}
+
+	if cfg.BotToken != "" {
+		tg := telegram.New(cfg.BotToken)
+		jobs = append(jobs, Job{
+			Name:  "send event reminders",
+			Every: time.Minute,
+			Run: func(ctx context.Context) error {
+				return reminders.Send(ctx, db, tg, time.Now())
+			},
+		}, Job{
+			Name:  "send rehearsal reminders",
+			Every: time.Minute,
+			Run: func(ctx context.Context) error {
+				return reminders.SendRehearsalReminders(ctx, db, tg, time.Now())
+			},
+		}, Job{
+			Name:  "send cancellation notices",
+			Every: time.Minute,
+			Run: func(ctx context.Context) error {
+				return reminders.SendCancellations(ctx, db, tg)
+			},
+		}, Job{
+			Name:  "send feedback invites",
+			Every: time.Minute,
+			Run: func(ctx context.Context) error {
+				return reminders.SendFeedbackInvites(ctx, db, tg, cfg.BotUsername)
+			},
+		}, Job{
+			Name:  "send ride matches",
+			Every: time.Minute,
+			Run: func(ctx context.Context) error {
+				return reminders.SendRideMatches(ctx, db, tg)
+			},
+		}, Job{
+			Name:  "send notification digests",
+			Every: 10 * time.Minute,
+			Run: func(ctx context.Context) error {
+				return reminders.SendDigests(ctx, db, tg, helpers.Location(cfg.DefaultTimezone), time.Now())
+			},
+		})
+		if cfg.ChatID != "" {
+			jobs = append(jobs, Job{
+				Name:  "post monthly stats",
+				Every: time.Hour,
+				Run: func(ctx context.Context) error {
+					return stats.PostMonthlyReport(ctx, db, tg, cfg.ChatID, helpers.Location(cfg.DefaultTimezone), time.Now())
+				},
+			}, Job{
+				Name:  "send group mentions",
+				Every: time.Minute,
+				Run: func(ctx context.Context) error {
+					return reminders.SendSongChangeMentions(ctx, db, tg, cfg.ChatID, time.Now())
+				},
+			})
+		}
+	}
+
+	if cfg.GoogleCalendarEnabled() {
+		client, err := gcal.New(ctx, cfg.GoogleCredentialsFile, cfg.GoogleCalendarID)
+		if err != nil {
+			log.Error("google calendar sync disabled", "error", err)
+		} else {
+			jobs = append(jobs, Job{
+				Name:  "sync google calendar",
+				Every: time.Minute,
+				Run: func(ctx context.Context) error {
+					return gcal.Sync(ctx, db, client)
+				},
+			})
+		}
+	}
+	return jobs
+}
+
+func loop(ctx context.Context, log *slog.Logger, job Job) {
+	ticker := time.NewTicker(job.Every)
+	defer ticker.Stop()
+	for {
+		if err := job.Run(ctx); err != nil && ctx.Err() == nil {
+			log.Error("job failed", "job", job.Name, "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			if job.Final {
+				runFinal(ctx, log, job)
+			}
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func runFinal(ctx context.Context, log *slog.Logger, job Job) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), finalRunTimeout)
+	defer cancel()
+	if err := job.Run(ctx); err != nil {
+		log.Error("job failed", "job", job.Name, "error", err)
+	}
+}
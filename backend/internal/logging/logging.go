@@ -0,0 +1,38 @@
+// Package logging builds the backend's structured logger. Request handlers
+// get one carrying the call's fields through the "log" context value.
+package logging
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"strings"
+)
+
+// New returns a logger writing to w at level ("debug", "info", "warn" or
+// "error"; info when unknown), as JSON when format is "json" and as
+// key=value text otherwise.
+func New(w io.Writer, level, format string) *slog.Logger {
+	var lvl slog.Level
+	if err := lvl.UnmarshalText([]byte(level)); err != nil {
+		lvl = slog.LevelInfo
+	}
+	opts := &slog.HandlerOptions{Level: lvl}
+	if strings.EqualFold(format, "json") {
+		return slog.New(slog.NewJSONHandler(w, opts))
+	}
+	return slog.New(slog.NewTextHandler(w, opts))
+}
+
+// FromContext returns the logger stored under "log", or the default one.
+func FromContext(ctx context.Context) *slog.Logger {
+	if log, ok := ctx.Value("log").(*slog.Logger); ok {
+		return log
+	}
+	return slog.Default()
+}
+
+// With stores a logger with the extra fields in the returned context.
+func With(ctx context.Context, args ...any) context.Context {
+	return context.WithValue(ctx, "log", FromContext(ctx).With(args...))
+}
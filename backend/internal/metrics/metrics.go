@@ -0,0 +1,79 @@
+// Package metrics exports Prometheus metrics at /metrics: RPCs by method and
+// status code, the database connection pool and Telegram Bot API calls.
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var registry = prometheus.NewRegistry()
+
+var (
+	rpcRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "musicclub_rpc_requests_total",
+		Help: "RPCs handled, by full method name and gRPC status code.",
+	}, []string{"method", "code"})
+	rpcDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "musicclub_rpc_duration_seconds",
+		Help:    "Time to handle an RPC; streams count until they end.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method"})
+	rpcPanics = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "musicclub_rpc_panics_total",
+		Help: "Handler panics recovered, by full method name.",
+	}, []string{"method"})
+	telegramCalls = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "musicclub_telegram_calls_total",
+		Help: "Telegram Bot API calls, by API method and result (ok or error).",
+	}, []string{"method", "result"})
+	telegramDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "musicclub_telegram_call_duration_seconds",
+		Help:    "Time for a Telegram Bot API call.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method"})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		rpcRequests, rpcDuration, rpcPanics, telegramCalls, telegramDuration,
+	)
+}
+
+// Handler serves the metrics in the Prometheus text format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+// RegisterDB exports the connection pool stats of db under the given name.
+func RegisterDB(db *sql.DB, name string) {
+	registry.MustRegister(collectors.NewDBStatsCollector(db, name))
+}
+
+// ObserveRPC records a finished RPC; code is its gRPC status code name.
+func ObserveRPC(method, code string, took time.Duration) {
+	rpcRequests.WithLabelValues(method, code).Inc()
+	rpcDuration.WithLabelValues(method).Observe(took.Seconds())
+}
+
+// ObservePanic records a recovered handler panic.
+func ObservePanic(method string) {
+	rpcPanics.WithLabelValues(method).Inc()
+}
+
+// ObserveTelegramCall records a finished Bot API call.
+func ObserveTelegramCall(method string, took time.Duration, err error) {
+	result := "ok"
+	if err != nil {
+		result = "error"
+	}
+	telegramCalls.WithLabelValues(method, result).Inc()
+	telegramDuration.WithLabelValues(method).Observe(took.Seconds())
+}
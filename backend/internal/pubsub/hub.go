@@ -0,0 +1,49 @@
+package pubsub
+
+import "sync"
+
+// Hub fans out change notifications to in-process subscribers. It only reaches
+// streams served by this instance, which matches our single-replica deployment.
+type Hub struct {
+	mu   sync.Mutex
+	subs map[string]map[chan struct{}]struct{}
+}
+
+func NewHub() *Hub {
+	return &Hub{subs: map[string]map[chan struct{}]struct{}{}}
+}
+
+// Subscribe returns a channel signalled whenever topic is published and a
+// function that must be called to unsubscribe. Signals are coalesced, so a slow
+// subscriber sees at most one pending notification.
+func (h *Hub) Subscribe(topic string) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	h.mu.Lock()
+	if h.subs[topic] == nil {
+		h.subs[topic] = map[chan struct{}]struct{}{}
+	}
+	h.subs[topic][ch] = struct{}{}
+	h.mu.Unlock()
+
+	return ch, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		delete(h.subs[topic], ch)
+		if len(h.subs[topic]) == 0 {
+			delete(h.subs, topic)
+		}
+	}
+}
+
+// Publish notifies every subscriber of topic without blocking.
+func (h *Hub) Publish(topic string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for ch := range h.subs[topic] {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package recurrence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/teambition/rrule-go"
+)
+
+const (
+	// Horizon is how far ahead occurrences are materialized as events.
+	Horizon = 8 * 7 * 24 * time.Hour
+	// maxPerPass bounds inserts for a single series in one pass.
+	maxPerPass = 100
+)
+
+// Execer is satisfied by both *sql.DB and *sql.Tx.
+type Execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// Normalize validates an RRULE (with or without the "RRULE:" prefix) and
+// returns it in canonical form. Only daily, weekly, monthly and yearly rules
+// are accepted.
+func Normalize(rule string) (string, error) {
+	rule = strings.TrimSpace(rule)
+	rule = strings.TrimPrefix(strings.ToUpper(rule), "RRULE:")
+	opt, err := rrule.StrToROption(rule)
+	if err != nil {
+		return "", fmt.Errorf("invalid recurrence rule: %w", err)
+	}
+	switch opt.Freq {
+	case rrule.DAILY, rrule.WEEKLY, rrule.MONTHLY, rrule.YEARLY:
+	default:
+		return "", fmt.Errorf("recurrence must be daily, weekly, monthly or yearly")
+	}
+	opt.Dtstart = time.Time{}
+	return opt.RRuleString(), nil
+}
+
+// Occurrences returns start times of the rule anchored at dtstart that fall
+// strictly after `after` and not later than `until`.
+func Occurrences(rule string, dtstart, after, until time.Time) ([]time.Time, error) {
+	opt, err := rrule.StrToROption(rule)
+	if err != nil {
+		return nil, err
+	}
+	opt.Dtstart = dtstart
+	r, err := rrule.NewRRule(*opt)
+	if err != nil {
+		return nil, err
+	}
+	return r.Between(after, until, false), nil
+}
+
+// Materialize creates events for occurrences of a series up to the horizon.
+// Occurrences before materialized_until are never recreated, so deleting a
+// single occurrence sticks.
+func Materialize(ctx context.Context, q Execer, seriesID string, now time.Time) error {
+	var rule, title, timezone string
+	var location, venueID, seasonID, theme, themeTag sql.NullString
+	var dtstart, materializedUntil time.Time
+	var notifyDay, notifyHour bool
+	var createdBy sql.NullString
+	var maxParticipants, timeSlot, trackGap int32
+	var requiredRoles []string
+	var notifyOffsets []int32
+	var lat, lon sql.NullFloat64
+	if err := q.QueryRowContext(ctx, `
+		SELECT rrule, dtstart, materialized_until, title, location, notify_day_before, notify_hour_before, created_by, max_participants, required_roles, venue_id, time_slot_minutes,
+		       notify_offsets, timezone, season_id, track_gap_seconds, latitude, longitude, theme, theme_tag
+		FROM event_series WHERE id = $1
+	`, seriesID).Scan(&rule, &dtstart, &materializedUntil, &title, &location, &notifyDay, &notifyHour, &createdBy, &maxParticipants, pq.Array(&requiredRoles),
+		&venueID, &timeSlot, pq.Array(&notifyOffsets), &timezone, &seasonID, &trackGap, &lat, &lon, &theme, &themeTag); err != nil {
+		return err
+	}
+
+	until := now.Add(Horizon)
+	if !until.After(materializedUntil) {
+		return nil
+	}
+	// Expanding in the series' zone keeps the wall-clock time across DST.
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return err
+	}
+	times, err := Occurrences(rule, dtstart.In(loc), materializedUntil, until)
+	if err != nil {
+		return err
+	}
+	if len(times) > maxPerPass {
+		times = times[:maxPerPass]
+		until = times[len(times)-1]
+	}
+
+	for _, at := range times {
+		if _, err := q.ExecContext(ctx, `
+			WITH inserted AS (
+				INSERT INTO event (title, start_at, location, notify_day_before, notify_hour_before, created_by, series_id, occurrence_at, max_participants, required_roles, venue_id, time_slot_minutes, timezone, season_id,
+				                   track_gap_seconds, latitude, longitude, theme, theme_tag)
+				SELECT $1, $2::timestamptz, $3, $4, $5, $6, $7, $2::timestamptz, $8, $9, $10, $11, $13, $14, $15, $16, $17, $18, $19
+				WHERE NOT EXISTS (SELECT 1 FROM event WHERE series_id = $7 AND occurrence_at = $2::timestamptz)
+				RETURNING id, created_by
+			), notifications AS (
+				INSERT INTO event_notification (event_id, offset_minutes)
+				SELECT inserted.id, o FROM inserted, unnest($12::int[]) AS o
+			)
+			INSERT INTO event_owner (event_id, user_id)
+			SELECT id, created_by FROM inserted WHERE created_by IS NOT NULL
+		`, title, at, location, notifyDay, notifyHour, createdBy, seriesID, maxParticipants, pq.Array(requiredRoles), venueID, timeSlot,
+			pq.Array(notifyOffsets), timezone, seasonID, trackGap, lat, lon, theme, themeTag); err != nil {
+			return err
+		}
+	}
+	_, err = q.ExecContext(ctx, `UPDATE event_series SET materialized_until = $1 WHERE id = $2`, until, seriesID)
+	return err
+}
+
+// MaterializeAll extends every series that is running out of occurrences.
+func MaterializeAll(ctx context.Context, db *sql.DB, now time.Time) error {
+	rows, err := db.QueryContext(ctx, `SELECT id FROM event_series WHERE materialized_until < $1`, now.Add(Horizon))
+	if err != nil {
+		return err
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return err
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if err := Materialize(ctx, db, id, now); err != nil {
+			return fmt.Errorf("materialize series %s: %w", id, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+package reminders
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"musicclubbot/backend/internal/telegram"
+)
+
+const (
+	// digestHour is the local hour digests go out at.
+	digestHour = 10
+	// maxDigestLength keeps a digest under Telegram's 4096 character limit
+	// with room for the header and the "more" line.
+	maxDigestLength = 3500
+)
+
+// recipient is a user a notification goes to.
+type recipient struct {
+	userID string
+	chat   string
+	// digest is app_user.notification_digest.
+	digest string
+}
+
+func scanRecipients(rows *sql.Rows) ([]recipient, error) {
+	defer rows.Close()
+	var out []recipient
+	for rows.Next() {
+		var r recipient
+		var chat int64
+		if err := rows.Scan(&r.userID, &chat, &r.digest); err != nil {
+			return nil, err
+		}
+		r.chat = strconv.FormatInt(chat, 10)
+		out = append(out, r)
+	}
+	return out, rows.Err()
+}
+
+// notice is a non-urgent notification: users who chose a digest get it with
+// their next one instead of right away.
+type notice struct {
+	text    string
+	buttons [][]telegram.Button
+	// digestText replaces text in digests, which can't carry buttons; empty
+	// uses text.
+	digestText string
+	// expiresAt drops the notice from digests sent after it; zero keeps it.
+	expiresAt time.Time
+}
+
+// deliver sends the notice now or queues it for the recipient's digest.
+func deliver(ctx context.Context, db *sql.DB, tg *telegram.Client, r recipient, n notice) error {
+	if r.digest == "immediate" {
+		if len(n.buttons) > 0 {
+			return tg.SendMessageWithButtons(ctx, r.chat, n.text, n.buttons)
+		}
+		return tg.SendMessage(ctx, r.chat, n.text)
+	}
+	text := n.digestText
+	if text == "" {
+		text = n.text
+	}
+	var expiresAt sql.NullTime
+	if !n.expiresAt.IsZero() {
+		expiresAt = sql.NullTime{Time: n.expiresAt, Valid: true}
+	}
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO pending_notification (user_id, text, expires_at) VALUES ($1, $2, $3)
+	`, r.userID, text, expiresAt)
+	return err
+}
+
+// SendDigests sends users their queued notices in one message once one of
+// them was queued before the latest digest time: digestHour every day for
+// daily digests, on Mondays for weekly ones. Users back on immediate
+// delivery get what was still queued. Notices are claimed before sending,
+// so a digest that fails is not retried.
+func SendDigests(ctx context.Context, db *sql.DB, tg *telegram.Client, loc *time.Location, now time.Time) error {
+	local := now.In(loc)
+	daily := time.Date(local.Year(), local.Month(), local.Day(), digestHour, 0, 0, 0, loc)
+	if daily.After(local) {
+		daily = daily.AddDate(0, 0, -1)
+	}
+	weekly := daily.AddDate(0, 0, -((int(daily.Weekday()) + 6) % 7))
+
+	var errs []error
+	for _, d := range []struct {
+		digest, title string
+		since         time.Time
+	}{
+		{"daily", "Сводка за день", daily},
+		{"weekly", "Сводка за неделю", weekly},
+		{"immediate", "Отложенные уведомления", now},
+	} {
+		if err := sendDigests(ctx, db, tg, d.digest, d.title, d.since, now); err != nil {
+			errs = append(errs, fmt.Errorf("%s digests: %w", d.digest, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func sendDigests(ctx context.Context, db *sql.DB, tg *telegram.Client, digest, title string, since, now time.Time) error {
+	rows, err := db.QueryContext(ctx, `
+		DELETE FROM pending_notification p USING app_user u
+		WHERE p.user_id = u.id AND u.notification_digest = $1
+		  AND EXISTS (SELECT 1 FROM pending_notification o WHERE o.user_id = u.id AND o.created_at < $2)
+		RETURNING u.id, u.tg_user_id, p.text, p.expires_at, p.created_at
+	`, digest, since)
+	if err != nil {
+		return err
+	}
+	type item struct {
+		text    string
+		created time.Time
+	}
+	chats := map[string]sql.NullInt64{}
+	items := map[string][]item{}
+	for rows.Next() {
+		var userID string
+		var chat sql.NullInt64
+		var it item
+		var expiresAt sql.NullTime
+		if err := rows.Scan(&userID, &chat, &it.text, &expiresAt, &it.created); err != nil {
+			rows.Close()
+			return err
+		}
+		chats[userID] = chat
+		if expiresAt.Valid && expiresAt.Time.Before(now) {
+			continue
+		}
+		items[userID] = append(items[userID], it)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	var errs []error
+	for userID, list := range items {
+		chat := chats[userID]
+		if !chat.Valid {
+			continue
+		}
+		// DELETE ... RETURNING has no order of its own.
+		sort.Slice(list, func(i, j int) bool { return list[i].created.Before(list[j].created) })
+		texts := make([]string, len(list))
+		for i, it := range list {
+			texts[i] = it.text
+		}
+		if err := tg.SendMessage(ctx, strconv.FormatInt(chat.Int64, 10), digestMessage(title, texts)); err != nil {
+			errs = append(errs, fmt.Errorf("user %s: %w", userID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// digestMessage joins the notices oldest first under a header, cutting the
+// list short rather than exceeding Telegram's message size.
+func digestMessage(title string, texts []string) string {
+	var b strings.Builder
+	b.WriteString("📬 <b>" + title + "</b>")
+	for i, text := range texts {
+		if b.Len()+len(text) > maxDigestLength {
+			fmt.Fprintf(&b, "\n\n…и ещё %d", len(texts)-i)
+			break
+		}
+		b.WriteString("\n\n" + text)
+	}
+	return b.String()
+}
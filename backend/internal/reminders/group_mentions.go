@@ -0,0 +1,152 @@
+package reminders
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+
+	"musicclubbot/backend/internal/telegram"
+)
+
+// songChangeQuiet is how long a song has to go without edits before they
+// are announced, so a burst of edits makes one message.
+const songChangeQuiet = 2 * time.Minute
+
+// songFieldNames names UpdateSong mask fields in announcements.
+var songFieldNames = map[string]string{
+	"title":            "название",
+	"artist":           "исполнитель",
+	"link":             "ссылка",
+	"description":      "описание",
+	"available_roles":  "роли",
+	"thumbnail_url":    "обложка",
+	"tags":             "теги",
+	"duration_seconds": "длительность",
+	"key":              "тональность",
+}
+
+// SendSongChangeMentions announces song edits in the club chat, mentioning
+// members of groups with mention_on_song_changes who play in the song. The
+// editor isn't mentioned, and edits nobody is mentioned for go unannounced.
+// Like reminders, edits are claimed before sending.
+func SendSongChangeMentions(ctx context.Context, db *sql.DB, tg *telegram.Client, chatID string, now time.Time) error {
+	rows, err := db.QueryContext(ctx, `
+		DELETE FROM song_change_notice n USING song s
+		WHERE s.id = n.song_id AND n.changed_at < $1
+		RETURNING n.song_id, s.title, s.artist, COALESCE(n.actor_id::text, ''), n.fields
+	`, now.Add(-songChangeQuiet))
+	if err != nil {
+		return err
+	}
+	type change struct {
+		songID, title, artist, actorID string
+		fields                         []string
+	}
+	var changes []change
+	for rows.Next() {
+		var c change
+		if err := rows.Scan(&c.songID, &c.title, &c.artist, &c.actorID, pq.Array(&c.fields)); err != nil {
+			rows.Close()
+			return err
+		}
+		changes = append(changes, c)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, c := range changes {
+		mentions, err := groupMentions(ctx, db, c.songID, c.actorID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("song %s: %w", c.songID, err))
+			continue
+		}
+		if len(mentions) == 0 {
+			continue
+		}
+		var b strings.Builder
+		b.WriteString("✏️ Обновлена песня <b>" + html.EscapeString(c.title) + "</b> — " + html.EscapeString(c.artist))
+		if len(c.fields) > 0 {
+			names := make([]string, len(c.fields))
+			for i, f := range c.fields {
+				names[i] = songFieldNames[f]
+				if names[i] == "" {
+					names[i] = f
+				}
+			}
+			b.WriteString("\nИзменено: " + strings.Join(names, ", "))
+		}
+		for _, m := range mentions {
+			b.WriteString("\n" + m)
+		}
+		if err := tg.SendMessage(ctx, chatID, b.String()); err != nil {
+			errs = append(errs, fmt.Errorf("song %s: %w", c.songID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// groupMentions returns a "Group: @a, @b" line per group with members who
+// play in the song, leaving out the editor.
+func groupMentions(ctx context.Context, db *sql.DB, songID, actorID string) ([]string, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT g.name, u.display_name, COALESCE(u.username, ''), COALESCE(u.tg_user_id, 0)
+		FROM member_group g
+		JOIN member_group_member gm ON gm.group_id = g.id
+		JOIN app_user u ON u.id = gm.user_id
+		WHERE g.mention_on_song_changes AND u.is_chat_member AND u.deactivated_at IS NULL
+		  AND u.id::text <> $2
+		  AND EXISTS (SELECT 1 FROM song_role_assignment a WHERE a.song_id = $1 AND a.user_id = u.id)
+		ORDER BY lower(g.name), g.id, u.display_name
+	`, songID, actorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var lines []string
+	var group string
+	var names []string
+	flush := func() {
+		if len(names) > 0 {
+			lines = append(lines, "<b>"+html.EscapeString(group)+"</b>: "+strings.Join(names, ", "))
+		}
+	}
+	for rows.Next() {
+		var g, displayName, username string
+		var tgID int64
+		if err := rows.Scan(&g, &displayName, &username, &tgID); err != nil {
+			return nil, err
+		}
+		if g != group {
+			flush()
+			group, names = g, nil
+		}
+		names = append(names, mention(displayName, username, tgID))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	flush()
+	return lines, nil
+}
+
+// mention links a member so Telegram notifies them: by username, or by id
+// for Telegram users without one.
+func mention(displayName, username string, tgID int64) string {
+	switch {
+	case username != "" && tgID != 0 && !strings.HasPrefix(username, "tg_"):
+		return "@" + username
+	case tgID != 0:
+		return `<a href="tg://user?id=` + strconv.FormatInt(tgID, 10) + `">` + html.EscapeString(displayName) + `</a>`
+	}
+	return html.EscapeString(displayName)
+}
@@ -0,0 +1,94 @@
+package reminders
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/internal/telegram"
+)
+
+// SendRehearsalReminders delivers the day and hour reminders of rehearsals
+// that asked for them. Like event reminders they are claimed before sending,
+// and both are claimed when the hour one is already due.
+func SendRehearsalReminders(ctx context.Context, db *sql.DB, tg *telegram.Client, now time.Time) error {
+	rows, err := db.QueryContext(ctx, `
+		UPDATE rehearsal r SET
+			day_reminder_sent_at = CASE WHEN r.notify_day_before AND r.start_at - INTERVAL '1 day' <= $1
+				THEN COALESCE(r.day_reminder_sent_at, $1) END,
+			hour_reminder_sent_at = CASE WHEN r.notify_hour_before AND r.start_at - INTERVAL '1 hour' <= $1
+				THEN COALESCE(r.hour_reminder_sent_at, $1) END
+		FROM event e
+		WHERE e.id = r.event_id AND e.cancelled_at IS NULL AND e.deleted_at IS NULL AND r.start_at > $1
+		  AND ((r.notify_day_before AND r.day_reminder_sent_at IS NULL AND r.start_at - INTERVAL '1 day' <= $1)
+		       OR (r.notify_hour_before AND r.hour_reminder_sent_at IS NULL AND r.start_at - INTERVAL '1 hour' <= $1))
+		RETURNING r.id, e.id, e.title, COALESCE(r.location, ''), r.start_at, e.timezone,
+		          CASE WHEN r.hour_reminder_sent_at IS NOT NULL THEN 60 ELSE 1440 END
+	`, now)
+	if err != nil {
+		return err
+	}
+	type rehearsalDue struct {
+		due
+		rehearsalID string
+	}
+	var dues []rehearsalDue
+	for rows.Next() {
+		var d rehearsalDue
+		if err := rows.Scan(&d.rehearsalID, &d.eventID, &d.title, &d.location, &d.startAt, &d.timezone, &d.offset); err != nil {
+			rows.Close()
+			return err
+		}
+		dues = append(dues, d)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, d := range dues {
+		if d.startAt.Add(-time.Duration(d.offset) * time.Minute).Before(now.Add(-maxDelay)) {
+			continue
+		}
+		to, err := rehearsalRecipients(ctx, db, d.rehearsalID, d.eventID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("rehearsal %s: %w", d.rehearsalID, err))
+			continue
+		}
+		var b strings.Builder
+		b.WriteString("⏰ Репетиция к <b>" + html.EscapeString(d.title) + "</b> " + untilText(d.offset) + "\n")
+		b.WriteString(helpers.FormatDateTimeRU(d.startAt.In(helpers.Location(d.timezone))))
+		if d.location != "" {
+			b.WriteString("\n📍 " + html.EscapeString(d.location))
+		}
+		for _, r := range to {
+			if err := tg.SendMessage(ctx, r.chat, b.String()); err != nil {
+				errs = append(errs, fmt.Errorf("rehearsal %s, chat %s: %w", d.rehearsalID, r.chat, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// rehearsalRecipients returns people who said they come to the rehearsal and
+// participants of the event who didn't decline it, if they have a Telegram
+// chat.
+func rehearsalRecipients(ctx context.Context, db *sql.DB, rehearsalID, eventID string) ([]recipient, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT u.id, u.tg_user_id, u.notification_digest FROM app_user u
+		LEFT JOIN rehearsal_attendance a ON a.rehearsal_id = $1 AND a.user_id = u.id
+		WHERE u.tg_user_id IS NOT NULL
+		  AND (a.status IN ('going', 'maybe')
+		       OR (a.status IS NULL AND EXISTS (SELECT 1 FROM event_participant p WHERE p.event_id = $2 AND p.user_id = u.id)))
+	`, rehearsalID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	return scanRecipients(rows)
+}
@@ -0,0 +1,239 @@
+// Package reminders sends Telegram reminders before events and rehearsals,
+// notices about cancelled events, feedback survey invites and carpool
+// matches. Non-urgent ones go through users' daily or weekly digests if they
+// chose one.
+package reminders
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+	"time"
+
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/internal/telegram"
+)
+
+// maxDelay is how late a reminder may still go out, e.g. after downtime;
+// older ones are dropped rather than sent out of context.
+const maxDelay = time.Hour
+
+type due struct {
+	eventID  string
+	title    string
+	location string
+	startAt  time.Time
+	timezone string
+	offset   int
+}
+
+// Send delivers every reminder that came due by now to the people taking
+// part in the event. Reminders are claimed before sending, so one that fails
+// is not retried.
+func Send(ctx context.Context, db *sql.DB, tg *telegram.Client, now time.Time) error {
+	rows, err := db.QueryContext(ctx, `
+		UPDATE event_notification n SET sent_at = $1
+		FROM event e LEFT JOIN venue v ON v.id = e.venue_id
+		WHERE e.id = n.event_id AND n.sent_at IS NULL AND e.cancelled_at IS NULL AND e.deleted_at IS NULL
+		  AND e.start_at > $1 AND e.start_at - make_interval(mins => n.offset_minutes) <= $1
+		RETURNING e.id, e.title, COALESCE(e.location, v.name, ''), e.start_at, e.timezone, n.offset_minutes
+	`, now)
+	if err != nil {
+		return err
+	}
+	// Offsets passed at once (e.g. an event created an hour before start)
+	// collapse into the closest one.
+	byEvent := map[string]due{}
+	for rows.Next() {
+		var d due
+		if err := rows.Scan(&d.eventID, &d.title, &d.location, &d.startAt, &d.timezone, &d.offset); err != nil {
+			rows.Close()
+			return err
+		}
+		if prev, ok := byEvent[d.eventID]; !ok || d.offset < prev.offset {
+			byEvent[d.eventID] = d
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, d := range byEvent {
+		if d.startAt.Add(-time.Duration(d.offset) * time.Minute).Before(now.Add(-maxDelay)) {
+			continue
+		}
+		to, err := recipients(ctx, db, d.eventID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("event %s: %w", d.eventID, err))
+			continue
+		}
+		text := message(d)
+		for _, r := range to {
+			if err := tg.SendMessage(ctx, r.chat, text); err != nil {
+				errs = append(errs, fmt.Errorf("event %s, chat %s: %w", d.eventID, r.chat, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// SendCancellations tells participants about events cancelled since the last
+// run. Like reminders, notices are claimed before sending.
+func SendCancellations(ctx context.Context, db *sql.DB, tg *telegram.Client) error {
+	rows, err := db.QueryContext(ctx, `
+		UPDATE event SET cancellation_sent_at = NOW()
+		WHERE cancelled_at IS NOT NULL AND cancellation_sent_at IS NULL AND deleted_at IS NULL
+		RETURNING id, title, start_at, timezone, COALESCE(cancel_reason, '')
+	`)
+	if err != nil {
+		return err
+	}
+	type cancelled struct {
+		eventID, title, timezone, reason string
+		startAt                          sql.NullTime
+	}
+	var events []cancelled
+	for rows.Next() {
+		var c cancelled
+		if err := rows.Scan(&c.eventID, &c.title, &c.startAt, &c.timezone, &c.reason); err != nil {
+			rows.Close()
+			return err
+		}
+		events = append(events, c)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, c := range events {
+		to, err := recipients(ctx, db, c.eventID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("event %s: %w", c.eventID, err))
+			continue
+		}
+		var b strings.Builder
+		b.WriteString("❌ <b>" + html.EscapeString(c.title) + "</b> отменяется")
+		if c.startAt.Valid {
+			b.WriteString("\n" + helpers.FormatDateTimeRU(c.startAt.Time.In(helpers.Location(c.timezone))))
+		}
+		if c.reason != "" {
+			b.WriteString("\nПричина: " + html.EscapeString(c.reason))
+		}
+		for _, r := range to {
+			if err := tg.SendMessage(ctx, r.chat, b.String()); err != nil {
+				errs = append(errs, fmt.Errorf("event %s, chat %s: %w", c.eventID, r.chat, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// SendFeedbackInvites asks participants of events with a newly opened
+// feedback survey to rate them, right in the chat or in the Mini App.
+func SendFeedbackInvites(ctx context.Context, db *sql.DB, tg *telegram.Client, botUsername string) error {
+	rows, err := db.QueryContext(ctx, `
+		UPDATE event_feedback_survey s SET invites_sent_at = NOW()
+		FROM event e
+		WHERE e.id = s.event_id AND s.invites_sent_at IS NULL AND s.closes_at > NOW() AND e.deleted_at IS NULL
+		RETURNING e.id, e.title, e.timezone, s.closes_at
+	`)
+	if err != nil {
+		return err
+	}
+	type survey struct {
+		eventID, title, timezone string
+		closesAt                 time.Time
+	}
+	var surveys []survey
+	for rows.Next() {
+		var sv survey
+		if err := rows.Scan(&sv.eventID, &sv.title, &sv.timezone, &sv.closesAt); err != nil {
+			rows.Close()
+			return err
+		}
+		surveys = append(surveys, sv)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, sv := range surveys {
+		to, err := recipients(ctx, db, sv.eventID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("event %s: %w", sv.eventID, err))
+			continue
+		}
+		text := "🎤 Как прошло <b>" + html.EscapeString(sv.title) + "</b>? Оцените от 1 до 5 до " +
+			helpers.FormatDateTimeRU(sv.closesAt.In(helpers.Location(sv.timezone)))
+		// The bot handles "feedback:<event id>:<rating>" callbacks.
+		var ratings []telegram.Button
+		for r := 1; r <= 5; r++ {
+			ratings = append(ratings, telegram.Button{
+				Text:         strconv.Itoa(r) + "⭐",
+				CallbackData: "feedback:" + sv.eventID + ":" + strconv.Itoa(r),
+			})
+		}
+		n := notice{text: text, buttons: [][]telegram.Button{ratings}, expiresAt: sv.closesAt}
+		if botUsername != "" {
+			link := "https://t.me/" + strings.TrimPrefix(botUsername, "@") + "?startapp=feedback_" + sv.eventID
+			n.buttons = append(n.buttons, []telegram.Button{{Text: "Написать отзыв", URL: link}})
+			// Digests can't carry the rating buttons, so they link to the
+			// survey instead.
+			n.digestText = text + "\n<a href=\"" + link + "\">Оценить</a>"
+		}
+		for _, r := range to {
+			if err := deliver(ctx, db, tg, r, n); err != nil {
+				errs = append(errs, fmt.Errorf("event %s, chat %s: %w", sv.eventID, r.chat, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// recipients returns participants, people who answered going or maybe and
+// those who checked in, if they have a Telegram chat.
+func recipients(ctx context.Context, db *sql.DB, eventID string) ([]recipient, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT u.id, u.tg_user_id, u.notification_digest FROM app_user u
+		WHERE u.tg_user_id IS NOT NULL
+		  AND (EXISTS (SELECT 1 FROM event_participant p WHERE p.event_id = $1 AND p.user_id = u.id)
+		       OR EXISTS (SELECT 1 FROM event_rsvp r WHERE r.event_id = $1 AND r.user_id = u.id AND r.status IN ('going', 'maybe'))
+		       OR EXISTS (SELECT 1 FROM event_attendance a WHERE a.event_id = $1 AND a.user_id = u.id))
+	`, eventID)
+	if err != nil {
+		return nil, err
+	}
+	return scanRecipients(rows)
+}
+
+func message(d due) string {
+	var b strings.Builder
+	b.WriteString("⏰ <b>" + html.EscapeString(d.title) + "</b> " + untilText(d.offset) + "\n")
+	b.WriteString(helpers.FormatDateTimeRU(d.startAt.In(helpers.Location(d.timezone))))
+	if d.location != "" {
+		b.WriteString("\n📍 " + html.EscapeString(d.location))
+	}
+	return b.String()
+}
+
+// untilText phrases the offset as "через 2 дн." / "через 3 ч" / "через 15 мин".
+func untilText(minutes int) string {
+	switch {
+	case minutes%(24*60) == 0:
+		return fmt.Sprintf("через %d дн.", minutes/(24*60))
+	case minutes%60 == 0:
+		return fmt.Sprintf("через %d ч", minutes/60)
+	default:
+		return fmt.Sprintf("через %d мин", minutes)
+	}
+}
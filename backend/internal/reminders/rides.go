@@ -0,0 +1,158 @@
+package reminders
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/internal/telegram"
+)
+
+type rideContact struct {
+	chat     sql.NullInt64
+	name     string
+	username string
+}
+
+func (c rideContact) String() string {
+	s := "<b>" + html.EscapeString(c.name) + "</b>"
+	if c.username != "" {
+		s += " (@" + html.EscapeString(c.username) + ")"
+	}
+	return s
+}
+
+// SendRideMatches tells drivers and passengers about newly taken seats and
+// tells people asking for a ride about new offers for the same event.
+func SendRideMatches(ctx context.Context, db *sql.DB, tg *telegram.Client) error {
+	return errors.Join(sendRidePassengers(ctx, db, tg), sendRideOffers(ctx, db, tg))
+}
+
+func sendRidePassengers(ctx context.Context, db *sql.DB, tg *telegram.Client) error {
+	rows, err := db.QueryContext(ctx, `
+		UPDATE event_ride_passenger p SET notified_at = NOW()
+		FROM event_ride r, event e, app_user d, app_user pu
+		WHERE p.notified_at IS NULL AND r.id = p.ride_id AND e.id = r.event_id AND d.id = r.user_id AND pu.id = p.user_id
+		RETURNING e.title, d.tg_user_id, d.display_name, COALESCE(d.username, ''),
+		          pu.tg_user_id, pu.display_name, COALESCE(pu.username, '')
+	`)
+	if err != nil {
+		return err
+	}
+	type match struct {
+		title             string
+		driver, passenger rideContact
+	}
+	var matches []match
+	for rows.Next() {
+		var m match
+		if err := rows.Scan(&m.title, &m.driver.chat, &m.driver.name, &m.driver.username,
+			&m.passenger.chat, &m.passenger.name, &m.passenger.username); err != nil {
+			rows.Close()
+			return err
+		}
+		matches = append(matches, m)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, m := range matches {
+		title := "<b>" + html.EscapeString(m.title) + "</b>"
+		if m.driver.chat.Valid {
+			text := "🚗 " + m.passenger.String() + " едет с вами на " + title
+			if err := tg.SendMessage(ctx, strconv.FormatInt(m.driver.chat.Int64, 10), text); err != nil {
+				errs = append(errs, fmt.Errorf("driver %s: %w", m.driver.name, err))
+			}
+		}
+		if m.passenger.chat.Valid {
+			text := "🚗 Вы едете на " + title + " с " + m.driver.String()
+			if err := tg.SendMessage(ctx, strconv.FormatInt(m.passenger.chat.Int64, 10), text); err != nil {
+				errs = append(errs, fmt.Errorf("passenger %s: %w", m.passenger.name, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func sendRideOffers(ctx context.Context, db *sql.DB, tg *telegram.Client) error {
+	rows, err := db.QueryContext(ctx, `
+		UPDATE event_ride r SET announced_at = NOW()
+		FROM event e, app_user d
+		WHERE r.kind = 'offer' AND r.announced_at IS NULL AND e.id = r.event_id AND d.id = r.user_id
+		  AND e.cancelled_at IS NULL AND e.deleted_at IS NULL AND (e.start_at IS NULL OR e.start_at > NOW())
+		RETURNING r.event_id, e.title, e.timezone, e.start_at, r.seats, COALESCE(r.from_location, ''), r.depart_at,
+		          d.display_name, COALESCE(d.username, '')
+	`)
+	if err != nil {
+		return err
+	}
+	type offer struct {
+		eventID, title, timezone, from string
+		seats                          int
+		startAt, departAt              sql.NullTime
+		driver                         rideContact
+	}
+	var offers []offer
+	for rows.Next() {
+		var o offer
+		if err := rows.Scan(&o.eventID, &o.title, &o.timezone, &o.startAt, &o.seats, &o.from, &o.departAt,
+			&o.driver.name, &o.driver.username); err != nil {
+			rows.Close()
+			return err
+		}
+		offers = append(offers, o)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, o := range offers {
+		to, err := rideRequesters(ctx, db, o.eventID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("event %s: %w", o.eventID, err))
+			continue
+		}
+		var b strings.Builder
+		fmt.Fprintf(&b, "🚗 %s предлагает мест: %d на <b>%s</b>", o.driver.String(), o.seats, html.EscapeString(o.title))
+		if o.from != "" {
+			b.WriteString("\nОткуда: " + html.EscapeString(o.from))
+		}
+		if o.departAt.Valid {
+			b.WriteString("\nВыезд: " + helpers.FormatDateTimeRU(o.departAt.Time.In(helpers.Location(o.timezone))))
+		}
+		n := notice{text: b.String()}
+		if o.startAt.Valid {
+			n.expiresAt = o.startAt.Time
+		}
+		for _, r := range to {
+			if err := deliver(ctx, db, tg, r, n); err != nil {
+				errs = append(errs, fmt.Errorf("event %s, chat %s: %w", o.eventID, r.chat, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// rideRequesters returns people still asking for a ride to the event who
+// have a Telegram chat.
+func rideRequesters(ctx context.Context, db *sql.DB, eventID string) ([]recipient, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT u.id, u.tg_user_id, u.notification_digest FROM event_ride r
+		JOIN app_user u ON u.id = r.user_id
+		WHERE r.event_id = $1 AND r.kind = 'request' AND u.tg_user_id IS NOT NULL
+	`, eventID)
+	if err != nil {
+		return nil, err
+	}
+	return scanRecipients(rows)
+}
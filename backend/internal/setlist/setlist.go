@@ -0,0 +1,140 @@
+// Package setlist renders printable setlists for the stage floor.
+package setlist
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/jung-kurt/gofpdf"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/gomono"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+// Sheet is everything printed on a setlist.
+type Sheet struct {
+	Title    string
+	Subtitle string
+	Sections []Section
+}
+
+// Section is a set; Name is empty for lists not split into sets.
+type Section struct {
+	Name   string
+	Tracks []Track
+}
+
+type Track struct {
+	Title    string
+	Artist   string
+	Key      string
+	Duration uint32
+	// Scheduled stage time as "19:35", empty if unknown.
+	StartsAt string
+	// Performance notes, printed under the title.
+	Notes string
+	// "Role: Name, Name" lines.
+	Lineup []string
+}
+
+// Text renders the sheet as monospace text.
+func Text(s Sheet) string {
+	var b strings.Builder
+	b.WriteString(strings.ToUpper(s.Title) + "\n")
+	if s.Subtitle != "" {
+		b.WriteString(s.Subtitle + "\n")
+	}
+	for _, section := range s.Sections {
+		b.WriteString("\n")
+		if section.Name != "" {
+			b.WriteString("== " + section.Name + " ==\n")
+		}
+		for i, t := range section.Tracks {
+			fmt.Fprintf(&b, "%2d. %s", i+1, trackTitle(t))
+			if meta := trackMeta(t); meta != "" {
+				b.WriteString("  [" + meta + "]")
+			}
+			b.WriteString("\n")
+			if t.Notes != "" {
+				b.WriteString("    » " + t.Notes + "\n")
+			}
+			for _, line := range t.Lineup {
+				b.WriteString("    " + line + "\n")
+			}
+		}
+	}
+	return b.String()
+}
+
+// PDF renders the sheet as an A4 page with large type readable from a
+// distance.
+func PDF(s Sheet) ([]byte, error) {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	// Core PDF fonts have no Cyrillic; the Go fonts do.
+	pdf.AddUTF8FontFromBytes("go", "", goregular.TTF)
+	pdf.AddUTF8FontFromBytes("go", "B", gobold.TTF)
+	pdf.AddUTF8FontFromBytes("gomono", "", gomono.TTF)
+	pdf.SetMargins(15, 15, 15)
+	pdf.SetAutoPageBreak(true, 15)
+	pdf.AddPage()
+
+	pdf.SetFont("go", "B", 24)
+	pdf.MultiCell(0, 11, s.Title, "", "L", false)
+	if s.Subtitle != "" {
+		pdf.SetFont("go", "", 14)
+		pdf.MultiCell(0, 8, s.Subtitle, "", "L", false)
+	}
+	for _, section := range s.Sections {
+		pdf.Ln(4)
+		if section.Name != "" {
+			pdf.SetFont("go", "B", 18)
+			pdf.MultiCell(0, 10, section.Name, "B", "L", false)
+			pdf.Ln(2)
+		}
+		for i, t := range section.Tracks {
+			pdf.SetFont("go", "B", 18)
+			pdf.MultiCell(0, 9, fmt.Sprintf("%d. %s", i+1, trackTitle(t)), "", "L", false)
+			if meta := trackMeta(t); meta != "" {
+				pdf.SetFont("gomono", "", 12)
+				pdf.MultiCell(0, 6, "    "+meta, "", "L", false)
+			}
+			if t.Notes != "" {
+				pdf.SetFont("go", "B", 13)
+				pdf.MultiCell(0, 6, "    » "+t.Notes, "", "L", false)
+			}
+			pdf.SetFont("go", "", 11)
+			for _, line := range t.Lineup {
+				pdf.MultiCell(0, 5, "    "+line, "", "L", false)
+			}
+			pdf.Ln(2)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func trackTitle(t Track) string {
+	if t.Artist == "" {
+		return t.Title
+	}
+	return t.Artist + " — " + t.Title
+}
+
+func trackMeta(t Track) string {
+	var parts []string
+	if t.StartsAt != "" {
+		parts = append(parts, t.StartsAt)
+	}
+	if t.Key != "" {
+		parts = append(parts, t.Key)
+	}
+	if t.Duration > 0 {
+		parts = append(parts, fmt.Sprintf("%d:%02d", t.Duration/60, t.Duration%60))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,143 @@
+package stats
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"musicclubbot/backend/internal/helpers"
+	"musicclubbot/backend/internal/telegram"
+)
+
+const (
+	// reportSongs and reportEvents cap the lists in the wrap-up; the counts
+	// still cover everything.
+	reportSongs  = 10
+	reportEvents = 10
+	// reportMembers is how many of the most active members get a mention.
+	reportMembers = 3
+	// reportDays is how far into a month the previous one is still posted.
+	reportDays = 3
+)
+
+// PostMonthlyReport posts the previous month's wrap-up during the first days
+// of the next one in loc; later (e.g. right after the first deploy) it's
+// left to the manual trigger. The month is claimed before sending, so a
+// failed post isn't retried.
+func PostMonthlyReport(ctx context.Context, db *sql.DB, tg *telegram.Client, chatID string, loc *time.Location, now time.Time) error {
+	now = now.In(loc)
+	if now.Day() > reportDays {
+		return nil
+	}
+	month := PreviousMonth(now)
+	var claimed bool
+	err := db.QueryRowContext(ctx, `
+		INSERT INTO monthly_report (month) VALUES ($1) ON CONFLICT DO NOTHING RETURNING TRUE
+	`, month.Format("2006-01-02")).Scan(&claimed)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	text, err := MonthlyReport(ctx, db, month)
+	if err != nil || text == "" {
+		return err
+	}
+	return tg.SendMessage(ctx, chatID, text)
+}
+
+// PreviousMonth returns the first moment of the month before t's, in t's
+// location.
+func PreviousMonth(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month()-1, 1, 0, 0, 0, 0, t.Location())
+}
+
+// MonthlyReport composes the wrap-up for the month starting at month: new
+// songs, events held and the most active members. Empty when nothing
+// happened.
+func MonthlyReport(ctx context.Context, db *sql.DB, month time.Time) (string, error) {
+	end := month.AddDate(0, 1, 0)
+
+	songs, songCount, err := reportLines(ctx, db, `
+		SELECT artist || ' — ' || title, COUNT(*) OVER ()
+		FROM song WHERE created_at >= $1 AND created_at < $2
+		ORDER BY created_at
+		LIMIT $3
+	`, month, end, reportSongs)
+	if err != nil {
+		return "", err
+	}
+	events, eventCount, err := reportLines(ctx, db, `
+		SELECT title || ' (' || to_char(start_at AT TIME ZONE timezone, 'DD.MM') || ')', COUNT(*) OVER ()
+		FROM event
+		WHERE start_at >= $1 AND start_at < $2 AND start_at <= NOW()
+		  AND cancelled_at IS NULL AND deleted_at IS NULL
+		ORDER BY start_at
+		LIMIT $3
+	`, month, end, reportEvents)
+	if err != nil {
+		return "", err
+	}
+	// Same totals as the leaderboard; its days are UTC, close enough here.
+	members, _, err := reportLines(ctx, db, `
+		SELECT u.display_name || ' — ' || SUM(d.songs_joined + d.events_attended + d.songs_proposed), 0
+		FROM member_stats_daily d JOIN app_user u ON u.id = d.user_id
+		WHERE d.day >= $1::date AND d.day < $2::date AND u.deactivated_at IS NULL
+		GROUP BY u.id, u.display_name
+		ORDER BY SUM(d.songs_joined + d.events_attended + d.songs_proposed) DESC, u.display_name
+		LIMIT $3
+	`, month.Format("2006-01-02"), end.Format("2006-01-02"), reportMembers)
+	if err != nil {
+		return "", err
+	}
+	if songCount == 0 && eventCount == 0 && len(members) == 0 {
+		return "", nil
+	}
+
+	var b strings.Builder
+	b.WriteString("📊 <b>Итоги за " + helpers.FormatMonthRU(month) + "</b>\n")
+	section := func(title string, count int, lines []string) {
+		if count == 0 {
+			return
+		}
+		fmt.Fprintf(&b, "\n<b>%s: %d</b>\n", title, count)
+		for _, line := range lines {
+			b.WriteString("• " + html.EscapeString(line) + "\n")
+		}
+		if more := count - len(lines); more > 0 {
+			fmt.Fprintf(&b, "и ещё %d\n", more)
+		}
+	}
+	section("Новые песни", songCount, songs)
+	section("Прошедшие события", eventCount, events)
+	if len(members) > 0 {
+		b.WriteString("\n<b>Самые активные</b>\n")
+		for i, line := range members {
+			fmt.Fprintf(&b, "%d. %s\n", i+1, html.EscapeString(line))
+		}
+	}
+	return strings.TrimRight(b.String(), "\n"), nil
+}
+
+// reportLines runs a query selecting a line of text and the total row count.
+func reportLines(ctx context.Context, db *sql.DB, query string, args ...any) ([]string, int, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+	var lines []string
+	var total int
+	for rows.Next() {
+		var line string
+		if err := rows.Scan(&line, &total); err != nil {
+			return nil, 0, err
+		}
+		lines = append(lines, line)
+	}
+	return lines, total, rows.Err()
+}
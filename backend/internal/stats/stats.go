@@ -0,0 +1,24 @@
+// Package stats keeps the precomputed statistics behind StatsService fresh
+// and composes the monthly wrap-up for the club chat.
+package stats
+
+import (
+	"context"
+	"database/sql"
+)
+
+// views are the materialized views Refresh recomputes.
+var views = []string{
+	"member_stats_daily",
+}
+
+// Refresh recomputes the statistics views. Concurrent refreshes keep them
+// readable while they run.
+func Refresh(ctx context.Context, db *sql.DB) error {
+	for _, view := range views {
+		if _, err := db.ExecContext(ctx, `REFRESH MATERIALIZED VIEW CONCURRENTLY `+view); err != nil {
+			return err
+		}
+	}
+	return nil
+}
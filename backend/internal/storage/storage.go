@@ -0,0 +1,21 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrNotFound is returned by Open when no object is stored under the key.
+var ErrNotFound = errors.New("object not found")
+
+// Store keeps uploaded binary objects (demo recordings, avatars) under
+// slash-separated keys such as "demos/<id>".
+type Store interface {
+	// Put stores everything read from r under key and returns its size.
+	Put(ctx context.Context, key string, r io.Reader) (int64, error)
+	// Open returns the object stored under key. Callers must close it.
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the object; deleting a missing key is not an error.
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,130 @@
+package telegram
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+
+	"musicclubbot/backend/internal/metrics"
+)
+
+// Client calls the Telegram Bot API on behalf of the backend.
+type Client struct {
+	token string
+	http  *http.Client
+}
+
+func New(token string) *Client {
+	return &Client{
+		token: token,
+		http:  &http.Client{Timeout: 30 * time.Second, Transport: otelhttp.NewTransport(http.DefaultTransport)},
+	}
+}
+
+type apiResponse struct {
+	Ok          bool   `json:"ok"`
+	Description string `json:"description"`
+}
+
+// SendMessage posts an HTML-formatted message to a chat or user.
+func (c *Client) SendMessage(ctx context.Context, chatID, text string) error {
+	return c.SendMessageWithButtons(ctx, chatID, text, nil)
+}
+
+// Button is an inline keyboard button; set either CallbackData (handled by
+// the bot) or URL.
+type Button struct {
+	Text         string `json:"text"`
+	CallbackData string `json:"callback_data,omitempty"`
+	URL          string `json:"url,omitempty"`
+}
+
+// SendMessageWithButtons posts an HTML-formatted message with an inline
+// keyboard, one slice per row.
+func (c *Client) SendMessageWithButtons(ctx context.Context, chatID, text string, rows [][]Button) error {
+	msg := map[string]any{
+		"chat_id":                  chatID,
+		"text":                     text,
+		"parse_mode":               "HTML",
+		"disable_web_page_preview": true,
+	}
+	if len(rows) > 0 {
+		msg["reply_markup"] = map[string]any{"inline_keyboard": rows}
+	}
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return c.call(ctx, "sendMessage", "application/json", bytes.NewReader(body))
+}
+
+// SendAudio uploads an audio file to a chat with an optional HTML caption.
+func (c *Client) SendAudio(ctx context.Context, chatID, filename string, audio io.Reader, caption string) error {
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	_ = mw.WriteField("chat_id", chatID)
+	if caption != "" {
+		_ = mw.WriteField("caption", caption)
+		_ = mw.WriteField("parse_mode", "HTML")
+	}
+	part, err := mw.CreateFormFile("audio", filename)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(part, audio); err != nil {
+		return err
+	}
+	if err := mw.Close(); err != nil {
+		return err
+	}
+	return c.call(ctx, "sendAudio", mw.FormDataContentType(), &buf)
+}
+
+// GetMe checks that the token works and the Bot API is reachable.
+func (c *Client) GetMe(ctx context.Context) error {
+	return c.call(ctx, "getMe", "application/json", nil)
+}
+
+func (c *Client) call(ctx context.Context, method, contentType string, body io.Reader) (err error) {
+	start := time.Now()
+	defer func() { metrics.ObserveTelegramCall(method, time.Since(start), err) }()
+	if c.token == "" {
+		return fmt.Errorf("telegram bot token is not configured")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		"https://api.telegram.org/bot"+c.token+"/"+method, body)
+	if err != nil {
+		// Parse errors quote the URL, which holds the token.
+		return fmt.Errorf("call telegram %s: bad request URL", method)
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		// The error carries the URL and with it the bot token.
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return fmt.Errorf("call telegram %s: %w", method, err)
+	}
+	defer resp.Body.Close()
+
+	var result apiResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("decode telegram %s response: %w", method, err)
+	}
+	if !result.Ok {
+		return fmt.Errorf("telegram %s failed: %s", method, result.Description)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+// Package usage counts RPC calls per method and user for the admin usage
+// report. Counts are kept in memory and flushed to api_usage_hourly by a
+// job, so requests never wait on the database for it.
+package usage
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+)
+
+// retention is how long hourly rows are kept.
+const retention = 180 * 24 * time.Hour
+
+type key struct {
+	hour   time.Time
+	method string
+	userID string
+}
+
+type counter struct {
+	calls, errors  int64
+	totalMs, maxMs int64
+}
+
+// Recorder accumulates calls between flushes. Calls recorded after the last
+// flush before shutdown are lost.
+type Recorder struct {
+	mu     sync.Mutex
+	counts map[key]*counter
+}
+
+func NewRecorder() *Recorder {
+	return &Recorder{counts: map[key]*counter{}}
+}
+
+// Record counts a finished call; userID is empty for unauthenticated ones.
+func (r *Recorder) Record(method, userID string, started time.Time, took time.Duration, failed bool) {
+	k := key{hour: started.UTC().Truncate(time.Hour), method: method, userID: userID}
+	ms := took.Milliseconds()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c := r.counts[k]
+	if c == nil {
+		c = &counter{}
+		r.counts[k] = c
+	}
+	c.calls++
+	if failed {
+		c.errors++
+	}
+	c.totalMs += ms
+	c.maxMs = max(c.maxMs, ms)
+}
+
+// Flush adds the counts gathered since the last flush to the table and
+// drops rows past retention. Counts that fail to save are kept for the next
+// flush.
+func (r *Recorder) Flush(ctx context.Context, db *sql.DB) error {
+	r.mu.Lock()
+	counts := r.counts
+	r.counts = map[key]*counter{}
+	r.mu.Unlock()
+
+	if err := save(ctx, db, counts); err != nil {
+		r.mu.Lock()
+		for k, c := range counts {
+			if cur := r.counts[k]; cur != nil {
+				c.calls += cur.calls
+				c.errors += cur.errors
+				c.totalMs += cur.totalMs
+				c.maxMs = max(c.maxMs, cur.maxMs)
+			}
+			r.counts[k] = c
+		}
+		r.mu.Unlock()
+		return err
+	}
+	_, err := db.ExecContext(ctx, `DELETE FROM api_usage_hourly WHERE hour < $1`, time.Now().Add(-retention))
+	return err
+}
+
+func save(ctx context.Context, db *sql.DB, counts map[key]*counter) error {
+	if len(counts) == 0 {
+		return nil
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	// Users deleted since the call count as unauthenticated.
+	stmt, err := tx.PrepareContext(ctx, `
+		INSERT INTO api_usage_hourly (hour, method, user_id, calls, errors, total_ms, max_ms)
+		VALUES ($1, $2, (SELECT id FROM app_user WHERE id = NULLIF($3, '')::uuid), $4, $5, $6, $7)
+		ON CONFLICT (hour, method, user_id) DO UPDATE
+		SET calls = api_usage_hourly.calls + EXCLUDED.calls,
+		    errors = api_usage_hourly.errors + EXCLUDED.errors,
+		    total_ms = api_usage_hourly.total_ms + EXCLUDED.total_ms,
+		    max_ms = GREATEST(api_usage_hourly.max_ms, EXCLUDED.max_ms)
+	`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	for k, c := range counts {
+		if _, err := stmt.ExecContext(ctx, k.hour, k.method, k.userID, c.calls, c.errors, c.totalMs, c.maxMs); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
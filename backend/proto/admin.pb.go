@@ -0,0 +1,2527 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: admin.proto
+
+package proto
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type FlagFilter int32
+
+const (
+	FlagFilter_FLAG_FILTER_ANY FlagFilter = 0
+	FlagFilter_FLAG_FILTER_YES FlagFilter = 1
+	FlagFilter_FLAG_FILTER_NO  FlagFilter = 2
+)
+
+// Enum value maps for FlagFilter.
+var (
+	FlagFilter_name = map[int32]string{
+		0: "FLAG_FILTER_ANY",
+		1: "FLAG_FILTER_YES",
+		2: "FLAG_FILTER_NO",
+	}
+	FlagFilter_value = map[string]int32{
+		"FLAG_FILTER_ANY": 0,
+		"FLAG_FILTER_YES": 1,
+		"FLAG_FILTER_NO":  2,
+	}
+)
+
+func (x FlagFilter) Enum() *FlagFilter {
+	p := new(FlagFilter)
+	*p = x
+	return p
+}
+
+func (x FlagFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FlagFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_admin_proto_enumTypes[0].Descriptor()
+}
+
+func (FlagFilter) Type() protoreflect.EnumType {
+	return &file_admin_proto_enumTypes[0]
+}
+
+func (x FlagFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FlagFilter.Descriptor instead.
+func (FlagFilter) EnumDescriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{0}
+}
+
+type SongRequestStatus int32
+
+const (
+	SongRequestStatus_SONG_REQUEST_STATUS_UNSPECIFIED SongRequestStatus = 0
+	SongRequestStatus_SONG_REQUEST_STATUS_PENDING     SongRequestStatus = 1
+	SongRequestStatus_SONG_REQUEST_STATUS_APPROVED    SongRequestStatus = 2
+	SongRequestStatus_SONG_REQUEST_STATUS_REJECTED    SongRequestStatus = 3
+)
+
+// Enum value maps for SongRequestStatus.
+var (
+	SongRequestStatus_name = map[int32]string{
+		0: "SONG_REQUEST_STATUS_UNSPECIFIED",
+		1: "SONG_REQUEST_STATUS_PENDING",
+		2: "SONG_REQUEST_STATUS_APPROVED",
+		3: "SONG_REQUEST_STATUS_REJECTED",
+	}
+	SongRequestStatus_value = map[string]int32{
+		"SONG_REQUEST_STATUS_UNSPECIFIED": 0,
+		"SONG_REQUEST_STATUS_PENDING":     1,
+		"SONG_REQUEST_STATUS_APPROVED":    2,
+		"SONG_REQUEST_STATUS_REJECTED":    3,
+	}
+)
+
+func (x SongRequestStatus) Enum() *SongRequestStatus {
+	p := new(SongRequestStatus)
+	*p = x
+	return p
+}
+
+func (x SongRequestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SongRequestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_admin_proto_enumTypes[1].Descriptor()
+}
+
+func (SongRequestStatus) Type() protoreflect.EnumType {
+	return &file_admin_proto_enumTypes[1]
+}
+
+func (x SongRequestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SongRequestStatus.Descriptor instead.
+func (SongRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{1}
+}
+
+type ListUsersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional substring filter by username or display name.
+	Query          string     `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	ChatMember     FlagFilter `protobuf:"varint,2,opt,name=chat_member,json=chatMember,proto3,enum=musicclub.admin.FlagFilter" json:"chat_member,omitempty"`
+	TelegramLinked FlagFilter `protobuf:"varint,3,opt,name=telegram_linked,json=telegramLinked,proto3,enum=musicclub.admin.FlagFilter" json:"telegram_linked,omitempty"`
+	// Only users holding this permission, e.g. "edit_events".
+	Permission string `protobuf:"bytes,4,opt,name=permission,proto3" json:"permission,omitempty"`
+	// Pagination cursor (opaque to client).
+	PageToken     string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize      uint32 `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	mi := &file_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersRequest) ProtoMessage() {}
+
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListUsersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetChatMember() FlagFilter {
+	if x != nil {
+		return x.ChatMember
+	}
+	return FlagFilter_FLAG_FILTER_ANY
+}
+
+func (x *ListUsersRequest) GetTelegramLinked() FlagFilter {
+	if x != nil {
+		return x.TelegramLinked
+	}
+	return FlagFilter_FLAG_FILTER_ANY
+}
+
+func (x *ListUsersRequest) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type UserSummary struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	User           *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	IsChatMember   bool                   `protobuf:"varint,2,opt,name=is_chat_member,json=isChatMember,proto3" json:"is_chat_member,omitempty"`
+	TelegramLinked bool                   `protobuf:"varint,3,opt,name=telegram_linked,json=telegramLinked,proto3" json:"telegram_linked,omitempty"`
+	Roles          []string               `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles,omitempty"`
+	// Catalog songs the user plays a role in.
+	SongCount int32 `protobuf:"varint,5,opt,name=song_count,json=songCount,proto3" json:"song_count,omitempty"`
+	// Events the user took part in.
+	EventCount int32 `protobuf:"varint,6,opt,name=event_count,json=eventCount,proto3" json:"event_count,omitempty"`
+	// Unset for users who haven't used the app since it started tracking.
+	LastSeenAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Unset unless the user is currently suspended.
+	Suspension *UserSuspension `protobuf:"bytes,9,opt,name=suspension,proto3" json:"suspension,omitempty"`
+	// The invite code the user registered with, if any.
+	InviteCode    string `protobuf:"bytes,10,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSummary) Reset() {
+	*x = UserSummary{}
+	mi := &file_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSummary) ProtoMessage() {}
+
+func (x *UserSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSummary.ProtoReflect.Descriptor instead.
+func (*UserSummary) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UserSummary) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *UserSummary) GetIsChatMember() bool {
+	if x != nil {
+		return x.IsChatMember
+	}
+	return false
+}
+
+func (x *UserSummary) GetTelegramLinked() bool {
+	if x != nil {
+		return x.TelegramLinked
+	}
+	return false
+}
+
+func (x *UserSummary) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *UserSummary) GetSongCount() int32 {
+	if x != nil {
+		return x.SongCount
+	}
+	return 0
+}
+
+func (x *UserSummary) GetEventCount() int32 {
+	if x != nil {
+		return x.EventCount
+	}
+	return 0
+}
+
+func (x *UserSummary) GetLastSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return nil
+}
+
+func (x *UserSummary) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *UserSummary) GetSuspension() *UserSuspension {
+	if x != nil {
+		return x.Suspension
+	}
+	return nil
+}
+
+func (x *UserSummary) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+type ListUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*UserSummary         `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Users matching the filters across all pages.
+	TotalCount    int32 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersResponse) Reset() {
+	*x = ListUsersResponse{}
+	mi := &file_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersResponse) ProtoMessage() {}
+
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListUsersResponse) GetUsers() []*UserSummary {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListUsersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListUsersResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type ListAuditEntriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional filters: "song", "event", "tracklist", "permissions", "user",
+	// "invite", "suggestion", "song_request", "voting_round", "dues" or
+	// "group", and the id within it (tracklist entries use the event id, invites the code).
+	EntityType string `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	EntityId   string `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	ActorId    string `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	// Optional time window, from inclusive and to exclusive.
+	From *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	// Pagination cursor (opaque to client).
+	PageToken     string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize      uint32 `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEntriesRequest) Reset() {
+	*x = ListAuditEntriesRequest{}
+	mi := &file_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEntriesRequest) ProtoMessage() {}
+
+func (x *ListAuditEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListAuditEntriesRequest) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *ListAuditEntriesRequest) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *ListAuditEntriesRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ListAuditEntriesRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListAuditEntriesRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListAuditEntriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListAuditEntriesRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type AuditEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Unset when the change was made by the system or the user was removed.
+	Actor      *User  `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	EntityType string `protobuf:"bytes,3,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	EntityId   string `protobuf:"bytes,4,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// What was done, e.g. "create", "update", "delete" or "remove_item".
+	Action string `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	// Human-readable details of the change.
+	Summary       string                 `protobuf:"bytes,6,opt,name=summary,proto3" json:"summary,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	mi := &file_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AuditEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetActor() *User {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *AuditEntry) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListAuditEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*AuditEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEntriesResponse) Reset() {
+	*x = ListAuditEntriesResponse{}
+	mi := &file_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEntriesResponse) ProtoMessage() {}
+
+func (x *ListAuditEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListAuditEntriesResponse) GetEntries() []*AuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListAuditEntriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SetUserSuspensionRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// False lifts the suspension.
+	Suspended bool `protobuf:"varint,2,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	// Optional end of the suspension; unset suspends until lifted.
+	Until         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=until,proto3" json:"until,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserSuspensionRequest) Reset() {
+	*x = SetUserSuspensionRequest{}
+	mi := &file_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserSuspensionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserSuspensionRequest) ProtoMessage() {}
+
+func (x *SetUserSuspensionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserSuspensionRequest.ProtoReflect.Descriptor instead.
+func (*SetUserSuspensionRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SetUserSuspensionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserSuspensionRequest) GetSuspended() bool {
+	if x != nil {
+		return x.Suspended
+	}
+	return false
+}
+
+func (x *SetUserSuspensionRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *SetUserSuspensionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UserSuspension struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Suspended     bool                   `protobuf:"varint,2,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	Until         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=until,proto3" json:"until,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSuspension) Reset() {
+	*x = UserSuspension{}
+	mi := &file_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSuspension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSuspension) ProtoMessage() {}
+
+func (x *UserSuspension) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSuspension.ProtoReflect.Descriptor instead.
+func (*UserSuspension) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UserSuspension) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserSuspension) GetSuspended() bool {
+	if x != nil {
+		return x.Suspended
+	}
+	return false
+}
+
+func (x *UserSuspension) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *UserSuspension) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type MergeUsersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The duplicate, deleted after the merge.
+	SourceUserId string `protobuf:"bytes,1,opt,name=source_user_id,json=sourceUserId,proto3" json:"source_user_id,omitempty"`
+	// The account that is kept.
+	TargetUserId  string `protobuf:"bytes,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeUsersRequest) Reset() {
+	*x = MergeUsersRequest{}
+	mi := &file_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeUsersRequest) ProtoMessage() {}
+
+func (x *MergeUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeUsersRequest.ProtoReflect.Descriptor instead.
+func (*MergeUsersRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MergeUsersRequest) GetSourceUserId() string {
+	if x != nil {
+		return x.SourceUserId
+	}
+	return ""
+}
+
+func (x *MergeUsersRequest) GetTargetUserId() string {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return ""
+}
+
+type CreateInviteCodeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// How many registrations the code allows; 0 means single-use.
+	MaxUses uint32 `protobuf:"varint,1,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	// Optional expiry.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Who the code is meant for.
+	Note          string `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInviteCodeRequest) Reset() {
+	*x = CreateInviteCodeRequest{}
+	mi := &file_admin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteCodeRequest) ProtoMessage() {}
+
+func (x *CreateInviteCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteCodeRequest.ProtoReflect.Descriptor instead.
+func (*CreateInviteCodeRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateInviteCodeRequest) GetMaxUses() uint32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *CreateInviteCodeRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CreateInviteCodeRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type InviteCode struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Code      string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	CreatedBy *User                  `protobuf:"bytes,2,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	MaxUses   uint32                 `protobuf:"varint,3,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	Uses      uint32                 `protobuf:"varint,4,opt,name=uses,proto3" json:"uses,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Note      string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	// False once the code is used up, expired or revoked.
+	Active    bool                   `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
+	RevokedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Users who registered with the code.
+	Users         []*User `protobuf:"bytes,10,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteCode) Reset() {
+	*x = InviteCode{}
+	mi := &file_admin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteCode) ProtoMessage() {}
+
+func (x *InviteCode) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteCode.ProtoReflect.Descriptor instead.
+func (*InviteCode) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *InviteCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *InviteCode) GetCreatedBy() *User {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return nil
+}
+
+func (x *InviteCode) GetMaxUses() uint32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *InviteCode) GetUses() uint32 {
+	if x != nil {
+		return x.Uses
+	}
+	return 0
+}
+
+func (x *InviteCode) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *InviteCode) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *InviteCode) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *InviteCode) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+func (x *InviteCode) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *InviteCode) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type InviteCodeRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteCodeRef) Reset() {
+	*x = InviteCodeRef{}
+	mi := &file_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteCodeRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteCodeRef) ProtoMessage() {}
+
+func (x *InviteCodeRef) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteCodeRef.ProtoReflect.Descriptor instead.
+func (*InviteCodeRef) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *InviteCodeRef) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ListInviteCodesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Newest first.
+	Codes         []*InviteCode `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInviteCodesResponse) Reset() {
+	*x = ListInviteCodesResponse{}
+	mi := &file_admin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInviteCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInviteCodesResponse) ProtoMessage() {}
+
+func (x *ListInviteCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInviteCodesResponse.ProtoReflect.Descriptor instead.
+func (*ListInviteCodesResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListInviteCodesResponse) GetCodes() []*InviteCode {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+type PostMonthlyStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "2026-09"; empty means the previous month.
+	Month         string `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostMonthlyStatsRequest) Reset() {
+	*x = PostMonthlyStatsRequest{}
+	mi := &file_admin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostMonthlyStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostMonthlyStatsRequest) ProtoMessage() {}
+
+func (x *PostMonthlyStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostMonthlyStatsRequest.ProtoReflect.Descriptor instead.
+func (*PostMonthlyStatsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PostMonthlyStatsRequest) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+type Suggestion struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Body      string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Unset while the suggestion is open.
+	ResolvedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	ResolvedBy *User                  `protobuf:"bytes,5,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty"`
+	// What came of it, e.g. "trying it at the next jam".
+	Resolution string `protobuf:"bytes,6,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	// Set once posted to the club chat.
+	PublishedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Suggestion) Reset() {
+	*x = Suggestion{}
+	mi := &file_admin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Suggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Suggestion) ProtoMessage() {}
+
+func (x *Suggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Suggestion.ProtoReflect.Descriptor instead.
+func (*Suggestion) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Suggestion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Suggestion) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Suggestion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Suggestion) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return nil
+}
+
+func (x *Suggestion) GetResolvedBy() *User {
+	if x != nil {
+		return x.ResolvedBy
+	}
+	return nil
+}
+
+func (x *Suggestion) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
+func (x *Suggestion) GetPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+type ListSuggestionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// YES lists resolved suggestions only, NO open ones only.
+	Resolved FlagFilter `protobuf:"varint,1,opt,name=resolved,proto3,enum=musicclub.admin.FlagFilter" json:"resolved,omitempty"`
+	// Pagination cursor (opaque to client).
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize      uint32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSuggestionsRequest) Reset() {
+	*x = ListSuggestionsRequest{}
+	mi := &file_admin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSuggestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSuggestionsRequest) ProtoMessage() {}
+
+func (x *ListSuggestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSuggestionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSuggestionsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListSuggestionsRequest) GetResolved() FlagFilter {
+	if x != nil {
+		return x.Resolved
+	}
+	return FlagFilter_FLAG_FILTER_ANY
+}
+
+func (x *ListSuggestionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListSuggestionsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListSuggestionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestions   []*Suggestion          `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSuggestionsResponse) Reset() {
+	*x = ListSuggestionsResponse{}
+	mi := &file_admin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSuggestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSuggestionsResponse) ProtoMessage() {}
+
+func (x *ListSuggestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSuggestionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSuggestionsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListSuggestionsResponse) GetSuggestions() []*Suggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+func (x *ListSuggestionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListSuggestionsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type ResolveSuggestionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// False reopens the suggestion and clears the resolution.
+	Resolved      bool   `protobuf:"varint,2,opt,name=resolved,proto3" json:"resolved,omitempty"`
+	Resolution    string `protobuf:"bytes,3,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveSuggestionRequest) Reset() {
+	*x = ResolveSuggestionRequest{}
+	mi := &file_admin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveSuggestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveSuggestionRequest) ProtoMessage() {}
+
+func (x *ResolveSuggestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveSuggestionRequest.ProtoReflect.Descriptor instead.
+func (*ResolveSuggestionRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ResolveSuggestionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ResolveSuggestionRequest) GetResolved() bool {
+	if x != nil {
+		return x.Resolved
+	}
+	return false
+}
+
+func (x *ResolveSuggestionRequest) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
+type SuggestionRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestionRef) Reset() {
+	*x = SuggestionRef{}
+	mi := &file_admin_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestionRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestionRef) ProtoMessage() {}
+
+func (x *SuggestionRef) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestionRef.ProtoReflect.Descriptor instead.
+func (*SuggestionRef) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SuggestionRef) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type SongRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Kind guessed from the URL, UNKNOWN for other sites.
+	Link    *SongLink `protobuf:"bytes,2,opt,name=link,proto3" json:"link,omitempty"`
+	Comment string    `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	// Telegram name of whoever asked, for context.
+	RequesterName       string                 `protobuf:"bytes,4,opt,name=requester_name,json=requesterName,proto3" json:"requester_name,omitempty"`
+	RequesterTelegramId uint64                 `protobuf:"varint,5,opt,name=requester_telegram_id,json=requesterTelegramId,proto3" json:"requester_telegram_id,omitempty"`
+	Status              SongRequestStatus      `protobuf:"varint,6,opt,name=status,proto3,enum=musicclub.admin.SongRequestStatus" json:"status,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ReviewedAt          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	ReviewedBy          *User                  `protobuf:"bytes,9,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	// Reason given on rejection.
+	ReviewNote string `protobuf:"bytes,10,opt,name=review_note,json=reviewNote,proto3" json:"review_note,omitempty"`
+	// The catalog song an approved request became.
+	SongId        string `protobuf:"bytes,11,opt,name=song_id,json=songId,proto3" json:"song_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SongRequest) Reset() {
+	*x = SongRequest{}
+	mi := &file_admin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SongRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SongRequest) ProtoMessage() {}
+
+func (x *SongRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SongRequest.ProtoReflect.Descriptor instead.
+func (*SongRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SongRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SongRequest) GetLink() *SongLink {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+func (x *SongRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *SongRequest) GetRequesterName() string {
+	if x != nil {
+		return x.RequesterName
+	}
+	return ""
+}
+
+func (x *SongRequest) GetRequesterTelegramId() uint64 {
+	if x != nil {
+		return x.RequesterTelegramId
+	}
+	return 0
+}
+
+func (x *SongRequest) GetStatus() SongRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SongRequestStatus_SONG_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *SongRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SongRequest) GetReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return nil
+}
+
+func (x *SongRequest) GetReviewedBy() *User {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return nil
+}
+
+func (x *SongRequest) GetReviewNote() string {
+	if x != nil {
+		return x.ReviewNote
+	}
+	return ""
+}
+
+func (x *SongRequest) GetSongId() string {
+	if x != nil {
+		return x.SongId
+	}
+	return ""
+}
+
+type ListSongRequestsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unspecified lists every status.
+	Status SongRequestStatus `protobuf:"varint,1,opt,name=status,proto3,enum=musicclub.admin.SongRequestStatus" json:"status,omitempty"`
+	// Pagination cursor (opaque to client).
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize      uint32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSongRequestsRequest) Reset() {
+	*x = ListSongRequestsRequest{}
+	mi := &file_admin_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSongRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSongRequestsRequest) ProtoMessage() {}
+
+func (x *ListSongRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSongRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListSongRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListSongRequestsRequest) GetStatus() SongRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SongRequestStatus_SONG_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *ListSongRequestsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListSongRequestsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListSongRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requests      []*SongRequest         `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSongRequestsResponse) Reset() {
+	*x = ListSongRequestsResponse{}
+	mi := &file_admin_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSongRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSongRequestsResponse) ProtoMessage() {}
+
+func (x *ListSongRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSongRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListSongRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListSongRequestsResponse) GetRequests() []*SongRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *ListSongRequestsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListSongRequestsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type ApproveSongRequestRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The song to create; title and artist are required. Without a link the
+	// requested one is used.
+	Song          *CreateSongRequest `protobuf:"bytes,2,opt,name=song,proto3" json:"song,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveSongRequestRequest) Reset() {
+	*x = ApproveSongRequestRequest{}
+	mi := &file_admin_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveSongRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveSongRequestRequest) ProtoMessage() {}
+
+func (x *ApproveSongRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveSongRequestRequest.ProtoReflect.Descriptor instead.
+func (*ApproveSongRequestRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ApproveSongRequestRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApproveSongRequestRequest) GetSong() *CreateSongRequest {
+	if x != nil {
+		return x.Song
+	}
+	return nil
+}
+
+type RejectSongRequestRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Optional reason, passed on to the requester.
+	Note          string `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectSongRequestRequest) Reset() {
+	*x = RejectSongRequestRequest{}
+	mi := &file_admin_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectSongRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectSongRequestRequest) ProtoMessage() {}
+
+func (x *RejectSongRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectSongRequestRequest.ProtoReflect.Descriptor instead.
+func (*RejectSongRequestRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RejectSongRequestRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RejectSongRequestRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type GetApiUsageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Defaults to the last 30 days. Counted in whole hours; kept for 180
+	// days.
+	From *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// Exclusive; defaults to now.
+	To *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// Only this user's calls.
+	UserId        string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApiUsageRequest) Reset() {
+	*x = GetApiUsageRequest{}
+	mi := &file_admin_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApiUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApiUsageRequest) ProtoMessage() {}
+
+func (x *GetApiUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApiUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetApiUsageRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetApiUsageRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetApiUsageRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetApiUsageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type MethodUsage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Full method name, e.g. "/musicclub.song.SongService/ListSongs".
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Calls  int64  `protobuf:"varint,2,opt,name=calls,proto3" json:"calls,omitempty"`
+	// Calls that returned an error, authorization failures included.
+	Errors int64 `protobuf:"varint,3,opt,name=errors,proto3" json:"errors,omitempty"`
+	// errors / calls.
+	ErrorRate        float64 `protobuf:"fixed64,4,opt,name=error_rate,json=errorRate,proto3" json:"error_rate,omitempty"`
+	AverageLatencyMs float64 `protobuf:"fixed64,5,opt,name=average_latency_ms,json=averageLatencyMs,proto3" json:"average_latency_ms,omitempty"`
+	// Slowest call within the range.
+	MaxLatencyMs int64 `protobuf:"varint,6,opt,name=max_latency_ms,json=maxLatencyMs,proto3" json:"max_latency_ms,omitempty"`
+	// Signed-in users who called the method.
+	Users         int32 `protobuf:"varint,7,opt,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MethodUsage) Reset() {
+	*x = MethodUsage{}
+	mi := &file_admin_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MethodUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MethodUsage) ProtoMessage() {}
+
+func (x *MethodUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MethodUsage.ProtoReflect.Descriptor instead.
+func (*MethodUsage) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MethodUsage) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *MethodUsage) GetCalls() int64 {
+	if x != nil {
+		return x.Calls
+	}
+	return 0
+}
+
+func (x *MethodUsage) GetErrors() int64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *MethodUsage) GetErrorRate() float64 {
+	if x != nil {
+		return x.ErrorRate
+	}
+	return 0
+}
+
+func (x *MethodUsage) GetAverageLatencyMs() float64 {
+	if x != nil {
+		return x.AverageLatencyMs
+	}
+	return 0
+}
+
+func (x *MethodUsage) GetMaxLatencyMs() int64 {
+	if x != nil {
+		return x.MaxLatencyMs
+	}
+	return 0
+}
+
+func (x *MethodUsage) GetUsers() int32 {
+	if x != nil {
+		return x.Users
+	}
+	return 0
+}
+
+type UserUsage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	User  *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Calls int64                  `protobuf:"varint,2,opt,name=calls,proto3" json:"calls,omitempty"`
+	// Distinct methods called.
+	Methods       int32 `protobuf:"varint,3,opt,name=methods,proto3" json:"methods,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserUsage) Reset() {
+	*x = UserUsage{}
+	mi := &file_admin_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserUsage) ProtoMessage() {}
+
+func (x *UserUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserUsage.ProtoReflect.Descriptor instead.
+func (*UserUsage) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UserUsage) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *UserUsage) GetCalls() int64 {
+	if x != nil {
+		return x.Calls
+	}
+	return 0
+}
+
+func (x *UserUsage) GetMethods() int32 {
+	if x != nil {
+		return x.Methods
+	}
+	return 0
+}
+
+type ApiUsage struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	From        *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	TotalCalls  int64                  `protobuf:"varint,3,opt,name=total_calls,json=totalCalls,proto3" json:"total_calls,omitempty"`
+	TotalErrors int64                  `protobuf:"varint,4,opt,name=total_errors,json=totalErrors,proto3" json:"total_errors,omitempty"`
+	// Signed-in users with any call.
+	ActiveUsers int32 `protobuf:"varint,5,opt,name=active_users,json=activeUsers,proto3" json:"active_users,omitempty"`
+	// Most called first.
+	Methods []*MethodUsage `protobuf:"bytes,6,rep,name=methods,proto3" json:"methods,omitempty"`
+	// Most active first, at most 20.
+	TopUsers      []*UserUsage `protobuf:"bytes,7,rep,name=top_users,json=topUsers,proto3" json:"top_users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiUsage) Reset() {
+	*x = ApiUsage{}
+	mi := &file_admin_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiUsage) ProtoMessage() {}
+
+func (x *ApiUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiUsage.ProtoReflect.Descriptor instead.
+func (*ApiUsage) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ApiUsage) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ApiUsage) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ApiUsage) GetTotalCalls() int64 {
+	if x != nil {
+		return x.TotalCalls
+	}
+	return 0
+}
+
+func (x *ApiUsage) GetTotalErrors() int64 {
+	if x != nil {
+		return x.TotalErrors
+	}
+	return 0
+}
+
+func (x *ApiUsage) GetActiveUsers() int32 {
+	if x != nil {
+		return x.ActiveUsers
+	}
+	return 0
+}
+
+func (x *ApiUsage) GetMethods() []*MethodUsage {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+func (x *ApiUsage) GetTopUsers() []*UserUsage {
+	if x != nil {
+		return x.TopUsers
+	}
+	return nil
+}
+
+var File_admin_proto protoreflect.FileDescriptor
+
+const file_admin_proto_rawDesc = "" +
+	"\n" +
+	"\vadmin.proto\x12\x0fmusicclub.admin\x1a\x1bbuf/validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\n" +
+	"song.proto\x1a\n" +
+	"user.proto\"\x92\x02\n" +
+	"\x10ListUsersRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12<\n" +
+	"\vchat_member\x18\x02 \x01(\x0e2\x1b.musicclub.admin.FlagFilterR\n" +
+	"chatMember\x12D\n" +
+	"\x0ftelegram_linked\x18\x03 \x01(\x0e2\x1b.musicclub.admin.FlagFilterR\x0etelegramLinked\x12\x1e\n" +
+	"\n" +
+	"permission\x18\x04 \x01(\tR\n" +
+	"permission\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\x12%\n" +
+	"\tpage_size\x18\x06 \x01(\rB\b\xbaH\x05*\x03\x18\xc8\x01R\bpageSize\"\xb7\x03\n" +
+	"\vUserSummary\x12(\n" +
+	"\x04user\x18\x01 \x01(\v2\x14.musicclub.user.UserR\x04user\x12$\n" +
+	"\x0eis_chat_member\x18\x02 \x01(\bR\fisChatMember\x12'\n" +
+	"\x0ftelegram_linked\x18\x03 \x01(\bR\x0etelegramLinked\x12\x14\n" +
+	"\x05roles\x18\x04 \x03(\tR\x05roles\x12\x1d\n" +
+	"\n" +
+	"song_count\x18\x05 \x01(\x05R\tsongCount\x12\x1f\n" +
+	"\vevent_count\x18\x06 \x01(\x05R\n" +
+	"eventCount\x12<\n" +
+	"\flast_seen_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastSeenAt\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12?\n" +
+	"\n" +
+	"suspension\x18\t \x01(\v2\x1f.musicclub.admin.UserSuspensionR\n" +
+	"suspension\x12\x1f\n" +
+	"\vinvite_code\x18\n" +
+	" \x01(\tR\n" +
+	"inviteCode\"\x90\x01\n" +
+	"\x11ListUsersResponse\x122\n" +
+	"\x05users\x18\x01 \x03(\v2\x1c.musicclub.admin.UserSummaryR\x05users\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"\x94\x02\n" +
+	"\x17ListAuditEntriesRequest\x12\x1f\n" +
+	"\ventity_type\x18\x01 \x01(\tR\n" +
+	"entityType\x12\x1b\n" +
+	"\tentity_id\x18\x02 \x01(\tR\bentityId\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\tR\aactorId\x12.\n" +
+	"\x04from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\x12%\n" +
+	"\tpage_size\x18\a \x01(\rB\b\xbaH\x05*\x03\x18\xc8\x01R\bpageSize\"\xf3\x01\n" +
+	"\n" +
+	"AuditEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12*\n" +
+	"\x05actor\x18\x02 \x01(\v2\x14.musicclub.user.UserR\x05actor\x12\x1f\n" +
+	"\ventity_type\x18\x03 \x01(\tR\n" +
+	"entityType\x12\x1b\n" +
+	"\tentity_id\x18\x04 \x01(\tR\bentityId\x12\x16\n" +
+	"\x06action\x18\x05 \x01(\tR\x06action\x12\x18\n" +
+	"\asummary\x18\x06 \x01(\tR\asummary\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"y\n" +
+	"\x18ListAuditEntriesResponse\x125\n" +
+	"\aentries\x18\x01 \x03(\v2\x1b.musicclub.admin.AuditEntryR\aentries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x9b\x01\n" +
+	"\x18SetUserSuspensionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1c\n" +
+	"\tsuspended\x18\x02 \x01(\bR\tsuspended\x120\n" +
+	"\x05until\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\x91\x01\n" +
+	"\x0eUserSuspension\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1c\n" +
+	"\tsuspended\x18\x02 \x01(\bR\tsuspended\x120\n" +
+	"\x05until\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"s\n" +
+	"\x11MergeUsersRequest\x12.\n" +
+	"\x0esource_user_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\fsourceUserId\x12.\n" +
+	"\x0etarget_user_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\ftargetUserId\"\x83\x01\n" +
+	"\x17CreateInviteCodeRequest\x12\x19\n" +
+	"\bmax_uses\x18\x01 \x01(\rR\amaxUses\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"\x8d\x03\n" +
+	"\n" +
+	"InviteCode\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x123\n" +
+	"\n" +
+	"created_by\x18\x02 \x01(\v2\x14.musicclub.user.UserR\tcreatedBy\x12\x19\n" +
+	"\bmax_uses\x18\x03 \x01(\rR\amaxUses\x12\x12\n" +
+	"\x04uses\x18\x04 \x01(\rR\x04uses\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04note\x12\x16\n" +
+	"\x06active\x18\a \x01(\bR\x06active\x129\n" +
+	"\n" +
+	"revoked_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12*\n" +
+	"\x05users\x18\n" +
+	" \x03(\v2\x14.musicclub.user.UserR\x05users\"#\n" +
+	"\rInviteCodeRef\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"L\n" +
+	"\x17ListInviteCodesResponse\x121\n" +
+	"\x05codes\x18\x01 \x03(\v2\x1b.musicclub.admin.InviteCodeR\x05codes\"/\n" +
+	"\x17PostMonthlyStatsRequest\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\tR\x05month\"\xbe\x02\n" +
+	"\n" +
+	"Suggestion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12;\n" +
+	"\vresolved_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"resolvedAt\x125\n" +
+	"\vresolved_by\x18\x05 \x01(\v2\x14.musicclub.user.UserR\n" +
+	"resolvedBy\x12\x1e\n" +
+	"\n" +
+	"resolution\x18\x06 \x01(\tR\n" +
+	"resolution\x12=\n" +
+	"\fpublished_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vpublishedAt\"\x97\x01\n" +
+	"\x16ListSuggestionsRequest\x127\n" +
+	"\bresolved\x18\x01 \x01(\x0e2\x1b.musicclub.admin.FlagFilterR\bresolved\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12%\n" +
+	"\tpage_size\x18\x03 \x01(\rB\b\xbaH\x05*\x03\x18\xc8\x01R\bpageSize\"\xa1\x01\n" +
+	"\x17ListSuggestionsResponse\x12=\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\x1b.musicclub.admin.SuggestionR\vsuggestions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"f\n" +
+	"\x18ResolveSuggestionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bresolved\x18\x02 \x01(\bR\bresolved\x12\x1e\n" +
+	"\n" +
+	"resolution\x18\x03 \x01(\tR\n" +
+	"resolution\"\x1f\n" +
+	"\rSuggestionRef\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xe5\x03\n" +
+	"\vSongRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12,\n" +
+	"\x04link\x18\x02 \x01(\v2\x18.musicclub.song.SongLinkR\x04link\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\x12%\n" +
+	"\x0erequester_name\x18\x04 \x01(\tR\rrequesterName\x122\n" +
+	"\x15requester_telegram_id\x18\x05 \x01(\x04R\x13requesterTelegramId\x12:\n" +
+	"\x06status\x18\x06 \x01(\x0e2\".musicclub.admin.SongRequestStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12;\n" +
+	"\vreviewed_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reviewedAt\x125\n" +
+	"\vreviewed_by\x18\t \x01(\v2\x14.musicclub.user.UserR\n" +
+	"reviewedBy\x12\x1f\n" +
+	"\vreview_note\x18\n" +
+	" \x01(\tR\n" +
+	"reviewNote\x12\x17\n" +
+	"\asong_id\x18\v \x01(\tR\x06songId\"\x9b\x01\n" +
+	"\x17ListSongRequestsRequest\x12:\n" +
+	"\x06status\x18\x01 \x01(\x0e2\".musicclub.admin.SongRequestStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12%\n" +
+	"\tpage_size\x18\x03 \x01(\rB\b\xbaH\x05*\x03\x18\xc8\x01R\bpageSize\"\x9d\x01\n" +
+	"\x18ListSongRequestsResponse\x128\n" +
+	"\brequests\x18\x01 \x03(\v2\x1c.musicclub.admin.SongRequestR\brequests\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"b\n" +
+	"\x19ApproveSongRequestRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x125\n" +
+	"\x04song\x18\x02 \x01(\v2!.musicclub.song.CreateSongRequestR\x04song\">\n" +
+	"\x18RejectSongRequestRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04note\x18\x02 \x01(\tR\x04note\"\x89\x01\n" +
+	"\x12GetApiUsageRequest\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"\xdc\x01\n" +
+	"\vMethodUsage\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\x12\x14\n" +
+	"\x05calls\x18\x02 \x01(\x03R\x05calls\x12\x16\n" +
+	"\x06errors\x18\x03 \x01(\x03R\x06errors\x12\x1d\n" +
+	"\n" +
+	"error_rate\x18\x04 \x01(\x01R\terrorRate\x12,\n" +
+	"\x12average_latency_ms\x18\x05 \x01(\x01R\x10averageLatencyMs\x12$\n" +
+	"\x0emax_latency_ms\x18\x06 \x01(\x03R\fmaxLatencyMs\x12\x14\n" +
+	"\x05users\x18\a \x01(\x05R\x05users\"e\n" +
+	"\tUserUsage\x12(\n" +
+	"\x04user\x18\x01 \x01(\v2\x14.musicclub.user.UserR\x04user\x12\x14\n" +
+	"\x05calls\x18\x02 \x01(\x03R\x05calls\x12\x18\n" +
+	"\amethods\x18\x03 \x01(\x05R\amethods\"\xbe\x02\n" +
+	"\bApiUsage\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x1f\n" +
+	"\vtotal_calls\x18\x03 \x01(\x03R\n" +
+	"totalCalls\x12!\n" +
+	"\ftotal_errors\x18\x04 \x01(\x03R\vtotalErrors\x12!\n" +
+	"\factive_users\x18\x05 \x01(\x05R\vactiveUsers\x126\n" +
+	"\amethods\x18\x06 \x03(\v2\x1c.musicclub.admin.MethodUsageR\amethods\x127\n" +
+	"\ttop_users\x18\a \x03(\v2\x1a.musicclub.admin.UserUsageR\btopUsers*J\n" +
+	"\n" +
+	"FlagFilter\x12\x13\n" +
+	"\x0fFLAG_FILTER_ANY\x10\x00\x12\x13\n" +
+	"\x0fFLAG_FILTER_YES\x10\x01\x12\x12\n" +
+	"\x0eFLAG_FILTER_NO\x10\x02*\x9d\x01\n" +
+	"\x11SongRequestStatus\x12#\n" +
+	"\x1fSONG_REQUEST_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bSONG_REQUEST_STATUS_PENDING\x10\x01\x12 \n" +
+	"\x1cSONG_REQUEST_STATUS_APPROVED\x10\x02\x12 \n" +
+	"\x1cSONG_REQUEST_STATUS_REJECTED\x10\x032\xd6\n" +
+	"\n" +
+	"\fAdminService\x12R\n" +
+	"\tListUsers\x12!.musicclub.admin.ListUsersRequest\x1a\".musicclub.admin.ListUsersResponse\x12g\n" +
+	"\x10ListAuditEntries\x12(.musicclub.admin.ListAuditEntriesRequest\x1a).musicclub.admin.ListAuditEntriesResponse\x12_\n" +
+	"\x11SetUserSuspension\x12).musicclub.admin.SetUserSuspensionRequest\x1a\x1f.musicclub.admin.UserSuspension\x12F\n" +
+	"\n" +
+	"MergeUsers\x12\".musicclub.admin.MergeUsersRequest\x1a\x14.musicclub.user.User\x12Y\n" +
+	"\x10CreateInviteCode\x12(.musicclub.admin.CreateInviteCodeRequest\x1a\x1b.musicclub.admin.InviteCode\x12S\n" +
+	"\x0fListInviteCodes\x12\x16.google.protobuf.Empty\x1a(.musicclub.admin.ListInviteCodesResponse\x12O\n" +
+	"\x10RevokeInviteCode\x12\x1e.musicclub.admin.InviteCodeRef\x1a\x1b.musicclub.admin.InviteCode\x12T\n" +
+	"\x10PostMonthlyStats\x12(.musicclub.admin.PostMonthlyStatsRequest\x1a\x16.google.protobuf.Empty\x12d\n" +
+	"\x0fListSuggestions\x12'.musicclub.admin.ListSuggestionsRequest\x1a(.musicclub.admin.ListSuggestionsResponse\x12[\n" +
+	"\x11ResolveSuggestion\x12).musicclub.admin.ResolveSuggestionRequest\x1a\x1b.musicclub.admin.Suggestion\x12P\n" +
+	"\x11PublishSuggestion\x12\x1e.musicclub.admin.SuggestionRef\x1a\x1b.musicclub.admin.Suggestion\x12g\n" +
+	"\x10ListSongRequests\x12(.musicclub.admin.ListSongRequestsRequest\x1a).musicclub.admin.ListSongRequestsResponse\x12^\n" +
+	"\x12ApproveSongRequest\x12*.musicclub.admin.ApproveSongRequestRequest\x1a\x1c.musicclub.admin.SongRequest\x12\\\n" +
+	"\x11RejectSongRequest\x12).musicclub.admin.RejectSongRequestRequest\x1a\x1c.musicclub.admin.SongRequest\x12M\n" +
+	"\vGetApiUsage\x12#.musicclub.admin.GetApiUsageRequest\x1a\x19.musicclub.admin.ApiUsageB\x1cZ\x1amusicclubbot/backend/protob\x06proto3"
+
+var (
+	file_admin_proto_rawDescOnce sync.Once
+	file_admin_proto_rawDescData []byte
+)
+
+func file_admin_proto_rawDescGZIP() []byte {
+	file_admin_proto_rawDescOnce.Do(func() {
+		file_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_admin_proto_rawDesc), len(file_admin_proto_rawDesc)))
+	})
+	return file_admin_proto_rawDescData
+}
+
+var file_admin_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_admin_proto_goTypes = []any{
+	(FlagFilter)(0),                   // 0: musicclub.admin.FlagFilter
+	(SongRequestStatus)(0),            // 1: musicclub.admin.SongRequestStatus
+	(*ListUsersRequest)(nil),          // 2: musicclub.admin.ListUsersRequest
+	(*UserSummary)(nil),               // 3: musicclub.admin.UserSummary
+	(*ListUsersResponse)(nil),         // 4: musicclub.admin.ListUsersResponse
+	(*ListAuditEntriesRequest)(nil),   // 5: musicclub.admin.ListAuditEntriesRequest
+	(*AuditEntry)(nil),                // 6: musicclub.admin.AuditEntry
+	(*ListAuditEntriesResponse)(nil),  // 7: musicclub.admin.ListAuditEntriesResponse
+	(*SetUserSuspensionRequest)(nil),  // 8: musicclub.admin.SetUserSuspensionRequest
+	(*UserSuspension)(nil),            // 9: musicclub.admin.UserSuspension
+	(*MergeUsersRequest)(nil),         // 10: musicclub.admin.MergeUsersRequest
+	(*CreateInviteCodeRequest)(nil),   // 11: musicclub.admin.CreateInviteCodeRequest
+	(*InviteCode)(nil),                // 12: musicclub.admin.InviteCode
+	(*InviteCodeRef)(nil),             // 13: musicclub.admin.InviteCodeRef
+	(*ListInviteCodesResponse)(nil),   // 14: musicclub.admin.ListInviteCodesResponse
+	(*PostMonthlyStatsRequest)(nil),   // 15: musicclub.admin.PostMonthlyStatsRequest
+	(*Suggestion)(nil),                // 16: musicclub.admin.Suggestion
+	(*ListSuggestionsRequest)(nil),    // 17: musicclub.admin.ListSuggestionsRequest
+	(*ListSuggestionsResponse)(nil),   // 18: musicclub.admin.ListSuggestionsResponse
+	(*ResolveSuggestionRequest)(nil),  // 19: musicclub.admin.ResolveSuggestionRequest
+	(*SuggestionRef)(nil),             // 20: musicclub.admin.SuggestionRef
+	(*SongRequest)(nil),               // 21: musicclub.admin.SongRequest
+	(*ListSongRequestsRequest)(nil),   // 22: musicclub.admin.ListSongRequestsRequest
+	(*ListSongRequestsResponse)(nil),  // 23: musicclub.admin.ListSongRequestsResponse
+	(*ApproveSongRequestRequest)(nil), // 24: musicclub.admin.ApproveSongRequestRequest
+	(*RejectSongRequestRequest)(nil),  // 25: musicclub.admin.RejectSongRequestRequest
+	(*GetApiUsageRequest)(nil),        // 26: musicclub.admin.GetApiUsageRequest
+	(*MethodUsage)(nil),               // 27: musicclub.admin.MethodUsage
+	(*UserUsage)(nil),                 // 28: musicclub.admin.UserUsage
+	(*ApiUsage)(nil),                  // 29: musicclub.admin.ApiUsage
+	(*User)(nil),                      // 30: musicclub.user.User
+	(*timestamppb.Timestamp)(nil),     // 31: google.protobuf.Timestamp
+	(*SongLink)(nil),                  // 32: musicclub.song.SongLink
+	(*CreateSongRequest)(nil),         // 33: musicclub.song.CreateSongRequest
+	(*emptypb.Empty)(nil),             // 34: google.protobuf.Empty
+}
+var file_admin_proto_depIdxs = []int32{
+	0,  // 0: musicclub.admin.ListUsersRequest.chat_member:type_name -> musicclub.admin.FlagFilter
+	0,  // 1: musicclub.admin.ListUsersRequest.telegram_linked:type_name -> musicclub.admin.FlagFilter
+	30, // 2: musicclub.admin.UserSummary.user:type_name -> musicclub.user.User
+	31, // 3: musicclub.admin.UserSummary.last_seen_at:type_name -> google.protobuf.Timestamp
+	31, // 4: musicclub.admin.UserSummary.created_at:type_name -> google.protobuf.Timestamp
+	9,  // 5: musicclub.admin.UserSummary.suspension:type_name -> musicclub.admin.UserSuspension
+	3,  // 6: musicclub.admin.ListUsersResponse.users:type_name -> musicclub.admin.UserSummary
+	31, // 7: musicclub.admin.ListAuditEntriesRequest.from:type_name -> google.protobuf.Timestamp
+	31, // 8: musicclub.admin.ListAuditEntriesRequest.to:type_name -> google.protobuf.Timestamp
+	30, // 9: musicclub.admin.AuditEntry.actor:type_name -> musicclub.user.User
+	31, // 10: musicclub.admin.AuditEntry.created_at:type_name -> google.protobuf.Timestamp
+	6,  // 11: musicclub.admin.ListAuditEntriesResponse.entries:type_name -> musicclub.admin.AuditEntry
+	31, // 12: musicclub.admin.SetUserSuspensionRequest.until:type_name -> google.protobuf.Timestamp
+	31, // 13: musicclub.admin.UserSuspension.until:type_name -> google.protobuf.Timestamp
+	31, // 14: musicclub.admin.CreateInviteCodeRequest.expires_at:type_name -> google.protobuf.Timestamp
+	30, // 15: musicclub.admin.InviteCode.created_by:type_name -> musicclub.user.User
+	31, // 16: musicclub.admin.InviteCode.expires_at:type_name -> google.protobuf.Timestamp
+	31, // 17: musicclub.admin.InviteCode.revoked_at:type_name -> google.protobuf.Timestamp
+	31, // 18: musicclub.admin.InviteCode.created_at:type_name -> google.protobuf.Timestamp
+	30, // 19: musicclub.admin.InviteCode.users:type_name -> musicclub.user.User
+	12, // 20: musicclub.admin.ListInviteCodesResponse.codes:type_name -> musicclub.admin.InviteCode
+	31, // 21: musicclub.admin.Suggestion.created_at:type_name -> google.protobuf.Timestamp
+	31, // 22: musicclub.admin.Suggestion.resolved_at:type_name -> google.protobuf.Timestamp
+	30, // 23: musicclub.admin.Suggestion.resolved_by:type_name -> musicclub.user.User
+	31, // 24: musicclub.admin.Suggestion.published_at:type_name -> google.protobuf.Timestamp
+	0,  // 25: musicclub.admin.ListSuggestionsRequest.resolved:type_name -> musicclub.admin.FlagFilter
+	16, // 26: musicclub.admin.ListSuggestionsResponse.suggestions:type_name -> musicclub.admin.Suggestion
+	32, // 27: musicclub.admin.SongRequest.link:type_name -> musicclub.song.SongLink
+	1,  // 28: musicclub.admin.SongRequest.status:type_name -> musicclub.admin.SongRequestStatus
+	31, // 29: musicclub.admin.SongRequest.created_at:type_name -> google.protobuf.Timestamp
+	31, // 30: musicclub.admin.SongRequest.reviewed_at:type_name -> google.protobuf.Timestamp
+	30, // 31: musicclub.admin.SongRequest.reviewed_by:type_name -> musicclub.user.User
+	1,  // 32: musicclub.admin.ListSongRequestsRequest.status:type_name -> musicclub.admin.SongRequestStatus
+	21, // 33: musicclub.admin.ListSongRequestsResponse.requests:type_name -> musicclub.admin.SongRequest
+	33, // 34: musicclub.admin.ApproveSongRequestRequest.song:type_name -> musicclub.song.CreateSongRequest
+	31, // 35: musicclub.admin.GetApiUsageRequest.from:type_name -> google.protobuf.Timestamp
+	31, // 36: musicclub.admin.GetApiUsageRequest.to:type_name -> google.protobuf.Timestamp
+	30, // 37: musicclub.admin.UserUsage.user:type_name -> musicclub.user.User
+	31, // 38: musicclub.admin.ApiUsage.from:type_name -> google.protobuf.Timestamp
+	31, // 39: musicclub.admin.ApiUsage.to:type_name -> google.protobuf.Timestamp
+	27, // 40: musicclub.admin.ApiUsage.methods:type_name -> musicclub.admin.MethodUsage
+	28, // 41: musicclub.admin.ApiUsage.top_users:type_name -> musicclub.admin.UserUsage
+	2,  // 42: musicclub.admin.AdminService.ListUsers:input_type -> musicclub.admin.ListUsersRequest
+	5,  // 43: musicclub.admin.AdminService.ListAuditEntries:input_type -> musicclub.admin.ListAuditEntriesRequest
+	8,  // 44: musicclub.admin.AdminService.SetUserSuspension:input_type -> musicclub.admin.SetUserSuspensionRequest
+	10, // 45: musicclub.admin.AdminService.MergeUsers:input_type -> musicclub.admin.MergeUsersRequest
+	11, // 46: musicclub.admin.AdminService.CreateInviteCode:input_type -> musicclub.admin.CreateInviteCodeRequest
+	34, // 47: musicclub.admin.AdminService.ListInviteCodes:input_type -> google.protobuf.Empty
+	13, // 48: musicclub.admin.AdminService.RevokeInviteCode:input_type -> musicclub.admin.InviteCodeRef
+	15, // 49: musicclub.admin.AdminService.PostMonthlyStats:input_type -> musicclub.admin.PostMonthlyStatsRequest
+	17, // 50: musicclub.admin.AdminService.ListSuggestions:input_type -> musicclub.admin.ListSuggestionsRequest
+	19, // 51: musicclub.admin.AdminService.ResolveSuggestion:input_type -> musicclub.admin.ResolveSuggestionRequest
+	20, // 52: musicclub.admin.AdminService.PublishSuggestion:input_type -> musicclub.admin.SuggestionRef
+	22, // 53: musicclub.admin.AdminService.ListSongRequests:input_type -> musicclub.admin.ListSongRequestsRequest
+	24, // 54: musicclub.admin.AdminService.ApproveSongRequest:input_type -> musicclub.admin.ApproveSongRequestRequest
+	25, // 55: musicclub.admin.AdminService.RejectSongRequest:input_type -> musicclub.admin.RejectSongRequestRequest
+	26, // 56: musicclub.admin.AdminService.GetApiUsage:input_type -> musicclub.admin.GetApiUsageRequest
+	4,  // 57: musicclub.admin.AdminService.ListUsers:output_type -> musicclub.admin.ListUsersResponse
+	7,  // 58: musicclub.admin.AdminService.ListAuditEntries:output_type -> musicclub.admin.ListAuditEntriesResponse
+	9,  // 59: musicclub.admin.AdminService.SetUserSuspension:output_type -> musicclub.admin.UserSuspension
+	30, // 60: musicclub.admin.AdminService.MergeUsers:output_type -> musicclub.user.User
+	12, // 61: musicclub.admin.AdminService.CreateInviteCode:output_type -> musicclub.admin.InviteCode
+	14, // 62: musicclub.admin.AdminService.ListInviteCodes:output_type -> musicclub.admin.ListInviteCodesResponse
+	12, // 63: musicclub.admin.AdminService.RevokeInviteCode:output_type -> musicclub.admin.InviteCode
+	34, // 64: musicclub.admin.AdminService.PostMonthlyStats:output_type -> google.protobuf.Empty
+	18, // 65: musicclub.admin.AdminService.ListSuggestions:output_type -> musicclub.admin.ListSuggestionsResponse
+	16, // 66: musicclub.admin.AdminService.ResolveSuggestion:output_type -> musicclub.admin.Suggestion
+	16, // 67: musicclub.admin.AdminService.PublishSuggestion:output_type -> musicclub.admin.Suggestion
+	23, // 68: musicclub.admin.AdminService.ListSongRequests:output_type -> musicclub.admin.ListSongRequestsResponse
+	21, // 69: musicclub.admin.AdminService.ApproveSongRequest:output_type -> musicclub.admin.SongRequest
+	21, // 70: musicclub.admin.AdminService.RejectSongRequest:output_type -> musicclub.admin.SongRequest
+	29, // 71: musicclub.admin.AdminService.GetApiUsage:output_type -> musicclub.admin.ApiUsage
+	57, // [57:72] is the sub-list for method output_type
+	42, // [42:57] is the sub-list for method input_type
+	42, // [42:42] is the sub-list for extension type_name
+	42, // [42:42] is the sub-list for extension extendee
+	0,  // [0:42] is the sub-list for field type_name
+}
+
+func init() { file_admin_proto_init() }
+func file_admin_proto_init() {
+	if File_admin_proto != nil {
+		return
+	}
+	file_song_proto_init()
+	file_user_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_admin_proto_rawDesc), len(file_admin_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   28,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_admin_proto_goTypes,
+		DependencyIndexes: file_admin_proto_depIdxs,
+		EnumInfos:         file_admin_proto_enumTypes,
+		MessageInfos:      file_admin_proto_msgTypes,
+	}.Build()
+	File_admin_proto = out.File
+	file_admin_proto_goTypes = nil
+	file_admin_proto_depIdxs = nil
+}
@@ -0,0 +1,692 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v3.21.12
+// source: admin.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AdminService_ListUsers_FullMethodName          = "/musicclub.admin.AdminService/ListUsers"
+	AdminService_ListAuditEntries_FullMethodName   = "/musicclub.admin.AdminService/ListAuditEntries"
+	AdminService_SetUserSuspension_FullMethodName  = "/musicclub.admin.AdminService/SetUserSuspension"
+	AdminService_MergeUsers_FullMethodName         = "/musicclub.admin.AdminService/MergeUsers"
+	AdminService_CreateInviteCode_FullMethodName   = "/musicclub.admin.AdminService/CreateInviteCode"
+	AdminService_ListInviteCodes_FullMethodName    = "/musicclub.admin.AdminService/ListInviteCodes"
+	AdminService_RevokeInviteCode_FullMethodName   = "/musicclub.admin.AdminService/RevokeInviteCode"
+	AdminService_PostMonthlyStats_FullMethodName   = "/musicclub.admin.AdminService/PostMonthlyStats"
+	AdminService_ListSuggestions_FullMethodName    = "/musicclub.admin.AdminService/ListSuggestions"
+	AdminService_ResolveSuggestion_FullMethodName  = "/musicclub.admin.AdminService/ResolveSuggestion"
+	AdminService_PublishSuggestion_FullMethodName  = "/musicclub.admin.AdminService/PublishSuggestion"
+	AdminService_ListSongRequests_FullMethodName   = "/musicclub.admin.AdminService/ListSongRequests"
+	AdminService_ApproveSongRequest_FullMethodName = "/musicclub.admin.AdminService/ApproveSongRequest"
+	AdminService_RejectSongRequest_FullMethodName  = "/musicclub.admin.AdminService/RejectSongRequest"
+	AdminService_GetApiUsage_FullMethodName        = "/musicclub.admin.AdminService/GetApiUsage"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Club administration for the admin panel (requires manage_permissions).
+type AdminServiceClient interface {
+	// Users sorted by display name, with activity totals.
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	// Recorded mutations, newest first.
+	ListAuditEntries(ctx context.Context, in *ListAuditEntriesRequest, opts ...grpc.CallOption) (*ListAuditEntriesResponse, error)
+	// Suspends or reinstates a user. Suspending signs them out everywhere.
+	SetUserSuspension(ctx context.Context, in *SetUserSuspensionRequest, opts ...grpc.CallOption) (*UserSuspension, error)
+	// Moves everything the source user has to the target and deletes the
+	// source, e.g. a duplicate account auto-created on Telegram sign-in.
+	MergeUsers(ctx context.Context, in *MergeUsersRequest, opts ...grpc.CallOption) (*User, error)
+	// Invite codes for password registration.
+	CreateInviteCode(ctx context.Context, in *CreateInviteCodeRequest, opts ...grpc.CallOption) (*InviteCode, error)
+	ListInviteCodes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListInviteCodesResponse, error)
+	// Stops the code from being used; users it created stay.
+	RevokeInviteCode(ctx context.Context, in *InviteCodeRef, opts ...grpc.CallOption) (*InviteCode, error)
+	// Posts a month's wrap-up to the club chat now. The backend posts the
+	// previous month's on its own at the start of each month.
+	PostMonthlyStats(ctx context.Context, in *PostMonthlyStatsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Anonymous suggestions sent to the bot with /suggest, newest first.
+	ListSuggestions(ctx context.Context, in *ListSuggestionsRequest, opts ...grpc.CallOption) (*ListSuggestionsResponse, error)
+	// Marks a suggestion as dealt with, or reopens it.
+	ResolveSuggestion(ctx context.Context, in *ResolveSuggestionRequest, opts ...grpc.CallOption) (*Suggestion, error)
+	// Posts the suggestion's text to the club chat, without any sender.
+	PublishSuggestion(ctx context.Context, in *SuggestionRef, opts ...grpc.CallOption) (*Suggestion, error)
+	// Songs requested through the bot by people outside the club, newest
+	// first.
+	ListSongRequests(ctx context.Context, in *ListSongRequestsRequest, opts ...grpc.CallOption) (*ListSongRequestsResponse, error)
+	// Adds the requested song to the catalog and lets the requester know.
+	ApproveSongRequest(ctx context.Context, in *ApproveSongRequestRequest, opts ...grpc.CallOption) (*SongRequest, error)
+	RejectSongRequest(ctx context.Context, in *RejectSongRequestRequest, opts ...grpc.CallOption) (*SongRequest, error)
+	// Calls per API method with error rates and latency, to see which
+	// features the club actually uses. Figures lag up to a minute.
+	GetApiUsage(ctx context.Context, in *GetApiUsageRequest, opts ...grpc.CallOption) (*ApiUsage, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListAuditEntries(ctx context.Context, in *ListAuditEntriesRequest, opts ...grpc.CallOption) (*ListAuditEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEntriesResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListAuditEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetUserSuspension(ctx context.Context, in *SetUserSuspensionRequest, opts ...grpc.CallOption) (*UserSuspension, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserSuspension)
+	err := c.cc.Invoke(ctx, AdminService_SetUserSuspension_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) MergeUsers(ctx context.Context, in *MergeUsersRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, AdminService_MergeUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateInviteCode(ctx context.Context, in *CreateInviteCodeRequest, opts ...grpc.CallOption) (*InviteCode, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InviteCode)
+	err := c.cc.Invoke(ctx, AdminService_CreateInviteCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListInviteCodes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListInviteCodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInviteCodesResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListInviteCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RevokeInviteCode(ctx context.Context, in *InviteCodeRef, opts ...grpc.CallOption) (*InviteCode, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InviteCode)
+	err := c.cc.Invoke(ctx, AdminService_RevokeInviteCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PostMonthlyStats(ctx context.Context, in *PostMonthlyStatsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AdminService_PostMonthlyStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListSuggestions(ctx context.Context, in *ListSuggestionsRequest, opts ...grpc.CallOption) (*ListSuggestionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSuggestionsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListSuggestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ResolveSuggestion(ctx context.Context, in *ResolveSuggestionRequest, opts ...grpc.CallOption) (*Suggestion, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Suggestion)
+	err := c.cc.Invoke(ctx, AdminService_ResolveSuggestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PublishSuggestion(ctx context.Context, in *SuggestionRef, opts ...grpc.CallOption) (*Suggestion, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Suggestion)
+	err := c.cc.Invoke(ctx, AdminService_PublishSuggestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListSongRequests(ctx context.Context, in *ListSongRequestsRequest, opts ...grpc.CallOption) (*ListSongRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSongRequestsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListSongRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ApproveSongRequest(ctx context.Context, in *ApproveSongRequestRequest, opts ...grpc.CallOption) (*SongRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SongRequest)
+	err := c.cc.Invoke(ctx, AdminService_ApproveSongRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RejectSongRequest(ctx context.Context, in *RejectSongRequestRequest, opts ...grpc.CallOption) (*SongRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SongRequest)
+	err := c.cc.Invoke(ctx, AdminService_RejectSongRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetApiUsage(ctx context.Context, in *GetApiUsageRequest, opts ...grpc.CallOption) (*ApiUsage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiUsage)
+	err := c.cc.Invoke(ctx, AdminService_GetApiUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility.
+//
+// Club administration for the admin panel (requires manage_permissions).
+type AdminServiceServer interface {
+	// Users sorted by display name, with activity totals.
+	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	// Recorded mutations, newest first.
+	ListAuditEntries(context.Context, *ListAuditEntriesRequest) (*ListAuditEntriesResponse, error)
+	// Suspends or reinstates a user. Suspending signs them out everywhere.
+	SetUserSuspension(context.Context, *SetUserSuspensionRequest) (*UserSuspension, error)
+	// Moves everything the source user has to the target and deletes the
+	// source, e.g. a duplicate account auto-created on Telegram sign-in.
+	MergeUsers(context.Context, *MergeUsersRequest) (*User, error)
+	// Invite codes for password registration.
+	CreateInviteCode(context.Context, *CreateInviteCodeRequest) (*InviteCode, error)
+	ListInviteCodes(context.Context, *emptypb.Empty) (*ListInviteCodesResponse, error)
+	// Stops the code from being used; users it created stay.
+	RevokeInviteCode(context.Context, *InviteCodeRef) (*InviteCode, error)
+	// Posts a month's wrap-up to the club chat now. The backend posts the
+	// previous month's on its own at the start of each month.
+	PostMonthlyStats(context.Context, *PostMonthlyStatsRequest) (*emptypb.Empty, error)
+	// Anonymous suggestions sent to the bot with /suggest, newest first.
+	ListSuggestions(context.Context, *ListSuggestionsRequest) (*ListSuggestionsResponse, error)
+	// Marks a suggestion as dealt with, or reopens it.
+	ResolveSuggestion(context.Context, *ResolveSuggestionRequest) (*Suggestion, error)
+	// Posts the suggestion's text to the club chat, without any sender.
+	PublishSuggestion(context.Context, *SuggestionRef) (*Suggestion, error)
+	// Songs requested through the bot by people outside the club, newest
+	// first.
+	ListSongRequests(context.Context, *ListSongRequestsRequest) (*ListSongRequestsResponse, error)
+	// Adds the requested song to the catalog and lets the requester know.
+	ApproveSongRequest(context.Context, *ApproveSongRequestRequest) (*SongRequest, error)
+	RejectSongRequest(context.Context, *RejectSongRequestRequest) (*SongRequest, error)
+	// Calls per API method with error rates and latency, to see which
+	// features the club actually uses. Figures lag up to a minute.
+	GetApiUsage(context.Context, *GetApiUsageRequest) (*ApiUsage, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminServiceServer struct{}
+
+func (UnimplementedAdminServiceServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedAdminServiceServer) ListAuditEntries(context.Context, *ListAuditEntriesRequest) (*ListAuditEntriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAuditEntries not implemented")
+}
+func (UnimplementedAdminServiceServer) SetUserSuspension(context.Context, *SetUserSuspensionRequest) (*UserSuspension, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetUserSuspension not implemented")
+}
+func (UnimplementedAdminServiceServer) MergeUsers(context.Context, *MergeUsersRequest) (*User, error) {
+	return nil, status.Error(codes.Unimplemented, "method MergeUsers not implemented")
+}
+func (UnimplementedAdminServiceServer) CreateInviteCode(context.Context, *CreateInviteCodeRequest) (*InviteCode, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateInviteCode not implemented")
+}
+func (UnimplementedAdminServiceServer) ListInviteCodes(context.Context, *emptypb.Empty) (*ListInviteCodesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListInviteCodes not implemented")
+}
+func (UnimplementedAdminServiceServer) RevokeInviteCode(context.Context, *InviteCodeRef) (*InviteCode, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeInviteCode not implemented")
+}
+func (UnimplementedAdminServiceServer) PostMonthlyStats(context.Context, *PostMonthlyStatsRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method PostMonthlyStats not implemented")
+}
+func (UnimplementedAdminServiceServer) ListSuggestions(context.Context, *ListSuggestionsRequest) (*ListSuggestionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSuggestions not implemented")
+}
+func (UnimplementedAdminServiceServer) ResolveSuggestion(context.Context, *ResolveSuggestionRequest) (*Suggestion, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResolveSuggestion not implemented")
+}
+func (UnimplementedAdminServiceServer) PublishSuggestion(context.Context, *SuggestionRef) (*Suggestion, error) {
+	return nil, status.Error(codes.Unimplemented, "method PublishSuggestion not implemented")
+}
+func (UnimplementedAdminServiceServer) ListSongRequests(context.Context, *ListSongRequestsRequest) (*ListSongRequestsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSongRequests not implemented")
+}
+func (UnimplementedAdminServiceServer) ApproveSongRequest(context.Context, *ApproveSongRequestRequest) (*SongRequest, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveSongRequest not implemented")
+}
+func (UnimplementedAdminServiceServer) RejectSongRequest(context.Context, *RejectSongRequestRequest) (*SongRequest, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectSongRequest not implemented")
+}
+func (UnimplementedAdminServiceServer) GetApiUsage(context.Context, *GetApiUsageRequest) (*ApiUsage, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetApiUsage not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	// If the following call panics, it indicates UnimplementedAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListAuditEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListAuditEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListAuditEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListAuditEntries(ctx, req.(*ListAuditEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetUserSuspension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserSuspensionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetUserSuspension(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetUserSuspension_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetUserSuspension(ctx, req.(*SetUserSuspensionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_MergeUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).MergeUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_MergeUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).MergeUsers(ctx, req.(*MergeUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateInviteCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInviteCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateInviteCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateInviteCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateInviteCode(ctx, req.(*CreateInviteCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListInviteCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListInviteCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListInviteCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListInviteCodes(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RevokeInviteCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteCodeRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RevokeInviteCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RevokeInviteCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RevokeInviteCode(ctx, req.(*InviteCodeRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PostMonthlyStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostMonthlyStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PostMonthlyStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_PostMonthlyStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PostMonthlyStats(ctx, req.(*PostMonthlyStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSuggestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListSuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListSuggestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListSuggestions(ctx, req.(*ListSuggestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ResolveSuggestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveSuggestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ResolveSuggestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ResolveSuggestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ResolveSuggestion(ctx, req.(*ResolveSuggestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PublishSuggestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestionRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PublishSuggestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_PublishSuggestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PublishSuggestion(ctx, req.(*SuggestionRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListSongRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSongRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListSongRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListSongRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListSongRequests(ctx, req.(*ListSongRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ApproveSongRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveSongRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ApproveSongRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ApproveSongRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ApproveSongRequest(ctx, req.(*ApproveSongRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RejectSongRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectSongRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RejectSongRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RejectSongRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RejectSongRequest(ctx, req.(*RejectSongRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetApiUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApiUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetApiUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetApiUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetApiUsage(ctx, req.(*GetApiUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "musicclub.admin.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListUsers",
+			Handler:    _AdminService_ListUsers_Handler,
+		},
+		{
+			MethodName: "ListAuditEntries",
+			Handler:    _AdminService_ListAuditEntries_Handler,
+		},
+		{
+			MethodName: "SetUserSuspension",
+			Handler:    _AdminService_SetUserSuspension_Handler,
+		},
+		{
+			MethodName: "MergeUsers",
+			Handler:    _AdminService_MergeUsers_Handler,
+		},
+		{
+			MethodName: "CreateInviteCode",
+			Handler:    _AdminService_CreateInviteCode_Handler,
+		},
+		{
+			MethodName: "ListInviteCodes",
+			Handler:    _AdminService_ListInviteCodes_Handler,
+		},
+		{
+			MethodName: "RevokeInviteCode",
+			Handler:    _AdminService_RevokeInviteCode_Handler,
+		},
+		{
+			MethodName: "PostMonthlyStats",
+			Handler:    _AdminService_PostMonthlyStats_Handler,
+		},
+		{
+			MethodName: "ListSuggestions",
+			Handler:    _AdminService_ListSuggestions_Handler,
+		},
+		{
+			MethodName: "ResolveSuggestion",
+			Handler:    _AdminService_ResolveSuggestion_Handler,
+		},
+		{
+			MethodName: "PublishSuggestion",
+			Handler:    _AdminService_PublishSuggestion_Handler,
+		},
+		{
+			MethodName: "ListSongRequests",
+			Handler:    _AdminService_ListSongRequests_Handler,
+		},
+		{
+			MethodName: "ApproveSongRequest",
+			Handler:    _AdminService_ApproveSongRequest_Handler,
+		},
+		{
+			MethodName: "RejectSongRequest",
+			Handler:    _AdminService_RejectSongRequest_Handler,
+		},
+		{
+			MethodName: "GetApiUsage",
+			Handler:    _AdminService_GetApiUsage_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "admin.proto",
+}
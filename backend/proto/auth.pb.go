@@ -7,9 +7,11 @@
 package proto
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -75,9 +77,11 @@ func (x *Credentials) GetPassword() string {
 }
 
 type RegisterUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Credentials   *Credentials           `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
-	Profile       *User                  `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Credentials *Credentials           `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	Profile     *User                  `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+	// Required when the club is invite-only.
+	InviteCode    string `protobuf:"bytes,3,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -126,6 +130,13 @@ func (x *RegisterUserRequest) GetProfile() *User {
 	return nil
 }
 
+func (x *RegisterUserRequest) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
 type RefreshRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
@@ -421,6 +432,7 @@ type ProfileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Profile       *User                  `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
 	Permissions   *PermissionSet         `protobuf:"bytes,2,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	Bio           string                 `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -469,6 +481,138 @@ func (x *ProfileResponse) GetPermissions() *PermissionSet {
 	return nil
 }
 
+func (x *ProfileResponse) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+type UpdateProfileRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	DisplayName string                 `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	AvatarUrl   string                 `protobuf:"bytes,2,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	Bio         string                 `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio,omitempty"`
+	// Fields to overwrite (display_name, avatar_url, bio, username); empty
+	// replaces all, except that an empty username keeps the current one.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// 3-32 latin letters, digits or underscores, starting with a letter. Names
+	// other members use or gave up in the last 30 days are refused. Access
+	// tokens carry the new name after the next Refresh.
+	Username      string `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileRequest) Reset() {
+	*x = UpdateProfileRequest{}
+	mi := &file_auth_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileRequest) ProtoMessage() {}
+
+func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateProfileRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateProfileRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type UploadAvatarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunk         []byte                 `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAvatarRequest) Reset() {
+	*x = UploadAvatarRequest{}
+	mi := &file_auth_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAvatarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAvatarRequest) ProtoMessage() {}
+
+func (x *UploadAvatarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAvatarRequest.ProtoReflect.Descriptor instead.
+func (*UploadAvatarRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UploadAvatarRequest) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
 type TelegramWebAppAuthRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Raw initData string from Telegram WebApp
@@ -479,7 +623,7 @@ type TelegramWebAppAuthRequest struct {
 
 func (x *TelegramWebAppAuthRequest) Reset() {
 	*x = TelegramWebAppAuthRequest{}
-	mi := &file_auth_proto_msgTypes[8]
+	mi := &file_auth_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -491,7 +635,7 @@ func (x *TelegramWebAppAuthRequest) String() string {
 func (*TelegramWebAppAuthRequest) ProtoMessage() {}
 
 func (x *TelegramWebAppAuthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[8]
+	mi := &file_auth_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -504,7 +648,7 @@ func (x *TelegramWebAppAuthRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TelegramWebAppAuthRequest.ProtoReflect.Descriptor instead.
 func (*TelegramWebAppAuthRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{8}
+	return file_auth_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *TelegramWebAppAuthRequest) GetInitData() string {
@@ -519,16 +663,18 @@ var File_auth_proto protoreflect.FileDescriptor
 const file_auth_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"auth.proto\x12\x0emusicclub.auth\x1a\x1bgoogle/protobuf/empty.proto\x1a\x11permissions.proto\x1a\n" +
-	"user.proto\"E\n" +
-	"\vCredentials\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"\x84\x01\n" +
-	"\x13RegisterUserRequest\x12=\n" +
-	"\vcredentials\x18\x01 \x01(\v2\x1b.musicclub.auth.CredentialsR\vcredentials\x12.\n" +
-	"\aprofile\x18\x02 \x01(\v2\x14.musicclub.user.UserR\aprofile\"5\n" +
-	"\x0eRefreshRequest\x12#\n" +
-	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"S\n" +
+	"auth.proto\x12\x0emusicclub.auth\x1a\x1bbuf/validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x11permissions.proto\x1a\n" +
+	"user.proto\"W\n" +
+	"\vCredentials\x12#\n" +
+	"\busername\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\busername\x12#\n" +
+	"\bpassword\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\bpassword\"\xad\x01\n" +
+	"\x13RegisterUserRequest\x12E\n" +
+	"\vcredentials\x18\x01 \x01(\v2\x1b.musicclub.auth.CredentialsB\x06\xbaH\x03\xc8\x01\x01R\vcredentials\x12.\n" +
+	"\aprofile\x18\x02 \x01(\v2\x14.musicclub.user.UserR\aprofile\x12\x1f\n" +
+	"\vinvite_code\x18\x03 \x01(\tR\n" +
+	"inviteCode\">\n" +
+	"\x0eRefreshRequest\x12,\n" +
+	"\rrefresh_token\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\frefreshToken\"S\n" +
 	"\tTokenPair\x12!\n" +
 	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
 	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\"4\n" +
@@ -546,12 +692,23 @@ const file_auth_proto_rawDesc = "" +
 	"\x0eis_chat_member\x18\x04 \x01(\bR\fisChatMember\x12(\n" +
 	"\x10join_request_url\x18\x05 \x01(\tR\x0ejoinRequestUrl\x12.\n" +
 	"\aprofile\x18\x06 \x01(\v2\x14.musicclub.user.UserR\aprofile\x12F\n" +
-	"\vpermissions\x18\a \x01(\v2$.musicclub.permissions.PermissionSetR\vpermissions\"\x89\x01\n" +
+	"\vpermissions\x18\a \x01(\v2$.musicclub.permissions.PermissionSetR\vpermissions\"\x9b\x01\n" +
 	"\x0fProfileResponse\x12.\n" +
 	"\aprofile\x18\x01 \x01(\v2\x14.musicclub.user.UserR\aprofile\x12F\n" +
-	"\vpermissions\x18\x02 \x01(\v2$.musicclub.permissions.PermissionSetR\vpermissions\"8\n" +
-	"\x19TelegramWebAppAuthRequest\x12\x1b\n" +
-	"\tinit_data\x18\x01 \x01(\tR\binitData2\xd6\x03\n" +
+	"\vpermissions\x18\x02 \x01(\v2$.musicclub.permissions.PermissionSetR\vpermissions\x12\x10\n" +
+	"\x03bio\x18\x03 \x01(\tR\x03bio\"\xc3\x01\n" +
+	"\x14UpdateProfileRequest\x12!\n" +
+	"\fdisplay_name\x18\x01 \x01(\tR\vdisplayName\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x02 \x01(\tR\tavatarUrl\x12\x10\n" +
+	"\x03bio\x18\x03 \x01(\tR\x03bio\x12;\n" +
+	"\vupdate_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x1a\n" +
+	"\busername\x18\x05 \x01(\tR\busername\"+\n" +
+	"\x13UploadAvatarRequest\x12\x14\n" +
+	"\x05chunk\x18\x01 \x01(\fR\x05chunk\"A\n" +
+	"\x19TelegramWebAppAuthRequest\x12$\n" +
+	"\tinit_data\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\binitData2\x86\x05\n" +
 	"\vAuthService\x12L\n" +
 	"\bRegister\x12#.musicclub.auth.RegisterUserRequest\x1a\x1b.musicclub.auth.AuthSession\x12A\n" +
 	"\x05Login\x12\x1b.musicclub.auth.Credentials\x1a\x1b.musicclub.auth.AuthSession\x12D\n" +
@@ -559,7 +716,9 @@ const file_auth_proto_rawDesc = "" +
 	"\x0eGetTgLoginLink\x12\x14.musicclub.user.User\x1a#.musicclub.auth.TgLoginLinkResponse\x12E\n" +
 	"\n" +
 	"GetProfile\x12\x16.google.protobuf.Empty\x1a\x1f.musicclub.auth.ProfileResponse\x12\\\n" +
-	"\x12TelegramWebAppAuth\x12).musicclub.auth.TelegramWebAppAuthRequest\x1a\x1b.musicclub.auth.AuthSessionB\x1cZ\x1amusicclubbot/backend/protob\x06proto3"
+	"\x12TelegramWebAppAuth\x12).musicclub.auth.TelegramWebAppAuthRequest\x1a\x1b.musicclub.auth.AuthSession\x12V\n" +
+	"\rUpdateProfile\x12$.musicclub.auth.UpdateProfileRequest\x1a\x1f.musicclub.auth.ProfileResponse\x12V\n" +
+	"\fUploadAvatar\x12#.musicclub.auth.UploadAvatarRequest\x1a\x1f.musicclub.auth.ProfileResponse(\x01B\x1cZ\x1amusicclubbot/backend/protob\x06proto3"
 
 var (
 	file_auth_proto_rawDescOnce sync.Once
@@ -573,7 +732,7 @@ func file_auth_proto_rawDescGZIP() []byte {
 	return file_auth_proto_rawDescData
 }
 
-var file_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_auth_proto_goTypes = []any{
 	(*Credentials)(nil),               // 0: musicclub.auth.Credentials
 	(*RegisterUserRequest)(nil),       // 1: musicclub.auth.RegisterUserRequest
@@ -583,37 +742,45 @@ var file_auth_proto_goTypes = []any{
 	(*TgLoginRequest)(nil),            // 5: musicclub.auth.TgLoginRequest
 	(*AuthSession)(nil),               // 6: musicclub.auth.AuthSession
 	(*ProfileResponse)(nil),           // 7: musicclub.auth.ProfileResponse
-	(*TelegramWebAppAuthRequest)(nil), // 8: musicclub.auth.TelegramWebAppAuthRequest
-	(*User)(nil),                      // 9: musicclub.user.User
-	(*PermissionSet)(nil),             // 10: musicclub.permissions.PermissionSet
-	(*emptypb.Empty)(nil),             // 11: google.protobuf.Empty
+	(*UpdateProfileRequest)(nil),      // 8: musicclub.auth.UpdateProfileRequest
+	(*UploadAvatarRequest)(nil),       // 9: musicclub.auth.UploadAvatarRequest
+	(*TelegramWebAppAuthRequest)(nil), // 10: musicclub.auth.TelegramWebAppAuthRequest
+	(*User)(nil),                      // 11: musicclub.user.User
+	(*PermissionSet)(nil),             // 12: musicclub.permissions.PermissionSet
+	(*fieldmaskpb.FieldMask)(nil),     // 13: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),             // 14: google.protobuf.Empty
 }
 var file_auth_proto_depIdxs = []int32{
 	0,  // 0: musicclub.auth.RegisterUserRequest.credentials:type_name -> musicclub.auth.Credentials
-	9,  // 1: musicclub.auth.RegisterUserRequest.profile:type_name -> musicclub.user.User
-	9,  // 2: musicclub.auth.TgLoginRequest.user:type_name -> musicclub.user.User
+	11, // 1: musicclub.auth.RegisterUserRequest.profile:type_name -> musicclub.user.User
+	11, // 2: musicclub.auth.TgLoginRequest.user:type_name -> musicclub.user.User
 	3,  // 3: musicclub.auth.AuthSession.tokens:type_name -> musicclub.auth.TokenPair
-	9,  // 4: musicclub.auth.AuthSession.profile:type_name -> musicclub.user.User
-	10, // 5: musicclub.auth.AuthSession.permissions:type_name -> musicclub.permissions.PermissionSet
-	9,  // 6: musicclub.auth.ProfileResponse.profile:type_name -> musicclub.user.User
-	10, // 7: musicclub.auth.ProfileResponse.permissions:type_name -> musicclub.permissions.PermissionSet
-	1,  // 8: musicclub.auth.AuthService.Register:input_type -> musicclub.auth.RegisterUserRequest
-	0,  // 9: musicclub.auth.AuthService.Login:input_type -> musicclub.auth.Credentials
-	2,  // 10: musicclub.auth.AuthService.Refresh:input_type -> musicclub.auth.RefreshRequest
-	9,  // 11: musicclub.auth.AuthService.GetTgLoginLink:input_type -> musicclub.user.User
-	11, // 12: musicclub.auth.AuthService.GetProfile:input_type -> google.protobuf.Empty
-	8,  // 13: musicclub.auth.AuthService.TelegramWebAppAuth:input_type -> musicclub.auth.TelegramWebAppAuthRequest
-	6,  // 14: musicclub.auth.AuthService.Register:output_type -> musicclub.auth.AuthSession
-	6,  // 15: musicclub.auth.AuthService.Login:output_type -> musicclub.auth.AuthSession
-	3,  // 16: musicclub.auth.AuthService.Refresh:output_type -> musicclub.auth.TokenPair
-	4,  // 17: musicclub.auth.AuthService.GetTgLoginLink:output_type -> musicclub.auth.TgLoginLinkResponse
-	7,  // 18: musicclub.auth.AuthService.GetProfile:output_type -> musicclub.auth.ProfileResponse
-	6,  // 19: musicclub.auth.AuthService.TelegramWebAppAuth:output_type -> musicclub.auth.AuthSession
-	14, // [14:20] is the sub-list for method output_type
-	8,  // [8:14] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	11, // 4: musicclub.auth.AuthSession.profile:type_name -> musicclub.user.User
+	12, // 5: musicclub.auth.AuthSession.permissions:type_name -> musicclub.permissions.PermissionSet
+	11, // 6: musicclub.auth.ProfileResponse.profile:type_name -> musicclub.user.User
+	12, // 7: musicclub.auth.ProfileResponse.permissions:type_name -> musicclub.permissions.PermissionSet
+	13, // 8: musicclub.auth.UpdateProfileRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 9: musicclub.auth.AuthService.Register:input_type -> musicclub.auth.RegisterUserRequest
+	0,  // 10: musicclub.auth.AuthService.Login:input_type -> musicclub.auth.Credentials
+	2,  // 11: musicclub.auth.AuthService.Refresh:input_type -> musicclub.auth.RefreshRequest
+	11, // 12: musicclub.auth.AuthService.GetTgLoginLink:input_type -> musicclub.user.User
+	14, // 13: musicclub.auth.AuthService.GetProfile:input_type -> google.protobuf.Empty
+	10, // 14: musicclub.auth.AuthService.TelegramWebAppAuth:input_type -> musicclub.auth.TelegramWebAppAuthRequest
+	8,  // 15: musicclub.auth.AuthService.UpdateProfile:input_type -> musicclub.auth.UpdateProfileRequest
+	9,  // 16: musicclub.auth.AuthService.UploadAvatar:input_type -> musicclub.auth.UploadAvatarRequest
+	6,  // 17: musicclub.auth.AuthService.Register:output_type -> musicclub.auth.AuthSession
+	6,  // 18: musicclub.auth.AuthService.Login:output_type -> musicclub.auth.AuthSession
+	3,  // 19: musicclub.auth.AuthService.Refresh:output_type -> musicclub.auth.TokenPair
+	4,  // 20: musicclub.auth.AuthService.GetTgLoginLink:output_type -> musicclub.auth.TgLoginLinkResponse
+	7,  // 21: musicclub.auth.AuthService.GetProfile:output_type -> musicclub.auth.ProfileResponse
+	6,  // 22: musicclub.auth.AuthService.TelegramWebAppAuth:output_type -> musicclub.auth.AuthSession
+	7,  // 23: musicclub.auth.AuthService.UpdateProfile:output_type -> musicclub.auth.ProfileResponse
+	7,  // 24: musicclub.auth.AuthService.UploadAvatar:output_type -> musicclub.auth.ProfileResponse
+	17, // [17:25] is the sub-list for method output_type
+	9,  // [9:17] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_auth_proto_init() }
@@ -629,7 +796,7 @@ func file_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_proto_rawDesc), len(file_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
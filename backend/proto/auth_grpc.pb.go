@@ -26,6 +26,8 @@ const (
 	AuthService_GetTgLoginLink_FullMethodName     = "/musicclub.auth.AuthService/GetTgLoginLink"
 	AuthService_GetProfile_FullMethodName         = "/musicclub.auth.AuthService/GetProfile"
 	AuthService_TelegramWebAppAuth_FullMethodName = "/musicclub.auth.AuthService/TelegramWebAppAuth"
+	AuthService_UpdateProfile_FullMethodName      = "/musicclub.auth.AuthService/UpdateProfile"
+	AuthService_UploadAvatar_FullMethodName       = "/musicclub.auth.AuthService/UploadAvatar"
 )
 
 // AuthServiceClient is the client API for AuthService service.
@@ -46,6 +48,12 @@ type AuthServiceClient interface {
 	GetProfile(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ProfileResponse, error)
 	// Authenticates user via Telegram WebApp initData.
 	TelegramWebAppAuth(ctx context.Context, in *TelegramWebAppAuthRequest, opts ...grpc.CallOption) (*AuthSession, error)
+	// Changes the current user's profile. A name or avatar set here is no
+	// longer synced from Telegram; clearing the avatar resumes syncing it.
+	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*ProfileResponse, error)
+	// Uploads a new avatar image (JPEG, PNG, GIF or WebP) in chunks. It is
+	// cropped to a square and served from /avatars/<id>?size=N.
+	UploadAvatar(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAvatarRequest, ProfileResponse], error)
 }
 
 type authServiceClient struct {
@@ -116,6 +124,29 @@ func (c *authServiceClient) TelegramWebAppAuth(ctx context.Context, in *Telegram
 	return out, nil
 }
 
+func (c *authServiceClient) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*ProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProfileResponse)
+	err := c.cc.Invoke(ctx, AuthService_UpdateProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) UploadAvatar(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAvatarRequest, ProfileResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AuthService_ServiceDesc.Streams[0], AuthService_UploadAvatar_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadAvatarRequest, ProfileResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AuthService_UploadAvatarClient = grpc.ClientStreamingClient[UploadAvatarRequest, ProfileResponse]
+
 // AuthServiceServer is the server API for AuthService service.
 // All implementations must embed UnimplementedAuthServiceServer
 // for forward compatibility.
@@ -134,6 +165,12 @@ type AuthServiceServer interface {
 	GetProfile(context.Context, *emptypb.Empty) (*ProfileResponse, error)
 	// Authenticates user via Telegram WebApp initData.
 	TelegramWebAppAuth(context.Context, *TelegramWebAppAuthRequest) (*AuthSession, error)
+	// Changes the current user's profile. A name or avatar set here is no
+	// longer synced from Telegram; clearing the avatar resumes syncing it.
+	UpdateProfile(context.Context, *UpdateProfileRequest) (*ProfileResponse, error)
+	// Uploads a new avatar image (JPEG, PNG, GIF or WebP) in chunks. It is
+	// cropped to a square and served from /avatars/<id>?size=N.
+	UploadAvatar(grpc.ClientStreamingServer[UploadAvatarRequest, ProfileResponse]) error
 	mustEmbedUnimplementedAuthServiceServer()
 }
 
@@ -162,6 +199,12 @@ func (UnimplementedAuthServiceServer) GetProfile(context.Context, *emptypb.Empty
 func (UnimplementedAuthServiceServer) TelegramWebAppAuth(context.Context, *TelegramWebAppAuthRequest) (*AuthSession, error) {
 	return nil, status.Error(codes.Unimplemented, "method TelegramWebAppAuth not implemented")
 }
+func (UnimplementedAuthServiceServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*ProfileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateProfile not implemented")
+}
+func (UnimplementedAuthServiceServer) UploadAvatar(grpc.ClientStreamingServer[UploadAvatarRequest, ProfileResponse]) error {
+	return status.Error(codes.Unimplemented, "method UploadAvatar not implemented")
+}
 func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
 func (UnimplementedAuthServiceServer) testEmbeddedByValue()                     {}
 
@@ -291,6 +334,31 @@ func _AuthService_TelegramWebAppAuth_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).UpdateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_UpdateProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).UpdateProfile(ctx, req.(*UpdateProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_UploadAvatar_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AuthServiceServer).UploadAvatar(&grpc.GenericServerStream[UploadAvatarRequest, ProfileResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AuthService_UploadAvatarServer = grpc.ClientStreamingServer[UploadAvatarRequest, ProfileResponse]
+
 // AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -322,7 +390,17 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TelegramWebAppAuth",
 			Handler:    _AuthService_TelegramWebAppAuth_Handler,
 		},
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _AuthService_UpdateProfile_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadAvatar",
+			Handler:       _AuthService_UploadAvatar_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "auth.proto",
 }
@@ -0,0 +1,380 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: dashboard.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TracklistReadiness struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Items int32                  `protobuf:"varint,1,opt,name=items,proto3" json:"items,omitempty"`
+	// Items by rehearsal status.
+	Ready      int32 `protobuf:"varint,2,opt,name=ready,proto3" json:"ready,omitempty"`
+	InProgress int32 `protobuf:"varint,3,opt,name=in_progress,json=inProgress,proto3" json:"in_progress,omitempty"`
+	NotStarted int32 `protobuf:"varint,4,opt,name=not_started,json=notStarted,proto3" json:"not_started,omitempty"`
+	// Roles of tracklist songs nobody plays yet.
+	VacantRoles   int32 `protobuf:"varint,5,opt,name=vacant_roles,json=vacantRoles,proto3" json:"vacant_roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TracklistReadiness) Reset() {
+	*x = TracklistReadiness{}
+	mi := &file_dashboard_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TracklistReadiness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TracklistReadiness) ProtoMessage() {}
+
+func (x *TracklistReadiness) ProtoReflect() protoreflect.Message {
+	mi := &file_dashboard_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TracklistReadiness.ProtoReflect.Descriptor instead.
+func (*TracklistReadiness) Descriptor() ([]byte, []int) {
+	return file_dashboard_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TracklistReadiness) GetItems() int32 {
+	if x != nil {
+		return x.Items
+	}
+	return 0
+}
+
+func (x *TracklistReadiness) GetReady() int32 {
+	if x != nil {
+		return x.Ready
+	}
+	return 0
+}
+
+func (x *TracklistReadiness) GetInProgress() int32 {
+	if x != nil {
+		return x.InProgress
+	}
+	return 0
+}
+
+func (x *TracklistReadiness) GetNotStarted() int32 {
+	if x != nil {
+		return x.NotStarted
+	}
+	return 0
+}
+
+func (x *TracklistReadiness) GetVacantRoles() int32 {
+	if x != nil {
+		return x.VacantRoles
+	}
+	return 0
+}
+
+// A role an upcoming event still needs someone for.
+type VacantRole struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	EventId    string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventTitle string                 `protobuf:"bytes,2,opt,name=event_title,json=eventTitle,proto3" json:"event_title,omitempty"`
+	StartAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	// Set for roles of a tracklist song, empty for roles the event itself
+	// requires.
+	SongId        string `protobuf:"bytes,4,opt,name=song_id,json=songId,proto3" json:"song_id,omitempty"`
+	SongTitle     string `protobuf:"bytes,5,opt,name=song_title,json=songTitle,proto3" json:"song_title,omitempty"`
+	Role          string `protobuf:"bytes,6,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VacantRole) Reset() {
+	*x = VacantRole{}
+	mi := &file_dashboard_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VacantRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VacantRole) ProtoMessage() {}
+
+func (x *VacantRole) ProtoReflect() protoreflect.Message {
+	mi := &file_dashboard_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VacantRole.ProtoReflect.Descriptor instead.
+func (*VacantRole) Descriptor() ([]byte, []int) {
+	return file_dashboard_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *VacantRole) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *VacantRole) GetEventTitle() string {
+	if x != nil {
+		return x.EventTitle
+	}
+	return ""
+}
+
+func (x *VacantRole) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *VacantRole) GetSongId() string {
+	if x != nil {
+		return x.SongId
+	}
+	return ""
+}
+
+func (x *VacantRole) GetSongTitle() string {
+	if x != nil {
+		return x.SongTitle
+	}
+	return ""
+}
+
+func (x *VacantRole) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type Dashboard struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The soonest upcoming event; unset when nothing is planned.
+	NextEvent          *Event              `protobuf:"bytes,1,opt,name=next_event,json=nextEvent,proto3" json:"next_event,omitempty"`
+	NextEventReadiness *TracklistReadiness `protobuf:"bytes,2,opt,name=next_event_readiness,json=nextEventReadiness,proto3" json:"next_event_readiness,omitempty"`
+	// Across upcoming events, soonest first; at most 50.
+	VacantRoles []*VacantRole `protobuf:"bytes,3,rep,name=vacant_roles,json=vacantRoles,proto3" json:"vacant_roles,omitempty"`
+	// Newest first.
+	RecentSongs []*Song `protobuf:"bytes,4,rep,name=recent_songs,json=recentSongs,proto3" json:"recent_songs,omitempty"`
+	// Other members' activity since the feed was last marked seen.
+	UnreadActivityCount int32 `protobuf:"varint,5,opt,name=unread_activity_count,json=unreadActivityCount,proto3" json:"unread_activity_count,omitempty"`
+	// The newest of those, at most 10.
+	UnreadActivity []*Activity `protobuf:"bytes,6,rep,name=unread_activity,json=unreadActivity,proto3" json:"unread_activity,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Dashboard) Reset() {
+	*x = Dashboard{}
+	mi := &file_dashboard_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Dashboard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Dashboard) ProtoMessage() {}
+
+func (x *Dashboard) ProtoReflect() protoreflect.Message {
+	mi := &file_dashboard_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Dashboard.ProtoReflect.Descriptor instead.
+func (*Dashboard) Descriptor() ([]byte, []int) {
+	return file_dashboard_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Dashboard) GetNextEvent() *Event {
+	if x != nil {
+		return x.NextEvent
+	}
+	return nil
+}
+
+func (x *Dashboard) GetNextEventReadiness() *TracklistReadiness {
+	if x != nil {
+		return x.NextEventReadiness
+	}
+	return nil
+}
+
+func (x *Dashboard) GetVacantRoles() []*VacantRole {
+	if x != nil {
+		return x.VacantRoles
+	}
+	return nil
+}
+
+func (x *Dashboard) GetRecentSongs() []*Song {
+	if x != nil {
+		return x.RecentSongs
+	}
+	return nil
+}
+
+func (x *Dashboard) GetUnreadActivityCount() int32 {
+	if x != nil {
+		return x.UnreadActivityCount
+	}
+	return 0
+}
+
+func (x *Dashboard) GetUnreadActivity() []*Activity {
+	if x != nil {
+		return x.UnreadActivity
+	}
+	return nil
+}
+
+var File_dashboard_proto protoreflect.FileDescriptor
+
+const file_dashboard_proto_rawDesc = "" +
+	"\n" +
+	"\x0fdashboard.proto\x12\x13musicclub.dashboard\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\vevent.proto\x1a\n" +
+	"song.proto\x1a\n" +
+	"user.proto\"\xa5\x01\n" +
+	"\x12TracklistReadiness\x12\x14\n" +
+	"\x05items\x18\x01 \x01(\x05R\x05items\x12\x14\n" +
+	"\x05ready\x18\x02 \x01(\x05R\x05ready\x12\x1f\n" +
+	"\vin_progress\x18\x03 \x01(\x05R\n" +
+	"inProgress\x12\x1f\n" +
+	"\vnot_started\x18\x04 \x01(\x05R\n" +
+	"notStarted\x12!\n" +
+	"\fvacant_roles\x18\x05 \x01(\x05R\vvacantRoles\"\xcb\x01\n" +
+	"\n" +
+	"VacantRole\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1f\n" +
+	"\vevent_title\x18\x02 \x01(\tR\n" +
+	"eventTitle\x125\n" +
+	"\bstart_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\astartAt\x12\x17\n" +
+	"\asong_id\x18\x04 \x01(\tR\x06songId\x12\x1d\n" +
+	"\n" +
+	"song_title\x18\x05 \x01(\tR\tsongTitle\x12\x12\n" +
+	"\x04role\x18\x06 \x01(\tR\x04role\"\x91\x03\n" +
+	"\tDashboard\x125\n" +
+	"\n" +
+	"next_event\x18\x01 \x01(\v2\x16.musicclub.event.EventR\tnextEvent\x12Y\n" +
+	"\x14next_event_readiness\x18\x02 \x01(\v2'.musicclub.dashboard.TracklistReadinessR\x12nextEventReadiness\x12B\n" +
+	"\fvacant_roles\x18\x03 \x03(\v2\x1f.musicclub.dashboard.VacantRoleR\vvacantRoles\x127\n" +
+	"\frecent_songs\x18\x04 \x03(\v2\x14.musicclub.song.SongR\vrecentSongs\x122\n" +
+	"\x15unread_activity_count\x18\x05 \x01(\x05R\x13unreadActivityCount\x12A\n" +
+	"\x0funread_activity\x18\x06 \x03(\v2\x18.musicclub.user.ActivityR\x0eunreadActivity2Z\n" +
+	"\x10DashboardService\x12F\n" +
+	"\fGetDashboard\x12\x16.google.protobuf.Empty\x1a\x1e.musicclub.dashboard.DashboardB\x1cZ\x1amusicclubbot/backend/protob\x06proto3"
+
+var (
+	file_dashboard_proto_rawDescOnce sync.Once
+	file_dashboard_proto_rawDescData []byte
+)
+
+func file_dashboard_proto_rawDescGZIP() []byte {
+	file_dashboard_proto_rawDescOnce.Do(func() {
+		file_dashboard_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_dashboard_proto_rawDesc), len(file_dashboard_proto_rawDesc)))
+	})
+	return file_dashboard_proto_rawDescData
+}
+
+var file_dashboard_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_dashboard_proto_goTypes = []any{
+	(*TracklistReadiness)(nil),    // 0: musicclub.dashboard.TracklistReadiness
+	(*VacantRole)(nil),            // 1: musicclub.dashboard.VacantRole
+	(*Dashboard)(nil),             // 2: musicclub.dashboard.Dashboard
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+	(*Event)(nil),                 // 4: musicclub.event.Event
+	(*Song)(nil),                  // 5: musicclub.song.Song
+	(*Activity)(nil),              // 6: musicclub.user.Activity
+	(*emptypb.Empty)(nil),         // 7: google.protobuf.Empty
+}
+var file_dashboard_proto_depIdxs = []int32{
+	3, // 0: musicclub.dashboard.VacantRole.start_at:type_name -> google.protobuf.Timestamp
+	4, // 1: musicclub.dashboard.Dashboard.next_event:type_name -> musicclub.event.Event
+	0, // 2: musicclub.dashboard.Dashboard.next_event_readiness:type_name -> musicclub.dashboard.TracklistReadiness
+	1, // 3: musicclub.dashboard.Dashboard.vacant_roles:type_name -> musicclub.dashboard.VacantRole
+	5, // 4: musicclub.dashboard.Dashboard.recent_songs:type_name -> musicclub.song.Song
+	6, // 5: musicclub.dashboard.Dashboard.unread_activity:type_name -> musicclub.user.Activity
+	7, // 6: musicclub.dashboard.DashboardService.GetDashboard:input_type -> google.protobuf.Empty
+	2, // 7: musicclub.dashboard.DashboardService.GetDashboard:output_type -> musicclub.dashboard.Dashboard
+	7, // [7:8] is the sub-list for method output_type
+	6, // [6:7] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_dashboard_proto_init() }
+func file_dashboard_proto_init() {
+	if File_dashboard_proto != nil {
+		return
+	}
+	file_event_proto_init()
+	file_song_proto_init()
+	file_user_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_dashboard_proto_rawDesc), len(file_dashboard_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_dashboard_proto_goTypes,
+		DependencyIndexes: file_dashboard_proto_depIdxs,
+		MessageInfos:      file_dashboard_proto_msgTypes,
+	}.Build()
+	File_dashboard_proto = out.File
+	file_dashboard_proto_goTypes = nil
+	file_dashboard_proto_depIdxs = nil
+}
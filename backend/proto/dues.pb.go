@@ -0,0 +1,849 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: dues.proto
+
+package proto
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DuesEntryKind int32
+
+const (
+	DuesEntryKind_DUES_ENTRY_KIND_UNSPECIFIED DuesEntryKind = 0
+	// Money the member paid in.
+	DuesEntryKind_DUES_ENTRY_KIND_CONTRIBUTION DuesEntryKind = 1
+	// Money the member owes, e.g. their part of the rent.
+	DuesEntryKind_DUES_ENTRY_KIND_CHARGE DuesEntryKind = 2
+)
+
+// Enum value maps for DuesEntryKind.
+var (
+	DuesEntryKind_name = map[int32]string{
+		0: "DUES_ENTRY_KIND_UNSPECIFIED",
+		1: "DUES_ENTRY_KIND_CONTRIBUTION",
+		2: "DUES_ENTRY_KIND_CHARGE",
+	}
+	DuesEntryKind_value = map[string]int32{
+		"DUES_ENTRY_KIND_UNSPECIFIED":  0,
+		"DUES_ENTRY_KIND_CONTRIBUTION": 1,
+		"DUES_ENTRY_KIND_CHARGE":       2,
+	}
+)
+
+func (x DuesEntryKind) Enum() *DuesEntryKind {
+	p := new(DuesEntryKind)
+	*p = x
+	return p
+}
+
+func (x DuesEntryKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DuesEntryKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_dues_proto_enumTypes[0].Descriptor()
+}
+
+func (DuesEntryKind) Type() protoreflect.EnumType {
+	return &file_dues_proto_enumTypes[0]
+}
+
+func (x DuesEntryKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DuesEntryKind.Descriptor instead.
+func (DuesEntryKind) EnumDescriptor() ([]byte, []int) {
+	return file_dues_proto_rawDescGZIP(), []int{0}
+}
+
+type DuesEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	User  *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Kind  DuesEntryKind          `protobuf:"varint,3,opt,name=kind,proto3,enum=musicclub.dues.DuesEntryKind" json:"kind,omitempty"`
+	// In minor currency units (kopecks), always positive.
+	AmountCents int64 `protobuf:"varint,4,opt,name=amount_cents,json=amountCents,proto3" json:"amount_cents,omitempty"`
+	// E.g. "Rehearsal room, March".
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// Empty when the entry belongs to no season.
+	SeasonId      string                 `protobuf:"bytes,6,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	RecordedBy    *User                  `protobuf:"bytes,8,opt,name=recorded_by,json=recordedBy,proto3" json:"recorded_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuesEntry) Reset() {
+	*x = DuesEntry{}
+	mi := &file_dues_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuesEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuesEntry) ProtoMessage() {}
+
+func (x *DuesEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_dues_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuesEntry.ProtoReflect.Descriptor instead.
+func (*DuesEntry) Descriptor() ([]byte, []int) {
+	return file_dues_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DuesEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DuesEntry) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *DuesEntry) GetKind() DuesEntryKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DuesEntryKind_DUES_ENTRY_KIND_UNSPECIFIED
+}
+
+func (x *DuesEntry) GetAmountCents() int64 {
+	if x != nil {
+		return x.AmountCents
+	}
+	return 0
+}
+
+func (x *DuesEntry) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DuesEntry) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *DuesEntry) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *DuesEntry) GetRecordedBy() *User {
+	if x != nil {
+		return x.RecordedBy
+	}
+	return nil
+}
+
+func (x *DuesEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type DuesEntryId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuesEntryId) Reset() {
+	*x = DuesEntryId{}
+	mi := &file_dues_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuesEntryId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuesEntryId) ProtoMessage() {}
+
+func (x *DuesEntryId) ProtoReflect() protoreflect.Message {
+	mi := &file_dues_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuesEntryId.ProtoReflect.Descriptor instead.
+func (*DuesEntryId) Descriptor() ([]byte, []int) {
+	return file_dues_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DuesEntryId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RecordDuesEntryRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	UserId      string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Kind        DuesEntryKind          `protobuf:"varint,2,opt,name=kind,proto3,enum=musicclub.dues.DuesEntryKind" json:"kind,omitempty"`
+	AmountCents int64                  `protobuf:"varint,3,opt,name=amount_cents,json=amountCents,proto3" json:"amount_cents,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// Defaults to now.
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// Defaults to the season running at occurred_at.
+	SeasonId      string `protobuf:"bytes,6,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordDuesEntryRequest) Reset() {
+	*x = RecordDuesEntryRequest{}
+	mi := &file_dues_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordDuesEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordDuesEntryRequest) ProtoMessage() {}
+
+func (x *RecordDuesEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dues_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordDuesEntryRequest.ProtoReflect.Descriptor instead.
+func (*RecordDuesEntryRequest) Descriptor() ([]byte, []int) {
+	return file_dues_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RecordDuesEntryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RecordDuesEntryRequest) GetKind() DuesEntryKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DuesEntryKind_DUES_ENTRY_KIND_UNSPECIFIED
+}
+
+func (x *RecordDuesEntryRequest) GetAmountCents() int64 {
+	if x != nil {
+		return x.AmountCents
+	}
+	return 0
+}
+
+func (x *RecordDuesEntryRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RecordDuesEntryRequest) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *RecordDuesEntryRequest) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+type ListDuesEntriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Defaults to the current user.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Optional season filter.
+	SeasonId string `protobuf:"bytes,2,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	// Pagination cursor (opaque to client).
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize      uint32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDuesEntriesRequest) Reset() {
+	*x = ListDuesEntriesRequest{}
+	mi := &file_dues_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDuesEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDuesEntriesRequest) ProtoMessage() {}
+
+func (x *ListDuesEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dues_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDuesEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListDuesEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_dues_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListDuesEntriesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListDuesEntriesRequest) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *ListDuesEntriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListDuesEntriesRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListDuesEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*DuesEntry           `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDuesEntriesResponse) Reset() {
+	*x = ListDuesEntriesResponse{}
+	mi := &file_dues_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDuesEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDuesEntriesResponse) ProtoMessage() {}
+
+func (x *ListDuesEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dues_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDuesEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListDuesEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_dues_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListDuesEntriesResponse) GetEntries() []*DuesEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListDuesEntriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListDuesEntriesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type DuesBalance struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	User             *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	ContributedCents int64                  `protobuf:"varint,2,opt,name=contributed_cents,json=contributedCents,proto3" json:"contributed_cents,omitempty"`
+	ChargedCents     int64                  `protobuf:"varint,3,opt,name=charged_cents,json=chargedCents,proto3" json:"charged_cents,omitempty"`
+	// contributed minus charged: negative means the member owes the club.
+	BalanceCents  int64 `protobuf:"varint,4,opt,name=balance_cents,json=balanceCents,proto3" json:"balance_cents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuesBalance) Reset() {
+	*x = DuesBalance{}
+	mi := &file_dues_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuesBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuesBalance) ProtoMessage() {}
+
+func (x *DuesBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_dues_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuesBalance.ProtoReflect.Descriptor instead.
+func (*DuesBalance) Descriptor() ([]byte, []int) {
+	return file_dues_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DuesBalance) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *DuesBalance) GetContributedCents() int64 {
+	if x != nil {
+		return x.ContributedCents
+	}
+	return 0
+}
+
+func (x *DuesBalance) GetChargedCents() int64 {
+	if x != nil {
+		return x.ChargedCents
+	}
+	return 0
+}
+
+func (x *DuesBalance) GetBalanceCents() int64 {
+	if x != nil {
+		return x.BalanceCents
+	}
+	return 0
+}
+
+type ListDuesBalancesResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Balances []*DuesBalance         `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"`
+	// Sums over all members.
+	ContributedCents int64 `protobuf:"varint,2,opt,name=contributed_cents,json=contributedCents,proto3" json:"contributed_cents,omitempty"`
+	ChargedCents     int64 `protobuf:"varint,3,opt,name=charged_cents,json=chargedCents,proto3" json:"charged_cents,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListDuesBalancesResponse) Reset() {
+	*x = ListDuesBalancesResponse{}
+	mi := &file_dues_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDuesBalancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDuesBalancesResponse) ProtoMessage() {}
+
+func (x *ListDuesBalancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dues_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDuesBalancesResponse.ProtoReflect.Descriptor instead.
+func (*ListDuesBalancesResponse) Descriptor() ([]byte, []int) {
+	return file_dues_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListDuesBalancesResponse) GetBalances() []*DuesBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+func (x *ListDuesBalancesResponse) GetContributedCents() int64 {
+	if x != nil {
+		return x.ContributedCents
+	}
+	return 0
+}
+
+func (x *ListDuesBalancesResponse) GetChargedCents() int64 {
+	if x != nil {
+		return x.ChargedCents
+	}
+	return 0
+}
+
+type SeasonDuesSummaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeasonId      string                 `protobuf:"bytes,1,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeasonDuesSummaryRequest) Reset() {
+	*x = SeasonDuesSummaryRequest{}
+	mi := &file_dues_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeasonDuesSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeasonDuesSummaryRequest) ProtoMessage() {}
+
+func (x *SeasonDuesSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dues_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeasonDuesSummaryRequest.ProtoReflect.Descriptor instead.
+func (*SeasonDuesSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_dues_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SeasonDuesSummaryRequest) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+type SeasonDuesSummary struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Season *Season                `protobuf:"bytes,1,opt,name=season,proto3" json:"season,omitempty"`
+	// Members with entries in the season, lowest balance first.
+	Balances         []*DuesBalance `protobuf:"bytes,2,rep,name=balances,proto3" json:"balances,omitempty"`
+	ContributedCents int64          `protobuf:"varint,3,opt,name=contributed_cents,json=contributedCents,proto3" json:"contributed_cents,omitempty"`
+	ChargedCents     int64          `protobuf:"varint,4,opt,name=charged_cents,json=chargedCents,proto3" json:"charged_cents,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SeasonDuesSummary) Reset() {
+	*x = SeasonDuesSummary{}
+	mi := &file_dues_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeasonDuesSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeasonDuesSummary) ProtoMessage() {}
+
+func (x *SeasonDuesSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_dues_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeasonDuesSummary.ProtoReflect.Descriptor instead.
+func (*SeasonDuesSummary) Descriptor() ([]byte, []int) {
+	return file_dues_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SeasonDuesSummary) GetSeason() *Season {
+	if x != nil {
+		return x.Season
+	}
+	return nil
+}
+
+func (x *SeasonDuesSummary) GetBalances() []*DuesBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+func (x *SeasonDuesSummary) GetContributedCents() int64 {
+	if x != nil {
+		return x.ContributedCents
+	}
+	return 0
+}
+
+func (x *SeasonDuesSummary) GetChargedCents() int64 {
+	if x != nil {
+		return x.ChargedCents
+	}
+	return 0
+}
+
+var File_dues_proto protoreflect.FileDescriptor
+
+const file_dues_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"dues.proto\x12\x0emusicclub.dues\x1a\x1bbuf/validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\fseason.proto\x1a\n" +
+	"user.proto\"\x89\x03\n" +
+	"\tDuesEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12(\n" +
+	"\x04user\x18\x02 \x01(\v2\x14.musicclub.user.UserR\x04user\x121\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x1d.musicclub.dues.DuesEntryKindR\x04kind\x12!\n" +
+	"\famount_cents\x18\x04 \x01(\x03R\vamountCents\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x1b\n" +
+	"\tseason_id\x18\x06 \x01(\tR\bseasonId\x12;\n" +
+	"\voccurred_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x125\n" +
+	"\vrecorded_by\x18\b \x01(\v2\x14.musicclub.user.UserR\n" +
+	"recordedBy\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"'\n" +
+	"\vDuesEntryId\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x9a\x02\n" +
+	"\x16RecordDuesEntryRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12>\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x1d.musicclub.dues.DuesEntryKindB\v\xbaH\b\xc8\x01\x01\x82\x01\x02\x10\x01R\x04kind\x12!\n" +
+	"\famount_cents\x18\x03 \x01(\x03R\vamountCents\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12;\n" +
+	"\voccurred_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x1b\n" +
+	"\tseason_id\x18\x06 \x01(\tR\bseasonId\"\x94\x01\n" +
+	"\x16ListDuesEntriesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tseason_id\x18\x02 \x01(\tR\bseasonId\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12%\n" +
+	"\tpage_size\x18\x04 \x01(\rB\b\xbaH\x05*\x03\x18\xc8\x01R\bpageSize\"\x97\x01\n" +
+	"\x17ListDuesEntriesResponse\x123\n" +
+	"\aentries\x18\x01 \x03(\v2\x19.musicclub.dues.DuesEntryR\aentries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"\xae\x01\n" +
+	"\vDuesBalance\x12(\n" +
+	"\x04user\x18\x01 \x01(\v2\x14.musicclub.user.UserR\x04user\x12+\n" +
+	"\x11contributed_cents\x18\x02 \x01(\x03R\x10contributedCents\x12#\n" +
+	"\rcharged_cents\x18\x03 \x01(\x03R\fchargedCents\x12#\n" +
+	"\rbalance_cents\x18\x04 \x01(\x03R\fbalanceCents\"\xa5\x01\n" +
+	"\x18ListDuesBalancesResponse\x127\n" +
+	"\bbalances\x18\x01 \x03(\v2\x1b.musicclub.dues.DuesBalanceR\bbalances\x12+\n" +
+	"\x11contributed_cents\x18\x02 \x01(\x03R\x10contributedCents\x12#\n" +
+	"\rcharged_cents\x18\x03 \x01(\x03R\fchargedCents\"7\n" +
+	"\x18SeasonDuesSummaryRequest\x12\x1b\n" +
+	"\tseason_id\x18\x01 \x01(\tR\bseasonId\"\xd0\x01\n" +
+	"\x11SeasonDuesSummary\x120\n" +
+	"\x06season\x18\x01 \x01(\v2\x18.musicclub.season.SeasonR\x06season\x127\n" +
+	"\bbalances\x18\x02 \x03(\v2\x1b.musicclub.dues.DuesBalanceR\bbalances\x12+\n" +
+	"\x11contributed_cents\x18\x03 \x01(\x03R\x10contributedCents\x12#\n" +
+	"\rcharged_cents\x18\x04 \x01(\x03R\fchargedCents*n\n" +
+	"\rDuesEntryKind\x12\x1f\n" +
+	"\x1bDUES_ENTRY_KIND_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cDUES_ENTRY_KIND_CONTRIBUTION\x10\x01\x12\x1a\n" +
+	"\x16DUES_ENTRY_KIND_CHARGE\x10\x022\x93\x04\n" +
+	"\vDuesService\x12T\n" +
+	"\x0fRecordDuesEntry\x12&.musicclub.dues.RecordDuesEntryRequest\x1a\x19.musicclub.dues.DuesEntry\x12F\n" +
+	"\x0fDeleteDuesEntry\x12\x1b.musicclub.dues.DuesEntryId\x1a\x16.google.protobuf.Empty\x12b\n" +
+	"\x0fListDuesEntries\x12&.musicclub.dues.ListDuesEntriesRequest\x1a'.musicclub.dues.ListDuesEntriesResponse\x12G\n" +
+	"\x10GetMyDuesBalance\x12\x16.google.protobuf.Empty\x1a\x1b.musicclub.dues.DuesBalance\x12T\n" +
+	"\x10ListDuesBalances\x12\x16.google.protobuf.Empty\x1a(.musicclub.dues.ListDuesBalancesResponse\x12c\n" +
+	"\x14GetSeasonDuesSummary\x12(.musicclub.dues.SeasonDuesSummaryRequest\x1a!.musicclub.dues.SeasonDuesSummaryB\x1cZ\x1amusicclubbot/backend/protob\x06proto3"
+
+var (
+	file_dues_proto_rawDescOnce sync.Once
+	file_dues_proto_rawDescData []byte
+)
+
+func file_dues_proto_rawDescGZIP() []byte {
+	file_dues_proto_rawDescOnce.Do(func() {
+		file_dues_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_dues_proto_rawDesc), len(file_dues_proto_rawDesc)))
+	})
+	return file_dues_proto_rawDescData
+}
+
+var file_dues_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_dues_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_dues_proto_goTypes = []any{
+	(DuesEntryKind)(0),               // 0: musicclub.dues.DuesEntryKind
+	(*DuesEntry)(nil),                // 1: musicclub.dues.DuesEntry
+	(*DuesEntryId)(nil),              // 2: musicclub.dues.DuesEntryId
+	(*RecordDuesEntryRequest)(nil),   // 3: musicclub.dues.RecordDuesEntryRequest
+	(*ListDuesEntriesRequest)(nil),   // 4: musicclub.dues.ListDuesEntriesRequest
+	(*ListDuesEntriesResponse)(nil),  // 5: musicclub.dues.ListDuesEntriesResponse
+	(*DuesBalance)(nil),              // 6: musicclub.dues.DuesBalance
+	(*ListDuesBalancesResponse)(nil), // 7: musicclub.dues.ListDuesBalancesResponse
+	(*SeasonDuesSummaryRequest)(nil), // 8: musicclub.dues.SeasonDuesSummaryRequest
+	(*SeasonDuesSummary)(nil),        // 9: musicclub.dues.SeasonDuesSummary
+	(*User)(nil),                     // 10: musicclub.user.User
+	(*timestamppb.Timestamp)(nil),    // 11: google.protobuf.Timestamp
+	(*Season)(nil),                   // 12: musicclub.season.Season
+	(*emptypb.Empty)(nil),            // 13: google.protobuf.Empty
+}
+var file_dues_proto_depIdxs = []int32{
+	10, // 0: musicclub.dues.DuesEntry.user:type_name -> musicclub.user.User
+	0,  // 1: musicclub.dues.DuesEntry.kind:type_name -> musicclub.dues.DuesEntryKind
+	11, // 2: musicclub.dues.DuesEntry.occurred_at:type_name -> google.protobuf.Timestamp
+	10, // 3: musicclub.dues.DuesEntry.recorded_by:type_name -> musicclub.user.User
+	11, // 4: musicclub.dues.DuesEntry.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 5: musicclub.dues.RecordDuesEntryRequest.kind:type_name -> musicclub.dues.DuesEntryKind
+	11, // 6: musicclub.dues.RecordDuesEntryRequest.occurred_at:type_name -> google.protobuf.Timestamp
+	1,  // 7: musicclub.dues.ListDuesEntriesResponse.entries:type_name -> musicclub.dues.DuesEntry
+	10, // 8: musicclub.dues.DuesBalance.user:type_name -> musicclub.user.User
+	6,  // 9: musicclub.dues.ListDuesBalancesResponse.balances:type_name -> musicclub.dues.DuesBalance
+	12, // 10: musicclub.dues.SeasonDuesSummary.season:type_name -> musicclub.season.Season
+	6,  // 11: musicclub.dues.SeasonDuesSummary.balances:type_name -> musicclub.dues.DuesBalance
+	3,  // 12: musicclub.dues.DuesService.RecordDuesEntry:input_type -> musicclub.dues.RecordDuesEntryRequest
+	2,  // 13: musicclub.dues.DuesService.DeleteDuesEntry:input_type -> musicclub.dues.DuesEntryId
+	4,  // 14: musicclub.dues.DuesService.ListDuesEntries:input_type -> musicclub.dues.ListDuesEntriesRequest
+	13, // 15: musicclub.dues.DuesService.GetMyDuesBalance:input_type -> google.protobuf.Empty
+	13, // 16: musicclub.dues.DuesService.ListDuesBalances:input_type -> google.protobuf.Empty
+	8,  // 17: musicclub.dues.DuesService.GetSeasonDuesSummary:input_type -> musicclub.dues.SeasonDuesSummaryRequest
+	1,  // 18: musicclub.dues.DuesService.RecordDuesEntry:output_type -> musicclub.dues.DuesEntry
+	13, // 19: musicclub.dues.DuesService.DeleteDuesEntry:output_type -> google.protobuf.Empty
+	5,  // 20: musicclub.dues.DuesService.ListDuesEntries:output_type -> musicclub.dues.ListDuesEntriesResponse
+	6,  // 21: musicclub.dues.DuesService.GetMyDuesBalance:output_type -> musicclub.dues.DuesBalance
+	7,  // 22: musicclub.dues.DuesService.ListDuesBalances:output_type -> musicclub.dues.ListDuesBalancesResponse
+	9,  // 23: musicclub.dues.DuesService.GetSeasonDuesSummary:output_type -> musicclub.dues.SeasonDuesSummary
+	18, // [18:24] is the sub-list for method output_type
+	12, // [12:18] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_dues_proto_init() }
+func file_dues_proto_init() {
+	if File_dues_proto != nil {
+		return
+	}
+	file_season_proto_init()
+	file_user_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_dues_proto_rawDesc), len(file_dues_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_dues_proto_goTypes,
+		DependencyIndexes: file_dues_proto_depIdxs,
+		EnumInfos:         file_dues_proto_enumTypes,
+		MessageInfos:      file_dues_proto_msgTypes,
+	}.Build()
+	File_dues_proto = out.File
+	file_dues_proto_goTypes = nil
+	file_dues_proto_depIdxs = nil
+}
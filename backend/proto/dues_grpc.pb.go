@@ -0,0 +1,336 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v3.21.12
+// source: dues.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DuesService_RecordDuesEntry_FullMethodName      = "/musicclub.dues.DuesService/RecordDuesEntry"
+	DuesService_DeleteDuesEntry_FullMethodName      = "/musicclub.dues.DuesService/DeleteDuesEntry"
+	DuesService_ListDuesEntries_FullMethodName      = "/musicclub.dues.DuesService/ListDuesEntries"
+	DuesService_GetMyDuesBalance_FullMethodName     = "/musicclub.dues.DuesService/GetMyDuesBalance"
+	DuesService_ListDuesBalances_FullMethodName     = "/musicclub.dues.DuesService/ListDuesBalances"
+	DuesService_GetSeasonDuesSummary_FullMethodName = "/musicclub.dues.DuesService/GetSeasonDuesSummary"
+)
+
+// DuesServiceClient is the client API for DuesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Club dues ledger kept by admins by hand: what members paid in and what
+// they were charged. Nothing here moves money.
+type DuesServiceClient interface {
+	// Admins only.
+	RecordDuesEntry(ctx context.Context, in *RecordDuesEntryRequest, opts ...grpc.CallOption) (*DuesEntry, error)
+	// Admins only.
+	DeleteDuesEntry(ctx context.Context, in *DuesEntryId, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Entries, latest first. Members may list their own; anyone else's needs
+	// admin rights.
+	ListDuesEntries(ctx context.Context, in *ListDuesEntriesRequest, opts ...grpc.CallOption) (*ListDuesEntriesResponse, error)
+	// The current user's all-time balance.
+	GetMyDuesBalance(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DuesBalance, error)
+	// All-time balance of every member with entries, lowest first. Admins
+	// only.
+	ListDuesBalances(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListDuesBalancesResponse, error)
+	// Totals of the season's entries, per member and for the club. Admins
+	// only.
+	GetSeasonDuesSummary(ctx context.Context, in *SeasonDuesSummaryRequest, opts ...grpc.CallOption) (*SeasonDuesSummary, error)
+}
+
+type duesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDuesServiceClient(cc grpc.ClientConnInterface) DuesServiceClient {
+	return &duesServiceClient{cc}
+}
+
+func (c *duesServiceClient) RecordDuesEntry(ctx context.Context, in *RecordDuesEntryRequest, opts ...grpc.CallOption) (*DuesEntry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DuesEntry)
+	err := c.cc.Invoke(ctx, DuesService_RecordDuesEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *duesServiceClient) DeleteDuesEntry(ctx context.Context, in *DuesEntryId, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DuesService_DeleteDuesEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *duesServiceClient) ListDuesEntries(ctx context.Context, in *ListDuesEntriesRequest, opts ...grpc.CallOption) (*ListDuesEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDuesEntriesResponse)
+	err := c.cc.Invoke(ctx, DuesService_ListDuesEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *duesServiceClient) GetMyDuesBalance(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DuesBalance, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DuesBalance)
+	err := c.cc.Invoke(ctx, DuesService_GetMyDuesBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *duesServiceClient) ListDuesBalances(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListDuesBalancesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDuesBalancesResponse)
+	err := c.cc.Invoke(ctx, DuesService_ListDuesBalances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *duesServiceClient) GetSeasonDuesSummary(ctx context.Context, in *SeasonDuesSummaryRequest, opts ...grpc.CallOption) (*SeasonDuesSummary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SeasonDuesSummary)
+	err := c.cc.Invoke(ctx, DuesService_GetSeasonDuesSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DuesServiceServer is the server API for DuesService service.
+// All implementations must embed UnimplementedDuesServiceServer
+// for forward compatibility.
+//
+// Club dues ledger kept by admins by hand: what members paid in and what
+// they were charged. Nothing here moves money.
+type DuesServiceServer interface {
+	// Admins only.
+	RecordDuesEntry(context.Context, *RecordDuesEntryRequest) (*DuesEntry, error)
+	// Admins only.
+	DeleteDuesEntry(context.Context, *DuesEntryId) (*emptypb.Empty, error)
+	// Entries, latest first. Members may list their own; anyone else's needs
+	// admin rights.
+	ListDuesEntries(context.Context, *ListDuesEntriesRequest) (*ListDuesEntriesResponse, error)
+	// The current user's all-time balance.
+	GetMyDuesBalance(context.Context, *emptypb.Empty) (*DuesBalance, error)
+	// All-time balance of every member with entries, lowest first. Admins
+	// only.
+	ListDuesBalances(context.Context, *emptypb.Empty) (*ListDuesBalancesResponse, error)
+	// Totals of the season's entries, per member and for the club. Admins
+	// only.
+	GetSeasonDuesSummary(context.Context, *SeasonDuesSummaryRequest) (*SeasonDuesSummary, error)
+	mustEmbedUnimplementedDuesServiceServer()
+}
+
+// UnimplementedDuesServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDuesServiceServer struct{}
+
+func (UnimplementedDuesServiceServer) RecordDuesEntry(context.Context, *RecordDuesEntryRequest) (*DuesEntry, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecordDuesEntry not implemented")
+}
+func (UnimplementedDuesServiceServer) DeleteDuesEntry(context.Context, *DuesEntryId) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteDuesEntry not implemented")
+}
+func (UnimplementedDuesServiceServer) ListDuesEntries(context.Context, *ListDuesEntriesRequest) (*ListDuesEntriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDuesEntries not implemented")
+}
+func (UnimplementedDuesServiceServer) GetMyDuesBalance(context.Context, *emptypb.Empty) (*DuesBalance, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMyDuesBalance not implemented")
+}
+func (UnimplementedDuesServiceServer) ListDuesBalances(context.Context, *emptypb.Empty) (*ListDuesBalancesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDuesBalances not implemented")
+}
+func (UnimplementedDuesServiceServer) GetSeasonDuesSummary(context.Context, *SeasonDuesSummaryRequest) (*SeasonDuesSummary, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSeasonDuesSummary not implemented")
+}
+func (UnimplementedDuesServiceServer) mustEmbedUnimplementedDuesServiceServer() {}
+func (UnimplementedDuesServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeDuesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DuesServiceServer will
+// result in compilation errors.
+type UnsafeDuesServiceServer interface {
+	mustEmbedUnimplementedDuesServiceServer()
+}
+
+func RegisterDuesServiceServer(s grpc.ServiceRegistrar, srv DuesServiceServer) {
+	// If the following call panics, it indicates UnimplementedDuesServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DuesService_ServiceDesc, srv)
+}
+
+func _DuesService_RecordDuesEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordDuesEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DuesServiceServer).RecordDuesEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DuesService_RecordDuesEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DuesServiceServer).RecordDuesEntry(ctx, req.(*RecordDuesEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DuesService_DeleteDuesEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DuesEntryId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DuesServiceServer).DeleteDuesEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DuesService_DeleteDuesEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DuesServiceServer).DeleteDuesEntry(ctx, req.(*DuesEntryId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DuesService_ListDuesEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDuesEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DuesServiceServer).ListDuesEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DuesService_ListDuesEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DuesServiceServer).ListDuesEntries(ctx, req.(*ListDuesEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DuesService_GetMyDuesBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DuesServiceServer).GetMyDuesBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DuesService_GetMyDuesBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DuesServiceServer).GetMyDuesBalance(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DuesService_ListDuesBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DuesServiceServer).ListDuesBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DuesService_ListDuesBalances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DuesServiceServer).ListDuesBalances(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DuesService_GetSeasonDuesSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SeasonDuesSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DuesServiceServer).GetSeasonDuesSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DuesService_GetSeasonDuesSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DuesServiceServer).GetSeasonDuesSummary(ctx, req.(*SeasonDuesSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DuesService_ServiceDesc is the grpc.ServiceDesc for DuesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DuesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "musicclub.dues.DuesService",
+	HandlerType: (*DuesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RecordDuesEntry",
+			Handler:    _DuesService_RecordDuesEntry_Handler,
+		},
+		{
+			MethodName: "DeleteDuesEntry",
+			Handler:    _DuesService_DeleteDuesEntry_Handler,
+		},
+		{
+			MethodName: "ListDuesEntries",
+			Handler:    _DuesService_ListDuesEntries_Handler,
+		},
+		{
+			MethodName: "GetMyDuesBalance",
+			Handler:    _DuesService_GetMyDuesBalance_Handler,
+		},
+		{
+			MethodName: "ListDuesBalances",
+			Handler:    _DuesService_ListDuesBalances_Handler,
+		},
+		{
+			MethodName: "GetSeasonDuesSummary",
+			Handler:    _DuesService_GetSeasonDuesSummary_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dues.proto",
+}
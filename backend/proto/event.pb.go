@@ -7,9 +7,11 @@
 package proto
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -23,28 +25,4842 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type EventTimeFilter int32
+
+const (
+	EventTimeFilter_EVENT_TIME_FILTER_ALL      EventTimeFilter = 0
+	EventTimeFilter_EVENT_TIME_FILTER_UPCOMING EventTimeFilter = 1
+	EventTimeFilter_EVENT_TIME_FILTER_PAST     EventTimeFilter = 2
+)
+
+// Enum value maps for EventTimeFilter.
+var (
+	EventTimeFilter_name = map[int32]string{
+		0: "EVENT_TIME_FILTER_ALL",
+		1: "EVENT_TIME_FILTER_UPCOMING",
+		2: "EVENT_TIME_FILTER_PAST",
+	}
+	EventTimeFilter_value = map[string]int32{
+		"EVENT_TIME_FILTER_ALL":      0,
+		"EVENT_TIME_FILTER_UPCOMING": 1,
+		"EVENT_TIME_FILTER_PAST":     2,
+	}
+)
+
+func (x EventTimeFilter) Enum() *EventTimeFilter {
+	p := new(EventTimeFilter)
+	*p = x
+	return p
+}
+
+func (x EventTimeFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventTimeFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_proto_enumTypes[0].Descriptor()
+}
+
+func (EventTimeFilter) Type() protoreflect.EnumType {
+	return &file_event_proto_enumTypes[0]
+}
+
+func (x EventTimeFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventTimeFilter.Descriptor instead.
+func (EventTimeFilter) EnumDescriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{0}
+}
+
+type RsvpStatus int32
+
+const (
+	RsvpStatus_RSVP_STATUS_UNSPECIFIED RsvpStatus = 0
+	RsvpStatus_RSVP_STATUS_GOING       RsvpStatus = 1
+	RsvpStatus_RSVP_STATUS_MAYBE       RsvpStatus = 2
+	RsvpStatus_RSVP_STATUS_DECLINED    RsvpStatus = 3
+	// Asked to go while the event was full; promoted in order as spots free up.
+	RsvpStatus_RSVP_STATUS_WAITLISTED RsvpStatus = 4
+)
+
+// Enum value maps for RsvpStatus.
+var (
+	RsvpStatus_name = map[int32]string{
+		0: "RSVP_STATUS_UNSPECIFIED",
+		1: "RSVP_STATUS_GOING",
+		2: "RSVP_STATUS_MAYBE",
+		3: "RSVP_STATUS_DECLINED",
+		4: "RSVP_STATUS_WAITLISTED",
+	}
+	RsvpStatus_value = map[string]int32{
+		"RSVP_STATUS_UNSPECIFIED": 0,
+		"RSVP_STATUS_GOING":       1,
+		"RSVP_STATUS_MAYBE":       2,
+		"RSVP_STATUS_DECLINED":    3,
+		"RSVP_STATUS_WAITLISTED":  4,
+	}
+)
+
+func (x RsvpStatus) Enum() *RsvpStatus {
+	p := new(RsvpStatus)
+	*p = x
+	return p
+}
+
+func (x RsvpStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RsvpStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_proto_enumTypes[1].Descriptor()
+}
+
+func (RsvpStatus) Type() protoreflect.EnumType {
+	return &file_event_proto_enumTypes[1]
+}
+
+func (x RsvpStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RsvpStatus.Descriptor instead.
+func (RsvpStatus) EnumDescriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{1}
+}
+
+type TracklistWarningKind int32
+
+const (
+	TracklistWarningKind_TRACKLIST_WARNING_KIND_UNSPECIFIED TracklistWarningKind = 0
+	// The member plays back-to-back items on different instruments.
+	TracklistWarningKind_TRACKLIST_WARNING_KIND_INSTRUMENT_CHANGE TracklistWarningKind = 1
+	// The member is in two items scheduled at overlapping times, e.g. sets
+	// with fixed start times that run into each other.
+	TracklistWarningKind_TRACKLIST_WARNING_KIND_OVERLAP TracklistWarningKind = 2
+	// The item's song lacks the event's theme tag; user and roles are empty.
+	TracklistWarningKind_TRACKLIST_WARNING_KIND_OFF_THEME TracklistWarningKind = 3
+)
+
+// Enum value maps for TracklistWarningKind.
+var (
+	TracklistWarningKind_name = map[int32]string{
+		0: "TRACKLIST_WARNING_KIND_UNSPECIFIED",
+		1: "TRACKLIST_WARNING_KIND_INSTRUMENT_CHANGE",
+		2: "TRACKLIST_WARNING_KIND_OVERLAP",
+		3: "TRACKLIST_WARNING_KIND_OFF_THEME",
+	}
+	TracklistWarningKind_value = map[string]int32{
+		"TRACKLIST_WARNING_KIND_UNSPECIFIED":       0,
+		"TRACKLIST_WARNING_KIND_INSTRUMENT_CHANGE": 1,
+		"TRACKLIST_WARNING_KIND_OVERLAP":           2,
+		"TRACKLIST_WARNING_KIND_OFF_THEME":         3,
+	}
+)
+
+func (x TracklistWarningKind) Enum() *TracklistWarningKind {
+	p := new(TracklistWarningKind)
+	*p = x
+	return p
+}
+
+func (x TracklistWarningKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TracklistWarningKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_proto_enumTypes[2].Descriptor()
+}
+
+func (TracklistWarningKind) Type() protoreflect.EnumType {
+	return &file_event_proto_enumTypes[2]
+}
+
+func (x TracklistWarningKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TracklistWarningKind.Descriptor instead.
+func (TracklistWarningKind) EnumDescriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{2}
+}
+
+type TrackRehearsalStatus int32
+
+const (
+	TrackRehearsalStatus_TRACK_REHEARSAL_STATUS_UNSPECIFIED TrackRehearsalStatus = 0
+	TrackRehearsalStatus_TRACK_REHEARSAL_STATUS_NOT_STARTED TrackRehearsalStatus = 1
+	TrackRehearsalStatus_TRACK_REHEARSAL_STATUS_IN_PROGRESS TrackRehearsalStatus = 2
+	TrackRehearsalStatus_TRACK_REHEARSAL_STATUS_READY       TrackRehearsalStatus = 3
+)
+
+// Enum value maps for TrackRehearsalStatus.
+var (
+	TrackRehearsalStatus_name = map[int32]string{
+		0: "TRACK_REHEARSAL_STATUS_UNSPECIFIED",
+		1: "TRACK_REHEARSAL_STATUS_NOT_STARTED",
+		2: "TRACK_REHEARSAL_STATUS_IN_PROGRESS",
+		3: "TRACK_REHEARSAL_STATUS_READY",
+	}
+	TrackRehearsalStatus_value = map[string]int32{
+		"TRACK_REHEARSAL_STATUS_UNSPECIFIED": 0,
+		"TRACK_REHEARSAL_STATUS_NOT_STARTED": 1,
+		"TRACK_REHEARSAL_STATUS_IN_PROGRESS": 2,
+		"TRACK_REHEARSAL_STATUS_READY":       3,
+	}
+)
+
+func (x TrackRehearsalStatus) Enum() *TrackRehearsalStatus {
+	p := new(TrackRehearsalStatus)
+	*p = x
+	return p
+}
+
+func (x TrackRehearsalStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TrackRehearsalStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_proto_enumTypes[3].Descriptor()
+}
+
+func (TrackRehearsalStatus) Type() protoreflect.EnumType {
+	return &file_event_proto_enumTypes[3]
+}
+
+func (x TrackRehearsalStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TrackRehearsalStatus.Descriptor instead.
+func (TrackRehearsalStatus) EnumDescriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{3}
+}
+
+type RecurrenceScope int32
+
+const (
+	RecurrenceScope_RECURRENCE_SCOPE_THIS_OCCURRENCE RecurrenceScope = 0
+	RecurrenceScope_RECURRENCE_SCOPE_ALL_FUTURE      RecurrenceScope = 1
+)
+
+// Enum value maps for RecurrenceScope.
+var (
+	RecurrenceScope_name = map[int32]string{
+		0: "RECURRENCE_SCOPE_THIS_OCCURRENCE",
+		1: "RECURRENCE_SCOPE_ALL_FUTURE",
+	}
+	RecurrenceScope_value = map[string]int32{
+		"RECURRENCE_SCOPE_THIS_OCCURRENCE": 0,
+		"RECURRENCE_SCOPE_ALL_FUTURE":      1,
+	}
+)
+
+func (x RecurrenceScope) Enum() *RecurrenceScope {
+	p := new(RecurrenceScope)
+	*p = x
+	return p
+}
+
+func (x RecurrenceScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecurrenceScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_proto_enumTypes[4].Descriptor()
+}
+
+func (RecurrenceScope) Type() protoreflect.EnumType {
+	return &file_event_proto_enumTypes[4]
+}
+
+func (x RecurrenceScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RecurrenceScope.Descriptor instead.
+func (RecurrenceScope) EnumDescriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{4}
+}
+
+type AnnouncementFormat int32
+
+const (
+	// Telegram HTML parse mode.
+	AnnouncementFormat_ANNOUNCEMENT_FORMAT_HTML AnnouncementFormat = 0
+	// Telegram MarkdownV2 parse mode.
+	AnnouncementFormat_ANNOUNCEMENT_FORMAT_MARKDOWN AnnouncementFormat = 1
+)
+
+// Enum value maps for AnnouncementFormat.
+var (
+	AnnouncementFormat_name = map[int32]string{
+		0: "ANNOUNCEMENT_FORMAT_HTML",
+		1: "ANNOUNCEMENT_FORMAT_MARKDOWN",
+	}
+	AnnouncementFormat_value = map[string]int32{
+		"ANNOUNCEMENT_FORMAT_HTML":     0,
+		"ANNOUNCEMENT_FORMAT_MARKDOWN": 1,
+	}
+)
+
+func (x AnnouncementFormat) Enum() *AnnouncementFormat {
+	p := new(AnnouncementFormat)
+	*p = x
+	return p
+}
+
+func (x AnnouncementFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnnouncementFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_proto_enumTypes[5].Descriptor()
+}
+
+func (AnnouncementFormat) Type() protoreflect.EnumType {
+	return &file_event_proto_enumTypes[5]
+}
+
+func (x AnnouncementFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnnouncementFormat.Descriptor instead.
+func (AnnouncementFormat) EnumDescriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{5}
+}
+
+type TracklistExportFormat int32
+
+const (
+	TracklistExportFormat_TRACKLIST_EXPORT_FORMAT_TEXT TracklistExportFormat = 0
+	TracklistExportFormat_TRACKLIST_EXPORT_FORMAT_PDF  TracklistExportFormat = 1
+)
+
+// Enum value maps for TracklistExportFormat.
+var (
+	TracklistExportFormat_name = map[int32]string{
+		0: "TRACKLIST_EXPORT_FORMAT_TEXT",
+		1: "TRACKLIST_EXPORT_FORMAT_PDF",
+	}
+	TracklistExportFormat_value = map[string]int32{
+		"TRACKLIST_EXPORT_FORMAT_TEXT": 0,
+		"TRACKLIST_EXPORT_FORMAT_PDF":  1,
+	}
+)
+
+func (x TracklistExportFormat) Enum() *TracklistExportFormat {
+	p := new(TracklistExportFormat)
+	*p = x
+	return p
+}
+
+func (x TracklistExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TracklistExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_proto_enumTypes[6].Descriptor()
+}
+
+func (TracklistExportFormat) Type() protoreflect.EnumType {
+	return &file_event_proto_enumTypes[6]
+}
+
+func (x TracklistExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TracklistExportFormat.Descriptor instead.
+func (TracklistExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{6}
+}
+
+type RideKind int32
+
+const (
+	RideKind_RIDE_KIND_UNSPECIFIED RideKind = 0
+	// The author drives and has free seats.
+	RideKind_RIDE_KIND_OFFER RideKind = 1
+	// The author needs seats.
+	RideKind_RIDE_KIND_REQUEST RideKind = 2
+)
+
+// Enum value maps for RideKind.
+var (
+	RideKind_name = map[int32]string{
+		0: "RIDE_KIND_UNSPECIFIED",
+		1: "RIDE_KIND_OFFER",
+		2: "RIDE_KIND_REQUEST",
+	}
+	RideKind_value = map[string]int32{
+		"RIDE_KIND_UNSPECIFIED": 0,
+		"RIDE_KIND_OFFER":       1,
+		"RIDE_KIND_REQUEST":     2,
+	}
+)
+
+func (x RideKind) Enum() *RideKind {
+	p := new(RideKind)
+	*p = x
+	return p
+}
+
+func (x RideKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RideKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_proto_enumTypes[7].Descriptor()
+}
+
+func (RideKind) Type() protoreflect.EnumType {
+	return &file_event_proto_enumTypes[7]
+}
+
+func (x RideKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RideKind.Descriptor instead.
+func (RideKind) EnumDescriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{7}
+}
+
 type EventId struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventId) Reset() {
+	*x = EventId{}
+	mi := &file_event_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventId) ProtoMessage() {}
+
+func (x *EventId) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventId.ProtoReflect.Descriptor instead.
+func (*EventId) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EventId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type EventOwnerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventOwnerRequest) Reset() {
+	*x = EventOwnerRequest{}
+	mi := &file_event_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventOwnerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventOwnerRequest) ProtoMessage() {}
+
+func (x *EventOwnerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventOwnerRequest.ProtoReflect.Descriptor instead.
+func (*EventOwnerRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EventOwnerRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *EventOwnerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type CancelEventRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// Optional; shown on the event and in the message to participants.
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelEventRequest) Reset() {
+	*x = CancelEventRequest{}
+	mi := &file_event_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelEventRequest) ProtoMessage() {}
+
+func (x *CancelEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelEventRequest.ProtoReflect.Descriptor instead.
+func (*CancelEventRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CancelEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CancelEventRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	From  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// Deprecated: use page_size.
+	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Upcoming events are sorted soonest first, past events latest first.
+	TimeFilter EventTimeFilter `protobuf:"varint,4,opt,name=time_filter,json=timeFilter,proto3,enum=musicclub.event.EventTimeFilter" json:"time_filter,omitempty"`
+	// Pagination cursor (opaque to client).
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  uint32 `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Only events at this venue.
+	VenueId string `protobuf:"bytes,7,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	// Only events of this season.
+	SeasonId string `protobuf:"bytes,8,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	// List archived (deleted) events instead of live ones (requires
+	// edit_events).
+	Archived      bool `protobuf:"varint,9,opt,name=archived,proto3" json:"archived,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventsRequest) Reset() {
+	*x = ListEventsRequest{}
+	mi := &file_event_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsRequest) ProtoMessage() {}
+
+func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventsRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListEventsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListEventsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListEventsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListEventsRequest) GetTimeFilter() EventTimeFilter {
+	if x != nil {
+		return x.TimeFilter
+	}
+	return EventTimeFilter_EVENT_TIME_FILTER_ALL
+}
+
+func (x *ListEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEventsRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+type ListEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventsResponse) Reset() {
+	*x = ListEventsResponse{}
+	mi := &file_event_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsResponse) ProtoMessage() {}
+
+func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListEventsResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListEventsResponse) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type Event struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title    string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	StartAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	Location string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	// Notification preferences for reminders.
+	NotifyDayBefore  bool `protobuf:"varint,5,opt,name=notify_day_before,json=notifyDayBefore,proto3" json:"notify_day_before,omitempty"`
+	NotifyHourBefore bool `protobuf:"varint,6,opt,name=notify_hour_before,json=notifyHourBefore,proto3" json:"notify_hour_before,omitempty"`
+	// Row version, incremented on every update.
+	Version int64 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	// Number of distinct users taking part in the event.
+	ParticipantCount int32 `protobuf:"varint,8,opt,name=participant_count,json=participantCount,proto3" json:"participant_count,omitempty"`
+	// Number of items in the tracklist.
+	TrackCount int32 `protobuf:"varint,9,opt,name=track_count,json=trackCount,proto3" json:"track_count,omitempty"`
+	// Series this event is an occurrence of, empty for one-off events.
+	SeriesId string `protobuf:"bytes,10,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	// RRULE of the series, e.g. "FREQ=WEEKLY;BYDAY=TH".
+	Recurrence string `protobuf:"bytes,11,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	// Maximum number of people going, 0 for unlimited.
+	MaxParticipants int32 `protobuf:"varint,12,opt,name=max_participants,json=maxParticipants,proto3" json:"max_participants,omitempty"`
+	// Roles the event needs covered (checklist for organizers).
+	RequiredRoles []string `protobuf:"bytes,13,rep,name=required_roles,json=requiredRoles,proto3" json:"required_roles,omitempty"`
+	// Number of people who checked in at the event.
+	AttendanceCount int32 `protobuf:"varint,14,opt,name=attendance_count,json=attendanceCount,proto3" json:"attendance_count,omitempty"`
+	// Venue the event takes place at; location falls back to its name.
+	VenueId string `protobuf:"bytes,15,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	// Length of the slot the band has to play in, 0 if not limited.
+	TimeSlotMinutes int32 `protobuf:"varint,16,opt,name=time_slot_minutes,json=timeSlotMinutes,proto3" json:"time_slot_minutes,omitempty"`
+	// When the event was marked as played; unset for upcoming events.
+	CompletedAt *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	// Reminders sent this many minutes before the start, largest first (e.g.
+	// 10080, 1440, 120). notify_day_before/notify_hour_before mirror 1440/60.
+	NotifyOffsetsMinutes []int32 `protobuf:"varint,18,rep,packed,name=notify_offsets_minutes,json=notifyOffsetsMinutes,proto3" json:"notify_offsets_minutes,omitempty"`
+	// Set for cancelled events, which stay visible with a badge.
+	CancelledAt  *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	CancelReason string                 `protobuf:"bytes,20,opt,name=cancel_reason,json=cancelReason,proto3" json:"cancel_reason,omitempty"`
+	// IANA timezone the event is planned in, e.g. "Europe/Moscow".
+	Timezone string `protobuf:"bytes,21,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// start_at in that timezone as RFC 3339, e.g. "2026-03-15T19:00:00+03:00".
+	LocalStartAt string `protobuf:"bytes,22,opt,name=local_start_at,json=localStartAt,proto3" json:"local_start_at,omitempty"`
+	SeasonId     string `protobuf:"bytes,23,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	// Pause between tracklist items used for scheduled start times.
+	TrackGapSeconds int32 `protobuf:"varint,24,opt,name=track_gap_seconds,json=trackGapSeconds,proto3" json:"track_gap_seconds,omitempty"`
+	// Tracklist item being played right now, empty outside of the show.
+	CurrentTrackItemId    string                 `protobuf:"bytes,25,opt,name=current_track_item_id,json=currentTrackItemId,proto3" json:"current_track_item_id,omitempty"`
+	CurrentTrackStartedAt *timestamppb.Timestamp `protobuf:"bytes,26,opt,name=current_track_started_at,json=currentTrackStartedAt,proto3" json:"current_track_started_at,omitempty"`
+	// Set for archived events; only editors still see them.
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,27,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// Where the event takes place when it isn't at its venue (or has none).
+	Coordinates *GeoPoint `protobuf:"bytes,28,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
+	// Optional theme of the night, e.g. "90s covers night".
+	Theme string `protobuf:"bytes,29,opt,name=theme,proto3" json:"theme,omitempty"`
+	// Song tag the theme expects; tracklist songs without it get warnings.
+	ThemeTag      string `protobuf:"bytes,30,opt,name=theme_tag,json=themeTag,proto3" json:"theme_tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_event_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Event) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Event) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Event) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *Event) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *Event) GetNotifyDayBefore() bool {
+	if x != nil {
+		return x.NotifyDayBefore
+	}
+	return false
+}
+
+func (x *Event) GetNotifyHourBefore() bool {
+	if x != nil {
+		return x.NotifyHourBefore
+	}
+	return false
+}
+
+func (x *Event) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Event) GetParticipantCount() int32 {
+	if x != nil {
+		return x.ParticipantCount
+	}
+	return 0
+}
+
+func (x *Event) GetTrackCount() int32 {
+	if x != nil {
+		return x.TrackCount
+	}
+	return 0
+}
+
+func (x *Event) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *Event) GetRecurrence() string {
+	if x != nil {
+		return x.Recurrence
+	}
+	return ""
+}
+
+func (x *Event) GetMaxParticipants() int32 {
+	if x != nil {
+		return x.MaxParticipants
+	}
+	return 0
+}
+
+func (x *Event) GetRequiredRoles() []string {
+	if x != nil {
+		return x.RequiredRoles
+	}
+	return nil
+}
+
+func (x *Event) GetAttendanceCount() int32 {
+	if x != nil {
+		return x.AttendanceCount
+	}
+	return 0
+}
+
+func (x *Event) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *Event) GetTimeSlotMinutes() int32 {
+	if x != nil {
+		return x.TimeSlotMinutes
+	}
+	return 0
+}
+
+func (x *Event) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *Event) GetNotifyOffsetsMinutes() []int32 {
+	if x != nil {
+		return x.NotifyOffsetsMinutes
+	}
+	return nil
+}
+
+func (x *Event) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
+
+func (x *Event) GetCancelReason() string {
+	if x != nil {
+		return x.CancelReason
+	}
+	return ""
+}
+
+func (x *Event) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Event) GetLocalStartAt() string {
+	if x != nil {
+		return x.LocalStartAt
+	}
+	return ""
+}
+
+func (x *Event) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *Event) GetTrackGapSeconds() int32 {
+	if x != nil {
+		return x.TrackGapSeconds
+	}
+	return 0
+}
+
+func (x *Event) GetCurrentTrackItemId() string {
+	if x != nil {
+		return x.CurrentTrackItemId
+	}
+	return ""
+}
+
+func (x *Event) GetCurrentTrackStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CurrentTrackStartedAt
+	}
+	return nil
+}
+
+func (x *Event) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Event) GetCoordinates() *GeoPoint {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
+}
+
+func (x *Event) GetTheme() string {
+	if x != nil {
+		return x.Theme
+	}
+	return ""
+}
+
+func (x *Event) GetThemeTag() string {
+	if x != nil {
+		return x.ThemeTag
+	}
+	return ""
+}
+
+type EventDetails struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Event        *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Tracklist    *Tracklist             `protobuf:"bytes,2,opt,name=tracklist,proto3" json:"tracklist,omitempty"`
+	Participants []*RoleAssignment      `protobuf:"bytes,3,rep,name=participants,proto3" json:"participants,omitempty"`
+	Permissions  *PermissionSet         `protobuf:"bytes,4,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	RsvpSummary  *RsvpSummary           `protobuf:"bytes,5,opt,name=rsvp_summary,json=rsvpSummary,proto3" json:"rsvp_summary,omitempty"`
+	// Current user's RSVP, unspecified if not answered.
+	MyRsvp RsvpStatus `protobuf:"varint,6,opt,name=my_rsvp,json=myRsvp,proto3,enum=musicclub.event.RsvpStatus" json:"my_rsvp,omitempty"`
+	// Everyone who answered, most recent first.
+	Rsvps []*Rsvp `protobuf:"bytes,7,rep,name=rsvps,proto3" json:"rsvps,omitempty"`
+	// Free places left, -1 when the event has no capacity limit.
+	SpotsLeft int32 `protobuf:"varint,8,opt,name=spots_left,json=spotsLeft,proto3" json:"spots_left,omitempty"`
+	// Link to an .ics file for adding the event to a calendar app. It works
+	// without a login and expires after 30 days.
+	IcsUrl string `protobuf:"bytes,9,opt,name=ics_url,json=icsUrl,proto3" json:"ics_url,omitempty"`
+	// Rehearsals preparing for this event, soonest first.
+	Rehearsals []*Rehearsal `protobuf:"bytes,10,rep,name=rehearsals,proto3" json:"rehearsals,omitempty"`
+	// Who actually showed up (checked in), earliest first.
+	Attendance []*Attendance `protobuf:"bytes,11,rep,name=attendance,proto3" json:"attendance,omitempty"`
+	// Whether the current user has checked in.
+	CheckedIn bool   `protobuf:"varint,12,opt,name=checked_in,json=checkedIn,proto3" json:"checked_in,omitempty"`
+	Venue     *Venue `protobuf:"bytes,13,opt,name=venue,proto3" json:"venue,omitempty"`
+	// Who plays each catalog song of the tracklist: the frozen snapshot for
+	// completed events, the songs' current assignments otherwise.
+	Lineups []*TrackLineup `protobuf:"bytes,14,rep,name=lineups,proto3" json:"lineups,omitempty"`
+	// Unset until a feedback survey is opened.
+	FeedbackSurvey *FeedbackSurvey `protobuf:"bytes,15,opt,name=feedback_survey,json=feedbackSurvey,proto3" json:"feedback_survey,omitempty"`
+	// Equipment checklist in the order items were added.
+	Equipment []*EquipmentItem `protobuf:"bytes,16,rep,name=equipment,proto3" json:"equipment,omitempty"`
+	// Ride offers and requests, oldest first.
+	Rides []*Ride `protobuf:"bytes,17,rep,name=rides,proto3" json:"rides,omitempty"`
+	// Organizers of this event, earliest first.
+	Owners []*User `protobuf:"bytes,18,rep,name=owners,proto3" json:"owners,omitempty"`
+	// Whether the current user may edit the event: club-wide edit_events or
+	// being an owner.
+	CanEdit bool `protobuf:"varint,19,opt,name=can_edit,json=canEdit,proto3" json:"can_edit,omitempty"`
+	// Opens route planning in a maps app: to the event's coordinates, else
+	// the venue's, else its address or location text. Empty when there is
+	// nothing to route to.
+	DirectionsUrl string `protobuf:"bytes,20,opt,name=directions_url,json=directionsUrl,proto3" json:"directions_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventDetails) Reset() {
+	*x = EventDetails{}
+	mi := &file_event_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventDetails) ProtoMessage() {}
+
+func (x *EventDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventDetails.ProtoReflect.Descriptor instead.
+func (*EventDetails) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EventDetails) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *EventDetails) GetTracklist() *Tracklist {
+	if x != nil {
+		return x.Tracklist
+	}
+	return nil
+}
+
+func (x *EventDetails) GetParticipants() []*RoleAssignment {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+func (x *EventDetails) GetPermissions() *PermissionSet {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *EventDetails) GetRsvpSummary() *RsvpSummary {
+	if x != nil {
+		return x.RsvpSummary
+	}
+	return nil
+}
+
+func (x *EventDetails) GetMyRsvp() RsvpStatus {
+	if x != nil {
+		return x.MyRsvp
+	}
+	return RsvpStatus_RSVP_STATUS_UNSPECIFIED
+}
+
+func (x *EventDetails) GetRsvps() []*Rsvp {
+	if x != nil {
+		return x.Rsvps
+	}
+	return nil
+}
+
+func (x *EventDetails) GetSpotsLeft() int32 {
+	if x != nil {
+		return x.SpotsLeft
+	}
+	return 0
+}
+
+func (x *EventDetails) GetIcsUrl() string {
+	if x != nil {
+		return x.IcsUrl
+	}
+	return ""
+}
+
+func (x *EventDetails) GetRehearsals() []*Rehearsal {
+	if x != nil {
+		return x.Rehearsals
+	}
+	return nil
+}
+
+func (x *EventDetails) GetAttendance() []*Attendance {
+	if x != nil {
+		return x.Attendance
+	}
+	return nil
+}
+
+func (x *EventDetails) GetCheckedIn() bool {
+	if x != nil {
+		return x.CheckedIn
+	}
+	return false
+}
+
+func (x *EventDetails) GetVenue() *Venue {
+	if x != nil {
+		return x.Venue
+	}
+	return nil
+}
+
+func (x *EventDetails) GetLineups() []*TrackLineup {
+	if x != nil {
+		return x.Lineups
+	}
+	return nil
+}
+
+func (x *EventDetails) GetFeedbackSurvey() *FeedbackSurvey {
+	if x != nil {
+		return x.FeedbackSurvey
+	}
+	return nil
+}
+
+func (x *EventDetails) GetEquipment() []*EquipmentItem {
+	if x != nil {
+		return x.Equipment
+	}
+	return nil
+}
+
+func (x *EventDetails) GetRides() []*Ride {
+	if x != nil {
+		return x.Rides
+	}
+	return nil
+}
+
+func (x *EventDetails) GetOwners() []*User {
+	if x != nil {
+		return x.Owners
+	}
+	return nil
+}
+
+func (x *EventDetails) GetCanEdit() bool {
+	if x != nil {
+		return x.CanEdit
+	}
+	return false
+}
+
+func (x *EventDetails) GetDirectionsUrl() string {
+	if x != nil {
+		return x.DirectionsUrl
+	}
+	return ""
+}
+
+type TrackLineup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TrackItemId   string                 `protobuf:"bytes,1,opt,name=track_item_id,json=trackItemId,proto3" json:"track_item_id,omitempty"`
+	Assignments   []*RoleAssignment      `protobuf:"bytes,2,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrackLineup) Reset() {
+	*x = TrackLineup{}
+	mi := &file_event_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackLineup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackLineup) ProtoMessage() {}
+
+func (x *TrackLineup) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackLineup.ProtoReflect.Descriptor instead.
+func (*TrackLineup) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TrackLineup) GetTrackItemId() string {
+	if x != nil {
+		return x.TrackItemId
+	}
+	return ""
+}
+
+func (x *TrackLineup) GetAssignments() []*RoleAssignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+type Attendance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	CheckedInAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=checked_in_at,json=checkedInAt,proto3" json:"checked_in_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attendance) Reset() {
+	*x = Attendance{}
+	mi := &file_event_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attendance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attendance) ProtoMessage() {}
+
+func (x *Attendance) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attendance.ProtoReflect.Descriptor instead.
+func (*Attendance) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Attendance) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *Attendance) GetCheckedInAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedInAt
+	}
+	return nil
+}
+
+type RsvpSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Going         int32                  `protobuf:"varint,1,opt,name=going,proto3" json:"going,omitempty"`
+	Maybe         int32                  `protobuf:"varint,2,opt,name=maybe,proto3" json:"maybe,omitempty"`
+	Declined      int32                  `protobuf:"varint,3,opt,name=declined,proto3" json:"declined,omitempty"`
+	Waitlisted    int32                  `protobuf:"varint,4,opt,name=waitlisted,proto3" json:"waitlisted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RsvpSummary) Reset() {
+	*x = RsvpSummary{}
+	mi := &file_event_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RsvpSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RsvpSummary) ProtoMessage() {}
+
+func (x *RsvpSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsvpSummary.ProtoReflect.Descriptor instead.
+func (*RsvpSummary) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RsvpSummary) GetGoing() int32 {
+	if x != nil {
+		return x.Going
+	}
+	return 0
+}
+
+func (x *RsvpSummary) GetMaybe() int32 {
+	if x != nil {
+		return x.Maybe
+	}
+	return 0
+}
+
+func (x *RsvpSummary) GetDeclined() int32 {
+	if x != nil {
+		return x.Declined
+	}
+	return 0
+}
+
+func (x *RsvpSummary) GetWaitlisted() int32 {
+	if x != nil {
+		return x.Waitlisted
+	}
+	return 0
+}
+
+type Rsvp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Status        RsvpStatus             `protobuf:"varint,2,opt,name=status,proto3,enum=musicclub.event.RsvpStatus" json:"status,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Rsvp) Reset() {
+	*x = Rsvp{}
+	mi := &file_event_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rsvp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rsvp) ProtoMessage() {}
+
+func (x *Rsvp) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rsvp.ProtoReflect.Descriptor instead.
+func (*Rsvp) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Rsvp) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *Rsvp) GetStatus() RsvpStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RsvpStatus_RSVP_STATUS_UNSPECIFIED
+}
+
+func (x *Rsvp) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SetRsvpRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Status        RsvpStatus             `protobuf:"varint,2,opt,name=status,proto3,enum=musicclub.event.RsvpStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRsvpRequest) Reset() {
+	*x = SetRsvpRequest{}
+	mi := &file_event_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRsvpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRsvpRequest) ProtoMessage() {}
+
+func (x *SetRsvpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRsvpRequest.ProtoReflect.Descriptor instead.
+func (*SetRsvpRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetRsvpRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *SetRsvpRequest) GetStatus() RsvpStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RsvpStatus_RSVP_STATUS_UNSPECIFIED
+}
+
+type Tracklist struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// All items in play order. SetTracklist with only items keeps the sets of
+	// existing items; new items go outside of any set.
+	Items []*TrackItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// Running time of the whole list in seconds (items of unknown length count
+	// as zero).
+	TotalSeconds uint32 `protobuf:"varint,2,opt,name=total_seconds,json=totalSeconds,proto3" json:"total_seconds,omitempty"`
+	// Named sets in play order; empty if the list is not split. Items outside
+	// of any set come first as a set with an empty id. When sent to
+	// SetTracklist, sets replace both the stored sets and items.
+	Sets []*TrackSet `protobuf:"bytes,3,rep,name=sets,proto3" json:"sets,omitempty"`
+	// Read-only lineup problems found in the list; saving still succeeds.
+	Warnings      []*TracklistWarning `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tracklist) Reset() {
+	*x = Tracklist{}
+	mi := &file_event_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tracklist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tracklist) ProtoMessage() {}
+
+func (x *Tracklist) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tracklist.ProtoReflect.Descriptor instead.
+func (*Tracklist) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Tracklist) GetItems() []*TrackItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Tracklist) GetTotalSeconds() uint32 {
+	if x != nil {
+		return x.TotalSeconds
+	}
+	return 0
+}
+
+func (x *Tracklist) GetSets() []*TrackSet {
+	if x != nil {
+		return x.Sets
+	}
+	return nil
+}
+
+func (x *Tracklist) GetWarnings() []*TracklistWarning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type TracklistWarning struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Kind  TracklistWarningKind   `protobuf:"varint,1,opt,name=kind,proto3,enum=musicclub.event.TracklistWarningKind" json:"kind,omitempty"`
+	User  *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// The items involved, in play order: two, or one for off-theme songs.
+	TrackItemIds []string `protobuf:"bytes,3,rep,name=track_item_ids,json=trackItemIds,proto3" json:"track_item_ids,omitempty"`
+	// The member's roles in those items, in the same order.
+	Roles []string `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles,omitempty"`
+	// Ready-to-show text, e.g. "Иван: bass в №3, guitar в №4".
+	Message       string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TracklistWarning) Reset() {
+	*x = TracklistWarning{}
+	mi := &file_event_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TracklistWarning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TracklistWarning) ProtoMessage() {}
+
+func (x *TracklistWarning) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TracklistWarning.ProtoReflect.Descriptor instead.
+func (*TracklistWarning) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TracklistWarning) GetKind() TracklistWarningKind {
+	if x != nil {
+		return x.Kind
+	}
+	return TracklistWarningKind_TRACKLIST_WARNING_KIND_UNSPECIFIED
+}
+
+func (x *TracklistWarning) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *TracklistWarning) GetTrackItemIds() []string {
+	if x != nil {
+		return x.TrackItemIds
+	}
+	return nil
+}
+
+func (x *TracklistWarning) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *TracklistWarning) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type TrackSet struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Empty for new sets.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// E.g. "Сет 1" or "Бис".
+	Name  string       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Items []*TrackItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	// Read-only running time of the set in seconds.
+	TotalSeconds  uint32 `protobuf:"varint,4,opt,name=total_seconds,json=totalSeconds,proto3" json:"total_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrackSet) Reset() {
+	*x = TrackSet{}
+	mi := &file_event_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackSet) ProtoMessage() {}
+
+func (x *TrackSet) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackSet.ProtoReflect.Descriptor instead.
+func (*TrackSet) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TrackSet) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TrackSet) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrackSet) GetItems() []*TrackItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *TrackSet) GetTotalSeconds() uint32 {
+	if x != nil {
+		return x.TotalSeconds
+	}
+	return 0
+}
+
+type TrackItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Order uint32                 `protobuf:"varint,1,opt,name=order,proto3" json:"order,omitempty"`
+	// Reference to a song in the catalog.
+	SongId string `protobuf:"bytes,2,opt,name=song_id,json=songId,proto3" json:"song_id,omitempty"`
+	// If song is not in catalog, allow a custom title/artist.
+	CustomTitle  string `protobuf:"bytes,3,opt,name=custom_title,json=customTitle,proto3" json:"custom_title,omitempty"`
+	CustomArtist string `protobuf:"bytes,4,opt,name=custom_artist,json=customArtist,proto3" json:"custom_artist,omitempty"`
+	// Stable item id; send it back on SetTracklist to keep links (performers,
+	// rehearsals) attached to the item. Empty for new items.
+	Id string `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	// Length override in seconds; 0 uses the song's duration.
+	DurationSeconds uint32 `protobuf:"varint,6,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	// Read-only: the length actually used (override or song duration, 0 if
+	// unknown) and the running time at the end of this item.
+	EffectiveDurationSeconds uint32 `protobuf:"varint,7,opt,name=effective_duration_seconds,json=effectiveDurationSeconds,proto3" json:"effective_duration_seconds,omitempty"`
+	EndsAtSeconds            uint32 `protobuf:"varint,8,opt,name=ends_at_seconds,json=endsAtSeconds,proto3" json:"ends_at_seconds,omitempty"`
+	// Read-only: the item ends after the event's time slot.
+	ExceedsTimeSlot bool `protobuf:"varint,9,opt,name=exceeds_time_slot,json=exceedsTimeSlot,proto3" json:"exceeds_time_slot,omitempty"`
+	// Read-only: the set the item belongs to, empty if none.
+	SetId string `protobuf:"bytes,10,opt,name=set_id,json=setId,proto3" json:"set_id,omitempty"`
+	// Whether the item was actually played; marked by organizers after the show.
+	Performed bool `protobuf:"varint,11,opt,name=performed,proto3" json:"performed,omitempty"`
+	// Read-only here; changed with SetTrackRehearsalStatus and reset when the
+	// item's song changes.
+	RehearsalStatus TrackRehearsalStatus `protobuf:"varint,12,opt,name=rehearsal_status,json=rehearsalStatus,proto3,enum=musicclub.event.TrackRehearsalStatus" json:"rehearsal_status,omitempty"`
+	// Performance notes, e.g. "capo 2, shortened outro".
+	Notes string `protobuf:"bytes,13,opt,name=notes,proto3" json:"notes,omitempty"`
+	// Key or transposition for this performance, e.g. "D" or "+2"; empty uses
+	// the song's key.
+	Key string `protobuf:"bytes,14,opt,name=key,proto3" json:"key,omitempty"`
+	// Fixed stage time for this item; later items continue from it.
+	PlannedStartAt *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=planned_start_at,json=plannedStartAt,proto3" json:"planned_start_at,omitempty"`
+	// Read-only: planned_start_at, or the event start plus the running time
+	// and gaps of earlier items. Unset when the event has no start time.
+	ScheduledStartAt *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=scheduled_start_at,json=scheduledStartAt,proto3" json:"scheduled_start_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TrackItem) Reset() {
+	*x = TrackItem{}
+	mi := &file_event_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackItem) ProtoMessage() {}
+
+func (x *TrackItem) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackItem.ProtoReflect.Descriptor instead.
+func (*TrackItem) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TrackItem) GetOrder() uint32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *TrackItem) GetSongId() string {
+	if x != nil {
+		return x.SongId
+	}
+	return ""
+}
+
+func (x *TrackItem) GetCustomTitle() string {
+	if x != nil {
+		return x.CustomTitle
+	}
+	return ""
+}
+
+func (x *TrackItem) GetCustomArtist() string {
+	if x != nil {
+		return x.CustomArtist
+	}
+	return ""
+}
+
+func (x *TrackItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TrackItem) GetDurationSeconds() uint32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *TrackItem) GetEffectiveDurationSeconds() uint32 {
+	if x != nil {
+		return x.EffectiveDurationSeconds
+	}
+	return 0
+}
+
+func (x *TrackItem) GetEndsAtSeconds() uint32 {
+	if x != nil {
+		return x.EndsAtSeconds
+	}
+	return 0
+}
+
+func (x *TrackItem) GetExceedsTimeSlot() bool {
+	if x != nil {
+		return x.ExceedsTimeSlot
+	}
+	return false
+}
+
+func (x *TrackItem) GetSetId() string {
+	if x != nil {
+		return x.SetId
+	}
+	return ""
+}
+
+func (x *TrackItem) GetPerformed() bool {
+	if x != nil {
+		return x.Performed
+	}
+	return false
+}
+
+func (x *TrackItem) GetRehearsalStatus() TrackRehearsalStatus {
+	if x != nil {
+		return x.RehearsalStatus
+	}
+	return TrackRehearsalStatus_TRACK_REHEARSAL_STATUS_UNSPECIFIED
+}
+
+func (x *TrackItem) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *TrackItem) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TrackItem) GetPlannedStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PlannedStartAt
+	}
+	return nil
+}
+
+func (x *TrackItem) GetScheduledStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledStartAt
+	}
+	return nil
+}
+
+type SetTrackRehearsalStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	ItemId        string                 `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Status        TrackRehearsalStatus   `protobuf:"varint,3,opt,name=status,proto3,enum=musicclub.event.TrackRehearsalStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTrackRehearsalStatusRequest) Reset() {
+	*x = SetTrackRehearsalStatusRequest{}
+	mi := &file_event_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTrackRehearsalStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTrackRehearsalStatusRequest) ProtoMessage() {}
+
+func (x *SetTrackRehearsalStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTrackRehearsalStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetTrackRehearsalStatusRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetTrackRehearsalStatusRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *SetTrackRehearsalStatusRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *SetTrackRehearsalStatusRequest) GetStatus() TrackRehearsalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TrackRehearsalStatus_TRACK_REHEARSAL_STATUS_UNSPECIFIED
+}
+
+type SetCurrentTrackRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// Item to mark as playing; empty clears the pointer unless next is set.
+	ItemId string `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	// Advance to the item after the current one (the first item if none is
+	// playing); past the last item the pointer is cleared.
+	Next          bool `protobuf:"varint,3,opt,name=next,proto3" json:"next,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCurrentTrackRequest) Reset() {
+	*x = SetCurrentTrackRequest{}
+	mi := &file_event_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCurrentTrackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCurrentTrackRequest) ProtoMessage() {}
+
+func (x *SetCurrentTrackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCurrentTrackRequest.ProtoReflect.Descriptor instead.
+func (*SetCurrentTrackRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetCurrentTrackRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *SetCurrentTrackRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *SetCurrentTrackRequest) GetNext() bool {
+	if x != nil {
+		return x.Next
+	}
+	return false
+}
+
+type CreateEventRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Title            string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	StartAt          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	Location         string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	NotifyDayBefore  bool                   `protobuf:"varint,4,opt,name=notify_day_before,json=notifyDayBefore,proto3" json:"notify_day_before,omitempty"`
+	NotifyHourBefore bool                   `protobuf:"varint,5,opt,name=notify_hour_before,json=notifyHourBefore,proto3" json:"notify_hour_before,omitempty"`
+	Tracklist        *Tracklist             `protobuf:"bytes,6,opt,name=tracklist,proto3" json:"tracklist,omitempty"`
+	// Optional RRULE (daily/weekly/monthly/yearly). Requires start_at; the
+	// returned event is the first occurrence.
+	Recurrence      string   `protobuf:"bytes,7,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	MaxParticipants int32    `protobuf:"varint,8,opt,name=max_participants,json=maxParticipants,proto3" json:"max_participants,omitempty"`
+	RequiredRoles   []string `protobuf:"bytes,9,rep,name=required_roles,json=requiredRoles,proto3" json:"required_roles,omitempty"`
+	VenueId         string   `protobuf:"bytes,10,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	TimeSlotMinutes int32    `protobuf:"varint,11,opt,name=time_slot_minutes,json=timeSlotMinutes,proto3" json:"time_slot_minutes,omitempty"`
+	// Reminder offsets in minutes; when empty, the notify_* flags are used.
+	NotifyOffsetsMinutes []int32 `protobuf:"varint,12,rep,packed,name=notify_offsets_minutes,json=notifyOffsetsMinutes,proto3" json:"notify_offsets_minutes,omitempty"`
+	// IANA timezone; the club's default when empty.
+	Timezone        string `protobuf:"bytes,13,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	SeasonId        string `protobuf:"bytes,14,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	TrackGapSeconds int32  `protobuf:"varint,15,opt,name=track_gap_seconds,json=trackGapSeconds,proto3" json:"track_gap_seconds,omitempty"`
+	// Where the event takes place; read from map_url or geocoded from
+	// location when unset. Not needed for events at a venue.
+	Coordinates *GeoPoint `protobuf:"bytes,16,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
+	MapUrl      string    `protobuf:"bytes,17,opt,name=map_url,json=mapUrl,proto3" json:"map_url,omitempty"`
+	// Theme of the event, or of every occurrence of a series.
+	Theme         string `protobuf:"bytes,18,opt,name=theme,proto3" json:"theme,omitempty"`
+	ThemeTag      string `protobuf:"bytes,19,opt,name=theme_tag,json=themeTag,proto3" json:"theme_tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEventRequest) Reset() {
+	*x = CreateEventRequest{}
+	mi := &file_event_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEventRequest) ProtoMessage() {}
+
+func (x *CreateEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEventRequest.ProtoReflect.Descriptor instead.
+func (*CreateEventRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateEventRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *CreateEventRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetNotifyDayBefore() bool {
+	if x != nil {
+		return x.NotifyDayBefore
+	}
+	return false
+}
+
+func (x *CreateEventRequest) GetNotifyHourBefore() bool {
+	if x != nil {
+		return x.NotifyHourBefore
+	}
+	return false
+}
+
+func (x *CreateEventRequest) GetTracklist() *Tracklist {
+	if x != nil {
+		return x.Tracklist
+	}
+	return nil
+}
+
+func (x *CreateEventRequest) GetRecurrence() string {
+	if x != nil {
+		return x.Recurrence
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetMaxParticipants() int32 {
+	if x != nil {
+		return x.MaxParticipants
+	}
+	return 0
+}
+
+func (x *CreateEventRequest) GetRequiredRoles() []string {
+	if x != nil {
+		return x.RequiredRoles
+	}
+	return nil
+}
+
+func (x *CreateEventRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetTimeSlotMinutes() int32 {
+	if x != nil {
+		return x.TimeSlotMinutes
+	}
+	return 0
+}
+
+func (x *CreateEventRequest) GetNotifyOffsetsMinutes() []int32 {
+	if x != nil {
+		return x.NotifyOffsetsMinutes
+	}
+	return nil
+}
+
+func (x *CreateEventRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetTrackGapSeconds() int32 {
+	if x != nil {
+		return x.TrackGapSeconds
+	}
+	return 0
+}
+
+func (x *CreateEventRequest) GetCoordinates() *GeoPoint {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
+}
+
+func (x *CreateEventRequest) GetMapUrl() string {
+	if x != nil {
+		return x.MapUrl
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetTheme() string {
+	if x != nil {
+		return x.Theme
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetThemeTag() string {
+	if x != nil {
+		return x.ThemeTag
+	}
+	return ""
+}
+
+type DuplicateEventRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// Unset leaves the copy without a date.
+	StartAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	// Overrides the copied title.
+	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// Also copy the tracklist with its sets, notes and keys.
+	CopyTracklist bool `protobuf:"varint,4,opt,name=copy_tracklist,json=copyTracklist,proto3" json:"copy_tracklist,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateEventRequest) Reset() {
+	*x = DuplicateEventRequest{}
+	mi := &file_event_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateEventRequest) ProtoMessage() {}
+
+func (x *DuplicateEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateEventRequest.ProtoReflect.Descriptor instead.
+func (*DuplicateEventRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DuplicateEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *DuplicateEventRequest) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *DuplicateEventRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DuplicateEventRequest) GetCopyTracklist() bool {
+	if x != nil {
+		return x.CopyTracklist
+	}
+	return false
+}
+
+type UpdateEventRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title            string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	StartAt          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	Location         string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	NotifyDayBefore  bool                   `protobuf:"varint,5,opt,name=notify_day_before,json=notifyDayBefore,proto3" json:"notify_day_before,omitempty"`
+	NotifyHourBefore bool                   `protobuf:"varint,6,opt,name=notify_hour_before,json=notifyHourBefore,proto3" json:"notify_hour_before,omitempty"`
+	// Version the client last saw; mismatch fails with ABORTED.
+	ExpectedVersion int64 `protobuf:"varint,7,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	// For occurrences of a series: also apply the change (and the shift of
+	// start time) to all later occurrences and the series itself.
+	Scope           RecurrenceScope `protobuf:"varint,8,opt,name=scope,proto3,enum=musicclub.event.RecurrenceScope" json:"scope,omitempty"`
+	MaxParticipants int32           `protobuf:"varint,9,opt,name=max_participants,json=maxParticipants,proto3" json:"max_participants,omitempty"`
+	RequiredRoles   []string        `protobuf:"bytes,10,rep,name=required_roles,json=requiredRoles,proto3" json:"required_roles,omitempty"`
+	VenueId         string          `protobuf:"bytes,11,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	TimeSlotMinutes int32           `protobuf:"varint,12,opt,name=time_slot_minutes,json=timeSlotMinutes,proto3" json:"time_slot_minutes,omitempty"`
+	// Reminder offsets in minutes; when empty, the notify_* flags are used.
+	NotifyOffsetsMinutes []int32 `protobuf:"varint,13,rep,packed,name=notify_offsets_minutes,json=notifyOffsetsMinutes,proto3" json:"notify_offsets_minutes,omitempty"`
+	// IANA timezone; the club's default when empty.
+	Timezone        string `protobuf:"bytes,14,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	SeasonId        string `protobuf:"bytes,15,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	TrackGapSeconds int32  `protobuf:"varint,16,opt,name=track_gap_seconds,json=trackGapSeconds,proto3" json:"track_gap_seconds,omitempty"`
+	// As in CreateEventRequest.
+	Coordinates *GeoPoint `protobuf:"bytes,17,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
+	MapUrl      string    `protobuf:"bytes,18,opt,name=map_url,json=mapUrl,proto3" json:"map_url,omitempty"`
+	Theme       string    `protobuf:"bytes,19,opt,name=theme,proto3" json:"theme,omitempty"`
+	ThemeTag    string    `protobuf:"bytes,20,opt,name=theme_tag,json=themeTag,proto3" json:"theme_tag,omitempty"`
+	// Fields to overwrite, named as above. Empty mask replaces title, start_at,
+	// location and the notify_* flags only. Coordinates are looked up again
+	// when location or venue_id changes or coordinates or map_url is set; the flags switch
+	// their reminder among the stored offsets unless notify_offsets_minutes
+	// is set as well.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,21,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEventRequest) Reset() {
+	*x = UpdateEventRequest{}
+	mi := &file_event_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEventRequest) ProtoMessage() {}
+
+func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEventRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEventRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *UpdateEventRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetNotifyDayBefore() bool {
+	if x != nil {
+		return x.NotifyDayBefore
+	}
+	return false
+}
+
+func (x *UpdateEventRequest) GetNotifyHourBefore() bool {
+	if x != nil {
+		return x.NotifyHourBefore
+	}
+	return false
+}
+
+func (x *UpdateEventRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *UpdateEventRequest) GetScope() RecurrenceScope {
+	if x != nil {
+		return x.Scope
+	}
+	return RecurrenceScope_RECURRENCE_SCOPE_THIS_OCCURRENCE
+}
+
+func (x *UpdateEventRequest) GetMaxParticipants() int32 {
+	if x != nil {
+		return x.MaxParticipants
+	}
+	return 0
+}
+
+func (x *UpdateEventRequest) GetRequiredRoles() []string {
+	if x != nil {
+		return x.RequiredRoles
+	}
+	return nil
+}
+
+func (x *UpdateEventRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetTimeSlotMinutes() int32 {
+	if x != nil {
+		return x.TimeSlotMinutes
+	}
+	return 0
+}
+
+func (x *UpdateEventRequest) GetNotifyOffsetsMinutes() []int32 {
+	if x != nil {
+		return x.NotifyOffsetsMinutes
+	}
+	return nil
+}
+
+func (x *UpdateEventRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetTrackGapSeconds() int32 {
+	if x != nil {
+		return x.TrackGapSeconds
+	}
+	return 0
+}
+
+func (x *UpdateEventRequest) GetCoordinates() *GeoPoint {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
+}
+
+func (x *UpdateEventRequest) GetMapUrl() string {
+	if x != nil {
+		return x.MapUrl
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetTheme() string {
+	if x != nil {
+		return x.Theme
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetThemeTag() string {
+	if x != nil {
+		return x.ThemeTag
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type SetTracklistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Tracklist     *Tracklist             `protobuf:"bytes,2,opt,name=tracklist,proto3" json:"tracklist,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTracklistRequest) Reset() {
+	*x = SetTracklistRequest{}
+	mi := &file_event_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTracklistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTracklistRequest) ProtoMessage() {}
+
+func (x *SetTracklistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTracklistRequest.ProtoReflect.Descriptor instead.
+func (*SetTracklistRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SetTracklistRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *SetTracklistRequest) GetTracklist() *Tracklist {
+	if x != nil {
+		return x.Tracklist
+	}
+	return nil
+}
+
+type CopyTracklistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceEventId string                 `protobuf:"bytes,1,opt,name=source_event_id,json=sourceEventId,proto3" json:"source_event_id,omitempty"`
+	TargetEventId string                 `protobuf:"bytes,2,opt,name=target_event_id,json=targetEventId,proto3" json:"target_event_id,omitempty"`
+	// Copy only items marked as performed.
+	OnlyPerformed bool `protobuf:"varint,3,opt,name=only_performed,json=onlyPerformed,proto3" json:"only_performed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CopyTracklistRequest) Reset() {
+	*x = CopyTracklistRequest{}
+	mi := &file_event_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyTracklistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyTracklistRequest) ProtoMessage() {}
+
+func (x *CopyTracklistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyTracklistRequest.ProtoReflect.Descriptor instead.
+func (*CopyTracklistRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CopyTracklistRequest) GetSourceEventId() string {
+	if x != nil {
+		return x.SourceEventId
+	}
+	return ""
+}
+
+func (x *CopyTracklistRequest) GetTargetEventId() string {
+	if x != nil {
+		return x.TargetEventId
+	}
+	return ""
+}
+
+func (x *CopyTracklistRequest) GetOnlyPerformed() bool {
+	if x != nil {
+		return x.OnlyPerformed
+	}
+	return false
+}
+
+type SuggestTracklistRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// Maximum number of songs; 0 fills the event's time slot, taking at most
+	// 50 songs.
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Rank songs favorited by more members higher.
+	WeightByVotes bool `protobuf:"varint,3,opt,name=weight_by_votes,json=weightByVotes,proto3" json:"weight_by_votes,omitempty"`
+	// Rank songs that were not played for a long time (or never) higher.
+	WeightByLastPerformed bool `protobuf:"varint,4,opt,name=weight_by_last_performed,json=weightByLastPerformed,proto3" json:"weight_by_last_performed,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *SuggestTracklistRequest) Reset() {
+	*x = SuggestTracklistRequest{}
+	mi := &file_event_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestTracklistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestTracklistRequest) ProtoMessage() {}
+
+func (x *SuggestTracklistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestTracklistRequest.ProtoReflect.Descriptor instead.
+func (*SuggestTracklistRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SuggestTracklistRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *SuggestTracklistRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SuggestTracklistRequest) GetWeightByVotes() bool {
+	if x != nil {
+		return x.WeightByVotes
+	}
+	return false
+}
+
+func (x *SuggestTracklistRequest) GetWeightByLastPerformed() bool {
+	if x != nil {
+		return x.WeightByLastPerformed
+	}
+	return false
+}
+
+type RenderEventAnnouncementRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Format  AnnouncementFormat     `protobuf:"varint,2,opt,name=format,proto3,enum=musicclub.event.AnnouncementFormat" json:"format,omitempty"`
+	// How many tracks to list; 0 means 5.
+	Highlights    uint32 `protobuf:"varint,3,opt,name=highlights,proto3" json:"highlights,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenderEventAnnouncementRequest) Reset() {
+	*x = RenderEventAnnouncementRequest{}
+	mi := &file_event_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderEventAnnouncementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderEventAnnouncementRequest) ProtoMessage() {}
+
+func (x *RenderEventAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderEventAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*RenderEventAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RenderEventAnnouncementRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *RenderEventAnnouncementRequest) GetFormat() AnnouncementFormat {
+	if x != nil {
+		return x.Format
+	}
+	return AnnouncementFormat_ANNOUNCEMENT_FORMAT_HTML
+}
+
+func (x *RenderEventAnnouncementRequest) GetHighlights() uint32 {
+	if x != nil {
+		return x.Highlights
+	}
+	return 0
+}
+
+type EventAnnouncement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Format        AnnouncementFormat     `protobuf:"varint,2,opt,name=format,proto3,enum=musicclub.event.AnnouncementFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventAnnouncement) Reset() {
+	*x = EventAnnouncement{}
+	mi := &file_event_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventAnnouncement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventAnnouncement) ProtoMessage() {}
+
+func (x *EventAnnouncement) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventAnnouncement.ProtoReflect.Descriptor instead.
+func (*EventAnnouncement) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *EventAnnouncement) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *EventAnnouncement) GetFormat() AnnouncementFormat {
+	if x != nil {
+		return x.Format
+	}
+	return AnnouncementFormat_ANNOUNCEMENT_FORMAT_HTML
+}
+
+type ExportTracklistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Format        TracklistExportFormat  `protobuf:"varint,2,opt,name=format,proto3,enum=musicclub.event.TracklistExportFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTracklistRequest) Reset() {
+	*x = ExportTracklistRequest{}
+	mi := &file_event_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTracklistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTracklistRequest) ProtoMessage() {}
+
+func (x *ExportTracklistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTracklistRequest.ProtoReflect.Descriptor instead.
+func (*ExportTracklistRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ExportTracklistRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ExportTracklistRequest) GetFormat() TracklistExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return TracklistExportFormat_TRACKLIST_EXPORT_FORMAT_TEXT
+}
+
+type ExportedTracklist struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportedTracklist) Reset() {
+	*x = ExportedTracklist{}
+	mi := &file_event_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportedTracklist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportedTracklist) ProtoMessage() {}
+
+func (x *ExportedTracklist) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportedTracklist.ProtoReflect.Descriptor instead.
+func (*ExportedTracklist) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ExportedTracklist) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportedTracklist) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportedTracklist) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type InsertTrackItemRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// order and id are ignored.
+	Item *TrackItem `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	// 1-based position within the set; 0 or past the end appends.
+	Position uint32 `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	// Set to insert into; empty puts the item outside of any set.
+	SetId         string `protobuf:"bytes,4,opt,name=set_id,json=setId,proto3" json:"set_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsertTrackItemRequest) Reset() {
+	*x = InsertTrackItemRequest{}
+	mi := &file_event_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsertTrackItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertTrackItemRequest) ProtoMessage() {}
+
+func (x *InsertTrackItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertTrackItemRequest.ProtoReflect.Descriptor instead.
+func (*InsertTrackItemRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *InsertTrackItemRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *InsertTrackItemRequest) GetItem() *TrackItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *InsertTrackItemRequest) GetPosition() uint32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *InsertTrackItemRequest) GetSetId() string {
+	if x != nil {
+		return x.SetId
+	}
+	return ""
+}
+
+type MoveTrackItemRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	ItemId  string                 `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	// 1-based target position within the set; past the end moves the item
+	// last.
+	Position uint32 `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	// Set to move the item to; empty keeps its current set.
+	SetId         string `protobuf:"bytes,4,opt,name=set_id,json=setId,proto3" json:"set_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveTrackItemRequest) Reset() {
+	*x = MoveTrackItemRequest{}
+	mi := &file_event_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveTrackItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveTrackItemRequest) ProtoMessage() {}
+
+func (x *MoveTrackItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveTrackItemRequest.ProtoReflect.Descriptor instead.
+func (*MoveTrackItemRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *MoveTrackItemRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *MoveTrackItemRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *MoveTrackItemRequest) GetPosition() uint32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *MoveTrackItemRequest) GetSetId() string {
+	if x != nil {
+		return x.SetId
+	}
+	return ""
+}
+
+type TrackItemRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	ItemId        string                 `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrackItemRef) Reset() {
+	*x = TrackItemRef{}
+	mi := &file_event_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackItemRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackItemRef) ProtoMessage() {}
+
+func (x *TrackItemRef) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackItemRef.ProtoReflect.Descriptor instead.
+func (*TrackItemRef) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TrackItemRef) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *TrackItemRef) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+type UpdateTrackItemRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// Identified by item.id; order is ignored.
+	Item *TrackItem `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	// Item fields to overwrite (song_id, custom_title, custom_artist,
+	// duration_seconds, performed, notes, key, planned_start_at). Empty mask
+	// replaces all of them except performed.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTrackItemRequest) Reset() {
+	*x = UpdateTrackItemRequest{}
+	mi := &file_event_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTrackItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTrackItemRequest) ProtoMessage() {}
+
+func (x *UpdateTrackItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTrackItemRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTrackItemRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateTrackItemRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *UpdateTrackItemRequest) GetItem() *TrackItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *UpdateTrackItemRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type CalendarFeed struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Secret subscription URL; anyone with it can read the feed.
+	Url           string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarFeed) Reset() {
+	*x = CalendarFeed{}
+	mi := &file_event_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarFeed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarFeed) ProtoMessage() {}
+
+func (x *CalendarFeed) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarFeed.ProtoReflect.Descriptor instead.
+func (*CalendarFeed) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CalendarFeed) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type EventTemplate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Title of created events; "{date}" becomes the start date and "{n}" the
+	// number of events created from this template so far, plus one.
+	TitlePattern     string   `protobuf:"bytes,3,opt,name=title_pattern,json=titlePattern,proto3" json:"title_pattern,omitempty"`
+	Location         string   `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	NotifyDayBefore  bool     `protobuf:"varint,5,opt,name=notify_day_before,json=notifyDayBefore,proto3" json:"notify_day_before,omitempty"`
+	NotifyHourBefore bool     `protobuf:"varint,6,opt,name=notify_hour_before,json=notifyHourBefore,proto3" json:"notify_hour_before,omitempty"`
+	MaxParticipants  int32    `protobuf:"varint,7,opt,name=max_participants,json=maxParticipants,proto3" json:"max_participants,omitempty"`
+	RequiredRoles    []string `protobuf:"bytes,8,rep,name=required_roles,json=requiredRoles,proto3" json:"required_roles,omitempty"`
+	// How many events were created from the template.
+	Uses            int32  `protobuf:"varint,9,opt,name=uses,proto3" json:"uses,omitempty"`
+	VenueId         string `protobuf:"bytes,10,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	TimeSlotMinutes int32  `protobuf:"varint,11,opt,name=time_slot_minutes,json=timeSlotMinutes,proto3" json:"time_slot_minutes,omitempty"`
+	// Reminder offsets in minutes; when empty, the notify_* flags are used.
+	NotifyOffsetsMinutes []int32 `protobuf:"varint,12,rep,packed,name=notify_offsets_minutes,json=notifyOffsetsMinutes,proto3" json:"notify_offsets_minutes,omitempty"`
+	Timezone             string  `protobuf:"bytes,13,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	TrackGapSeconds      int32   `protobuf:"varint,14,opt,name=track_gap_seconds,json=trackGapSeconds,proto3" json:"track_gap_seconds,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *EventTemplate) Reset() {
+	*x = EventTemplate{}
+	mi := &file_event_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventTemplate) ProtoMessage() {}
+
+func (x *EventTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventTemplate.ProtoReflect.Descriptor instead.
+func (*EventTemplate) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *EventTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EventTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EventTemplate) GetTitlePattern() string {
+	if x != nil {
+		return x.TitlePattern
+	}
+	return ""
+}
+
+func (x *EventTemplate) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *EventTemplate) GetNotifyDayBefore() bool {
+	if x != nil {
+		return x.NotifyDayBefore
+	}
+	return false
+}
+
+func (x *EventTemplate) GetNotifyHourBefore() bool {
+	if x != nil {
+		return x.NotifyHourBefore
+	}
+	return false
+}
+
+func (x *EventTemplate) GetMaxParticipants() int32 {
+	if x != nil {
+		return x.MaxParticipants
+	}
+	return 0
+}
+
+func (x *EventTemplate) GetRequiredRoles() []string {
+	if x != nil {
+		return x.RequiredRoles
+	}
+	return nil
+}
+
+func (x *EventTemplate) GetUses() int32 {
+	if x != nil {
+		return x.Uses
+	}
+	return 0
+}
+
+func (x *EventTemplate) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *EventTemplate) GetTimeSlotMinutes() int32 {
+	if x != nil {
+		return x.TimeSlotMinutes
+	}
+	return 0
+}
+
+func (x *EventTemplate) GetNotifyOffsetsMinutes() []int32 {
+	if x != nil {
+		return x.NotifyOffsetsMinutes
+	}
+	return nil
+}
+
+func (x *EventTemplate) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *EventTemplate) GetTrackGapSeconds() int32 {
+	if x != nil {
+		return x.TrackGapSeconds
+	}
+	return 0
+}
+
+type EventTemplateId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventTemplateId) Reset() {
+	*x = EventTemplateId{}
+	mi := &file_event_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventTemplateId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventTemplateId) ProtoMessage() {}
+
+func (x *EventTemplateId) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventTemplateId.ProtoReflect.Descriptor instead.
+func (*EventTemplateId) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *EventTemplateId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type SaveEventTemplateRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Name    string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Defaults to the event's title.
+	TitlePattern  string `protobuf:"bytes,3,opt,name=title_pattern,json=titlePattern,proto3" json:"title_pattern,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveEventTemplateRequest) Reset() {
+	*x = SaveEventTemplateRequest{}
+	mi := &file_event_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveEventTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveEventTemplateRequest) ProtoMessage() {}
+
+func (x *SaveEventTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveEventTemplateRequest.ProtoReflect.Descriptor instead.
+func (*SaveEventTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SaveEventTemplateRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *SaveEventTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SaveEventTemplateRequest) GetTitlePattern() string {
+	if x != nil {
+		return x.TitlePattern
+	}
+	return ""
+}
+
+type ListEventTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*EventTemplate       `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventTemplatesResponse) Reset() {
+	*x = ListEventTemplatesResponse{}
+	mi := &file_event_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventTemplatesResponse) ProtoMessage() {}
+
+func (x *ListEventTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListEventTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListEventTemplatesResponse) GetTemplates() []*EventTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type CreateEventFromTemplateRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	StartAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	// Overrides the title produced from the pattern.
+	Title         string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEventFromTemplateRequest) Reset() {
+	*x = CreateEventFromTemplateRequest{}
+	mi := &file_event_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEventFromTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEventFromTemplateRequest) ProtoMessage() {}
+
+func (x *CreateEventFromTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEventFromTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateEventFromTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CreateEventFromTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *CreateEventFromTemplateRequest) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *CreateEventFromTemplateRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type Rehearsal struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId  string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	StartAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	Location string                 `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	Notes    string                 `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+	// Tracklist items (TrackItem.id) to be rehearsed.
+	TrackItemIds     []string `protobuf:"bytes,7,rep,name=track_item_ids,json=trackItemIds,proto3" json:"track_item_ids,omitempty"`
+	NotifyDayBefore  bool     `protobuf:"varint,8,opt,name=notify_day_before,json=notifyDayBefore,proto3" json:"notify_day_before,omitempty"`
+	NotifyHourBefore bool     `protobuf:"varint,9,opt,name=notify_hour_before,json=notifyHourBefore,proto3" json:"notify_hour_before,omitempty"`
+	// Answers of members; waitlist does not apply to rehearsals.
+	Attendance    []*Rsvp    `protobuf:"bytes,10,rep,name=attendance,proto3" json:"attendance,omitempty"`
+	MyAttendance  RsvpStatus `protobuf:"varint,11,opt,name=my_attendance,json=myAttendance,proto3,enum=musicclub.event.RsvpStatus" json:"my_attendance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Rehearsal) Reset() {
+	*x = Rehearsal{}
+	mi := &file_event_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rehearsal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rehearsal) ProtoMessage() {}
+
+func (x *Rehearsal) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rehearsal.ProtoReflect.Descriptor instead.
+func (*Rehearsal) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *Rehearsal) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Rehearsal) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *Rehearsal) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *Rehearsal) GetEndAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndAt
+	}
+	return nil
+}
+
+func (x *Rehearsal) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *Rehearsal) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Rehearsal) GetTrackItemIds() []string {
+	if x != nil {
+		return x.TrackItemIds
+	}
+	return nil
+}
+
+func (x *Rehearsal) GetNotifyDayBefore() bool {
+	if x != nil {
+		return x.NotifyDayBefore
+	}
+	return false
+}
+
+func (x *Rehearsal) GetNotifyHourBefore() bool {
+	if x != nil {
+		return x.NotifyHourBefore
+	}
+	return false
+}
+
+func (x *Rehearsal) GetAttendance() []*Rsvp {
+	if x != nil {
+		return x.Attendance
+	}
+	return nil
+}
+
+func (x *Rehearsal) GetMyAttendance() RsvpStatus {
+	if x != nil {
+		return x.MyAttendance
+	}
+	return RsvpStatus_RSVP_STATUS_UNSPECIFIED
+}
+
+type RehearsalId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RehearsalId) Reset() {
+	*x = RehearsalId{}
+	mi := &file_event_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RehearsalId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RehearsalId) ProtoMessage() {}
+
+func (x *RehearsalId) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RehearsalId.ProtoReflect.Descriptor instead.
+func (*RehearsalId) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RehearsalId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RehearsalInput struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	EventId          string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	StartAt          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	Location         string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	Notes            string                 `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	TrackItemIds     []string               `protobuf:"bytes,6,rep,name=track_item_ids,json=trackItemIds,proto3" json:"track_item_ids,omitempty"`
+	NotifyDayBefore  bool                   `protobuf:"varint,7,opt,name=notify_day_before,json=notifyDayBefore,proto3" json:"notify_day_before,omitempty"`
+	NotifyHourBefore bool                   `protobuf:"varint,8,opt,name=notify_hour_before,json=notifyHourBefore,proto3" json:"notify_hour_before,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RehearsalInput) Reset() {
+	*x = RehearsalInput{}
+	mi := &file_event_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RehearsalInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RehearsalInput) ProtoMessage() {}
+
+func (x *RehearsalInput) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RehearsalInput.ProtoReflect.Descriptor instead.
+func (*RehearsalInput) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RehearsalInput) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *RehearsalInput) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *RehearsalInput) GetEndAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndAt
+	}
+	return nil
+}
+
+func (x *RehearsalInput) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *RehearsalInput) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *RehearsalInput) GetTrackItemIds() []string {
+	if x != nil {
+		return x.TrackItemIds
+	}
+	return nil
+}
+
+func (x *RehearsalInput) GetNotifyDayBefore() bool {
+	if x != nil {
+		return x.NotifyDayBefore
+	}
+	return false
+}
+
+func (x *RehearsalInput) GetNotifyHourBefore() bool {
+	if x != nil {
+		return x.NotifyHourBefore
+	}
+	return false
+}
+
+type UpdateRehearsalRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// event_id is ignored; rehearsals can't move between events.
+	Rehearsal     *RehearsalInput `protobuf:"bytes,2,opt,name=rehearsal,proto3" json:"rehearsal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRehearsalRequest) Reset() {
+	*x = UpdateRehearsalRequest{}
+	mi := &file_event_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRehearsalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRehearsalRequest) ProtoMessage() {}
+
+func (x *UpdateRehearsalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRehearsalRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRehearsalRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UpdateRehearsalRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateRehearsalRequest) GetRehearsal() *RehearsalInput {
+	if x != nil {
+		return x.Rehearsal
+	}
+	return nil
+}
+
+type SetRehearsalAttendanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RehearsalId   string                 `protobuf:"bytes,1,opt,name=rehearsal_id,json=rehearsalId,proto3" json:"rehearsal_id,omitempty"`
+	Status        RsvpStatus             `protobuf:"varint,2,opt,name=status,proto3,enum=musicclub.event.RsvpStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRehearsalAttendanceRequest) Reset() {
+	*x = SetRehearsalAttendanceRequest{}
+	mi := &file_event_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRehearsalAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRehearsalAttendanceRequest) ProtoMessage() {}
+
+func (x *SetRehearsalAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRehearsalAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*SetRehearsalAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SetRehearsalAttendanceRequest) GetRehearsalId() string {
+	if x != nil {
+		return x.RehearsalId
+	}
+	return ""
+}
+
+func (x *SetRehearsalAttendanceRequest) GetStatus() RsvpStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RsvpStatus_RSVP_STATUS_UNSPECIFIED
+}
+
+type GetCheckInCodeRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// Invalidate the old code and issue a new one.
+	Rotate        bool `protobuf:"varint,2,opt,name=rotate,proto3" json:"rotate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCheckInCodeRequest) Reset() {
+	*x = GetCheckInCodeRequest{}
+	mi := &file_event_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCheckInCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCheckInCodeRequest) ProtoMessage() {}
+
+func (x *GetCheckInCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCheckInCodeRequest.ProtoReflect.Descriptor instead.
+func (*GetCheckInCodeRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetCheckInCodeRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *GetCheckInCodeRequest) GetRotate() bool {
+	if x != nil {
+		return x.Rotate
+	}
+	return false
+}
+
+type CheckInCode struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Code  string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// Mini App link carrying the code; this is what the QR encodes.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// PNG image of the QR code.
+	QrPng         []byte `protobuf:"bytes,3,opt,name=qr_png,json=qrPng,proto3" json:"qr_png,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInCode) Reset() {
+	*x = CheckInCode{}
+	mi := &file_event_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInCode) ProtoMessage() {}
+
+func (x *CheckInCode) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInCode.ProtoReflect.Descriptor instead.
+func (*CheckInCode) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CheckInCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CheckInCode) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CheckInCode) GetQrPng() []byte {
+	if x != nil {
+		return x.QrPng
+	}
+	return nil
+}
+
+type CheckInRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInRequest) Reset() {
+	*x = CheckInRequest{}
+	mi := &file_event_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInRequest) ProtoMessage() {}
+
+func (x *CheckInRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInRequest.ProtoReflect.Descriptor instead.
+func (*CheckInRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CheckInRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type FeedbackSurvey struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	OpenedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=opened_at,json=openedAt,proto3" json:"opened_at,omitempty"`
+	ClosesAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=closes_at,json=closesAt,proto3" json:"closes_at,omitempty"`
+	Open     bool                   `protobuf:"varint,3,opt,name=open,proto3" json:"open,omitempty"`
+	// Current user's answer; 0 when not answered.
+	MyRating      uint32 `protobuf:"varint,4,opt,name=my_rating,json=myRating,proto3" json:"my_rating,omitempty"`
+	MyComment     string `protobuf:"bytes,5,opt,name=my_comment,json=myComment,proto3" json:"my_comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedbackSurvey) Reset() {
+	*x = FeedbackSurvey{}
+	mi := &file_event_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedbackSurvey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedbackSurvey) ProtoMessage() {}
+
+func (x *FeedbackSurvey) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedbackSurvey.ProtoReflect.Descriptor instead.
+func (*FeedbackSurvey) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *FeedbackSurvey) GetOpenedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpenedAt
+	}
+	return nil
+}
+
+func (x *FeedbackSurvey) GetClosesAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosesAt
+	}
+	return nil
+}
+
+func (x *FeedbackSurvey) GetOpen() bool {
+	if x != nil {
+		return x.Open
+	}
+	return false
+}
+
+func (x *FeedbackSurvey) GetMyRating() uint32 {
+	if x != nil {
+		return x.MyRating
+	}
+	return 0
+}
+
+func (x *FeedbackSurvey) GetMyComment() string {
+	if x != nil {
+		return x.MyComment
+	}
+	return ""
+}
+
+type OpenFeedbackSurveyRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// Defaults to a week from now.
+	ClosesAt      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=closes_at,json=closesAt,proto3" json:"closes_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenFeedbackSurveyRequest) Reset() {
+	*x = OpenFeedbackSurveyRequest{}
+	mi := &file_event_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenFeedbackSurveyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenFeedbackSurveyRequest) ProtoMessage() {}
+
+func (x *OpenFeedbackSurveyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenFeedbackSurveyRequest.ProtoReflect.Descriptor instead.
+func (*OpenFeedbackSurveyRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *OpenFeedbackSurveyRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *OpenFeedbackSurveyRequest) GetClosesAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosesAt
+	}
+	return nil
+}
+
+type SubmitFeedbackRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// 1 to 5.
+	Rating        uint32 `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"`
+	Comment       string `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitFeedbackRequest) Reset() {
+	*x = SubmitFeedbackRequest{}
+	mi := &file_event_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitFeedbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitFeedbackRequest) ProtoMessage() {}
+
+func (x *SubmitFeedbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitFeedbackRequest.ProtoReflect.Descriptor instead.
+func (*SubmitFeedbackRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SubmitFeedbackRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *SubmitFeedbackRequest) GetRating() uint32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *SubmitFeedbackRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type FeedbackAnswer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Rating        uint32                 `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"`
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	SubmittedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedbackAnswer) Reset() {
+	*x = FeedbackAnswer{}
+	mi := &file_event_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedbackAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedbackAnswer) ProtoMessage() {}
+
+func (x *FeedbackAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedbackAnswer.ProtoReflect.Descriptor instead.
+func (*FeedbackAnswer) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *FeedbackAnswer) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *FeedbackAnswer) GetRating() uint32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *FeedbackAnswer) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *FeedbackAnswer) GetSubmittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return nil
+}
+
+type FeedbackResults struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Survey        *FeedbackSurvey        `protobuf:"bytes,2,opt,name=survey,proto3" json:"survey,omitempty"`
+	Responses     uint32                 `protobuf:"varint,3,opt,name=responses,proto3" json:"responses,omitempty"`
+	AverageRating float64                `protobuf:"fixed64,4,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"`
+	// Number of answers per rating, index 0 holding the 1-star ones.
+	RatingCounts []uint32 `protobuf:"varint,5,rep,packed,name=rating_counts,json=ratingCounts,proto3" json:"rating_counts,omitempty"`
+	// Most recent first.
+	Answers       []*FeedbackAnswer `protobuf:"bytes,6,rep,name=answers,proto3" json:"answers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedbackResults) Reset() {
+	*x = FeedbackResults{}
+	mi := &file_event_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedbackResults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedbackResults) ProtoMessage() {}
+
+func (x *FeedbackResults) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedbackResults.ProtoReflect.Descriptor instead.
+func (*FeedbackResults) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *FeedbackResults) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *FeedbackResults) GetSurvey() *FeedbackSurvey {
+	if x != nil {
+		return x.Survey
+	}
+	return nil
+}
+
+func (x *FeedbackResults) GetResponses() uint32 {
+	if x != nil {
+		return x.Responses
+	}
+	return 0
+}
+
+func (x *FeedbackResults) GetAverageRating() float64 {
+	if x != nil {
+		return x.AverageRating
+	}
+	return 0
+}
+
+func (x *FeedbackResults) GetRatingCounts() []uint32 {
+	if x != nil {
+		return x.RatingCounts
+	}
+	return nil
+}
+
+func (x *FeedbackResults) GetAnswers() []*FeedbackAnswer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+type EquipmentItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// E.g. "Snare drum" or "XLR cable".
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Quantity uint32 `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Notes    string `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	// Unset while nobody has taken the item.
+	Bringer *User `protobuf:"bytes,5,opt,name=bringer,proto3" json:"bringer,omitempty"`
+	// Set when the item was ticked off; cleared when the bringer changes.
+	CheckedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	CreatedById   string                 `protobuf:"bytes,7,opt,name=created_by_id,json=createdById,proto3" json:"created_by_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EquipmentItem) Reset() {
+	*x = EquipmentItem{}
+	mi := &file_event_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EquipmentItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EquipmentItem) ProtoMessage() {}
+
+func (x *EquipmentItem) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EquipmentItem.ProtoReflect.Descriptor instead.
+func (*EquipmentItem) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *EquipmentItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EquipmentItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EquipmentItem) GetQuantity() uint32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *EquipmentItem) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *EquipmentItem) GetBringer() *User {
+	if x != nil {
+		return x.Bringer
+	}
+	return nil
+}
+
+func (x *EquipmentItem) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+func (x *EquipmentItem) GetCreatedById() string {
+	if x != nil {
+		return x.CreatedById
+	}
+	return ""
+}
+
+type EquipmentItemId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EquipmentItemId) Reset() {
+	*x = EquipmentItemId{}
+	mi := &file_event_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EquipmentItemId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EquipmentItemId) ProtoMessage() {}
+
+func (x *EquipmentItemId) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EquipmentItemId.ProtoReflect.Descriptor instead.
+func (*EquipmentItemId) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *EquipmentItemId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type AddEquipmentItemRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Name    string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Defaults to 1.
+	Quantity      uint32 `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Notes         string `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddEquipmentItemRequest) Reset() {
+	*x = AddEquipmentItemRequest{}
+	mi := &file_event_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddEquipmentItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddEquipmentItemRequest) ProtoMessage() {}
+
+func (x *AddEquipmentItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddEquipmentItemRequest.ProtoReflect.Descriptor instead.
+func (*AddEquipmentItemRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *AddEquipmentItemRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *AddEquipmentItemRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddEquipmentItemRequest) GetQuantity() uint32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *AddEquipmentItemRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type UpdateEquipmentItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Quantity      uint32                 `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Notes         string                 `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEquipmentItemRequest) Reset() {
+	*x = UpdateEquipmentItemRequest{}
+	mi := &file_event_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEquipmentItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEquipmentItemRequest) ProtoMessage() {}
+
+func (x *UpdateEquipmentItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEquipmentItemRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEquipmentItemRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *UpdateEquipmentItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateEquipmentItemRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateEquipmentItemRequest) GetQuantity() uint32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *UpdateEquipmentItemRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type SetEquipmentBringerRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	ItemId string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	// Empty clears the bringer.
+	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetEquipmentBringerRequest) Reset() {
+	*x = SetEquipmentBringerRequest{}
+	mi := &file_event_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEquipmentBringerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEquipmentBringerRequest) ProtoMessage() {}
+
+func (x *SetEquipmentBringerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEquipmentBringerRequest.ProtoReflect.Descriptor instead.
+func (*SetEquipmentBringerRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SetEquipmentBringerRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *SetEquipmentBringerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type SetEquipmentCheckedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Checked       bool                   `protobuf:"varint,2,opt,name=checked,proto3" json:"checked,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EventId) Reset() {
-	*x = EventId{}
-	mi := &file_event_proto_msgTypes[0]
+func (x *SetEquipmentCheckedRequest) Reset() {
+	*x = SetEquipmentCheckedRequest{}
+	mi := &file_event_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventId) String() string {
+func (x *SetEquipmentCheckedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventId) ProtoMessage() {}
+func (*SetEquipmentCheckedRequest) ProtoMessage() {}
 
-func (x *EventId) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[0]
+func (x *SetEquipmentCheckedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -55,42 +4871,58 @@ func (x *EventId) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventId.ProtoReflect.Descriptor instead.
-func (*EventId) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use SetEquipmentCheckedRequest.ProtoReflect.Descriptor instead.
+func (*SetEquipmentCheckedRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *EventId) GetId() string {
+func (x *SetEquipmentCheckedRequest) GetItemId() string {
 	if x != nil {
-		return x.Id
+		return x.ItemId
 	}
 	return ""
 }
 
-type ListEventsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	From          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	Limit         uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+func (x *SetEquipmentCheckedRequest) GetChecked() bool {
+	if x != nil {
+		return x.Checked
+	}
+	return false
+}
+
+type Ride struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind  RideKind               `protobuf:"varint,2,opt,name=kind,proto3,enum=musicclub.event.RideKind" json:"kind,omitempty"`
+	User  *User                  `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	// Seats offered or needed.
+	Seats uint32 `protobuf:"varint,4,opt,name=seats,proto3" json:"seats,omitempty"`
+	// Where the ride starts or the passenger can be picked up.
+	FromLocation string                 `protobuf:"bytes,5,opt,name=from_location,json=fromLocation,proto3" json:"from_location,omitempty"`
+	DepartAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=depart_at,json=departAt,proto3" json:"depart_at,omitempty"`
+	Notes        string                 `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
+	// Offers only: who took a seat, earliest first, and what is left.
+	Passengers    []*User `protobuf:"bytes,8,rep,name=passengers,proto3" json:"passengers,omitempty"`
+	SeatsLeft     uint32  `protobuf:"varint,9,opt,name=seats_left,json=seatsLeft,proto3" json:"seats_left,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEventsRequest) Reset() {
-	*x = ListEventsRequest{}
-	mi := &file_event_proto_msgTypes[1]
+func (x *Ride) Reset() {
+	*x = Ride{}
+	mi := &file_event_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEventsRequest) String() string {
+func (x *Ride) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEventsRequest) ProtoMessage() {}
+func (*Ride) ProtoMessage() {}
 
-func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[1]
+func (x *Ride) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -101,54 +4933,96 @@ func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
-func (*ListEventsRequest) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use Ride.ProtoReflect.Descriptor instead.
+func (*Ride) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *ListEventsRequest) GetFrom() *timestamppb.Timestamp {
+func (x *Ride) GetId() string {
 	if x != nil {
-		return x.From
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Ride) GetKind() RideKind {
+	if x != nil {
+		return x.Kind
+	}
+	return RideKind_RIDE_KIND_UNSPECIFIED
+}
+
+func (x *Ride) GetUser() *User {
+	if x != nil {
+		return x.User
 	}
 	return nil
 }
 
-func (x *ListEventsRequest) GetTo() *timestamppb.Timestamp {
+func (x *Ride) GetSeats() uint32 {
 	if x != nil {
-		return x.To
+		return x.Seats
+	}
+	return 0
+}
+
+func (x *Ride) GetFromLocation() string {
+	if x != nil {
+		return x.FromLocation
+	}
+	return ""
+}
+
+func (x *Ride) GetDepartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DepartAt
 	}
 	return nil
 }
 
-func (x *ListEventsRequest) GetLimit() uint32 {
+func (x *Ride) GetNotes() string {
 	if x != nil {
-		return x.Limit
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Ride) GetPassengers() []*User {
+	if x != nil {
+		return x.Passengers
+	}
+	return nil
+}
+
+func (x *Ride) GetSeatsLeft() uint32 {
+	if x != nil {
+		return x.SeatsLeft
 	}
 	return 0
 }
 
-type ListEventsResponse struct {
+type RideId struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEventsResponse) Reset() {
-	*x = ListEventsResponse{}
-	mi := &file_event_proto_msgTypes[2]
+func (x *RideId) Reset() {
+	*x = RideId{}
+	mi := &file_event_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEventsResponse) String() string {
+func (x *RideId) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEventsResponse) ProtoMessage() {}
+func (*RideId) ProtoMessage() {}
 
-func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[2]
+func (x *RideId) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -159,46 +5033,128 @@ func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
-func (*ListEventsResponse) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use RideId.ProtoReflect.Descriptor instead.
+func (*RideId) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *ListEventsResponse) GetEvents() []*Event {
+func (x *RideId) GetId() string {
 	if x != nil {
-		return x.Events
+		return x.Id
+	}
+	return ""
+}
+
+type PostRideRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Kind    RideKind               `protobuf:"varint,2,opt,name=kind,proto3,enum=musicclub.event.RideKind" json:"kind,omitempty"`
+	// Defaults to 1.
+	Seats         uint32                 `protobuf:"varint,3,opt,name=seats,proto3" json:"seats,omitempty"`
+	FromLocation  string                 `protobuf:"bytes,4,opt,name=from_location,json=fromLocation,proto3" json:"from_location,omitempty"`
+	DepartAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=depart_at,json=departAt,proto3" json:"depart_at,omitempty"`
+	Notes         string                 `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostRideRequest) Reset() {
+	*x = PostRideRequest{}
+	mi := &file_event_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostRideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostRideRequest) ProtoMessage() {}
+
+func (x *PostRideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostRideRequest.ProtoReflect.Descriptor instead.
+func (*PostRideRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *PostRideRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *PostRideRequest) GetKind() RideKind {
+	if x != nil {
+		return x.Kind
+	}
+	return RideKind_RIDE_KIND_UNSPECIFIED
+}
+
+func (x *PostRideRequest) GetSeats() uint32 {
+	if x != nil {
+		return x.Seats
+	}
+	return 0
+}
+
+func (x *PostRideRequest) GetFromLocation() string {
+	if x != nil {
+		return x.FromLocation
+	}
+	return ""
+}
+
+func (x *PostRideRequest) GetDepartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DepartAt
 	}
 	return nil
 }
 
-type Event struct {
-	state    protoimpl.MessageState `protogen:"open.v1"`
-	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title    string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	StartAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
-	Location string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
-	// Notification preferences for reminders.
-	NotifyDayBefore  bool `protobuf:"varint,5,opt,name=notify_day_before,json=notifyDayBefore,proto3" json:"notify_day_before,omitempty"`
-	NotifyHourBefore bool `protobuf:"varint,6,opt,name=notify_hour_before,json=notifyHourBefore,proto3" json:"notify_hour_before,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *PostRideRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
 }
 
-func (x *Event) Reset() {
-	*x = Event{}
-	mi := &file_event_proto_msgTypes[3]
+type SetRidePassengerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// An offer.
+	RideId string `protobuf:"bytes,1,opt,name=ride_id,json=rideId,proto3" json:"ride_id,omitempty"`
+	// false gives the seat back.
+	Riding        bool `protobuf:"varint,2,opt,name=riding,proto3" json:"riding,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRidePassengerRequest) Reset() {
+	*x = SetRidePassengerRequest{}
+	mi := &file_event_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Event) String() string {
+func (x *SetRidePassengerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event) ProtoMessage() {}
+func (*SetRidePassengerRequest) ProtoMessage() {}
 
-func (x *Event) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[3]
+func (x *SetRidePassengerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -209,78 +5165,192 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event.ProtoReflect.Descriptor instead.
-func (*Event) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use SetRidePassengerRequest.ProtoReflect.Descriptor instead.
+func (*SetRidePassengerRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *Event) GetId() string {
+func (x *SetRidePassengerRequest) GetRideId() string {
+	if x != nil {
+		return x.RideId
+	}
+	return ""
+}
+
+func (x *SetRidePassengerRequest) GetRiding() bool {
+	if x != nil {
+		return x.Riding
+	}
+	return false
+}
+
+type Expense struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Payer *User                  `protobuf:"bytes,2,opt,name=payer,proto3" json:"payer,omitempty"`
+	// E.g. "Rehearsal room" or "Strings".
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// In minor currency units (kopecks).
+	AmountCents int64 `protobuf:"varint,4,opt,name=amount_cents,json=amountCents,proto3" json:"amount_cents,omitempty"`
+	// People sharing the cost equally, the payer included if they take part.
+	SplitBetween  []*User                `protobuf:"bytes,5,rep,name=split_between,json=splitBetween,proto3" json:"split_between,omitempty"`
+	CreatedById   string                 `protobuf:"bytes,6,opt,name=created_by_id,json=createdById,proto3" json:"created_by_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Expense) Reset() {
+	*x = Expense{}
+	mi := &file_event_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Expense) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Expense) ProtoMessage() {}
+
+func (x *Expense) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Expense.ProtoReflect.Descriptor instead.
+func (*Expense) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *Expense) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Event) GetTitle() string {
+func (x *Expense) GetPayer() *User {
 	if x != nil {
-		return x.Title
+		return x.Payer
+	}
+	return nil
+}
+
+func (x *Expense) GetDescription() string {
+	if x != nil {
+		return x.Description
 	}
 	return ""
 }
 
-func (x *Event) GetStartAt() *timestamppb.Timestamp {
+func (x *Expense) GetAmountCents() int64 {
 	if x != nil {
-		return x.StartAt
+		return x.AmountCents
+	}
+	return 0
+}
+
+func (x *Expense) GetSplitBetween() []*User {
+	if x != nil {
+		return x.SplitBetween
 	}
 	return nil
 }
 
-func (x *Event) GetLocation() string {
+func (x *Expense) GetCreatedById() string {
 	if x != nil {
-		return x.Location
+		return x.CreatedById
 	}
 	return ""
 }
 
-func (x *Event) GetNotifyDayBefore() bool {
-	if x != nil {
-		return x.NotifyDayBefore
-	}
-	return false
+func (x *Expense) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ExpenseId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseId) Reset() {
+	*x = ExpenseId{}
+	mi := &file_event_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseId) ProtoMessage() {}
+
+func (x *ExpenseId) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseId.ProtoReflect.Descriptor instead.
+func (*ExpenseId) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *Event) GetNotifyHourBefore() bool {
+func (x *ExpenseId) GetId() string {
 	if x != nil {
-		return x.NotifyHourBefore
+		return x.Id
 	}
-	return false
+	return ""
 }
 
-type EventDetails struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
-	Tracklist     *Tracklist             `protobuf:"bytes,2,opt,name=tracklist,proto3" json:"tracklist,omitempty"`
-	Participants  []*RoleAssignment      `protobuf:"bytes,3,rep,name=participants,proto3" json:"participants,omitempty"`
-	Permissions   *PermissionSet         `protobuf:"bytes,4,opt,name=permissions,proto3" json:"permissions,omitempty"`
+type AddExpenseRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	EventId     string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	AmountCents int64                  `protobuf:"varint,3,opt,name=amount_cents,json=amountCents,proto3" json:"amount_cents,omitempty"`
+	// Defaults to the current user.
+	PayerId string `protobuf:"bytes,4,opt,name=payer_id,json=payerId,proto3" json:"payer_id,omitempty"`
+	// Defaults to the event's participants and the payer.
+	SplitUserIds  []string `protobuf:"bytes,5,rep,name=split_user_ids,json=splitUserIds,proto3" json:"split_user_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EventDetails) Reset() {
-	*x = EventDetails{}
-	mi := &file_event_proto_msgTypes[4]
+func (x *AddExpenseRequest) Reset() {
+	*x = AddExpenseRequest{}
+	mi := &file_event_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventDetails) String() string {
+func (x *AddExpenseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventDetails) ProtoMessage() {}
+func (*AddExpenseRequest) ProtoMessage() {}
 
-func (x *EventDetails) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[4]
+func (x *AddExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -291,61 +5361,73 @@ func (x *EventDetails) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventDetails.ProtoReflect.Descriptor instead.
-func (*EventDetails) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use AddExpenseRequest.ProtoReflect.Descriptor instead.
+func (*AddExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *EventDetails) GetEvent() *Event {
+func (x *AddExpenseRequest) GetEventId() string {
 	if x != nil {
-		return x.Event
+		return x.EventId
 	}
-	return nil
+	return ""
 }
 
-func (x *EventDetails) GetTracklist() *Tracklist {
+func (x *AddExpenseRequest) GetDescription() string {
 	if x != nil {
-		return x.Tracklist
+		return x.Description
 	}
-	return nil
+	return ""
 }
 
-func (x *EventDetails) GetParticipants() []*RoleAssignment {
+func (x *AddExpenseRequest) GetAmountCents() int64 {
 	if x != nil {
-		return x.Participants
+		return x.AmountCents
 	}
-	return nil
+	return 0
 }
 
-func (x *EventDetails) GetPermissions() *PermissionSet {
+func (x *AddExpenseRequest) GetPayerId() string {
 	if x != nil {
-		return x.Permissions
+		return x.PayerId
+	}
+	return ""
+}
+
+func (x *AddExpenseRequest) GetSplitUserIds() []string {
+	if x != nil {
+		return x.SplitUserIds
 	}
 	return nil
 }
 
-type Tracklist struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*TrackItem           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+type ExpenseBalance struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	User      *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	PaidCents int64                  `protobuf:"varint,2,opt,name=paid_cents,json=paidCents,proto3" json:"paid_cents,omitempty"`
+	// The user's part of all expenses they share.
+	ShareCents int64 `protobuf:"varint,3,opt,name=share_cents,json=shareCents,proto3" json:"share_cents,omitempty"`
+	// paid minus share: positive means the user gets money back.
+	BalanceCents  int64 `protobuf:"varint,4,opt,name=balance_cents,json=balanceCents,proto3" json:"balance_cents,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Tracklist) Reset() {
-	*x = Tracklist{}
-	mi := &file_event_proto_msgTypes[5]
+func (x *ExpenseBalance) Reset() {
+	*x = ExpenseBalance{}
+	mi := &file_event_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Tracklist) String() string {
+func (x *ExpenseBalance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Tracklist) ProtoMessage() {}
+func (*ExpenseBalance) ProtoMessage() {}
 
-func (x *Tracklist) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[5]
+func (x *ExpenseBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -356,45 +5438,63 @@ func (x *Tracklist) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Tracklist.ProtoReflect.Descriptor instead.
-func (*Tracklist) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ExpenseBalance.ProtoReflect.Descriptor instead.
+func (*ExpenseBalance) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *Tracklist) GetItems() []*TrackItem {
+func (x *ExpenseBalance) GetUser() *User {
 	if x != nil {
-		return x.Items
+		return x.User
 	}
 	return nil
 }
 
-type TrackItem struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	Order uint32                 `protobuf:"varint,1,opt,name=order,proto3" json:"order,omitempty"`
-	// Reference to a song in the catalog.
-	SongId string `protobuf:"bytes,2,opt,name=song_id,json=songId,proto3" json:"song_id,omitempty"`
-	// If song is not in catalog, allow a custom title/artist.
-	CustomTitle   string `protobuf:"bytes,3,opt,name=custom_title,json=customTitle,proto3" json:"custom_title,omitempty"`
-	CustomArtist  string `protobuf:"bytes,4,opt,name=custom_artist,json=customArtist,proto3" json:"custom_artist,omitempty"`
+func (x *ExpenseBalance) GetPaidCents() int64 {
+	if x != nil {
+		return x.PaidCents
+	}
+	return 0
+}
+
+func (x *ExpenseBalance) GetShareCents() int64 {
+	if x != nil {
+		return x.ShareCents
+	}
+	return 0
+}
+
+func (x *ExpenseBalance) GetBalanceCents() int64 {
+	if x != nil {
+		return x.BalanceCents
+	}
+	return 0
+}
+
+type Settlement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *User                  `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *User                  `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	AmountCents   int64                  `protobuf:"varint,3,opt,name=amount_cents,json=amountCents,proto3" json:"amount_cents,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TrackItem) Reset() {
-	*x = TrackItem{}
-	mi := &file_event_proto_msgTypes[6]
+func (x *Settlement) Reset() {
+	*x = Settlement{}
+	mi := &file_event_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TrackItem) String() string {
+func (x *Settlement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrackItem) ProtoMessage() {}
+func (*Settlement) ProtoMessage() {}
 
-func (x *TrackItem) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[6]
+func (x *Settlement) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -405,66 +5505,62 @@ func (x *TrackItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrackItem.ProtoReflect.Descriptor instead.
-func (*TrackItem) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *TrackItem) GetOrder() uint32 {
-	if x != nil {
-		return x.Order
-	}
-	return 0
+// Deprecated: Use Settlement.ProtoReflect.Descriptor instead.
+func (*Settlement) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *TrackItem) GetSongId() string {
+func (x *Settlement) GetFrom() *User {
 	if x != nil {
-		return x.SongId
+		return x.From
 	}
-	return ""
+	return nil
 }
 
-func (x *TrackItem) GetCustomTitle() string {
+func (x *Settlement) GetTo() *User {
 	if x != nil {
-		return x.CustomTitle
+		return x.To
 	}
-	return ""
+	return nil
 }
 
-func (x *TrackItem) GetCustomArtist() string {
+func (x *Settlement) GetAmountCents() int64 {
 	if x != nil {
-		return x.CustomArtist
+		return x.AmountCents
 	}
-	return ""
+	return 0
 }
 
-type CreateEventRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Title            string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	StartAt          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
-	Location         string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
-	NotifyDayBefore  bool                   `protobuf:"varint,4,opt,name=notify_day_before,json=notifyDayBefore,proto3" json:"notify_day_before,omitempty"`
-	NotifyHourBefore bool                   `protobuf:"varint,5,opt,name=notify_hour_before,json=notifyHourBefore,proto3" json:"notify_hour_before,omitempty"`
-	Tracklist        *Tracklist             `protobuf:"bytes,6,opt,name=tracklist,proto3" json:"tracklist,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type ExpenseSummary struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// Oldest first.
+	Expenses   []*Expense `protobuf:"bytes,2,rep,name=expenses,proto3" json:"expenses,omitempty"`
+	TotalCents int64      `protobuf:"varint,3,opt,name=total_cents,json=totalCents,proto3" json:"total_cents,omitempty"`
+	// Largest balance first.
+	Balances []*ExpenseBalance `protobuf:"bytes,4,rep,name=balances,proto3" json:"balances,omitempty"`
+	// Transfers that bring every balance to zero, largest debts settled
+	// first.
+	Settlements   []*Settlement `protobuf:"bytes,5,rep,name=settlements,proto3" json:"settlements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateEventRequest) Reset() {
-	*x = CreateEventRequest{}
-	mi := &file_event_proto_msgTypes[7]
+func (x *ExpenseSummary) Reset() {
+	*x = ExpenseSummary{}
+	mi := &file_event_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateEventRequest) String() string {
+func (x *ExpenseSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEventRequest) ProtoMessage() {}
+func (*ExpenseSummary) ProtoMessage() {}
 
-func (x *CreateEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[7]
+func (x *ExpenseSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -475,80 +5571,70 @@ func (x *CreateEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEventRequest.ProtoReflect.Descriptor instead.
-func (*CreateEventRequest) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ExpenseSummary.ProtoReflect.Descriptor instead.
+func (*ExpenseSummary) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *CreateEventRequest) GetTitle() string {
+func (x *ExpenseSummary) GetEventId() string {
 	if x != nil {
-		return x.Title
+		return x.EventId
 	}
 	return ""
 }
 
-func (x *CreateEventRequest) GetStartAt() *timestamppb.Timestamp {
+func (x *ExpenseSummary) GetExpenses() []*Expense {
 	if x != nil {
-		return x.StartAt
+		return x.Expenses
 	}
 	return nil
 }
 
-func (x *CreateEventRequest) GetLocation() string {
-	if x != nil {
-		return x.Location
-	}
-	return ""
-}
-
-func (x *CreateEventRequest) GetNotifyDayBefore() bool {
+func (x *ExpenseSummary) GetTotalCents() int64 {
 	if x != nil {
-		return x.NotifyDayBefore
+		return x.TotalCents
 	}
-	return false
+	return 0
 }
 
-func (x *CreateEventRequest) GetNotifyHourBefore() bool {
+func (x *ExpenseSummary) GetBalances() []*ExpenseBalance {
 	if x != nil {
-		return x.NotifyHourBefore
+		return x.Balances
 	}
-	return false
+	return nil
 }
 
-func (x *CreateEventRequest) GetTracklist() *Tracklist {
+func (x *ExpenseSummary) GetSettlements() []*Settlement {
 	if x != nil {
-		return x.Tracklist
+		return x.Settlements
 	}
 	return nil
 }
 
-type UpdateEventRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title            string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	StartAt          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
-	Location         string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
-	NotifyDayBefore  bool                   `protobuf:"varint,5,opt,name=notify_day_before,json=notifyDayBefore,proto3" json:"notify_day_before,omitempty"`
-	NotifyHourBefore bool                   `protobuf:"varint,6,opt,name=notify_hour_before,json=notifyHourBefore,proto3" json:"notify_hour_before,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type CreateEventShareLinkRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// Defaults to 30 days from now; at most a year ahead.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateEventRequest) Reset() {
-	*x = UpdateEventRequest{}
-	mi := &file_event_proto_msgTypes[8]
+func (x *CreateEventShareLinkRequest) Reset() {
+	*x = CreateEventShareLinkRequest{}
+	mi := &file_event_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEventRequest) String() string {
+func (x *CreateEventShareLinkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEventRequest) ProtoMessage() {}
+func (*CreateEventShareLinkRequest) ProtoMessage() {}
 
-func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[8]
+func (x *CreateEventShareLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -559,76 +5645,48 @@ func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEventRequest.ProtoReflect.Descriptor instead.
-func (*UpdateEventRequest) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *UpdateEventRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use CreateEventShareLinkRequest.ProtoReflect.Descriptor instead.
+func (*CreateEventShareLinkRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *UpdateEventRequest) GetTitle() string {
+func (x *CreateEventShareLinkRequest) GetEventId() string {
 	if x != nil {
-		return x.Title
+		return x.EventId
 	}
 	return ""
 }
 
-func (x *UpdateEventRequest) GetStartAt() *timestamppb.Timestamp {
+func (x *CreateEventShareLinkRequest) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.StartAt
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-func (x *UpdateEventRequest) GetLocation() string {
-	if x != nil {
-		return x.Location
-	}
-	return ""
-}
-
-func (x *UpdateEventRequest) GetNotifyDayBefore() bool {
-	if x != nil {
-		return x.NotifyDayBefore
-	}
-	return false
-}
-
-func (x *UpdateEventRequest) GetNotifyHourBefore() bool {
-	if x != nil {
-		return x.NotifyHourBefore
-	}
-	return false
-}
-
-type SetTracklistRequest struct {
+type EventShareLink struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	Tracklist     *Tracklist             `protobuf:"bytes,2,opt,name=tracklist,proto3" json:"tracklist,omitempty"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetTracklistRequest) Reset() {
-	*x = SetTracklistRequest{}
-	mi := &file_event_proto_msgTypes[9]
+func (x *EventShareLink) Reset() {
+	*x = EventShareLink{}
+	mi := &file_event_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetTracklistRequest) String() string {
+func (x *EventShareLink) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetTracklistRequest) ProtoMessage() {}
+func (*EventShareLink) ProtoMessage() {}
 
-func (x *SetTracklistRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[9]
+func (x *EventShareLink) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -639,21 +5697,21 @@ func (x *SetTracklistRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetTracklistRequest.ProtoReflect.Descriptor instead.
-func (*SetTracklistRequest) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use EventShareLink.ProtoReflect.Descriptor instead.
+func (*EventShareLink) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *SetTracklistRequest) GetEventId() string {
+func (x *EventShareLink) GetUrl() string {
 	if x != nil {
-		return x.EventId
+		return x.Url
 	}
 	return ""
 }
 
-func (x *SetTracklistRequest) GetTracklist() *Tracklist {
+func (x *EventShareLink) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Tracklist
+		return x.ExpiresAt
 	}
 	return nil
 }
@@ -662,61 +5720,543 @@ var File_event_proto protoreflect.FileDescriptor
 
 const file_event_proto_rawDesc = "" +
 	"\n" +
-	"\vevent.proto\x12\x0fmusicclub.event\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\n" +
+	"\vevent.proto\x12\x0fmusicclub.event\x1a\x1bbuf/validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\n" +
 	"song.proto\x1a\n" +
-	"user.proto\x1a\x11permissions.proto\"\x19\n" +
-	"\aEventId\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x85\x01\n" +
+	"user.proto\x1a\x11permissions.proto\x1a\vvenue.proto\x1a\x10validation.proto\"#\n" +
+	"\aEventId\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"G\n" +
+	"\x11EventOwnerRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"G\n" +
+	"\x12CancelEventRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x86\x03\n" +
 	"\x11ListEventsRequest\x12.\n" +
 	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
-	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\rR\x05limit\"D\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x1e\n" +
+	"\x05limit\x18\x03 \x01(\rB\b\xbaH\x05*\x03\x18\xc8\x01R\x05limit\x12A\n" +
+	"\vtime_filter\x18\x04 \x01(\x0e2 .musicclub.event.EventTimeFilterR\n" +
+	"timeFilter\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\x12%\n" +
+	"\tpage_size\x18\x06 \x01(\rB\b\xbaH\x05*\x03\x18\xc8\x01R\bpageSize\x12&\n" +
+	"\bvenue_id\x18\a \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\avenueId\x12(\n" +
+	"\tseason_id\x18\b \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\bseasonId\x12\x1a\n" +
+	"\barchived\x18\t \x01(\bR\barchived\"l\n" +
 	"\x12ListEventsResponse\x12.\n" +
-	"\x06events\x18\x01 \x03(\v2\x16.musicclub.event.EventR\x06events\"\xda\x01\n" +
+	"\x06events\x18\x01 \x03(\v2\x16.musicclub.event.EventR\x06events\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xda\t\n" +
 	"\x05Event\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x125\n" +
 	"\bstart_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\astartAt\x12\x1a\n" +
 	"\blocation\x18\x04 \x01(\tR\blocation\x12*\n" +
 	"\x11notify_day_before\x18\x05 \x01(\bR\x0fnotifyDayBefore\x12,\n" +
-	"\x12notify_hour_before\x18\x06 \x01(\bR\x10notifyHourBefore\"\x82\x02\n" +
+	"\x12notify_hour_before\x18\x06 \x01(\bR\x10notifyHourBefore\x12\x18\n" +
+	"\aversion\x18\a \x01(\x03R\aversion\x12+\n" +
+	"\x11participant_count\x18\b \x01(\x05R\x10participantCount\x12\x1f\n" +
+	"\vtrack_count\x18\t \x01(\x05R\n" +
+	"trackCount\x12\x1b\n" +
+	"\tseries_id\x18\n" +
+	" \x01(\tR\bseriesId\x12\x1e\n" +
+	"\n" +
+	"recurrence\x18\v \x01(\tR\n" +
+	"recurrence\x12)\n" +
+	"\x10max_participants\x18\f \x01(\x05R\x0fmaxParticipants\x12%\n" +
+	"\x0erequired_roles\x18\r \x03(\tR\rrequiredRoles\x12)\n" +
+	"\x10attendance_count\x18\x0e \x01(\x05R\x0fattendanceCount\x12\x19\n" +
+	"\bvenue_id\x18\x0f \x01(\tR\avenueId\x12*\n" +
+	"\x11time_slot_minutes\x18\x10 \x01(\x05R\x0ftimeSlotMinutes\x12=\n" +
+	"\fcompleted_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x124\n" +
+	"\x16notify_offsets_minutes\x18\x12 \x03(\x05R\x14notifyOffsetsMinutes\x12=\n" +
+	"\fcancelled_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\vcancelledAt\x12#\n" +
+	"\rcancel_reason\x18\x14 \x01(\tR\fcancelReason\x12\x1a\n" +
+	"\btimezone\x18\x15 \x01(\tR\btimezone\x12$\n" +
+	"\x0elocal_start_at\x18\x16 \x01(\tR\flocalStartAt\x12\x1b\n" +
+	"\tseason_id\x18\x17 \x01(\tR\bseasonId\x12*\n" +
+	"\x11track_gap_seconds\x18\x18 \x01(\x05R\x0ftrackGapSeconds\x121\n" +
+	"\x15current_track_item_id\x18\x19 \x01(\tR\x12currentTrackItemId\x12S\n" +
+	"\x18current_track_started_at\x18\x1a \x01(\v2\x1a.google.protobuf.TimestampR\x15currentTrackStartedAt\x129\n" +
+	"\n" +
+	"deleted_at\x18\x1b \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12;\n" +
+	"\vcoordinates\x18\x1c \x01(\v2\x19.musicclub.venue.GeoPointR\vcoordinates\x12\x14\n" +
+	"\x05theme\x18\x1d \x01(\tR\x05theme\x12\x1b\n" +
+	"\ttheme_tag\x18\x1e \x01(\tR\bthemeTag\"\x81\b\n" +
 	"\fEventDetails\x12,\n" +
 	"\x05event\x18\x01 \x01(\v2\x16.musicclub.event.EventR\x05event\x128\n" +
 	"\ttracklist\x18\x02 \x01(\v2\x1a.musicclub.event.TracklistR\ttracklist\x12B\n" +
 	"\fparticipants\x18\x03 \x03(\v2\x1e.musicclub.song.RoleAssignmentR\fparticipants\x12F\n" +
-	"\vpermissions\x18\x04 \x01(\v2$.musicclub.permissions.PermissionSetR\vpermissions\"=\n" +
+	"\vpermissions\x18\x04 \x01(\v2$.musicclub.permissions.PermissionSetR\vpermissions\x12?\n" +
+	"\frsvp_summary\x18\x05 \x01(\v2\x1c.musicclub.event.RsvpSummaryR\vrsvpSummary\x124\n" +
+	"\amy_rsvp\x18\x06 \x01(\x0e2\x1b.musicclub.event.RsvpStatusR\x06myRsvp\x12+\n" +
+	"\x05rsvps\x18\a \x03(\v2\x15.musicclub.event.RsvpR\x05rsvps\x12\x1d\n" +
+	"\n" +
+	"spots_left\x18\b \x01(\x05R\tspotsLeft\x12\x17\n" +
+	"\aics_url\x18\t \x01(\tR\x06icsUrl\x12:\n" +
+	"\n" +
+	"rehearsals\x18\n" +
+	" \x03(\v2\x1a.musicclub.event.RehearsalR\n" +
+	"rehearsals\x12;\n" +
+	"\n" +
+	"attendance\x18\v \x03(\v2\x1b.musicclub.event.AttendanceR\n" +
+	"attendance\x12\x1d\n" +
+	"\n" +
+	"checked_in\x18\f \x01(\bR\tcheckedIn\x12,\n" +
+	"\x05venue\x18\r \x01(\v2\x16.musicclub.venue.VenueR\x05venue\x126\n" +
+	"\alineups\x18\x0e \x03(\v2\x1c.musicclub.event.TrackLineupR\alineups\x12H\n" +
+	"\x0ffeedback_survey\x18\x0f \x01(\v2\x1f.musicclub.event.FeedbackSurveyR\x0efeedbackSurvey\x12<\n" +
+	"\tequipment\x18\x10 \x03(\v2\x1e.musicclub.event.EquipmentItemR\tequipment\x12+\n" +
+	"\x05rides\x18\x11 \x03(\v2\x15.musicclub.event.RideR\x05rides\x12,\n" +
+	"\x06owners\x18\x12 \x03(\v2\x14.musicclub.user.UserR\x06owners\x12\x19\n" +
+	"\bcan_edit\x18\x13 \x01(\bR\acanEdit\x12%\n" +
+	"\x0edirections_url\x18\x14 \x01(\tR\rdirectionsUrl\"s\n" +
+	"\vTrackLineup\x12\"\n" +
+	"\rtrack_item_id\x18\x01 \x01(\tR\vtrackItemId\x12@\n" +
+	"\vassignments\x18\x02 \x03(\v2\x1e.musicclub.song.RoleAssignmentR\vassignments\"v\n" +
+	"\n" +
+	"Attendance\x12(\n" +
+	"\x04user\x18\x01 \x01(\v2\x14.musicclub.user.UserR\x04user\x12>\n" +
+	"\rchecked_in_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vcheckedInAt\"u\n" +
+	"\vRsvpSummary\x12\x14\n" +
+	"\x05going\x18\x01 \x01(\x05R\x05going\x12\x14\n" +
+	"\x05maybe\x18\x02 \x01(\x05R\x05maybe\x12\x1a\n" +
+	"\bdeclined\x18\x03 \x01(\x05R\bdeclined\x12\x1e\n" +
+	"\n" +
+	"waitlisted\x18\x04 \x01(\x05R\n" +
+	"waitlisted\"\xa0\x01\n" +
+	"\x04Rsvp\x12(\n" +
+	"\x04user\x18\x01 \x01(\v2\x14.musicclub.user.UserR\x04user\x123\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1b.musicclub.event.RsvpStatusR\x06status\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"j\n" +
+	"\x0eSetRsvpRequest\x12#\n" +
+	"\bevent_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aeventId\x123\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1b.musicclub.event.RsvpStatusR\x06status\"\xd0\x01\n" +
 	"\tTracklist\x120\n" +
-	"\x05items\x18\x01 \x03(\v2\x1a.musicclub.event.TrackItemR\x05items\"\x82\x01\n" +
+	"\x05items\x18\x01 \x03(\v2\x1a.musicclub.event.TrackItemR\x05items\x12#\n" +
+	"\rtotal_seconds\x18\x02 \x01(\rR\ftotalSeconds\x12-\n" +
+	"\x04sets\x18\x03 \x03(\v2\x19.musicclub.event.TrackSetR\x04sets\x12=\n" +
+	"\bwarnings\x18\x04 \x03(\v2!.musicclub.event.TracklistWarningR\bwarnings\"\xcd\x01\n" +
+	"\x10TracklistWarning\x129\n" +
+	"\x04kind\x18\x01 \x01(\x0e2%.musicclub.event.TracklistWarningKindR\x04kind\x12(\n" +
+	"\x04user\x18\x02 \x01(\v2\x14.musicclub.user.UserR\x04user\x12$\n" +
+	"\x0etrack_item_ids\x18\x03 \x03(\tR\ftrackItemIds\x12\x14\n" +
+	"\x05roles\x18\x04 \x03(\tR\x05roles\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\"\x85\x01\n" +
+	"\bTrackSet\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x120\n" +
+	"\x05items\x18\x03 \x03(\v2\x1a.musicclub.event.TrackItemR\x05items\x12#\n" +
+	"\rtotal_seconds\x18\x04 \x01(\rR\ftotalSeconds\"\x8e\x05\n" +
 	"\tTrackItem\x12\x14\n" +
 	"\x05order\x18\x01 \x01(\rR\x05order\x12\x17\n" +
 	"\asong_id\x18\x02 \x01(\tR\x06songId\x12!\n" +
 	"\fcustom_title\x18\x03 \x01(\tR\vcustomTitle\x12#\n" +
-	"\rcustom_artist\x18\x04 \x01(\tR\fcustomArtist\"\x91\x02\n" +
+	"\rcustom_artist\x18\x04 \x01(\tR\fcustomArtist\x12\x0e\n" +
+	"\x02id\x18\x05 \x01(\tR\x02id\x12)\n" +
+	"\x10duration_seconds\x18\x06 \x01(\rR\x0fdurationSeconds\x12<\n" +
+	"\x1aeffective_duration_seconds\x18\a \x01(\rR\x18effectiveDurationSeconds\x12&\n" +
+	"\x0fends_at_seconds\x18\b \x01(\rR\rendsAtSeconds\x12*\n" +
+	"\x11exceeds_time_slot\x18\t \x01(\bR\x0fexceedsTimeSlot\x12\x15\n" +
+	"\x06set_id\x18\n" +
+	" \x01(\tR\x05setId\x12\x1c\n" +
+	"\tperformed\x18\v \x01(\bR\tperformed\x12P\n" +
+	"\x10rehearsal_status\x18\f \x01(\x0e2%.musicclub.event.TrackRehearsalStatusR\x0frehearsalStatus\x12\x14\n" +
+	"\x05notes\x18\r \x01(\tR\x05notes\x12\x10\n" +
+	"\x03key\x18\x0e \x01(\tR\x03key\x12D\n" +
+	"\x10planned_start_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\x0eplannedStartAt\x12H\n" +
+	"\x12scheduled_start_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\x10scheduledStartAt\"\x93\x01\n" +
+	"\x1eSetTrackRehearsalStatusRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\tR\x06itemId\x12=\n" +
+	"\x06status\x18\x03 \x01(\x0e2%.musicclub.event.TrackRehearsalStatusR\x06status\"`\n" +
+	"\x16SetCurrentTrackRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\tR\x06itemId\x12\x12\n" +
+	"\x04next\x18\x03 \x01(\bR\x04next\"\xee\x05\n" +
 	"\x12CreateEventRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x125\n" +
 	"\bstart_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\astartAt\x12\x1a\n" +
 	"\blocation\x18\x03 \x01(\tR\blocation\x12*\n" +
 	"\x11notify_day_before\x18\x04 \x01(\bR\x0fnotifyDayBefore\x12,\n" +
 	"\x12notify_hour_before\x18\x05 \x01(\bR\x10notifyHourBefore\x128\n" +
-	"\ttracklist\x18\x06 \x01(\v2\x1a.musicclub.event.TracklistR\ttracklist\"\xe7\x01\n" +
-	"\x12UpdateEventRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\ttracklist\x18\x06 \x01(\v2\x1a.musicclub.event.TracklistR\ttracklist\x12\x1e\n" +
+	"\n" +
+	"recurrence\x18\a \x01(\tR\n" +
+	"recurrence\x12)\n" +
+	"\x10max_participants\x18\b \x01(\x05R\x0fmaxParticipants\x12%\n" +
+	"\x0erequired_roles\x18\t \x03(\tR\rrequiredRoles\x12\x19\n" +
+	"\bvenue_id\x18\n" +
+	" \x01(\tR\avenueId\x12*\n" +
+	"\x11time_slot_minutes\x18\v \x01(\x05R\x0ftimeSlotMinutes\x124\n" +
+	"\x16notify_offsets_minutes\x18\f \x03(\x05R\x14notifyOffsetsMinutes\x12\x1a\n" +
+	"\btimezone\x18\r \x01(\tR\btimezone\x12\x1b\n" +
+	"\tseason_id\x18\x0e \x01(\tR\bseasonId\x12*\n" +
+	"\x11track_gap_seconds\x18\x0f \x01(\x05R\x0ftrackGapSeconds\x12;\n" +
+	"\vcoordinates\x18\x10 \x01(\v2\x19.musicclub.venue.GeoPointR\vcoordinates\x12\x17\n" +
+	"\amap_url\x18\x11 \x01(\tR\x06mapUrl\x12\x14\n" +
+	"\x05theme\x18\x12 \x01(\tR\x05theme\x12\x1b\n" +
+	"\ttheme_tag\x18\x13 \x01(\tR\bthemeTag\"\xa6\x01\n" +
+	"\x15DuplicateEventRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x125\n" +
+	"\bstart_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\astartAt\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12%\n" +
+	"\x0ecopy_tracklist\x18\x04 \x01(\bR\rcopyTracklist\"\xd7\x06\n" +
+	"\x12UpdateEventRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x125\n" +
 	"\bstart_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\astartAt\x12\x1a\n" +
 	"\blocation\x18\x04 \x01(\tR\blocation\x12*\n" +
 	"\x11notify_day_before\x18\x05 \x01(\bR\x0fnotifyDayBefore\x12,\n" +
-	"\x12notify_hour_before\x18\x06 \x01(\bR\x10notifyHourBefore\"j\n" +
+	"\x12notify_hour_before\x18\x06 \x01(\bR\x10notifyHourBefore\x122\n" +
+	"\x10expected_version\x18\a \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x0fexpectedVersion\x126\n" +
+	"\x05scope\x18\b \x01(\x0e2 .musicclub.event.RecurrenceScopeR\x05scope\x12)\n" +
+	"\x10max_participants\x18\t \x01(\x05R\x0fmaxParticipants\x12%\n" +
+	"\x0erequired_roles\x18\n" +
+	" \x03(\tR\rrequiredRoles\x12\x19\n" +
+	"\bvenue_id\x18\v \x01(\tR\avenueId\x12*\n" +
+	"\x11time_slot_minutes\x18\f \x01(\x05R\x0ftimeSlotMinutes\x124\n" +
+	"\x16notify_offsets_minutes\x18\r \x03(\x05R\x14notifyOffsetsMinutes\x12\x1a\n" +
+	"\btimezone\x18\x0e \x01(\tR\btimezone\x12\x1b\n" +
+	"\tseason_id\x18\x0f \x01(\tR\bseasonId\x12*\n" +
+	"\x11track_gap_seconds\x18\x10 \x01(\x05R\x0ftrackGapSeconds\x12;\n" +
+	"\vcoordinates\x18\x11 \x01(\v2\x19.musicclub.venue.GeoPointR\vcoordinates\x12\x17\n" +
+	"\amap_url\x18\x12 \x01(\tR\x06mapUrl\x12\x14\n" +
+	"\x05theme\x18\x13 \x01(\tR\x05theme\x12\x1b\n" +
+	"\ttheme_tag\x18\x14 \x01(\tR\bthemeTag\x12;\n" +
+	"\vupdate_mask\x18\x15 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"j\n" +
 	"\x13SetTracklistRequest\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\x128\n" +
-	"\ttracklist\x18\x02 \x01(\v2\x1a.musicclub.event.TracklistR\ttracklist2\xe6\x03\n" +
+	"\ttracklist\x18\x02 \x01(\v2\x1a.musicclub.event.TracklistR\ttracklist\"\x8d\x01\n" +
+	"\x14CopyTracklistRequest\x12&\n" +
+	"\x0fsource_event_id\x18\x01 \x01(\tR\rsourceEventId\x12&\n" +
+	"\x0ftarget_event_id\x18\x02 \x01(\tR\rtargetEventId\x12%\n" +
+	"\x0eonly_performed\x18\x03 \x01(\bR\ronlyPerformed\"\xab\x01\n" +
+	"\x17SuggestTracklistRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\x12&\n" +
+	"\x0fweight_by_votes\x18\x03 \x01(\bR\rweightByVotes\x127\n" +
+	"\x18weight_by_last_performed\x18\x04 \x01(\bR\x15weightByLastPerformed\"\x98\x01\n" +
+	"\x1eRenderEventAnnouncementRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12;\n" +
+	"\x06format\x18\x02 \x01(\x0e2#.musicclub.event.AnnouncementFormatR\x06format\x12\x1e\n" +
+	"\n" +
+	"highlights\x18\x03 \x01(\rR\n" +
+	"highlights\"d\n" +
+	"\x11EventAnnouncement\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12;\n" +
+	"\x06format\x18\x02 \x01(\x0e2#.musicclub.event.AnnouncementFormatR\x06format\"s\n" +
+	"\x16ExportTracklistRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12>\n" +
+	"\x06format\x18\x02 \x01(\x0e2&.musicclub.event.TracklistExportFormatR\x06format\"l\n" +
+	"\x11ExportedTracklist\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\"\x96\x01\n" +
+	"\x16InsertTrackItemRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12.\n" +
+	"\x04item\x18\x02 \x01(\v2\x1a.musicclub.event.TrackItemR\x04item\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\rR\bposition\x12\x15\n" +
+	"\x06set_id\x18\x04 \x01(\tR\x05setId\"}\n" +
+	"\x14MoveTrackItemRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\tR\x06itemId\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\rR\bposition\x12\x15\n" +
+	"\x06set_id\x18\x04 \x01(\tR\x05setId\"B\n" +
+	"\fTrackItemRef\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\tR\x06itemId\"\xa0\x01\n" +
+	"\x16UpdateTrackItemRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12.\n" +
+	"\x04item\x18\x02 \x01(\v2\x1a.musicclub.event.TrackItemR\x04item\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\" \n" +
+	"\fCalendarFeed\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"\xf9\x03\n" +
+	"\rEventTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\rtitle_pattern\x18\x03 \x01(\tR\ftitlePattern\x12\x1a\n" +
+	"\blocation\x18\x04 \x01(\tR\blocation\x12*\n" +
+	"\x11notify_day_before\x18\x05 \x01(\bR\x0fnotifyDayBefore\x12,\n" +
+	"\x12notify_hour_before\x18\x06 \x01(\bR\x10notifyHourBefore\x12)\n" +
+	"\x10max_participants\x18\a \x01(\x05R\x0fmaxParticipants\x12%\n" +
+	"\x0erequired_roles\x18\b \x03(\tR\rrequiredRoles\x12\x12\n" +
+	"\x04uses\x18\t \x01(\x05R\x04uses\x12\x19\n" +
+	"\bvenue_id\x18\n" +
+	" \x01(\tR\avenueId\x12*\n" +
+	"\x11time_slot_minutes\x18\v \x01(\x05R\x0ftimeSlotMinutes\x124\n" +
+	"\x16notify_offsets_minutes\x18\f \x03(\x05R\x14notifyOffsetsMinutes\x12\x1a\n" +
+	"\btimezone\x18\r \x01(\tR\btimezone\x12*\n" +
+	"\x11track_gap_seconds\x18\x0e \x01(\x05R\x0ftrackGapSeconds\"+\n" +
+	"\x0fEventTemplateId\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x82\x01\n" +
+	"\x18SaveEventTemplateRequest\x12#\n" +
+	"\bevent_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aeventId\x12\x1c\n" +
+	"\x04name\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xc0>\x01R\x04name\x12#\n" +
+	"\rtitle_pattern\x18\x03 \x01(\tR\ftitlePattern\"Z\n" +
+	"\x1aListEventTemplatesResponse\x12<\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x1e.musicclub.event.EventTemplateR\ttemplates\"\x8e\x01\n" +
+	"\x1eCreateEventFromTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x125\n" +
+	"\bstart_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\astartAt\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\"\xcb\x03\n" +
+	"\tRehearsal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x125\n" +
+	"\bstart_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\astartAt\x121\n" +
+	"\x06end_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x05endAt\x12\x1a\n" +
+	"\blocation\x18\x05 \x01(\tR\blocation\x12\x14\n" +
+	"\x05notes\x18\x06 \x01(\tR\x05notes\x12$\n" +
+	"\x0etrack_item_ids\x18\a \x03(\tR\ftrackItemIds\x12*\n" +
+	"\x11notify_day_before\x18\b \x01(\bR\x0fnotifyDayBefore\x12,\n" +
+	"\x12notify_hour_before\x18\t \x01(\bR\x10notifyHourBefore\x125\n" +
+	"\n" +
+	"attendance\x18\n" +
+	" \x03(\v2\x15.musicclub.event.RsvpR\n" +
+	"attendance\x12@\n" +
+	"\rmy_attendance\x18\v \x01(\x0e2\x1b.musicclub.event.RsvpStatusR\fmyAttendance\"'\n" +
+	"\vRehearsalId\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\xc7\x02\n" +
+	"\x0eRehearsalInput\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x125\n" +
+	"\bstart_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\astartAt\x121\n" +
+	"\x06end_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05endAt\x12\x1a\n" +
+	"\blocation\x18\x04 \x01(\tR\blocation\x12\x14\n" +
+	"\x05notes\x18\x05 \x01(\tR\x05notes\x12$\n" +
+	"\x0etrack_item_ids\x18\x06 \x03(\tR\ftrackItemIds\x12*\n" +
+	"\x11notify_day_before\x18\a \x01(\bR\x0fnotifyDayBefore\x12,\n" +
+	"\x12notify_hour_before\x18\b \x01(\bR\x10notifyHourBefore\"g\n" +
+	"\x16UpdateRehearsalRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12=\n" +
+	"\trehearsal\x18\x02 \x01(\v2\x1f.musicclub.event.RehearsalInputR\trehearsal\"w\n" +
+	"\x1dSetRehearsalAttendanceRequest\x12!\n" +
+	"\frehearsal_id\x18\x01 \x01(\tR\vrehearsalId\x123\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1b.musicclub.event.RsvpStatusR\x06status\"J\n" +
+	"\x15GetCheckInCodeRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x16\n" +
+	"\x06rotate\x18\x02 \x01(\bR\x06rotate\"J\n" +
+	"\vCheckInCode\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x15\n" +
+	"\x06qr_png\x18\x03 \x01(\fR\x05qrPng\"$\n" +
+	"\x0eCheckInRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"\xd2\x01\n" +
+	"\x0eFeedbackSurvey\x127\n" +
+	"\topened_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\bopenedAt\x127\n" +
+	"\tcloses_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bclosesAt\x12\x12\n" +
+	"\x04open\x18\x03 \x01(\bR\x04open\x12\x1b\n" +
+	"\tmy_rating\x18\x04 \x01(\rR\bmyRating\x12\x1d\n" +
+	"\n" +
+	"my_comment\x18\x05 \x01(\tR\tmyComment\"o\n" +
+	"\x19OpenFeedbackSurveyRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x127\n" +
+	"\tcloses_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bclosesAt\"d\n" +
+	"\x15SubmitFeedbackRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x16\n" +
+	"\x06rating\x18\x02 \x01(\rR\x06rating\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\"\xab\x01\n" +
+	"\x0eFeedbackAnswer\x12(\n" +
+	"\x04user\x18\x01 \x01(\v2\x14.musicclub.user.UserR\x04user\x12\x16\n" +
+	"\x06rating\x18\x02 \x01(\rR\x06rating\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\x12=\n" +
+	"\fsubmitted_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vsubmittedAt\"\x8a\x02\n" +
+	"\x0fFeedbackResults\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x127\n" +
+	"\x06survey\x18\x02 \x01(\v2\x1f.musicclub.event.FeedbackSurveyR\x06survey\x12\x1c\n" +
+	"\tresponses\x18\x03 \x01(\rR\tresponses\x12%\n" +
+	"\x0eaverage_rating\x18\x04 \x01(\x01R\raverageRating\x12#\n" +
+	"\rrating_counts\x18\x05 \x03(\rR\fratingCounts\x129\n" +
+	"\aanswers\x18\x06 \x03(\v2\x1f.musicclub.event.FeedbackAnswerR\aanswers\"\xf4\x01\n" +
+	"\rEquipmentItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\rR\bquantity\x12\x14\n" +
+	"\x05notes\x18\x04 \x01(\tR\x05notes\x12.\n" +
+	"\abringer\x18\x05 \x01(\v2\x14.musicclub.user.UserR\abringer\x129\n" +
+	"\n" +
+	"checked_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcheckedAt\x12\"\n" +
+	"\rcreated_by_id\x18\a \x01(\tR\vcreatedById\"+\n" +
+	"\x0fEquipmentItemId\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x8e\x01\n" +
+	"\x17AddEquipmentItemRequest\x12#\n" +
+	"\bevent_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aeventId\x12\x1c\n" +
+	"\x04name\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xc0>\x01R\x04name\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\rR\bquantity\x12\x14\n" +
+	"\x05notes\x18\x04 \x01(\tR\x05notes\"\x86\x01\n" +
+	"\x1aUpdateEquipmentItemRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1c\n" +
+	"\x04name\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xc0>\x01R\x04name\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\rR\bquantity\x12\x14\n" +
+	"\x05notes\x18\x04 \x01(\tR\x05notes\"N\n" +
+	"\x1aSetEquipmentBringerRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"O\n" +
+	"\x1aSetEquipmentCheckedRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\x18\n" +
+	"\achecked\x18\x02 \x01(\bR\achecked\"\xce\x02\n" +
+	"\x04Ride\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12-\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x19.musicclub.event.RideKindR\x04kind\x12(\n" +
+	"\x04user\x18\x03 \x01(\v2\x14.musicclub.user.UserR\x04user\x12\x14\n" +
+	"\x05seats\x18\x04 \x01(\rR\x05seats\x12#\n" +
+	"\rfrom_location\x18\x05 \x01(\tR\ffromLocation\x127\n" +
+	"\tdepart_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bdepartAt\x12\x14\n" +
+	"\x05notes\x18\a \x01(\tR\x05notes\x124\n" +
+	"\n" +
+	"passengers\x18\b \x03(\v2\x14.musicclub.user.UserR\n" +
+	"passengers\x12\x1d\n" +
+	"\n" +
+	"seats_left\x18\t \x01(\rR\tseatsLeft\"\"\n" +
+	"\x06RideId\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\xe5\x01\n" +
+	"\x0fPostRideRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12-\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x19.musicclub.event.RideKindR\x04kind\x12\x14\n" +
+	"\x05seats\x18\x03 \x01(\rR\x05seats\x12#\n" +
+	"\rfrom_location\x18\x04 \x01(\tR\ffromLocation\x127\n" +
+	"\tdepart_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bdepartAt\x12\x14\n" +
+	"\x05notes\x18\x06 \x01(\tR\x05notes\"J\n" +
+	"\x17SetRidePassengerRequest\x12\x17\n" +
+	"\aride_id\x18\x01 \x01(\tR\x06rideId\x12\x16\n" +
+	"\x06riding\x18\x02 \x01(\bR\x06riding\"\xa4\x02\n" +
+	"\aExpense\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12*\n" +
+	"\x05payer\x18\x02 \x01(\v2\x14.musicclub.user.UserR\x05payer\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12!\n" +
+	"\famount_cents\x18\x04 \x01(\x03R\vamountCents\x129\n" +
+	"\rsplit_between\x18\x05 \x03(\v2\x14.musicclub.user.UserR\fsplitBetween\x12\"\n" +
+	"\rcreated_by_id\x18\x06 \x01(\tR\vcreatedById\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"%\n" +
+	"\tExpenseId\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\xe4\x01\n" +
+	"\x11AddExpenseRequest\x12#\n" +
+	"\bevent_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aeventId\x12*\n" +
+	"\vdescription\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xc0>\x01R\vdescription\x12!\n" +
+	"\famount_cents\x18\x03 \x01(\x03R\vamountCents\x12&\n" +
+	"\bpayer_id\x18\x04 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\apayerId\x123\n" +
+	"\x0esplit_user_ids\x18\x05 \x03(\tB\r\xbaH\n" +
+	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\fsplitUserIds\"\x9f\x01\n" +
+	"\x0eExpenseBalance\x12(\n" +
+	"\x04user\x18\x01 \x01(\v2\x14.musicclub.user.UserR\x04user\x12\x1d\n" +
+	"\n" +
+	"paid_cents\x18\x02 \x01(\x03R\tpaidCents\x12\x1f\n" +
+	"\vshare_cents\x18\x03 \x01(\x03R\n" +
+	"shareCents\x12#\n" +
+	"\rbalance_cents\x18\x04 \x01(\x03R\fbalanceCents\"\x7f\n" +
+	"\n" +
+	"Settlement\x12(\n" +
+	"\x04from\x18\x01 \x01(\v2\x14.musicclub.user.UserR\x04from\x12$\n" +
+	"\x02to\x18\x02 \x01(\v2\x14.musicclub.user.UserR\x02to\x12!\n" +
+	"\famount_cents\x18\x03 \x01(\x03R\vamountCents\"\xfe\x01\n" +
+	"\x0eExpenseSummary\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x124\n" +
+	"\bexpenses\x18\x02 \x03(\v2\x18.musicclub.event.ExpenseR\bexpenses\x12\x1f\n" +
+	"\vtotal_cents\x18\x03 \x01(\x03R\n" +
+	"totalCents\x12;\n" +
+	"\bbalances\x18\x04 \x03(\v2\x1f.musicclub.event.ExpenseBalanceR\bbalances\x12=\n" +
+	"\vsettlements\x18\x05 \x03(\v2\x1b.musicclub.event.SettlementR\vsettlements\"s\n" +
+	"\x1bCreateEventShareLinkRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"]\n" +
+	"\x0eEventShareLink\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt*h\n" +
+	"\x0fEventTimeFilter\x12\x19\n" +
+	"\x15EVENT_TIME_FILTER_ALL\x10\x00\x12\x1e\n" +
+	"\x1aEVENT_TIME_FILTER_UPCOMING\x10\x01\x12\x1a\n" +
+	"\x16EVENT_TIME_FILTER_PAST\x10\x02*\x8d\x01\n" +
+	"\n" +
+	"RsvpStatus\x12\x1b\n" +
+	"\x17RSVP_STATUS_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11RSVP_STATUS_GOING\x10\x01\x12\x15\n" +
+	"\x11RSVP_STATUS_MAYBE\x10\x02\x12\x18\n" +
+	"\x14RSVP_STATUS_DECLINED\x10\x03\x12\x1a\n" +
+	"\x16RSVP_STATUS_WAITLISTED\x10\x04*\xb6\x01\n" +
+	"\x14TracklistWarningKind\x12&\n" +
+	"\"TRACKLIST_WARNING_KIND_UNSPECIFIED\x10\x00\x12,\n" +
+	"(TRACKLIST_WARNING_KIND_INSTRUMENT_CHANGE\x10\x01\x12\"\n" +
+	"\x1eTRACKLIST_WARNING_KIND_OVERLAP\x10\x02\x12$\n" +
+	" TRACKLIST_WARNING_KIND_OFF_THEME\x10\x03*\xb0\x01\n" +
+	"\x14TrackRehearsalStatus\x12&\n" +
+	"\"TRACK_REHEARSAL_STATUS_UNSPECIFIED\x10\x00\x12&\n" +
+	"\"TRACK_REHEARSAL_STATUS_NOT_STARTED\x10\x01\x12&\n" +
+	"\"TRACK_REHEARSAL_STATUS_IN_PROGRESS\x10\x02\x12 \n" +
+	"\x1cTRACK_REHEARSAL_STATUS_READY\x10\x03*X\n" +
+	"\x0fRecurrenceScope\x12$\n" +
+	" RECURRENCE_SCOPE_THIS_OCCURRENCE\x10\x00\x12\x1f\n" +
+	"\x1bRECURRENCE_SCOPE_ALL_FUTURE\x10\x01*T\n" +
+	"\x12AnnouncementFormat\x12\x1c\n" +
+	"\x18ANNOUNCEMENT_FORMAT_HTML\x10\x00\x12 \n" +
+	"\x1cANNOUNCEMENT_FORMAT_MARKDOWN\x10\x01*Z\n" +
+	"\x15TracklistExportFormat\x12 \n" +
+	"\x1cTRACKLIST_EXPORT_FORMAT_TEXT\x10\x00\x12\x1f\n" +
+	"\x1bTRACKLIST_EXPORT_FORMAT_PDF\x10\x01*Q\n" +
+	"\bRideKind\x12\x19\n" +
+	"\x15RIDE_KIND_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fRIDE_KIND_OFFER\x10\x01\x12\x15\n" +
+	"\x11RIDE_KIND_REQUEST\x10\x022\xc5\"\n" +
 	"\fEventService\x12U\n" +
 	"\n" +
 	"ListEvents\x12\".musicclub.event.ListEventsRequest\x1a#.musicclub.event.ListEventsResponse\x12C\n" +
 	"\bGetEvent\x12\x18.musicclub.event.EventId\x1a\x1d.musicclub.event.EventDetails\x12Q\n" +
-	"\vCreateEvent\x12#.musicclub.event.CreateEventRequest\x1a\x1d.musicclub.event.EventDetails\x12Q\n" +
+	"\vCreateEvent\x12#.musicclub.event.CreateEventRequest\x1a\x1d.musicclub.event.EventDetails\x12W\n" +
+	"\x0eDuplicateEvent\x12&.musicclub.event.DuplicateEventRequest\x1a\x1d.musicclub.event.EventDetails\x12Q\n" +
 	"\vUpdateEvent\x12#.musicclub.event.UpdateEventRequest\x1a\x1d.musicclub.event.EventDetails\x12?\n" +
-	"\vDeleteEvent\x12\x18.musicclub.event.EventId\x1a\x16.google.protobuf.Empty\x12S\n" +
-	"\fSetTracklist\x12$.musicclub.event.SetTracklistRequest\x1a\x1d.musicclub.event.EventDetailsB\x1cZ\x1amusicclubbot/backend/protob\x06proto3"
+	"\vDeleteEvent\x12\x18.musicclub.event.EventId\x1a\x16.google.protobuf.Empty\x12G\n" +
+	"\fRestoreEvent\x12\x18.musicclub.event.EventId\x1a\x1d.musicclub.event.EventDetails\x12H\n" +
+	"\rCompleteEvent\x12\x18.musicclub.event.EventId\x1a\x1d.musicclub.event.EventDetails\x12Q\n" +
+	"\vCancelEvent\x12#.musicclub.event.CancelEventRequest\x1a\x1d.musicclub.event.EventDetails\x12R\n" +
+	"\rAddEventOwner\x12\".musicclub.event.EventOwnerRequest\x1a\x1d.musicclub.event.EventDetails\x12U\n" +
+	"\x10RemoveEventOwner\x12\".musicclub.event.EventOwnerRequest\x1a\x1d.musicclub.event.EventDetails\x12S\n" +
+	"\fSetTracklist\x12$.musicclub.event.SetTracklistRequest\x1a\x1d.musicclub.event.EventDetails\x12D\n" +
+	"\x10PublishTracklist\x12\x18.musicclub.event.EventId\x1a\x16.google.protobuf.Empty\x12U\n" +
+	"\rCopyTracklist\x12%.musicclub.event.CopyTracklistRequest\x1a\x1d.musicclub.event.EventDetails\x12X\n" +
+	"\x10SuggestTracklist\x12(.musicclub.event.SuggestTracklistRequest\x1a\x1a.musicclub.event.Tracklist\x12n\n" +
+	"\x17RenderEventAnnouncement\x12/.musicclub.event.RenderEventAnnouncementRequest\x1a\".musicclub.event.EventAnnouncement\x12^\n" +
+	"\x0fExportTracklist\x12'.musicclub.event.ExportTracklistRequest\x1a\".musicclub.event.ExportedTracklist\x12Y\n" +
+	"\x0fInsertTrackItem\x12'.musicclub.event.InsertTrackItemRequest\x1a\x1d.musicclub.event.EventDetails\x12U\n" +
+	"\rMoveTrackItem\x12%.musicclub.event.MoveTrackItemRequest\x1a\x1d.musicclub.event.EventDetails\x12O\n" +
+	"\x0fRemoveTrackItem\x12\x1d.musicclub.event.TrackItemRef\x1a\x1d.musicclub.event.EventDetails\x12Y\n" +
+	"\x0fUpdateTrackItem\x12'.musicclub.event.UpdateTrackItemRequest\x1a\x1d.musicclub.event.EventDetails\x12i\n" +
+	"\x17SetTrackRehearsalStatus\x12/.musicclub.event.SetTrackRehearsalStatusRequest\x1a\x1d.musicclub.event.EventDetails\x12Y\n" +
+	"\x0fSetCurrentTrack\x12'.musicclub.event.SetCurrentTrackRequest\x1a\x1d.musicclub.event.EventDetails\x12G\n" +
+	"\n" +
+	"WatchEvent\x12\x18.musicclub.event.EventId\x1a\x1d.musicclub.event.EventDetails0\x01\x12I\n" +
+	"\aSetRsvp\x12\x1f.musicclub.event.SetRsvpRequest\x1a\x1d.musicclub.event.EventDetails\x12H\n" +
+	"\x0fGetCalendarFeed\x12\x16.google.protobuf.Empty\x1a\x1d.musicclub.event.CalendarFeed\x12e\n" +
+	"\x14CreateEventShareLink\x12,.musicclub.event.CreateEventShareLinkRequest\x1a\x1f.musicclub.event.EventShareLink\x12^\n" +
+	"\x11SaveEventTemplate\x12).musicclub.event.SaveEventTemplateRequest\x1a\x1e.musicclub.event.EventTemplate\x12Y\n" +
+	"\x12ListEventTemplates\x12\x16.google.protobuf.Empty\x1a+.musicclub.event.ListEventTemplatesResponse\x12O\n" +
+	"\x13DeleteEventTemplate\x12 .musicclub.event.EventTemplateId\x1a\x16.google.protobuf.Empty\x12i\n" +
+	"\x17CreateEventFromTemplate\x12/.musicclub.event.CreateEventFromTemplateRequest\x1a\x1d.musicclub.event.EventDetails\x12Q\n" +
+	"\x0fCreateRehearsal\x12\x1f.musicclub.event.RehearsalInput\x1a\x1d.musicclub.event.EventDetails\x12Y\n" +
+	"\x0fUpdateRehearsal\x12'.musicclub.event.UpdateRehearsalRequest\x1a\x1d.musicclub.event.EventDetails\x12G\n" +
+	"\x0fDeleteRehearsal\x12\x1c.musicclub.event.RehearsalId\x1a\x16.google.protobuf.Empty\x12g\n" +
+	"\x16SetRehearsalAttendance\x12..musicclub.event.SetRehearsalAttendanceRequest\x1a\x1d.musicclub.event.EventDetails\x12V\n" +
+	"\x0eGetCheckInCode\x12&.musicclub.event.GetCheckInCodeRequest\x1a\x1c.musicclub.event.CheckInCode\x12I\n" +
+	"\aCheckIn\x12\x1f.musicclub.event.CheckInRequest\x1a\x1d.musicclub.event.EventDetails\x12_\n" +
+	"\x12OpenFeedbackSurvey\x12*.musicclub.event.OpenFeedbackSurveyRequest\x1a\x1d.musicclub.event.EventDetails\x12W\n" +
+	"\x0eSubmitFeedback\x12&.musicclub.event.SubmitFeedbackRequest\x1a\x1d.musicclub.event.EventDetails\x12P\n" +
+	"\x12GetFeedbackResults\x12\x18.musicclub.event.EventId\x1a .musicclub.event.FeedbackResults\x12[\n" +
+	"\x10AddEquipmentItem\x12(.musicclub.event.AddEquipmentItemRequest\x1a\x1d.musicclub.event.EventDetails\x12a\n" +
+	"\x13UpdateEquipmentItem\x12+.musicclub.event.UpdateEquipmentItemRequest\x1a\x1d.musicclub.event.EventDetails\x12V\n" +
+	"\x13RemoveEquipmentItem\x12 .musicclub.event.EquipmentItemId\x1a\x1d.musicclub.event.EventDetails\x12a\n" +
+	"\x13SetEquipmentBringer\x12+.musicclub.event.SetEquipmentBringerRequest\x1a\x1d.musicclub.event.EventDetails\x12a\n" +
+	"\x13SetEquipmentChecked\x12+.musicclub.event.SetEquipmentCheckedRequest\x1a\x1d.musicclub.event.EventDetails\x12K\n" +
+	"\bPostRide\x12 .musicclub.event.PostRideRequest\x1a\x1d.musicclub.event.EventDetails\x12D\n" +
+	"\n" +
+	"CancelRide\x12\x17.musicclub.event.RideId\x1a\x1d.musicclub.event.EventDetails\x12[\n" +
+	"\x10SetRidePassenger\x12(.musicclub.event.SetRidePassengerRequest\x1a\x1d.musicclub.event.EventDetails\x12Q\n" +
+	"\n" +
+	"AddExpense\x12\".musicclub.event.AddExpenseRequest\x1a\x1f.musicclub.event.ExpenseSummary\x12L\n" +
+	"\rRemoveExpense\x12\x1a.musicclub.event.ExpenseId\x1a\x1f.musicclub.event.ExpenseSummary\x12N\n" +
+	"\x11GetExpenseSummary\x12\x18.musicclub.event.EventId\x1a\x1f.musicclub.event.ExpenseSummaryB\x1cZ\x1amusicclubbot/backend/protob\x06proto3"
 
 var (
 	file_event_proto_rawDescOnce sync.Once
@@ -730,54 +6270,296 @@ func file_event_proto_rawDescGZIP() []byte {
 	return file_event_proto_rawDescData
 }
 
-var file_event_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_event_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_event_proto_msgTypes = make([]protoimpl.MessageInfo, 69)
 var file_event_proto_goTypes = []any{
-	(*EventId)(nil),               // 0: musicclub.event.EventId
-	(*ListEventsRequest)(nil),     // 1: musicclub.event.ListEventsRequest
-	(*ListEventsResponse)(nil),    // 2: musicclub.event.ListEventsResponse
-	(*Event)(nil),                 // 3: musicclub.event.Event
-	(*EventDetails)(nil),          // 4: musicclub.event.EventDetails
-	(*Tracklist)(nil),             // 5: musicclub.event.Tracklist
-	(*TrackItem)(nil),             // 6: musicclub.event.TrackItem
-	(*CreateEventRequest)(nil),    // 7: musicclub.event.CreateEventRequest
-	(*UpdateEventRequest)(nil),    // 8: musicclub.event.UpdateEventRequest
-	(*SetTracklistRequest)(nil),   // 9: musicclub.event.SetTracklistRequest
-	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
-	(*RoleAssignment)(nil),        // 11: musicclub.song.RoleAssignment
-	(*PermissionSet)(nil),         // 12: musicclub.permissions.PermissionSet
-	(*emptypb.Empty)(nil),         // 13: google.protobuf.Empty
+	(EventTimeFilter)(0),                   // 0: musicclub.event.EventTimeFilter
+	(RsvpStatus)(0),                        // 1: musicclub.event.RsvpStatus
+	(TracklistWarningKind)(0),              // 2: musicclub.event.TracklistWarningKind
+	(TrackRehearsalStatus)(0),              // 3: musicclub.event.TrackRehearsalStatus
+	(RecurrenceScope)(0),                   // 4: musicclub.event.RecurrenceScope
+	(AnnouncementFormat)(0),                // 5: musicclub.event.AnnouncementFormat
+	(TracklistExportFormat)(0),             // 6: musicclub.event.TracklistExportFormat
+	(RideKind)(0),                          // 7: musicclub.event.RideKind
+	(*EventId)(nil),                        // 8: musicclub.event.EventId
+	(*EventOwnerRequest)(nil),              // 9: musicclub.event.EventOwnerRequest
+	(*CancelEventRequest)(nil),             // 10: musicclub.event.CancelEventRequest
+	(*ListEventsRequest)(nil),              // 11: musicclub.event.ListEventsRequest
+	(*ListEventsResponse)(nil),             // 12: musicclub.event.ListEventsResponse
+	(*Event)(nil),                          // 13: musicclub.event.Event
+	(*EventDetails)(nil),                   // 14: musicclub.event.EventDetails
+	(*TrackLineup)(nil),                    // 15: musicclub.event.TrackLineup
+	(*Attendance)(nil),                     // 16: musicclub.event.Attendance
+	(*RsvpSummary)(nil),                    // 17: musicclub.event.RsvpSummary
+	(*Rsvp)(nil),                           // 18: musicclub.event.Rsvp
+	(*SetRsvpRequest)(nil),                 // 19: musicclub.event.SetRsvpRequest
+	(*Tracklist)(nil),                      // 20: musicclub.event.Tracklist
+	(*TracklistWarning)(nil),               // 21: musicclub.event.TracklistWarning
+	(*TrackSet)(nil),                       // 22: musicclub.event.TrackSet
+	(*TrackItem)(nil),                      // 23: musicclub.event.TrackItem
+	(*SetTrackRehearsalStatusRequest)(nil), // 24: musicclub.event.SetTrackRehearsalStatusRequest
+	(*SetCurrentTrackRequest)(nil),         // 25: musicclub.event.SetCurrentTrackRequest
+	(*CreateEventRequest)(nil),             // 26: musicclub.event.CreateEventRequest
+	(*DuplicateEventRequest)(nil),          // 27: musicclub.event.DuplicateEventRequest
+	(*UpdateEventRequest)(nil),             // 28: musicclub.event.UpdateEventRequest
+	(*SetTracklistRequest)(nil),            // 29: musicclub.event.SetTracklistRequest
+	(*CopyTracklistRequest)(nil),           // 30: musicclub.event.CopyTracklistRequest
+	(*SuggestTracklistRequest)(nil),        // 31: musicclub.event.SuggestTracklistRequest
+	(*RenderEventAnnouncementRequest)(nil), // 32: musicclub.event.RenderEventAnnouncementRequest
+	(*EventAnnouncement)(nil),              // 33: musicclub.event.EventAnnouncement
+	(*ExportTracklistRequest)(nil),         // 34: musicclub.event.ExportTracklistRequest
+	(*ExportedTracklist)(nil),              // 35: musicclub.event.ExportedTracklist
+	(*InsertTrackItemRequest)(nil),         // 36: musicclub.event.InsertTrackItemRequest
+	(*MoveTrackItemRequest)(nil),           // 37: musicclub.event.MoveTrackItemRequest
+	(*TrackItemRef)(nil),                   // 38: musicclub.event.TrackItemRef
+	(*UpdateTrackItemRequest)(nil),         // 39: musicclub.event.UpdateTrackItemRequest
+	(*CalendarFeed)(nil),                   // 40: musicclub.event.CalendarFeed
+	(*EventTemplate)(nil),                  // 41: musicclub.event.EventTemplate
+	(*EventTemplateId)(nil),                // 42: musicclub.event.EventTemplateId
+	(*SaveEventTemplateRequest)(nil),       // 43: musicclub.event.SaveEventTemplateRequest
+	(*ListEventTemplatesResponse)(nil),     // 44: musicclub.event.ListEventTemplatesResponse
+	(*CreateEventFromTemplateRequest)(nil), // 45: musicclub.event.CreateEventFromTemplateRequest
+	(*Rehearsal)(nil),                      // 46: musicclub.event.Rehearsal
+	(*RehearsalId)(nil),                    // 47: musicclub.event.RehearsalId
+	(*RehearsalInput)(nil),                 // 48: musicclub.event.RehearsalInput
+	(*UpdateRehearsalRequest)(nil),         // 49: musicclub.event.UpdateRehearsalRequest
+	(*SetRehearsalAttendanceRequest)(nil),  // 50: musicclub.event.SetRehearsalAttendanceRequest
+	(*GetCheckInCodeRequest)(nil),          // 51: musicclub.event.GetCheckInCodeRequest
+	(*CheckInCode)(nil),                    // 52: musicclub.event.CheckInCode
+	(*CheckInRequest)(nil),                 // 53: musicclub.event.CheckInRequest
+	(*FeedbackSurvey)(nil),                 // 54: musicclub.event.FeedbackSurvey
+	(*OpenFeedbackSurveyRequest)(nil),      // 55: musicclub.event.OpenFeedbackSurveyRequest
+	(*SubmitFeedbackRequest)(nil),          // 56: musicclub.event.SubmitFeedbackRequest
+	(*FeedbackAnswer)(nil),                 // 57: musicclub.event.FeedbackAnswer
+	(*FeedbackResults)(nil),                // 58: musicclub.event.FeedbackResults
+	(*EquipmentItem)(nil),                  // 59: musicclub.event.EquipmentItem
+	(*EquipmentItemId)(nil),                // 60: musicclub.event.EquipmentItemId
+	(*AddEquipmentItemRequest)(nil),        // 61: musicclub.event.AddEquipmentItemRequest
+	(*UpdateEquipmentItemRequest)(nil),     // 62: musicclub.event.UpdateEquipmentItemRequest
+	(*SetEquipmentBringerRequest)(nil),     // 63: musicclub.event.SetEquipmentBringerRequest
+	(*SetEquipmentCheckedRequest)(nil),     // 64: musicclub.event.SetEquipmentCheckedRequest
+	(*Ride)(nil),                           // 65: musicclub.event.Ride
+	(*RideId)(nil),                         // 66: musicclub.event.RideId
+	(*PostRideRequest)(nil),                // 67: musicclub.event.PostRideRequest
+	(*SetRidePassengerRequest)(nil),        // 68: musicclub.event.SetRidePassengerRequest
+	(*Expense)(nil),                        // 69: musicclub.event.Expense
+	(*ExpenseId)(nil),                      // 70: musicclub.event.ExpenseId
+	(*AddExpenseRequest)(nil),              // 71: musicclub.event.AddExpenseRequest
+	(*ExpenseBalance)(nil),                 // 72: musicclub.event.ExpenseBalance
+	(*Settlement)(nil),                     // 73: musicclub.event.Settlement
+	(*ExpenseSummary)(nil),                 // 74: musicclub.event.ExpenseSummary
+	(*CreateEventShareLinkRequest)(nil),    // 75: musicclub.event.CreateEventShareLinkRequest
+	(*EventShareLink)(nil),                 // 76: musicclub.event.EventShareLink
+	(*timestamppb.Timestamp)(nil),          // 77: google.protobuf.Timestamp
+	(*GeoPoint)(nil),                       // 78: musicclub.venue.GeoPoint
+	(*RoleAssignment)(nil),                 // 79: musicclub.song.RoleAssignment
+	(*PermissionSet)(nil),                  // 80: musicclub.permissions.PermissionSet
+	(*Venue)(nil),                          // 81: musicclub.venue.Venue
+	(*User)(nil),                           // 82: musicclub.user.User
+	(*fieldmaskpb.FieldMask)(nil),          // 83: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                  // 84: google.protobuf.Empty
 }
 var file_event_proto_depIdxs = []int32{
-	10, // 0: musicclub.event.ListEventsRequest.from:type_name -> google.protobuf.Timestamp
-	10, // 1: musicclub.event.ListEventsRequest.to:type_name -> google.protobuf.Timestamp
-	3,  // 2: musicclub.event.ListEventsResponse.events:type_name -> musicclub.event.Event
-	10, // 3: musicclub.event.Event.start_at:type_name -> google.protobuf.Timestamp
-	3,  // 4: musicclub.event.EventDetails.event:type_name -> musicclub.event.Event
-	5,  // 5: musicclub.event.EventDetails.tracklist:type_name -> musicclub.event.Tracklist
-	11, // 6: musicclub.event.EventDetails.participants:type_name -> musicclub.song.RoleAssignment
-	12, // 7: musicclub.event.EventDetails.permissions:type_name -> musicclub.permissions.PermissionSet
-	6,  // 8: musicclub.event.Tracklist.items:type_name -> musicclub.event.TrackItem
-	10, // 9: musicclub.event.CreateEventRequest.start_at:type_name -> google.protobuf.Timestamp
-	5,  // 10: musicclub.event.CreateEventRequest.tracklist:type_name -> musicclub.event.Tracklist
-	10, // 11: musicclub.event.UpdateEventRequest.start_at:type_name -> google.protobuf.Timestamp
-	5,  // 12: musicclub.event.SetTracklistRequest.tracklist:type_name -> musicclub.event.Tracklist
-	1,  // 13: musicclub.event.EventService.ListEvents:input_type -> musicclub.event.ListEventsRequest
-	0,  // 14: musicclub.event.EventService.GetEvent:input_type -> musicclub.event.EventId
-	7,  // 15: musicclub.event.EventService.CreateEvent:input_type -> musicclub.event.CreateEventRequest
-	8,  // 16: musicclub.event.EventService.UpdateEvent:input_type -> musicclub.event.UpdateEventRequest
-	0,  // 17: musicclub.event.EventService.DeleteEvent:input_type -> musicclub.event.EventId
-	9,  // 18: musicclub.event.EventService.SetTracklist:input_type -> musicclub.event.SetTracklistRequest
-	2,  // 19: musicclub.event.EventService.ListEvents:output_type -> musicclub.event.ListEventsResponse
-	4,  // 20: musicclub.event.EventService.GetEvent:output_type -> musicclub.event.EventDetails
-	4,  // 21: musicclub.event.EventService.CreateEvent:output_type -> musicclub.event.EventDetails
-	4,  // 22: musicclub.event.EventService.UpdateEvent:output_type -> musicclub.event.EventDetails
-	13, // 23: musicclub.event.EventService.DeleteEvent:output_type -> google.protobuf.Empty
-	4,  // 24: musicclub.event.EventService.SetTracklist:output_type -> musicclub.event.EventDetails
-	19, // [19:25] is the sub-list for method output_type
-	13, // [13:19] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	77,  // 0: musicclub.event.ListEventsRequest.from:type_name -> google.protobuf.Timestamp
+	77,  // 1: musicclub.event.ListEventsRequest.to:type_name -> google.protobuf.Timestamp
+	0,   // 2: musicclub.event.ListEventsRequest.time_filter:type_name -> musicclub.event.EventTimeFilter
+	13,  // 3: musicclub.event.ListEventsResponse.events:type_name -> musicclub.event.Event
+	77,  // 4: musicclub.event.Event.start_at:type_name -> google.protobuf.Timestamp
+	77,  // 5: musicclub.event.Event.completed_at:type_name -> google.protobuf.Timestamp
+	77,  // 6: musicclub.event.Event.cancelled_at:type_name -> google.protobuf.Timestamp
+	77,  // 7: musicclub.event.Event.current_track_started_at:type_name -> google.protobuf.Timestamp
+	77,  // 8: musicclub.event.Event.deleted_at:type_name -> google.protobuf.Timestamp
+	78,  // 9: musicclub.event.Event.coordinates:type_name -> musicclub.venue.GeoPoint
+	13,  // 10: musicclub.event.EventDetails.event:type_name -> musicclub.event.Event
+	20,  // 11: musicclub.event.EventDetails.tracklist:type_name -> musicclub.event.Tracklist
+	79,  // 12: musicclub.event.EventDetails.participants:type_name -> musicclub.song.RoleAssignment
+	80,  // 13: musicclub.event.EventDetails.permissions:type_name -> musicclub.permissions.PermissionSet
+	17,  // 14: musicclub.event.EventDetails.rsvp_summary:type_name -> musicclub.event.RsvpSummary
+	1,   // 15: musicclub.event.EventDetails.my_rsvp:type_name -> musicclub.event.RsvpStatus
+	18,  // 16: musicclub.event.EventDetails.rsvps:type_name -> musicclub.event.Rsvp
+	46,  // 17: musicclub.event.EventDetails.rehearsals:type_name -> musicclub.event.Rehearsal
+	16,  // 18: musicclub.event.EventDetails.attendance:type_name -> musicclub.event.Attendance
+	81,  // 19: musicclub.event.EventDetails.venue:type_name -> musicclub.venue.Venue
+	15,  // 20: musicclub.event.EventDetails.lineups:type_name -> musicclub.event.TrackLineup
+	54,  // 21: musicclub.event.EventDetails.feedback_survey:type_name -> musicclub.event.FeedbackSurvey
+	59,  // 22: musicclub.event.EventDetails.equipment:type_name -> musicclub.event.EquipmentItem
+	65,  // 23: musicclub.event.EventDetails.rides:type_name -> musicclub.event.Ride
+	82,  // 24: musicclub.event.EventDetails.owners:type_name -> musicclub.user.User
+	79,  // 25: musicclub.event.TrackLineup.assignments:type_name -> musicclub.song.RoleAssignment
+	82,  // 26: musicclub.event.Attendance.user:type_name -> musicclub.user.User
+	77,  // 27: musicclub.event.Attendance.checked_in_at:type_name -> google.protobuf.Timestamp
+	82,  // 28: musicclub.event.Rsvp.user:type_name -> musicclub.user.User
+	1,   // 29: musicclub.event.Rsvp.status:type_name -> musicclub.event.RsvpStatus
+	77,  // 30: musicclub.event.Rsvp.updated_at:type_name -> google.protobuf.Timestamp
+	1,   // 31: musicclub.event.SetRsvpRequest.status:type_name -> musicclub.event.RsvpStatus
+	23,  // 32: musicclub.event.Tracklist.items:type_name -> musicclub.event.TrackItem
+	22,  // 33: musicclub.event.Tracklist.sets:type_name -> musicclub.event.TrackSet
+	21,  // 34: musicclub.event.Tracklist.warnings:type_name -> musicclub.event.TracklistWarning
+	2,   // 35: musicclub.event.TracklistWarning.kind:type_name -> musicclub.event.TracklistWarningKind
+	82,  // 36: musicclub.event.TracklistWarning.user:type_name -> musicclub.user.User
+	23,  // 37: musicclub.event.TrackSet.items:type_name -> musicclub.event.TrackItem
+	3,   // 38: musicclub.event.TrackItem.rehearsal_status:type_name -> musicclub.event.TrackRehearsalStatus
+	77,  // 39: musicclub.event.TrackItem.planned_start_at:type_name -> google.protobuf.Timestamp
+	77,  // 40: musicclub.event.TrackItem.scheduled_start_at:type_name -> google.protobuf.Timestamp
+	3,   // 41: musicclub.event.SetTrackRehearsalStatusRequest.status:type_name -> musicclub.event.TrackRehearsalStatus
+	77,  // 42: musicclub.event.CreateEventRequest.start_at:type_name -> google.protobuf.Timestamp
+	20,  // 43: musicclub.event.CreateEventRequest.tracklist:type_name -> musicclub.event.Tracklist
+	78,  // 44: musicclub.event.CreateEventRequest.coordinates:type_name -> musicclub.venue.GeoPoint
+	77,  // 45: musicclub.event.DuplicateEventRequest.start_at:type_name -> google.protobuf.Timestamp
+	77,  // 46: musicclub.event.UpdateEventRequest.start_at:type_name -> google.protobuf.Timestamp
+	4,   // 47: musicclub.event.UpdateEventRequest.scope:type_name -> musicclub.event.RecurrenceScope
+	78,  // 48: musicclub.event.UpdateEventRequest.coordinates:type_name -> musicclub.venue.GeoPoint
+	83,  // 49: musicclub.event.UpdateEventRequest.update_mask:type_name -> google.protobuf.FieldMask
+	20,  // 50: musicclub.event.SetTracklistRequest.tracklist:type_name -> musicclub.event.Tracklist
+	5,   // 51: musicclub.event.RenderEventAnnouncementRequest.format:type_name -> musicclub.event.AnnouncementFormat
+	5,   // 52: musicclub.event.EventAnnouncement.format:type_name -> musicclub.event.AnnouncementFormat
+	6,   // 53: musicclub.event.ExportTracklistRequest.format:type_name -> musicclub.event.TracklistExportFormat
+	23,  // 54: musicclub.event.InsertTrackItemRequest.item:type_name -> musicclub.event.TrackItem
+	23,  // 55: musicclub.event.UpdateTrackItemRequest.item:type_name -> musicclub.event.TrackItem
+	83,  // 56: musicclub.event.UpdateTrackItemRequest.update_mask:type_name -> google.protobuf.FieldMask
+	41,  // 57: musicclub.event.ListEventTemplatesResponse.templates:type_name -> musicclub.event.EventTemplate
+	77,  // 58: musicclub.event.CreateEventFromTemplateRequest.start_at:type_name -> google.protobuf.Timestamp
+	77,  // 59: musicclub.event.Rehearsal.start_at:type_name -> google.protobuf.Timestamp
+	77,  // 60: musicclub.event.Rehearsal.end_at:type_name -> google.protobuf.Timestamp
+	18,  // 61: musicclub.event.Rehearsal.attendance:type_name -> musicclub.event.Rsvp
+	1,   // 62: musicclub.event.Rehearsal.my_attendance:type_name -> musicclub.event.RsvpStatus
+	77,  // 63: musicclub.event.RehearsalInput.start_at:type_name -> google.protobuf.Timestamp
+	77,  // 64: musicclub.event.RehearsalInput.end_at:type_name -> google.protobuf.Timestamp
+	48,  // 65: musicclub.event.UpdateRehearsalRequest.rehearsal:type_name -> musicclub.event.RehearsalInput
+	1,   // 66: musicclub.event.SetRehearsalAttendanceRequest.status:type_name -> musicclub.event.RsvpStatus
+	77,  // 67: musicclub.event.FeedbackSurvey.opened_at:type_name -> google.protobuf.Timestamp
+	77,  // 68: musicclub.event.FeedbackSurvey.closes_at:type_name -> google.protobuf.Timestamp
+	77,  // 69: musicclub.event.OpenFeedbackSurveyRequest.closes_at:type_name -> google.protobuf.Timestamp
+	82,  // 70: musicclub.event.FeedbackAnswer.user:type_name -> musicclub.user.User
+	77,  // 71: musicclub.event.FeedbackAnswer.submitted_at:type_name -> google.protobuf.Timestamp
+	54,  // 72: musicclub.event.FeedbackResults.survey:type_name -> musicclub.event.FeedbackSurvey
+	57,  // 73: musicclub.event.FeedbackResults.answers:type_name -> musicclub.event.FeedbackAnswer
+	82,  // 74: musicclub.event.EquipmentItem.bringer:type_name -> musicclub.user.User
+	77,  // 75: musicclub.event.EquipmentItem.checked_at:type_name -> google.protobuf.Timestamp
+	7,   // 76: musicclub.event.Ride.kind:type_name -> musicclub.event.RideKind
+	82,  // 77: musicclub.event.Ride.user:type_name -> musicclub.user.User
+	77,  // 78: musicclub.event.Ride.depart_at:type_name -> google.protobuf.Timestamp
+	82,  // 79: musicclub.event.Ride.passengers:type_name -> musicclub.user.User
+	7,   // 80: musicclub.event.PostRideRequest.kind:type_name -> musicclub.event.RideKind
+	77,  // 81: musicclub.event.PostRideRequest.depart_at:type_name -> google.protobuf.Timestamp
+	82,  // 82: musicclub.event.Expense.payer:type_name -> musicclub.user.User
+	82,  // 83: musicclub.event.Expense.split_between:type_name -> musicclub.user.User
+	77,  // 84: musicclub.event.Expense.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 85: musicclub.event.ExpenseBalance.user:type_name -> musicclub.user.User
+	82,  // 86: musicclub.event.Settlement.from:type_name -> musicclub.user.User
+	82,  // 87: musicclub.event.Settlement.to:type_name -> musicclub.user.User
+	69,  // 88: musicclub.event.ExpenseSummary.expenses:type_name -> musicclub.event.Expense
+	72,  // 89: musicclub.event.ExpenseSummary.balances:type_name -> musicclub.event.ExpenseBalance
+	73,  // 90: musicclub.event.ExpenseSummary.settlements:type_name -> musicclub.event.Settlement
+	77,  // 91: musicclub.event.CreateEventShareLinkRequest.expires_at:type_name -> google.protobuf.Timestamp
+	77,  // 92: musicclub.event.EventShareLink.expires_at:type_name -> google.protobuf.Timestamp
+	11,  // 93: musicclub.event.EventService.ListEvents:input_type -> musicclub.event.ListEventsRequest
+	8,   // 94: musicclub.event.EventService.GetEvent:input_type -> musicclub.event.EventId
+	26,  // 95: musicclub.event.EventService.CreateEvent:input_type -> musicclub.event.CreateEventRequest
+	27,  // 96: musicclub.event.EventService.DuplicateEvent:input_type -> musicclub.event.DuplicateEventRequest
+	28,  // 97: musicclub.event.EventService.UpdateEvent:input_type -> musicclub.event.UpdateEventRequest
+	8,   // 98: musicclub.event.EventService.DeleteEvent:input_type -> musicclub.event.EventId
+	8,   // 99: musicclub.event.EventService.RestoreEvent:input_type -> musicclub.event.EventId
+	8,   // 100: musicclub.event.EventService.CompleteEvent:input_type -> musicclub.event.EventId
+	10,  // 101: musicclub.event.EventService.CancelEvent:input_type -> musicclub.event.CancelEventRequest
+	9,   // 102: musicclub.event.EventService.AddEventOwner:input_type -> musicclub.event.EventOwnerRequest
+	9,   // 103: musicclub.event.EventService.RemoveEventOwner:input_type -> musicclub.event.EventOwnerRequest
+	29,  // 104: musicclub.event.EventService.SetTracklist:input_type -> musicclub.event.SetTracklistRequest
+	8,   // 105: musicclub.event.EventService.PublishTracklist:input_type -> musicclub.event.EventId
+	30,  // 106: musicclub.event.EventService.CopyTracklist:input_type -> musicclub.event.CopyTracklistRequest
+	31,  // 107: musicclub.event.EventService.SuggestTracklist:input_type -> musicclub.event.SuggestTracklistRequest
+	32,  // 108: musicclub.event.EventService.RenderEventAnnouncement:input_type -> musicclub.event.RenderEventAnnouncementRequest
+	34,  // 109: musicclub.event.EventService.ExportTracklist:input_type -> musicclub.event.ExportTracklistRequest
+	36,  // 110: musicclub.event.EventService.InsertTrackItem:input_type -> musicclub.event.InsertTrackItemRequest
+	37,  // 111: musicclub.event.EventService.MoveTrackItem:input_type -> musicclub.event.MoveTrackItemRequest
+	38,  // 112: musicclub.event.EventService.RemoveTrackItem:input_type -> musicclub.event.TrackItemRef
+	39,  // 113: musicclub.event.EventService.UpdateTrackItem:input_type -> musicclub.event.UpdateTrackItemRequest
+	24,  // 114: musicclub.event.EventService.SetTrackRehearsalStatus:input_type -> musicclub.event.SetTrackRehearsalStatusRequest
+	25,  // 115: musicclub.event.EventService.SetCurrentTrack:input_type -> musicclub.event.SetCurrentTrackRequest
+	8,   // 116: musicclub.event.EventService.WatchEvent:input_type -> musicclub.event.EventId
+	19,  // 117: musicclub.event.EventService.SetRsvp:input_type -> musicclub.event.SetRsvpRequest
+	84,  // 118: musicclub.event.EventService.GetCalendarFeed:input_type -> google.protobuf.Empty
+	75,  // 119: musicclub.event.EventService.CreateEventShareLink:input_type -> musicclub.event.CreateEventShareLinkRequest
+	43,  // 120: musicclub.event.EventService.SaveEventTemplate:input_type -> musicclub.event.SaveEventTemplateRequest
+	84,  // 121: musicclub.event.EventService.ListEventTemplates:input_type -> google.protobuf.Empty
+	42,  // 122: musicclub.event.EventService.DeleteEventTemplate:input_type -> musicclub.event.EventTemplateId
+	45,  // 123: musicclub.event.EventService.CreateEventFromTemplate:input_type -> musicclub.event.CreateEventFromTemplateRequest
+	48,  // 124: musicclub.event.EventService.CreateRehearsal:input_type -> musicclub.event.RehearsalInput
+	49,  // 125: musicclub.event.EventService.UpdateRehearsal:input_type -> musicclub.event.UpdateRehearsalRequest
+	47,  // 126: musicclub.event.EventService.DeleteRehearsal:input_type -> musicclub.event.RehearsalId
+	50,  // 127: musicclub.event.EventService.SetRehearsalAttendance:input_type -> musicclub.event.SetRehearsalAttendanceRequest
+	51,  // 128: musicclub.event.EventService.GetCheckInCode:input_type -> musicclub.event.GetCheckInCodeRequest
+	53,  // 129: musicclub.event.EventService.CheckIn:input_type -> musicclub.event.CheckInRequest
+	55,  // 130: musicclub.event.EventService.OpenFeedbackSurvey:input_type -> musicclub.event.OpenFeedbackSurveyRequest
+	56,  // 131: musicclub.event.EventService.SubmitFeedback:input_type -> musicclub.event.SubmitFeedbackRequest
+	8,   // 132: musicclub.event.EventService.GetFeedbackResults:input_type -> musicclub.event.EventId
+	61,  // 133: musicclub.event.EventService.AddEquipmentItem:input_type -> musicclub.event.AddEquipmentItemRequest
+	62,  // 134: musicclub.event.EventService.UpdateEquipmentItem:input_type -> musicclub.event.UpdateEquipmentItemRequest
+	60,  // 135: musicclub.event.EventService.RemoveEquipmentItem:input_type -> musicclub.event.EquipmentItemId
+	63,  // 136: musicclub.event.EventService.SetEquipmentBringer:input_type -> musicclub.event.SetEquipmentBringerRequest
+	64,  // 137: musicclub.event.EventService.SetEquipmentChecked:input_type -> musicclub.event.SetEquipmentCheckedRequest
+	67,  // 138: musicclub.event.EventService.PostRide:input_type -> musicclub.event.PostRideRequest
+	66,  // 139: musicclub.event.EventService.CancelRide:input_type -> musicclub.event.RideId
+	68,  // 140: musicclub.event.EventService.SetRidePassenger:input_type -> musicclub.event.SetRidePassengerRequest
+	71,  // 141: musicclub.event.EventService.AddExpense:input_type -> musicclub.event.AddExpenseRequest
+	70,  // 142: musicclub.event.EventService.RemoveExpense:input_type -> musicclub.event.ExpenseId
+	8,   // 143: musicclub.event.EventService.GetExpenseSummary:input_type -> musicclub.event.EventId
+	12,  // 144: musicclub.event.EventService.ListEvents:output_type -> musicclub.event.ListEventsResponse
+	14,  // 145: musicclub.event.EventService.GetEvent:output_type -> musicclub.event.EventDetails
+	14,  // 146: musicclub.event.EventService.CreateEvent:output_type -> musicclub.event.EventDetails
+	14,  // 147: musicclub.event.EventService.DuplicateEvent:output_type -> musicclub.event.EventDetails
+	14,  // 148: musicclub.event.EventService.UpdateEvent:output_type -> musicclub.event.EventDetails
+	84,  // 149: musicclub.event.EventService.DeleteEvent:output_type -> google.protobuf.Empty
+	14,  // 150: musicclub.event.EventService.RestoreEvent:output_type -> musicclub.event.EventDetails
+	14,  // 151: musicclub.event.EventService.CompleteEvent:output_type -> musicclub.event.EventDetails
+	14,  // 152: musicclub.event.EventService.CancelEvent:output_type -> musicclub.event.EventDetails
+	14,  // 153: musicclub.event.EventService.AddEventOwner:output_type -> musicclub.event.EventDetails
+	14,  // 154: musicclub.event.EventService.RemoveEventOwner:output_type -> musicclub.event.EventDetails
+	14,  // 155: musicclub.event.EventService.SetTracklist:output_type -> musicclub.event.EventDetails
+	84,  // 156: musicclub.event.EventService.PublishTracklist:output_type -> google.protobuf.Empty
+	14,  // 157: musicclub.event.EventService.CopyTracklist:output_type -> musicclub.event.EventDetails
+	20,  // 158: musicclub.event.EventService.SuggestTracklist:output_type -> musicclub.event.Tracklist
+	33,  // 159: musicclub.event.EventService.RenderEventAnnouncement:output_type -> musicclub.event.EventAnnouncement
+	35,  // 160: musicclub.event.EventService.ExportTracklist:output_type -> musicclub.event.ExportedTracklist
+	14,  // 161: musicclub.event.EventService.InsertTrackItem:output_type -> musicclub.event.EventDetails
+	14,  // 162: musicclub.event.EventService.MoveTrackItem:output_type -> musicclub.event.EventDetails
+	14,  // 163: musicclub.event.EventService.RemoveTrackItem:output_type -> musicclub.event.EventDetails
+	14,  // 164: musicclub.event.EventService.UpdateTrackItem:output_type -> musicclub.event.EventDetails
+	14,  // 165: musicclub.event.EventService.SetTrackRehearsalStatus:output_type -> musicclub.event.EventDetails
+	14,  // 166: musicclub.event.EventService.SetCurrentTrack:output_type -> musicclub.event.EventDetails
+	14,  // 167: musicclub.event.EventService.WatchEvent:output_type -> musicclub.event.EventDetails
+	14,  // 168: musicclub.event.EventService.SetRsvp:output_type -> musicclub.event.EventDetails
+	40,  // 169: musicclub.event.EventService.GetCalendarFeed:output_type -> musicclub.event.CalendarFeed
+	76,  // 170: musicclub.event.EventService.CreateEventShareLink:output_type -> musicclub.event.EventShareLink
+	41,  // 171: musicclub.event.EventService.SaveEventTemplate:output_type -> musicclub.event.EventTemplate
+	44,  // 172: musicclub.event.EventService.ListEventTemplates:output_type -> musicclub.event.ListEventTemplatesResponse
+	84,  // 173: musicclub.event.EventService.DeleteEventTemplate:output_type -> google.protobuf.Empty
+	14,  // 174: musicclub.event.EventService.CreateEventFromTemplate:output_type -> musicclub.event.EventDetails
+	14,  // 175: musicclub.event.EventService.CreateRehearsal:output_type -> musicclub.event.EventDetails
+	14,  // 176: musicclub.event.EventService.UpdateRehearsal:output_type -> musicclub.event.EventDetails
+	84,  // 177: musicclub.event.EventService.DeleteRehearsal:output_type -> google.protobuf.Empty
+	14,  // 178: musicclub.event.EventService.SetRehearsalAttendance:output_type -> musicclub.event.EventDetails
+	52,  // 179: musicclub.event.EventService.GetCheckInCode:output_type -> musicclub.event.CheckInCode
+	14,  // 180: musicclub.event.EventService.CheckIn:output_type -> musicclub.event.EventDetails
+	14,  // 181: musicclub.event.EventService.OpenFeedbackSurvey:output_type -> musicclub.event.EventDetails
+	14,  // 182: musicclub.event.EventService.SubmitFeedback:output_type -> musicclub.event.EventDetails
+	58,  // 183: musicclub.event.EventService.GetFeedbackResults:output_type -> musicclub.event.FeedbackResults
+	14,  // 184: musicclub.event.EventService.AddEquipmentItem:output_type -> musicclub.event.EventDetails
+	14,  // 185: musicclub.event.EventService.UpdateEquipmentItem:output_type -> musicclub.event.EventDetails
+	14,  // 186: musicclub.event.EventService.RemoveEquipmentItem:output_type -> musicclub.event.EventDetails
+	14,  // 187: musicclub.event.EventService.SetEquipmentBringer:output_type -> musicclub.event.EventDetails
+	14,  // 188: musicclub.event.EventService.SetEquipmentChecked:output_type -> musicclub.event.EventDetails
+	14,  // 189: musicclub.event.EventService.PostRide:output_type -> musicclub.event.EventDetails
+	14,  // 190: musicclub.event.EventService.CancelRide:output_type -> musicclub.event.EventDetails
+	14,  // 191: musicclub.event.EventService.SetRidePassenger:output_type -> musicclub.event.EventDetails
+	74,  // 192: musicclub.event.EventService.AddExpense:output_type -> musicclub.event.ExpenseSummary
+	74,  // 193: musicclub.event.EventService.RemoveExpense:output_type -> musicclub.event.ExpenseSummary
+	74,  // 194: musicclub.event.EventService.GetExpenseSummary:output_type -> musicclub.event.ExpenseSummary
+	144, // [144:195] is the sub-list for method output_type
+	93,  // [93:144] is the sub-list for method input_type
+	93,  // [93:93] is the sub-list for extension type_name
+	93,  // [93:93] is the sub-list for extension extendee
+	0,   // [0:93] is the sub-list for field type_name
 }
 
 func init() { file_event_proto_init() }
@@ -788,18 +6570,21 @@ func file_event_proto_init() {
 	file_song_proto_init()
 	file_user_proto_init()
 	file_permissions_proto_init()
+	file_venue_proto_init()
+	file_validation_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_event_proto_rawDesc), len(file_event_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   10,
+			NumEnums:      8,
+			NumMessages:   69,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_event_proto_goTypes,
 		DependencyIndexes: file_event_proto_depIdxs,
+		EnumInfos:         file_event_proto_enumTypes,
 		MessageInfos:      file_event_proto_msgTypes,
 	}.Build()
 	File_event_proto = out.File
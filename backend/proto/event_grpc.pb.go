@@ -20,32 +20,193 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	EventService_ListEvents_FullMethodName   = "/musicclub.event.EventService/ListEvents"
-	EventService_GetEvent_FullMethodName     = "/musicclub.event.EventService/GetEvent"
-	EventService_CreateEvent_FullMethodName  = "/musicclub.event.EventService/CreateEvent"
-	EventService_UpdateEvent_FullMethodName  = "/musicclub.event.EventService/UpdateEvent"
-	EventService_DeleteEvent_FullMethodName  = "/musicclub.event.EventService/DeleteEvent"
-	EventService_SetTracklist_FullMethodName = "/musicclub.event.EventService/SetTracklist"
+	EventService_ListEvents_FullMethodName              = "/musicclub.event.EventService/ListEvents"
+	EventService_GetEvent_FullMethodName                = "/musicclub.event.EventService/GetEvent"
+	EventService_CreateEvent_FullMethodName             = "/musicclub.event.EventService/CreateEvent"
+	EventService_DuplicateEvent_FullMethodName          = "/musicclub.event.EventService/DuplicateEvent"
+	EventService_UpdateEvent_FullMethodName             = "/musicclub.event.EventService/UpdateEvent"
+	EventService_DeleteEvent_FullMethodName             = "/musicclub.event.EventService/DeleteEvent"
+	EventService_RestoreEvent_FullMethodName            = "/musicclub.event.EventService/RestoreEvent"
+	EventService_CompleteEvent_FullMethodName           = "/musicclub.event.EventService/CompleteEvent"
+	EventService_CancelEvent_FullMethodName             = "/musicclub.event.EventService/CancelEvent"
+	EventService_AddEventOwner_FullMethodName           = "/musicclub.event.EventService/AddEventOwner"
+	EventService_RemoveEventOwner_FullMethodName        = "/musicclub.event.EventService/RemoveEventOwner"
+	EventService_SetTracklist_FullMethodName            = "/musicclub.event.EventService/SetTracklist"
+	EventService_PublishTracklist_FullMethodName        = "/musicclub.event.EventService/PublishTracklist"
+	EventService_CopyTracklist_FullMethodName           = "/musicclub.event.EventService/CopyTracklist"
+	EventService_SuggestTracklist_FullMethodName        = "/musicclub.event.EventService/SuggestTracklist"
+	EventService_RenderEventAnnouncement_FullMethodName = "/musicclub.event.EventService/RenderEventAnnouncement"
+	EventService_ExportTracklist_FullMethodName         = "/musicclub.event.EventService/ExportTracklist"
+	EventService_InsertTrackItem_FullMethodName         = "/musicclub.event.EventService/InsertTrackItem"
+	EventService_MoveTrackItem_FullMethodName           = "/musicclub.event.EventService/MoveTrackItem"
+	EventService_RemoveTrackItem_FullMethodName         = "/musicclub.event.EventService/RemoveTrackItem"
+	EventService_UpdateTrackItem_FullMethodName         = "/musicclub.event.EventService/UpdateTrackItem"
+	EventService_SetTrackRehearsalStatus_FullMethodName = "/musicclub.event.EventService/SetTrackRehearsalStatus"
+	EventService_SetCurrentTrack_FullMethodName         = "/musicclub.event.EventService/SetCurrentTrack"
+	EventService_WatchEvent_FullMethodName              = "/musicclub.event.EventService/WatchEvent"
+	EventService_SetRsvp_FullMethodName                 = "/musicclub.event.EventService/SetRsvp"
+	EventService_GetCalendarFeed_FullMethodName         = "/musicclub.event.EventService/GetCalendarFeed"
+	EventService_CreateEventShareLink_FullMethodName    = "/musicclub.event.EventService/CreateEventShareLink"
+	EventService_SaveEventTemplate_FullMethodName       = "/musicclub.event.EventService/SaveEventTemplate"
+	EventService_ListEventTemplates_FullMethodName      = "/musicclub.event.EventService/ListEventTemplates"
+	EventService_DeleteEventTemplate_FullMethodName     = "/musicclub.event.EventService/DeleteEventTemplate"
+	EventService_CreateEventFromTemplate_FullMethodName = "/musicclub.event.EventService/CreateEventFromTemplate"
+	EventService_CreateRehearsal_FullMethodName         = "/musicclub.event.EventService/CreateRehearsal"
+	EventService_UpdateRehearsal_FullMethodName         = "/musicclub.event.EventService/UpdateRehearsal"
+	EventService_DeleteRehearsal_FullMethodName         = "/musicclub.event.EventService/DeleteRehearsal"
+	EventService_SetRehearsalAttendance_FullMethodName  = "/musicclub.event.EventService/SetRehearsalAttendance"
+	EventService_GetCheckInCode_FullMethodName          = "/musicclub.event.EventService/GetCheckInCode"
+	EventService_CheckIn_FullMethodName                 = "/musicclub.event.EventService/CheckIn"
+	EventService_OpenFeedbackSurvey_FullMethodName      = "/musicclub.event.EventService/OpenFeedbackSurvey"
+	EventService_SubmitFeedback_FullMethodName          = "/musicclub.event.EventService/SubmitFeedback"
+	EventService_GetFeedbackResults_FullMethodName      = "/musicclub.event.EventService/GetFeedbackResults"
+	EventService_AddEquipmentItem_FullMethodName        = "/musicclub.event.EventService/AddEquipmentItem"
+	EventService_UpdateEquipmentItem_FullMethodName     = "/musicclub.event.EventService/UpdateEquipmentItem"
+	EventService_RemoveEquipmentItem_FullMethodName     = "/musicclub.event.EventService/RemoveEquipmentItem"
+	EventService_SetEquipmentBringer_FullMethodName     = "/musicclub.event.EventService/SetEquipmentBringer"
+	EventService_SetEquipmentChecked_FullMethodName     = "/musicclub.event.EventService/SetEquipmentChecked"
+	EventService_PostRide_FullMethodName                = "/musicclub.event.EventService/PostRide"
+	EventService_CancelRide_FullMethodName              = "/musicclub.event.EventService/CancelRide"
+	EventService_SetRidePassenger_FullMethodName        = "/musicclub.event.EventService/SetRidePassenger"
+	EventService_AddExpense_FullMethodName              = "/musicclub.event.EventService/AddExpense"
+	EventService_RemoveExpense_FullMethodName           = "/musicclub.event.EventService/RemoveExpense"
+	EventService_GetExpenseSummary_FullMethodName       = "/musicclub.event.EventService/GetExpenseSummary"
 )
 
 // EventServiceClient is the client API for EventService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
-// Provides CRUD functionality for events and tracklists.
+// Provides CRUD functionality for events and tracklists. Per-event actions
+// that require edit_events or edit_tracklists are also open to the event's
+// owners.
 type EventServiceClient interface {
-	// Returns unpaginated list of events.
+	// Returns a paginated list of events with lightweight summaries.
 	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
 	// Returns a single event with full details and tracklist.
 	GetEvent(ctx context.Context, in *EventId, opts ...grpc.CallOption) (*EventDetails, error)
-	// Create events (requires permissions).
+	// Create events (requires edit_events or create_events); the creator
+	// becomes an owner.
 	CreateEvent(ctx context.Context, in *CreateEventRequest, opts ...grpc.CallOption) (*EventDetails, error)
+	// New one-off event with the settings of an existing one (title, venue,
+	// reminders, capacity, roles, timezone, season) on a new date, optionally
+	// with its tracklist (requires edit_events or create_events).
+	DuplicateEvent(ctx context.Context, in *DuplicateEventRequest, opts ...grpc.CallOption) (*EventDetails, error)
 	// Update events (requires permissions).
 	UpdateEvent(ctx context.Context, in *UpdateEventRequest, opts ...grpc.CallOption) (*EventDetails, error)
-	// Delete events (requires permissions).
+	// Archive an event (requires permissions). Its tracklist and
+	// participation history are kept; archived events are hidden everywhere
+	// except ListEvents with archived set.
 	DeleteEvent(ctx context.Context, in *EventId, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Bring an archived event back (requires edit_events).
+	RestoreEvent(ctx context.Context, in *EventId, opts ...grpc.CallOption) (*EventDetails, error)
+	// Mark the event as played and freeze the lineup of every tracklist item
+	// (requires edit_events). Completing it again changes nothing.
+	CompleteEvent(ctx context.Context, in *EventId, opts ...grpc.CallOption) (*EventDetails, error)
+	// Mark the event as cancelled without deleting it, stop its reminders and
+	// tell participants via the bot (requires edit_events). Cancelling again
+	// changes nothing.
+	CancelEvent(ctx context.Context, in *CancelEventRequest, opts ...grpc.CallOption) (*EventDetails, error)
+	// Add or remove an organizer of the event (requires edit_events or being
+	// an owner).
+	AddEventOwner(ctx context.Context, in *EventOwnerRequest, opts ...grpc.CallOption) (*EventDetails, error)
+	RemoveEventOwner(ctx context.Context, in *EventOwnerRequest, opts ...grpc.CallOption) (*EventDetails, error)
 	// Replace the entire tracklist in one call.
 	SetTracklist(ctx context.Context, in *SetTracklistRequest, opts ...grpc.CallOption) (*EventDetails, error)
+	// Post the tracklist, split into sets, to the club chat (requires
+	// edit_tracklists).
+	PublishTracklist(ctx context.Context, in *EventId, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Append another event's tracklist (with its sets) to this event's one
+	// (requires edit_tracklists).
+	CopyTracklist(ctx context.Context, in *CopyTracklistRequest, opts ...grpc.CallOption) (*EventDetails, error)
+	// Propose a tracklist of songs with every role filled; nothing is saved,
+	// organizers tweak the result and store it with SetTracklist (requires
+	// edit_tracklists).
+	SuggestTracklist(ctx context.Context, in *SuggestTracklistRequest, opts ...grpc.CallOption) (*Tracklist, error)
+	// Ready-to-post announcement with date, venue, lineup and tracklist
+	// highlights, for the bot and for copy-pasting to other channels.
+	RenderEventAnnouncement(ctx context.Context, in *RenderEventAnnouncementRequest, opts ...grpc.CallOption) (*EventAnnouncement, error)
+	// Printable setlist with lineups and keys, for taping to the stage floor.
+	ExportTracklist(ctx context.Context, in *ExportTracklistRequest, opts ...grpc.CallOption) (*ExportedTracklist, error)
+	// Single-item tracklist edits; the rest of the list is renumbered 1..n.
+	InsertTrackItem(ctx context.Context, in *InsertTrackItemRequest, opts ...grpc.CallOption) (*EventDetails, error)
+	MoveTrackItem(ctx context.Context, in *MoveTrackItemRequest, opts ...grpc.CallOption) (*EventDetails, error)
+	RemoveTrackItem(ctx context.Context, in *TrackItemRef, opts ...grpc.CallOption) (*EventDetails, error)
+	// Changes the song or custom title/artist of an item, keeping its position.
+	UpdateTrackItem(ctx context.Context, in *UpdateTrackItemRequest, opts ...grpc.CallOption) (*EventDetails, error)
+	// Mark how ready an item is; allowed to tracklist editors and to whoever
+	// plays the item's song.
+	SetTrackRehearsalStatus(ctx context.Context, in *SetTrackRehearsalStatusRequest, opts ...grpc.CallOption) (*EventDetails, error)
+	// Move the "now playing" pointer during the show (requires edit_events or
+	// edit_tracklists).
+	SetCurrentTrack(ctx context.Context, in *SetCurrentTrackRequest, opts ...grpc.CallOption) (*EventDetails, error)
+	// Streams event details: the current state first, then again on every
+	// change of the event, its tracklist or the now playing pointer.
+	WatchEvent(ctx context.Context, in *EventId, opts ...grpc.CallOption) (grpc.ServerStreamingClient[EventDetails], error)
+	// Set the current user's RSVP; RSVP_STATUS_UNSPECIFIED clears it. Going to
+	// a full event puts the user on the waitlist instead.
+	SetRsvp(ctx context.Context, in *SetRsvpRequest, opts ...grpc.CallOption) (*EventDetails, error)
+	// Returns the current user's personal iCal feed URL with the events they
+	// take part in or plan to attend.
+	GetCalendarFeed(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CalendarFeed, error)
+	// Mint a signed link to a public read-only page of the event (title,
+	// time, venue, tracklist) for guests without Telegram (requires
+	// edit_events).
+	CreateEventShareLink(ctx context.Context, in *CreateEventShareLinkRequest, opts ...grpc.CallOption) (*EventShareLink, error)
+	// Save an existing event's shape as a reusable template (requires edit_events).
+	SaveEventTemplate(ctx context.Context, in *SaveEventTemplateRequest, opts ...grpc.CallOption) (*EventTemplate, error)
+	ListEventTemplates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListEventTemplatesResponse, error)
+	// Delete a template (requires edit_events).
+	DeleteEventTemplate(ctx context.Context, in *EventTemplateId, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Create an event from a template (requires edit_events or create_events).
+	CreateEventFromTemplate(ctx context.Context, in *CreateEventFromTemplateRequest, opts ...grpc.CallOption) (*EventDetails, error)
+	// Schedule a rehearsal for an event (requires edit_events).
+	CreateRehearsal(ctx context.Context, in *RehearsalInput, opts ...grpc.CallOption) (*EventDetails, error)
+	// Update a rehearsal (requires edit_events).
+	UpdateRehearsal(ctx context.Context, in *UpdateRehearsalRequest, opts ...grpc.CallOption) (*EventDetails, error)
+	// Delete a rehearsal (requires edit_events).
+	DeleteRehearsal(ctx context.Context, in *RehearsalId, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Set whether the current user comes to a rehearsal; unspecified clears it.
+	SetRehearsalAttendance(ctx context.Context, in *SetRehearsalAttendanceRequest, opts ...grpc.CallOption) (*EventDetails, error)
+	// Returns the event's check-in code and QR image, creating or rotating the
+	// code as requested (requires edit_events).
+	GetCheckInCode(ctx context.Context, in *GetCheckInCodeRequest, opts ...grpc.CallOption) (*CheckInCode, error)
+	// Record that the current user is at the event; called by the Mini App
+	// after scanning the QR code.
+	CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*EventDetails, error)
+	// Open (or extend) the rating survey of a completed event; participants
+	// are invited via the bot (requires edit_events).
+	OpenFeedbackSurvey(ctx context.Context, in *OpenFeedbackSurveyRequest, opts ...grpc.CallOption) (*EventDetails, error)
+	// Rate the event as the current user; answering again replaces the answer.
+	SubmitFeedback(ctx context.Context, in *SubmitFeedbackRequest, opts ...grpc.CallOption) (*EventDetails, error)
+	// Aggregated survey answers (requires edit_events).
+	GetFeedbackResults(ctx context.Context, in *EventId, opts ...grpc.CallOption) (*FeedbackResults, error)
+	// Add an item to the event's equipment checklist.
+	AddEquipmentItem(ctx context.Context, in *AddEquipmentItemRequest, opts ...grpc.CallOption) (*EventDetails, error)
+	// Change or remove an item (its author or edit_events).
+	UpdateEquipmentItem(ctx context.Context, in *UpdateEquipmentItemRequest, opts ...grpc.CallOption) (*EventDetails, error)
+	RemoveEquipmentItem(ctx context.Context, in *EquipmentItemId, opts ...grpc.CallOption) (*EventDetails, error)
+	// Say who brings an item; members may take or drop items themselves,
+	// assigning others requires edit_events.
+	SetEquipmentBringer(ctx context.Context, in *SetEquipmentBringerRequest, opts ...grpc.CallOption) (*EventDetails, error)
+	// Tick an item off as packed (its bringer or edit_events).
+	SetEquipmentChecked(ctx context.Context, in *SetEquipmentCheckedRequest, opts ...grpc.CallOption) (*EventDetails, error)
+	// Offer seats or ask for a ride to the event; posting the same kind again
+	// replaces the current user's previous post. People asking for a ride get
+	// a bot message about new offers.
+	PostRide(ctx context.Context, in *PostRideRequest, opts ...grpc.CallOption) (*EventDetails, error)
+	// Withdraw a post (its author or edit_events); passengers are dropped.
+	CancelRide(ctx context.Context, in *RideId, opts ...grpc.CallOption) (*EventDetails, error)
+	// Take or give back a seat of an offer; the driver and the passenger are
+	// told about the match via the bot.
+	SetRidePassenger(ctx context.Context, in *SetRidePassengerRequest, opts ...grpc.CallOption) (*EventDetails, error)
+	// Record a cost of the event. Members record what they paid themselves;
+	// recording for someone else requires edit_events.
+	AddExpense(ctx context.Context, in *AddExpenseRequest, opts ...grpc.CallOption) (*ExpenseSummary, error)
+	// Delete an expense (its author or edit_events).
+	RemoveExpense(ctx context.Context, in *ExpenseId, opts ...grpc.CallOption) (*ExpenseSummary, error)
+	// Expenses with everyone's balance and the transfers that settle them.
+	GetExpenseSummary(ctx context.Context, in *EventId, opts ...grpc.CallOption) (*ExpenseSummary, error)
 }
 
 type eventServiceClient struct {
@@ -86,6 +247,16 @@ func (c *eventServiceClient) CreateEvent(ctx context.Context, in *CreateEventReq
 	return out, nil
 }
 
+func (c *eventServiceClient) DuplicateEvent(ctx context.Context, in *DuplicateEventRequest, opts ...grpc.CallOption) (*EventDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventDetails)
+	err := c.cc.Invoke(ctx, EventService_DuplicateEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *eventServiceClient) UpdateEvent(ctx context.Context, in *UpdateEventRequest, opts ...grpc.CallOption) (*EventDetails, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(EventDetails)
@@ -93,200 +264,1703 @@ func (c *eventServiceClient) UpdateEvent(ctx context.Context, in *UpdateEventReq
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *eventServiceClient) DeleteEvent(ctx context.Context, in *EventId, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EventService_DeleteEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) RestoreEvent(ctx context.Context, in *EventId, opts ...grpc.CallOption) (*EventDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventDetails)
+	err := c.cc.Invoke(ctx, EventService_RestoreEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CompleteEvent(ctx context.Context, in *EventId, opts ...grpc.CallOption) (*EventDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventDetails)
+	err := c.cc.Invoke(ctx, EventService_CompleteEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CancelEvent(ctx context.Context, in *CancelEventRequest, opts ...grpc.CallOption) (*EventDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventDetails)
+	err := c.cc.Invoke(ctx, EventService_CancelEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) AddEventOwner(ctx context.Context, in *EventOwnerRequest, opts ...grpc.CallOption) (*EventDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventDetails)
+	err := c.cc.Invoke(ctx, EventService_AddEventOwner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) RemoveEventOwner(ctx context.Context, in *EventOwnerRequest, opts ...grpc.CallOption) (*EventDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventDetails)
+	err := c.cc.Invoke(ctx, EventService_RemoveEventOwner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) SetTracklist(ctx context.Context, in *SetTracklistRequest, opts ...grpc.CallOption) (*EventDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventDetails)
+	err := c.cc.Invoke(ctx, EventService_SetTracklist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) PublishTracklist(ctx context.Context, in *EventId, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EventService_PublishTracklist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CopyTracklist(ctx context.Context, in *CopyTracklistRequest, opts ...grpc.CallOption) (*EventDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventDetails)
+	err := c.cc.Invoke(ctx, EventService_CopyTracklist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) SuggestTracklist(ctx context.Context, in *SuggestTracklistRequest, opts ...grpc.CallOption) (*Tracklist, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tracklist)
+	err := c.cc.Invoke(ctx, EventService_SuggestTracklist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) RenderEventAnnouncement(ctx context.Context, in *RenderEventAnnouncementRequest, opts ...grpc.CallOption) (*EventAnnouncement, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventAnnouncement)
+	err := c.cc.Invoke(ctx, EventService_RenderEventAnnouncement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ExportTracklist(ctx context.Context, in *ExportTracklistRequest, opts ...grpc.CallOption) (*ExportedTracklist, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportedTracklist)
+	err := c.cc.Invoke(ctx, EventService_ExportTracklist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) InsertTrackItem(ctx context.Context, in *InsertTrackItemRequest, opts ...grpc.CallOption) (*EventDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventDetails)
+	err := c.cc.Invoke(ctx, EventService_InsertTrackItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) MoveTrackItem(ctx context.Context, in *MoveTrackItemRequest, opts ...grpc.CallOption) (*EventDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventDetails)
+	err := c.cc.Invoke(ctx, EventService_MoveTrackItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) RemoveTrackItem(ctx context.Context, in *TrackItemRef, opts ...grpc.CallOption) (*EventDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventDetails)
+	err := c.cc.Invoke(ctx, EventService_RemoveTrackItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) UpdateTrackItem(ctx context.Context, in *UpdateTrackItemRequest, opts ...grpc.CallOption) (*EventDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventDetails)
+	err := c.cc.Invoke(ctx, EventService_UpdateTrackItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) SetTrackRehearsalStatus(ctx context.Context, in *SetTrackRehearsalStatusRequest, opts ...grpc.CallOption) (*EventDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventDetails)
+	err := c.cc.Invoke(ctx, EventService_SetTrackRehearsalStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) SetCurrentTrack(ctx context.Context, in *SetCurrentTrackRequest, opts ...grpc.CallOption) (*EventDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventDetails)
+	err := c.cc.Invoke(ctx, EventService_SetCurrentTrack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) WatchEvent(ctx context.Context, in *EventId, opts ...grpc.CallOption) (grpc.ServerStreamingClient[EventDetails], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &EventService_ServiceDesc.Streams[0], EventService_WatchEvent_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[EventId, EventDetails]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EventService_WatchEventClient = grpc.ServerStreamingClient[EventDetails]
+
+func (c *eventServiceClient) SetRsvp(ctx context.Context, in *SetRsvpRequest, opts ...grpc.CallOption) (*EventDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventDetails)
+	err := c.cc.Invoke(ctx, EventService_SetRsvp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetCalendarFeed(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CalendarFeed, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalendarFeed)
+	err := c.cc.Invoke(ctx, EventService_GetCalendarFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CreateEventShareLink(ctx context.Context, in *CreateEventShareLinkRequest, opts ...grpc.CallOption) (*EventShareLink, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventShareLink)
+	err := c.cc.Invoke(ctx, EventService_CreateEventShareLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) SaveEventTemplate(ctx context.Context, in *SaveEventTemplateRequest, opts ...grpc.CallOption) (*EventTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventTemplate)
+	err := c.cc.Invoke(ctx, EventService_SaveEventTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ListEventTemplates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListEventTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEventTemplatesResponse)
+	err := c.cc.Invoke(ctx, EventService_ListEventTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) DeleteEventTemplate(ctx context.Context, in *EventTemplateId, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EventService_DeleteEventTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CreateEventFromTemplate(ctx context.Context, in *CreateEventFromTemplateRequest, opts ...grpc.CallOption) (*EventDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventDetails)
+	err := c.cc.Invoke(ctx, EventService_CreateEventFromTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CreateRehearsal(ctx context.Context, in *RehearsalInput, opts ...grpc.CallOption) (*EventDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventDetails)
+	err := c.cc.Invoke(ctx, EventService_CreateRehearsal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) UpdateRehearsal(ctx context.Context, in *UpdateRehearsalRequest, opts ...grpc.CallOption) (*EventDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventDetails)
+	err := c.cc.Invoke(ctx, EventService_UpdateRehearsal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) DeleteRehearsal(ctx context.Context, in *RehearsalId, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EventService_DeleteRehearsal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) SetRehearsalAttendance(ctx context.Context, in *SetRehearsalAttendanceRequest, opts ...grpc.CallOption) (*EventDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventDetails)
+	err := c.cc.Invoke(ctx, EventService_SetRehearsalAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetCheckInCode(ctx context.Context, in *GetCheckInCodeRequest, opts ...grpc.CallOption) (*CheckInCode, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckInCode)
+	err := c.cc.Invoke(ctx, EventService_GetCheckInCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*EventDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventDetails)
+	err := c.cc.Invoke(ctx, EventService_CheckIn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) OpenFeedbackSurvey(ctx context.Context, in *OpenFeedbackSurveyRequest, opts ...grpc.CallOption) (*EventDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventDetails)
+	err := c.cc.Invoke(ctx, EventService_OpenFeedbackSurvey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) SubmitFeedback(ctx context.Context, in *SubmitFeedbackRequest, opts ...grpc.CallOption) (*EventDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventDetails)
+	err := c.cc.Invoke(ctx, EventService_SubmitFeedback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetFeedbackResults(ctx context.Context, in *EventId, opts ...grpc.CallOption) (*FeedbackResults, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeedbackResults)
+	err := c.cc.Invoke(ctx, EventService_GetFeedbackResults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) AddEquipmentItem(ctx context.Context, in *AddEquipmentItemRequest, opts ...grpc.CallOption) (*EventDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventDetails)
+	err := c.cc.Invoke(ctx, EventService_AddEquipmentItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) UpdateEquipmentItem(ctx context.Context, in *UpdateEquipmentItemRequest, opts ...grpc.CallOption) (*EventDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventDetails)
+	err := c.cc.Invoke(ctx, EventService_UpdateEquipmentItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) RemoveEquipmentItem(ctx context.Context, in *EquipmentItemId, opts ...grpc.CallOption) (*EventDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventDetails)
+	err := c.cc.Invoke(ctx, EventService_RemoveEquipmentItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) SetEquipmentBringer(ctx context.Context, in *SetEquipmentBringerRequest, opts ...grpc.CallOption) (*EventDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventDetails)
+	err := c.cc.Invoke(ctx, EventService_SetEquipmentBringer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) SetEquipmentChecked(ctx context.Context, in *SetEquipmentCheckedRequest, opts ...grpc.CallOption) (*EventDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventDetails)
+	err := c.cc.Invoke(ctx, EventService_SetEquipmentChecked_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) PostRide(ctx context.Context, in *PostRideRequest, opts ...grpc.CallOption) (*EventDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventDetails)
+	err := c.cc.Invoke(ctx, EventService_PostRide_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CancelRide(ctx context.Context, in *RideId, opts ...grpc.CallOption) (*EventDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventDetails)
+	err := c.cc.Invoke(ctx, EventService_CancelRide_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) SetRidePassenger(ctx context.Context, in *SetRidePassengerRequest, opts ...grpc.CallOption) (*EventDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventDetails)
+	err := c.cc.Invoke(ctx, EventService_SetRidePassenger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) AddExpense(ctx context.Context, in *AddExpenseRequest, opts ...grpc.CallOption) (*ExpenseSummary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExpenseSummary)
+	err := c.cc.Invoke(ctx, EventService_AddExpense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) RemoveExpense(ctx context.Context, in *ExpenseId, opts ...grpc.CallOption) (*ExpenseSummary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExpenseSummary)
+	err := c.cc.Invoke(ctx, EventService_RemoveExpense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetExpenseSummary(ctx context.Context, in *EventId, opts ...grpc.CallOption) (*ExpenseSummary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExpenseSummary)
+	err := c.cc.Invoke(ctx, EventService_GetExpenseSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EventServiceServer is the server API for EventService service.
+// All implementations must embed UnimplementedEventServiceServer
+// for forward compatibility.
+//
+// Provides CRUD functionality for events and tracklists. Per-event actions
+// that require edit_events or edit_tracklists are also open to the event's
+// owners.
+type EventServiceServer interface {
+	// Returns a paginated list of events with lightweight summaries.
+	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
+	// Returns a single event with full details and tracklist.
+	GetEvent(context.Context, *EventId) (*EventDetails, error)
+	// Create events (requires edit_events or create_events); the creator
+	// becomes an owner.
+	CreateEvent(context.Context, *CreateEventRequest) (*EventDetails, error)
+	// New one-off event with the settings of an existing one (title, venue,
+	// reminders, capacity, roles, timezone, season) on a new date, optionally
+	// with its tracklist (requires edit_events or create_events).
+	DuplicateEvent(context.Context, *DuplicateEventRequest) (*EventDetails, error)
+	// Update events (requires permissions).
+	UpdateEvent(context.Context, *UpdateEventRequest) (*EventDetails, error)
+	// Archive an event (requires permissions). Its tracklist and
+	// participation history are kept; archived events are hidden everywhere
+	// except ListEvents with archived set.
+	DeleteEvent(context.Context, *EventId) (*emptypb.Empty, error)
+	// Bring an archived event back (requires edit_events).
+	RestoreEvent(context.Context, *EventId) (*EventDetails, error)
+	// Mark the event as played and freeze the lineup of every tracklist item
+	// (requires edit_events). Completing it again changes nothing.
+	CompleteEvent(context.Context, *EventId) (*EventDetails, error)
+	// Mark the event as cancelled without deleting it, stop its reminders and
+	// tell participants via the bot (requires edit_events). Cancelling again
+	// changes nothing.
+	CancelEvent(context.Context, *CancelEventRequest) (*EventDetails, error)
+	// Add or remove an organizer of the event (requires edit_events or being
+	// an owner).
+	AddEventOwner(context.Context, *EventOwnerRequest) (*EventDetails, error)
+	RemoveEventOwner(context.Context, *EventOwnerRequest) (*EventDetails, error)
+	// Replace the entire tracklist in one call.
+	SetTracklist(context.Context, *SetTracklistRequest) (*EventDetails, error)
+	// Post the tracklist, split into sets, to the club chat (requires
+	// edit_tracklists).
+	PublishTracklist(context.Context, *EventId) (*emptypb.Empty, error)
+	// Append another event's tracklist (with its sets) to this event's one
+	// (requires edit_tracklists).
+	CopyTracklist(context.Context, *CopyTracklistRequest) (*EventDetails, error)
+	// Propose a tracklist of songs with every role filled; nothing is saved,
+	// organizers tweak the result and store it with SetTracklist (requires
+	// edit_tracklists).
+	SuggestTracklist(context.Context, *SuggestTracklistRequest) (*Tracklist, error)
+	// Ready-to-post announcement with date, venue, lineup and tracklist
+	// highlights, for the bot and for copy-pasting to other channels.
+	RenderEventAnnouncement(context.Context, *RenderEventAnnouncementRequest) (*EventAnnouncement, error)
+	// Printable setlist with lineups and keys, for taping to the stage floor.
+	ExportTracklist(context.Context, *ExportTracklistRequest) (*ExportedTracklist, error)
+	// Single-item tracklist edits; the rest of the list is renumbered 1..n.
+	InsertTrackItem(context.Context, *InsertTrackItemRequest) (*EventDetails, error)
+	MoveTrackItem(context.Context, *MoveTrackItemRequest) (*EventDetails, error)
+	RemoveTrackItem(context.Context, *TrackItemRef) (*EventDetails, error)
+	// Changes the song or custom title/artist of an item, keeping its position.
+	UpdateTrackItem(context.Context, *UpdateTrackItemRequest) (*EventDetails, error)
+	// Mark how ready an item is; allowed to tracklist editors and to whoever
+	// plays the item's song.
+	SetTrackRehearsalStatus(context.Context, *SetTrackRehearsalStatusRequest) (*EventDetails, error)
+	// Move the "now playing" pointer during the show (requires edit_events or
+	// edit_tracklists).
+	SetCurrentTrack(context.Context, *SetCurrentTrackRequest) (*EventDetails, error)
+	// Streams event details: the current state first, then again on every
+	// change of the event, its tracklist or the now playing pointer.
+	WatchEvent(*EventId, grpc.ServerStreamingServer[EventDetails]) error
+	// Set the current user's RSVP; RSVP_STATUS_UNSPECIFIED clears it. Going to
+	// a full event puts the user on the waitlist instead.
+	SetRsvp(context.Context, *SetRsvpRequest) (*EventDetails, error)
+	// Returns the current user's personal iCal feed URL with the events they
+	// take part in or plan to attend.
+	GetCalendarFeed(context.Context, *emptypb.Empty) (*CalendarFeed, error)
+	// Mint a signed link to a public read-only page of the event (title,
+	// time, venue, tracklist) for guests without Telegram (requires
+	// edit_events).
+	CreateEventShareLink(context.Context, *CreateEventShareLinkRequest) (*EventShareLink, error)
+	// Save an existing event's shape as a reusable template (requires edit_events).
+	SaveEventTemplate(context.Context, *SaveEventTemplateRequest) (*EventTemplate, error)
+	ListEventTemplates(context.Context, *emptypb.Empty) (*ListEventTemplatesResponse, error)
+	// Delete a template (requires edit_events).
+	DeleteEventTemplate(context.Context, *EventTemplateId) (*emptypb.Empty, error)
+	// Create an event from a template (requires edit_events or create_events).
+	CreateEventFromTemplate(context.Context, *CreateEventFromTemplateRequest) (*EventDetails, error)
+	// Schedule a rehearsal for an event (requires edit_events).
+	CreateRehearsal(context.Context, *RehearsalInput) (*EventDetails, error)
+	// Update a rehearsal (requires edit_events).
+	UpdateRehearsal(context.Context, *UpdateRehearsalRequest) (*EventDetails, error)
+	// Delete a rehearsal (requires edit_events).
+	DeleteRehearsal(context.Context, *RehearsalId) (*emptypb.Empty, error)
+	// Set whether the current user comes to a rehearsal; unspecified clears it.
+	SetRehearsalAttendance(context.Context, *SetRehearsalAttendanceRequest) (*EventDetails, error)
+	// Returns the event's check-in code and QR image, creating or rotating the
+	// code as requested (requires edit_events).
+	GetCheckInCode(context.Context, *GetCheckInCodeRequest) (*CheckInCode, error)
+	// Record that the current user is at the event; called by the Mini App
+	// after scanning the QR code.
+	CheckIn(context.Context, *CheckInRequest) (*EventDetails, error)
+	// Open (or extend) the rating survey of a completed event; participants
+	// are invited via the bot (requires edit_events).
+	OpenFeedbackSurvey(context.Context, *OpenFeedbackSurveyRequest) (*EventDetails, error)
+	// Rate the event as the current user; answering again replaces the answer.
+	SubmitFeedback(context.Context, *SubmitFeedbackRequest) (*EventDetails, error)
+	// Aggregated survey answers (requires edit_events).
+	GetFeedbackResults(context.Context, *EventId) (*FeedbackResults, error)
+	// Add an item to the event's equipment checklist.
+	AddEquipmentItem(context.Context, *AddEquipmentItemRequest) (*EventDetails, error)
+	// Change or remove an item (its author or edit_events).
+	UpdateEquipmentItem(context.Context, *UpdateEquipmentItemRequest) (*EventDetails, error)
+	RemoveEquipmentItem(context.Context, *EquipmentItemId) (*EventDetails, error)
+	// Say who brings an item; members may take or drop items themselves,
+	// assigning others requires edit_events.
+	SetEquipmentBringer(context.Context, *SetEquipmentBringerRequest) (*EventDetails, error)
+	// Tick an item off as packed (its bringer or edit_events).
+	SetEquipmentChecked(context.Context, *SetEquipmentCheckedRequest) (*EventDetails, error)
+	// Offer seats or ask for a ride to the event; posting the same kind again
+	// replaces the current user's previous post. People asking for a ride get
+	// a bot message about new offers.
+	PostRide(context.Context, *PostRideRequest) (*EventDetails, error)
+	// Withdraw a post (its author or edit_events); passengers are dropped.
+	CancelRide(context.Context, *RideId) (*EventDetails, error)
+	// Take or give back a seat of an offer; the driver and the passenger are
+	// told about the match via the bot.
+	SetRidePassenger(context.Context, *SetRidePassengerRequest) (*EventDetails, error)
+	// Record a cost of the event. Members record what they paid themselves;
+	// recording for someone else requires edit_events.
+	AddExpense(context.Context, *AddExpenseRequest) (*ExpenseSummary, error)
+	// Delete an expense (its author or edit_events).
+	RemoveExpense(context.Context, *ExpenseId) (*ExpenseSummary, error)
+	// Expenses with everyone's balance and the transfers that settle them.
+	GetExpenseSummary(context.Context, *EventId) (*ExpenseSummary, error)
+	mustEmbedUnimplementedEventServiceServer()
+}
+
+// UnimplementedEventServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEventServiceServer struct{}
+
+func (UnimplementedEventServiceServer) ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListEvents not implemented")
+}
+func (UnimplementedEventServiceServer) GetEvent(context.Context, *EventId) (*EventDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEvent not implemented")
+}
+func (UnimplementedEventServiceServer) CreateEvent(context.Context, *CreateEventRequest) (*EventDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateEvent not implemented")
+}
+func (UnimplementedEventServiceServer) DuplicateEvent(context.Context, *DuplicateEventRequest) (*EventDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method DuplicateEvent not implemented")
+}
+func (UnimplementedEventServiceServer) UpdateEvent(context.Context, *UpdateEventRequest) (*EventDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateEvent not implemented")
+}
+func (UnimplementedEventServiceServer) DeleteEvent(context.Context, *EventId) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteEvent not implemented")
+}
+func (UnimplementedEventServiceServer) RestoreEvent(context.Context, *EventId) (*EventDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreEvent not implemented")
+}
+func (UnimplementedEventServiceServer) CompleteEvent(context.Context, *EventId) (*EventDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompleteEvent not implemented")
+}
+func (UnimplementedEventServiceServer) CancelEvent(context.Context, *CancelEventRequest) (*EventDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelEvent not implemented")
+}
+func (UnimplementedEventServiceServer) AddEventOwner(context.Context, *EventOwnerRequest) (*EventDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddEventOwner not implemented")
+}
+func (UnimplementedEventServiceServer) RemoveEventOwner(context.Context, *EventOwnerRequest) (*EventDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveEventOwner not implemented")
+}
+func (UnimplementedEventServiceServer) SetTracklist(context.Context, *SetTracklistRequest) (*EventDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetTracklist not implemented")
+}
+func (UnimplementedEventServiceServer) PublishTracklist(context.Context, *EventId) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method PublishTracklist not implemented")
+}
+func (UnimplementedEventServiceServer) CopyTracklist(context.Context, *CopyTracklistRequest) (*EventDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method CopyTracklist not implemented")
+}
+func (UnimplementedEventServiceServer) SuggestTracklist(context.Context, *SuggestTracklistRequest) (*Tracklist, error) {
+	return nil, status.Error(codes.Unimplemented, "method SuggestTracklist not implemented")
+}
+func (UnimplementedEventServiceServer) RenderEventAnnouncement(context.Context, *RenderEventAnnouncementRequest) (*EventAnnouncement, error) {
+	return nil, status.Error(codes.Unimplemented, "method RenderEventAnnouncement not implemented")
+}
+func (UnimplementedEventServiceServer) ExportTracklist(context.Context, *ExportTracklistRequest) (*ExportedTracklist, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportTracklist not implemented")
+}
+func (UnimplementedEventServiceServer) InsertTrackItem(context.Context, *InsertTrackItemRequest) (*EventDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method InsertTrackItem not implemented")
+}
+func (UnimplementedEventServiceServer) MoveTrackItem(context.Context, *MoveTrackItemRequest) (*EventDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method MoveTrackItem not implemented")
+}
+func (UnimplementedEventServiceServer) RemoveTrackItem(context.Context, *TrackItemRef) (*EventDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveTrackItem not implemented")
+}
+func (UnimplementedEventServiceServer) UpdateTrackItem(context.Context, *UpdateTrackItemRequest) (*EventDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTrackItem not implemented")
+}
+func (UnimplementedEventServiceServer) SetTrackRehearsalStatus(context.Context, *SetTrackRehearsalStatusRequest) (*EventDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetTrackRehearsalStatus not implemented")
+}
+func (UnimplementedEventServiceServer) SetCurrentTrack(context.Context, *SetCurrentTrackRequest) (*EventDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetCurrentTrack not implemented")
+}
+func (UnimplementedEventServiceServer) WatchEvent(*EventId, grpc.ServerStreamingServer[EventDetails]) error {
+	return status.Error(codes.Unimplemented, "method WatchEvent not implemented")
+}
+func (UnimplementedEventServiceServer) SetRsvp(context.Context, *SetRsvpRequest) (*EventDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetRsvp not implemented")
+}
+func (UnimplementedEventServiceServer) GetCalendarFeed(context.Context, *emptypb.Empty) (*CalendarFeed, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCalendarFeed not implemented")
+}
+func (UnimplementedEventServiceServer) CreateEventShareLink(context.Context, *CreateEventShareLinkRequest) (*EventShareLink, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateEventShareLink not implemented")
+}
+func (UnimplementedEventServiceServer) SaveEventTemplate(context.Context, *SaveEventTemplateRequest) (*EventTemplate, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveEventTemplate not implemented")
+}
+func (UnimplementedEventServiceServer) ListEventTemplates(context.Context, *emptypb.Empty) (*ListEventTemplatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListEventTemplates not implemented")
+}
+func (UnimplementedEventServiceServer) DeleteEventTemplate(context.Context, *EventTemplateId) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteEventTemplate not implemented")
+}
+func (UnimplementedEventServiceServer) CreateEventFromTemplate(context.Context, *CreateEventFromTemplateRequest) (*EventDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateEventFromTemplate not implemented")
+}
+func (UnimplementedEventServiceServer) CreateRehearsal(context.Context, *RehearsalInput) (*EventDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateRehearsal not implemented")
+}
+func (UnimplementedEventServiceServer) UpdateRehearsal(context.Context, *UpdateRehearsalRequest) (*EventDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateRehearsal not implemented")
+}
+func (UnimplementedEventServiceServer) DeleteRehearsal(context.Context, *RehearsalId) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteRehearsal not implemented")
+}
+func (UnimplementedEventServiceServer) SetRehearsalAttendance(context.Context, *SetRehearsalAttendanceRequest) (*EventDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetRehearsalAttendance not implemented")
+}
+func (UnimplementedEventServiceServer) GetCheckInCode(context.Context, *GetCheckInCodeRequest) (*CheckInCode, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCheckInCode not implemented")
+}
+func (UnimplementedEventServiceServer) CheckIn(context.Context, *CheckInRequest) (*EventDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckIn not implemented")
+}
+func (UnimplementedEventServiceServer) OpenFeedbackSurvey(context.Context, *OpenFeedbackSurveyRequest) (*EventDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method OpenFeedbackSurvey not implemented")
+}
+func (UnimplementedEventServiceServer) SubmitFeedback(context.Context, *SubmitFeedbackRequest) (*EventDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubmitFeedback not implemented")
+}
+func (UnimplementedEventServiceServer) GetFeedbackResults(context.Context, *EventId) (*FeedbackResults, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFeedbackResults not implemented")
+}
+func (UnimplementedEventServiceServer) AddEquipmentItem(context.Context, *AddEquipmentItemRequest) (*EventDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddEquipmentItem not implemented")
+}
+func (UnimplementedEventServiceServer) UpdateEquipmentItem(context.Context, *UpdateEquipmentItemRequest) (*EventDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateEquipmentItem not implemented")
+}
+func (UnimplementedEventServiceServer) RemoveEquipmentItem(context.Context, *EquipmentItemId) (*EventDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveEquipmentItem not implemented")
+}
+func (UnimplementedEventServiceServer) SetEquipmentBringer(context.Context, *SetEquipmentBringerRequest) (*EventDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetEquipmentBringer not implemented")
+}
+func (UnimplementedEventServiceServer) SetEquipmentChecked(context.Context, *SetEquipmentCheckedRequest) (*EventDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetEquipmentChecked not implemented")
+}
+func (UnimplementedEventServiceServer) PostRide(context.Context, *PostRideRequest) (*EventDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method PostRide not implemented")
+}
+func (UnimplementedEventServiceServer) CancelRide(context.Context, *RideId) (*EventDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelRide not implemented")
+}
+func (UnimplementedEventServiceServer) SetRidePassenger(context.Context, *SetRidePassengerRequest) (*EventDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetRidePassenger not implemented")
+}
+func (UnimplementedEventServiceServer) AddExpense(context.Context, *AddExpenseRequest) (*ExpenseSummary, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddExpense not implemented")
+}
+func (UnimplementedEventServiceServer) RemoveExpense(context.Context, *ExpenseId) (*ExpenseSummary, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveExpense not implemented")
+}
+func (UnimplementedEventServiceServer) GetExpenseSummary(context.Context, *EventId) (*ExpenseSummary, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetExpenseSummary not implemented")
+}
+func (UnimplementedEventServiceServer) mustEmbedUnimplementedEventServiceServer() {}
+func (UnimplementedEventServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeEventServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EventServiceServer will
+// result in compilation errors.
+type UnsafeEventServiceServer interface {
+	mustEmbedUnimplementedEventServiceServer()
+}
+
+func RegisterEventServiceServer(s grpc.ServiceRegistrar, srv EventServiceServer) {
+	// If the following call panics, it indicates UnimplementedEventServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&EventService_ServiceDesc, srv)
+}
+
+func _EventService_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ListEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ListEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ListEvents(ctx, req.(*ListEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetEvent(ctx, req.(*EventId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CreateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CreateEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CreateEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CreateEvent(ctx, req.(*CreateEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_DuplicateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DuplicateEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).DuplicateEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_DuplicateEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).DuplicateEvent(ctx, req.(*DuplicateEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_UpdateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).UpdateEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_UpdateEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).UpdateEvent(ctx, req.(*UpdateEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_DeleteEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).DeleteEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_DeleteEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).DeleteEvent(ctx, req.(*EventId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_RestoreEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).RestoreEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_RestoreEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).RestoreEvent(ctx, req.(*EventId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CompleteEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CompleteEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CompleteEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CompleteEvent(ctx, req.(*EventId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CancelEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CancelEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CancelEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CancelEvent(ctx, req.(*CancelEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_AddEventOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).AddEventOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_AddEventOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).AddEventOwner(ctx, req.(*EventOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_RemoveEventOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).RemoveEventOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_RemoveEventOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).RemoveEventOwner(ctx, req.(*EventOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_SetTracklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTracklistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).SetTracklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_SetTracklist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).SetTracklist(ctx, req.(*SetTracklistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_PublishTracklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).PublishTracklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_PublishTracklist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).PublishTracklist(ctx, req.(*EventId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CopyTracklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyTracklistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CopyTracklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CopyTracklist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CopyTracklist(ctx, req.(*CopyTracklistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_SuggestTracklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestTracklistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).SuggestTracklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_SuggestTracklist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).SuggestTracklist(ctx, req.(*SuggestTracklistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_RenderEventAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderEventAnnouncementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).RenderEventAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_RenderEventAnnouncement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).RenderEventAnnouncement(ctx, req.(*RenderEventAnnouncementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ExportTracklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportTracklistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ExportTracklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ExportTracklist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ExportTracklist(ctx, req.(*ExportTracklistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_InsertTrackItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertTrackItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).InsertTrackItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_InsertTrackItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).InsertTrackItem(ctx, req.(*InsertTrackItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_MoveTrackItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveTrackItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).MoveTrackItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_MoveTrackItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).MoveTrackItem(ctx, req.(*MoveTrackItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_RemoveTrackItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrackItemRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).RemoveTrackItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_RemoveTrackItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).RemoveTrackItem(ctx, req.(*TrackItemRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_UpdateTrackItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTrackItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).UpdateTrackItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_UpdateTrackItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).UpdateTrackItem(ctx, req.(*UpdateTrackItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_SetTrackRehearsalStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTrackRehearsalStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).SetTrackRehearsalStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_SetTrackRehearsalStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).SetTrackRehearsalStatus(ctx, req.(*SetTrackRehearsalStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_SetCurrentTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCurrentTrackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).SetCurrentTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_SetCurrentTrack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).SetCurrentTrack(ctx, req.(*SetCurrentTrackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_WatchEvent_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EventId)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EventServiceServer).WatchEvent(m, &grpc.GenericServerStream[EventId, EventDetails]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EventService_WatchEventServer = grpc.ServerStreamingServer[EventDetails]
+
+func _EventService_SetRsvp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRsvpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).SetRsvp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_SetRsvp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).SetRsvp(ctx, req.(*SetRsvpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetCalendarFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetCalendarFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetCalendarFeed(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CreateEventShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEventShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CreateEventShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CreateEventShareLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CreateEventShareLink(ctx, req.(*CreateEventShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_SaveEventTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveEventTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).SaveEventTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_SaveEventTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).SaveEventTemplate(ctx, req.(*SaveEventTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ListEventTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ListEventTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ListEventTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ListEventTemplates(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_DeleteEventTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventTemplateId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).DeleteEventTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_DeleteEventTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).DeleteEventTemplate(ctx, req.(*EventTemplateId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CreateEventFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEventFromTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CreateEventFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CreateEventFromTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CreateEventFromTemplate(ctx, req.(*CreateEventFromTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CreateRehearsal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RehearsalInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CreateRehearsal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CreateRehearsal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CreateRehearsal(ctx, req.(*RehearsalInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_UpdateRehearsal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRehearsalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).UpdateRehearsal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_UpdateRehearsal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).UpdateRehearsal(ctx, req.(*UpdateRehearsalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_DeleteRehearsal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RehearsalId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).DeleteRehearsal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_DeleteRehearsal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).DeleteRehearsal(ctx, req.(*RehearsalId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_SetRehearsalAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRehearsalAttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).SetRehearsalAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_SetRehearsalAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).SetRehearsalAttendance(ctx, req.(*SetRehearsalAttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetCheckInCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCheckInCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetCheckInCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetCheckInCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetCheckInCode(ctx, req.(*GetCheckInCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CheckIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CheckIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CheckIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CheckIn(ctx, req.(*CheckInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_OpenFeedbackSurvey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenFeedbackSurveyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).OpenFeedbackSurvey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_OpenFeedbackSurvey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).OpenFeedbackSurvey(ctx, req.(*OpenFeedbackSurveyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_SubmitFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitFeedbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).SubmitFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_SubmitFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).SubmitFeedback(ctx, req.(*SubmitFeedbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *eventServiceClient) DeleteEvent(ctx context.Context, in *EventId, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, EventService_DeleteEvent_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _EventService_GetFeedbackResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventId)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetFeedbackResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetFeedbackResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetFeedbackResults(ctx, req.(*EventId))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *eventServiceClient) SetTracklist(ctx context.Context, in *SetTracklistRequest, opts ...grpc.CallOption) (*EventDetails, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(EventDetails)
-	err := c.cc.Invoke(ctx, EventService_SetTracklist_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _EventService_AddEquipmentItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddEquipmentItemRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(EventServiceServer).AddEquipmentItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_AddEquipmentItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).AddEquipmentItem(ctx, req.(*AddEquipmentItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// EventServiceServer is the server API for EventService service.
-// All implementations must embed UnimplementedEventServiceServer
-// for forward compatibility.
-//
-// Provides CRUD functionality for events and tracklists.
-type EventServiceServer interface {
-	// Returns unpaginated list of events.
-	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
-	// Returns a single event with full details and tracklist.
-	GetEvent(context.Context, *EventId) (*EventDetails, error)
-	// Create events (requires permissions).
-	CreateEvent(context.Context, *CreateEventRequest) (*EventDetails, error)
-	// Update events (requires permissions).
-	UpdateEvent(context.Context, *UpdateEventRequest) (*EventDetails, error)
-	// Delete events (requires permissions).
-	DeleteEvent(context.Context, *EventId) (*emptypb.Empty, error)
-	// Replace the entire tracklist in one call.
-	SetTracklist(context.Context, *SetTracklistRequest) (*EventDetails, error)
-	mustEmbedUnimplementedEventServiceServer()
+func _EventService_UpdateEquipmentItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEquipmentItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).UpdateEquipmentItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_UpdateEquipmentItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).UpdateEquipmentItem(ctx, req.(*UpdateEquipmentItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedEventServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedEventServiceServer struct{}
-
-func (UnimplementedEventServiceServer) ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListEvents not implemented")
-}
-func (UnimplementedEventServiceServer) GetEvent(context.Context, *EventId) (*EventDetails, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetEvent not implemented")
-}
-func (UnimplementedEventServiceServer) CreateEvent(context.Context, *CreateEventRequest) (*EventDetails, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateEvent not implemented")
-}
-func (UnimplementedEventServiceServer) UpdateEvent(context.Context, *UpdateEventRequest) (*EventDetails, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateEvent not implemented")
-}
-func (UnimplementedEventServiceServer) DeleteEvent(context.Context, *EventId) (*emptypb.Empty, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteEvent not implemented")
-}
-func (UnimplementedEventServiceServer) SetTracklist(context.Context, *SetTracklistRequest) (*EventDetails, error) {
-	return nil, status.Error(codes.Unimplemented, "method SetTracklist not implemented")
+func _EventService_RemoveEquipmentItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EquipmentItemId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).RemoveEquipmentItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_RemoveEquipmentItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).RemoveEquipmentItem(ctx, req.(*EquipmentItemId))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedEventServiceServer) mustEmbedUnimplementedEventServiceServer() {}
-func (UnimplementedEventServiceServer) testEmbeddedByValue()                      {}
 
-// UnsafeEventServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to EventServiceServer will
-// result in compilation errors.
-type UnsafeEventServiceServer interface {
-	mustEmbedUnimplementedEventServiceServer()
+func _EventService_SetEquipmentBringer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEquipmentBringerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).SetEquipmentBringer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_SetEquipmentBringer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).SetEquipmentBringer(ctx, req.(*SetEquipmentBringerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterEventServiceServer(s grpc.ServiceRegistrar, srv EventServiceServer) {
-	// If the following call panics, it indicates UnimplementedEventServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _EventService_SetEquipmentChecked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEquipmentCheckedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&EventService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(EventServiceServer).SetEquipmentChecked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_SetEquipmentChecked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).SetEquipmentChecked(ctx, req.(*SetEquipmentCheckedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _EventService_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListEventsRequest)
+func _EventService_PostRide_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostRideRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EventServiceServer).ListEvents(ctx, in)
+		return srv.(EventServiceServer).PostRide(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EventService_ListEvents_FullMethodName,
+		FullMethod: EventService_PostRide_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventServiceServer).ListEvents(ctx, req.(*ListEventsRequest))
+		return srv.(EventServiceServer).PostRide(ctx, req.(*PostRideRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EventService_GetEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EventId)
+func _EventService_CancelRide_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RideId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EventServiceServer).GetEvent(ctx, in)
+		return srv.(EventServiceServer).CancelRide(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EventService_GetEvent_FullMethodName,
+		FullMethod: EventService_CancelRide_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventServiceServer).GetEvent(ctx, req.(*EventId))
+		return srv.(EventServiceServer).CancelRide(ctx, req.(*RideId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EventService_CreateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateEventRequest)
+func _EventService_SetRidePassenger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRidePassengerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EventServiceServer).CreateEvent(ctx, in)
+		return srv.(EventServiceServer).SetRidePassenger(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EventService_CreateEvent_FullMethodName,
+		FullMethod: EventService_SetRidePassenger_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventServiceServer).CreateEvent(ctx, req.(*CreateEventRequest))
+		return srv.(EventServiceServer).SetRidePassenger(ctx, req.(*SetRidePassengerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EventService_UpdateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateEventRequest)
+func _EventService_AddExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddExpenseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EventServiceServer).UpdateEvent(ctx, in)
+		return srv.(EventServiceServer).AddExpense(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EventService_UpdateEvent_FullMethodName,
+		FullMethod: EventService_AddExpense_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventServiceServer).UpdateEvent(ctx, req.(*UpdateEventRequest))
+		return srv.(EventServiceServer).AddExpense(ctx, req.(*AddExpenseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EventService_DeleteEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EventId)
+func _EventService_RemoveExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpenseId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EventServiceServer).DeleteEvent(ctx, in)
+		return srv.(EventServiceServer).RemoveExpense(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EventService_DeleteEvent_FullMethodName,
+		FullMethod: EventService_RemoveExpense_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventServiceServer).DeleteEvent(ctx, req.(*EventId))
+		return srv.(EventServiceServer).RemoveExpense(ctx, req.(*ExpenseId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EventService_SetTracklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetTracklistRequest)
+func _EventService_GetExpenseSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EventServiceServer).SetTracklist(ctx, in)
+		return srv.(EventServiceServer).GetExpenseSummary(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EventService_SetTracklist_FullMethodName,
+		FullMethod: EventService_GetExpenseSummary_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventServiceServer).SetTracklist(ctx, req.(*SetTracklistRequest))
+		return srv.(EventServiceServer).GetExpenseSummary(ctx, req.(*EventId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -310,6 +1984,10 @@ var EventService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateEvent",
 			Handler:    _EventService_CreateEvent_Handler,
 		},
+		{
+			MethodName: "DuplicateEvent",
+			Handler:    _EventService_DuplicateEvent_Handler,
+		},
 		{
 			MethodName: "UpdateEvent",
 			Handler:    _EventService_UpdateEvent_Handler,
@@ -318,11 +1996,189 @@ var EventService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteEvent",
 			Handler:    _EventService_DeleteEvent_Handler,
 		},
+		{
+			MethodName: "RestoreEvent",
+			Handler:    _EventService_RestoreEvent_Handler,
+		},
+		{
+			MethodName: "CompleteEvent",
+			Handler:    _EventService_CompleteEvent_Handler,
+		},
+		{
+			MethodName: "CancelEvent",
+			Handler:    _EventService_CancelEvent_Handler,
+		},
+		{
+			MethodName: "AddEventOwner",
+			Handler:    _EventService_AddEventOwner_Handler,
+		},
+		{
+			MethodName: "RemoveEventOwner",
+			Handler:    _EventService_RemoveEventOwner_Handler,
+		},
 		{
 			MethodName: "SetTracklist",
 			Handler:    _EventService_SetTracklist_Handler,
 		},
+		{
+			MethodName: "PublishTracklist",
+			Handler:    _EventService_PublishTracklist_Handler,
+		},
+		{
+			MethodName: "CopyTracklist",
+			Handler:    _EventService_CopyTracklist_Handler,
+		},
+		{
+			MethodName: "SuggestTracklist",
+			Handler:    _EventService_SuggestTracklist_Handler,
+		},
+		{
+			MethodName: "RenderEventAnnouncement",
+			Handler:    _EventService_RenderEventAnnouncement_Handler,
+		},
+		{
+			MethodName: "ExportTracklist",
+			Handler:    _EventService_ExportTracklist_Handler,
+		},
+		{
+			MethodName: "InsertTrackItem",
+			Handler:    _EventService_InsertTrackItem_Handler,
+		},
+		{
+			MethodName: "MoveTrackItem",
+			Handler:    _EventService_MoveTrackItem_Handler,
+		},
+		{
+			MethodName: "RemoveTrackItem",
+			Handler:    _EventService_RemoveTrackItem_Handler,
+		},
+		{
+			MethodName: "UpdateTrackItem",
+			Handler:    _EventService_UpdateTrackItem_Handler,
+		},
+		{
+			MethodName: "SetTrackRehearsalStatus",
+			Handler:    _EventService_SetTrackRehearsalStatus_Handler,
+		},
+		{
+			MethodName: "SetCurrentTrack",
+			Handler:    _EventService_SetCurrentTrack_Handler,
+		},
+		{
+			MethodName: "SetRsvp",
+			Handler:    _EventService_SetRsvp_Handler,
+		},
+		{
+			MethodName: "GetCalendarFeed",
+			Handler:    _EventService_GetCalendarFeed_Handler,
+		},
+		{
+			MethodName: "CreateEventShareLink",
+			Handler:    _EventService_CreateEventShareLink_Handler,
+		},
+		{
+			MethodName: "SaveEventTemplate",
+			Handler:    _EventService_SaveEventTemplate_Handler,
+		},
+		{
+			MethodName: "ListEventTemplates",
+			Handler:    _EventService_ListEventTemplates_Handler,
+		},
+		{
+			MethodName: "DeleteEventTemplate",
+			Handler:    _EventService_DeleteEventTemplate_Handler,
+		},
+		{
+			MethodName: "CreateEventFromTemplate",
+			Handler:    _EventService_CreateEventFromTemplate_Handler,
+		},
+		{
+			MethodName: "CreateRehearsal",
+			Handler:    _EventService_CreateRehearsal_Handler,
+		},
+		{
+			MethodName: "UpdateRehearsal",
+			Handler:    _EventService_UpdateRehearsal_Handler,
+		},
+		{
+			MethodName: "DeleteRehearsal",
+			Handler:    _EventService_DeleteRehearsal_Handler,
+		},
+		{
+			MethodName: "SetRehearsalAttendance",
+			Handler:    _EventService_SetRehearsalAttendance_Handler,
+		},
+		{
+			MethodName: "GetCheckInCode",
+			Handler:    _EventService_GetCheckInCode_Handler,
+		},
+		{
+			MethodName: "CheckIn",
+			Handler:    _EventService_CheckIn_Handler,
+		},
+		{
+			MethodName: "OpenFeedbackSurvey",
+			Handler:    _EventService_OpenFeedbackSurvey_Handler,
+		},
+		{
+			MethodName: "SubmitFeedback",
+			Handler:    _EventService_SubmitFeedback_Handler,
+		},
+		{
+			MethodName: "GetFeedbackResults",
+			Handler:    _EventService_GetFeedbackResults_Handler,
+		},
+		{
+			MethodName: "AddEquipmentItem",
+			Handler:    _EventService_AddEquipmentItem_Handler,
+		},
+		{
+			MethodName: "UpdateEquipmentItem",
+			Handler:    _EventService_UpdateEquipmentItem_Handler,
+		},
+		{
+			MethodName: "RemoveEquipmentItem",
+			Handler:    _EventService_RemoveEquipmentItem_Handler,
+		},
+		{
+			MethodName: "SetEquipmentBringer",
+			Handler:    _EventService_SetEquipmentBringer_Handler,
+		},
+		{
+			MethodName: "SetEquipmentChecked",
+			Handler:    _EventService_SetEquipmentChecked_Handler,
+		},
+		{
+			MethodName: "PostRide",
+			Handler:    _EventService_PostRide_Handler,
+		},
+		{
+			MethodName: "CancelRide",
+			Handler:    _EventService_CancelRide_Handler,
+		},
+		{
+			MethodName: "SetRidePassenger",
+			Handler:    _EventService_SetRidePassenger_Handler,
+		},
+		{
+			MethodName: "AddExpense",
+			Handler:    _EventService_AddExpense_Handler,
+		},
+		{
+			MethodName: "RemoveExpense",
+			Handler:    _EventService_RemoveExpense_Handler,
+		},
+		{
+			MethodName: "GetExpenseSummary",
+			Handler:    _EventService_GetExpenseSummary_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchEvent",
+			Handler:       _EventService_WatchEvent_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "event.proto",
 }
@@ -0,0 +1,524 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: group.proto
+
+package proto
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Group struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// When set, the bot mentions the group's members in the club chat when a
+	// song they play in is edited.
+	MentionOnSongChanges bool  `protobuf:"varint,4,opt,name=mention_on_song_changes,json=mentionOnSongChanges,proto3" json:"mention_on_song_changes,omitempty"`
+	MemberCount          int32 `protobuf:"varint,5,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Group) Reset() {
+	*x = Group{}
+	mi := &file_group_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Group) ProtoMessage() {}
+
+func (x *Group) ProtoReflect() protoreflect.Message {
+	mi := &file_group_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Group.ProtoReflect.Descriptor instead.
+func (*Group) Descriptor() ([]byte, []int) {
+	return file_group_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Group) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Group) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Group) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Group) GetMentionOnSongChanges() bool {
+	if x != nil {
+		return x.MentionOnSongChanges
+	}
+	return false
+}
+
+func (x *Group) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+type GroupDetails struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *Group                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Members       []*User                `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupDetails) Reset() {
+	*x = GroupDetails{}
+	mi := &file_group_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupDetails) ProtoMessage() {}
+
+func (x *GroupDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_group_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupDetails.ProtoReflect.Descriptor instead.
+func (*GroupDetails) Descriptor() ([]byte, []int) {
+	return file_group_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GroupDetails) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *GroupDetails) GetMembers() []*User {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type GroupId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupId) Reset() {
+	*x = GroupId{}
+	mi := &file_group_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupId) ProtoMessage() {}
+
+func (x *GroupId) ProtoReflect() protoreflect.Message {
+	mi := &file_group_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupId.ProtoReflect.Descriptor instead.
+func (*GroupId) Descriptor() ([]byte, []int) {
+	return file_group_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GroupId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListGroupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*Group               `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupsResponse) Reset() {
+	*x = ListGroupsResponse{}
+	mi := &file_group_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupsResponse) ProtoMessage() {}
+
+func (x *ListGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupsResponse.ProtoReflect.Descriptor instead.
+func (*ListGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_group_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListGroupsResponse) GetGroups() []*Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type GroupInput struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique, ignoring case.
+	Name                 string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	MentionOnSongChanges bool   `protobuf:"varint,3,opt,name=mention_on_song_changes,json=mentionOnSongChanges,proto3" json:"mention_on_song_changes,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GroupInput) Reset() {
+	*x = GroupInput{}
+	mi := &file_group_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupInput) ProtoMessage() {}
+
+func (x *GroupInput) ProtoReflect() protoreflect.Message {
+	mi := &file_group_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupInput.ProtoReflect.Descriptor instead.
+func (*GroupInput) Descriptor() ([]byte, []int) {
+	return file_group_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GroupInput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GroupInput) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GroupInput) GetMentionOnSongChanges() bool {
+	if x != nil {
+		return x.MentionOnSongChanges
+	}
+	return false
+}
+
+type UpdateGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Group         *GroupInput            `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGroupRequest) Reset() {
+	*x = UpdateGroupRequest{}
+	mi := &file_group_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupRequest) ProtoMessage() {}
+
+func (x *UpdateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_group_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateGroupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateGroupRequest) GetGroup() *GroupInput {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type GroupMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserIds       []string               `protobuf:"bytes,2,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupMembersRequest) Reset() {
+	*x = GroupMembersRequest{}
+	mi := &file_group_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMembersRequest) ProtoMessage() {}
+
+func (x *GroupMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMembersRequest.ProtoReflect.Descriptor instead.
+func (*GroupMembersRequest) Descriptor() ([]byte, []int) {
+	return file_group_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GroupMembersRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GroupMembersRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+var File_group_proto protoreflect.FileDescriptor
+
+const file_group_proto_rawDesc = "" +
+	"\n" +
+	"\vgroup.proto\x12\x0fmusicclub.group\x1a\x1bbuf/validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\n" +
+	"user.proto\x1a\x10validation.proto\"\xa7\x01\n" +
+	"\x05Group\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x125\n" +
+	"\x17mention_on_song_changes\x18\x04 \x01(\bR\x14mentionOnSongChanges\x12!\n" +
+	"\fmember_count\x18\x05 \x01(\x05R\vmemberCount\"l\n" +
+	"\fGroupDetails\x12,\n" +
+	"\x05group\x18\x01 \x01(\v2\x16.musicclub.group.GroupR\x05group\x12.\n" +
+	"\amembers\x18\x02 \x03(\v2\x14.musicclub.user.UserR\amembers\"#\n" +
+	"\aGroupId\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"D\n" +
+	"\x12ListGroupsResponse\x12.\n" +
+	"\x06groups\x18\x01 \x03(\v2\x16.musicclub.group.GroupR\x06groups\"\x83\x01\n" +
+	"\n" +
+	"GroupInput\x12\x1c\n" +
+	"\x04name\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xc0>\x01R\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x125\n" +
+	"\x17mention_on_song_changes\x18\x03 \x01(\bR\x14mentionOnSongChanges\"i\n" +
+	"\x12UpdateGroupRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x129\n" +
+	"\x05group\x18\x02 \x01(\v2\x1b.musicclub.group.GroupInputB\x06\xbaH\x03\xc8\x01\x01R\x05group\"d\n" +
+	"\x13GroupMembersRequest\x12#\n" +
+	"\bgroup_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\agroupId\x12(\n" +
+	"\buser_ids\x18\x02 \x03(\tB\r\xbaH\n" +
+	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\auserIds2\xb0\x04\n" +
+	"\fGroupService\x12I\n" +
+	"\n" +
+	"ListGroups\x12\x16.google.protobuf.Empty\x1a#.musicclub.group.ListGroupsResponse\x12C\n" +
+	"\bGetGroup\x12\x18.musicclub.group.GroupId\x1a\x1d.musicclub.group.GroupDetails\x12I\n" +
+	"\vCreateGroup\x12\x1b.musicclub.group.GroupInput\x1a\x1d.musicclub.group.GroupDetails\x12Q\n" +
+	"\vUpdateGroup\x12#.musicclub.group.UpdateGroupRequest\x1a\x1d.musicclub.group.GroupDetails\x12?\n" +
+	"\vDeleteGroup\x12\x18.musicclub.group.GroupId\x1a\x16.google.protobuf.Empty\x12V\n" +
+	"\x0fAddGroupMembers\x12$.musicclub.group.GroupMembersRequest\x1a\x1d.musicclub.group.GroupDetails\x12Y\n" +
+	"\x12RemoveGroupMembers\x12$.musicclub.group.GroupMembersRequest\x1a\x1d.musicclub.group.GroupDetailsB\x1cZ\x1amusicclubbot/backend/protob\x06proto3"
+
+var (
+	file_group_proto_rawDescOnce sync.Once
+	file_group_proto_rawDescData []byte
+)
+
+func file_group_proto_rawDescGZIP() []byte {
+	file_group_proto_rawDescOnce.Do(func() {
+		file_group_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_group_proto_rawDesc), len(file_group_proto_rawDesc)))
+	})
+	return file_group_proto_rawDescData
+}
+
+var file_group_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_group_proto_goTypes = []any{
+	(*Group)(nil),               // 0: musicclub.group.Group
+	(*GroupDetails)(nil),        // 1: musicclub.group.GroupDetails
+	(*GroupId)(nil),             // 2: musicclub.group.GroupId
+	(*ListGroupsResponse)(nil),  // 3: musicclub.group.ListGroupsResponse
+	(*GroupInput)(nil),          // 4: musicclub.group.GroupInput
+	(*UpdateGroupRequest)(nil),  // 5: musicclub.group.UpdateGroupRequest
+	(*GroupMembersRequest)(nil), // 6: musicclub.group.GroupMembersRequest
+	(*User)(nil),                // 7: musicclub.user.User
+	(*emptypb.Empty)(nil),       // 8: google.protobuf.Empty
+}
+var file_group_proto_depIdxs = []int32{
+	0,  // 0: musicclub.group.GroupDetails.group:type_name -> musicclub.group.Group
+	7,  // 1: musicclub.group.GroupDetails.members:type_name -> musicclub.user.User
+	0,  // 2: musicclub.group.ListGroupsResponse.groups:type_name -> musicclub.group.Group
+	4,  // 3: musicclub.group.UpdateGroupRequest.group:type_name -> musicclub.group.GroupInput
+	8,  // 4: musicclub.group.GroupService.ListGroups:input_type -> google.protobuf.Empty
+	2,  // 5: musicclub.group.GroupService.GetGroup:input_type -> musicclub.group.GroupId
+	4,  // 6: musicclub.group.GroupService.CreateGroup:input_type -> musicclub.group.GroupInput
+	5,  // 7: musicclub.group.GroupService.UpdateGroup:input_type -> musicclub.group.UpdateGroupRequest
+	2,  // 8: musicclub.group.GroupService.DeleteGroup:input_type -> musicclub.group.GroupId
+	6,  // 9: musicclub.group.GroupService.AddGroupMembers:input_type -> musicclub.group.GroupMembersRequest
+	6,  // 10: musicclub.group.GroupService.RemoveGroupMembers:input_type -> musicclub.group.GroupMembersRequest
+	3,  // 11: musicclub.group.GroupService.ListGroups:output_type -> musicclub.group.ListGroupsResponse
+	1,  // 12: musicclub.group.GroupService.GetGroup:output_type -> musicclub.group.GroupDetails
+	1,  // 13: musicclub.group.GroupService.CreateGroup:output_type -> musicclub.group.GroupDetails
+	1,  // 14: musicclub.group.GroupService.UpdateGroup:output_type -> musicclub.group.GroupDetails
+	8,  // 15: musicclub.group.GroupService.DeleteGroup:output_type -> google.protobuf.Empty
+	1,  // 16: musicclub.group.GroupService.AddGroupMembers:output_type -> musicclub.group.GroupDetails
+	1,  // 17: musicclub.group.GroupService.RemoveGroupMembers:output_type -> musicclub.group.GroupDetails
+	11, // [11:18] is the sub-list for method output_type
+	4,  // [4:11] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_group_proto_init() }
+func file_group_proto_init() {
+	if File_group_proto != nil {
+		return
+	}
+	file_user_proto_init()
+	file_validation_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_group_proto_rawDesc), len(file_group_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_group_proto_goTypes,
+		DependencyIndexes: file_group_proto_depIdxs,
+		MessageInfos:      file_group_proto_msgTypes,
+	}.Build()
+	File_group_proto = out.File
+	file_group_proto_goTypes = nil
+	file_group_proto_depIdxs = nil
+}
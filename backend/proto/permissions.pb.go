@@ -9,6 +9,7 @@ package proto
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,12 +22,16 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// Aggregated permissions for a user session.
+// Aggregated permissions for a user session: the union of the user's roles
+// and any permissions granted to them individually.
 type PermissionSet struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Join          *JoinPermissions       `protobuf:"bytes,1,opt,name=join,proto3" json:"join,omitempty"`
-	Songs         *SongPermissions       `protobuf:"bytes,2,opt,name=songs,proto3" json:"songs,omitempty"`
-	Events        *EventPermissions      `protobuf:"bytes,3,opt,name=events,proto3" json:"events,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Join   *JoinPermissions       `protobuf:"bytes,1,opt,name=join,proto3" json:"join,omitempty"`
+	Songs  *SongPermissions       `protobuf:"bytes,2,opt,name=songs,proto3" json:"songs,omitempty"`
+	Events *EventPermissions      `protobuf:"bytes,3,opt,name=events,proto3" json:"events,omitempty"`
+	// Names of the user's roles, e.g. "admin", "moderator", "member".
+	Roles         []string          `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles,omitempty"`
+	Admin         *AdminPermissions `protobuf:"bytes,5,opt,name=admin,proto3" json:"admin,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -82,6 +87,20 @@ func (x *PermissionSet) GetEvents() *EventPermissions {
 	return nil
 }
 
+func (x *PermissionSet) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *PermissionSet) GetAdmin() *AdminPermissions {
+	if x != nil {
+		return x.Admin
+	}
+	return nil
+}
+
 // Rights around participation in roles.
 type JoinPermissions struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
@@ -193,8 +212,11 @@ type EventPermissions struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	EditEvents     bool                   `protobuf:"varint,1,opt,name=edit_events,json=editEvents,proto3" json:"edit_events,omitempty"`
 	EditTracklists bool                   `protobuf:"varint,2,opt,name=edit_tracklists,json=editTracklists,proto3" json:"edit_tracklists,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Create events; the creator owns them and may manage them like an
+	// event editor.
+	CreateEvents  bool `protobuf:"varint,3,opt,name=create_events,json=createEvents,proto3" json:"create_events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *EventPermissions) Reset() {
@@ -241,25 +263,688 @@ func (x *EventPermissions) GetEditTracklists() bool {
 	return false
 }
 
+func (x *EventPermissions) GetCreateEvents() bool {
+	if x != nil {
+		return x.CreateEvents
+	}
+	return false
+}
+
+// Rights around administering the club.
+type AdminPermissions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Grant and revoke roles and permissions of any user.
+	ManagePermissions bool `protobuf:"varint,1,opt,name=manage_permissions,json=managePermissions,proto3" json:"manage_permissions,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AdminPermissions) Reset() {
+	*x = AdminPermissions{}
+	mi := &file_permissions_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminPermissions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminPermissions) ProtoMessage() {}
+
+func (x *AdminPermissions) ProtoReflect() protoreflect.Message {
+	mi := &file_permissions_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminPermissions.ProtoReflect.Descriptor instead.
+func (*AdminPermissions) Descriptor() ([]byte, []int) {
+	return file_permissions_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AdminPermissions) GetManagePermissions() bool {
+	if x != nil {
+		return x.ManagePermissions
+	}
+	return false
+}
+
+type Role struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Permissions   *PermissionSet         `protobuf:"bytes,3,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Role) Reset() {
+	*x = Role{}
+	mi := &file_permissions_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Role) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Role) ProtoMessage() {}
+
+func (x *Role) ProtoReflect() protoreflect.Message {
+	mi := &file_permissions_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Role.ProtoReflect.Descriptor instead.
+func (*Role) Descriptor() ([]byte, []int) {
+	return file_permissions_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Role) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Role) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Role) GetPermissions() *PermissionSet {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type UserPermissionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserPermissionsRequest) Reset() {
+	*x = UserPermissionsRequest{}
+	mi := &file_permissions_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserPermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPermissionsRequest) ProtoMessage() {}
+
+func (x *UserPermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permissions_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPermissionsRequest.ProtoReflect.Descriptor instead.
+func (*UserPermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_permissions_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UserPermissionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UserPermissions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	User  *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Roles []string               `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+	// Permissions granted one by one, on top of the roles.
+	Granted *PermissionSet `protobuf:"bytes,3,opt,name=granted,proto3" json:"granted,omitempty"`
+	// What the user can actually do: roles and individual grants combined.
+	Effective     *PermissionSet `protobuf:"bytes,4,opt,name=effective,proto3" json:"effective,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserPermissions) Reset() {
+	*x = UserPermissions{}
+	mi := &file_permissions_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserPermissions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPermissions) ProtoMessage() {}
+
+func (x *UserPermissions) ProtoReflect() protoreflect.Message {
+	mi := &file_permissions_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPermissions.ProtoReflect.Descriptor instead.
+func (*UserPermissions) Descriptor() ([]byte, []int) {
+	return file_permissions_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UserPermissions) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *UserPermissions) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *UserPermissions) GetGranted() *PermissionSet {
+	if x != nil {
+		return x.Granted
+	}
+	return nil
+}
+
+func (x *UserPermissions) GetEffective() *PermissionSet {
+	if x != nil {
+		return x.Effective
+	}
+	return nil
+}
+
+type UpdateUserPermissionsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	UserId  string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Roles   []string               `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+	Granted *PermissionSet         `protobuf:"bytes,3,opt,name=granted,proto3" json:"granted,omitempty"`
+	// Kept with the change, e.g. "new tracklist curator".
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserPermissionsRequest) Reset() {
+	*x = UpdateUserPermissionsRequest{}
+	mi := &file_permissions_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserPermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserPermissionsRequest) ProtoMessage() {}
+
+func (x *UpdateUserPermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permissions_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserPermissionsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserPermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_permissions_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateUserPermissionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateUserPermissionsRequest) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *UpdateUserPermissionsRequest) GetGranted() *PermissionSet {
+	if x != nil {
+		return x.Granted
+	}
+	return nil
+}
+
+func (x *UpdateUserPermissionsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Changes relative to what a user already has. Permissions not set in
+// grant or revoke, and roles not listed, are left alone.
+type PermissionPatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AddRoles      []string               `protobuf:"bytes,1,rep,name=add_roles,json=addRoles,proto3" json:"add_roles,omitempty"`
+	RemoveRoles   []string               `protobuf:"bytes,2,rep,name=remove_roles,json=removeRoles,proto3" json:"remove_roles,omitempty"`
+	Grant         *PermissionSet         `protobuf:"bytes,3,opt,name=grant,proto3" json:"grant,omitempty"`
+	Revoke        *PermissionSet         `protobuf:"bytes,4,opt,name=revoke,proto3" json:"revoke,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PermissionPatch) Reset() {
+	*x = PermissionPatch{}
+	mi := &file_permissions_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PermissionPatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionPatch) ProtoMessage() {}
+
+func (x *PermissionPatch) ProtoReflect() protoreflect.Message {
+	mi := &file_permissions_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermissionPatch.ProtoReflect.Descriptor instead.
+func (*PermissionPatch) Descriptor() ([]byte, []int) {
+	return file_permissions_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PermissionPatch) GetAddRoles() []string {
+	if x != nil {
+		return x.AddRoles
+	}
+	return nil
+}
+
+func (x *PermissionPatch) GetRemoveRoles() []string {
+	if x != nil {
+		return x.RemoveRoles
+	}
+	return nil
+}
+
+func (x *PermissionPatch) GetGrant() *PermissionSet {
+	if x != nil {
+		return x.Grant
+	}
+	return nil
+}
+
+func (x *PermissionPatch) GetRevoke() *PermissionSet {
+	if x != nil {
+		return x.Revoke
+	}
+	return nil
+}
+
+type BatchUpdatePermissionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// At most 200 users.
+	UserIds []string         `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	Patch   *PermissionPatch `protobuf:"bytes,2,opt,name=patch,proto3" json:"patch,omitempty"`
+	// Kept with each user's change.
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdatePermissionsRequest) Reset() {
+	*x = BatchUpdatePermissionsRequest{}
+	mi := &file_permissions_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdatePermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdatePermissionsRequest) ProtoMessage() {}
+
+func (x *BatchUpdatePermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permissions_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdatePermissionsRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdatePermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_permissions_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BatchUpdatePermissionsRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *BatchUpdatePermissionsRequest) GetPatch() *PermissionPatch {
+	if x != nil {
+		return x.Patch
+	}
+	return nil
+}
+
+func (x *BatchUpdatePermissionsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type BatchUpdatePermissionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The users in the order requested, duplicates dropped.
+	Users         []*UserPermissions `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdatePermissionsResponse) Reset() {
+	*x = BatchUpdatePermissionsResponse{}
+	mi := &file_permissions_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdatePermissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdatePermissionsResponse) ProtoMessage() {}
+
+func (x *BatchUpdatePermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permissions_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdatePermissionsResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdatePermissionsResponse) Descriptor() ([]byte, []int) {
+	return file_permissions_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BatchUpdatePermissionsResponse) GetUsers() []*UserPermissions {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type ListUsersWithPermissionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Permission as named in the schema, e.g. "edit_events".
+	Permission string `protobuf:"bytes,1,opt,name=permission,proto3" json:"permission,omitempty"`
+	// Role name instead of a permission.
+	Role          string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersWithPermissionRequest) Reset() {
+	*x = ListUsersWithPermissionRequest{}
+	mi := &file_permissions_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersWithPermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersWithPermissionRequest) ProtoMessage() {}
+
+func (x *ListUsersWithPermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permissions_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersWithPermissionRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersWithPermissionRequest) Descriptor() ([]byte, []int) {
+	return file_permissions_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListUsersWithPermissionRequest) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+func (x *ListUsersWithPermissionRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type ListUsersWithPermissionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*UserPermissions     `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersWithPermissionResponse) Reset() {
+	*x = ListUsersWithPermissionResponse{}
+	mi := &file_permissions_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersWithPermissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersWithPermissionResponse) ProtoMessage() {}
+
+func (x *ListUsersWithPermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permissions_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersWithPermissionResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersWithPermissionResponse) Descriptor() ([]byte, []int) {
+	return file_permissions_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListUsersWithPermissionResponse) GetUsers() []*UserPermissions {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type ListRolesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roles         []*Role                `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRolesResponse) Reset() {
+	*x = ListRolesResponse{}
+	mi := &file_permissions_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolesResponse) ProtoMessage() {}
+
+func (x *ListRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permissions_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolesResponse.ProtoReflect.Descriptor instead.
+func (*ListRolesResponse) Descriptor() ([]byte, []int) {
+	return file_permissions_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListRolesResponse) GetRoles() []*Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
 var File_permissions_proto protoreflect.FileDescriptor
 
 const file_permissions_proto_rawDesc = "" +
 	"\n" +
-	"\x11permissions.proto\x12\x15musicclub.permissions\"\xca\x01\n" +
+	"\x11permissions.proto\x12\x15musicclub.permissions\x1a\x1bgoogle/protobuf/empty.proto\x1a\n" +
+	"user.proto\"\x9f\x02\n" +
 	"\rPermissionSet\x12:\n" +
 	"\x04join\x18\x01 \x01(\v2&.musicclub.permissions.JoinPermissionsR\x04join\x12<\n" +
 	"\x05songs\x18\x02 \x01(\v2&.musicclub.permissions.SongPermissionsR\x05songs\x12?\n" +
-	"\x06events\x18\x03 \x01(\v2'.musicclub.permissions.EventPermissionsR\x06events\"}\n" +
+	"\x06events\x18\x03 \x01(\v2'.musicclub.permissions.EventPermissionsR\x06events\x12\x14\n" +
+	"\x05roles\x18\x04 \x03(\tR\x05roles\x12=\n" +
+	"\x05admin\x18\x05 \x01(\v2'.musicclub.permissions.AdminPermissionsR\x05admin\"}\n" +
 	"\x0fJoinPermissions\x124\n" +
 	"\x16edit_own_participation\x18\x01 \x01(\bR\x14editOwnParticipation\x124\n" +
 	"\x16edit_any_participation\x18\x02 \x01(\bR\x14editAnyParticipation\"]\n" +
 	"\x0fSongPermissions\x12$\n" +
 	"\x0eedit_own_songs\x18\x01 \x01(\bR\feditOwnSongs\x12$\n" +
-	"\x0eedit_any_songs\x18\x02 \x01(\bR\feditAnySongs\"\\\n" +
+	"\x0eedit_any_songs\x18\x02 \x01(\bR\feditAnySongs\"\x81\x01\n" +
 	"\x10EventPermissions\x12\x1f\n" +
 	"\vedit_events\x18\x01 \x01(\bR\n" +
 	"editEvents\x12'\n" +
-	"\x0fedit_tracklists\x18\x02 \x01(\bR\x0eeditTracklistsB\x1cZ\x1amusicclubbot/backend/protob\x06proto3"
+	"\x0fedit_tracklists\x18\x02 \x01(\bR\x0eeditTracklists\x12#\n" +
+	"\rcreate_events\x18\x03 \x01(\bR\fcreateEvents\"A\n" +
+	"\x10AdminPermissions\x12-\n" +
+	"\x12manage_permissions\x18\x01 \x01(\bR\x11managePermissions\"\x84\x01\n" +
+	"\x04Role\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12F\n" +
+	"\vpermissions\x18\x03 \x01(\v2$.musicclub.permissions.PermissionSetR\vpermissions\"1\n" +
+	"\x16UserPermissionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xd5\x01\n" +
+	"\x0fUserPermissions\x12(\n" +
+	"\x04user\x18\x01 \x01(\v2\x14.musicclub.user.UserR\x04user\x12\x14\n" +
+	"\x05roles\x18\x02 \x03(\tR\x05roles\x12>\n" +
+	"\agranted\x18\x03 \x01(\v2$.musicclub.permissions.PermissionSetR\agranted\x12B\n" +
+	"\teffective\x18\x04 \x01(\v2$.musicclub.permissions.PermissionSetR\teffective\"\xa5\x01\n" +
+	"\x1cUpdateUserPermissionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05roles\x18\x02 \x03(\tR\x05roles\x12>\n" +
+	"\agranted\x18\x03 \x01(\v2$.musicclub.permissions.PermissionSetR\agranted\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\xcb\x01\n" +
+	"\x0fPermissionPatch\x12\x1b\n" +
+	"\tadd_roles\x18\x01 \x03(\tR\baddRoles\x12!\n" +
+	"\fremove_roles\x18\x02 \x03(\tR\vremoveRoles\x12:\n" +
+	"\x05grant\x18\x03 \x01(\v2$.musicclub.permissions.PermissionSetR\x05grant\x12<\n" +
+	"\x06revoke\x18\x04 \x01(\v2$.musicclub.permissions.PermissionSetR\x06revoke\"\x90\x01\n" +
+	"\x1dBatchUpdatePermissionsRequest\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\tR\auserIds\x12<\n" +
+	"\x05patch\x18\x02 \x01(\v2&.musicclub.permissions.PermissionPatchR\x05patch\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"^\n" +
+	"\x1eBatchUpdatePermissionsResponse\x12<\n" +
+	"\x05users\x18\x01 \x03(\v2&.musicclub.permissions.UserPermissionsR\x05users\"T\n" +
+	"\x1eListUsersWithPermissionRequest\x12\x1e\n" +
+	"\n" +
+	"permission\x18\x01 \x01(\tR\n" +
+	"permission\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\"_\n" +
+	"\x1fListUsersWithPermissionResponse\x12<\n" +
+	"\x05users\x18\x01 \x03(\v2&.musicclub.permissions.UserPermissionsR\x05users\"F\n" +
+	"\x11ListRolesResponse\x121\n" +
+	"\x05roles\x18\x01 \x03(\v2\x1b.musicclub.permissions.RoleR\x05roles2\xd9\x04\n" +
+	"\x12PermissionsService\x12k\n" +
+	"\x12GetUserPermissions\x12-.musicclub.permissions.UserPermissionsRequest\x1a&.musicclub.permissions.UserPermissions\x12t\n" +
+	"\x15UpdateUserPermissions\x123.musicclub.permissions.UpdateUserPermissionsRequest\x1a&.musicclub.permissions.UserPermissions\x12\x85\x01\n" +
+	"\x16BatchUpdatePermissions\x124.musicclub.permissions.BatchUpdatePermissionsRequest\x1a5.musicclub.permissions.BatchUpdatePermissionsResponse\x12\x88\x01\n" +
+	"\x17ListUsersWithPermission\x125.musicclub.permissions.ListUsersWithPermissionRequest\x1a6.musicclub.permissions.ListUsersWithPermissionResponse\x12M\n" +
+	"\tListRoles\x12\x16.google.protobuf.Empty\x1a(.musicclub.permissions.ListRolesResponseB\x1cZ\x1amusicclubbot/backend/protob\x06proto3"
 
 var (
 	file_permissions_proto_rawDescOnce sync.Once
@@ -273,22 +958,57 @@ func file_permissions_proto_rawDescGZIP() []byte {
 	return file_permissions_proto_rawDescData
 }
 
-var file_permissions_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_permissions_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_permissions_proto_goTypes = []any{
-	(*PermissionSet)(nil),    // 0: musicclub.permissions.PermissionSet
-	(*JoinPermissions)(nil),  // 1: musicclub.permissions.JoinPermissions
-	(*SongPermissions)(nil),  // 2: musicclub.permissions.SongPermissions
-	(*EventPermissions)(nil), // 3: musicclub.permissions.EventPermissions
+	(*PermissionSet)(nil),                   // 0: musicclub.permissions.PermissionSet
+	(*JoinPermissions)(nil),                 // 1: musicclub.permissions.JoinPermissions
+	(*SongPermissions)(nil),                 // 2: musicclub.permissions.SongPermissions
+	(*EventPermissions)(nil),                // 3: musicclub.permissions.EventPermissions
+	(*AdminPermissions)(nil),                // 4: musicclub.permissions.AdminPermissions
+	(*Role)(nil),                            // 5: musicclub.permissions.Role
+	(*UserPermissionsRequest)(nil),          // 6: musicclub.permissions.UserPermissionsRequest
+	(*UserPermissions)(nil),                 // 7: musicclub.permissions.UserPermissions
+	(*UpdateUserPermissionsRequest)(nil),    // 8: musicclub.permissions.UpdateUserPermissionsRequest
+	(*PermissionPatch)(nil),                 // 9: musicclub.permissions.PermissionPatch
+	(*BatchUpdatePermissionsRequest)(nil),   // 10: musicclub.permissions.BatchUpdatePermissionsRequest
+	(*BatchUpdatePermissionsResponse)(nil),  // 11: musicclub.permissions.BatchUpdatePermissionsResponse
+	(*ListUsersWithPermissionRequest)(nil),  // 12: musicclub.permissions.ListUsersWithPermissionRequest
+	(*ListUsersWithPermissionResponse)(nil), // 13: musicclub.permissions.ListUsersWithPermissionResponse
+	(*ListRolesResponse)(nil),               // 14: musicclub.permissions.ListRolesResponse
+	(*User)(nil),                            // 15: musicclub.user.User
+	(*emptypb.Empty)(nil),                   // 16: google.protobuf.Empty
 }
 var file_permissions_proto_depIdxs = []int32{
-	1, // 0: musicclub.permissions.PermissionSet.join:type_name -> musicclub.permissions.JoinPermissions
-	2, // 1: musicclub.permissions.PermissionSet.songs:type_name -> musicclub.permissions.SongPermissions
-	3, // 2: musicclub.permissions.PermissionSet.events:type_name -> musicclub.permissions.EventPermissions
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	1,  // 0: musicclub.permissions.PermissionSet.join:type_name -> musicclub.permissions.JoinPermissions
+	2,  // 1: musicclub.permissions.PermissionSet.songs:type_name -> musicclub.permissions.SongPermissions
+	3,  // 2: musicclub.permissions.PermissionSet.events:type_name -> musicclub.permissions.EventPermissions
+	4,  // 3: musicclub.permissions.PermissionSet.admin:type_name -> musicclub.permissions.AdminPermissions
+	0,  // 4: musicclub.permissions.Role.permissions:type_name -> musicclub.permissions.PermissionSet
+	15, // 5: musicclub.permissions.UserPermissions.user:type_name -> musicclub.user.User
+	0,  // 6: musicclub.permissions.UserPermissions.granted:type_name -> musicclub.permissions.PermissionSet
+	0,  // 7: musicclub.permissions.UserPermissions.effective:type_name -> musicclub.permissions.PermissionSet
+	0,  // 8: musicclub.permissions.UpdateUserPermissionsRequest.granted:type_name -> musicclub.permissions.PermissionSet
+	0,  // 9: musicclub.permissions.PermissionPatch.grant:type_name -> musicclub.permissions.PermissionSet
+	0,  // 10: musicclub.permissions.PermissionPatch.revoke:type_name -> musicclub.permissions.PermissionSet
+	9,  // 11: musicclub.permissions.BatchUpdatePermissionsRequest.patch:type_name -> musicclub.permissions.PermissionPatch
+	7,  // 12: musicclub.permissions.BatchUpdatePermissionsResponse.users:type_name -> musicclub.permissions.UserPermissions
+	7,  // 13: musicclub.permissions.ListUsersWithPermissionResponse.users:type_name -> musicclub.permissions.UserPermissions
+	5,  // 14: musicclub.permissions.ListRolesResponse.roles:type_name -> musicclub.permissions.Role
+	6,  // 15: musicclub.permissions.PermissionsService.GetUserPermissions:input_type -> musicclub.permissions.UserPermissionsRequest
+	8,  // 16: musicclub.permissions.PermissionsService.UpdateUserPermissions:input_type -> musicclub.permissions.UpdateUserPermissionsRequest
+	10, // 17: musicclub.permissions.PermissionsService.BatchUpdatePermissions:input_type -> musicclub.permissions.BatchUpdatePermissionsRequest
+	12, // 18: musicclub.permissions.PermissionsService.ListUsersWithPermission:input_type -> musicclub.permissions.ListUsersWithPermissionRequest
+	16, // 19: musicclub.permissions.PermissionsService.ListRoles:input_type -> google.protobuf.Empty
+	7,  // 20: musicclub.permissions.PermissionsService.GetUserPermissions:output_type -> musicclub.permissions.UserPermissions
+	7,  // 21: musicclub.permissions.PermissionsService.UpdateUserPermissions:output_type -> musicclub.permissions.UserPermissions
+	11, // 22: musicclub.permissions.PermissionsService.BatchUpdatePermissions:output_type -> musicclub.permissions.BatchUpdatePermissionsResponse
+	13, // 23: musicclub.permissions.PermissionsService.ListUsersWithPermission:output_type -> musicclub.permissions.ListUsersWithPermissionResponse
+	14, // 24: musicclub.permissions.PermissionsService.ListRoles:output_type -> musicclub.permissions.ListRolesResponse
+	20, // [20:25] is the sub-list for method output_type
+	15, // [15:20] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_permissions_proto_init() }
@@ -296,15 +1016,16 @@ func file_permissions_proto_init() {
 	if File_permissions_proto != nil {
 		return
 	}
+	file_user_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_permissions_proto_rawDesc), len(file_permissions_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   15,
 			NumExtensions: 0,
-			NumServices:   0,
+			NumServices:   1,
 		},
 		GoTypes:           file_permissions_proto_goTypes,
 		DependencyIndexes: file_permissions_proto_depIdxs,
@@ -0,0 +1,294 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v3.21.12
+// source: permissions.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PermissionsService_GetUserPermissions_FullMethodName      = "/musicclub.permissions.PermissionsService/GetUserPermissions"
+	PermissionsService_UpdateUserPermissions_FullMethodName   = "/musicclub.permissions.PermissionsService/UpdateUserPermissions"
+	PermissionsService_BatchUpdatePermissions_FullMethodName  = "/musicclub.permissions.PermissionsService/BatchUpdatePermissions"
+	PermissionsService_ListUsersWithPermission_FullMethodName = "/musicclub.permissions.PermissionsService/ListUsersWithPermission"
+	PermissionsService_ListRoles_FullMethodName               = "/musicclub.permissions.PermissionsService/ListRoles"
+)
+
+// PermissionsServiceClient is the client API for PermissionsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Grants and revokes permissions (requires manage_permissions). Every change
+// is recorded with who made it and why.
+type PermissionsServiceClient interface {
+	GetUserPermissions(ctx context.Context, in *UserPermissionsRequest, opts ...grpc.CallOption) (*UserPermissions, error)
+	// Replaces the user's roles and individually granted permissions. The last
+	// user able to manage permissions can't lose that right.
+	UpdateUserPermissions(ctx context.Context, in *UpdateUserPermissionsRequest, opts ...grpc.CallOption) (*UserPermissions, error)
+	// Applies the same patch to several users at once, e.g. granting event
+	// rights to an organizing team. All users change or none do.
+	BatchUpdatePermissions(ctx context.Context, in *BatchUpdatePermissionsRequest, opts ...grpc.CallOption) (*BatchUpdatePermissionsResponse, error)
+	// Users holding the permission (through a role or individually) or the
+	// role, sorted by display name.
+	ListUsersWithPermission(ctx context.Context, in *ListUsersWithPermissionRequest, opts ...grpc.CallOption) (*ListUsersWithPermissionResponse, error)
+	// Roles with the permissions they bundle.
+	ListRoles(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListRolesResponse, error)
+}
+
+type permissionsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPermissionsServiceClient(cc grpc.ClientConnInterface) PermissionsServiceClient {
+	return &permissionsServiceClient{cc}
+}
+
+func (c *permissionsServiceClient) GetUserPermissions(ctx context.Context, in *UserPermissionsRequest, opts ...grpc.CallOption) (*UserPermissions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserPermissions)
+	err := c.cc.Invoke(ctx, PermissionsService_GetUserPermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *permissionsServiceClient) UpdateUserPermissions(ctx context.Context, in *UpdateUserPermissionsRequest, opts ...grpc.CallOption) (*UserPermissions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserPermissions)
+	err := c.cc.Invoke(ctx, PermissionsService_UpdateUserPermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *permissionsServiceClient) BatchUpdatePermissions(ctx context.Context, in *BatchUpdatePermissionsRequest, opts ...grpc.CallOption) (*BatchUpdatePermissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchUpdatePermissionsResponse)
+	err := c.cc.Invoke(ctx, PermissionsService_BatchUpdatePermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *permissionsServiceClient) ListUsersWithPermission(ctx context.Context, in *ListUsersWithPermissionRequest, opts ...grpc.CallOption) (*ListUsersWithPermissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUsersWithPermissionResponse)
+	err := c.cc.Invoke(ctx, PermissionsService_ListUsersWithPermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *permissionsServiceClient) ListRoles(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRolesResponse)
+	err := c.cc.Invoke(ctx, PermissionsService_ListRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PermissionsServiceServer is the server API for PermissionsService service.
+// All implementations must embed UnimplementedPermissionsServiceServer
+// for forward compatibility.
+//
+// Grants and revokes permissions (requires manage_permissions). Every change
+// is recorded with who made it and why.
+type PermissionsServiceServer interface {
+	GetUserPermissions(context.Context, *UserPermissionsRequest) (*UserPermissions, error)
+	// Replaces the user's roles and individually granted permissions. The last
+	// user able to manage permissions can't lose that right.
+	UpdateUserPermissions(context.Context, *UpdateUserPermissionsRequest) (*UserPermissions, error)
+	// Applies the same patch to several users at once, e.g. granting event
+	// rights to an organizing team. All users change or none do.
+	BatchUpdatePermissions(context.Context, *BatchUpdatePermissionsRequest) (*BatchUpdatePermissionsResponse, error)
+	// Users holding the permission (through a role or individually) or the
+	// role, sorted by display name.
+	ListUsersWithPermission(context.Context, *ListUsersWithPermissionRequest) (*ListUsersWithPermissionResponse, error)
+	// Roles with the permissions they bundle.
+	ListRoles(context.Context, *emptypb.Empty) (*ListRolesResponse, error)
+	mustEmbedUnimplementedPermissionsServiceServer()
+}
+
+// UnimplementedPermissionsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPermissionsServiceServer struct{}
+
+func (UnimplementedPermissionsServiceServer) GetUserPermissions(context.Context, *UserPermissionsRequest) (*UserPermissions, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserPermissions not implemented")
+}
+func (UnimplementedPermissionsServiceServer) UpdateUserPermissions(context.Context, *UpdateUserPermissionsRequest) (*UserPermissions, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateUserPermissions not implemented")
+}
+func (UnimplementedPermissionsServiceServer) BatchUpdatePermissions(context.Context, *BatchUpdatePermissionsRequest) (*BatchUpdatePermissionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchUpdatePermissions not implemented")
+}
+func (UnimplementedPermissionsServiceServer) ListUsersWithPermission(context.Context, *ListUsersWithPermissionRequest) (*ListUsersWithPermissionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUsersWithPermission not implemented")
+}
+func (UnimplementedPermissionsServiceServer) ListRoles(context.Context, *emptypb.Empty) (*ListRolesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRoles not implemented")
+}
+func (UnimplementedPermissionsServiceServer) mustEmbedUnimplementedPermissionsServiceServer() {}
+func (UnimplementedPermissionsServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafePermissionsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PermissionsServiceServer will
+// result in compilation errors.
+type UnsafePermissionsServiceServer interface {
+	mustEmbedUnimplementedPermissionsServiceServer()
+}
+
+func RegisterPermissionsServiceServer(s grpc.ServiceRegistrar, srv PermissionsServiceServer) {
+	// If the following call panics, it indicates UnimplementedPermissionsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PermissionsService_ServiceDesc, srv)
+}
+
+func _PermissionsService_GetUserPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionsServiceServer).GetUserPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PermissionsService_GetUserPermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionsServiceServer).GetUserPermissions(ctx, req.(*UserPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PermissionsService_UpdateUserPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionsServiceServer).UpdateUserPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PermissionsService_UpdateUserPermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionsServiceServer).UpdateUserPermissions(ctx, req.(*UpdateUserPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PermissionsService_BatchUpdatePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdatePermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionsServiceServer).BatchUpdatePermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PermissionsService_BatchUpdatePermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionsServiceServer).BatchUpdatePermissions(ctx, req.(*BatchUpdatePermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PermissionsService_ListUsersWithPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersWithPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionsServiceServer).ListUsersWithPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PermissionsService_ListUsersWithPermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionsServiceServer).ListUsersWithPermission(ctx, req.(*ListUsersWithPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PermissionsService_ListRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionsServiceServer).ListRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PermissionsService_ListRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionsServiceServer).ListRoles(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PermissionsService_ServiceDesc is the grpc.ServiceDesc for PermissionsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PermissionsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "musicclub.permissions.PermissionsService",
+	HandlerType: (*PermissionsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetUserPermissions",
+			Handler:    _PermissionsService_GetUserPermissions_Handler,
+		},
+		{
+			MethodName: "UpdateUserPermissions",
+			Handler:    _PermissionsService_UpdateUserPermissions_Handler,
+		},
+		{
+			MethodName: "BatchUpdatePermissions",
+			Handler:    _PermissionsService_BatchUpdatePermissions_Handler,
+		},
+		{
+			MethodName: "ListUsersWithPermission",
+			Handler:    _PermissionsService_ListUsersWithPermission_Handler,
+		},
+		{
+			MethodName: "ListRoles",
+			Handler:    _PermissionsService_ListRoles_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "permissions.proto",
+}
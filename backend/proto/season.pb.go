@@ -0,0 +1,619 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: season.proto
+
+package proto
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Season struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional bounds, informational only; events join a season explicitly.
+	StartsAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	// Number of events in the season.
+	EventCount    int32 `protobuf:"varint,5,opt,name=event_count,json=eventCount,proto3" json:"event_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Season) Reset() {
+	*x = Season{}
+	mi := &file_season_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Season) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Season) ProtoMessage() {}
+
+func (x *Season) ProtoReflect() protoreflect.Message {
+	mi := &file_season_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Season.ProtoReflect.Descriptor instead.
+func (*Season) Descriptor() ([]byte, []int) {
+	return file_season_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Season) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Season) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Season) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *Season) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *Season) GetEventCount() int32 {
+	if x != nil {
+		return x.EventCount
+	}
+	return 0
+}
+
+type SeasonId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeasonId) Reset() {
+	*x = SeasonId{}
+	mi := &file_season_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeasonId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeasonId) ProtoMessage() {}
+
+func (x *SeasonId) ProtoReflect() protoreflect.Message {
+	mi := &file_season_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeasonId.ProtoReflect.Descriptor instead.
+func (*SeasonId) Descriptor() ([]byte, []int) {
+	return file_season_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SeasonId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListSeasonsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seasons       []*Season              `protobuf:"bytes,1,rep,name=seasons,proto3" json:"seasons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSeasonsResponse) Reset() {
+	*x = ListSeasonsResponse{}
+	mi := &file_season_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSeasonsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSeasonsResponse) ProtoMessage() {}
+
+func (x *ListSeasonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_season_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSeasonsResponse.ProtoReflect.Descriptor instead.
+func (*ListSeasonsResponse) Descriptor() ([]byte, []int) {
+	return file_season_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListSeasonsResponse) GetSeasons() []*Season {
+	if x != nil {
+		return x.Seasons
+	}
+	return nil
+}
+
+type SeasonInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeasonInput) Reset() {
+	*x = SeasonInput{}
+	mi := &file_season_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeasonInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeasonInput) ProtoMessage() {}
+
+func (x *SeasonInput) ProtoReflect() protoreflect.Message {
+	mi := &file_season_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeasonInput.ProtoReflect.Descriptor instead.
+func (*SeasonInput) Descriptor() ([]byte, []int) {
+	return file_season_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SeasonInput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SeasonInput) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *SeasonInput) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+type UpdateSeasonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Season        *SeasonInput           `protobuf:"bytes,2,opt,name=season,proto3" json:"season,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSeasonRequest) Reset() {
+	*x = UpdateSeasonRequest{}
+	mi := &file_season_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSeasonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSeasonRequest) ProtoMessage() {}
+
+func (x *UpdateSeasonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_season_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSeasonRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSeasonRequest) Descriptor() ([]byte, []int) {
+	return file_season_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateSeasonRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateSeasonRequest) GetSeason() *SeasonInput {
+	if x != nil {
+		return x.Season
+	}
+	return nil
+}
+
+type SeasonSongStat struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	SongId string                 `protobuf:"bytes,1,opt,name=song_id,json=songId,proto3" json:"song_id,omitempty"`
+	Title  string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Artist string                 `protobuf:"bytes,3,opt,name=artist,proto3" json:"artist,omitempty"`
+	// Times the song was marked as performed during the season.
+	Performed     uint32 `protobuf:"varint,4,opt,name=performed,proto3" json:"performed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeasonSongStat) Reset() {
+	*x = SeasonSongStat{}
+	mi := &file_season_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeasonSongStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeasonSongStat) ProtoMessage() {}
+
+func (x *SeasonSongStat) ProtoReflect() protoreflect.Message {
+	mi := &file_season_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeasonSongStat.ProtoReflect.Descriptor instead.
+func (*SeasonSongStat) Descriptor() ([]byte, []int) {
+	return file_season_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SeasonSongStat) GetSongId() string {
+	if x != nil {
+		return x.SongId
+	}
+	return ""
+}
+
+func (x *SeasonSongStat) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SeasonSongStat) GetArtist() string {
+	if x != nil {
+		return x.Artist
+	}
+	return ""
+}
+
+func (x *SeasonSongStat) GetPerformed() uint32 {
+	if x != nil {
+		return x.Performed
+	}
+	return 0
+}
+
+type SeasonStats struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Season          *Season                `protobuf:"bytes,1,opt,name=season,proto3" json:"season,omitempty"`
+	CompletedEvents uint32                 `protobuf:"varint,2,opt,name=completed_events,json=completedEvents,proto3" json:"completed_events,omitempty"`
+	CancelledEvents uint32                 `protobuf:"varint,3,opt,name=cancelled_events,json=cancelledEvents,proto3" json:"cancelled_events,omitempty"`
+	// Tracklist items marked as performed.
+	TracksPerformed uint32 `protobuf:"varint,4,opt,name=tracks_performed,json=tracksPerformed,proto3" json:"tracks_performed,omitempty"`
+	UniqueSongs     uint32 `protobuf:"varint,5,opt,name=unique_songs,json=uniqueSongs,proto3" json:"unique_songs,omitempty"`
+	// Check-ins over all events, and how many different people they were.
+	Attendance        uint32 `protobuf:"varint,6,opt,name=attendance,proto3" json:"attendance,omitempty"`
+	UniqueAttendees   uint32 `protobuf:"varint,7,opt,name=unique_attendees,json=uniqueAttendees,proto3" json:"unique_attendees,omitempty"`
+	FeedbackResponses uint32 `protobuf:"varint,8,opt,name=feedback_responses,json=feedbackResponses,proto3" json:"feedback_responses,omitempty"`
+	// Average feedback rating; 0 without responses.
+	AverageRating float64 `protobuf:"fixed64,9,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"`
+	// Most performed songs, up to 10.
+	TopSongs      []*SeasonSongStat `protobuf:"bytes,10,rep,name=top_songs,json=topSongs,proto3" json:"top_songs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeasonStats) Reset() {
+	*x = SeasonStats{}
+	mi := &file_season_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeasonStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeasonStats) ProtoMessage() {}
+
+func (x *SeasonStats) ProtoReflect() protoreflect.Message {
+	mi := &file_season_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeasonStats.ProtoReflect.Descriptor instead.
+func (*SeasonStats) Descriptor() ([]byte, []int) {
+	return file_season_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SeasonStats) GetSeason() *Season {
+	if x != nil {
+		return x.Season
+	}
+	return nil
+}
+
+func (x *SeasonStats) GetCompletedEvents() uint32 {
+	if x != nil {
+		return x.CompletedEvents
+	}
+	return 0
+}
+
+func (x *SeasonStats) GetCancelledEvents() uint32 {
+	if x != nil {
+		return x.CancelledEvents
+	}
+	return 0
+}
+
+func (x *SeasonStats) GetTracksPerformed() uint32 {
+	if x != nil {
+		return x.TracksPerformed
+	}
+	return 0
+}
+
+func (x *SeasonStats) GetUniqueSongs() uint32 {
+	if x != nil {
+		return x.UniqueSongs
+	}
+	return 0
+}
+
+func (x *SeasonStats) GetAttendance() uint32 {
+	if x != nil {
+		return x.Attendance
+	}
+	return 0
+}
+
+func (x *SeasonStats) GetUniqueAttendees() uint32 {
+	if x != nil {
+		return x.UniqueAttendees
+	}
+	return 0
+}
+
+func (x *SeasonStats) GetFeedbackResponses() uint32 {
+	if x != nil {
+		return x.FeedbackResponses
+	}
+	return 0
+}
+
+func (x *SeasonStats) GetAverageRating() float64 {
+	if x != nil {
+		return x.AverageRating
+	}
+	return 0
+}
+
+func (x *SeasonStats) GetTopSongs() []*SeasonSongStat {
+	if x != nil {
+		return x.TopSongs
+	}
+	return nil
+}
+
+var File_season_proto protoreflect.FileDescriptor
+
+const file_season_proto_rawDesc = "" +
+	"\n" +
+	"\fseason.proto\x12\x10musicclub.season\x1a\x1bbuf/validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x10validation.proto\"\xbb\x01\n" +
+	"\x06Season\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x127\n" +
+	"\tstarts_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x1f\n" +
+	"\vevent_count\x18\x05 \x01(\x05R\n" +
+	"eventCount\"$\n" +
+	"\bSeasonId\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"I\n" +
+	"\x13ListSeasonsResponse\x122\n" +
+	"\aseasons\x18\x01 \x03(\v2\x18.musicclub.season.SeasonR\aseasons\"\x99\x01\n" +
+	"\vSeasonInput\x12\x1c\n" +
+	"\x04name\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xc0>\x01R\x04name\x127\n" +
+	"\tstarts_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\"n\n" +
+	"\x13UpdateSeasonRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12=\n" +
+	"\x06season\x18\x02 \x01(\v2\x1d.musicclub.season.SeasonInputB\x06\xbaH\x03\xc8\x01\x01R\x06season\"u\n" +
+	"\x0eSeasonSongStat\x12\x17\n" +
+	"\asong_id\x18\x01 \x01(\tR\x06songId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
+	"\x06artist\x18\x03 \x01(\tR\x06artist\x12\x1c\n" +
+	"\tperformed\x18\x04 \x01(\rR\tperformed\"\xc3\x03\n" +
+	"\vSeasonStats\x120\n" +
+	"\x06season\x18\x01 \x01(\v2\x18.musicclub.season.SeasonR\x06season\x12)\n" +
+	"\x10completed_events\x18\x02 \x01(\rR\x0fcompletedEvents\x12)\n" +
+	"\x10cancelled_events\x18\x03 \x01(\rR\x0fcancelledEvents\x12)\n" +
+	"\x10tracks_performed\x18\x04 \x01(\rR\x0ftracksPerformed\x12!\n" +
+	"\funique_songs\x18\x05 \x01(\rR\vuniqueSongs\x12\x1e\n" +
+	"\n" +
+	"attendance\x18\x06 \x01(\rR\n" +
+	"attendance\x12)\n" +
+	"\x10unique_attendees\x18\a \x01(\rR\x0funiqueAttendees\x12-\n" +
+	"\x12feedback_responses\x18\b \x01(\rR\x11feedbackResponses\x12%\n" +
+	"\x0eaverage_rating\x18\t \x01(\x01R\raverageRating\x12=\n" +
+	"\ttop_songs\x18\n" +
+	" \x03(\v2 .musicclub.season.SeasonSongStatR\btopSongs2\xcb\x03\n" +
+	"\rSeasonService\x12L\n" +
+	"\vListSeasons\x12\x16.google.protobuf.Empty\x1a%.musicclub.season.ListSeasonsResponse\x12A\n" +
+	"\tGetSeason\x12\x1a.musicclub.season.SeasonId\x1a\x18.musicclub.season.Season\x12G\n" +
+	"\fCreateSeason\x12\x1d.musicclub.season.SeasonInput\x1a\x18.musicclub.season.Season\x12O\n" +
+	"\fUpdateSeason\x12%.musicclub.season.UpdateSeasonRequest\x1a\x18.musicclub.season.Season\x12B\n" +
+	"\fDeleteSeason\x12\x1a.musicclub.season.SeasonId\x1a\x16.google.protobuf.Empty\x12K\n" +
+	"\x0eGetSeasonStats\x12\x1a.musicclub.season.SeasonId\x1a\x1d.musicclub.season.SeasonStatsB\x1cZ\x1amusicclubbot/backend/protob\x06proto3"
+
+var (
+	file_season_proto_rawDescOnce sync.Once
+	file_season_proto_rawDescData []byte
+)
+
+func file_season_proto_rawDescGZIP() []byte {
+	file_season_proto_rawDescOnce.Do(func() {
+		file_season_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_season_proto_rawDesc), len(file_season_proto_rawDesc)))
+	})
+	return file_season_proto_rawDescData
+}
+
+var file_season_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_season_proto_goTypes = []any{
+	(*Season)(nil),                // 0: musicclub.season.Season
+	(*SeasonId)(nil),              // 1: musicclub.season.SeasonId
+	(*ListSeasonsResponse)(nil),   // 2: musicclub.season.ListSeasonsResponse
+	(*SeasonInput)(nil),           // 3: musicclub.season.SeasonInput
+	(*UpdateSeasonRequest)(nil),   // 4: musicclub.season.UpdateSeasonRequest
+	(*SeasonSongStat)(nil),        // 5: musicclub.season.SeasonSongStat
+	(*SeasonStats)(nil),           // 6: musicclub.season.SeasonStats
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),         // 8: google.protobuf.Empty
+}
+var file_season_proto_depIdxs = []int32{
+	7,  // 0: musicclub.season.Season.starts_at:type_name -> google.protobuf.Timestamp
+	7,  // 1: musicclub.season.Season.ends_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: musicclub.season.ListSeasonsResponse.seasons:type_name -> musicclub.season.Season
+	7,  // 3: musicclub.season.SeasonInput.starts_at:type_name -> google.protobuf.Timestamp
+	7,  // 4: musicclub.season.SeasonInput.ends_at:type_name -> google.protobuf.Timestamp
+	3,  // 5: musicclub.season.UpdateSeasonRequest.season:type_name -> musicclub.season.SeasonInput
+	0,  // 6: musicclub.season.SeasonStats.season:type_name -> musicclub.season.Season
+	5,  // 7: musicclub.season.SeasonStats.top_songs:type_name -> musicclub.season.SeasonSongStat
+	8,  // 8: musicclub.season.SeasonService.ListSeasons:input_type -> google.protobuf.Empty
+	1,  // 9: musicclub.season.SeasonService.GetSeason:input_type -> musicclub.season.SeasonId
+	3,  // 10: musicclub.season.SeasonService.CreateSeason:input_type -> musicclub.season.SeasonInput
+	4,  // 11: musicclub.season.SeasonService.UpdateSeason:input_type -> musicclub.season.UpdateSeasonRequest
+	1,  // 12: musicclub.season.SeasonService.DeleteSeason:input_type -> musicclub.season.SeasonId
+	1,  // 13: musicclub.season.SeasonService.GetSeasonStats:input_type -> musicclub.season.SeasonId
+	2,  // 14: musicclub.season.SeasonService.ListSeasons:output_type -> musicclub.season.ListSeasonsResponse
+	0,  // 15: musicclub.season.SeasonService.GetSeason:output_type -> musicclub.season.Season
+	0,  // 16: musicclub.season.SeasonService.CreateSeason:output_type -> musicclub.season.Season
+	0,  // 17: musicclub.season.SeasonService.UpdateSeason:output_type -> musicclub.season.Season
+	8,  // 18: musicclub.season.SeasonService.DeleteSeason:output_type -> google.protobuf.Empty
+	6,  // 19: musicclub.season.SeasonService.GetSeasonStats:output_type -> musicclub.season.SeasonStats
+	14, // [14:20] is the sub-list for method output_type
+	8,  // [8:14] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_season_proto_init() }
+func file_season_proto_init() {
+	if File_season_proto != nil {
+		return
+	}
+	file_validation_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_season_proto_rawDesc), len(file_season_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_season_proto_goTypes,
+		DependencyIndexes: file_season_proto_depIdxs,
+		MessageInfos:      file_season_proto_msgTypes,
+	}.Build()
+	File_season_proto = out.File
+	file_season_proto_goTypes = nil
+	file_season_proto_depIdxs = nil
+}
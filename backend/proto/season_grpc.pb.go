@@ -0,0 +1,326 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v3.21.12
+// source: season.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SeasonService_ListSeasons_FullMethodName    = "/musicclub.season.SeasonService/ListSeasons"
+	SeasonService_GetSeason_FullMethodName      = "/musicclub.season.SeasonService/GetSeason"
+	SeasonService_CreateSeason_FullMethodName   = "/musicclub.season.SeasonService/CreateSeason"
+	SeasonService_UpdateSeason_FullMethodName   = "/musicclub.season.SeasonService/UpdateSeason"
+	SeasonService_DeleteSeason_FullMethodName   = "/musicclub.season.SeasonService/DeleteSeason"
+	SeasonService_GetSeasonStats_FullMethodName = "/musicclub.season.SeasonService/GetSeasonStats"
+)
+
+// SeasonServiceClient is the client API for SeasonService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Provides CRUD functionality for seasons grouping events, plus season totals.
+type SeasonServiceClient interface {
+	// Returns seasons, latest first.
+	ListSeasons(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSeasonsResponse, error)
+	GetSeason(ctx context.Context, in *SeasonId, opts ...grpc.CallOption) (*Season, error)
+	// Create seasons (requires edit_events).
+	CreateSeason(ctx context.Context, in *SeasonInput, opts ...grpc.CallOption) (*Season, error)
+	// Update seasons (requires edit_events).
+	UpdateSeason(ctx context.Context, in *UpdateSeasonRequest, opts ...grpc.CallOption) (*Season, error)
+	// Delete seasons (requires edit_events); their events stay, without a season.
+	DeleteSeason(ctx context.Context, in *SeasonId, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Totals over the season's events for end-of-season retrospectives.
+	GetSeasonStats(ctx context.Context, in *SeasonId, opts ...grpc.CallOption) (*SeasonStats, error)
+}
+
+type seasonServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSeasonServiceClient(cc grpc.ClientConnInterface) SeasonServiceClient {
+	return &seasonServiceClient{cc}
+}
+
+func (c *seasonServiceClient) ListSeasons(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSeasonsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSeasonsResponse)
+	err := c.cc.Invoke(ctx, SeasonService_ListSeasons_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seasonServiceClient) GetSeason(ctx context.Context, in *SeasonId, opts ...grpc.CallOption) (*Season, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Season)
+	err := c.cc.Invoke(ctx, SeasonService_GetSeason_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seasonServiceClient) CreateSeason(ctx context.Context, in *SeasonInput, opts ...grpc.CallOption) (*Season, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Season)
+	err := c.cc.Invoke(ctx, SeasonService_CreateSeason_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seasonServiceClient) UpdateSeason(ctx context.Context, in *UpdateSeasonRequest, opts ...grpc.CallOption) (*Season, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Season)
+	err := c.cc.Invoke(ctx, SeasonService_UpdateSeason_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seasonServiceClient) DeleteSeason(ctx context.Context, in *SeasonId, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SeasonService_DeleteSeason_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seasonServiceClient) GetSeasonStats(ctx context.Context, in *SeasonId, opts ...grpc.CallOption) (*SeasonStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SeasonStats)
+	err := c.cc.Invoke(ctx, SeasonService_GetSeasonStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SeasonServiceServer is the server API for SeasonService service.
+// All implementations must embed UnimplementedSeasonServiceServer
+// for forward compatibility.
+//
+// Provides CRUD functionality for seasons grouping events, plus season totals.
+type SeasonServiceServer interface {
+	// Returns seasons, latest first.
+	ListSeasons(context.Context, *emptypb.Empty) (*ListSeasonsResponse, error)
+	GetSeason(context.Context, *SeasonId) (*Season, error)
+	// Create seasons (requires edit_events).
+	CreateSeason(context.Context, *SeasonInput) (*Season, error)
+	// Update seasons (requires edit_events).
+	UpdateSeason(context.Context, *UpdateSeasonRequest) (*Season, error)
+	// Delete seasons (requires edit_events); their events stay, without a season.
+	DeleteSeason(context.Context, *SeasonId) (*emptypb.Empty, error)
+	// Totals over the season's events for end-of-season retrospectives.
+	GetSeasonStats(context.Context, *SeasonId) (*SeasonStats, error)
+	mustEmbedUnimplementedSeasonServiceServer()
+}
+
+// UnimplementedSeasonServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSeasonServiceServer struct{}
+
+func (UnimplementedSeasonServiceServer) ListSeasons(context.Context, *emptypb.Empty) (*ListSeasonsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSeasons not implemented")
+}
+func (UnimplementedSeasonServiceServer) GetSeason(context.Context, *SeasonId) (*Season, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSeason not implemented")
+}
+func (UnimplementedSeasonServiceServer) CreateSeason(context.Context, *SeasonInput) (*Season, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateSeason not implemented")
+}
+func (UnimplementedSeasonServiceServer) UpdateSeason(context.Context, *UpdateSeasonRequest) (*Season, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSeason not implemented")
+}
+func (UnimplementedSeasonServiceServer) DeleteSeason(context.Context, *SeasonId) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteSeason not implemented")
+}
+func (UnimplementedSeasonServiceServer) GetSeasonStats(context.Context, *SeasonId) (*SeasonStats, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSeasonStats not implemented")
+}
+func (UnimplementedSeasonServiceServer) mustEmbedUnimplementedSeasonServiceServer() {}
+func (UnimplementedSeasonServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeSeasonServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SeasonServiceServer will
+// result in compilation errors.
+type UnsafeSeasonServiceServer interface {
+	mustEmbedUnimplementedSeasonServiceServer()
+}
+
+func RegisterSeasonServiceServer(s grpc.ServiceRegistrar, srv SeasonServiceServer) {
+	// If the following call panics, it indicates UnimplementedSeasonServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SeasonService_ServiceDesc, srv)
+}
+
+func _SeasonService_ListSeasons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeasonServiceServer).ListSeasons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SeasonService_ListSeasons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeasonServiceServer).ListSeasons(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeasonService_GetSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SeasonId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeasonServiceServer).GetSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SeasonService_GetSeason_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeasonServiceServer).GetSeason(ctx, req.(*SeasonId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeasonService_CreateSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SeasonInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeasonServiceServer).CreateSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SeasonService_CreateSeason_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeasonServiceServer).CreateSeason(ctx, req.(*SeasonInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeasonService_UpdateSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSeasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeasonServiceServer).UpdateSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SeasonService_UpdateSeason_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeasonServiceServer).UpdateSeason(ctx, req.(*UpdateSeasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeasonService_DeleteSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SeasonId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeasonServiceServer).DeleteSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SeasonService_DeleteSeason_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeasonServiceServer).DeleteSeason(ctx, req.(*SeasonId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeasonService_GetSeasonStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SeasonId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeasonServiceServer).GetSeasonStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SeasonService_GetSeasonStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeasonServiceServer).GetSeasonStats(ctx, req.(*SeasonId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SeasonService_ServiceDesc is the grpc.ServiceDesc for SeasonService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SeasonService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "musicclub.season.SeasonService",
+	HandlerType: (*SeasonServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListSeasons",
+			Handler:    _SeasonService_ListSeasons_Handler,
+		},
+		{
+			MethodName: "GetSeason",
+			Handler:    _SeasonService_GetSeason_Handler,
+		},
+		{
+			MethodName: "CreateSeason",
+			Handler:    _SeasonService_CreateSeason_Handler,
+		},
+		{
+			MethodName: "UpdateSeason",
+			Handler:    _SeasonService_UpdateSeason_Handler,
+		},
+		{
+			MethodName: "DeleteSeason",
+			Handler:    _SeasonService_DeleteSeason_Handler,
+		},
+		{
+			MethodName: "GetSeasonStats",
+			Handler:    _SeasonService_GetSeasonStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "season.proto",
+}
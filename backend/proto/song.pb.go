@@ -7,9 +7,11 @@
 package proto
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -80,8 +82,12 @@ type ListSongsRequest struct {
 	// Optional substring filter by title or artist.
 	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
 	// Pagination cursor (opaque to client).
-	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	PageSize      uint32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  uint32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Only return songs the current user marked as favorite.
+	FavoritesOnly bool `protobuf:"varint,4,opt,name=favorites_only,json=favoritesOnly,proto3" json:"favorites_only,omitempty"`
+	// Only return songs rated at most this hard (1-5); unrated songs are kept.
+	MaxDifficulty uint32 `protobuf:"varint,5,opt,name=max_difficulty,json=maxDifficulty,proto3" json:"max_difficulty,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -137,6 +143,20 @@ func (x *ListSongsRequest) GetPageSize() uint32 {
 	return 0
 }
 
+func (x *ListSongsRequest) GetFavoritesOnly() bool {
+	if x != nil {
+		return x.FavoritesOnly
+	}
+	return false
+}
+
+func (x *ListSongsRequest) GetMaxDifficulty() uint32 {
+	if x != nil {
+		return x.MaxDifficulty
+	}
+	return 0
+}
+
 type ListSongsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Songs         []*Song                `protobuf:"bytes,1,rep,name=songs,proto3" json:"songs,omitempty"`
@@ -233,6 +253,96 @@ func (x *SongId) GetId() string {
 	return ""
 }
 
+type BatchGetSongsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Song ids to load, at most 100; unknown ids are skipped.
+	Ids           []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetSongsRequest) Reset() {
+	*x = BatchGetSongsRequest{}
+	mi := &file_song_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetSongsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetSongsRequest) ProtoMessage() {}
+
+func (x *BatchGetSongsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_song_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetSongsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetSongsRequest) Descriptor() ([]byte, []int) {
+	return file_song_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BatchGetSongsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type BatchGetSongsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Songs in the order they were requested.
+	Songs         []*SongDetails `protobuf:"bytes,1,rep,name=songs,proto3" json:"songs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetSongsResponse) Reset() {
+	*x = BatchGetSongsResponse{}
+	mi := &file_song_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetSongsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetSongsResponse) ProtoMessage() {}
+
+func (x *BatchGetSongsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_song_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetSongsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetSongsResponse) Descriptor() ([]byte, []int) {
+	return file_song_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BatchGetSongsResponse) GetSongs() []*SongDetails {
+	if x != nil {
+		return x.Songs
+	}
+	return nil
+}
+
 type Song struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -246,14 +356,28 @@ type Song struct {
 	// Number of participants currently assigned to this song.
 	AssignmentCount int32 `protobuf:"varint,8,opt,name=assignment_count,json=assignmentCount,proto3" json:"assignment_count,omitempty"`
 	// Thumbnail image URL (auto-extracted from link or custom).
-	ThumbnailUrl  string `protobuf:"bytes,9,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
+	ThumbnailUrl string `protobuf:"bytes,9,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
+	// Row version, incremented on every update.
+	Version int64 `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
+	// Whether current user marked this song as favorite.
+	IsFavorite bool `protobuf:"varint,11,opt,name=is_favorite,json=isFavorite,proto3" json:"is_favorite,omitempty"`
+	// Whether the song is pinned to the top of the catalog.
+	IsPinned bool `protobuf:"varint,12,opt,name=is_pinned,json=isPinned,proto3" json:"is_pinned,omitempty"`
+	// Lowercase free-form tags (genre, era, mood).
+	Tags []string `protobuf:"bytes,13,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Average difficulty rating (1-5) across roles, 0 if nobody rated yet.
+	Difficulty float64 `protobuf:"fixed64,14,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	// Typical length in seconds, 0 if unknown; tracklists use it by default.
+	DurationSeconds uint32 `protobuf:"varint,15,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	// Musical key the club plays the song in, e.g. "Am".
+	Key           string `protobuf:"bytes,16,opt,name=key,proto3" json:"key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Song) Reset() {
 	*x = Song{}
-	mi := &file_song_proto_msgTypes[3]
+	mi := &file_song_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -264,8 +388,827 @@ func (x *Song) String() string {
 
 func (*Song) ProtoMessage() {}
 
-func (x *Song) ProtoReflect() protoreflect.Message {
-	mi := &file_song_proto_msgTypes[3]
+func (x *Song) ProtoReflect() protoreflect.Message {
+	mi := &file_song_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Song.ProtoReflect.Descriptor instead.
+func (*Song) Descriptor() ([]byte, []int) {
+	return file_song_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Song) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Song) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Song) GetArtist() string {
+	if x != nil {
+		return x.Artist
+	}
+	return ""
+}
+
+func (x *Song) GetLink() *SongLink {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+func (x *Song) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Song) GetAvailableRoles() []string {
+	if x != nil {
+		return x.AvailableRoles
+	}
+	return nil
+}
+
+func (x *Song) GetEditableByMe() bool {
+	if x != nil {
+		return x.EditableByMe
+	}
+	return false
+}
+
+func (x *Song) GetAssignmentCount() int32 {
+	if x != nil {
+		return x.AssignmentCount
+	}
+	return 0
+}
+
+func (x *Song) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *Song) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Song) GetIsFavorite() bool {
+	if x != nil {
+		return x.IsFavorite
+	}
+	return false
+}
+
+func (x *Song) GetIsPinned() bool {
+	if x != nil {
+		return x.IsPinned
+	}
+	return false
+}
+
+func (x *Song) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Song) GetDifficulty() float64 {
+	if x != nil {
+		return x.Difficulty
+	}
+	return 0
+}
+
+func (x *Song) GetDurationSeconds() uint32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *Song) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type SongDetails struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Song           *Song                  `protobuf:"bytes,1,opt,name=song,proto3" json:"song,omitempty"`
+	Assignments    []*RoleAssignment      `protobuf:"bytes,2,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	Permissions    *PermissionSet         `protobuf:"bytes,3,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	RoleDifficulty []*RoleDifficulty      `protobuf:"bytes,4,rep,name=role_difficulty,json=roleDifficulty,proto3" json:"role_difficulty,omitempty"`
+	// Demo recordings, newest first.
+	Demos         []*Demo `protobuf:"bytes,5,rep,name=demos,proto3" json:"demos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SongDetails) Reset() {
+	*x = SongDetails{}
+	mi := &file_song_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SongDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SongDetails) ProtoMessage() {}
+
+func (x *SongDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_song_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SongDetails.ProtoReflect.Descriptor instead.
+func (*SongDetails) Descriptor() ([]byte, []int) {
+	return file_song_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SongDetails) GetSong() *Song {
+	if x != nil {
+		return x.Song
+	}
+	return nil
+}
+
+func (x *SongDetails) GetAssignments() []*RoleAssignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+func (x *SongDetails) GetPermissions() *PermissionSet {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *SongDetails) GetRoleDifficulty() []*RoleDifficulty {
+	if x != nil {
+		return x.RoleDifficulty
+	}
+	return nil
+}
+
+func (x *SongDetails) GetDemos() []*Demo {
+	if x != nil {
+		return x.Demos
+	}
+	return nil
+}
+
+type Demo struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SongId      string                 `protobuf:"bytes,2,opt,name=song_id,json=songId,proto3" json:"song_id,omitempty"`
+	Title       string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	ContentType string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes   int64                  `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	UploadedBy  *User                  `protobuf:"bytes,6,opt,name=uploaded_by,json=uploadedBy,proto3" json:"uploaded_by,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Direct download URL.
+	Url           string `protobuf:"bytes,8,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Demo) Reset() {
+	*x = Demo{}
+	mi := &file_song_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Demo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Demo) ProtoMessage() {}
+
+func (x *Demo) ProtoReflect() protoreflect.Message {
+	mi := &file_song_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Demo.ProtoReflect.Descriptor instead.
+func (*Demo) Descriptor() ([]byte, []int) {
+	return file_song_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Demo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Demo) GetSongId() string {
+	if x != nil {
+		return x.SongId
+	}
+	return ""
+}
+
+func (x *Demo) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Demo) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Demo) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *Demo) GetUploadedBy() *User {
+	if x != nil {
+		return x.UploadedBy
+	}
+	return nil
+}
+
+func (x *Demo) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Demo) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type DemoId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DemoId) Reset() {
+	*x = DemoId{}
+	mi := &file_song_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DemoId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DemoId) ProtoMessage() {}
+
+func (x *DemoId) ProtoReflect() protoreflect.Message {
+	mi := &file_song_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DemoId.ProtoReflect.Descriptor instead.
+func (*DemoId) Descriptor() ([]byte, []int) {
+	return file_song_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DemoId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DemoMetadata struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	SongId string                 `protobuf:"bytes,1,opt,name=song_id,json=songId,proto3" json:"song_id,omitempty"`
+	Title  string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// Must be an audio/* MIME type.
+	ContentType   string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DemoMetadata) Reset() {
+	*x = DemoMetadata{}
+	mi := &file_song_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DemoMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DemoMetadata) ProtoMessage() {}
+
+func (x *DemoMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_song_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DemoMetadata.ProtoReflect.Descriptor instead.
+func (*DemoMetadata) Descriptor() ([]byte, []int) {
+	return file_song_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DemoMetadata) GetSongId() string {
+	if x != nil {
+		return x.SongId
+	}
+	return ""
+}
+
+func (x *DemoMetadata) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DemoMetadata) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type UploadDemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*UploadDemoRequest_Metadata
+	//	*UploadDemoRequest_Chunk
+	Payload       isUploadDemoRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadDemoRequest) Reset() {
+	*x = UploadDemoRequest{}
+	mi := &file_song_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadDemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadDemoRequest) ProtoMessage() {}
+
+func (x *UploadDemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_song_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadDemoRequest.ProtoReflect.Descriptor instead.
+func (*UploadDemoRequest) Descriptor() ([]byte, []int) {
+	return file_song_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UploadDemoRequest) GetPayload() isUploadDemoRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *UploadDemoRequest) GetMetadata() *DemoMetadata {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadDemoRequest_Metadata); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
+
+func (x *UploadDemoRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadDemoRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadDemoRequest_Payload interface {
+	isUploadDemoRequest_Payload()
+}
+
+type UploadDemoRequest_Metadata struct {
+	Metadata *DemoMetadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type UploadDemoRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadDemoRequest_Metadata) isUploadDemoRequest_Payload() {}
+
+func (*UploadDemoRequest_Chunk) isUploadDemoRequest_Payload() {}
+
+type RoleDifficulty struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Role    string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Average float64                `protobuf:"fixed64,2,opt,name=average,proto3" json:"average,omitempty"`
+	Ratings int32                  `protobuf:"varint,3,opt,name=ratings,proto3" json:"ratings,omitempty"`
+	// Current user's rating for this role, 0 if not rated.
+	MyRating      uint32 `protobuf:"varint,4,opt,name=my_rating,json=myRating,proto3" json:"my_rating,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleDifficulty) Reset() {
+	*x = RoleDifficulty{}
+	mi := &file_song_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleDifficulty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleDifficulty) ProtoMessage() {}
+
+func (x *RoleDifficulty) ProtoReflect() protoreflect.Message {
+	mi := &file_song_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleDifficulty.ProtoReflect.Descriptor instead.
+func (*RoleDifficulty) Descriptor() ([]byte, []int) {
+	return file_song_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RoleDifficulty) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *RoleDifficulty) GetAverage() float64 {
+	if x != nil {
+		return x.Average
+	}
+	return 0
+}
+
+func (x *RoleDifficulty) GetRatings() int32 {
+	if x != nil {
+		return x.Ratings
+	}
+	return 0
+}
+
+func (x *RoleDifficulty) GetMyRating() uint32 {
+	if x != nil {
+		return x.MyRating
+	}
+	return 0
+}
+
+type SongLink struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          SongLinkType           `protobuf:"varint,1,opt,name=kind,proto3,enum=musicclub.song.SongLinkType" json:"kind,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SongLink) Reset() {
+	*x = SongLink{}
+	mi := &file_song_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SongLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SongLink) ProtoMessage() {}
+
+func (x *SongLink) ProtoReflect() protoreflect.Message {
+	mi := &file_song_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SongLink.ProtoReflect.Descriptor instead.
+func (*SongLink) Descriptor() ([]byte, []int) {
+	return file_song_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SongLink) GetKind() SongLinkType {
+	if x != nil {
+		return x.Kind
+	}
+	return SongLinkType_SONG_LINK_TYPE_UNKNOWN
+}
+
+func (x *SongLink) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type RoleAssignment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	User          *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	JoinedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleAssignment) Reset() {
+	*x = RoleAssignment{}
+	mi := &file_song_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleAssignment) ProtoMessage() {}
+
+func (x *RoleAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_song_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleAssignment.ProtoReflect.Descriptor instead.
+func (*RoleAssignment) Descriptor() ([]byte, []int) {
+	return file_song_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RoleAssignment) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *RoleAssignment) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *RoleAssignment) GetJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return nil
+}
+
+type CreateSongRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Title           string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Artist          string                 `protobuf:"bytes,2,opt,name=artist,proto3" json:"artist,omitempty"`
+	Link            *SongLink              `protobuf:"bytes,3,opt,name=link,proto3" json:"link,omitempty"`
+	Description     string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	AvailableRoles  []string               `protobuf:"bytes,5,rep,name=available_roles,json=availableRoles,proto3" json:"available_roles,omitempty"`
+	ThumbnailUrl    string                 `protobuf:"bytes,6,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
+	Tags            []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	DurationSeconds uint32                 `protobuf:"varint,8,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	Key             string                 `protobuf:"bytes,9,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateSongRequest) Reset() {
+	*x = CreateSongRequest{}
+	mi := &file_song_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSongRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSongRequest) ProtoMessage() {}
+
+func (x *CreateSongRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_song_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSongRequest.ProtoReflect.Descriptor instead.
+func (*CreateSongRequest) Descriptor() ([]byte, []int) {
+	return file_song_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateSongRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateSongRequest) GetArtist() string {
+	if x != nil {
+		return x.Artist
+	}
+	return ""
+}
+
+func (x *CreateSongRequest) GetLink() *SongLink {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+func (x *CreateSongRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateSongRequest) GetAvailableRoles() []string {
+	if x != nil {
+		return x.AvailableRoles
+	}
+	return nil
+}
+
+func (x *CreateSongRequest) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *CreateSongRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateSongRequest) GetDurationSeconds() uint32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *CreateSongRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type UpdateSongRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Artist         string                 `protobuf:"bytes,3,opt,name=artist,proto3" json:"artist,omitempty"`
+	Link           *SongLink              `protobuf:"bytes,4,opt,name=link,proto3" json:"link,omitempty"`
+	Description    string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	AvailableRoles []string               `protobuf:"bytes,6,rep,name=available_roles,json=availableRoles,proto3" json:"available_roles,omitempty"`
+	ThumbnailUrl   string                 `protobuf:"bytes,7,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
+	// Fields to overwrite (title, artist, link, description, available_roles,
+	// thumbnail_url, tags, duration_seconds, key). Empty mask replaces all of
+	// them except tags, duration_seconds and key. A thumbnail taken from the
+	// old link follows a new link unless thumbnail_url is set as well.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,8,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// Version the client last saw; mismatch fails with ABORTED.
+	ExpectedVersion int64    `protobuf:"varint,9,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	Tags            []string `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
+	DurationSeconds uint32   `protobuf:"varint,11,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	Key             string   `protobuf:"bytes,12,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateSongRequest) Reset() {
+	*x = UpdateSongRequest{}
+	mi := &file_song_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSongRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSongRequest) ProtoMessage() {}
+
+func (x *UpdateSongRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_song_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -276,98 +1219,119 @@ func (x *Song) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Song.ProtoReflect.Descriptor instead.
-func (*Song) Descriptor() ([]byte, []int) {
-	return file_song_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use UpdateSongRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSongRequest) Descriptor() ([]byte, []int) {
+	return file_song_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *Song) GetId() string {
+func (x *UpdateSongRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Song) GetTitle() string {
+func (x *UpdateSongRequest) GetTitle() string {
 	if x != nil {
 		return x.Title
 	}
 	return ""
 }
 
-func (x *Song) GetArtist() string {
+func (x *UpdateSongRequest) GetArtist() string {
 	if x != nil {
 		return x.Artist
 	}
 	return ""
 }
 
-func (x *Song) GetLink() *SongLink {
+func (x *UpdateSongRequest) GetLink() *SongLink {
 	if x != nil {
 		return x.Link
 	}
 	return nil
 }
 
-func (x *Song) GetDescription() string {
+func (x *UpdateSongRequest) GetDescription() string {
 	if x != nil {
 		return x.Description
 	}
 	return ""
 }
 
-func (x *Song) GetAvailableRoles() []string {
+func (x *UpdateSongRequest) GetAvailableRoles() []string {
 	if x != nil {
 		return x.AvailableRoles
 	}
 	return nil
 }
 
-func (x *Song) GetEditableByMe() bool {
+func (x *UpdateSongRequest) GetThumbnailUrl() string {
 	if x != nil {
-		return x.EditableByMe
+		return x.ThumbnailUrl
 	}
-	return false
+	return ""
 }
 
-func (x *Song) GetAssignmentCount() int32 {
+func (x *UpdateSongRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	if x != nil {
-		return x.AssignmentCount
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateSongRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
 	}
 	return 0
 }
 
-func (x *Song) GetThumbnailUrl() string {
+func (x *UpdateSongRequest) GetTags() []string {
 	if x != nil {
-		return x.ThumbnailUrl
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateSongRequest) GetDurationSeconds() uint32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *UpdateSongRequest) GetKey() string {
+	if x != nil {
+		return x.Key
 	}
 	return ""
 }
 
-type SongDetails struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Song          *Song                  `protobuf:"bytes,1,opt,name=song,proto3" json:"song,omitempty"`
-	Assignments   []*RoleAssignment      `protobuf:"bytes,2,rep,name=assignments,proto3" json:"assignments,omitempty"`
-	Permissions   *PermissionSet         `protobuf:"bytes,3,opt,name=permissions,proto3" json:"permissions,omitempty"`
+type GetRelatedSongsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	SongId string                 `protobuf:"bytes,1,opt,name=song_id,json=songId,proto3" json:"song_id,omitempty"`
+	// Defaults to 10; larger values are capped at 50.
+	Limit         uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SongDetails) Reset() {
-	*x = SongDetails{}
-	mi := &file_song_proto_msgTypes[4]
+func (x *GetRelatedSongsRequest) Reset() {
+	*x = GetRelatedSongsRequest{}
+	mi := &file_song_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SongDetails) String() string {
+func (x *GetRelatedSongsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SongDetails) ProtoMessage() {}
+func (*GetRelatedSongsRequest) ProtoMessage() {}
 
-func (x *SongDetails) ProtoReflect() protoreflect.Message {
-	mi := &file_song_proto_msgTypes[4]
+func (x *GetRelatedSongsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_song_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -378,55 +1342,47 @@ func (x *SongDetails) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SongDetails.ProtoReflect.Descriptor instead.
-func (*SongDetails) Descriptor() ([]byte, []int) {
-	return file_song_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *SongDetails) GetSong() *Song {
-	if x != nil {
-		return x.Song
-	}
-	return nil
+// Deprecated: Use GetRelatedSongsRequest.ProtoReflect.Descriptor instead.
+func (*GetRelatedSongsRequest) Descriptor() ([]byte, []int) {
+	return file_song_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *SongDetails) GetAssignments() []*RoleAssignment {
+func (x *GetRelatedSongsRequest) GetSongId() string {
 	if x != nil {
-		return x.Assignments
+		return x.SongId
 	}
-	return nil
+	return ""
 }
 
-func (x *SongDetails) GetPermissions() *PermissionSet {
+func (x *GetRelatedSongsRequest) GetLimit() uint32 {
 	if x != nil {
-		return x.Permissions
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-type SongLink struct {
+type GetRelatedSongsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Kind          SongLinkType           `protobuf:"varint,1,opt,name=kind,proto3,enum=musicclub.song.SongLinkType" json:"kind,omitempty"`
-	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Songs         []*RelatedSong         `protobuf:"bytes,1,rep,name=songs,proto3" json:"songs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SongLink) Reset() {
-	*x = SongLink{}
-	mi := &file_song_proto_msgTypes[5]
+func (x *GetRelatedSongsResponse) Reset() {
+	*x = GetRelatedSongsResponse{}
+	mi := &file_song_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SongLink) String() string {
+func (x *GetRelatedSongsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SongLink) ProtoMessage() {}
+func (*GetRelatedSongsResponse) ProtoMessage() {}
 
-func (x *SongLink) ProtoReflect() protoreflect.Message {
-	mi := &file_song_proto_msgTypes[5]
+func (x *GetRelatedSongsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_song_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -437,49 +1393,44 @@ func (x *SongLink) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SongLink.ProtoReflect.Descriptor instead.
-func (*SongLink) Descriptor() ([]byte, []int) {
-	return file_song_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *SongLink) GetKind() SongLinkType {
-	if x != nil {
-		return x.Kind
-	}
-	return SongLinkType_SONG_LINK_TYPE_UNKNOWN
+// Deprecated: Use GetRelatedSongsResponse.ProtoReflect.Descriptor instead.
+func (*GetRelatedSongsResponse) Descriptor() ([]byte, []int) {
+	return file_song_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *SongLink) GetUrl() string {
+func (x *GetRelatedSongsResponse) GetSongs() []*RelatedSong {
 	if x != nil {
-		return x.Url
+		return x.Songs
 	}
-	return ""
+	return nil
 }
 
-type RoleAssignment struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
-	User          *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
-	JoinedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+type RelatedSong struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Song  *Song                  `protobuf:"bytes,1,opt,name=song,proto3" json:"song,omitempty"`
+	// Why the song was suggested.
+	SameArtist    bool     `protobuf:"varint,2,opt,name=same_artist,json=sameArtist,proto3" json:"same_artist,omitempty"`
+	SharedTags    []string `protobuf:"bytes,3,rep,name=shared_tags,json=sharedTags,proto3" json:"shared_tags,omitempty"`
+	SharedMembers int32    `protobuf:"varint,4,opt,name=shared_members,json=sharedMembers,proto3" json:"shared_members,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RoleAssignment) Reset() {
-	*x = RoleAssignment{}
-	mi := &file_song_proto_msgTypes[6]
+func (x *RelatedSong) Reset() {
+	*x = RelatedSong{}
+	mi := &file_song_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RoleAssignment) String() string {
+func (x *RelatedSong) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoleAssignment) ProtoMessage() {}
+func (*RelatedSong) ProtoMessage() {}
 
-func (x *RoleAssignment) ProtoReflect() protoreflect.Message {
-	mi := &file_song_proto_msgTypes[6]
+func (x *RelatedSong) ProtoReflect() protoreflect.Message {
+	mi := &file_song_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -490,59 +1441,64 @@ func (x *RoleAssignment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoleAssignment.ProtoReflect.Descriptor instead.
-func (*RoleAssignment) Descriptor() ([]byte, []int) {
-	return file_song_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use RelatedSong.ProtoReflect.Descriptor instead.
+func (*RelatedSong) Descriptor() ([]byte, []int) {
+	return file_song_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *RoleAssignment) GetRole() string {
+func (x *RelatedSong) GetSong() *Song {
 	if x != nil {
-		return x.Role
+		return x.Song
 	}
-	return ""
+	return nil
 }
 
-func (x *RoleAssignment) GetUser() *User {
+func (x *RelatedSong) GetSameArtist() bool {
 	if x != nil {
-		return x.User
+		return x.SameArtist
 	}
-	return nil
+	return false
 }
 
-func (x *RoleAssignment) GetJoinedAt() *timestamppb.Timestamp {
+func (x *RelatedSong) GetSharedTags() []string {
 	if x != nil {
-		return x.JoinedAt
+		return x.SharedTags
 	}
 	return nil
 }
 
-type CreateSongRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Title          string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Artist         string                 `protobuf:"bytes,2,opt,name=artist,proto3" json:"artist,omitempty"`
-	Link           *SongLink              `protobuf:"bytes,3,opt,name=link,proto3" json:"link,omitempty"`
-	Description    string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	AvailableRoles []string               `protobuf:"bytes,5,rep,name=available_roles,json=availableRoles,proto3" json:"available_roles,omitempty"`
-	ThumbnailUrl   string                 `protobuf:"bytes,6,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *RelatedSong) GetSharedMembers() int32 {
+	if x != nil {
+		return x.SharedMembers
+	}
+	return 0
 }
 
-func (x *CreateSongRequest) Reset() {
-	*x = CreateSongRequest{}
-	mi := &file_song_proto_msgTypes[7]
+type MergeRolesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Role name to get rid of, matched case-insensitively.
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// Canonical role name to keep.
+	Into          string `protobuf:"bytes,2,opt,name=into,proto3" json:"into,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeRolesRequest) Reset() {
+	*x = MergeRolesRequest{}
+	mi := &file_song_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSongRequest) String() string {
+func (x *MergeRolesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSongRequest) ProtoMessage() {}
+func (*MergeRolesRequest) ProtoMessage() {}
 
-func (x *CreateSongRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_song_proto_msgTypes[7]
+func (x *MergeRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_song_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -553,81 +1509,95 @@ func (x *CreateSongRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSongRequest.ProtoReflect.Descriptor instead.
-func (*CreateSongRequest) Descriptor() ([]byte, []int) {
-	return file_song_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use MergeRolesRequest.ProtoReflect.Descriptor instead.
+func (*MergeRolesRequest) Descriptor() ([]byte, []int) {
+	return file_song_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *CreateSongRequest) GetTitle() string {
+func (x *MergeRolesRequest) GetFrom() string {
 	if x != nil {
-		return x.Title
+		return x.From
 	}
 	return ""
 }
 
-func (x *CreateSongRequest) GetArtist() string {
+func (x *MergeRolesRequest) GetInto() string {
 	if x != nil {
-		return x.Artist
+		return x.Into
 	}
 	return ""
 }
 
-func (x *CreateSongRequest) GetLink() *SongLink {
-	if x != nil {
-		return x.Link
-	}
-	return nil
+type MergeRolesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of songs whose roles were rewritten.
+	SongsUpdated  int32 `protobuf:"varint,1,opt,name=songs_updated,json=songsUpdated,proto3" json:"songs_updated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSongRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+func (x *MergeRolesResponse) Reset() {
+	*x = MergeRolesResponse{}
+	mi := &file_song_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSongRequest) GetAvailableRoles() []string {
+func (x *MergeRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeRolesResponse) ProtoMessage() {}
+
+func (x *MergeRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_song_proto_msgTypes[20]
 	if x != nil {
-		return x.AvailableRoles
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CreateSongRequest) GetThumbnailUrl() string {
+// Deprecated: Use MergeRolesResponse.ProtoReflect.Descriptor instead.
+func (*MergeRolesResponse) Descriptor() ([]byte, []int) {
+	return file_song_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MergeRolesResponse) GetSongsUpdated() int32 {
 	if x != nil {
-		return x.ThumbnailUrl
+		return x.SongsUpdated
 	}
-	return ""
+	return 0
 }
 
-type UpdateSongRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Artist         string                 `protobuf:"bytes,3,opt,name=artist,proto3" json:"artist,omitempty"`
-	Link           *SongLink              `protobuf:"bytes,4,opt,name=link,proto3" json:"link,omitempty"`
-	Description    string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	AvailableRoles []string               `protobuf:"bytes,6,rep,name=available_roles,json=availableRoles,proto3" json:"available_roles,omitempty"`
-	ThumbnailUrl   string                 `protobuf:"bytes,7,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type RateDifficultyRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	SongId string                 `protobuf:"bytes,1,opt,name=song_id,json=songId,proto3" json:"song_id,omitempty"`
+	Role   string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	// 1-5, or 0 to clear the rating.
+	Rating        uint32 `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateSongRequest) Reset() {
-	*x = UpdateSongRequest{}
-	mi := &file_song_proto_msgTypes[8]
+func (x *RateDifficultyRequest) Reset() {
+	*x = RateDifficultyRequest{}
+	mi := &file_song_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSongRequest) String() string {
+func (x *RateDifficultyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSongRequest) ProtoMessage() {}
+func (*RateDifficultyRequest) ProtoMessage() {}
 
-func (x *UpdateSongRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_song_proto_msgTypes[8]
+func (x *RateDifficultyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_song_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -638,58 +1608,30 @@ func (x *UpdateSongRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSongRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSongRequest) Descriptor() ([]byte, []int) {
-	return file_song_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *UpdateSongRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *UpdateSongRequest) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+// Deprecated: Use RateDifficultyRequest.ProtoReflect.Descriptor instead.
+func (*RateDifficultyRequest) Descriptor() ([]byte, []int) {
+	return file_song_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *UpdateSongRequest) GetArtist() string {
+func (x *RateDifficultyRequest) GetSongId() string {
 	if x != nil {
-		return x.Artist
+		return x.SongId
 	}
 	return ""
 }
 
-func (x *UpdateSongRequest) GetLink() *SongLink {
-	if x != nil {
-		return x.Link
-	}
-	return nil
-}
-
-func (x *UpdateSongRequest) GetDescription() string {
+func (x *RateDifficultyRequest) GetRole() string {
 	if x != nil {
-		return x.Description
+		return x.Role
 	}
 	return ""
 }
 
-func (x *UpdateSongRequest) GetAvailableRoles() []string {
-	if x != nil {
-		return x.AvailableRoles
-	}
-	return nil
-}
-
-func (x *UpdateSongRequest) GetThumbnailUrl() string {
+func (x *RateDifficultyRequest) GetRating() uint32 {
 	if x != nil {
-		return x.ThumbnailUrl
+		return x.Rating
 	}
-	return ""
+	return 0
 }
 
 type JoinRoleRequest struct {
@@ -702,7 +1644,7 @@ type JoinRoleRequest struct {
 
 func (x *JoinRoleRequest) Reset() {
 	*x = JoinRoleRequest{}
-	mi := &file_song_proto_msgTypes[9]
+	mi := &file_song_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -714,7 +1656,7 @@ func (x *JoinRoleRequest) String() string {
 func (*JoinRoleRequest) ProtoMessage() {}
 
 func (x *JoinRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_song_proto_msgTypes[9]
+	mi := &file_song_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -727,7 +1669,7 @@ func (x *JoinRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinRoleRequest.ProtoReflect.Descriptor instead.
 func (*JoinRoleRequest) Descriptor() ([]byte, []int) {
-	return file_song_proto_rawDescGZIP(), []int{9}
+	return file_song_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *JoinRoleRequest) GetSongId() string {
@@ -754,7 +1696,7 @@ type LeaveRoleRequest struct {
 
 func (x *LeaveRoleRequest) Reset() {
 	*x = LeaveRoleRequest{}
-	mi := &file_song_proto_msgTypes[10]
+	mi := &file_song_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -766,7 +1708,7 @@ func (x *LeaveRoleRequest) String() string {
 func (*LeaveRoleRequest) ProtoMessage() {}
 
 func (x *LeaveRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_song_proto_msgTypes[10]
+	mi := &file_song_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -779,7 +1721,7 @@ func (x *LeaveRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeaveRoleRequest.ProtoReflect.Descriptor instead.
 func (*LeaveRoleRequest) Descriptor() ([]byte, []int) {
-	return file_song_proto_rawDescGZIP(), []int{10}
+	return file_song_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *LeaveRoleRequest) GetSongId() string {
@@ -801,18 +1743,24 @@ var File_song_proto protoreflect.FileDescriptor
 const file_song_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"song.proto\x12\x0emusicclub.song\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\n" +
-	"user.proto\x1a\x11permissions.proto\"d\n" +
+	"song.proto\x12\x0emusicclub.song\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a\n" +
+	"user.proto\x1a\x11permissions.proto\x1a\x10validation.proto\"\xc4\x01\n" +
 	"\x10ListSongsRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x1b\n" +
-	"\tpage_size\x18\x03 \x01(\rR\bpageSize\"g\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12$\n" +
+	"\tpage_size\x18\x03 \x01(\rB\a\xbaH\x04*\x02\x18dR\bpageSize\x12%\n" +
+	"\x0efavorites_only\x18\x04 \x01(\bR\rfavoritesOnly\x12.\n" +
+	"\x0emax_difficulty\x18\x05 \x01(\rB\a\xbaH\x04*\x02\x18\x05R\rmaxDifficulty\"g\n" +
 	"\x11ListSongsResponse\x12*\n" +
 	"\x05songs\x18\x01 \x03(\v2\x14.musicclub.song.SongR\x05songs\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x18\n" +
-	"\x06SongId\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\xb3\x02\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\"\n" +
+	"\x06SongId\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"9\n" +
+	"\x14BatchGetSongsRequest\x12!\n" +
+	"\x03ids\x18\x01 \x03(\tB\x0f\xbaH\f\x92\x01\t\x10d\"\x05r\x03\xb0\x01\x01R\x03ids\"J\n" +
+	"\x15BatchGetSongsResponse\x121\n" +
+	"\x05songs\x18\x01 \x03(\v2\x1b.musicclub.song.SongDetailsR\x05songs\"\xfc\x03\n" +
 	"\x04Song\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
@@ -822,47 +1770,121 @@ const file_song_proto_rawDesc = "" +
 	"\x0favailable_roles\x18\x06 \x03(\tR\x0eavailableRoles\x12$\n" +
 	"\x0eeditable_by_me\x18\a \x01(\bR\feditableByMe\x12)\n" +
 	"\x10assignment_count\x18\b \x01(\x05R\x0fassignmentCount\x12#\n" +
-	"\rthumbnail_url\x18\t \x01(\tR\fthumbnailUrl\"\xc1\x01\n" +
+	"\rthumbnail_url\x18\t \x01(\tR\fthumbnailUrl\x12\x18\n" +
+	"\aversion\x18\n" +
+	" \x01(\x03R\aversion\x12\x1f\n" +
+	"\vis_favorite\x18\v \x01(\bR\n" +
+	"isFavorite\x12\x1b\n" +
+	"\tis_pinned\x18\f \x01(\bR\bisPinned\x12\x12\n" +
+	"\x04tags\x18\r \x03(\tR\x04tags\x12\x1e\n" +
+	"\n" +
+	"difficulty\x18\x0e \x01(\x01R\n" +
+	"difficulty\x12)\n" +
+	"\x10duration_seconds\x18\x0f \x01(\rR\x0fdurationSeconds\x12\x10\n" +
+	"\x03key\x18\x10 \x01(\tR\x03key\"\xb6\x02\n" +
 	"\vSongDetails\x12(\n" +
 	"\x04song\x18\x01 \x01(\v2\x14.musicclub.song.SongR\x04song\x12@\n" +
 	"\vassignments\x18\x02 \x03(\v2\x1e.musicclub.song.RoleAssignmentR\vassignments\x12F\n" +
-	"\vpermissions\x18\x03 \x01(\v2$.musicclub.permissions.PermissionSetR\vpermissions\"N\n" +
-	"\bSongLink\x120\n" +
-	"\x04kind\x18\x01 \x01(\x0e2\x1c.musicclub.song.SongLinkTypeR\x04kind\x12\x10\n" +
-	"\x03url\x18\x02 \x01(\tR\x03url\"\x87\x01\n" +
+	"\vpermissions\x18\x03 \x01(\v2$.musicclub.permissions.PermissionSetR\vpermissions\x12G\n" +
+	"\x0frole_difficulty\x18\x04 \x03(\v2\x1e.musicclub.song.RoleDifficultyR\x0eroleDifficulty\x12*\n" +
+	"\x05demos\x18\x05 \x03(\v2\x14.musicclub.song.DemoR\x05demos\"\x8b\x02\n" +
+	"\x04Demo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\asong_id\x18\x02 \x01(\tR\x06songId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x05 \x01(\x03R\tsizeBytes\x125\n" +
+	"\vuploaded_by\x18\x06 \x01(\v2\x14.musicclub.user.UserR\n" +
+	"uploadedBy\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x10\n" +
+	"\x03url\x18\b \x01(\tR\x03url\"\"\n" +
+	"\x06DemoId\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x8b\x01\n" +
+	"\fDemoMetadata\x12!\n" +
+	"\asong_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06songId\x12\x1e\n" +
+	"\x05title\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xc0>\x01R\x05title\x128\n" +
+	"\fcontent_type\x18\x03 \x01(\tB\x15\xbaH\x12r\x102\x0e(?i)^\\s*audio/R\vcontentType\"r\n" +
+	"\x11UploadDemoRequest\x12:\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x1c.musicclub.song.DemoMetadataH\x00R\bmetadata\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"u\n" +
+	"\x0eRoleDifficulty\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\x12\x18\n" +
+	"\aaverage\x18\x02 \x01(\x01R\aaverage\x12\x18\n" +
+	"\aratings\x18\x03 \x01(\x05R\aratings\x12\x1b\n" +
+	"\tmy_rating\x18\x04 \x01(\rR\bmyRating\"e\n" +
+	"\bSongLink\x12:\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x1c.musicclub.song.SongLinkTypeB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04kind\x12\x1d\n" +
+	"\x03url\x18\x02 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\x88\x01\x01R\x03url\"\x87\x01\n" +
 	"\x0eRoleAssignment\x12\x12\n" +
 	"\x04role\x18\x01 \x01(\tR\x04role\x12(\n" +
 	"\x04user\x18\x02 \x01(\v2\x14.musicclub.user.UserR\x04user\x127\n" +
-	"\tjoined_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinedAt\"\xdf\x01\n" +
-	"\x11CreateSongRequest\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\x12\x16\n" +
-	"\x06artist\x18\x02 \x01(\tR\x06artist\x12,\n" +
+	"\tjoined_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinedAt\"\xd1\x02\n" +
+	"\x11CreateSongRequest\x12\x1e\n" +
+	"\x05title\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xc0>\x01R\x05title\x12 \n" +
+	"\x06artist\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xc0>\x01R\x06artist\x12,\n" +
 	"\x04link\x18\x03 \x01(\v2\x18.musicclub.song.SongLinkR\x04link\x12 \n" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12'\n" +
-	"\x0favailable_roles\x18\x05 \x03(\tR\x0eavailableRoles\x12#\n" +
-	"\rthumbnail_url\x18\x06 \x01(\tR\fthumbnailUrl\"\xef\x01\n" +
-	"\x11UpdateSongRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x0favailable_roles\x18\x05 \x03(\tR\x0eavailableRoles\x120\n" +
+	"\rthumbnail_url\x18\x06 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\x88\x01\x01R\fthumbnailUrl\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\x12)\n" +
+	"\x10duration_seconds\x18\b \x01(\rR\x0fdurationSeconds\x12\x10\n" +
+	"\x03key\x18\t \x01(\tR\x03key\"\xf1\x05\n" +
+	"\x11UpdateSongRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
 	"\x06artist\x18\x03 \x01(\tR\x06artist\x12,\n" +
 	"\x04link\x18\x04 \x01(\v2\x18.musicclub.song.SongLinkR\x04link\x12 \n" +
 	"\vdescription\x18\x05 \x01(\tR\vdescription\x12'\n" +
-	"\x0favailable_roles\x18\x06 \x03(\tR\x0eavailableRoles\x12#\n" +
-	"\rthumbnail_url\x18\a \x01(\tR\fthumbnailUrl\">\n" +
-	"\x0fJoinRoleRequest\x12\x17\n" +
-	"\asong_id\x18\x01 \x01(\tR\x06songId\x12\x12\n" +
-	"\x04role\x18\x02 \x01(\tR\x04role\"?\n" +
-	"\x10LeaveRoleRequest\x12\x17\n" +
-	"\asong_id\x18\x01 \x01(\tR\x06songId\x12\x12\n" +
-	"\x04role\x18\x02 \x01(\tR\x04role*\x86\x01\n" +
+	"\x0favailable_roles\x18\x06 \x03(\tR\x0eavailableRoles\x120\n" +
+	"\rthumbnail_url\x18\a \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\x88\x01\x01R\fthumbnailUrl\x12;\n" +
+	"\vupdate_mask\x18\b \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x122\n" +
+	"\x10expected_version\x18\t \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x0fexpectedVersion\x12\x12\n" +
+	"\x04tags\x18\n" +
+	" \x03(\tR\x04tags\x12)\n" +
+	"\x10duration_seconds\x18\v \x01(\rR\x0fdurationSeconds\x12\x10\n" +
+	"\x03key\x18\f \x01(\tR\x03key:\xa6\x02\xbaH\xa2\x02\x1a\x8c\x01\n" +
+	"\x11update_song.title\x12\x11title is required\x1ad!(size(this.update_mask.paths) == 0 || 'title' in this.update_mask.paths) || this.title.trim() != ''\x1a\x90\x01\n" +
+	"\x12update_song.artist\x12\x12artist is required\x1af!(size(this.update_mask.paths) == 0 || 'artist' in this.update_mask.paths) || this.artist.trim() != ''\"Q\n" +
+	"\x16GetRelatedSongsRequest\x12!\n" +
+	"\asong_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06songId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\"L\n" +
+	"\x17GetRelatedSongsResponse\x121\n" +
+	"\x05songs\x18\x01 \x03(\v2\x1b.musicclub.song.RelatedSongR\x05songs\"\xa0\x01\n" +
+	"\vRelatedSong\x12(\n" +
+	"\x04song\x18\x01 \x01(\v2\x14.musicclub.song.SongR\x04song\x12\x1f\n" +
+	"\vsame_artist\x18\x02 \x01(\bR\n" +
+	"sameArtist\x12\x1f\n" +
+	"\vshared_tags\x18\x03 \x03(\tR\n" +
+	"sharedTags\x12%\n" +
+	"\x0eshared_members\x18\x04 \x01(\x05R\rsharedMembers\"O\n" +
+	"\x11MergeRolesRequest\x12\x1c\n" +
+	"\x04from\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xc0>\x01R\x04from\x12\x1c\n" +
+	"\x04into\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xc0>\x01R\x04into\"9\n" +
+	"\x12MergeRolesResponse\x12#\n" +
+	"\rsongs_updated\x18\x01 \x01(\x05R\fsongsUpdated\"y\n" +
+	"\x15RateDifficultyRequest\x12!\n" +
+	"\asong_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06songId\x12\x1c\n" +
+	"\x04role\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xc0>\x01R\x04role\x12\x1f\n" +
+	"\x06rating\x18\x03 \x01(\rB\a\xbaH\x04*\x02\x18\x05R\x06rating\"R\n" +
+	"\x0fJoinRoleRequest\x12!\n" +
+	"\asong_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06songId\x12\x1c\n" +
+	"\x04role\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xc0>\x01R\x04role\"S\n" +
+	"\x10LeaveRoleRequest\x12!\n" +
+	"\asong_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06songId\x12\x1c\n" +
+	"\x04role\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xc0>\x01R\x04role*\x86\x01\n" +
 	"\fSongLinkType\x12\x1a\n" +
 	"\x16SONG_LINK_TYPE_UNKNOWN\x10\x00\x12\x1a\n" +
 	"\x16SONG_LINK_TYPE_YOUTUBE\x10\x01\x12\x1f\n" +
 	"\x1bSONG_LINK_TYPE_YANDEX_MUSIC\x10\x02\x12\x1d\n" +
-	"\x19SONG_LINK_TYPE_SOUNDCLOUD\x10\x032\x8f\x04\n" +
+	"\x19SONG_LINK_TYPE_SOUNDCLOUD\x10\x032\x9a\v\n" +
 	"\vSongService\x12P\n" +
 	"\tListSongs\x12 .musicclub.song.ListSongsRequest\x1a!.musicclub.song.ListSongsResponse\x12>\n" +
-	"\aGetSong\x12\x16.musicclub.song.SongId\x1a\x1b.musicclub.song.SongDetails\x12L\n" +
+	"\aGetSong\x12\x16.musicclub.song.SongId\x1a\x1b.musicclub.song.SongDetails\x12\\\n" +
+	"\rBatchGetSongs\x12$.musicclub.song.BatchGetSongsRequest\x1a%.musicclub.song.BatchGetSongsResponse\x12L\n" +
 	"\n" +
 	"CreateSong\x12!.musicclub.song.CreateSongRequest\x1a\x1b.musicclub.song.SongDetails\x12L\n" +
 	"\n" +
@@ -870,7 +1892,21 @@ const file_song_proto_rawDesc = "" +
 	"\n" +
 	"DeleteSong\x12\x16.musicclub.song.SongId\x1a\x16.google.protobuf.Empty\x12H\n" +
 	"\bJoinRole\x12\x1f.musicclub.song.JoinRoleRequest\x1a\x1b.musicclub.song.SongDetails\x12J\n" +
-	"\tLeaveRole\x12 .musicclub.song.LeaveRoleRequest\x1a\x1b.musicclub.song.SongDetailsB\x1cZ\x1amusicclubbot/backend/protob\x06proto3"
+	"\tLeaveRole\x12 .musicclub.song.LeaveRoleRequest\x1a\x1b.musicclub.song.SongDetails\x12B\n" +
+	"\tWatchSong\x12\x16.musicclub.song.SongId\x1a\x1b.musicclub.song.SongDetails0\x01\x12C\n" +
+	"\fFavoriteSong\x12\x16.musicclub.song.SongId\x1a\x1b.musicclub.song.SongDetails\x12E\n" +
+	"\x0eUnfavoriteSong\x12\x16.musicclub.song.SongId\x1a\x1b.musicclub.song.SongDetails\x12>\n" +
+	"\aPinSong\x12\x16.musicclub.song.SongId\x1a\x1b.musicclub.song.SongDetails\x12@\n" +
+	"\tUnpinSong\x12\x16.musicclub.song.SongId\x1a\x1b.musicclub.song.SongDetails\x12b\n" +
+	"\x0fGetRelatedSongs\x12&.musicclub.song.GetRelatedSongsRequest\x1a'.musicclub.song.GetRelatedSongsResponse\x12S\n" +
+	"\n" +
+	"MergeRoles\x12!.musicclub.song.MergeRolesRequest\x1a\".musicclub.song.MergeRolesResponse\x12T\n" +
+	"\x0eRateDifficulty\x12%.musicclub.song.RateDifficultyRequest\x1a\x1b.musicclub.song.SongDetails\x12G\n" +
+	"\n" +
+	"UploadDemo\x12!.musicclub.song.UploadDemoRequest\x1a\x14.musicclub.song.Demo(\x01\x12<\n" +
+	"\n" +
+	"DeleteDemo\x12\x16.musicclub.song.DemoId\x1a\x16.google.protobuf.Empty\x12C\n" +
+	"\x11ForwardDemoToChat\x12\x16.musicclub.song.DemoId\x1a\x16.google.protobuf.EmptyB\x1cZ\x1amusicclubbot/backend/protob\x06proto3"
 
 var (
 	file_song_proto_rawDescOnce sync.Once
@@ -885,55 +1921,102 @@ func file_song_proto_rawDescGZIP() []byte {
 }
 
 var file_song_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_song_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_song_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_song_proto_goTypes = []any{
-	(SongLinkType)(0),             // 0: musicclub.song.SongLinkType
-	(*ListSongsRequest)(nil),      // 1: musicclub.song.ListSongsRequest
-	(*ListSongsResponse)(nil),     // 2: musicclub.song.ListSongsResponse
-	(*SongId)(nil),                // 3: musicclub.song.SongId
-	(*Song)(nil),                  // 4: musicclub.song.Song
-	(*SongDetails)(nil),           // 5: musicclub.song.SongDetails
-	(*SongLink)(nil),              // 6: musicclub.song.SongLink
-	(*RoleAssignment)(nil),        // 7: musicclub.song.RoleAssignment
-	(*CreateSongRequest)(nil),     // 8: musicclub.song.CreateSongRequest
-	(*UpdateSongRequest)(nil),     // 9: musicclub.song.UpdateSongRequest
-	(*JoinRoleRequest)(nil),       // 10: musicclub.song.JoinRoleRequest
-	(*LeaveRoleRequest)(nil),      // 11: musicclub.song.LeaveRoleRequest
-	(*PermissionSet)(nil),         // 12: musicclub.permissions.PermissionSet
-	(*User)(nil),                  // 13: musicclub.user.User
-	(*timestamppb.Timestamp)(nil), // 14: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 15: google.protobuf.Empty
+	(SongLinkType)(0),               // 0: musicclub.song.SongLinkType
+	(*ListSongsRequest)(nil),        // 1: musicclub.song.ListSongsRequest
+	(*ListSongsResponse)(nil),       // 2: musicclub.song.ListSongsResponse
+	(*SongId)(nil),                  // 3: musicclub.song.SongId
+	(*BatchGetSongsRequest)(nil),    // 4: musicclub.song.BatchGetSongsRequest
+	(*BatchGetSongsResponse)(nil),   // 5: musicclub.song.BatchGetSongsResponse
+	(*Song)(nil),                    // 6: musicclub.song.Song
+	(*SongDetails)(nil),             // 7: musicclub.song.SongDetails
+	(*Demo)(nil),                    // 8: musicclub.song.Demo
+	(*DemoId)(nil),                  // 9: musicclub.song.DemoId
+	(*DemoMetadata)(nil),            // 10: musicclub.song.DemoMetadata
+	(*UploadDemoRequest)(nil),       // 11: musicclub.song.UploadDemoRequest
+	(*RoleDifficulty)(nil),          // 12: musicclub.song.RoleDifficulty
+	(*SongLink)(nil),                // 13: musicclub.song.SongLink
+	(*RoleAssignment)(nil),          // 14: musicclub.song.RoleAssignment
+	(*CreateSongRequest)(nil),       // 15: musicclub.song.CreateSongRequest
+	(*UpdateSongRequest)(nil),       // 16: musicclub.song.UpdateSongRequest
+	(*GetRelatedSongsRequest)(nil),  // 17: musicclub.song.GetRelatedSongsRequest
+	(*GetRelatedSongsResponse)(nil), // 18: musicclub.song.GetRelatedSongsResponse
+	(*RelatedSong)(nil),             // 19: musicclub.song.RelatedSong
+	(*MergeRolesRequest)(nil),       // 20: musicclub.song.MergeRolesRequest
+	(*MergeRolesResponse)(nil),      // 21: musicclub.song.MergeRolesResponse
+	(*RateDifficultyRequest)(nil),   // 22: musicclub.song.RateDifficultyRequest
+	(*JoinRoleRequest)(nil),         // 23: musicclub.song.JoinRoleRequest
+	(*LeaveRoleRequest)(nil),        // 24: musicclub.song.LeaveRoleRequest
+	(*PermissionSet)(nil),           // 25: musicclub.permissions.PermissionSet
+	(*User)(nil),                    // 26: musicclub.user.User
+	(*timestamppb.Timestamp)(nil),   // 27: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),   // 28: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),           // 29: google.protobuf.Empty
 }
 var file_song_proto_depIdxs = []int32{
-	4,  // 0: musicclub.song.ListSongsResponse.songs:type_name -> musicclub.song.Song
-	6,  // 1: musicclub.song.Song.link:type_name -> musicclub.song.SongLink
-	4,  // 2: musicclub.song.SongDetails.song:type_name -> musicclub.song.Song
-	7,  // 3: musicclub.song.SongDetails.assignments:type_name -> musicclub.song.RoleAssignment
-	12, // 4: musicclub.song.SongDetails.permissions:type_name -> musicclub.permissions.PermissionSet
-	0,  // 5: musicclub.song.SongLink.kind:type_name -> musicclub.song.SongLinkType
-	13, // 6: musicclub.song.RoleAssignment.user:type_name -> musicclub.user.User
-	14, // 7: musicclub.song.RoleAssignment.joined_at:type_name -> google.protobuf.Timestamp
-	6,  // 8: musicclub.song.CreateSongRequest.link:type_name -> musicclub.song.SongLink
-	6,  // 9: musicclub.song.UpdateSongRequest.link:type_name -> musicclub.song.SongLink
-	1,  // 10: musicclub.song.SongService.ListSongs:input_type -> musicclub.song.ListSongsRequest
-	3,  // 11: musicclub.song.SongService.GetSong:input_type -> musicclub.song.SongId
-	8,  // 12: musicclub.song.SongService.CreateSong:input_type -> musicclub.song.CreateSongRequest
-	9,  // 13: musicclub.song.SongService.UpdateSong:input_type -> musicclub.song.UpdateSongRequest
-	3,  // 14: musicclub.song.SongService.DeleteSong:input_type -> musicclub.song.SongId
-	10, // 15: musicclub.song.SongService.JoinRole:input_type -> musicclub.song.JoinRoleRequest
-	11, // 16: musicclub.song.SongService.LeaveRole:input_type -> musicclub.song.LeaveRoleRequest
-	2,  // 17: musicclub.song.SongService.ListSongs:output_type -> musicclub.song.ListSongsResponse
-	5,  // 18: musicclub.song.SongService.GetSong:output_type -> musicclub.song.SongDetails
-	5,  // 19: musicclub.song.SongService.CreateSong:output_type -> musicclub.song.SongDetails
-	5,  // 20: musicclub.song.SongService.UpdateSong:output_type -> musicclub.song.SongDetails
-	15, // 21: musicclub.song.SongService.DeleteSong:output_type -> google.protobuf.Empty
-	5,  // 22: musicclub.song.SongService.JoinRole:output_type -> musicclub.song.SongDetails
-	5,  // 23: musicclub.song.SongService.LeaveRole:output_type -> musicclub.song.SongDetails
-	17, // [17:24] is the sub-list for method output_type
-	10, // [10:17] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	6,  // 0: musicclub.song.ListSongsResponse.songs:type_name -> musicclub.song.Song
+	7,  // 1: musicclub.song.BatchGetSongsResponse.songs:type_name -> musicclub.song.SongDetails
+	13, // 2: musicclub.song.Song.link:type_name -> musicclub.song.SongLink
+	6,  // 3: musicclub.song.SongDetails.song:type_name -> musicclub.song.Song
+	14, // 4: musicclub.song.SongDetails.assignments:type_name -> musicclub.song.RoleAssignment
+	25, // 5: musicclub.song.SongDetails.permissions:type_name -> musicclub.permissions.PermissionSet
+	12, // 6: musicclub.song.SongDetails.role_difficulty:type_name -> musicclub.song.RoleDifficulty
+	8,  // 7: musicclub.song.SongDetails.demos:type_name -> musicclub.song.Demo
+	26, // 8: musicclub.song.Demo.uploaded_by:type_name -> musicclub.user.User
+	27, // 9: musicclub.song.Demo.created_at:type_name -> google.protobuf.Timestamp
+	10, // 10: musicclub.song.UploadDemoRequest.metadata:type_name -> musicclub.song.DemoMetadata
+	0,  // 11: musicclub.song.SongLink.kind:type_name -> musicclub.song.SongLinkType
+	26, // 12: musicclub.song.RoleAssignment.user:type_name -> musicclub.user.User
+	27, // 13: musicclub.song.RoleAssignment.joined_at:type_name -> google.protobuf.Timestamp
+	13, // 14: musicclub.song.CreateSongRequest.link:type_name -> musicclub.song.SongLink
+	13, // 15: musicclub.song.UpdateSongRequest.link:type_name -> musicclub.song.SongLink
+	28, // 16: musicclub.song.UpdateSongRequest.update_mask:type_name -> google.protobuf.FieldMask
+	19, // 17: musicclub.song.GetRelatedSongsResponse.songs:type_name -> musicclub.song.RelatedSong
+	6,  // 18: musicclub.song.RelatedSong.song:type_name -> musicclub.song.Song
+	1,  // 19: musicclub.song.SongService.ListSongs:input_type -> musicclub.song.ListSongsRequest
+	3,  // 20: musicclub.song.SongService.GetSong:input_type -> musicclub.song.SongId
+	4,  // 21: musicclub.song.SongService.BatchGetSongs:input_type -> musicclub.song.BatchGetSongsRequest
+	15, // 22: musicclub.song.SongService.CreateSong:input_type -> musicclub.song.CreateSongRequest
+	16, // 23: musicclub.song.SongService.UpdateSong:input_type -> musicclub.song.UpdateSongRequest
+	3,  // 24: musicclub.song.SongService.DeleteSong:input_type -> musicclub.song.SongId
+	23, // 25: musicclub.song.SongService.JoinRole:input_type -> musicclub.song.JoinRoleRequest
+	24, // 26: musicclub.song.SongService.LeaveRole:input_type -> musicclub.song.LeaveRoleRequest
+	3,  // 27: musicclub.song.SongService.WatchSong:input_type -> musicclub.song.SongId
+	3,  // 28: musicclub.song.SongService.FavoriteSong:input_type -> musicclub.song.SongId
+	3,  // 29: musicclub.song.SongService.UnfavoriteSong:input_type -> musicclub.song.SongId
+	3,  // 30: musicclub.song.SongService.PinSong:input_type -> musicclub.song.SongId
+	3,  // 31: musicclub.song.SongService.UnpinSong:input_type -> musicclub.song.SongId
+	17, // 32: musicclub.song.SongService.GetRelatedSongs:input_type -> musicclub.song.GetRelatedSongsRequest
+	20, // 33: musicclub.song.SongService.MergeRoles:input_type -> musicclub.song.MergeRolesRequest
+	22, // 34: musicclub.song.SongService.RateDifficulty:input_type -> musicclub.song.RateDifficultyRequest
+	11, // 35: musicclub.song.SongService.UploadDemo:input_type -> musicclub.song.UploadDemoRequest
+	9,  // 36: musicclub.song.SongService.DeleteDemo:input_type -> musicclub.song.DemoId
+	9,  // 37: musicclub.song.SongService.ForwardDemoToChat:input_type -> musicclub.song.DemoId
+	2,  // 38: musicclub.song.SongService.ListSongs:output_type -> musicclub.song.ListSongsResponse
+	7,  // 39: musicclub.song.SongService.GetSong:output_type -> musicclub.song.SongDetails
+	5,  // 40: musicclub.song.SongService.BatchGetSongs:output_type -> musicclub.song.BatchGetSongsResponse
+	7,  // 41: musicclub.song.SongService.CreateSong:output_type -> musicclub.song.SongDetails
+	7,  // 42: musicclub.song.SongService.UpdateSong:output_type -> musicclub.song.SongDetails
+	29, // 43: musicclub.song.SongService.DeleteSong:output_type -> google.protobuf.Empty
+	7,  // 44: musicclub.song.SongService.JoinRole:output_type -> musicclub.song.SongDetails
+	7,  // 45: musicclub.song.SongService.LeaveRole:output_type -> musicclub.song.SongDetails
+	7,  // 46: musicclub.song.SongService.WatchSong:output_type -> musicclub.song.SongDetails
+	7,  // 47: musicclub.song.SongService.FavoriteSong:output_type -> musicclub.song.SongDetails
+	7,  // 48: musicclub.song.SongService.UnfavoriteSong:output_type -> musicclub.song.SongDetails
+	7,  // 49: musicclub.song.SongService.PinSong:output_type -> musicclub.song.SongDetails
+	7,  // 50: musicclub.song.SongService.UnpinSong:output_type -> musicclub.song.SongDetails
+	18, // 51: musicclub.song.SongService.GetRelatedSongs:output_type -> musicclub.song.GetRelatedSongsResponse
+	21, // 52: musicclub.song.SongService.MergeRoles:output_type -> musicclub.song.MergeRolesResponse
+	7,  // 53: musicclub.song.SongService.RateDifficulty:output_type -> musicclub.song.SongDetails
+	8,  // 54: musicclub.song.SongService.UploadDemo:output_type -> musicclub.song.Demo
+	29, // 55: musicclub.song.SongService.DeleteDemo:output_type -> google.protobuf.Empty
+	29, // 56: musicclub.song.SongService.ForwardDemoToChat:output_type -> google.protobuf.Empty
+	38, // [38:57] is the sub-list for method output_type
+	19, // [19:38] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_song_proto_init() }
@@ -943,13 +2026,18 @@ func file_song_proto_init() {
 	}
 	file_user_proto_init()
 	file_permissions_proto_init()
+	file_validation_proto_init()
+	file_song_proto_msgTypes[10].OneofWrappers = []any{
+		(*UploadDemoRequest_Metadata)(nil),
+		(*UploadDemoRequest_Chunk)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_song_proto_rawDesc), len(file_song_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   11,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
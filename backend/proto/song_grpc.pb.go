@@ -20,13 +20,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SongService_ListSongs_FullMethodName  = "/musicclub.song.SongService/ListSongs"
-	SongService_GetSong_FullMethodName    = "/musicclub.song.SongService/GetSong"
-	SongService_CreateSong_FullMethodName = "/musicclub.song.SongService/CreateSong"
-	SongService_UpdateSong_FullMethodName = "/musicclub.song.SongService/UpdateSong"
-	SongService_DeleteSong_FullMethodName = "/musicclub.song.SongService/DeleteSong"
-	SongService_JoinRole_FullMethodName   = "/musicclub.song.SongService/JoinRole"
-	SongService_LeaveRole_FullMethodName  = "/musicclub.song.SongService/LeaveRole"
+	SongService_ListSongs_FullMethodName         = "/musicclub.song.SongService/ListSongs"
+	SongService_GetSong_FullMethodName           = "/musicclub.song.SongService/GetSong"
+	SongService_BatchGetSongs_FullMethodName     = "/musicclub.song.SongService/BatchGetSongs"
+	SongService_CreateSong_FullMethodName        = "/musicclub.song.SongService/CreateSong"
+	SongService_UpdateSong_FullMethodName        = "/musicclub.song.SongService/UpdateSong"
+	SongService_DeleteSong_FullMethodName        = "/musicclub.song.SongService/DeleteSong"
+	SongService_JoinRole_FullMethodName          = "/musicclub.song.SongService/JoinRole"
+	SongService_LeaveRole_FullMethodName         = "/musicclub.song.SongService/LeaveRole"
+	SongService_WatchSong_FullMethodName         = "/musicclub.song.SongService/WatchSong"
+	SongService_FavoriteSong_FullMethodName      = "/musicclub.song.SongService/FavoriteSong"
+	SongService_UnfavoriteSong_FullMethodName    = "/musicclub.song.SongService/UnfavoriteSong"
+	SongService_PinSong_FullMethodName           = "/musicclub.song.SongService/PinSong"
+	SongService_UnpinSong_FullMethodName         = "/musicclub.song.SongService/UnpinSong"
+	SongService_GetRelatedSongs_FullMethodName   = "/musicclub.song.SongService/GetRelatedSongs"
+	SongService_MergeRoles_FullMethodName        = "/musicclub.song.SongService/MergeRoles"
+	SongService_RateDifficulty_FullMethodName    = "/musicclub.song.SongService/RateDifficulty"
+	SongService_UploadDemo_FullMethodName        = "/musicclub.song.SongService/UploadDemo"
+	SongService_DeleteDemo_FullMethodName        = "/musicclub.song.SongService/DeleteDemo"
+	SongService_ForwardDemoToChat_FullMethodName = "/musicclub.song.SongService/ForwardDemoToChat"
 )
 
 // SongServiceClient is the client API for SongService service.
@@ -39,6 +51,8 @@ type SongServiceClient interface {
 	ListSongs(ctx context.Context, in *ListSongsRequest, opts ...grpc.CallOption) (*ListSongsResponse, error)
 	// Returns a single song with full metadata and assignments.
 	GetSong(ctx context.Context, in *SongId, opts ...grpc.CallOption) (*SongDetails, error)
+	// Returns several songs with full metadata and assignments in one call.
+	BatchGetSongs(ctx context.Context, in *BatchGetSongsRequest, opts ...grpc.CallOption) (*BatchGetSongsResponse, error)
 	// Create songs (requires permissions).
 	CreateSong(ctx context.Context, in *CreateSongRequest, opts ...grpc.CallOption) (*SongDetails, error)
 	// Update songs (requires permissions).
@@ -49,6 +63,32 @@ type SongServiceClient interface {
 	JoinRole(ctx context.Context, in *JoinRoleRequest, opts ...grpc.CallOption) (*SongDetails, error)
 	// Leave a role for a song.
 	LeaveRole(ctx context.Context, in *LeaveRoleRequest, opts ...grpc.CallOption) (*SongDetails, error)
+	// Streams song details: the current state first, then again on every
+	// change of the song, its roles or assignments.
+	WatchSong(ctx context.Context, in *SongId, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SongDetails], error)
+	// Add a song to the current user's favorites.
+	FavoriteSong(ctx context.Context, in *SongId, opts ...grpc.CallOption) (*SongDetails, error)
+	// Remove a song from the current user's favorites.
+	UnfavoriteSong(ctx context.Context, in *SongId, opts ...grpc.CallOption) (*SongDetails, error)
+	// Pin a song to the top of the catalog (requires edit_events).
+	PinSong(ctx context.Context, in *SongId, opts ...grpc.CallOption) (*SongDetails, error)
+	// Unpin a song (requires edit_events).
+	UnpinSong(ctx context.Context, in *SongId, opts ...grpc.CallOption) (*SongDetails, error)
+	// Suggests songs by the same artist, with shared tags or shared lineups.
+	GetRelatedSongs(ctx context.Context, in *GetRelatedSongsRequest, opts ...grpc.CallOption) (*GetRelatedSongsResponse, error)
+	// Folds one role spelling into another across songs, difficulty ratings,
+	// participants and the roles events require, and remembers it as an alias
+	// (requires edit_any_songs).
+	MergeRoles(ctx context.Context, in *MergeRolesRequest, opts ...grpc.CallOption) (*MergeRolesResponse, error)
+	// Rate how hard a song is on your instrument (only for role participants).
+	RateDifficulty(ctx context.Context, in *RateDifficultyRequest, opts ...grpc.CallOption) (*SongDetails, error)
+	// Upload a short demo recording. The first message carries metadata, the
+	// rest carry audio chunks (song editors and participants only).
+	UploadDemo(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadDemoRequest, Demo], error)
+	// Delete a demo recording (uploader or song editors).
+	DeleteDemo(ctx context.Context, in *DemoId, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Post a demo recording to the club Telegram chat.
+	ForwardDemoToChat(ctx context.Context, in *DemoId, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type songServiceClient struct {
@@ -79,6 +119,16 @@ func (c *songServiceClient) GetSong(ctx context.Context, in *SongId, opts ...grp
 	return out, nil
 }
 
+func (c *songServiceClient) BatchGetSongs(ctx context.Context, in *BatchGetSongsRequest, opts ...grpc.CallOption) (*BatchGetSongsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetSongsResponse)
+	err := c.cc.Invoke(ctx, SongService_BatchGetSongs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *songServiceClient) CreateSong(ctx context.Context, in *CreateSongRequest, opts ...grpc.CallOption) (*SongDetails, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SongDetails)
@@ -129,6 +179,128 @@ func (c *songServiceClient) LeaveRole(ctx context.Context, in *LeaveRoleRequest,
 	return out, nil
 }
 
+func (c *songServiceClient) WatchSong(ctx context.Context, in *SongId, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SongDetails], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SongService_ServiceDesc.Streams[0], SongService_WatchSong_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SongId, SongDetails]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SongService_WatchSongClient = grpc.ServerStreamingClient[SongDetails]
+
+func (c *songServiceClient) FavoriteSong(ctx context.Context, in *SongId, opts ...grpc.CallOption) (*SongDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SongDetails)
+	err := c.cc.Invoke(ctx, SongService_FavoriteSong_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) UnfavoriteSong(ctx context.Context, in *SongId, opts ...grpc.CallOption) (*SongDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SongDetails)
+	err := c.cc.Invoke(ctx, SongService_UnfavoriteSong_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) PinSong(ctx context.Context, in *SongId, opts ...grpc.CallOption) (*SongDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SongDetails)
+	err := c.cc.Invoke(ctx, SongService_PinSong_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) UnpinSong(ctx context.Context, in *SongId, opts ...grpc.CallOption) (*SongDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SongDetails)
+	err := c.cc.Invoke(ctx, SongService_UnpinSong_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) GetRelatedSongs(ctx context.Context, in *GetRelatedSongsRequest, opts ...grpc.CallOption) (*GetRelatedSongsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRelatedSongsResponse)
+	err := c.cc.Invoke(ctx, SongService_GetRelatedSongs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) MergeRoles(ctx context.Context, in *MergeRolesRequest, opts ...grpc.CallOption) (*MergeRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeRolesResponse)
+	err := c.cc.Invoke(ctx, SongService_MergeRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) RateDifficulty(ctx context.Context, in *RateDifficultyRequest, opts ...grpc.CallOption) (*SongDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SongDetails)
+	err := c.cc.Invoke(ctx, SongService_RateDifficulty_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) UploadDemo(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadDemoRequest, Demo], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SongService_ServiceDesc.Streams[1], SongService_UploadDemo_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadDemoRequest, Demo]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SongService_UploadDemoClient = grpc.ClientStreamingClient[UploadDemoRequest, Demo]
+
+func (c *songServiceClient) DeleteDemo(ctx context.Context, in *DemoId, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SongService_DeleteDemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) ForwardDemoToChat(ctx context.Context, in *DemoId, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SongService_ForwardDemoToChat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SongServiceServer is the server API for SongService service.
 // All implementations must embed UnimplementedSongServiceServer
 // for forward compatibility.
@@ -139,6 +311,8 @@ type SongServiceServer interface {
 	ListSongs(context.Context, *ListSongsRequest) (*ListSongsResponse, error)
 	// Returns a single song with full metadata and assignments.
 	GetSong(context.Context, *SongId) (*SongDetails, error)
+	// Returns several songs with full metadata and assignments in one call.
+	BatchGetSongs(context.Context, *BatchGetSongsRequest) (*BatchGetSongsResponse, error)
 	// Create songs (requires permissions).
 	CreateSong(context.Context, *CreateSongRequest) (*SongDetails, error)
 	// Update songs (requires permissions).
@@ -149,6 +323,32 @@ type SongServiceServer interface {
 	JoinRole(context.Context, *JoinRoleRequest) (*SongDetails, error)
 	// Leave a role for a song.
 	LeaveRole(context.Context, *LeaveRoleRequest) (*SongDetails, error)
+	// Streams song details: the current state first, then again on every
+	// change of the song, its roles or assignments.
+	WatchSong(*SongId, grpc.ServerStreamingServer[SongDetails]) error
+	// Add a song to the current user's favorites.
+	FavoriteSong(context.Context, *SongId) (*SongDetails, error)
+	// Remove a song from the current user's favorites.
+	UnfavoriteSong(context.Context, *SongId) (*SongDetails, error)
+	// Pin a song to the top of the catalog (requires edit_events).
+	PinSong(context.Context, *SongId) (*SongDetails, error)
+	// Unpin a song (requires edit_events).
+	UnpinSong(context.Context, *SongId) (*SongDetails, error)
+	// Suggests songs by the same artist, with shared tags or shared lineups.
+	GetRelatedSongs(context.Context, *GetRelatedSongsRequest) (*GetRelatedSongsResponse, error)
+	// Folds one role spelling into another across songs, difficulty ratings,
+	// participants and the roles events require, and remembers it as an alias
+	// (requires edit_any_songs).
+	MergeRoles(context.Context, *MergeRolesRequest) (*MergeRolesResponse, error)
+	// Rate how hard a song is on your instrument (only for role participants).
+	RateDifficulty(context.Context, *RateDifficultyRequest) (*SongDetails, error)
+	// Upload a short demo recording. The first message carries metadata, the
+	// rest carry audio chunks (song editors and participants only).
+	UploadDemo(grpc.ClientStreamingServer[UploadDemoRequest, Demo]) error
+	// Delete a demo recording (uploader or song editors).
+	DeleteDemo(context.Context, *DemoId) (*emptypb.Empty, error)
+	// Post a demo recording to the club Telegram chat.
+	ForwardDemoToChat(context.Context, *DemoId) (*emptypb.Empty, error)
 	mustEmbedUnimplementedSongServiceServer()
 }
 
@@ -165,6 +365,9 @@ func (UnimplementedSongServiceServer) ListSongs(context.Context, *ListSongsReque
 func (UnimplementedSongServiceServer) GetSong(context.Context, *SongId) (*SongDetails, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetSong not implemented")
 }
+func (UnimplementedSongServiceServer) BatchGetSongs(context.Context, *BatchGetSongsRequest) (*BatchGetSongsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchGetSongs not implemented")
+}
 func (UnimplementedSongServiceServer) CreateSong(context.Context, *CreateSongRequest) (*SongDetails, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateSong not implemented")
 }
@@ -180,6 +383,39 @@ func (UnimplementedSongServiceServer) JoinRole(context.Context, *JoinRoleRequest
 func (UnimplementedSongServiceServer) LeaveRole(context.Context, *LeaveRoleRequest) (*SongDetails, error) {
 	return nil, status.Error(codes.Unimplemented, "method LeaveRole not implemented")
 }
+func (UnimplementedSongServiceServer) WatchSong(*SongId, grpc.ServerStreamingServer[SongDetails]) error {
+	return status.Error(codes.Unimplemented, "method WatchSong not implemented")
+}
+func (UnimplementedSongServiceServer) FavoriteSong(context.Context, *SongId) (*SongDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method FavoriteSong not implemented")
+}
+func (UnimplementedSongServiceServer) UnfavoriteSong(context.Context, *SongId) (*SongDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnfavoriteSong not implemented")
+}
+func (UnimplementedSongServiceServer) PinSong(context.Context, *SongId) (*SongDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method PinSong not implemented")
+}
+func (UnimplementedSongServiceServer) UnpinSong(context.Context, *SongId) (*SongDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnpinSong not implemented")
+}
+func (UnimplementedSongServiceServer) GetRelatedSongs(context.Context, *GetRelatedSongsRequest) (*GetRelatedSongsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRelatedSongs not implemented")
+}
+func (UnimplementedSongServiceServer) MergeRoles(context.Context, *MergeRolesRequest) (*MergeRolesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MergeRoles not implemented")
+}
+func (UnimplementedSongServiceServer) RateDifficulty(context.Context, *RateDifficultyRequest) (*SongDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method RateDifficulty not implemented")
+}
+func (UnimplementedSongServiceServer) UploadDemo(grpc.ClientStreamingServer[UploadDemoRequest, Demo]) error {
+	return status.Error(codes.Unimplemented, "method UploadDemo not implemented")
+}
+func (UnimplementedSongServiceServer) DeleteDemo(context.Context, *DemoId) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteDemo not implemented")
+}
+func (UnimplementedSongServiceServer) ForwardDemoToChat(context.Context, *DemoId) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ForwardDemoToChat not implemented")
+}
 func (UnimplementedSongServiceServer) mustEmbedUnimplementedSongServiceServer() {}
 func (UnimplementedSongServiceServer) testEmbeddedByValue()                     {}
 
@@ -237,6 +473,24 @@ func _SongService_GetSong_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SongService_BatchGetSongs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetSongsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).BatchGetSongs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_BatchGetSongs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).BatchGetSongs(ctx, req.(*BatchGetSongsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SongService_CreateSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateSongRequest)
 	if err := dec(in); err != nil {
@@ -327,6 +581,186 @@ func _SongService_LeaveRole_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SongService_WatchSong_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SongId)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SongServiceServer).WatchSong(m, &grpc.GenericServerStream[SongId, SongDetails]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SongService_WatchSongServer = grpc.ServerStreamingServer[SongDetails]
+
+func _SongService_FavoriteSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SongId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).FavoriteSong(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_FavoriteSong_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).FavoriteSong(ctx, req.(*SongId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_UnfavoriteSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SongId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).UnfavoriteSong(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_UnfavoriteSong_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).UnfavoriteSong(ctx, req.(*SongId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_PinSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SongId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).PinSong(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_PinSong_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).PinSong(ctx, req.(*SongId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_UnpinSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SongId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).UnpinSong(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_UnpinSong_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).UnpinSong(ctx, req.(*SongId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_GetRelatedSongs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelatedSongsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).GetRelatedSongs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_GetRelatedSongs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).GetRelatedSongs(ctx, req.(*GetRelatedSongsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_MergeRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).MergeRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_MergeRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).MergeRoles(ctx, req.(*MergeRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_RateDifficulty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateDifficultyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).RateDifficulty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_RateDifficulty_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).RateDifficulty(ctx, req.(*RateDifficultyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_UploadDemo_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SongServiceServer).UploadDemo(&grpc.GenericServerStream[UploadDemoRequest, Demo]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SongService_UploadDemoServer = grpc.ClientStreamingServer[UploadDemoRequest, Demo]
+
+func _SongService_DeleteDemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DemoId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).DeleteDemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_DeleteDemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).DeleteDemo(ctx, req.(*DemoId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_ForwardDemoToChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DemoId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).ForwardDemoToChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_ForwardDemoToChat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).ForwardDemoToChat(ctx, req.(*DemoId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SongService_ServiceDesc is the grpc.ServiceDesc for SongService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -342,6 +776,10 @@ var SongService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSong",
 			Handler:    _SongService_GetSong_Handler,
 		},
+		{
+			MethodName: "BatchGetSongs",
+			Handler:    _SongService_BatchGetSongs_Handler,
+		},
 		{
 			MethodName: "CreateSong",
 			Handler:    _SongService_CreateSong_Handler,
@@ -362,7 +800,54 @@ var SongService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LeaveRole",
 			Handler:    _SongService_LeaveRole_Handler,
 		},
+		{
+			MethodName: "FavoriteSong",
+			Handler:    _SongService_FavoriteSong_Handler,
+		},
+		{
+			MethodName: "UnfavoriteSong",
+			Handler:    _SongService_UnfavoriteSong_Handler,
+		},
+		{
+			MethodName: "PinSong",
+			Handler:    _SongService_PinSong_Handler,
+		},
+		{
+			MethodName: "UnpinSong",
+			Handler:    _SongService_UnpinSong_Handler,
+		},
+		{
+			MethodName: "GetRelatedSongs",
+			Handler:    _SongService_GetRelatedSongs_Handler,
+		},
+		{
+			MethodName: "MergeRoles",
+			Handler:    _SongService_MergeRoles_Handler,
+		},
+		{
+			MethodName: "RateDifficulty",
+			Handler:    _SongService_RateDifficulty_Handler,
+		},
+		{
+			MethodName: "DeleteDemo",
+			Handler:    _SongService_DeleteDemo_Handler,
+		},
+		{
+			MethodName: "ForwardDemoToChat",
+			Handler:    _SongService_ForwardDemoToChat_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchSong",
+			Handler:       _SongService_WatchSong_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadDemo",
+			Handler:       _SongService_UploadDemo_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "song.proto",
 }
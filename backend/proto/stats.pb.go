@@ -0,0 +1,1230 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: stats.proto
+
+package proto
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LeaderboardMetric int32
+
+const (
+	// All three metrics added up.
+	LeaderboardMetric_LEADERBOARD_METRIC_UNSPECIFIED     LeaderboardMetric = 0
+	LeaderboardMetric_LEADERBOARD_METRIC_SONGS_JOINED    LeaderboardMetric = 1
+	LeaderboardMetric_LEADERBOARD_METRIC_EVENTS_ATTENDED LeaderboardMetric = 2
+	LeaderboardMetric_LEADERBOARD_METRIC_SONGS_PROPOSED  LeaderboardMetric = 3
+)
+
+// Enum value maps for LeaderboardMetric.
+var (
+	LeaderboardMetric_name = map[int32]string{
+		0: "LEADERBOARD_METRIC_UNSPECIFIED",
+		1: "LEADERBOARD_METRIC_SONGS_JOINED",
+		2: "LEADERBOARD_METRIC_EVENTS_ATTENDED",
+		3: "LEADERBOARD_METRIC_SONGS_PROPOSED",
+	}
+	LeaderboardMetric_value = map[string]int32{
+		"LEADERBOARD_METRIC_UNSPECIFIED":     0,
+		"LEADERBOARD_METRIC_SONGS_JOINED":    1,
+		"LEADERBOARD_METRIC_EVENTS_ATTENDED": 2,
+		"LEADERBOARD_METRIC_SONGS_PROPOSED":  3,
+	}
+)
+
+func (x LeaderboardMetric) Enum() *LeaderboardMetric {
+	p := new(LeaderboardMetric)
+	*p = x
+	return p
+}
+
+func (x LeaderboardMetric) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeaderboardMetric) Descriptor() protoreflect.EnumDescriptor {
+	return file_stats_proto_enumTypes[0].Descriptor()
+}
+
+func (LeaderboardMetric) Type() protoreflect.EnumType {
+	return &file_stats_proto_enumTypes[0]
+}
+
+func (x LeaderboardMetric) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeaderboardMetric.Descriptor instead.
+func (LeaderboardMetric) EnumDescriptor() ([]byte, []int) {
+	return file_stats_proto_rawDescGZIP(), []int{0}
+}
+
+type SongStatsSort int32
+
+const (
+	// Most performed first.
+	SongStatsSort_SONG_STATS_SORT_UNSPECIFIED  SongStatsSort = 0
+	SongStatsSort_SONG_STATS_SORT_VOTES        SongStatsSort = 1
+	SongStatsSort_SONG_STATS_SORT_PARTICIPANTS SongStatsSort = 2
+	// Most recently performed first, never performed last.
+	SongStatsSort_SONG_STATS_SORT_LAST_PERFORMED SongStatsSort = 3
+	// Longest rested first, never performed first of all.
+	SongStatsSort_SONG_STATS_SORT_LEAST_RECENT SongStatsSort = 4
+)
+
+// Enum value maps for SongStatsSort.
+var (
+	SongStatsSort_name = map[int32]string{
+		0: "SONG_STATS_SORT_UNSPECIFIED",
+		1: "SONG_STATS_SORT_VOTES",
+		2: "SONG_STATS_SORT_PARTICIPANTS",
+		3: "SONG_STATS_SORT_LAST_PERFORMED",
+		4: "SONG_STATS_SORT_LEAST_RECENT",
+	}
+	SongStatsSort_value = map[string]int32{
+		"SONG_STATS_SORT_UNSPECIFIED":    0,
+		"SONG_STATS_SORT_VOTES":          1,
+		"SONG_STATS_SORT_PARTICIPANTS":   2,
+		"SONG_STATS_SORT_LAST_PERFORMED": 3,
+		"SONG_STATS_SORT_LEAST_RECENT":   4,
+	}
+)
+
+func (x SongStatsSort) Enum() *SongStatsSort {
+	p := new(SongStatsSort)
+	*p = x
+	return p
+}
+
+func (x SongStatsSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SongStatsSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_stats_proto_enumTypes[1].Descriptor()
+}
+
+func (SongStatsSort) Type() protoreflect.EnumType {
+	return &file_stats_proto_enumTypes[1]
+}
+
+func (x SongStatsSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SongStatsSort.Descriptor instead.
+func (SongStatsSort) EnumDescriptor() ([]byte, []int) {
+	return file_stats_proto_rawDescGZIP(), []int{1}
+}
+
+type GetLeaderboardRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Range start; unset counts from the beginning. Ranges are counted in
+	// whole UTC days.
+	From *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// Range end (exclusive); unset counts up to now.
+	To *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// Takes the range from the season instead of from/to.
+	SeasonId string            `protobuf:"bytes,3,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	Metric   LeaderboardMetric `protobuf:"varint,4,opt,name=metric,proto3,enum=musicclub.stats.LeaderboardMetric" json:"metric,omitempty"`
+	// Defaults to 10; larger values are capped at 100.
+	Limit         uint32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeaderboardRequest) Reset() {
+	*x = GetLeaderboardRequest{}
+	mi := &file_stats_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stats_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_stats_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetLeaderboardRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetLeaderboardRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetLeaderboardRequest) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *GetLeaderboardRequest) GetMetric() LeaderboardMetric {
+	if x != nil {
+		return x.Metric
+	}
+	return LeaderboardMetric_LEADERBOARD_METRIC_UNSPECIFIED
+}
+
+func (x *GetLeaderboardRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type LeaderboardEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 1-based; members with equal scores share a rank.
+	Rank uint32 `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	User *User  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// Distinct songs the member took a role in.
+	SongsJoined int32 `protobuf:"varint,3,opt,name=songs_joined,json=songsJoined,proto3" json:"songs_joined,omitempty"`
+	// Past events the member performed at or checked in to.
+	EventsAttended int32 `protobuf:"varint,4,opt,name=events_attended,json=eventsAttended,proto3" json:"events_attended,omitempty"`
+	// Songs the member added to the catalog.
+	SongsProposed int32 `protobuf:"varint,5,opt,name=songs_proposed,json=songsProposed,proto3" json:"songs_proposed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaderboardEntry) Reset() {
+	*x = LeaderboardEntry{}
+	mi := &file_stats_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardEntry) ProtoMessage() {}
+
+func (x *LeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_stats_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_stats_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LeaderboardEntry) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *LeaderboardEntry) GetSongsJoined() int32 {
+	if x != nil {
+		return x.SongsJoined
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetEventsAttended() int32 {
+	if x != nil {
+		return x.EventsAttended
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetSongsProposed() int32 {
+	if x != nil {
+		return x.SongsProposed
+	}
+	return 0
+}
+
+type Leaderboard struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*LeaderboardEntry    `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Leaderboard) Reset() {
+	*x = Leaderboard{}
+	mi := &file_stats_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Leaderboard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Leaderboard) ProtoMessage() {}
+
+func (x *Leaderboard) ProtoReflect() protoreflect.Message {
+	mi := &file_stats_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Leaderboard.ProtoReflect.Descriptor instead.
+func (*Leaderboard) Descriptor() ([]byte, []int) {
+	return file_stats_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Leaderboard) GetEntries() []*LeaderboardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *Leaderboard) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *Leaderboard) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type ListSongStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Limit times_performed and unique_participants to a range, as in
+	// GetLeaderboardRequest. Votes and last performance are all-time.
+	From     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	SeasonId string                 `protobuf:"bytes,3,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	Sort     SongStatsSort          `protobuf:"varint,4,opt,name=sort,proto3,enum=musicclub.stats.SongStatsSort" json:"sort,omitempty"`
+	// Pagination cursor (opaque to client).
+	PageToken     string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize      uint32 `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSongStatsRequest) Reset() {
+	*x = ListSongStatsRequest{}
+	mi := &file_stats_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSongStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSongStatsRequest) ProtoMessage() {}
+
+func (x *ListSongStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stats_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSongStatsRequest.ProtoReflect.Descriptor instead.
+func (*ListSongStatsRequest) Descriptor() ([]byte, []int) {
+	return file_stats_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListSongStatsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListSongStatsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListSongStatsRequest) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *ListSongStatsRequest) GetSort() SongStatsSort {
+	if x != nil {
+		return x.Sort
+	}
+	return SongStatsSort_SONG_STATS_SORT_UNSPECIFIED
+}
+
+func (x *ListSongStatsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListSongStatsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type SongStat struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	SongId string                 `protobuf:"bytes,1,opt,name=song_id,json=songId,proto3" json:"song_id,omitempty"`
+	Title  string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Artist string                 `protobuf:"bytes,3,opt,name=artist,proto3" json:"artist,omitempty"`
+	// Tracklist items marked as performed.
+	TimesPerformed int32 `protobuf:"varint,4,opt,name=times_performed,json=timesPerformed,proto3" json:"times_performed,omitempty"`
+	// Distinct members who played it in those performances.
+	UniqueParticipants int32 `protobuf:"varint,5,opt,name=unique_participants,json=uniqueParticipants,proto3" json:"unique_participants,omitempty"`
+	// Members who favorited the song.
+	Votes int32 `protobuf:"varint,6,opt,name=votes,proto3" json:"votes,omitempty"`
+	// Unset if the song was never performed.
+	LastPerformedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_performed_at,json=lastPerformedAt,proto3" json:"last_performed_at,omitempty"`
+	// Whole days since last_performed_at; 0 when never performed.
+	DaysSinceLastPerformed int32 `protobuf:"varint,8,opt,name=days_since_last_performed,json=daysSinceLastPerformed,proto3" json:"days_since_last_performed,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *SongStat) Reset() {
+	*x = SongStat{}
+	mi := &file_stats_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SongStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SongStat) ProtoMessage() {}
+
+func (x *SongStat) ProtoReflect() protoreflect.Message {
+	mi := &file_stats_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SongStat.ProtoReflect.Descriptor instead.
+func (*SongStat) Descriptor() ([]byte, []int) {
+	return file_stats_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SongStat) GetSongId() string {
+	if x != nil {
+		return x.SongId
+	}
+	return ""
+}
+
+func (x *SongStat) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SongStat) GetArtist() string {
+	if x != nil {
+		return x.Artist
+	}
+	return ""
+}
+
+func (x *SongStat) GetTimesPerformed() int32 {
+	if x != nil {
+		return x.TimesPerformed
+	}
+	return 0
+}
+
+func (x *SongStat) GetUniqueParticipants() int32 {
+	if x != nil {
+		return x.UniqueParticipants
+	}
+	return 0
+}
+
+func (x *SongStat) GetVotes() int32 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+func (x *SongStat) GetLastPerformedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastPerformedAt
+	}
+	return nil
+}
+
+func (x *SongStat) GetDaysSinceLastPerformed() int32 {
+	if x != nil {
+		return x.DaysSinceLastPerformed
+	}
+	return 0
+}
+
+type ListSongStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Songs         []*SongStat            `protobuf:"bytes,1,rep,name=songs,proto3" json:"songs,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSongStatsResponse) Reset() {
+	*x = ListSongStatsResponse{}
+	mi := &file_stats_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSongStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSongStatsResponse) ProtoMessage() {}
+
+func (x *ListSongStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stats_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSongStatsResponse.ProtoReflect.Descriptor instead.
+func (*ListSongStatsResponse) Descriptor() ([]byte, []int) {
+	return file_stats_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListSongStatsResponse) GetSongs() []*SongStat {
+	if x != nil {
+		return x.Songs
+	}
+	return nil
+}
+
+func (x *ListSongStatsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListSongStatsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type GetAttendanceStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Range of event start times, as in GetLeaderboardRequest.
+	From          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	SeasonId      string                 `protobuf:"bytes,3,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceStatsRequest) Reset() {
+	*x = GetAttendanceStatsRequest{}
+	mi := &file_stats_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceStatsRequest) ProtoMessage() {}
+
+func (x *GetAttendanceStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stats_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttendanceStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetAttendanceStatsRequest) Descriptor() ([]byte, []int) {
+	return file_stats_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetAttendanceStatsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetAttendanceStatsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetAttendanceStatsRequest) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+type EventAttendance struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Title   string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	StartAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	// Final RSVP answers.
+	Going      int32 `protobuf:"varint,4,opt,name=going,proto3" json:"going,omitempty"`
+	Maybe      int32 `protobuf:"varint,5,opt,name=maybe,proto3" json:"maybe,omitempty"`
+	Declined   int32 `protobuf:"varint,6,opt,name=declined,proto3" json:"declined,omitempty"`
+	Waitlisted int32 `protobuf:"varint,7,opt,name=waitlisted,proto3" json:"waitlisted,omitempty"`
+	CheckedIn  int32 `protobuf:"varint,8,opt,name=checked_in,json=checkedIn,proto3" json:"checked_in,omitempty"`
+	// Answered going but never checked in.
+	NoShows int32 `protobuf:"varint,9,opt,name=no_shows,json=noShows,proto3" json:"no_shows,omitempty"`
+	// Checked in without answering going.
+	WalkIns       int32 `protobuf:"varint,10,opt,name=walk_ins,json=walkIns,proto3" json:"walk_ins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventAttendance) Reset() {
+	*x = EventAttendance{}
+	mi := &file_stats_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventAttendance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventAttendance) ProtoMessage() {}
+
+func (x *EventAttendance) ProtoReflect() protoreflect.Message {
+	mi := &file_stats_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventAttendance.ProtoReflect.Descriptor instead.
+func (*EventAttendance) Descriptor() ([]byte, []int) {
+	return file_stats_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EventAttendance) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *EventAttendance) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *EventAttendance) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *EventAttendance) GetGoing() int32 {
+	if x != nil {
+		return x.Going
+	}
+	return 0
+}
+
+func (x *EventAttendance) GetMaybe() int32 {
+	if x != nil {
+		return x.Maybe
+	}
+	return 0
+}
+
+func (x *EventAttendance) GetDeclined() int32 {
+	if x != nil {
+		return x.Declined
+	}
+	return 0
+}
+
+func (x *EventAttendance) GetWaitlisted() int32 {
+	if x != nil {
+		return x.Waitlisted
+	}
+	return 0
+}
+
+func (x *EventAttendance) GetCheckedIn() int32 {
+	if x != nil {
+		return x.CheckedIn
+	}
+	return 0
+}
+
+func (x *EventAttendance) GetNoShows() int32 {
+	if x != nil {
+		return x.NoShows
+	}
+	return 0
+}
+
+func (x *EventAttendance) GetWalkIns() int32 {
+	if x != nil {
+		return x.WalkIns
+	}
+	return 0
+}
+
+type MonthlyAttendance struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "2026-10", in the events' own timezones.
+	Month     string `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"`
+	Events    int32  `protobuf:"varint,2,opt,name=events,proto3" json:"events,omitempty"`
+	Going     int32  `protobuf:"varint,3,opt,name=going,proto3" json:"going,omitempty"`
+	CheckedIn int32  `protobuf:"varint,4,opt,name=checked_in,json=checkedIn,proto3" json:"checked_in,omitempty"`
+	// checked_in / going; 0 when nobody answered going.
+	Turnout       float64 `protobuf:"fixed64,5,opt,name=turnout,proto3" json:"turnout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MonthlyAttendance) Reset() {
+	*x = MonthlyAttendance{}
+	mi := &file_stats_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonthlyAttendance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonthlyAttendance) ProtoMessage() {}
+
+func (x *MonthlyAttendance) ProtoReflect() protoreflect.Message {
+	mi := &file_stats_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonthlyAttendance.ProtoReflect.Descriptor instead.
+func (*MonthlyAttendance) Descriptor() ([]byte, []int) {
+	return file_stats_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MonthlyAttendance) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *MonthlyAttendance) GetEvents() int32 {
+	if x != nil {
+		return x.Events
+	}
+	return 0
+}
+
+func (x *MonthlyAttendance) GetGoing() int32 {
+	if x != nil {
+		return x.Going
+	}
+	return 0
+}
+
+func (x *MonthlyAttendance) GetCheckedIn() int32 {
+	if x != nil {
+		return x.CheckedIn
+	}
+	return 0
+}
+
+func (x *MonthlyAttendance) GetTurnout() float64 {
+	if x != nil {
+		return x.Turnout
+	}
+	return 0
+}
+
+type AttendanceStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Latest first.
+	Events []*EventAttendance `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// Oldest first.
+	Months        []*MonthlyAttendance `protobuf:"bytes,2,rep,name=months,proto3" json:"months,omitempty"`
+	Going         int32                `protobuf:"varint,3,opt,name=going,proto3" json:"going,omitempty"`
+	CheckedIn     int32                `protobuf:"varint,4,opt,name=checked_in,json=checkedIn,proto3" json:"checked_in,omitempty"`
+	Turnout       float64              `protobuf:"fixed64,5,opt,name=turnout,proto3" json:"turnout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttendanceStats) Reset() {
+	*x = AttendanceStats{}
+	mi := &file_stats_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendanceStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendanceStats) ProtoMessage() {}
+
+func (x *AttendanceStats) ProtoReflect() protoreflect.Message {
+	mi := &file_stats_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendanceStats.ProtoReflect.Descriptor instead.
+func (*AttendanceStats) Descriptor() ([]byte, []int) {
+	return file_stats_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AttendanceStats) GetEvents() []*EventAttendance {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *AttendanceStats) GetMonths() []*MonthlyAttendance {
+	if x != nil {
+		return x.Months
+	}
+	return nil
+}
+
+func (x *AttendanceStats) GetGoing() int32 {
+	if x != nil {
+		return x.Going
+	}
+	return 0
+}
+
+func (x *AttendanceStats) GetCheckedIn() int32 {
+	if x != nil {
+		return x.CheckedIn
+	}
+	return 0
+}
+
+func (x *AttendanceStats) GetTurnout() float64 {
+	if x != nil {
+		return x.Turnout
+	}
+	return 0
+}
+
+type MyStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Songs the user added to the catalog.
+	SongsProposed int32 `protobuf:"varint,1,opt,name=songs_proposed,json=songsProposed,proto3" json:"songs_proposed,omitempty"`
+	// Song roles the user currently plays.
+	RolesHeld int32 `protobuf:"varint,2,opt,name=roles_held,json=rolesHeld,proto3" json:"roles_held,omitempty"`
+	// The running season, if any: the latest one that has started and not
+	// ended.
+	SeasonId   string `protobuf:"bytes,3,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	SeasonName string `protobuf:"bytes,4,opt,name=season_name,json=seasonName,proto3" json:"season_name,omitempty"`
+	// Past events of that season the user performed at or checked in to.
+	EventsAttendedThisSeason int32 `protobuf:"varint,5,opt,name=events_attended_this_season,json=eventsAttendedThisSeason,proto3" json:"events_attended_this_season,omitempty"`
+	// Consecutive most recent club events the user attended.
+	CurrentStreak int32 `protobuf:"varint,6,opt,name=current_streak,json=currentStreak,proto3" json:"current_streak,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MyStats) Reset() {
+	*x = MyStats{}
+	mi := &file_stats_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MyStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MyStats) ProtoMessage() {}
+
+func (x *MyStats) ProtoReflect() protoreflect.Message {
+	mi := &file_stats_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MyStats.ProtoReflect.Descriptor instead.
+func (*MyStats) Descriptor() ([]byte, []int) {
+	return file_stats_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MyStats) GetSongsProposed() int32 {
+	if x != nil {
+		return x.SongsProposed
+	}
+	return 0
+}
+
+func (x *MyStats) GetRolesHeld() int32 {
+	if x != nil {
+		return x.RolesHeld
+	}
+	return 0
+}
+
+func (x *MyStats) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *MyStats) GetSeasonName() string {
+	if x != nil {
+		return x.SeasonName
+	}
+	return ""
+}
+
+func (x *MyStats) GetEventsAttendedThisSeason() int32 {
+	if x != nil {
+		return x.EventsAttendedThisSeason
+	}
+	return 0
+}
+
+func (x *MyStats) GetCurrentStreak() int32 {
+	if x != nil {
+		return x.CurrentStreak
+	}
+	return 0
+}
+
+var File_stats_proto protoreflect.FileDescriptor
+
+const file_stats_proto_rawDesc = "" +
+	"\n" +
+	"\vstats.proto\x12\x0fmusicclub.stats\x1a\x1bbuf/validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\n" +
+	"user.proto\"\xe2\x01\n" +
+	"\x15GetLeaderboardRequest\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x1b\n" +
+	"\tseason_id\x18\x03 \x01(\tR\bseasonId\x12:\n" +
+	"\x06metric\x18\x04 \x01(\x0e2\".musicclub.stats.LeaderboardMetricR\x06metric\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\rR\x05limit\"\xc3\x01\n" +
+	"\x10LeaderboardEntry\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\rR\x04rank\x12(\n" +
+	"\x04user\x18\x02 \x01(\v2\x14.musicclub.user.UserR\x04user\x12!\n" +
+	"\fsongs_joined\x18\x03 \x01(\x05R\vsongsJoined\x12'\n" +
+	"\x0fevents_attended\x18\x04 \x01(\x05R\x0eeventsAttended\x12%\n" +
+	"\x0esongs_proposed\x18\x05 \x01(\x05R\rsongsProposed\"\xa6\x01\n" +
+	"\vLeaderboard\x12;\n" +
+	"\aentries\x18\x01 \x03(\v2!.musicclub.stats.LeaderboardEntryR\aentries\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"\x89\x02\n" +
+	"\x14ListSongStatsRequest\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x1b\n" +
+	"\tseason_id\x18\x03 \x01(\tR\bseasonId\x122\n" +
+	"\x04sort\x18\x04 \x01(\x0e2\x1e.musicclub.stats.SongStatsSortR\x04sort\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\x12%\n" +
+	"\tpage_size\x18\x06 \x01(\rB\b\xbaH\x05*\x03\x18\xc8\x01R\bpageSize\"\xc4\x02\n" +
+	"\bSongStat\x12\x17\n" +
+	"\asong_id\x18\x01 \x01(\tR\x06songId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
+	"\x06artist\x18\x03 \x01(\tR\x06artist\x12'\n" +
+	"\x0ftimes_performed\x18\x04 \x01(\x05R\x0etimesPerformed\x12/\n" +
+	"\x13unique_participants\x18\x05 \x01(\x05R\x12uniqueParticipants\x12\x14\n" +
+	"\x05votes\x18\x06 \x01(\x05R\x05votes\x12F\n" +
+	"\x11last_performed_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0flastPerformedAt\x129\n" +
+	"\x19days_since_last_performed\x18\b \x01(\x05R\x16daysSinceLastPerformed\"\x91\x01\n" +
+	"\x15ListSongStatsResponse\x12/\n" +
+	"\x05songs\x18\x01 \x03(\v2\x19.musicclub.stats.SongStatR\x05songs\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"\x94\x01\n" +
+	"\x19GetAttendanceStatsRequest\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x1b\n" +
+	"\tseason_id\x18\x03 \x01(\tR\bseasonId\"\xb6\x02\n" +
+	"\x0fEventAttendance\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x125\n" +
+	"\bstart_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\astartAt\x12\x14\n" +
+	"\x05going\x18\x04 \x01(\x05R\x05going\x12\x14\n" +
+	"\x05maybe\x18\x05 \x01(\x05R\x05maybe\x12\x1a\n" +
+	"\bdeclined\x18\x06 \x01(\x05R\bdeclined\x12\x1e\n" +
+	"\n" +
+	"waitlisted\x18\a \x01(\x05R\n" +
+	"waitlisted\x12\x1d\n" +
+	"\n" +
+	"checked_in\x18\b \x01(\x05R\tcheckedIn\x12\x19\n" +
+	"\bno_shows\x18\t \x01(\x05R\anoShows\x12\x19\n" +
+	"\bwalk_ins\x18\n" +
+	" \x01(\x05R\awalkIns\"\x90\x01\n" +
+	"\x11MonthlyAttendance\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\tR\x05month\x12\x16\n" +
+	"\x06events\x18\x02 \x01(\x05R\x06events\x12\x14\n" +
+	"\x05going\x18\x03 \x01(\x05R\x05going\x12\x1d\n" +
+	"\n" +
+	"checked_in\x18\x04 \x01(\x05R\tcheckedIn\x12\x18\n" +
+	"\aturnout\x18\x05 \x01(\x01R\aturnout\"\xd6\x01\n" +
+	"\x0fAttendanceStats\x128\n" +
+	"\x06events\x18\x01 \x03(\v2 .musicclub.stats.EventAttendanceR\x06events\x12:\n" +
+	"\x06months\x18\x02 \x03(\v2\".musicclub.stats.MonthlyAttendanceR\x06months\x12\x14\n" +
+	"\x05going\x18\x03 \x01(\x05R\x05going\x12\x1d\n" +
+	"\n" +
+	"checked_in\x18\x04 \x01(\x05R\tcheckedIn\x12\x18\n" +
+	"\aturnout\x18\x05 \x01(\x01R\aturnout\"\xf3\x01\n" +
+	"\aMyStats\x12%\n" +
+	"\x0esongs_proposed\x18\x01 \x01(\x05R\rsongsProposed\x12\x1d\n" +
+	"\n" +
+	"roles_held\x18\x02 \x01(\x05R\trolesHeld\x12\x1b\n" +
+	"\tseason_id\x18\x03 \x01(\tR\bseasonId\x12\x1f\n" +
+	"\vseason_name\x18\x04 \x01(\tR\n" +
+	"seasonName\x12=\n" +
+	"\x1bevents_attended_this_season\x18\x05 \x01(\x05R\x18eventsAttendedThisSeason\x12%\n" +
+	"\x0ecurrent_streak\x18\x06 \x01(\x05R\rcurrentStreak*\xab\x01\n" +
+	"\x11LeaderboardMetric\x12\"\n" +
+	"\x1eLEADERBOARD_METRIC_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fLEADERBOARD_METRIC_SONGS_JOINED\x10\x01\x12&\n" +
+	"\"LEADERBOARD_METRIC_EVENTS_ATTENDED\x10\x02\x12%\n" +
+	"!LEADERBOARD_METRIC_SONGS_PROPOSED\x10\x03*\xb3\x01\n" +
+	"\rSongStatsSort\x12\x1f\n" +
+	"\x1bSONG_STATS_SORT_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15SONG_STATS_SORT_VOTES\x10\x01\x12 \n" +
+	"\x1cSONG_STATS_SORT_PARTICIPANTS\x10\x02\x12\"\n" +
+	"\x1eSONG_STATS_SORT_LAST_PERFORMED\x10\x03\x12 \n" +
+	"\x1cSONG_STATS_SORT_LEAST_RECENT\x10\x042\xea\x02\n" +
+	"\fStatsService\x12V\n" +
+	"\x0eGetLeaderboard\x12&.musicclub.stats.GetLeaderboardRequest\x1a\x1c.musicclub.stats.Leaderboard\x12^\n" +
+	"\rListSongStats\x12%.musicclub.stats.ListSongStatsRequest\x1a&.musicclub.stats.ListSongStatsResponse\x12b\n" +
+	"\x12GetAttendanceStats\x12*.musicclub.stats.GetAttendanceStatsRequest\x1a .musicclub.stats.AttendanceStats\x12>\n" +
+	"\n" +
+	"GetMyStats\x12\x16.google.protobuf.Empty\x1a\x18.musicclub.stats.MyStatsB\x1cZ\x1amusicclubbot/backend/protob\x06proto3"
+
+var (
+	file_stats_proto_rawDescOnce sync.Once
+	file_stats_proto_rawDescData []byte
+)
+
+func file_stats_proto_rawDescGZIP() []byte {
+	file_stats_proto_rawDescOnce.Do(func() {
+		file_stats_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_stats_proto_rawDesc), len(file_stats_proto_rawDesc)))
+	})
+	return file_stats_proto_rawDescData
+}
+
+var file_stats_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_stats_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_stats_proto_goTypes = []any{
+	(LeaderboardMetric)(0),            // 0: musicclub.stats.LeaderboardMetric
+	(SongStatsSort)(0),                // 1: musicclub.stats.SongStatsSort
+	(*GetLeaderboardRequest)(nil),     // 2: musicclub.stats.GetLeaderboardRequest
+	(*LeaderboardEntry)(nil),          // 3: musicclub.stats.LeaderboardEntry
+	(*Leaderboard)(nil),               // 4: musicclub.stats.Leaderboard
+	(*ListSongStatsRequest)(nil),      // 5: musicclub.stats.ListSongStatsRequest
+	(*SongStat)(nil),                  // 6: musicclub.stats.SongStat
+	(*ListSongStatsResponse)(nil),     // 7: musicclub.stats.ListSongStatsResponse
+	(*GetAttendanceStatsRequest)(nil), // 8: musicclub.stats.GetAttendanceStatsRequest
+	(*EventAttendance)(nil),           // 9: musicclub.stats.EventAttendance
+	(*MonthlyAttendance)(nil),         // 10: musicclub.stats.MonthlyAttendance
+	(*AttendanceStats)(nil),           // 11: musicclub.stats.AttendanceStats
+	(*MyStats)(nil),                   // 12: musicclub.stats.MyStats
+	(*timestamppb.Timestamp)(nil),     // 13: google.protobuf.Timestamp
+	(*User)(nil),                      // 14: musicclub.user.User
+	(*emptypb.Empty)(nil),             // 15: google.protobuf.Empty
+}
+var file_stats_proto_depIdxs = []int32{
+	13, // 0: musicclub.stats.GetLeaderboardRequest.from:type_name -> google.protobuf.Timestamp
+	13, // 1: musicclub.stats.GetLeaderboardRequest.to:type_name -> google.protobuf.Timestamp
+	0,  // 2: musicclub.stats.GetLeaderboardRequest.metric:type_name -> musicclub.stats.LeaderboardMetric
+	14, // 3: musicclub.stats.LeaderboardEntry.user:type_name -> musicclub.user.User
+	3,  // 4: musicclub.stats.Leaderboard.entries:type_name -> musicclub.stats.LeaderboardEntry
+	13, // 5: musicclub.stats.Leaderboard.from:type_name -> google.protobuf.Timestamp
+	13, // 6: musicclub.stats.Leaderboard.to:type_name -> google.protobuf.Timestamp
+	13, // 7: musicclub.stats.ListSongStatsRequest.from:type_name -> google.protobuf.Timestamp
+	13, // 8: musicclub.stats.ListSongStatsRequest.to:type_name -> google.protobuf.Timestamp
+	1,  // 9: musicclub.stats.ListSongStatsRequest.sort:type_name -> musicclub.stats.SongStatsSort
+	13, // 10: musicclub.stats.SongStat.last_performed_at:type_name -> google.protobuf.Timestamp
+	6,  // 11: musicclub.stats.ListSongStatsResponse.songs:type_name -> musicclub.stats.SongStat
+	13, // 12: musicclub.stats.GetAttendanceStatsRequest.from:type_name -> google.protobuf.Timestamp
+	13, // 13: musicclub.stats.GetAttendanceStatsRequest.to:type_name -> google.protobuf.Timestamp
+	13, // 14: musicclub.stats.EventAttendance.start_at:type_name -> google.protobuf.Timestamp
+	9,  // 15: musicclub.stats.AttendanceStats.events:type_name -> musicclub.stats.EventAttendance
+	10, // 16: musicclub.stats.AttendanceStats.months:type_name -> musicclub.stats.MonthlyAttendance
+	2,  // 17: musicclub.stats.StatsService.GetLeaderboard:input_type -> musicclub.stats.GetLeaderboardRequest
+	5,  // 18: musicclub.stats.StatsService.ListSongStats:input_type -> musicclub.stats.ListSongStatsRequest
+	8,  // 19: musicclub.stats.StatsService.GetAttendanceStats:input_type -> musicclub.stats.GetAttendanceStatsRequest
+	15, // 20: musicclub.stats.StatsService.GetMyStats:input_type -> google.protobuf.Empty
+	4,  // 21: musicclub.stats.StatsService.GetLeaderboard:output_type -> musicclub.stats.Leaderboard
+	7,  // 22: musicclub.stats.StatsService.ListSongStats:output_type -> musicclub.stats.ListSongStatsResponse
+	11, // 23: musicclub.stats.StatsService.GetAttendanceStats:output_type -> musicclub.stats.AttendanceStats
+	12, // 24: musicclub.stats.StatsService.GetMyStats:output_type -> musicclub.stats.MyStats
+	21, // [21:25] is the sub-list for method output_type
+	17, // [17:21] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
+}
+
+func init() { file_stats_proto_init() }
+func file_stats_proto_init() {
+	if File_stats_proto != nil {
+		return
+	}
+	file_user_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_stats_proto_rawDesc), len(file_stats_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_stats_proto_goTypes,
+		DependencyIndexes: file_stats_proto_depIdxs,
+		EnumInfos:         file_stats_proto_enumTypes,
+		MessageInfos:      file_stats_proto_msgTypes,
+	}.Build()
+	File_stats_proto = out.File
+	file_stats_proto_goTypes = nil
+	file_stats_proto_depIdxs = nil
+}
@@ -7,8 +7,11 @@
 package proto
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,6 +24,114 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ActivityKind int32
+
+const (
+	ActivityKind_ACTIVITY_KIND_UNSPECIFIED ActivityKind = 0
+	ActivityKind_ACTIVITY_KIND_SONG_ADDED  ActivityKind = 1
+	ActivityKind_ACTIVITY_KIND_ROLE_JOINED ActivityKind = 2
+	ActivityKind_ACTIVITY_KIND_EVENT_RSVP  ActivityKind = 3
+)
+
+// Enum value maps for ActivityKind.
+var (
+	ActivityKind_name = map[int32]string{
+		0: "ACTIVITY_KIND_UNSPECIFIED",
+		1: "ACTIVITY_KIND_SONG_ADDED",
+		2: "ACTIVITY_KIND_ROLE_JOINED",
+		3: "ACTIVITY_KIND_EVENT_RSVP",
+	}
+	ActivityKind_value = map[string]int32{
+		"ACTIVITY_KIND_UNSPECIFIED": 0,
+		"ACTIVITY_KIND_SONG_ADDED":  1,
+		"ACTIVITY_KIND_ROLE_JOINED": 2,
+		"ACTIVITY_KIND_EVENT_RSVP":  3,
+	}
+)
+
+func (x ActivityKind) Enum() *ActivityKind {
+	p := new(ActivityKind)
+	*p = x
+	return p
+}
+
+func (x ActivityKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ActivityKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_user_proto_enumTypes[0].Descriptor()
+}
+
+func (ActivityKind) Type() protoreflect.EnumType {
+	return &file_user_proto_enumTypes[0]
+}
+
+func (x ActivityKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ActivityKind.Descriptor instead.
+func (ActivityKind) EnumDescriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{0}
+}
+
+type NotificationDigest int32
+
+const (
+	// Treated as IMMEDIATE.
+	NotificationDigest_NOTIFICATION_DIGEST_UNSPECIFIED NotificationDigest = 0
+	// One message per notification, right away.
+	NotificationDigest_NOTIFICATION_DIGEST_IMMEDIATE NotificationDigest = 1
+	// Collected into one message every morning.
+	NotificationDigest_NOTIFICATION_DIGEST_DAILY NotificationDigest = 2
+	// Collected into one message on Monday mornings.
+	NotificationDigest_NOTIFICATION_DIGEST_WEEKLY NotificationDigest = 3
+)
+
+// Enum value maps for NotificationDigest.
+var (
+	NotificationDigest_name = map[int32]string{
+		0: "NOTIFICATION_DIGEST_UNSPECIFIED",
+		1: "NOTIFICATION_DIGEST_IMMEDIATE",
+		2: "NOTIFICATION_DIGEST_DAILY",
+		3: "NOTIFICATION_DIGEST_WEEKLY",
+	}
+	NotificationDigest_value = map[string]int32{
+		"NOTIFICATION_DIGEST_UNSPECIFIED": 0,
+		"NOTIFICATION_DIGEST_IMMEDIATE":   1,
+		"NOTIFICATION_DIGEST_DAILY":       2,
+		"NOTIFICATION_DIGEST_WEEKLY":      3,
+	}
+)
+
+func (x NotificationDigest) Enum() *NotificationDigest {
+	p := new(NotificationDigest)
+	*p = x
+	return p
+}
+
+func (x NotificationDigest) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationDigest) Descriptor() protoreflect.EnumDescriptor {
+	return file_user_proto_enumTypes[1].Descriptor()
+}
+
+func (NotificationDigest) Type() protoreflect.EnumType {
+	return &file_user_proto_enumTypes[1]
+}
+
+func (x NotificationDigest) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationDigest.Descriptor instead.
+func (NotificationDigest) EnumDescriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{1}
+}
+
 // Minimal user info for displaying assignments and ownership.
 type User struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -98,20 +209,1097 @@ func (x *User) GetTelegramId() uint64 {
 	return 0
 }
 
-var File_user_proto protoreflect.FileDescriptor
+type ListMembersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional substring filter by username or display name.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Only members playing this role in some song, e.g. "guitar".
+	Instrument string `protobuf:"bytes,2,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	// Only members of this group.
+	GroupId string `protobuf:"bytes,5,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// Pagination cursor (opaque to client).
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize      uint32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_user_proto_rawDesc = "" +
-	"\n" +
-	"\n" +
-	"user.proto\x12\x0emusicclub.user\"\x95\x01\n" +
-	"\x04User\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
-	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x1a\n" +
-	"\busername\x18\x03 \x01(\tR\busername\x12\x1d\n" +
-	"\n" +
-	"avatar_url\x18\x04 \x01(\tR\tavatarUrl\x12\x1f\n" +
-	"\vtelegram_id\x18\x05 \x01(\x04R\n" +
-	"telegramIdB\x1cZ\x1amusicclubbot/backend/protob\x06proto3"
+func (x *ListMembersRequest) Reset() {
+	*x = ListMembersRequest{}
+	mi := &file_user_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMembersRequest) ProtoMessage() {}
+
+func (x *ListMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListMembersRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListMembersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListMembersRequest) GetInstrument() string {
+	if x != nil {
+		return x.Instrument
+	}
+	return ""
+}
+
+func (x *ListMembersRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ListMembersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMembersRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type Member struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	User  *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// Roles the member plays across the catalog, most played first.
+	Instruments []string `protobuf:"bytes,2,rep,name=instruments,proto3" json:"instruments,omitempty"`
+	// Catalog songs the member plays a role in.
+	SongCount int32 `protobuf:"varint,3,opt,name=song_count,json=songCount,proto3" json:"song_count,omitempty"`
+	// Events the member took part in.
+	EventCount int32 `protobuf:"varint,4,opt,name=event_count,json=eventCount,proto3" json:"event_count,omitempty"`
+	// Names of the groups the member is in, sorted.
+	Groups        []string `protobuf:"bytes,5,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Member) Reset() {
+	*x = Member{}
+	mi := &file_user_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Member) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Member) ProtoMessage() {}
+
+func (x *Member) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Member.ProtoReflect.Descriptor instead.
+func (*Member) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Member) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *Member) GetInstruments() []string {
+	if x != nil {
+		return x.Instruments
+	}
+	return nil
+}
+
+func (x *Member) GetSongCount() int32 {
+	if x != nil {
+		return x.SongCount
+	}
+	return 0
+}
+
+func (x *Member) GetEventCount() int32 {
+	if x != nil {
+		return x.EventCount
+	}
+	return 0
+}
+
+func (x *Member) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type ListMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*Member              `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Members matching the filters across all pages.
+	TotalCount    int32 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMembersResponse) Reset() {
+	*x = ListMembersResponse{}
+	mi := &file_user_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMembersResponse) ProtoMessage() {}
+
+func (x *ListMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListMembersResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListMembersResponse) GetMembers() []*Member {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ListMembersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListMembersResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type GetUserProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserProfileRequest) Reset() {
+	*x = GetUserProfileRequest{}
+	mi := &file_user_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserProfileRequest) ProtoMessage() {}
+
+func (x *GetUserProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetUserProfileRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetUserProfileRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// A role the user plays in a catalog song.
+type ProfileSongRole struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SongId        string                 `protobuf:"bytes,1,opt,name=song_id,json=songId,proto3" json:"song_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Artist        string                 `protobuf:"bytes,3,opt,name=artist,proto3" json:"artist,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfileSongRole) Reset() {
+	*x = ProfileSongRole{}
+	mi := &file_user_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileSongRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileSongRole) ProtoMessage() {}
+
+func (x *ProfileSongRole) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileSongRole.ProtoReflect.Descriptor instead.
+func (*ProfileSongRole) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ProfileSongRole) GetSongId() string {
+	if x != nil {
+		return x.SongId
+	}
+	return ""
+}
+
+func (x *ProfileSongRole) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ProfileSongRole) GetArtist() string {
+	if x != nil {
+		return x.Artist
+	}
+	return ""
+}
+
+func (x *ProfileSongRole) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+// An upcoming event the user takes part in.
+type ProfileEvent struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Title   string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	StartAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	// Roles the user plays at the event.
+	Roles         []string `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfileEvent) Reset() {
+	*x = ProfileEvent{}
+	mi := &file_user_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileEvent) ProtoMessage() {}
+
+func (x *ProfileEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileEvent.ProtoReflect.Descriptor instead.
+func (*ProfileEvent) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ProfileEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ProfileEvent) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ProfileEvent) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *ProfileEvent) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type UserProfile struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	User         *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Bio          string                 `protobuf:"bytes,2,opt,name=bio,proto3" json:"bio,omitempty"`
+	IsChatMember bool                   `protobuf:"varint,3,opt,name=is_chat_member,json=isChatMember,proto3" json:"is_chat_member,omitempty"`
+	MemberSince  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=member_since,json=memberSince,proto3" json:"member_since,omitempty"`
+	SongRoles    []*ProfileSongRole     `protobuf:"bytes,5,rep,name=song_roles,json=songRoles,proto3" json:"song_roles,omitempty"`
+	// Soonest first; cancelled and archived events are left out.
+	UpcomingEvents []*ProfileEvent `protobuf:"bytes,6,rep,name=upcoming_events,json=upcomingEvents,proto3" json:"upcoming_events,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UserProfile) Reset() {
+	*x = UserProfile{}
+	mi := &file_user_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserProfile) ProtoMessage() {}
+
+func (x *UserProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserProfile.ProtoReflect.Descriptor instead.
+func (*UserProfile) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UserProfile) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *UserProfile) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *UserProfile) GetIsChatMember() bool {
+	if x != nil {
+		return x.IsChatMember
+	}
+	return false
+}
+
+func (x *UserProfile) GetMemberSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MemberSince
+	}
+	return nil
+}
+
+func (x *UserProfile) GetSongRoles() []*ProfileSongRole {
+	if x != nil {
+		return x.SongRoles
+	}
+	return nil
+}
+
+func (x *UserProfile) GetUpcomingEvents() []*ProfileEvent {
+	if x != nil {
+		return x.UpcomingEvents
+	}
+	return nil
+}
+
+type Activity struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	User  *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Kind  ActivityKind           `protobuf:"varint,3,opt,name=kind,proto3,enum=musicclub.user.ActivityKind" json:"kind,omitempty"`
+	// Set for song activity.
+	SongId     string `protobuf:"bytes,4,opt,name=song_id,json=songId,proto3" json:"song_id,omitempty"`
+	SongTitle  string `protobuf:"bytes,5,opt,name=song_title,json=songTitle,proto3" json:"song_title,omitempty"`
+	SongArtist string `protobuf:"bytes,6,opt,name=song_artist,json=songArtist,proto3" json:"song_artist,omitempty"`
+	// Set for event activity.
+	EventId    string `protobuf:"bytes,7,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventTitle string `protobuf:"bytes,8,opt,name=event_title,json=eventTitle,proto3" json:"event_title,omitempty"`
+	// The role joined, or the RSVP answer ("going", "maybe", "declined",
+	// "waitlisted").
+	Detail        string                 `protobuf:"bytes,9,opt,name=detail,proto3" json:"detail,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Activity) Reset() {
+	*x = Activity{}
+	mi := &file_user_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Activity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Activity) ProtoMessage() {}
+
+func (x *Activity) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Activity.ProtoReflect.Descriptor instead.
+func (*Activity) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Activity) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Activity) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *Activity) GetKind() ActivityKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ActivityKind_ACTIVITY_KIND_UNSPECIFIED
+}
+
+func (x *Activity) GetSongId() string {
+	if x != nil {
+		return x.SongId
+	}
+	return ""
+}
+
+func (x *Activity) GetSongTitle() string {
+	if x != nil {
+		return x.SongTitle
+	}
+	return ""
+}
+
+func (x *Activity) GetSongArtist() string {
+	if x != nil {
+		return x.SongArtist
+	}
+	return ""
+}
+
+func (x *Activity) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *Activity) GetEventTitle() string {
+	if x != nil {
+		return x.EventTitle
+	}
+	return ""
+}
+
+func (x *Activity) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *Activity) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListActivityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional; empty lists everyone's activity.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Pagination cursor (opaque to client).
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize      uint32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListActivityRequest) Reset() {
+	*x = ListActivityRequest{}
+	mi := &file_user_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListActivityRequest) ProtoMessage() {}
+
+func (x *ListActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListActivityRequest.ProtoReflect.Descriptor instead.
+func (*ListActivityRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListActivityRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListActivityRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListActivityRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListActivityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Activities    []*Activity            `protobuf:"bytes,1,rep,name=activities,proto3" json:"activities,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListActivityResponse) Reset() {
+	*x = ListActivityResponse{}
+	mi := &file_user_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListActivityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListActivityResponse) ProtoMessage() {}
+
+func (x *ListActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListActivityResponse.ProtoReflect.Descriptor instead.
+func (*ListActivityResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListActivityResponse) GetActivities() []*Activity {
+	if x != nil {
+		return x.Activities
+	}
+	return nil
+}
+
+func (x *ListActivityResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// A file for the client to download.
+type DataExport struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Suggested file name, e.g. "musicclub-export-alice-2026-10-16.json".
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	// UTF-8 text in the format content_type names.
+	Content []byte `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// "application/json" or "text/csv".
+	ContentType   string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataExport) Reset() {
+	*x = DataExport{}
+	mi := &file_user_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataExport) ProtoMessage() {}
+
+func (x *DataExport) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataExport.ProtoReflect.Descriptor instead.
+func (*DataExport) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DataExport) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *DataExport) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *DataExport) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type DeactivateAccountResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ReactivateBefore *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=reactivate_before,json=reactivateBefore,proto3" json:"reactivate_before,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DeactivateAccountResponse) Reset() {
+	*x = DeactivateAccountResponse{}
+	mi := &file_user_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateAccountResponse) ProtoMessage() {}
+
+func (x *DeactivateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeactivateAccountResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeactivateAccountResponse) GetReactivateBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReactivateBefore
+	}
+	return nil
+}
+
+type SearchUsersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// What was typed after "@"; a leading "@" is ignored.
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// Defaults to 10; larger values are capped at 50.
+	Limit         uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersRequest) Reset() {
+	*x = SearchUsersRequest{}
+	mi := &file_user_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersRequest) ProtoMessage() {}
+
+func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
+func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SearchUsersRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *SearchUsersRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchUsersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Username matches first, then display name matches, then by name.
+	Users         []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersResponse) Reset() {
+	*x = SearchUsersResponse{}
+	mi := &file_user_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersResponse) ProtoMessage() {}
+
+func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersResponse.ProtoReflect.Descriptor instead.
+func (*SearchUsersResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SearchUsersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type NotificationSettings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Digest        NotificationDigest     `protobuf:"varint,1,opt,name=digest,proto3,enum=musicclub.user.NotificationDigest" json:"digest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationSettings) Reset() {
+	*x = NotificationSettings{}
+	mi := &file_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationSettings) ProtoMessage() {}
+
+func (x *NotificationSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationSettings.ProtoReflect.Descriptor instead.
+func (*NotificationSettings) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *NotificationSettings) GetDigest() NotificationDigest {
+	if x != nil {
+		return x.Digest
+	}
+	return NotificationDigest_NOTIFICATION_DIGEST_UNSPECIFIED
+}
+
+var File_user_proto protoreflect.FileDescriptor
+
+const file_user_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"user.proto\x12\x0emusicclub.user\x1a\x1bbuf/validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x95\x01\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x04 \x01(\tR\tavatarUrl\x12\x1f\n" +
+	"\vtelegram_id\x18\x05 \x01(\x04R\n" +
+	"telegramId\"\xb8\x01\n" +
+	"\x12ListMembersRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1e\n" +
+	"\n" +
+	"instrument\x18\x02 \x01(\tR\n" +
+	"instrument\x12&\n" +
+	"\bgroup_id\x18\x05 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\agroupId\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12%\n" +
+	"\tpage_size\x18\x04 \x01(\rB\b\xbaH\x05*\x03\x18\xc8\x01R\bpageSize\"\xac\x01\n" +
+	"\x06Member\x12(\n" +
+	"\x04user\x18\x01 \x01(\v2\x14.musicclub.user.UserR\x04user\x12 \n" +
+	"\vinstruments\x18\x02 \x03(\tR\vinstruments\x12\x1d\n" +
+	"\n" +
+	"song_count\x18\x03 \x01(\x05R\tsongCount\x12\x1f\n" +
+	"\vevent_count\x18\x04 \x01(\x05R\n" +
+	"eventCount\x12\x16\n" +
+	"\x06groups\x18\x05 \x03(\tR\x06groups\"\x90\x01\n" +
+	"\x13ListMembersResponse\x120\n" +
+	"\amembers\x18\x01 \x03(\v2\x16.musicclub.user.MemberR\amembers\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\":\n" +
+	"\x15GetUserProfileRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\"l\n" +
+	"\x0fProfileSongRole\x12\x17\n" +
+	"\asong_id\x18\x01 \x01(\tR\x06songId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
+	"\x06artist\x18\x03 \x01(\tR\x06artist\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\"\x8c\x01\n" +
+	"\fProfileEvent\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x125\n" +
+	"\bstart_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\astartAt\x12\x14\n" +
+	"\x05roles\x18\x04 \x03(\tR\x05roles\"\xb5\x02\n" +
+	"\vUserProfile\x12(\n" +
+	"\x04user\x18\x01 \x01(\v2\x14.musicclub.user.UserR\x04user\x12\x10\n" +
+	"\x03bio\x18\x02 \x01(\tR\x03bio\x12$\n" +
+	"\x0eis_chat_member\x18\x03 \x01(\bR\fisChatMember\x12=\n" +
+	"\fmember_since\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vmemberSince\x12>\n" +
+	"\n" +
+	"song_roles\x18\x05 \x03(\v2\x1f.musicclub.user.ProfileSongRoleR\tsongRoles\x12E\n" +
+	"\x0fupcoming_events\x18\x06 \x03(\v2\x1c.musicclub.user.ProfileEventR\x0eupcomingEvents\"\xde\x02\n" +
+	"\bActivity\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12(\n" +
+	"\x04user\x18\x02 \x01(\v2\x14.musicclub.user.UserR\x04user\x120\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x1c.musicclub.user.ActivityKindR\x04kind\x12\x17\n" +
+	"\asong_id\x18\x04 \x01(\tR\x06songId\x12\x1d\n" +
+	"\n" +
+	"song_title\x18\x05 \x01(\tR\tsongTitle\x12\x1f\n" +
+	"\vsong_artist\x18\x06 \x01(\tR\n" +
+	"songArtist\x12\x19\n" +
+	"\bevent_id\x18\a \x01(\tR\aeventId\x12\x1f\n" +
+	"\vevent_title\x18\b \x01(\tR\n" +
+	"eventTitle\x12\x16\n" +
+	"\x06detail\x18\t \x01(\tR\x06detail\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x81\x01\n" +
+	"\x13ListActivityRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12%\n" +
+	"\tpage_size\x18\x03 \x01(\rB\b\xbaH\x05*\x03\x18\xc8\x01R\bpageSize\"x\n" +
+	"\x14ListActivityResponse\x128\n" +
+	"\n" +
+	"activities\x18\x01 \x03(\v2\x18.musicclub.user.ActivityR\n" +
+	"activities\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"e\n" +
+	"\n" +
+	"DataExport\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\"d\n" +
+	"\x19DeactivateAccountResponse\x12G\n" +
+	"\x11reactivate_before\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x10reactivateBefore\"B\n" +
+	"\x12SearchUsersRequest\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\"A\n" +
+	"\x13SearchUsersResponse\x12*\n" +
+	"\x05users\x18\x01 \x03(\v2\x14.musicclub.user.UserR\x05users\"R\n" +
+	"\x14NotificationSettings\x12:\n" +
+	"\x06digest\x18\x01 \x01(\x0e2\".musicclub.user.NotificationDigestR\x06digest*\x88\x01\n" +
+	"\fActivityKind\x12\x1d\n" +
+	"\x19ACTIVITY_KIND_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18ACTIVITY_KIND_SONG_ADDED\x10\x01\x12\x1d\n" +
+	"\x19ACTIVITY_KIND_ROLE_JOINED\x10\x02\x12\x1c\n" +
+	"\x18ACTIVITY_KIND_EVENT_RSVP\x10\x03*\x9b\x01\n" +
+	"\x12NotificationDigest\x12#\n" +
+	"\x1fNOTIFICATION_DIGEST_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dNOTIFICATION_DIGEST_IMMEDIATE\x10\x01\x12\x1d\n" +
+	"\x19NOTIFICATION_DIGEST_DAILY\x10\x02\x12\x1e\n" +
+	"\x1aNOTIFICATION_DIGEST_WEEKLY\x10\x032\xd8\x06\n" +
+	"\vUserService\x12V\n" +
+	"\vListMembers\x12\".musicclub.user.ListMembersRequest\x1a#.musicclub.user.ListMembersResponse\x12T\n" +
+	"\x0eGetUserProfile\x12%.musicclub.user.GetUserProfileRequest\x1a\x1b.musicclub.user.UserProfile\x12Y\n" +
+	"\fListActivity\x12#.musicclub.user.ListActivityRequest\x1a$.musicclub.user.ListActivityResponse\x12B\n" +
+	"\x10MarkActivitySeen\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\x12B\n" +
+	"\fExportMyData\x12\x16.google.protobuf.Empty\x1a\x1a.musicclub.user.DataExport\x12V\n" +
+	"\x11DeactivateAccount\x12\x16.google.protobuf.Empty\x1a).musicclub.user.DeactivateAccountResponse\x12V\n" +
+	"\vSearchUsers\x12\".musicclub.user.SearchUsersRequest\x1a#.musicclub.user.SearchUsersResponse\x12E\n" +
+	"\x0fExportMyHistory\x12\x16.google.protobuf.Empty\x1a\x1a.musicclub.user.DataExport\x12W\n" +
+	"\x17GetNotificationSettings\x12\x16.google.protobuf.Empty\x1a$.musicclub.user.NotificationSettings\x12h\n" +
+	"\x1aUpdateNotificationSettings\x12$.musicclub.user.NotificationSettings\x1a$.musicclub.user.NotificationSettingsB\x1cZ\x1amusicclubbot/backend/protob\x06proto3"
 
 var (
 	file_user_proto_rawDescOnce sync.Once
@@ -125,16 +1313,70 @@ func file_user_proto_rawDescGZIP() []byte {
 	return file_user_proto_rawDescData
 }
 
-var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_user_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_user_proto_goTypes = []any{
-	(*User)(nil), // 0: musicclub.user.User
+	(ActivityKind)(0),                 // 0: musicclub.user.ActivityKind
+	(NotificationDigest)(0),           // 1: musicclub.user.NotificationDigest
+	(*User)(nil),                      // 2: musicclub.user.User
+	(*ListMembersRequest)(nil),        // 3: musicclub.user.ListMembersRequest
+	(*Member)(nil),                    // 4: musicclub.user.Member
+	(*ListMembersResponse)(nil),       // 5: musicclub.user.ListMembersResponse
+	(*GetUserProfileRequest)(nil),     // 6: musicclub.user.GetUserProfileRequest
+	(*ProfileSongRole)(nil),           // 7: musicclub.user.ProfileSongRole
+	(*ProfileEvent)(nil),              // 8: musicclub.user.ProfileEvent
+	(*UserProfile)(nil),               // 9: musicclub.user.UserProfile
+	(*Activity)(nil),                  // 10: musicclub.user.Activity
+	(*ListActivityRequest)(nil),       // 11: musicclub.user.ListActivityRequest
+	(*ListActivityResponse)(nil),      // 12: musicclub.user.ListActivityResponse
+	(*DataExport)(nil),                // 13: musicclub.user.DataExport
+	(*DeactivateAccountResponse)(nil), // 14: musicclub.user.DeactivateAccountResponse
+	(*SearchUsersRequest)(nil),        // 15: musicclub.user.SearchUsersRequest
+	(*SearchUsersResponse)(nil),       // 16: musicclub.user.SearchUsersResponse
+	(*NotificationSettings)(nil),      // 17: musicclub.user.NotificationSettings
+	(*timestamppb.Timestamp)(nil),     // 18: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),             // 19: google.protobuf.Empty
 }
 var file_user_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2,  // 0: musicclub.user.Member.user:type_name -> musicclub.user.User
+	4,  // 1: musicclub.user.ListMembersResponse.members:type_name -> musicclub.user.Member
+	18, // 2: musicclub.user.ProfileEvent.start_at:type_name -> google.protobuf.Timestamp
+	2,  // 3: musicclub.user.UserProfile.user:type_name -> musicclub.user.User
+	18, // 4: musicclub.user.UserProfile.member_since:type_name -> google.protobuf.Timestamp
+	7,  // 5: musicclub.user.UserProfile.song_roles:type_name -> musicclub.user.ProfileSongRole
+	8,  // 6: musicclub.user.UserProfile.upcoming_events:type_name -> musicclub.user.ProfileEvent
+	2,  // 7: musicclub.user.Activity.user:type_name -> musicclub.user.User
+	0,  // 8: musicclub.user.Activity.kind:type_name -> musicclub.user.ActivityKind
+	18, // 9: musicclub.user.Activity.created_at:type_name -> google.protobuf.Timestamp
+	10, // 10: musicclub.user.ListActivityResponse.activities:type_name -> musicclub.user.Activity
+	18, // 11: musicclub.user.DeactivateAccountResponse.reactivate_before:type_name -> google.protobuf.Timestamp
+	2,  // 12: musicclub.user.SearchUsersResponse.users:type_name -> musicclub.user.User
+	1,  // 13: musicclub.user.NotificationSettings.digest:type_name -> musicclub.user.NotificationDigest
+	3,  // 14: musicclub.user.UserService.ListMembers:input_type -> musicclub.user.ListMembersRequest
+	6,  // 15: musicclub.user.UserService.GetUserProfile:input_type -> musicclub.user.GetUserProfileRequest
+	11, // 16: musicclub.user.UserService.ListActivity:input_type -> musicclub.user.ListActivityRequest
+	19, // 17: musicclub.user.UserService.MarkActivitySeen:input_type -> google.protobuf.Empty
+	19, // 18: musicclub.user.UserService.ExportMyData:input_type -> google.protobuf.Empty
+	19, // 19: musicclub.user.UserService.DeactivateAccount:input_type -> google.protobuf.Empty
+	15, // 20: musicclub.user.UserService.SearchUsers:input_type -> musicclub.user.SearchUsersRequest
+	19, // 21: musicclub.user.UserService.ExportMyHistory:input_type -> google.protobuf.Empty
+	19, // 22: musicclub.user.UserService.GetNotificationSettings:input_type -> google.protobuf.Empty
+	17, // 23: musicclub.user.UserService.UpdateNotificationSettings:input_type -> musicclub.user.NotificationSettings
+	5,  // 24: musicclub.user.UserService.ListMembers:output_type -> musicclub.user.ListMembersResponse
+	9,  // 25: musicclub.user.UserService.GetUserProfile:output_type -> musicclub.user.UserProfile
+	12, // 26: musicclub.user.UserService.ListActivity:output_type -> musicclub.user.ListActivityResponse
+	19, // 27: musicclub.user.UserService.MarkActivitySeen:output_type -> google.protobuf.Empty
+	13, // 28: musicclub.user.UserService.ExportMyData:output_type -> musicclub.user.DataExport
+	14, // 29: musicclub.user.UserService.DeactivateAccount:output_type -> musicclub.user.DeactivateAccountResponse
+	16, // 30: musicclub.user.UserService.SearchUsers:output_type -> musicclub.user.SearchUsersResponse
+	13, // 31: musicclub.user.UserService.ExportMyHistory:output_type -> musicclub.user.DataExport
+	17, // 32: musicclub.user.UserService.GetNotificationSettings:output_type -> musicclub.user.NotificationSettings
+	17, // 33: musicclub.user.UserService.UpdateNotificationSettings:output_type -> musicclub.user.NotificationSettings
+	24, // [24:34] is the sub-list for method output_type
+	14, // [14:24] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_user_proto_init() }
@@ -147,13 +1389,14 @@ func file_user_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_proto_rawDesc), len(file_user_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      2,
+			NumMessages:   16,
 			NumExtensions: 0,
-			NumServices:   0,
+			NumServices:   1,
 		},
 		GoTypes:           file_user_proto_goTypes,
 		DependencyIndexes: file_user_proto_depIdxs,
+		EnumInfos:         file_user_proto_enumTypes,
 		MessageInfos:      file_user_proto_msgTypes,
 	}.Build()
 	File_user_proto = out.File
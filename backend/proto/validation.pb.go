@@ -0,0 +1,88 @@
+// proto2, as proto3 files can't extend buf.validate's rule messages.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: validation.proto
+
+package proto
+
+import (
+	validate "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var file_validation_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*validate.StringRules)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         1000,
+		Name:          "musicclub.validation.not_blank",
+		Tag:           "varint,1000,opt,name=not_blank",
+		Filename:      "validation.proto",
+	},
+}
+
+// Extension fields to validate.StringRules.
+var (
+	// The string has something besides whitespace; handlers trim it.
+	//
+	// optional bool not_blank = 1000;
+	E_NotBlank = &file_validation_proto_extTypes[0]
+)
+
+var File_validation_proto protoreflect.FileDescriptor
+
+const file_validation_proto_rawDesc = "" +
+	"\n" +
+	"\x10validation.proto\x12\x14musicclub.validation\x1a\x1bbuf/validate/validate.proto:\x81\x01\n" +
+	"\tnot_blank\x12\x19.buf.validate.StringRules\x18\xe8\a \x01(\bBH\xc2HE\n" +
+	"C\n" +
+	"\x10string.not_blank\x1a/!rule || this.trim() != '' ? '' : 'is required'R\bnotBlankB\x1cZ\x1amusicclubbot/backend/proto"
+
+var file_validation_proto_goTypes = []any{
+	(*validate.StringRules)(nil), // 0: buf.validate.StringRules
+}
+var file_validation_proto_depIdxs = []int32{
+	0, // 0: musicclub.validation.not_blank:extendee -> buf.validate.StringRules
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	0, // [0:1] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_validation_proto_init() }
+func file_validation_proto_init() {
+	if File_validation_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_validation_proto_rawDesc), len(file_validation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 1,
+			NumServices:   0,
+		},
+		GoTypes:           file_validation_proto_goTypes,
+		DependencyIndexes: file_validation_proto_depIdxs,
+		ExtensionInfos:    file_validation_proto_extTypes,
+	}.Build()
+	File_validation_proto = out.File
+	file_validation_proto_goTypes = nil
+	file_validation_proto_depIdxs = nil
+}
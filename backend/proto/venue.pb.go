@@ -0,0 +1,545 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: venue.proto
+
+package proto
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Venue struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name    string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// Link to the venue on a map service.
+	MapUrl string `protobuf:"bytes,4,opt,name=map_url,json=mapUrl,proto3" json:"map_url,omitempty"`
+	// Load-in instructions, parking, contacts, etc.
+	Notes string `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	// Number of events held at the venue.
+	EventCount int32 `protobuf:"varint,6,opt,name=event_count,json=eventCount,proto3" json:"event_count,omitempty"`
+	// Unset when neither the map link nor the address could be located.
+	Coordinates   *GeoPoint `protobuf:"bytes,7,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Venue) Reset() {
+	*x = Venue{}
+	mi := &file_venue_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Venue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Venue) ProtoMessage() {}
+
+func (x *Venue) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Venue.ProtoReflect.Descriptor instead.
+func (*Venue) Descriptor() ([]byte, []int) {
+	return file_venue_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Venue) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Venue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Venue) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Venue) GetMapUrl() string {
+	if x != nil {
+		return x.MapUrl
+	}
+	return ""
+}
+
+func (x *Venue) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Venue) GetEventCount() int32 {
+	if x != nil {
+		return x.EventCount
+	}
+	return 0
+}
+
+func (x *Venue) GetCoordinates() *GeoPoint {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
+}
+
+type GeoPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeoPoint) Reset() {
+	*x = GeoPoint{}
+	mi := &file_venue_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeoPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoPoint) ProtoMessage() {}
+
+func (x *GeoPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoPoint.ProtoReflect.Descriptor instead.
+func (*GeoPoint) Descriptor() ([]byte, []int) {
+	return file_venue_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GeoPoint) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GeoPoint) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type VenueId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VenueId) Reset() {
+	*x = VenueId{}
+	mi := &file_venue_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VenueId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VenueId) ProtoMessage() {}
+
+func (x *VenueId) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VenueId.ProtoReflect.Descriptor instead.
+func (*VenueId) Descriptor() ([]byte, []int) {
+	return file_venue_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *VenueId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListVenuesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional substring filter by name or address.
+	Query         string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVenuesRequest) Reset() {
+	*x = ListVenuesRequest{}
+	mi := &file_venue_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVenuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVenuesRequest) ProtoMessage() {}
+
+func (x *ListVenuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVenuesRequest.ProtoReflect.Descriptor instead.
+func (*ListVenuesRequest) Descriptor() ([]byte, []int) {
+	return file_venue_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListVenuesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type ListVenuesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Venues        []*Venue               `protobuf:"bytes,1,rep,name=venues,proto3" json:"venues,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVenuesResponse) Reset() {
+	*x = ListVenuesResponse{}
+	mi := &file_venue_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVenuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVenuesResponse) ProtoMessage() {}
+
+func (x *ListVenuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVenuesResponse.ProtoReflect.Descriptor instead.
+func (*ListVenuesResponse) Descriptor() ([]byte, []int) {
+	return file_venue_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListVenuesResponse) GetVenues() []*Venue {
+	if x != nil {
+		return x.Venues
+	}
+	return nil
+}
+
+type VenueInput struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Name    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	MapUrl  string                 `protobuf:"bytes,3,opt,name=map_url,json=mapUrl,proto3" json:"map_url,omitempty"`
+	Notes   string                 `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	// Taken from map_url or geocoded from the address when unset.
+	Coordinates   *GeoPoint `protobuf:"bytes,5,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VenueInput) Reset() {
+	*x = VenueInput{}
+	mi := &file_venue_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VenueInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VenueInput) ProtoMessage() {}
+
+func (x *VenueInput) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VenueInput.ProtoReflect.Descriptor instead.
+func (*VenueInput) Descriptor() ([]byte, []int) {
+	return file_venue_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *VenueInput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VenueInput) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *VenueInput) GetMapUrl() string {
+	if x != nil {
+		return x.MapUrl
+	}
+	return ""
+}
+
+func (x *VenueInput) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *VenueInput) GetCoordinates() *GeoPoint {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
+}
+
+type UpdateVenueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Venue         *VenueInput            `protobuf:"bytes,2,opt,name=venue,proto3" json:"venue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVenueRequest) Reset() {
+	*x = UpdateVenueRequest{}
+	mi := &file_venue_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVenueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVenueRequest) ProtoMessage() {}
+
+func (x *UpdateVenueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVenueRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVenueRequest) Descriptor() ([]byte, []int) {
+	return file_venue_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateVenueRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateVenueRequest) GetVenue() *VenueInput {
+	if x != nil {
+		return x.Venue
+	}
+	return nil
+}
+
+var File_venue_proto protoreflect.FileDescriptor
+
+const file_venue_proto_rawDesc = "" +
+	"\n" +
+	"\vvenue.proto\x12\x0fmusicclub.venue\x1a\x1bbuf/validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x10validation.proto\"\xd2\x01\n" +
+	"\x05Venue\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12\x17\n" +
+	"\amap_url\x18\x04 \x01(\tR\x06mapUrl\x12\x14\n" +
+	"\x05notes\x18\x05 \x01(\tR\x05notes\x12\x1f\n" +
+	"\vevent_count\x18\x06 \x01(\x05R\n" +
+	"eventCount\x12;\n" +
+	"\vcoordinates\x18\a \x01(\v2\x19.musicclub.venue.GeoPointR\vcoordinates\"D\n" +
+	"\bGeoPoint\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\"#\n" +
+	"\aVenueId\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\")\n" +
+	"\x11ListVenuesRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\"D\n" +
+	"\x12ListVenuesResponse\x12.\n" +
+	"\x06venues\x18\x01 \x03(\v2\x16.musicclub.venue.VenueR\x06venues\"\xbd\x01\n" +
+	"\n" +
+	"VenueInput\x12\x1c\n" +
+	"\x04name\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xc0>\x01R\x04name\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12$\n" +
+	"\amap_url\x18\x03 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\x88\x01\x01R\x06mapUrl\x12\x14\n" +
+	"\x05notes\x18\x04 \x01(\tR\x05notes\x12;\n" +
+	"\vcoordinates\x18\x05 \x01(\v2\x19.musicclub.venue.GeoPointR\vcoordinates\"i\n" +
+	"\x12UpdateVenueRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x129\n" +
+	"\x05venue\x18\x02 \x01(\v2\x1b.musicclub.venue.VenueInputB\x06\xbaH\x03\xc8\x01\x01R\x05venue2\xf4\x02\n" +
+	"\fVenueService\x12U\n" +
+	"\n" +
+	"ListVenues\x12\".musicclub.venue.ListVenuesRequest\x1a#.musicclub.venue.ListVenuesResponse\x12<\n" +
+	"\bGetVenue\x12\x18.musicclub.venue.VenueId\x1a\x16.musicclub.venue.Venue\x12B\n" +
+	"\vCreateVenue\x12\x1b.musicclub.venue.VenueInput\x1a\x16.musicclub.venue.Venue\x12J\n" +
+	"\vUpdateVenue\x12#.musicclub.venue.UpdateVenueRequest\x1a\x16.musicclub.venue.Venue\x12?\n" +
+	"\vDeleteVenue\x12\x18.musicclub.venue.VenueId\x1a\x16.google.protobuf.EmptyB\x1cZ\x1amusicclubbot/backend/protob\x06proto3"
+
+var (
+	file_venue_proto_rawDescOnce sync.Once
+	file_venue_proto_rawDescData []byte
+)
+
+func file_venue_proto_rawDescGZIP() []byte {
+	file_venue_proto_rawDescOnce.Do(func() {
+		file_venue_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_venue_proto_rawDesc), len(file_venue_proto_rawDesc)))
+	})
+	return file_venue_proto_rawDescData
+}
+
+var file_venue_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_venue_proto_goTypes = []any{
+	(*Venue)(nil),              // 0: musicclub.venue.Venue
+	(*GeoPoint)(nil),           // 1: musicclub.venue.GeoPoint
+	(*VenueId)(nil),            // 2: musicclub.venue.VenueId
+	(*ListVenuesRequest)(nil),  // 3: musicclub.venue.ListVenuesRequest
+	(*ListVenuesResponse)(nil), // 4: musicclub.venue.ListVenuesResponse
+	(*VenueInput)(nil),         // 5: musicclub.venue.VenueInput
+	(*UpdateVenueRequest)(nil), // 6: musicclub.venue.UpdateVenueRequest
+	(*emptypb.Empty)(nil),      // 7: google.protobuf.Empty
+}
+var file_venue_proto_depIdxs = []int32{
+	1, // 0: musicclub.venue.Venue.coordinates:type_name -> musicclub.venue.GeoPoint
+	0, // 1: musicclub.venue.ListVenuesResponse.venues:type_name -> musicclub.venue.Venue
+	1, // 2: musicclub.venue.VenueInput.coordinates:type_name -> musicclub.venue.GeoPoint
+	5, // 3: musicclub.venue.UpdateVenueRequest.venue:type_name -> musicclub.venue.VenueInput
+	3, // 4: musicclub.venue.VenueService.ListVenues:input_type -> musicclub.venue.ListVenuesRequest
+	2, // 5: musicclub.venue.VenueService.GetVenue:input_type -> musicclub.venue.VenueId
+	5, // 6: musicclub.venue.VenueService.CreateVenue:input_type -> musicclub.venue.VenueInput
+	6, // 7: musicclub.venue.VenueService.UpdateVenue:input_type -> musicclub.venue.UpdateVenueRequest
+	2, // 8: musicclub.venue.VenueService.DeleteVenue:input_type -> musicclub.venue.VenueId
+	4, // 9: musicclub.venue.VenueService.ListVenues:output_type -> musicclub.venue.ListVenuesResponse
+	0, // 10: musicclub.venue.VenueService.GetVenue:output_type -> musicclub.venue.Venue
+	0, // 11: musicclub.venue.VenueService.CreateVenue:output_type -> musicclub.venue.Venue
+	0, // 12: musicclub.venue.VenueService.UpdateVenue:output_type -> musicclub.venue.Venue
+	7, // 13: musicclub.venue.VenueService.DeleteVenue:output_type -> google.protobuf.Empty
+	9, // [9:14] is the sub-list for method output_type
+	4, // [4:9] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_venue_proto_init() }
+func file_venue_proto_init() {
+	if File_venue_proto != nil {
+		return
+	}
+	file_validation_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_venue_proto_rawDesc), len(file_venue_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_venue_proto_goTypes,
+		DependencyIndexes: file_venue_proto_depIdxs,
+		MessageInfos:      file_venue_proto_msgTypes,
+	}.Build()
+	File_venue_proto = out.File
+	file_venue_proto_goTypes = nil
+	file_venue_proto_depIdxs = nil
+}
@@ -0,0 +1,905 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: voting.proto
+
+package proto
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type VotingRound struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// Event the winners are meant for; empty if none.
+	EventId string `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// How many songs win.
+	Seats    uint32                 `protobuf:"varint,4,opt,name=seats,proto3" json:"seats,omitempty"`
+	ClosesAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=closes_at,json=closesAt,proto3" json:"closes_at,omitempty"`
+	// The deadline passed or an admin ended voting early.
+	Closed bool `protobuf:"varint,6,opt,name=closed,proto3" json:"closed,omitempty"`
+	// In the order the admin listed them.
+	Candidates []*Song `protobuf:"bytes,7,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	// Members who cast a ballot.
+	BallotCount uint32 `protobuf:"varint,8,opt,name=ballot_count,json=ballotCount,proto3" json:"ballot_count,omitempty"`
+	// Current user's ranking, first choice first; empty if not voted.
+	MyRanking     []string               `protobuf:"bytes,9,rep,name=my_ranking,json=myRanking,proto3" json:"my_ranking,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VotingRound) Reset() {
+	*x = VotingRound{}
+	mi := &file_voting_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VotingRound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VotingRound) ProtoMessage() {}
+
+func (x *VotingRound) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VotingRound.ProtoReflect.Descriptor instead.
+func (*VotingRound) Descriptor() ([]byte, []int) {
+	return file_voting_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *VotingRound) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VotingRound) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *VotingRound) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *VotingRound) GetSeats() uint32 {
+	if x != nil {
+		return x.Seats
+	}
+	return 0
+}
+
+func (x *VotingRound) GetClosesAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosesAt
+	}
+	return nil
+}
+
+func (x *VotingRound) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
+}
+
+func (x *VotingRound) GetCandidates() []*Song {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+func (x *VotingRound) GetBallotCount() uint32 {
+	if x != nil {
+		return x.BallotCount
+	}
+	return 0
+}
+
+func (x *VotingRound) GetMyRanking() []string {
+	if x != nil {
+		return x.MyRanking
+	}
+	return nil
+}
+
+func (x *VotingRound) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type VotingRoundId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VotingRoundId) Reset() {
+	*x = VotingRoundId{}
+	mi := &file_voting_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VotingRoundId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VotingRoundId) ProtoMessage() {}
+
+func (x *VotingRoundId) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VotingRoundId.ProtoReflect.Descriptor instead.
+func (*VotingRoundId) Descriptor() ([]byte, []int) {
+	return file_voting_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *VotingRoundId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListVotingRoundsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Open rounds only.
+	OnlyOpen bool `protobuf:"varint,1,opt,name=only_open,json=onlyOpen,proto3" json:"only_open,omitempty"`
+	// Pagination cursor (opaque to client).
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize      uint32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVotingRoundsRequest) Reset() {
+	*x = ListVotingRoundsRequest{}
+	mi := &file_voting_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVotingRoundsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVotingRoundsRequest) ProtoMessage() {}
+
+func (x *ListVotingRoundsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVotingRoundsRequest.ProtoReflect.Descriptor instead.
+func (*ListVotingRoundsRequest) Descriptor() ([]byte, []int) {
+	return file_voting_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListVotingRoundsRequest) GetOnlyOpen() bool {
+	if x != nil {
+		return x.OnlyOpen
+	}
+	return false
+}
+
+func (x *ListVotingRoundsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListVotingRoundsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListVotingRoundsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rounds        []*VotingRound         `protobuf:"bytes,1,rep,name=rounds,proto3" json:"rounds,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVotingRoundsResponse) Reset() {
+	*x = ListVotingRoundsResponse{}
+	mi := &file_voting_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVotingRoundsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVotingRoundsResponse) ProtoMessage() {}
+
+func (x *ListVotingRoundsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVotingRoundsResponse.ProtoReflect.Descriptor instead.
+func (*ListVotingRoundsResponse) Descriptor() ([]byte, []int) {
+	return file_voting_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListVotingRoundsResponse) GetRounds() []*VotingRound {
+	if x != nil {
+		return x.Rounds
+	}
+	return nil
+}
+
+func (x *ListVotingRoundsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListVotingRoundsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type CreateVotingRoundRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Title   string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	EventId string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// Defaults to 1; at most the number of candidates.
+	Seats uint32 `protobuf:"varint,3,opt,name=seats,proto3" json:"seats,omitempty"`
+	// Must be in the future.
+	ClosesAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=closes_at,json=closesAt,proto3" json:"closes_at,omitempty"`
+	// Catalog songs, at least two.
+	CandidateSongIds []string `protobuf:"bytes,5,rep,name=candidate_song_ids,json=candidateSongIds,proto3" json:"candidate_song_ids,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateVotingRoundRequest) Reset() {
+	*x = CreateVotingRoundRequest{}
+	mi := &file_voting_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVotingRoundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVotingRoundRequest) ProtoMessage() {}
+
+func (x *CreateVotingRoundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVotingRoundRequest.ProtoReflect.Descriptor instead.
+func (*CreateVotingRoundRequest) Descriptor() ([]byte, []int) {
+	return file_voting_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateVotingRoundRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateVotingRoundRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CreateVotingRoundRequest) GetSeats() uint32 {
+	if x != nil {
+		return x.Seats
+	}
+	return 0
+}
+
+func (x *CreateVotingRoundRequest) GetClosesAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosesAt
+	}
+	return nil
+}
+
+func (x *CreateVotingRoundRequest) GetCandidateSongIds() []string {
+	if x != nil {
+		return x.CandidateSongIds
+	}
+	return nil
+}
+
+type CastBallotRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	RoundId string                 `protobuf:"bytes,1,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	// Candidates in order of preference; unranked ones count as least
+	// preferred. Empty withdraws the ballot.
+	SongIds       []string `protobuf:"bytes,2,rep,name=song_ids,json=songIds,proto3" json:"song_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CastBallotRequest) Reset() {
+	*x = CastBallotRequest{}
+	mi := &file_voting_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CastBallotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CastBallotRequest) ProtoMessage() {}
+
+func (x *CastBallotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CastBallotRequest.ProtoReflect.Descriptor instead.
+func (*CastBallotRequest) Descriptor() ([]byte, []int) {
+	return file_voting_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CastBallotRequest) GetRoundId() string {
+	if x != nil {
+		return x.RoundId
+	}
+	return ""
+}
+
+func (x *CastBallotRequest) GetSongIds() []string {
+	if x != nil {
+		return x.SongIds
+	}
+	return nil
+}
+
+type VotingTally struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SongId        string                 `protobuf:"bytes,1,opt,name=song_id,json=songId,proto3" json:"song_id,omitempty"`
+	Votes         uint32                 `protobuf:"varint,2,opt,name=votes,proto3" json:"votes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VotingTally) Reset() {
+	*x = VotingTally{}
+	mi := &file_voting_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VotingTally) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VotingTally) ProtoMessage() {}
+
+func (x *VotingTally) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VotingTally.ProtoReflect.Descriptor instead.
+func (*VotingTally) Descriptor() ([]byte, []int) {
+	return file_voting_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *VotingTally) GetSongId() string {
+	if x != nil {
+		return x.SongId
+	}
+	return ""
+}
+
+func (x *VotingTally) GetVotes() uint32 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+// One count of the runoff for a seat.
+type RunoffStep struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Candidates still in the race, most votes first.
+	Tallies []*VotingTally `protobuf:"bytes,1,rep,name=tallies,proto3" json:"tallies,omitempty"`
+	// Dropped after this count; empty on the final count.
+	EliminatedSongId string `protobuf:"bytes,2,opt,name=eliminated_song_id,json=eliminatedSongId,proto3" json:"eliminated_song_id,omitempty"`
+	// Ballots with none of the remaining candidates ranked.
+	Exhausted     uint32 `protobuf:"varint,3,opt,name=exhausted,proto3" json:"exhausted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunoffStep) Reset() {
+	*x = RunoffStep{}
+	mi := &file_voting_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunoffStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunoffStep) ProtoMessage() {}
+
+func (x *RunoffStep) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunoffStep.ProtoReflect.Descriptor instead.
+func (*RunoffStep) Descriptor() ([]byte, []int) {
+	return file_voting_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RunoffStep) GetTallies() []*VotingTally {
+	if x != nil {
+		return x.Tallies
+	}
+	return nil
+}
+
+func (x *RunoffStep) GetEliminatedSongId() string {
+	if x != nil {
+		return x.EliminatedSongId
+	}
+	return ""
+}
+
+func (x *RunoffStep) GetExhausted() uint32 {
+	if x != nil {
+		return x.Exhausted
+	}
+	return 0
+}
+
+type VotingSeat struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 1-based; seat 1 is the overall winner.
+	Seat   uint32 `protobuf:"varint,1,opt,name=seat,proto3" json:"seat,omitempty"`
+	SongId string `protobuf:"bytes,2,opt,name=song_id,json=songId,proto3" json:"song_id,omitempty"`
+	// How the seat was decided; later seats are counted again without the
+	// songs that already won.
+	Steps         []*RunoffStep `protobuf:"bytes,3,rep,name=steps,proto3" json:"steps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VotingSeat) Reset() {
+	*x = VotingSeat{}
+	mi := &file_voting_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VotingSeat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VotingSeat) ProtoMessage() {}
+
+func (x *VotingSeat) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VotingSeat.ProtoReflect.Descriptor instead.
+func (*VotingSeat) Descriptor() ([]byte, []int) {
+	return file_voting_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *VotingSeat) GetSeat() uint32 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
+func (x *VotingSeat) GetSongId() string {
+	if x != nil {
+		return x.SongId
+	}
+	return ""
+}
+
+func (x *VotingSeat) GetSteps() []*RunoffStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+type VotingResults struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Round *VotingRound           `protobuf:"bytes,1,opt,name=round,proto3" json:"round,omitempty"`
+	// Up to round.seats, best first.
+	Seats []*VotingSeat `protobuf:"bytes,2,rep,name=seats,proto3" json:"seats,omitempty"`
+	// First-choice votes per candidate, most first.
+	FirstChoices  []*VotingTally `protobuf:"bytes,3,rep,name=first_choices,json=firstChoices,proto3" json:"first_choices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VotingResults) Reset() {
+	*x = VotingResults{}
+	mi := &file_voting_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VotingResults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VotingResults) ProtoMessage() {}
+
+func (x *VotingResults) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VotingResults.ProtoReflect.Descriptor instead.
+func (*VotingResults) Descriptor() ([]byte, []int) {
+	return file_voting_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *VotingResults) GetRound() *VotingRound {
+	if x != nil {
+		return x.Round
+	}
+	return nil
+}
+
+func (x *VotingResults) GetSeats() []*VotingSeat {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+func (x *VotingResults) GetFirstChoices() []*VotingTally {
+	if x != nil {
+		return x.FirstChoices
+	}
+	return nil
+}
+
+type SeedTracklistRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	RoundId string                 `protobuf:"bytes,1,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	// Defaults to the round's event.
+	EventId       string `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeedTracklistRequest) Reset() {
+	*x = SeedTracklistRequest{}
+	mi := &file_voting_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeedTracklistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeedTracklistRequest) ProtoMessage() {}
+
+func (x *SeedTracklistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeedTracklistRequest.ProtoReflect.Descriptor instead.
+func (*SeedTracklistRequest) Descriptor() ([]byte, []int) {
+	return file_voting_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SeedTracklistRequest) GetRoundId() string {
+	if x != nil {
+		return x.RoundId
+	}
+	return ""
+}
+
+func (x *SeedTracklistRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+var File_voting_proto protoreflect.FileDescriptor
+
+const file_voting_proto_rawDesc = "" +
+	"\n" +
+	"\fvoting.proto\x12\x10musicclub.voting\x1a\x1bbuf/validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\vevent.proto\x1a\n" +
+	"song.proto\x1a\x10validation.proto\"\xe8\x02\n" +
+	"\vVotingRound\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12\x14\n" +
+	"\x05seats\x18\x04 \x01(\rR\x05seats\x127\n" +
+	"\tcloses_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bclosesAt\x12\x16\n" +
+	"\x06closed\x18\x06 \x01(\bR\x06closed\x124\n" +
+	"\n" +
+	"candidates\x18\a \x03(\v2\x14.musicclub.song.SongR\n" +
+	"candidates\x12!\n" +
+	"\fballot_count\x18\b \x01(\rR\vballotCount\x12\x1d\n" +
+	"\n" +
+	"my_ranking\x18\t \x03(\tR\tmyRanking\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\")\n" +
+	"\rVotingRoundId\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"|\n" +
+	"\x17ListVotingRoundsRequest\x12\x1b\n" +
+	"\tonly_open\x18\x01 \x01(\bR\bonlyOpen\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12%\n" +
+	"\tpage_size\x18\x03 \x01(\rB\b\xbaH\x05*\x03\x18\xc8\x01R\bpageSize\"\x9a\x01\n" +
+	"\x18ListVotingRoundsResponse\x125\n" +
+	"\x06rounds\x18\x01 \x03(\v2\x1d.musicclub.voting.VotingRoundR\x06rounds\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"\xdf\x01\n" +
+	"\x18CreateVotingRoundRequest\x12\x1e\n" +
+	"\x05title\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xc0>\x01R\x05title\x12&\n" +
+	"\bevent_id\x18\x02 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\aeventId\x12\x14\n" +
+	"\x05seats\x18\x03 \x01(\rR\x05seats\x127\n" +
+	"\tcloses_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bclosesAt\x12,\n" +
+	"\x12candidate_song_ids\x18\x05 \x03(\tR\x10candidateSongIds\"I\n" +
+	"\x11CastBallotRequest\x12\x19\n" +
+	"\bround_id\x18\x01 \x01(\tR\aroundId\x12\x19\n" +
+	"\bsong_ids\x18\x02 \x03(\tR\asongIds\"<\n" +
+	"\vVotingTally\x12\x17\n" +
+	"\asong_id\x18\x01 \x01(\tR\x06songId\x12\x14\n" +
+	"\x05votes\x18\x02 \x01(\rR\x05votes\"\x91\x01\n" +
+	"\n" +
+	"RunoffStep\x127\n" +
+	"\atallies\x18\x01 \x03(\v2\x1d.musicclub.voting.VotingTallyR\atallies\x12,\n" +
+	"\x12eliminated_song_id\x18\x02 \x01(\tR\x10eliminatedSongId\x12\x1c\n" +
+	"\texhausted\x18\x03 \x01(\rR\texhausted\"m\n" +
+	"\n" +
+	"VotingSeat\x12\x12\n" +
+	"\x04seat\x18\x01 \x01(\rR\x04seat\x12\x17\n" +
+	"\asong_id\x18\x02 \x01(\tR\x06songId\x122\n" +
+	"\x05steps\x18\x03 \x03(\v2\x1c.musicclub.voting.RunoffStepR\x05steps\"\xbc\x01\n" +
+	"\rVotingResults\x123\n" +
+	"\x05round\x18\x01 \x01(\v2\x1d.musicclub.voting.VotingRoundR\x05round\x122\n" +
+	"\x05seats\x18\x02 \x03(\v2\x1c.musicclub.voting.VotingSeatR\x05seats\x12B\n" +
+	"\rfirst_choices\x18\x03 \x03(\v2\x1d.musicclub.voting.VotingTallyR\ffirstChoices\"L\n" +
+	"\x14SeedTracklistRequest\x12\x19\n" +
+	"\bround_id\x18\x01 \x01(\tR\aroundId\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId2\xce\x05\n" +
+	"\rVotingService\x12i\n" +
+	"\x10ListVotingRounds\x12).musicclub.voting.ListVotingRoundsRequest\x1a*.musicclub.voting.ListVotingRoundsResponse\x12P\n" +
+	"\x0eGetVotingRound\x12\x1f.musicclub.voting.VotingRoundId\x1a\x1d.musicclub.voting.VotingRound\x12^\n" +
+	"\x11CreateVotingRound\x12*.musicclub.voting.CreateVotingRoundRequest\x1a\x1d.musicclub.voting.VotingRound\x12R\n" +
+	"\x10CloseVotingRound\x12\x1f.musicclub.voting.VotingRoundId\x1a\x1d.musicclub.voting.VotingRound\x12L\n" +
+	"\x11DeleteVotingRound\x12\x1f.musicclub.voting.VotingRoundId\x1a\x16.google.protobuf.Empty\x12P\n" +
+	"\n" +
+	"CastBallot\x12#.musicclub.voting.CastBallotRequest\x1a\x1d.musicclub.voting.VotingRound\x12T\n" +
+	"\x10GetVotingResults\x12\x1f.musicclub.voting.VotingRoundId\x1a\x1f.musicclub.voting.VotingResults\x12V\n" +
+	"\rSeedTracklist\x12&.musicclub.voting.SeedTracklistRequest\x1a\x1d.musicclub.event.EventDetailsB\x1cZ\x1amusicclubbot/backend/protob\x06proto3"
+
+var (
+	file_voting_proto_rawDescOnce sync.Once
+	file_voting_proto_rawDescData []byte
+)
+
+func file_voting_proto_rawDescGZIP() []byte {
+	file_voting_proto_rawDescOnce.Do(func() {
+		file_voting_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_voting_proto_rawDesc), len(file_voting_proto_rawDesc)))
+	})
+	return file_voting_proto_rawDescData
+}
+
+var file_voting_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_voting_proto_goTypes = []any{
+	(*VotingRound)(nil),              // 0: musicclub.voting.VotingRound
+	(*VotingRoundId)(nil),            // 1: musicclub.voting.VotingRoundId
+	(*ListVotingRoundsRequest)(nil),  // 2: musicclub.voting.ListVotingRoundsRequest
+	(*ListVotingRoundsResponse)(nil), // 3: musicclub.voting.ListVotingRoundsResponse
+	(*CreateVotingRoundRequest)(nil), // 4: musicclub.voting.CreateVotingRoundRequest
+	(*CastBallotRequest)(nil),        // 5: musicclub.voting.CastBallotRequest
+	(*VotingTally)(nil),              // 6: musicclub.voting.VotingTally
+	(*RunoffStep)(nil),               // 7: musicclub.voting.RunoffStep
+	(*VotingSeat)(nil),               // 8: musicclub.voting.VotingSeat
+	(*VotingResults)(nil),            // 9: musicclub.voting.VotingResults
+	(*SeedTracklistRequest)(nil),     // 10: musicclub.voting.SeedTracklistRequest
+	(*timestamppb.Timestamp)(nil),    // 11: google.protobuf.Timestamp
+	(*Song)(nil),                     // 12: musicclub.song.Song
+	(*emptypb.Empty)(nil),            // 13: google.protobuf.Empty
+	(*EventDetails)(nil),             // 14: musicclub.event.EventDetails
+}
+var file_voting_proto_depIdxs = []int32{
+	11, // 0: musicclub.voting.VotingRound.closes_at:type_name -> google.protobuf.Timestamp
+	12, // 1: musicclub.voting.VotingRound.candidates:type_name -> musicclub.song.Song
+	11, // 2: musicclub.voting.VotingRound.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: musicclub.voting.ListVotingRoundsResponse.rounds:type_name -> musicclub.voting.VotingRound
+	11, // 4: musicclub.voting.CreateVotingRoundRequest.closes_at:type_name -> google.protobuf.Timestamp
+	6,  // 5: musicclub.voting.RunoffStep.tallies:type_name -> musicclub.voting.VotingTally
+	7,  // 6: musicclub.voting.VotingSeat.steps:type_name -> musicclub.voting.RunoffStep
+	0,  // 7: musicclub.voting.VotingResults.round:type_name -> musicclub.voting.VotingRound
+	8,  // 8: musicclub.voting.VotingResults.seats:type_name -> musicclub.voting.VotingSeat
+	6,  // 9: musicclub.voting.VotingResults.first_choices:type_name -> musicclub.voting.VotingTally
+	2,  // 10: musicclub.voting.VotingService.ListVotingRounds:input_type -> musicclub.voting.ListVotingRoundsRequest
+	1,  // 11: musicclub.voting.VotingService.GetVotingRound:input_type -> musicclub.voting.VotingRoundId
+	4,  // 12: musicclub.voting.VotingService.CreateVotingRound:input_type -> musicclub.voting.CreateVotingRoundRequest
+	1,  // 13: musicclub.voting.VotingService.CloseVotingRound:input_type -> musicclub.voting.VotingRoundId
+	1,  // 14: musicclub.voting.VotingService.DeleteVotingRound:input_type -> musicclub.voting.VotingRoundId
+	5,  // 15: musicclub.voting.VotingService.CastBallot:input_type -> musicclub.voting.CastBallotRequest
+	1,  // 16: musicclub.voting.VotingService.GetVotingResults:input_type -> musicclub.voting.VotingRoundId
+	10, // 17: musicclub.voting.VotingService.SeedTracklist:input_type -> musicclub.voting.SeedTracklistRequest
+	3,  // 18: musicclub.voting.VotingService.ListVotingRounds:output_type -> musicclub.voting.ListVotingRoundsResponse
+	0,  // 19: musicclub.voting.VotingService.GetVotingRound:output_type -> musicclub.voting.VotingRound
+	0,  // 20: musicclub.voting.VotingService.CreateVotingRound:output_type -> musicclub.voting.VotingRound
+	0,  // 21: musicclub.voting.VotingService.CloseVotingRound:output_type -> musicclub.voting.VotingRound
+	13, // 22: musicclub.voting.VotingService.DeleteVotingRound:output_type -> google.protobuf.Empty
+	0,  // 23: musicclub.voting.VotingService.CastBallot:output_type -> musicclub.voting.VotingRound
+	9,  // 24: musicclub.voting.VotingService.GetVotingResults:output_type -> musicclub.voting.VotingResults
+	14, // 25: musicclub.voting.VotingService.SeedTracklist:output_type -> musicclub.event.EventDetails
+	18, // [18:26] is the sub-list for method output_type
+	10, // [10:18] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_voting_proto_init() }
+func file_voting_proto_init() {
+	if File_voting_proto != nil {
+		return
+	}
+	file_event_proto_init()
+	file_song_proto_init()
+	file_validation_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_voting_proto_rawDesc), len(file_voting_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_voting_proto_goTypes,
+		DependencyIndexes: file_voting_proto_depIdxs,
+		MessageInfos:      file_voting_proto_msgTypes,
+	}.Build()
+	File_voting_proto = out.File
+	file_voting_proto_goTypes = nil
+	file_voting_proto_depIdxs = nil
+}
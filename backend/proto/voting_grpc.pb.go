@@ -0,0 +1,412 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v3.21.12
+// source: voting.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	VotingService_ListVotingRounds_FullMethodName  = "/musicclub.voting.VotingService/ListVotingRounds"
+	VotingService_GetVotingRound_FullMethodName    = "/musicclub.voting.VotingService/GetVotingRound"
+	VotingService_CreateVotingRound_FullMethodName = "/musicclub.voting.VotingService/CreateVotingRound"
+	VotingService_CloseVotingRound_FullMethodName  = "/musicclub.voting.VotingService/CloseVotingRound"
+	VotingService_DeleteVotingRound_FullMethodName = "/musicclub.voting.VotingService/DeleteVotingRound"
+	VotingService_CastBallot_FullMethodName        = "/musicclub.voting.VotingService/CastBallot"
+	VotingService_GetVotingResults_FullMethodName  = "/musicclub.voting.VotingService/GetVotingResults"
+	VotingService_SeedTracklist_FullMethodName     = "/musicclub.voting.VotingService/SeedTracklist"
+)
+
+// VotingServiceClient is the client API for VotingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Ranked-choice voting on songs, e.g. to pick an event's setlist. Members
+// rank the candidates; winners are counted by instant runoff.
+type VotingServiceClient interface {
+	// Open rounds first, soonest deadline first, then closed ones, latest
+	// first.
+	ListVotingRounds(ctx context.Context, in *ListVotingRoundsRequest, opts ...grpc.CallOption) (*ListVotingRoundsResponse, error)
+	GetVotingRound(ctx context.Context, in *VotingRoundId, opts ...grpc.CallOption) (*VotingRound, error)
+	// Admins only.
+	CreateVotingRound(ctx context.Context, in *CreateVotingRoundRequest, opts ...grpc.CallOption) (*VotingRound, error)
+	// Ends voting before the deadline. Admins only.
+	CloseVotingRound(ctx context.Context, in *VotingRoundId, opts ...grpc.CallOption) (*VotingRound, error)
+	// Admins only.
+	DeleteVotingRound(ctx context.Context, in *VotingRoundId, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Replaces the current user's ranking while the round is open.
+	CastBallot(ctx context.Context, in *CastBallotRequest, opts ...grpc.CallOption) (*VotingRound, error)
+	// Counts the ballots; available once the round is closed.
+	GetVotingResults(ctx context.Context, in *VotingRoundId, opts ...grpc.CallOption) (*VotingResults, error)
+	// Adds the winners not yet in the tracklist after the items outside of
+	// sets, best first (requires rights to edit the event's tracklist).
+	SeedTracklist(ctx context.Context, in *SeedTracklistRequest, opts ...grpc.CallOption) (*EventDetails, error)
+}
+
+type votingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVotingServiceClient(cc grpc.ClientConnInterface) VotingServiceClient {
+	return &votingServiceClient{cc}
+}
+
+func (c *votingServiceClient) ListVotingRounds(ctx context.Context, in *ListVotingRoundsRequest, opts ...grpc.CallOption) (*ListVotingRoundsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVotingRoundsResponse)
+	err := c.cc.Invoke(ctx, VotingService_ListVotingRounds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *votingServiceClient) GetVotingRound(ctx context.Context, in *VotingRoundId, opts ...grpc.CallOption) (*VotingRound, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VotingRound)
+	err := c.cc.Invoke(ctx, VotingService_GetVotingRound_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *votingServiceClient) CreateVotingRound(ctx context.Context, in *CreateVotingRoundRequest, opts ...grpc.CallOption) (*VotingRound, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VotingRound)
+	err := c.cc.Invoke(ctx, VotingService_CreateVotingRound_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *votingServiceClient) CloseVotingRound(ctx context.Context, in *VotingRoundId, opts ...grpc.CallOption) (*VotingRound, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VotingRound)
+	err := c.cc.Invoke(ctx, VotingService_CloseVotingRound_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *votingServiceClient) DeleteVotingRound(ctx context.Context, in *VotingRoundId, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, VotingService_DeleteVotingRound_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *votingServiceClient) CastBallot(ctx context.Context, in *CastBallotRequest, opts ...grpc.CallOption) (*VotingRound, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VotingRound)
+	err := c.cc.Invoke(ctx, VotingService_CastBallot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *votingServiceClient) GetVotingResults(ctx context.Context, in *VotingRoundId, opts ...grpc.CallOption) (*VotingResults, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VotingResults)
+	err := c.cc.Invoke(ctx, VotingService_GetVotingResults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *votingServiceClient) SeedTracklist(ctx context.Context, in *SeedTracklistRequest, opts ...grpc.CallOption) (*EventDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventDetails)
+	err := c.cc.Invoke(ctx, VotingService_SeedTracklist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VotingServiceServer is the server API for VotingService service.
+// All implementations must embed UnimplementedVotingServiceServer
+// for forward compatibility.
+//
+// Ranked-choice voting on songs, e.g. to pick an event's setlist. Members
+// rank the candidates; winners are counted by instant runoff.
+type VotingServiceServer interface {
+	// Open rounds first, soonest deadline first, then closed ones, latest
+	// first.
+	ListVotingRounds(context.Context, *ListVotingRoundsRequest) (*ListVotingRoundsResponse, error)
+	GetVotingRound(context.Context, *VotingRoundId) (*VotingRound, error)
+	// Admins only.
+	CreateVotingRound(context.Context, *CreateVotingRoundRequest) (*VotingRound, error)
+	// Ends voting before the deadline. Admins only.
+	CloseVotingRound(context.Context, *VotingRoundId) (*VotingRound, error)
+	// Admins only.
+	DeleteVotingRound(context.Context, *VotingRoundId) (*emptypb.Empty, error)
+	// Replaces the current user's ranking while the round is open.
+	CastBallot(context.Context, *CastBallotRequest) (*VotingRound, error)
+	// Counts the ballots; available once the round is closed.
+	GetVotingResults(context.Context, *VotingRoundId) (*VotingResults, error)
+	// Adds the winners not yet in the tracklist after the items outside of
+	// sets, best first (requires rights to edit the event's tracklist).
+	SeedTracklist(context.Context, *SeedTracklistRequest) (*EventDetails, error)
+	mustEmbedUnimplementedVotingServiceServer()
+}
+
+// UnimplementedVotingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedVotingServiceServer struct{}
+
+func (UnimplementedVotingServiceServer) ListVotingRounds(context.Context, *ListVotingRoundsRequest) (*ListVotingRoundsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListVotingRounds not implemented")
+}
+func (UnimplementedVotingServiceServer) GetVotingRound(context.Context, *VotingRoundId) (*VotingRound, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetVotingRound not implemented")
+}
+func (UnimplementedVotingServiceServer) CreateVotingRound(context.Context, *CreateVotingRoundRequest) (*VotingRound, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateVotingRound not implemented")
+}
+func (UnimplementedVotingServiceServer) CloseVotingRound(context.Context, *VotingRoundId) (*VotingRound, error) {
+	return nil, status.Error(codes.Unimplemented, "method CloseVotingRound not implemented")
+}
+func (UnimplementedVotingServiceServer) DeleteVotingRound(context.Context, *VotingRoundId) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteVotingRound not implemented")
+}
+func (UnimplementedVotingServiceServer) CastBallot(context.Context, *CastBallotRequest) (*VotingRound, error) {
+	return nil, status.Error(codes.Unimplemented, "method CastBallot not implemented")
+}
+func (UnimplementedVotingServiceServer) GetVotingResults(context.Context, *VotingRoundId) (*VotingResults, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetVotingResults not implemented")
+}
+func (UnimplementedVotingServiceServer) SeedTracklist(context.Context, *SeedTracklistRequest) (*EventDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method SeedTracklist not implemented")
+}
+func (UnimplementedVotingServiceServer) mustEmbedUnimplementedVotingServiceServer() {}
+func (UnimplementedVotingServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeVotingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VotingServiceServer will
+// result in compilation errors.
+type UnsafeVotingServiceServer interface {
+	mustEmbedUnimplementedVotingServiceServer()
+}
+
+func RegisterVotingServiceServer(s grpc.ServiceRegistrar, srv VotingServiceServer) {
+	// If the following call panics, it indicates UnimplementedVotingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&VotingService_ServiceDesc, srv)
+}
+
+func _VotingService_ListVotingRounds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVotingRoundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VotingServiceServer).ListVotingRounds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VotingService_ListVotingRounds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VotingServiceServer).ListVotingRounds(ctx, req.(*ListVotingRoundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VotingService_GetVotingRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VotingRoundId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VotingServiceServer).GetVotingRound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VotingService_GetVotingRound_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VotingServiceServer).GetVotingRound(ctx, req.(*VotingRoundId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VotingService_CreateVotingRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVotingRoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VotingServiceServer).CreateVotingRound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VotingService_CreateVotingRound_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VotingServiceServer).CreateVotingRound(ctx, req.(*CreateVotingRoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VotingService_CloseVotingRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VotingRoundId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VotingServiceServer).CloseVotingRound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VotingService_CloseVotingRound_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VotingServiceServer).CloseVotingRound(ctx, req.(*VotingRoundId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VotingService_DeleteVotingRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VotingRoundId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VotingServiceServer).DeleteVotingRound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VotingService_DeleteVotingRound_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VotingServiceServer).DeleteVotingRound(ctx, req.(*VotingRoundId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VotingService_CastBallot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CastBallotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VotingServiceServer).CastBallot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VotingService_CastBallot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VotingServiceServer).CastBallot(ctx, req.(*CastBallotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VotingService_GetVotingResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VotingRoundId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VotingServiceServer).GetVotingResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VotingService_GetVotingResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VotingServiceServer).GetVotingResults(ctx, req.(*VotingRoundId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VotingService_SeedTracklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SeedTracklistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VotingServiceServer).SeedTracklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VotingService_SeedTracklist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VotingServiceServer).SeedTracklist(ctx, req.(*SeedTracklistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// VotingService_ServiceDesc is the grpc.ServiceDesc for VotingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VotingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "musicclub.voting.VotingService",
+	HandlerType: (*VotingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListVotingRounds",
+			Handler:    _VotingService_ListVotingRounds_Handler,
+		},
+		{
+			MethodName: "GetVotingRound",
+			Handler:    _VotingService_GetVotingRound_Handler,
+		},
+		{
+			MethodName: "CreateVotingRound",
+			Handler:    _VotingService_CreateVotingRound_Handler,
+		},
+		{
+			MethodName: "CloseVotingRound",
+			Handler:    _VotingService_CloseVotingRound_Handler,
+		},
+		{
+			MethodName: "DeleteVotingRound",
+			Handler:    _VotingService_DeleteVotingRound_Handler,
+		},
+		{
+			MethodName: "CastBallot",
+			Handler:    _VotingService_CastBallot_Handler,
+		},
+		{
+			MethodName: "GetVotingResults",
+			Handler:    _VotingService_GetVotingResults_Handler,
+		},
+		{
+			MethodName: "SeedTracklist",
+			Handler:    _VotingService_SeedTracklist_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "voting.proto",
+}
@@ -1,9 +1,11 @@
 import asyncio
 import logging
 import os
-from aiogram import Bot, Dispatcher, Router
+from urllib.parse import urlparse
+from aiogram import Bot, Dispatcher, F, Router
 from aiogram.filters import CommandStart, Command, CommandObject
 from aiogram.types import (
+    CallbackQuery,
     Message,
     InlineKeyboardMarkup,
     InlineKeyboardButton,
@@ -101,6 +103,107 @@ async def auth_confirm(token: UUID, telegram_user_id: int) -> bool:
     return True
 
 
+async def save_feedback(event_id: UUID, telegram_user_id: int, rating: int) -> bool:
+    """Stores a rating from the survey invite; False if the survey is closed
+    or the Telegram account isn't linked to a member."""
+    if DB_CONN is None:
+        logger.error("Database connection is not available.")
+        return False
+
+    try:
+        saved = execute(
+            DB_CONN,
+            """
+            INSERT INTO event_feedback (event_id, user_id, rating)
+            SELECT s.event_id, u.id, %s
+            FROM event_feedback_survey s, app_user u
+            WHERE s.event_id = %s AND s.closes_at > NOW() AND u.tg_user_id = %s
+            ON CONFLICT (event_id, user_id) DO UPDATE
+            SET rating = EXCLUDED.rating, submitted_at = NOW()
+            """,
+            (rating, str(event_id), telegram_user_id),
+        )
+    except Exception as exc:
+        logger.error("Failed to save feedback for event %s: %s", event_id, exc)
+        return False
+    return saved > 0
+
+
+# Longest suggestion /suggest accepts.
+MAX_SUGGESTION_LENGTH = 2000
+
+
+async def save_suggestion(telegram_user_id: int, body: str) -> bool:
+    """Stores an anonymous suggestion; False if the sender isn't a club
+    member. Only the text is kept, nothing about who sent it."""
+    if DB_CONN is None:
+        logger.error("Database connection is not available.")
+        return False
+
+    try:
+        saved = execute(
+            DB_CONN,
+            """
+            INSERT INTO suggestion (body)
+            SELECT %s
+            WHERE EXISTS (
+                SELECT 1 FROM app_user
+                WHERE tg_user_id = %s AND is_chat_member AND deactivated_at IS NULL
+            )
+            """,
+            (body, telegram_user_id),
+        )
+    except Exception as exc:
+        logger.error("Failed to save suggestion: %s", exc)
+        return False
+    return saved > 0
+
+
+# Pending song requests one Telegram account may have at a time.
+MAX_PENDING_SONG_REQUESTS = 3
+
+# Sites the catalog can link songs to.
+SONG_LINK_HOSTS = ("youtube.com", "youtu.be", "music.yandex.ru", "music.yandex.com", "soundcloud.com")
+
+
+def is_song_link(url: str) -> bool:
+    parsed = urlparse(url)
+    host = (parsed.hostname or "").removeprefix("www.").removeprefix("m.")
+    return parsed.scheme in ("http", "https") and host in SONG_LINK_HOSTS
+
+
+async def save_song_request(
+    telegram_user_id: int, requester_name: str, link: str, comment: str
+) -> bool:
+    """Queues a song request for admins; False once the requester already has
+    MAX_PENDING_SONG_REQUESTS waiting."""
+    if DB_CONN is None:
+        logger.error("Database connection is not available.")
+        return False
+
+    try:
+        saved = execute(
+            DB_CONN,
+            """
+            INSERT INTO song_request (link_url, comment, requester_tg_id, requester_name)
+            SELECT %s, %s, %s, %s
+            WHERE (SELECT COUNT(*) FROM song_request WHERE requester_tg_id = %s AND status = 'pending') < %s
+            """,
+            (
+                link,
+                comment,
+                telegram_user_id,
+                requester_name,
+                telegram_user_id,
+                MAX_PENDING_SONG_REQUESTS,
+            ),
+        )
+    except Exception as exc:
+        logger.error("Failed to save song request: %s", exc)
+        return False
+    return saved > 0
+
+
 # ---------------- handlers ----------------
 @router.message(CommandStart(deep_link=True))
 async def cmd_start_with_args(message: Message, command: CommandObject):
@@ -111,6 +214,11 @@ async def cmd_start_with_args(message: Message, command: CommandObject):
     args = command.args
     logger.info("Received command start with %s", args)
 
+    # Share link for people outside the club: t.me/<bot>?start=request
+    if args == "request":
+        await message.answer(SONG_REQUEST_HELP())
+        return
+
     if not args or not args.startswith("auth_"):
         await message.answer(_("Invalid start parameter."))
         return
@@ -155,9 +263,101 @@ async def cmd_start(message: Message):
     )
 
 
+@router.callback_query(F.data.startswith("feedback:"))
+async def on_feedback_rating(callback: CallbackQuery):
+    """
+    Handles rating buttons of survey invites: feedback:<event uuid>:<1-5>
+    """
+    try:
+        _prefix, raw_event, raw_rating = callback.data.split(":")
+        event_id = UUID(raw_event)
+        rating = int(raw_rating)
+    except ValueError:
+        await callback.answer(_("Invalid feedback button."))
+        return
+    if not 1 <= rating <= 5:
+        await callback.answer(_("Invalid feedback button."))
+        return
+
+    if await save_feedback(event_id, callback.from_user.id, rating):
+        await callback.answer(_("Thanks for your feedback!"))
+    else:
+        await callback.answer(
+            _("The survey is closed or your account is not linked."), show_alert=True
+        )
+
+
+@router.message(Command("suggest"), F.chat.type == "private")
+async def cmd_suggest(message: Message, command: CommandObject):
+    """
+    Handles:
+      /suggest <text>
+    """
+    body = (command.args or "").strip()
+    if not body:
+        await message.answer(
+            _(
+                "Write your idea after the command, e.g. /suggest let's try metal covers. It is sent anonymously."
+            )
+        )
+        return
+    if len(body) > MAX_SUGGESTION_LENGTH:
+        await message.answer(
+            _("The suggestion is too long, keep it under {limit} characters.").format(
+                limit=MAX_SUGGESTION_LENGTH
+            )
+        )
+        return
+
+    if await save_suggestion(message.from_user.id, body):
+        await message.answer(_("Thanks! Your suggestion was passed on anonymously."))
+    else:
+        await message.answer(
+            _("Only club members with a linked account can send suggestions.")
+        )
+
+
+def SONG_REQUEST_HELP() -> str:
+    return _(
+        "Want the club to play a song? Send /request <link> <comment>, e.g. /request https://youtu.be/... great for the summer gig. Links to YouTube, Yandex Music and SoundCloud are accepted."
+    )
+
+
+@router.message(Command("request"), F.chat.type == "private")
+async def cmd_request(message: Message, command: CommandObject):
+    """
+    Handles:
+      /request <link> [comment]
+    Open to anyone, club member or not.
+    """
+    link, _sep, comment = (command.args or "").strip().partition(" ")
+    if not link or not is_song_link(link):
+        await message.answer(SONG_REQUEST_HELP())
+        return
+
+    if await save_song_request(
+        message.from_user.id, message.from_user.full_name, link, comment.strip()
+    ):
+        await message.answer(
+            _("Thanks! The club admins will review your request and let you know.")
+        )
+    else:
+        await message.answer(
+            _(
+                "You already have {limit} requests waiting for review, please wait for them first."
+            ).format(limit=MAX_PENDING_SONG_REQUESTS)
+        )
+
+
 @router.message(Command("help"))
 async def cmd_help(message: Message):
-    await message.answer(_("Send /start to get the webapp link."))
+    await message.answer(
+        _("Send /start to get the webapp link.")
+        + "\n"
+        + _("Send /suggest <text> to share an idea anonymously.")
+        + "\n"
+        + _("Send /request <link> to ask the club to play a song.")
+    )
 
 
 # ---------------- entrypoint ----------------
@@ -170,6 +370,7 @@ async def main():
     dp = Dispatcher()
 
     dp.message.middleware(MyI18nMiddleware(i18n))
+    dp.callback_query.middleware(MyI18nMiddleware(i18n))
     dp.include_router(router)
 
     logger.info("Starting polling for bot")
@@ -73,7 +73,7 @@ const EventList: React.FC<Props> = ({ permissions }) => {
 					data={detailQuery.data}
 					onClose={() => setSelectedId(null)}
 					onUpdate={async (payload: { title: string; startAt?: Timestamp; location?: string; notifyDayBefore?: boolean; notifyHourBefore?: boolean }) => {
-						await updateEvent({ ...payload, id: selectedId });
+						await updateEvent({ ...payload, id: selectedId, expectedVersion: detailQuery.data?.event?.version ?? 0n });
 						queryClient.invalidateQueries({ queryKey: ["event", selectedId] });
 						queryClient.invalidateQueries({ queryKey: ["events"] });
 					}}
@@ -98,7 +98,7 @@ const SongList: React.FC<Props> = ({ permissions, profile }) => {
 					onJoin={(role) => joinMutation.mutate({ songId: selectedId, role })}
 					onLeave={(role) => leaveMutation.mutate({ songId: selectedId, role })}
 					onUpdate={async (payload) => {
-						await updateSong({ ...payload, id: selectedId });
+						await updateSong({ ...payload, id: selectedId, expectedVersion: detailQuery.data?.song?.version ?? 0n });
 						queryClient.invalidateQueries({ queryKey: ["song", selectedId] });
 						queryClient.invalidateQueries({ queryKey: ["songs"] });
 					}}
@@ -0,0 +1,1276 @@
+// @generated by protoc-gen-es v2.10.2 with parameter "target=ts,import_extension=ts"
+// @generated from file admin.proto (package musicclub.admin, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "./buf/validate/validate_pb.ts";
+import type { EmptySchema, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_empty, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { CreateSongRequest, SongLink } from "./song_pb.ts";
+import { file_song } from "./song_pb.ts";
+import type { User, UserSchema } from "./user_pb.ts";
+import { file_user } from "./user_pb.ts";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file admin.proto.
+ */
+export const file_admin: GenFile = /*@__PURE__*/
+  fileDesc("CgthZG1pbi5wcm90bxIPbXVzaWNjbHViLmFkbWluIs4BChBMaXN0VXNlcnNSZXF1ZXN0Eg0KBXF1ZXJ5GAEgASgJEjAKC2NoYXRfbWVtYmVyGAIgASgOMhsubXVzaWNjbHViLmFkbWluLkZsYWdGaWx0ZXISNAoPdGVsZWdyYW1fbGlua2VkGAMgASgOMhsubXVzaWNjbHViLmFkbWluLkZsYWdGaWx0ZXISEgoKcGVybWlzc2lvbhgEIAEoCRISCgpwYWdlX3Rva2VuGAUgASgJEhsKCXBhZ2Vfc2l6ZRgGIAEoDUIIukgFKgMYyAEixgIKC1VzZXJTdW1tYXJ5EiIKBHVzZXIYASABKAsyFC5tdXNpY2NsdWIudXNlci5Vc2VyEhYKDmlzX2NoYXRfbWVtYmVyGAIgASgIEhcKD3RlbGVncmFtX2xpbmtlZBgDIAEoCBINCgVyb2xlcxgEIAMoCRISCgpzb25nX2NvdW50GAUgASgFEhMKC2V2ZW50X2NvdW50GAYgASgFEjAKDGxhc3Rfc2Vlbl9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKY3JlYXRlZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMwoKc3VzcGVuc2lvbhgJIAEoCzIfLm11c2ljY2x1Yi5hZG1pbi5Vc2VyU3VzcGVuc2lvbhITCgtpbnZpdGVfY29kZRgKIAEoCSJuChFMaXN0VXNlcnNSZXNwb25zZRIrCgV1c2VycxgBIAMoCzIcLm11c2ljY2x1Yi5hZG1pbi5Vc2VyU3VtbWFyeRIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkSEwoLdG90YWxfY291bnQYAyABKAUi1gEKF0xpc3RBdWRpdEVudHJpZXNSZXF1ZXN0EhMKC2VudGl0eV90eXBlGAEgASgJEhEKCWVudGl0eV9pZBgCIAEoCRIQCghhY3Rvcl9pZBgDIAEoCRIoCgRmcm9tGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBImCgJ0bxgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEgoKcGFnZV90b2tlbhgGIAEoCRIbCglwYWdlX3NpemUYByABKA1CCLpIBSoDGMgBIrYBCgpBdWRpdEVudHJ5EgoKAmlkGAEgASgJEiMKBWFjdG9yGAIgASgLMhQubXVzaWNjbHViLnVzZXIuVXNlchITCgtlbnRpdHlfdHlwZRgDIAEoCRIRCgllbnRpdHlfaWQYBCABKAkSDgoGYWN0aW9uGAUgASgJEg8KB3N1bW1hcnkYBiABKAkSLgoKY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiYQoYTGlzdEF1ZGl0RW50cmllc1Jlc3BvbnNlEiwKB2VudHJpZXMYASADKAsyGy5tdXNpY2NsdWIuYWRtaW4uQXVkaXRFbnRyeRIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkieQoYU2V0VXNlclN1c3BlbnNpb25SZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEQoJc3VzcGVuZGVkGAIgASgIEikKBXVudGlsGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIOCgZyZWFzb24YBCABKAkibwoOVXNlclN1c3BlbnNpb24SDwoHdXNlcl9pZBgBIAEoCRIRCglzdXNwZW5kZWQYAiABKAgSKQoFdW50aWwYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg4KBnJlYXNvbhgEIAEoCSJXChFNZXJnZVVzZXJzUmVxdWVzdBIgCg5zb3VyY2VfdXNlcl9pZBgBIAEoCUIIukgFcgOwAQESIAoOdGFyZ2V0X3VzZXJfaWQYAiABKAlCCLpIBXIDsAEBImkKF0NyZWF0ZUludml0ZUNvZGVSZXF1ZXN0EhAKCG1heF91c2VzGAEgASgNEi4KCmV4cGlyZXNfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEgwKBG5vdGUYAyABKAkitwIKCkludml0ZUNvZGUSDAoEY29kZRgBIAEoCRIoCgpjcmVhdGVkX2J5GAIgASgLMhQubXVzaWNjbHViLnVzZXIuVXNlchIQCghtYXhfdXNlcxgDIAEoDRIMCgR1c2VzGAQgASgNEi4KCmV4cGlyZXNfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEgwKBG5vdGUYBiABKAkSDgoGYWN0aXZlGAcgASgIEi4KCnJldm9rZWRfYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCmNyZWF0ZWRfYXQYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiMKBXVzZXJzGAogAygLMhQubXVzaWNjbHViLnVzZXIuVXNlciIdCg1JbnZpdGVDb2RlUmVmEgwKBGNvZGUYASABKAkiRQoXTGlzdEludml0ZUNvZGVzUmVzcG9uc2USKgoFY29kZXMYASADKAsyGy5tdXNpY2NsdWIuYWRtaW4uSW52aXRlQ29kZSIoChdQb3N0TW9udGhseVN0YXRzUmVxdWVzdBINCgVtb250aBgBIAEoCSL4AQoKU3VnZ2VzdGlvbhIKCgJpZBgBIAEoCRIMCgRib2R5GAIgASgJEi4KCmNyZWF0ZWRfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi8KC3Jlc29sdmVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIpCgtyZXNvbHZlZF9ieRgFIAEoCzIULm11c2ljY2x1Yi51c2VyLlVzZXISEgoKcmVzb2x1dGlvbhgGIAEoCRIwCgxwdWJsaXNoZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIngKFkxpc3RTdWdnZXN0aW9uc1JlcXVlc3QSLQoIcmVzb2x2ZWQYASABKA4yGy5tdXNpY2NsdWIuYWRtaW4uRmxhZ0ZpbHRlchISCgpwYWdlX3Rva2VuGAIgASgJEhsKCXBhZ2Vfc2l6ZRgDIAEoDUIIukgFKgMYyAEieQoXTGlzdFN1Z2dlc3Rpb25zUmVzcG9uc2USMAoLc3VnZ2VzdGlvbnMYASADKAsyGy5tdXNpY2NsdWIuYWRtaW4uU3VnZ2VzdGlvbhIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkSEwoLdG90YWxfY291bnQYAyABKAUiTAoYUmVzb2x2ZVN1Z2dlc3Rpb25SZXF1ZXN0EgoKAmlkGAEgASgJEhAKCHJlc29sdmVkGAIgASgIEhIKCnJlc29sdXRpb24YAyABKAkiGwoNU3VnZ2VzdGlvblJlZhIKCgJpZBgBIAEoCSLvAgoLU29uZ1JlcXVlc3QSCgoCaWQYASABKAkSJgoEbGluaxgCIAEoCzIYLm11c2ljY2x1Yi5zb25nLlNvbmdMaW5rEg8KB2NvbW1lbnQYAyABKAkSFgoOcmVxdWVzdGVyX25hbWUYBCABKAkSHQoVcmVxdWVzdGVyX3RlbGVncmFtX2lkGAUgASgEEjIKBnN0YXR1cxgGIAEoDjIiLm11c2ljY2x1Yi5hZG1pbi5Tb25nUmVxdWVzdFN0YXR1cxIuCgpjcmVhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIvCgtyZXZpZXdlZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASKQoLcmV2aWV3ZWRfYnkYCSABKAsyFC5tdXNpY2NsdWIudXNlci5Vc2VyEhMKC3Jldmlld19ub3RlGAogASgJEg8KB3NvbmdfaWQYCyABKAkifgoXTGlzdFNvbmdSZXF1ZXN0c1JlcXVlc3QSMgoGc3RhdHVzGAEgASgOMiIubXVzaWNjbHViLmFkbWluLlNvbmdSZXF1ZXN0U3RhdHVzEhIKCnBhZ2VfdG9rZW4YAiABKAkSGwoJcGFnZV9zaXplGAMgASgNQgi6SAUqAxjIASJ4ChhMaXN0U29uZ1JlcXVlc3RzUmVzcG9uc2USLgoIcmVxdWVzdHMYASADKAsyHC5tdXNpY2NsdWIuYWRtaW4uU29uZ1JlcXVlc3QSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJEhMKC3RvdGFsX2NvdW50GAMgASgFIlgKGUFwcHJvdmVTb25nUmVxdWVzdFJlcXVlc3QSCgoCaWQYASABKAkSLwoEc29uZxgCIAEoCzIhLm11c2ljY2x1Yi5zb25nLkNyZWF0ZVNvbmdSZXF1ZXN0IjQKGFJlamVjdFNvbmdSZXF1ZXN0UmVxdWVzdBIKCgJpZBgBIAEoCRIMCgRub3RlGAIgASgJIncKEkdldEFwaVVzYWdlUmVxdWVzdBIoCgRmcm9tGAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBImCgJ0bxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDwoHdXNlcl9pZBgDIAEoCSKTAQoLTWV0aG9kVXNhZ2USDgoGbWV0aG9kGAEgASgJEg0KBWNhbGxzGAIgASgDEg4KBmVycm9ycxgDIAEoAxISCgplcnJvcl9yYXRlGAQgASgBEhoKEmF2ZXJhZ2VfbGF0ZW5jeV9tcxgFIAEoARIWCg5tYXhfbGF0ZW5jeV9tcxgGIAEoAxINCgV1c2VycxgHIAEoBSJPCglVc2VyVXNhZ2USIgoEdXNlchgBIAEoCzIULm11c2ljY2x1Yi51c2VyLlVzZXISDQoFY2FsbHMYAiABKAMSDwoHbWV0aG9kcxgDIAEoBSL7AQoIQXBpVXNhZ2USKAoEZnJvbRgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASJgoCdG8YAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhMKC3RvdGFsX2NhbGxzGAMgASgDEhQKDHRvdGFsX2Vycm9ycxgEIAEoAxIUCgxhY3RpdmVfdXNlcnMYBSABKAUSLQoHbWV0aG9kcxgGIAMoCzIcLm11c2ljY2x1Yi5hZG1pbi5NZXRob2RVc2FnZRItCgl0b3BfdXNlcnMYByADKAsyGi5tdXNpY2NsdWIuYWRtaW4uVXNlclVzYWdlKkoKCkZsYWdGaWx0ZXISEwoPRkxBR19GSUxURVJfQU5ZEAASEwoPRkxBR19GSUxURVJfWUVTEAESEgoORkxBR19GSUxURVJfTk8QAiqdAQoRU29uZ1JlcXVlc3RTdGF0dXMSIwofU09OR19SRVFVRVNUX1NUQVRVU19VTlNQRUNJRklFRBAAEh8KG1NPTkdfUkVRVUVTVF9TVEFUVVNfUEVORElORxABEiAKHFNPTkdfUkVRVUVTVF9TVEFUVVNfQVBQUk9WRUQQAhIgChxTT05HX1JFUVVFU1RfU1RBVFVTX1JFSkVDVEVEEAMy1goKDEFkbWluU2VydmljZRJSCglMaXN0VXNlcnMSIS5tdXNpY2NsdWIuYWRtaW4uTGlzdFVzZXJzUmVxdWVzdBoiLm11c2ljY2x1Yi5hZG1pbi5MaXN0VXNlcnNSZXNwb25zZRJnChBMaXN0QXVkaXRFbnRyaWVzEigubXVzaWNjbHViLmFkbWluLkxpc3RBdWRpdEVudHJpZXNSZXF1ZXN0GikubXVzaWNjbHViLmFkbWluLkxpc3RBdWRpdEVudHJpZXNSZXNwb25zZRJfChFTZXRVc2VyU3VzcGVuc2lvbhIpLm11c2ljY2x1Yi5hZG1pbi5TZXRVc2VyU3VzcGVuc2lvblJlcXVlc3QaHy5tdXNpY2NsdWIuYWRtaW4uVXNlclN1c3BlbnNpb24SRgoKTWVyZ2VVc2VycxIiLm11c2ljY2x1Yi5hZG1pbi5NZXJnZVVzZXJzUmVxdWVzdBoULm11c2ljY2x1Yi51c2VyLlVzZXISWQoQQ3JlYXRlSW52aXRlQ29kZRIoLm11c2ljY2x1Yi5hZG1pbi5DcmVhdGVJbnZpdGVDb2RlUmVxdWVzdBobLm11c2ljY2x1Yi5hZG1pbi5JbnZpdGVDb2RlElMKD0xpc3RJbnZpdGVDb2RlcxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRooLm11c2ljY2x1Yi5hZG1pbi5MaXN0SW52aXRlQ29kZXNSZXNwb25zZRJPChBSZXZva2VJbnZpdGVDb2RlEh4ubXVzaWNjbHViLmFkbWluLkludml0ZUNvZGVSZWYaGy5tdXNpY2NsdWIuYWRtaW4uSW52aXRlQ29kZRJUChBQb3N0TW9udGhseVN0YXRzEigubXVzaWNjbHViLmFkbWluLlBvc3RNb250aGx5U3RhdHNSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5EmQKD0xpc3RTdWdnZXN0aW9ucxInLm11c2ljY2x1Yi5hZG1pbi5MaXN0U3VnZ2VzdGlvbnNSZXF1ZXN0GigubXVzaWNjbHViLmFkbWluLkxpc3RTdWdnZXN0aW9uc1Jlc3BvbnNlElsKEVJlc29sdmVTdWdnZXN0aW9uEikubXVzaWNjbHViLmFkbWluLlJlc29sdmVTdWdnZXN0aW9uUmVxdWVzdBobLm11c2ljY2x1Yi5hZG1pbi5TdWdnZXN0aW9uElAKEVB1Ymxpc2hTdWdnZXN0aW9uEh4ubXVzaWNjbHViLmFkbWluLlN1Z2dlc3Rpb25SZWYaGy5tdXNpY2NsdWIuYWRtaW4uU3VnZ2VzdGlvbhJnChBMaXN0U29uZ1JlcXVlc3RzEigubXVzaWNjbHViLmFkbWluLkxpc3RTb25nUmVxdWVzdHNSZXF1ZXN0GikubXVzaWNjbHViLmFkbWluLkxpc3RTb25nUmVxdWVzdHNSZXNwb25zZRJeChJBcHByb3ZlU29uZ1JlcXVlc3QSKi5tdXNpY2NsdWIuYWRtaW4uQXBwcm92ZVNvbmdSZXF1ZXN0UmVxdWVzdBocLm11c2ljY2x1Yi5hZG1pbi5Tb25nUmVxdWVzdBJcChFSZWplY3RTb25nUmVxdWVzdBIpLm11c2ljY2x1Yi5hZG1pbi5SZWplY3RTb25nUmVxdWVzdFJlcXVlc3QaHC5tdXNpY2NsdWIuYWRtaW4uU29uZ1JlcXVlc3QSTQoLR2V0QXBpVXNhZ2USIy5tdXNpY2NsdWIuYWRtaW4uR2V0QXBpVXNhZ2VSZXF1ZXN0GhkubXVzaWNjbHViLmFkbWluLkFwaVVzYWdlQhxaGm11c2ljY2x1YmJvdC9iYWNrZW5kL3Byb3RvYgZwcm90bzM", [file_buf_validate_validate, file_google_protobuf_empty, file_google_protobuf_timestamp, file_song, file_user]);
+
+/**
+ * @generated from message musicclub.admin.ListUsersRequest
+ */
+export type ListUsersRequest = Message<"musicclub.admin.ListUsersRequest"> & {
+  /**
+   * Optional substring filter by username or display name.
+   *
+   * @generated from field: string query = 1;
+   */
+  query: string;
+
+  /**
+   * @generated from field: musicclub.admin.FlagFilter chat_member = 2;
+   */
+  chatMember: FlagFilter;
+
+  /**
+   * @generated from field: musicclub.admin.FlagFilter telegram_linked = 3;
+   */
+  telegramLinked: FlagFilter;
+
+  /**
+   * Only users holding this permission, e.g. "edit_events".
+   *
+   * @generated from field: string permission = 4;
+   */
+  permission: string;
+
+  /**
+   * Pagination cursor (opaque to client).
+   *
+   * @generated from field: string page_token = 5;
+   */
+  pageToken: string;
+
+  /**
+   * @generated from field: uint32 page_size = 6;
+   */
+  pageSize: number;
+};
+
+/**
+ * Describes the message musicclub.admin.ListUsersRequest.
+ * Use `create(ListUsersRequestSchema)` to create a new message.
+ */
+export const ListUsersRequestSchema: GenMessage<ListUsersRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 0);
+
+/**
+ * @generated from message musicclub.admin.UserSummary
+ */
+export type UserSummary = Message<"musicclub.admin.UserSummary"> & {
+  /**
+   * @generated from field: musicclub.user.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: bool is_chat_member = 2;
+   */
+  isChatMember: boolean;
+
+  /**
+   * @generated from field: bool telegram_linked = 3;
+   */
+  telegramLinked: boolean;
+
+  /**
+   * @generated from field: repeated string roles = 4;
+   */
+  roles: string[];
+
+  /**
+   * Catalog songs the user plays a role in.
+   *
+   * @generated from field: int32 song_count = 5;
+   */
+  songCount: number;
+
+  /**
+   * Events the user took part in.
+   *
+   * @generated from field: int32 event_count = 6;
+   */
+  eventCount: number;
+
+  /**
+   * Unset for users who haven't used the app since it started tracking.
+   *
+   * @generated from field: google.protobuf.Timestamp last_seen_at = 7;
+   */
+  lastSeenAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 8;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * Unset unless the user is currently suspended.
+   *
+   * @generated from field: musicclub.admin.UserSuspension suspension = 9;
+   */
+  suspension?: UserSuspension;
+
+  /**
+   * The invite code the user registered with, if any.
+   *
+   * @generated from field: string invite_code = 10;
+   */
+  inviteCode: string;
+};
+
+/**
+ * Describes the message musicclub.admin.UserSummary.
+ * Use `create(UserSummarySchema)` to create a new message.
+ */
+export const UserSummarySchema: GenMessage<UserSummary> = /*@__PURE__*/
+  messageDesc(file_admin, 1);
+
+/**
+ * @generated from message musicclub.admin.ListUsersResponse
+ */
+export type ListUsersResponse = Message<"musicclub.admin.ListUsersResponse"> & {
+  /**
+   * @generated from field: repeated musicclub.admin.UserSummary users = 1;
+   */
+  users: UserSummary[];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+
+  /**
+   * Users matching the filters across all pages.
+   *
+   * @generated from field: int32 total_count = 3;
+   */
+  totalCount: number;
+};
+
+/**
+ * Describes the message musicclub.admin.ListUsersResponse.
+ * Use `create(ListUsersResponseSchema)` to create a new message.
+ */
+export const ListUsersResponseSchema: GenMessage<ListUsersResponse> = /*@__PURE__*/
+  messageDesc(file_admin, 2);
+
+/**
+ * @generated from message musicclub.admin.ListAuditEntriesRequest
+ */
+export type ListAuditEntriesRequest = Message<"musicclub.admin.ListAuditEntriesRequest"> & {
+  /**
+   * Optional filters: "song", "event", "tracklist", "permissions", "user",
+   * "invite", "suggestion", "song_request", "voting_round", "dues" or
+   * "group", and the id within it (tracklist entries use the event id, invites the code).
+   *
+   * @generated from field: string entity_type = 1;
+   */
+  entityType: string;
+
+  /**
+   * @generated from field: string entity_id = 2;
+   */
+  entityId: string;
+
+  /**
+   * @generated from field: string actor_id = 3;
+   */
+  actorId: string;
+
+  /**
+   * Optional time window, from inclusive and to exclusive.
+   *
+   * @generated from field: google.protobuf.Timestamp from = 4;
+   */
+  from?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp to = 5;
+   */
+  to?: Timestamp;
+
+  /**
+   * Pagination cursor (opaque to client).
+   *
+   * @generated from field: string page_token = 6;
+   */
+  pageToken: string;
+
+  /**
+   * @generated from field: uint32 page_size = 7;
+   */
+  pageSize: number;
+};
+
+/**
+ * Describes the message musicclub.admin.ListAuditEntriesRequest.
+ * Use `create(ListAuditEntriesRequestSchema)` to create a new message.
+ */
+export const ListAuditEntriesRequestSchema: GenMessage<ListAuditEntriesRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 3);
+
+/**
+ * @generated from message musicclub.admin.AuditEntry
+ */
+export type AuditEntry = Message<"musicclub.admin.AuditEntry"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * Unset when the change was made by the system or the user was removed.
+   *
+   * @generated from field: musicclub.user.User actor = 2;
+   */
+  actor?: User;
+
+  /**
+   * @generated from field: string entity_type = 3;
+   */
+  entityType: string;
+
+  /**
+   * @generated from field: string entity_id = 4;
+   */
+  entityId: string;
+
+  /**
+   * What was done, e.g. "create", "update", "delete" or "remove_item".
+   *
+   * @generated from field: string action = 5;
+   */
+  action: string;
+
+  /**
+   * Human-readable details of the change.
+   *
+   * @generated from field: string summary = 6;
+   */
+  summary: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message musicclub.admin.AuditEntry.
+ * Use `create(AuditEntrySchema)` to create a new message.
+ */
+export const AuditEntrySchema: GenMessage<AuditEntry> = /*@__PURE__*/
+  messageDesc(file_admin, 4);
+
+/**
+ * @generated from message musicclub.admin.ListAuditEntriesResponse
+ */
+export type ListAuditEntriesResponse = Message<"musicclub.admin.ListAuditEntriesResponse"> & {
+  /**
+   * @generated from field: repeated musicclub.admin.AuditEntry entries = 1;
+   */
+  entries: AuditEntry[];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message musicclub.admin.ListAuditEntriesResponse.
+ * Use `create(ListAuditEntriesResponseSchema)` to create a new message.
+ */
+export const ListAuditEntriesResponseSchema: GenMessage<ListAuditEntriesResponse> = /*@__PURE__*/
+  messageDesc(file_admin, 5);
+
+/**
+ * @generated from message musicclub.admin.SetUserSuspensionRequest
+ */
+export type SetUserSuspensionRequest = Message<"musicclub.admin.SetUserSuspensionRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * False lifts the suspension.
+   *
+   * @generated from field: bool suspended = 2;
+   */
+  suspended: boolean;
+
+  /**
+   * Optional end of the suspension; unset suspends until lifted.
+   *
+   * @generated from field: google.protobuf.Timestamp until = 3;
+   */
+  until?: Timestamp;
+
+  /**
+   * @generated from field: string reason = 4;
+   */
+  reason: string;
+};
+
+/**
+ * Describes the message musicclub.admin.SetUserSuspensionRequest.
+ * Use `create(SetUserSuspensionRequestSchema)` to create a new message.
+ */
+export const SetUserSuspensionRequestSchema: GenMessage<SetUserSuspensionRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 6);
+
+/**
+ * @generated from message musicclub.admin.UserSuspension
+ */
+export type UserSuspension = Message<"musicclub.admin.UserSuspension"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: bool suspended = 2;
+   */
+  suspended: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp until = 3;
+   */
+  until?: Timestamp;
+
+  /**
+   * @generated from field: string reason = 4;
+   */
+  reason: string;
+};
+
+/**
+ * Describes the message musicclub.admin.UserSuspension.
+ * Use `create(UserSuspensionSchema)` to create a new message.
+ */
+export const UserSuspensionSchema: GenMessage<UserSuspension> = /*@__PURE__*/
+  messageDesc(file_admin, 7);
+
+/**
+ * @generated from message musicclub.admin.MergeUsersRequest
+ */
+export type MergeUsersRequest = Message<"musicclub.admin.MergeUsersRequest"> & {
+  /**
+   * The duplicate, deleted after the merge.
+   *
+   * @generated from field: string source_user_id = 1;
+   */
+  sourceUserId: string;
+
+  /**
+   * The account that is kept.
+   *
+   * @generated from field: string target_user_id = 2;
+   */
+  targetUserId: string;
+};
+
+/**
+ * Describes the message musicclub.admin.MergeUsersRequest.
+ * Use `create(MergeUsersRequestSchema)` to create a new message.
+ */
+export const MergeUsersRequestSchema: GenMessage<MergeUsersRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 8);
+
+/**
+ * @generated from message musicclub.admin.CreateInviteCodeRequest
+ */
+export type CreateInviteCodeRequest = Message<"musicclub.admin.CreateInviteCodeRequest"> & {
+  /**
+   * How many registrations the code allows; 0 means single-use.
+   *
+   * @generated from field: uint32 max_uses = 1;
+   */
+  maxUses: number;
+
+  /**
+   * Optional expiry.
+   *
+   * @generated from field: google.protobuf.Timestamp expires_at = 2;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * Who the code is meant for.
+   *
+   * @generated from field: string note = 3;
+   */
+  note: string;
+};
+
+/**
+ * Describes the message musicclub.admin.CreateInviteCodeRequest.
+ * Use `create(CreateInviteCodeRequestSchema)` to create a new message.
+ */
+export const CreateInviteCodeRequestSchema: GenMessage<CreateInviteCodeRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 9);
+
+/**
+ * @generated from message musicclub.admin.InviteCode
+ */
+export type InviteCode = Message<"musicclub.admin.InviteCode"> & {
+  /**
+   * @generated from field: string code = 1;
+   */
+  code: string;
+
+  /**
+   * @generated from field: musicclub.user.User created_by = 2;
+   */
+  createdBy?: User;
+
+  /**
+   * @generated from field: uint32 max_uses = 3;
+   */
+  maxUses: number;
+
+  /**
+   * @generated from field: uint32 uses = 4;
+   */
+  uses: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 5;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * @generated from field: string note = 6;
+   */
+  note: string;
+
+  /**
+   * False once the code is used up, expired or revoked.
+   *
+   * @generated from field: bool active = 7;
+   */
+  active: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp revoked_at = 8;
+   */
+  revokedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 9;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * Users who registered with the code.
+   *
+   * @generated from field: repeated musicclub.user.User users = 10;
+   */
+  users: User[];
+};
+
+/**
+ * Describes the message musicclub.admin.InviteCode.
+ * Use `create(InviteCodeSchema)` to create a new message.
+ */
+export const InviteCodeSchema: GenMessage<InviteCode> = /*@__PURE__*/
+  messageDesc(file_admin, 10);
+
+/**
+ * @generated from message musicclub.admin.InviteCodeRef
+ */
+export type InviteCodeRef = Message<"musicclub.admin.InviteCodeRef"> & {
+  /**
+   * @generated from field: string code = 1;
+   */
+  code: string;
+};
+
+/**
+ * Describes the message musicclub.admin.InviteCodeRef.
+ * Use `create(InviteCodeRefSchema)` to create a new message.
+ */
+export const InviteCodeRefSchema: GenMessage<InviteCodeRef> = /*@__PURE__*/
+  messageDesc(file_admin, 11);
+
+/**
+ * @generated from message musicclub.admin.ListInviteCodesResponse
+ */
+export type ListInviteCodesResponse = Message<"musicclub.admin.ListInviteCodesResponse"> & {
+  /**
+   * Newest first.
+   *
+   * @generated from field: repeated musicclub.admin.InviteCode codes = 1;
+   */
+  codes: InviteCode[];
+};
+
+/**
+ * Describes the message musicclub.admin.ListInviteCodesResponse.
+ * Use `create(ListInviteCodesResponseSchema)` to create a new message.
+ */
+export const ListInviteCodesResponseSchema: GenMessage<ListInviteCodesResponse> = /*@__PURE__*/
+  messageDesc(file_admin, 12);
+
+/**
+ * @generated from message musicclub.admin.PostMonthlyStatsRequest
+ */
+export type PostMonthlyStatsRequest = Message<"musicclub.admin.PostMonthlyStatsRequest"> & {
+  /**
+   * "2026-09"; empty means the previous month.
+   *
+   * @generated from field: string month = 1;
+   */
+  month: string;
+};
+
+/**
+ * Describes the message musicclub.admin.PostMonthlyStatsRequest.
+ * Use `create(PostMonthlyStatsRequestSchema)` to create a new message.
+ */
+export const PostMonthlyStatsRequestSchema: GenMessage<PostMonthlyStatsRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 13);
+
+/**
+ * @generated from message musicclub.admin.Suggestion
+ */
+export type Suggestion = Message<"musicclub.admin.Suggestion"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string body = 2;
+   */
+  body: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 3;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * Unset while the suggestion is open.
+   *
+   * @generated from field: google.protobuf.Timestamp resolved_at = 4;
+   */
+  resolvedAt?: Timestamp;
+
+  /**
+   * @generated from field: musicclub.user.User resolved_by = 5;
+   */
+  resolvedBy?: User;
+
+  /**
+   * What came of it, e.g. "trying it at the next jam".
+   *
+   * @generated from field: string resolution = 6;
+   */
+  resolution: string;
+
+  /**
+   * Set once posted to the club chat.
+   *
+   * @generated from field: google.protobuf.Timestamp published_at = 7;
+   */
+  publishedAt?: Timestamp;
+};
+
+/**
+ * Describes the message musicclub.admin.Suggestion.
+ * Use `create(SuggestionSchema)` to create a new message.
+ */
+export const SuggestionSchema: GenMessage<Suggestion> = /*@__PURE__*/
+  messageDesc(file_admin, 14);
+
+/**
+ * @generated from message musicclub.admin.ListSuggestionsRequest
+ */
+export type ListSuggestionsRequest = Message<"musicclub.admin.ListSuggestionsRequest"> & {
+  /**
+   * YES lists resolved suggestions only, NO open ones only.
+   *
+   * @generated from field: musicclub.admin.FlagFilter resolved = 1;
+   */
+  resolved: FlagFilter;
+
+  /**
+   * Pagination cursor (opaque to client).
+   *
+   * @generated from field: string page_token = 2;
+   */
+  pageToken: string;
+
+  /**
+   * @generated from field: uint32 page_size = 3;
+   */
+  pageSize: number;
+};
+
+/**
+ * Describes the message musicclub.admin.ListSuggestionsRequest.
+ * Use `create(ListSuggestionsRequestSchema)` to create a new message.
+ */
+export const ListSuggestionsRequestSchema: GenMessage<ListSuggestionsRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 15);
+
+/**
+ * @generated from message musicclub.admin.ListSuggestionsResponse
+ */
+export type ListSuggestionsResponse = Message<"musicclub.admin.ListSuggestionsResponse"> & {
+  /**
+   * @generated from field: repeated musicclub.admin.Suggestion suggestions = 1;
+   */
+  suggestions: Suggestion[];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+
+  /**
+   * @generated from field: int32 total_count = 3;
+   */
+  totalCount: number;
+};
+
+/**
+ * Describes the message musicclub.admin.ListSuggestionsResponse.
+ * Use `create(ListSuggestionsResponseSchema)` to create a new message.
+ */
+export const ListSuggestionsResponseSchema: GenMessage<ListSuggestionsResponse> = /*@__PURE__*/
+  messageDesc(file_admin, 16);
+
+/**
+ * @generated from message musicclub.admin.ResolveSuggestionRequest
+ */
+export type ResolveSuggestionRequest = Message<"musicclub.admin.ResolveSuggestionRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * False reopens the suggestion and clears the resolution.
+   *
+   * @generated from field: bool resolved = 2;
+   */
+  resolved: boolean;
+
+  /**
+   * @generated from field: string resolution = 3;
+   */
+  resolution: string;
+};
+
+/**
+ * Describes the message musicclub.admin.ResolveSuggestionRequest.
+ * Use `create(ResolveSuggestionRequestSchema)` to create a new message.
+ */
+export const ResolveSuggestionRequestSchema: GenMessage<ResolveSuggestionRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 17);
+
+/**
+ * @generated from message musicclub.admin.SuggestionRef
+ */
+export type SuggestionRef = Message<"musicclub.admin.SuggestionRef"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message musicclub.admin.SuggestionRef.
+ * Use `create(SuggestionRefSchema)` to create a new message.
+ */
+export const SuggestionRefSchema: GenMessage<SuggestionRef> = /*@__PURE__*/
+  messageDesc(file_admin, 18);
+
+/**
+ * @generated from message musicclub.admin.SongRequest
+ */
+export type SongRequest = Message<"musicclub.admin.SongRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * Kind guessed from the URL, UNKNOWN for other sites.
+   *
+   * @generated from field: musicclub.song.SongLink link = 2;
+   */
+  link?: SongLink;
+
+  /**
+   * @generated from field: string comment = 3;
+   */
+  comment: string;
+
+  /**
+   * Telegram name of whoever asked, for context.
+   *
+   * @generated from field: string requester_name = 4;
+   */
+  requesterName: string;
+
+  /**
+   * @generated from field: uint64 requester_telegram_id = 5;
+   */
+  requesterTelegramId: bigint;
+
+  /**
+   * @generated from field: musicclub.admin.SongRequestStatus status = 6;
+   */
+  status: SongRequestStatus;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp reviewed_at = 8;
+   */
+  reviewedAt?: Timestamp;
+
+  /**
+   * @generated from field: musicclub.user.User reviewed_by = 9;
+   */
+  reviewedBy?: User;
+
+  /**
+   * Reason given on rejection.
+   *
+   * @generated from field: string review_note = 10;
+   */
+  reviewNote: string;
+
+  /**
+   * The catalog song an approved request became.
+   *
+   * @generated from field: string song_id = 11;
+   */
+  songId: string;
+};
+
+/**
+ * Describes the message musicclub.admin.SongRequest.
+ * Use `create(SongRequestSchema)` to create a new message.
+ */
+export const SongRequestSchema: GenMessage<SongRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 19);
+
+/**
+ * @generated from message musicclub.admin.ListSongRequestsRequest
+ */
+export type ListSongRequestsRequest = Message<"musicclub.admin.ListSongRequestsRequest"> & {
+  /**
+   * Unspecified lists every status.
+   *
+   * @generated from field: musicclub.admin.SongRequestStatus status = 1;
+   */
+  status: SongRequestStatus;
+
+  /**
+   * Pagination cursor (opaque to client).
+   *
+   * @generated from field: string page_token = 2;
+   */
+  pageToken: string;
+
+  /**
+   * @generated from field: uint32 page_size = 3;
+   */
+  pageSize: number;
+};
+
+/**
+ * Describes the message musicclub.admin.ListSongRequestsRequest.
+ * Use `create(ListSongRequestsRequestSchema)` to create a new message.
+ */
+export const ListSongRequestsRequestSchema: GenMessage<ListSongRequestsRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 20);
+
+/**
+ * @generated from message musicclub.admin.ListSongRequestsResponse
+ */
+export type ListSongRequestsResponse = Message<"musicclub.admin.ListSongRequestsResponse"> & {
+  /**
+   * @generated from field: repeated musicclub.admin.SongRequest requests = 1;
+   */
+  requests: SongRequest[];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+
+  /**
+   * @generated from field: int32 total_count = 3;
+   */
+  totalCount: number;
+};
+
+/**
+ * Describes the message musicclub.admin.ListSongRequestsResponse.
+ * Use `create(ListSongRequestsResponseSchema)` to create a new message.
+ */
+export const ListSongRequestsResponseSchema: GenMessage<ListSongRequestsResponse> = /*@__PURE__*/
+  messageDesc(file_admin, 21);
+
+/**
+ * @generated from message musicclub.admin.ApproveSongRequestRequest
+ */
+export type ApproveSongRequestRequest = Message<"musicclub.admin.ApproveSongRequestRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * The song to create; title and artist are required. Without a link the
+   * requested one is used.
+   *
+   * @generated from field: musicclub.song.CreateSongRequest song = 2;
+   */
+  song?: CreateSongRequest;
+};
+
+/**
+ * Describes the message musicclub.admin.ApproveSongRequestRequest.
+ * Use `create(ApproveSongRequestRequestSchema)` to create a new message.
+ */
+export const ApproveSongRequestRequestSchema: GenMessage<ApproveSongRequestRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 22);
+
+/**
+ * @generated from message musicclub.admin.RejectSongRequestRequest
+ */
+export type RejectSongRequestRequest = Message<"musicclub.admin.RejectSongRequestRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * Optional reason, passed on to the requester.
+   *
+   * @generated from field: string note = 2;
+   */
+  note: string;
+};
+
+/**
+ * Describes the message musicclub.admin.RejectSongRequestRequest.
+ * Use `create(RejectSongRequestRequestSchema)` to create a new message.
+ */
+export const RejectSongRequestRequestSchema: GenMessage<RejectSongRequestRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 23);
+
+/**
+ * @generated from message musicclub.admin.GetApiUsageRequest
+ */
+export type GetApiUsageRequest = Message<"musicclub.admin.GetApiUsageRequest"> & {
+  /**
+   * Defaults to the last 30 days. Counted in whole hours; kept for 180
+   * days.
+   *
+   * @generated from field: google.protobuf.Timestamp from = 1;
+   */
+  from?: Timestamp;
+
+  /**
+   * Exclusive; defaults to now.
+   *
+   * @generated from field: google.protobuf.Timestamp to = 2;
+   */
+  to?: Timestamp;
+
+  /**
+   * Only this user's calls.
+   *
+   * @generated from field: string user_id = 3;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message musicclub.admin.GetApiUsageRequest.
+ * Use `create(GetApiUsageRequestSchema)` to create a new message.
+ */
+export const GetApiUsageRequestSchema: GenMessage<GetApiUsageRequest> = /*@__PURE__*/
+  messageDesc(file_admin, 24);
+
+/**
+ * @generated from message musicclub.admin.MethodUsage
+ */
+export type MethodUsage = Message<"musicclub.admin.MethodUsage"> & {
+  /**
+   * Full method name, e.g. "/musicclub.song.SongService/ListSongs".
+   *
+   * @generated from field: string method = 1;
+   */
+  method: string;
+
+  /**
+   * @generated from field: int64 calls = 2;
+   */
+  calls: bigint;
+
+  /**
+   * Calls that returned an error, authorization failures included.
+   *
+   * @generated from field: int64 errors = 3;
+   */
+  errors: bigint;
+
+  /**
+   * errors / calls.
+   *
+   * @generated from field: double error_rate = 4;
+   */
+  errorRate: number;
+
+  /**
+   * @generated from field: double average_latency_ms = 5;
+   */
+  averageLatencyMs: number;
+
+  /**
+   * Slowest call within the range.
+   *
+   * @generated from field: int64 max_latency_ms = 6;
+   */
+  maxLatencyMs: bigint;
+
+  /**
+   * Signed-in users who called the method.
+   *
+   * @generated from field: int32 users = 7;
+   */
+  users: number;
+};
+
+/**
+ * Describes the message musicclub.admin.MethodUsage.
+ * Use `create(MethodUsageSchema)` to create a new message.
+ */
+export const MethodUsageSchema: GenMessage<MethodUsage> = /*@__PURE__*/
+  messageDesc(file_admin, 25);
+
+/**
+ * @generated from message musicclub.admin.UserUsage
+ */
+export type UserUsage = Message<"musicclub.admin.UserUsage"> & {
+  /**
+   * @generated from field: musicclub.user.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: int64 calls = 2;
+   */
+  calls: bigint;
+
+  /**
+   * Distinct methods called.
+   *
+   * @generated from field: int32 methods = 3;
+   */
+  methods: number;
+};
+
+/**
+ * Describes the message musicclub.admin.UserUsage.
+ * Use `create(UserUsageSchema)` to create a new message.
+ */
+export const UserUsageSchema: GenMessage<UserUsage> = /*@__PURE__*/
+  messageDesc(file_admin, 26);
+
+/**
+ * @generated from message musicclub.admin.ApiUsage
+ */
+export type ApiUsage = Message<"musicclub.admin.ApiUsage"> & {
+  /**
+   * @generated from field: google.protobuf.Timestamp from = 1;
+   */
+  from?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp to = 2;
+   */
+  to?: Timestamp;
+
+  /**
+   * @generated from field: int64 total_calls = 3;
+   */
+  totalCalls: bigint;
+
+  /**
+   * @generated from field: int64 total_errors = 4;
+   */
+  totalErrors: bigint;
+
+  /**
+   * Signed-in users with any call.
+   *
+   * @generated from field: int32 active_users = 5;
+   */
+  activeUsers: number;
+
+  /**
+   * Most called first.
+   *
+   * @generated from field: repeated musicclub.admin.MethodUsage methods = 6;
+   */
+  methods: MethodUsage[];
+
+  /**
+   * Most active first, at most 20.
+   *
+   * @generated from field: repeated musicclub.admin.UserUsage top_users = 7;
+   */
+  topUsers: UserUsage[];
+};
+
+/**
+ * Describes the message musicclub.admin.ApiUsage.
+ * Use `create(ApiUsageSchema)` to create a new message.
+ */
+export const ApiUsageSchema: GenMessage<ApiUsage> = /*@__PURE__*/
+  messageDesc(file_admin, 27);
+
+/**
+ * @generated from enum musicclub.admin.FlagFilter
+ */
+export enum FlagFilter {
+  /**
+   * @generated from enum value: FLAG_FILTER_ANY = 0;
+   */
+  ANY = 0,
+
+  /**
+   * @generated from enum value: FLAG_FILTER_YES = 1;
+   */
+  YES = 1,
+
+  /**
+   * @generated from enum value: FLAG_FILTER_NO = 2;
+   */
+  NO = 2,
+}
+
+/**
+ * Describes the enum musicclub.admin.FlagFilter.
+ */
+export const FlagFilterSchema: GenEnum<FlagFilter> = /*@__PURE__*/
+  enumDesc(file_admin, 0);
+
+/**
+ * @generated from enum musicclub.admin.SongRequestStatus
+ */
+export enum SongRequestStatus {
+  /**
+   * @generated from enum value: SONG_REQUEST_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SONG_REQUEST_STATUS_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * @generated from enum value: SONG_REQUEST_STATUS_APPROVED = 2;
+   */
+  APPROVED = 2,
+
+  /**
+   * @generated from enum value: SONG_REQUEST_STATUS_REJECTED = 3;
+   */
+  REJECTED = 3,
+}
+
+/**
+ * Describes the enum musicclub.admin.SongRequestStatus.
+ */
+export const SongRequestStatusSchema: GenEnum<SongRequestStatus> = /*@__PURE__*/
+  enumDesc(file_admin, 1);
+
+/**
+ * Club administration for the admin panel (requires manage_permissions).
+ *
+ * @generated from service musicclub.admin.AdminService
+ */
+export const AdminService: GenService<{
+  /**
+   * Users sorted by display name, with activity totals.
+   *
+   * @generated from rpc musicclub.admin.AdminService.ListUsers
+   */
+  listUsers: {
+    methodKind: "unary";
+    input: typeof ListUsersRequestSchema;
+    output: typeof ListUsersResponseSchema;
+  },
+  /**
+   * Recorded mutations, newest first.
+   *
+   * @generated from rpc musicclub.admin.AdminService.ListAuditEntries
+   */
+  listAuditEntries: {
+    methodKind: "unary";
+    input: typeof ListAuditEntriesRequestSchema;
+    output: typeof ListAuditEntriesResponseSchema;
+  },
+  /**
+   * Suspends or reinstates a user. Suspending signs them out everywhere.
+   *
+   * @generated from rpc musicclub.admin.AdminService.SetUserSuspension
+   */
+  setUserSuspension: {
+    methodKind: "unary";
+    input: typeof SetUserSuspensionRequestSchema;
+    output: typeof UserSuspensionSchema;
+  },
+  /**
+   * Moves everything the source user has to the target and deletes the
+   * source, e.g. a duplicate account auto-created on Telegram sign-in.
+   *
+   * @generated from rpc musicclub.admin.AdminService.MergeUsers
+   */
+  mergeUsers: {
+    methodKind: "unary";
+    input: typeof MergeUsersRequestSchema;
+    output: typeof UserSchema;
+  },
+  /**
+   * Invite codes for password registration.
+   *
+   * @generated from rpc musicclub.admin.AdminService.CreateInviteCode
+   */
+  createInviteCode: {
+    methodKind: "unary";
+    input: typeof CreateInviteCodeRequestSchema;
+    output: typeof InviteCodeSchema;
+  },
+  /**
+   * @generated from rpc musicclub.admin.AdminService.ListInviteCodes
+   */
+  listInviteCodes: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof ListInviteCodesResponseSchema;
+  },
+  /**
+   * Stops the code from being used; users it created stay.
+   *
+   * @generated from rpc musicclub.admin.AdminService.RevokeInviteCode
+   */
+  revokeInviteCode: {
+    methodKind: "unary";
+    input: typeof InviteCodeRefSchema;
+    output: typeof InviteCodeSchema;
+  },
+  /**
+   * Posts a month's wrap-up to the club chat now. The backend posts the
+   * previous month's on its own at the start of each month.
+   *
+   * @generated from rpc musicclub.admin.AdminService.PostMonthlyStats
+   */
+  postMonthlyStats: {
+    methodKind: "unary";
+    input: typeof PostMonthlyStatsRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * Anonymous suggestions sent to the bot with /suggest, newest first.
+   *
+   * @generated from rpc musicclub.admin.AdminService.ListSuggestions
+   */
+  listSuggestions: {
+    methodKind: "unary";
+    input: typeof ListSuggestionsRequestSchema;
+    output: typeof ListSuggestionsResponseSchema;
+  },
+  /**
+   * Marks a suggestion as dealt with, or reopens it.
+   *
+   * @generated from rpc musicclub.admin.AdminService.ResolveSuggestion
+   */
+  resolveSuggestion: {
+    methodKind: "unary";
+    input: typeof ResolveSuggestionRequestSchema;
+    output: typeof SuggestionSchema;
+  },
+  /**
+   * Posts the suggestion's text to the club chat, without any sender.
+   *
+   * @generated from rpc musicclub.admin.AdminService.PublishSuggestion
+   */
+  publishSuggestion: {
+    methodKind: "unary";
+    input: typeof SuggestionRefSchema;
+    output: typeof SuggestionSchema;
+  },
+  /**
+   * Songs requested through the bot by people outside the club, newest
+   * first.
+   *
+   * @generated from rpc musicclub.admin.AdminService.ListSongRequests
+   */
+  listSongRequests: {
+    methodKind: "unary";
+    input: typeof ListSongRequestsRequestSchema;
+    output: typeof ListSongRequestsResponseSchema;
+  },
+  /**
+   * Adds the requested song to the catalog and lets the requester know.
+   *
+   * @generated from rpc musicclub.admin.AdminService.ApproveSongRequest
+   */
+  approveSongRequest: {
+    methodKind: "unary";
+    input: typeof ApproveSongRequestRequestSchema;
+    output: typeof SongRequestSchema;
+  },
+  /**
+   * @generated from rpc musicclub.admin.AdminService.RejectSongRequest
+   */
+  rejectSongRequest: {
+    methodKind: "unary";
+    input: typeof RejectSongRequestRequestSchema;
+    output: typeof SongRequestSchema;
+  },
+  /**
+   * Calls per API method with error rates and latency, to see which
+   * features the club actually uses. Figures lag up to a minute.
+   *
+   * @generated from rpc musicclub.admin.AdminService.GetApiUsage
+   */
+  getApiUsage: {
+    methodKind: "unary";
+    input: typeof GetApiUsageRequestSchema;
+    output: typeof ApiUsageSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_admin, 0);
+
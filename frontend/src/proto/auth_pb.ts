@@ -4,8 +4,9 @@
 
 import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
-import type { EmptySchema } from "@bufbuild/protobuf/wkt";
-import { file_google_protobuf_empty } from "@bufbuild/protobuf/wkt";
+import { file_buf_validate_validate } from "./buf/validate/validate_pb.ts";
+import type { EmptySchema, FieldMask } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_empty, file_google_protobuf_field_mask } from "@bufbuild/protobuf/wkt";
 import type { PermissionSet } from "./permissions_pb.ts";
 import { file_permissions } from "./permissions_pb.ts";
 import type { User, UserSchema } from "./user_pb.ts";
@@ -16,7 +17,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file auth.proto.
  */
 export const file_auth: GenFile = /*@__PURE__*/
-  fileDesc("CgphdXRoLnByb3RvEg5tdXNpY2NsdWIuYXV0aCIxCgtDcmVkZW50aWFscxIQCgh1c2VybmFtZRgBIAEoCRIQCghwYXNzd29yZBgCIAEoCSJuChNSZWdpc3RlclVzZXJSZXF1ZXN0EjAKC2NyZWRlbnRpYWxzGAEgASgLMhsubXVzaWNjbHViLmF1dGguQ3JlZGVudGlhbHMSJQoHcHJvZmlsZRgCIAEoCzIULm11c2ljY2x1Yi51c2VyLlVzZXIiJwoOUmVmcmVzaFJlcXVlc3QSFQoNcmVmcmVzaF90b2tlbhgBIAEoCSI4CglUb2tlblBhaXISFAoMYWNjZXNzX3Rva2VuGAEgASgJEhUKDXJlZnJlc2hfdG9rZW4YAiABKAkiKQoTVGdMb2dpbkxpbmtSZXNwb25zZRISCgpsb2dpbl9saW5rGAEgASgJIkgKDlRnTG9naW5SZXF1ZXN0EiIKBHVzZXIYASABKAsyFC5tdXNpY2NsdWIudXNlci5Vc2VyEhIKCnRnX3VzZXJfaWQYAiABKAQi5gEKC0F1dGhTZXNzaW9uEikKBnRva2VucxgBIAEoCzIZLm11c2ljY2x1Yi5hdXRoLlRva2VuUGFpchILCgNpYXQYAiABKAQSCwoDZXhwGAMgASgEEhYKDmlzX2NoYXRfbWVtYmVyGAQgASgIEhgKEGpvaW5fcmVxdWVzdF91cmwYBSABKAkSJQoHcHJvZmlsZRgGIAEoCzIULm11c2ljY2x1Yi51c2VyLlVzZXISOQoLcGVybWlzc2lvbnMYByABKAsyJC5tdXNpY2NsdWIucGVybWlzc2lvbnMuUGVybWlzc2lvblNldCJzCg9Qcm9maWxlUmVzcG9uc2USJQoHcHJvZmlsZRgBIAEoCzIULm11c2ljY2x1Yi51c2VyLlVzZXISOQoLcGVybWlzc2lvbnMYAiABKAsyJC5tdXNpY2NsdWIucGVybWlzc2lvbnMuUGVybWlzc2lvblNldCIuChlUZWxlZ3JhbVdlYkFwcEF1dGhSZXF1ZXN0EhEKCWluaXRfZGF0YRgBIAEoCTLWAwoLQXV0aFNlcnZpY2USTAoIUmVnaXN0ZXISIy5tdXNpY2NsdWIuYXV0aC5SZWdpc3RlclVzZXJSZXF1ZXN0GhsubXVzaWNjbHViLmF1dGguQXV0aFNlc3Npb24SQQoFTG9naW4SGy5tdXNpY2NsdWIuYXV0aC5DcmVkZW50aWFscxobLm11c2ljY2x1Yi5hdXRoLkF1dGhTZXNzaW9uEkQKB1JlZnJlc2gSHi5tdXNpY2NsdWIuYXV0aC5SZWZyZXNoUmVxdWVzdBoZLm11c2ljY2x1Yi5hdXRoLlRva2VuUGFpchJLCg5HZXRUZ0xvZ2luTGluaxIULm11c2ljY2x1Yi51c2VyLlVzZXIaIy5tdXNpY2NsdWIuYXV0aC5UZ0xvZ2luTGlua1Jlc3BvbnNlEkUKCkdldFByb2ZpbGUSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaHy5tdXNpY2NsdWIuYXV0aC5Qcm9maWxlUmVzcG9uc2USXAoSVGVsZWdyYW1XZWJBcHBBdXRoEikubXVzaWNjbHViLmF1dGguVGVsZWdyYW1XZWJBcHBBdXRoUmVxdWVzdBobLm11c2ljY2x1Yi5hdXRoLkF1dGhTZXNzaW9uQhxaGm11c2ljY2x1YmJvdC9iYWNrZW5kL3Byb3RvYgZwcm90bzM", [file_google_protobuf_empty, file_permissions, file_user]);
+  fileDesc("CgphdXRoLnByb3RvEg5tdXNpY2NsdWIuYXV0aCJDCgtDcmVkZW50aWFscxIZCgh1c2VybmFtZRgBIAEoCUIHukgEcgIQARIZCghwYXNzd29yZBgCIAEoCUIHukgEcgIQASKLAQoTUmVnaXN0ZXJVc2VyUmVxdWVzdBI4CgtjcmVkZW50aWFscxgBIAEoCzIbLm11c2ljY2x1Yi5hdXRoLkNyZWRlbnRpYWxzQga6SAPIAQESJQoHcHJvZmlsZRgCIAEoCzIULm11c2ljY2x1Yi51c2VyLlVzZXISEwoLaW52aXRlX2NvZGUYAyABKAkiMAoOUmVmcmVzaFJlcXVlc3QSHgoNcmVmcmVzaF90b2tlbhgBIAEoCUIHukgEcgIQASI4CglUb2tlblBhaXISFAoMYWNjZXNzX3Rva2VuGAEgASgJEhUKDXJlZnJlc2hfdG9rZW4YAiABKAkiKQoTVGdMb2dpbkxpbmtSZXNwb25zZRISCgpsb2dpbl9saW5rGAEgASgJIkgKDlRnTG9naW5SZXF1ZXN0EiIKBHVzZXIYASABKAsyFC5tdXNpY2NsdWIudXNlci5Vc2VyEhIKCnRnX3VzZXJfaWQYAiABKAQi5gEKC0F1dGhTZXNzaW9uEikKBnRva2VucxgBIAEoCzIZLm11c2ljY2x1Yi5hdXRoLlRva2VuUGFpchILCgNpYXQYAiABKAQSCwoDZXhwGAMgASgEEhYKDmlzX2NoYXRfbWVtYmVyGAQgASgIEhgKEGpvaW5fcmVxdWVzdF91cmwYBSABKAkSJQoHcHJvZmlsZRgGIAEoCzIULm11c2ljY2x1Yi51c2VyLlVzZXISOQoLcGVybWlzc2lvbnMYByABKAsyJC5tdXNpY2NsdWIucGVybWlzc2lvbnMuUGVybWlzc2lvblNldCKAAQoPUHJvZmlsZVJlc3BvbnNlEiUKB3Byb2ZpbGUYASABKAsyFC5tdXNpY2NsdWIudXNlci5Vc2VyEjkKC3Blcm1pc3Npb25zGAIgASgLMiQubXVzaWNjbHViLnBlcm1pc3Npb25zLlBlcm1pc3Npb25TZXQSCwoDYmlvGAMgASgJIpABChRVcGRhdGVQcm9maWxlUmVxdWVzdBIUCgxkaXNwbGF5X25hbWUYASABKAkSEgoKYXZhdGFyX3VybBgCIAEoCRILCgNiaW8YAyABKAkSLwoLdXBkYXRlX21hc2sYBCABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrEhAKCHVzZXJuYW1lGAUgASgJIiQKE1VwbG9hZEF2YXRhclJlcXVlc3QSDQoFY2h1bmsYASABKAwiNwoZVGVsZWdyYW1XZWJBcHBBdXRoUmVxdWVzdBIaCglpbml0X2RhdGEYASABKAlCB7pIBHICEAEyhgUKC0F1dGhTZXJ2aWNlEkwKCFJlZ2lzdGVyEiMubXVzaWNjbHViLmF1dGguUmVnaXN0ZXJVc2VyUmVxdWVzdBobLm11c2ljY2x1Yi5hdXRoLkF1dGhTZXNzaW9uEkEKBUxvZ2luEhsubXVzaWNjbHViLmF1dGguQ3JlZGVudGlhbHMaGy5tdXNpY2NsdWIuYXV0aC5BdXRoU2Vzc2lvbhJECgdSZWZyZXNoEh4ubXVzaWNjbHViLmF1dGguUmVmcmVzaFJlcXVlc3QaGS5tdXNpY2NsdWIuYXV0aC5Ub2tlblBhaXISSwoOR2V0VGdMb2dpbkxpbmsSFC5tdXNpY2NsdWIudXNlci5Vc2VyGiMubXVzaWNjbHViLmF1dGguVGdMb2dpbkxpbmtSZXNwb25zZRJFCgpHZXRQcm9maWxlEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5Gh8ubXVzaWNjbHViLmF1dGguUHJvZmlsZVJlc3BvbnNlElwKElRlbGVncmFtV2ViQXBwQXV0aBIpLm11c2ljY2x1Yi5hdXRoLlRlbGVncmFtV2ViQXBwQXV0aFJlcXVlc3QaGy5tdXNpY2NsdWIuYXV0aC5BdXRoU2Vzc2lvbhJWCg1VcGRhdGVQcm9maWxlEiQubXVzaWNjbHViLmF1dGguVXBkYXRlUHJvZmlsZVJlcXVlc3QaHy5tdXNpY2NsdWIuYXV0aC5Qcm9maWxlUmVzcG9uc2USVgoMVXBsb2FkQXZhdGFyEiMubXVzaWNjbHViLmF1dGguVXBsb2FkQXZhdGFyUmVxdWVzdBofLm11c2ljY2x1Yi5hdXRoLlByb2ZpbGVSZXNwb25zZSgBQhxaGm11c2ljY2x1YmJvdC9iYWNrZW5kL3Byb3RvYgZwcm90bzM", [file_buf_validate_validate, file_google_protobuf_empty, file_google_protobuf_field_mask, file_permissions, file_user]);
 
 /**
  * @generated from message musicclub.auth.Credentials
@@ -53,6 +54,13 @@ export type RegisterUserRequest = Message<"musicclub.auth.RegisterUserRequest">
    * @generated from field: musicclub.user.User profile = 2;
    */
   profile?: User;
+
+  /**
+   * Required when the club is invite-only.
+   *
+   * @generated from field: string invite_code = 3;
+   */
+  inviteCode: string;
 };
 
 /**
@@ -214,6 +222,11 @@ export type ProfileResponse = Message<"musicclub.auth.ProfileResponse"> & {
    * @generated from field: musicclub.permissions.PermissionSet permissions = 2;
    */
   permissions?: PermissionSet;
+
+  /**
+   * @generated from field: string bio = 3;
+   */
+  bio: string;
 };
 
 /**
@@ -223,6 +236,67 @@ export type ProfileResponse = Message<"musicclub.auth.ProfileResponse"> & {
 export const ProfileResponseSchema: GenMessage<ProfileResponse> = /*@__PURE__*/
   messageDesc(file_auth, 7);
 
+/**
+ * @generated from message musicclub.auth.UpdateProfileRequest
+ */
+export type UpdateProfileRequest = Message<"musicclub.auth.UpdateProfileRequest"> & {
+  /**
+   * @generated from field: string display_name = 1;
+   */
+  displayName: string;
+
+  /**
+   * @generated from field: string avatar_url = 2;
+   */
+  avatarUrl: string;
+
+  /**
+   * @generated from field: string bio = 3;
+   */
+  bio: string;
+
+  /**
+   * Fields to overwrite (display_name, avatar_url, bio, username); empty
+   * replaces all, except that an empty username keeps the current one.
+   *
+   * @generated from field: google.protobuf.FieldMask update_mask = 4;
+   */
+  updateMask?: FieldMask;
+
+  /**
+   * 3-32 latin letters, digits or underscores, starting with a letter. Names
+   * other members use or gave up in the last 30 days are refused. Access
+   * tokens carry the new name after the next Refresh.
+   *
+   * @generated from field: string username = 5;
+   */
+  username: string;
+};
+
+/**
+ * Describes the message musicclub.auth.UpdateProfileRequest.
+ * Use `create(UpdateProfileRequestSchema)` to create a new message.
+ */
+export const UpdateProfileRequestSchema: GenMessage<UpdateProfileRequest> = /*@__PURE__*/
+  messageDesc(file_auth, 8);
+
+/**
+ * @generated from message musicclub.auth.UploadAvatarRequest
+ */
+export type UploadAvatarRequest = Message<"musicclub.auth.UploadAvatarRequest"> & {
+  /**
+   * @generated from field: bytes chunk = 1;
+   */
+  chunk: Uint8Array;
+};
+
+/**
+ * Describes the message musicclub.auth.UploadAvatarRequest.
+ * Use `create(UploadAvatarRequestSchema)` to create a new message.
+ */
+export const UploadAvatarRequestSchema: GenMessage<UploadAvatarRequest> = /*@__PURE__*/
+  messageDesc(file_auth, 9);
+
 /**
  * @generated from message musicclub.auth.TelegramWebAppAuthRequest
  */
@@ -240,7 +314,7 @@ export type TelegramWebAppAuthRequest = Message<"musicclub.auth.TelegramWebAppAu
  * Use `create(TelegramWebAppAuthRequestSchema)` to create a new message.
  */
 export const TelegramWebAppAuthRequestSchema: GenMessage<TelegramWebAppAuthRequest> = /*@__PURE__*/
-  messageDesc(file_auth, 8);
+  messageDesc(file_auth, 10);
 
 /**
  * Authentication and membership gating for the app.
@@ -308,6 +382,28 @@ export const AuthService: GenService<{
     input: typeof TelegramWebAppAuthRequestSchema;
     output: typeof AuthSessionSchema;
   },
+  /**
+   * Changes the current user's profile. A name or avatar set here is no
+   * longer synced from Telegram; clearing the avatar resumes syncing it.
+   *
+   * @generated from rpc musicclub.auth.AuthService.UpdateProfile
+   */
+  updateProfile: {
+    methodKind: "unary";
+    input: typeof UpdateProfileRequestSchema;
+    output: typeof ProfileResponseSchema;
+  },
+  /**
+   * Uploads a new avatar image (JPEG, PNG, GIF or WebP) in chunks. It is
+   * cropped to a square and served from /avatars/<id>?size=N.
+   *
+   * @generated from rpc musicclub.auth.AuthService.UploadAvatar
+   */
+  uploadAvatar: {
+    methodKind: "client_streaming";
+    input: typeof UploadAvatarRequestSchema;
+    output: typeof ProfileResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_auth, 0);
 
@@ -0,0 +1,181 @@
+// @generated by protoc-gen-es v2.10.2 with parameter "target=ts,import_extension=ts"
+// @generated from file dashboard.proto (package musicclub.dashboard, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { EmptySchema, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_empty, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Event } from "./event_pb.ts";
+import { file_event } from "./event_pb.ts";
+import type { Song } from "./song_pb.ts";
+import { file_song } from "./song_pb.ts";
+import type { Activity } from "./user_pb.ts";
+import { file_user } from "./user_pb.ts";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file dashboard.proto.
+ */
+export const file_dashboard: GenFile = /*@__PURE__*/
+  fileDesc("Cg9kYXNoYm9hcmQucHJvdG8SE211c2ljY2x1Yi5kYXNoYm9hcmQicgoSVHJhY2tsaXN0UmVhZGluZXNzEg0KBWl0ZW1zGAEgASgFEg0KBXJlYWR5GAIgASgFEhMKC2luX3Byb2dyZXNzGAMgASgFEhMKC25vdF9zdGFydGVkGAQgASgFEhQKDHZhY2FudF9yb2xlcxgFIAEoBSKUAQoKVmFjYW50Um9sZRIQCghldmVudF9pZBgBIAEoCRITCgtldmVudF90aXRsZRgCIAEoCRIsCghzdGFydF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDwoHc29uZ19pZBgEIAEoCRISCgpzb25nX3RpdGxlGAUgASgJEgwKBHJvbGUYBiABKAkiswIKCURhc2hib2FyZBIqCgpuZXh0X2V2ZW50GAEgASgLMhYubXVzaWNjbHViLmV2ZW50LkV2ZW50EkUKFG5leHRfZXZlbnRfcmVhZGluZXNzGAIgASgLMicubXVzaWNjbHViLmRhc2hib2FyZC5UcmFja2xpc3RSZWFkaW5lc3MSNQoMdmFjYW50X3JvbGVzGAMgAygLMh8ubXVzaWNjbHViLmRhc2hib2FyZC5WYWNhbnRSb2xlEioKDHJlY2VudF9zb25ncxgEIAMoCzIULm11c2ljY2x1Yi5zb25nLlNvbmcSHQoVdW5yZWFkX2FjdGl2aXR5X2NvdW50GAUgASgFEjEKD3VucmVhZF9hY3Rpdml0eRgGIAMoCzIYLm11c2ljY2x1Yi51c2VyLkFjdGl2aXR5MloKEERhc2hib2FyZFNlcnZpY2USRgoMR2V0RGFzaGJvYXJkEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5Gh4ubXVzaWNjbHViLmRhc2hib2FyZC5EYXNoYm9hcmRCHFoabXVzaWNjbHViYm90L2JhY2tlbmQvcHJvdG9iBnByb3RvMw", [file_google_protobuf_empty, file_google_protobuf_timestamp, file_event, file_song, file_user]);
+
+/**
+ * @generated from message musicclub.dashboard.TracklistReadiness
+ */
+export type TracklistReadiness = Message<"musicclub.dashboard.TracklistReadiness"> & {
+  /**
+   * @generated from field: int32 items = 1;
+   */
+  items: number;
+
+  /**
+   * Items by rehearsal status.
+   *
+   * @generated from field: int32 ready = 2;
+   */
+  ready: number;
+
+  /**
+   * @generated from field: int32 in_progress = 3;
+   */
+  inProgress: number;
+
+  /**
+   * @generated from field: int32 not_started = 4;
+   */
+  notStarted: number;
+
+  /**
+   * Roles of tracklist songs nobody plays yet.
+   *
+   * @generated from field: int32 vacant_roles = 5;
+   */
+  vacantRoles: number;
+};
+
+/**
+ * Describes the message musicclub.dashboard.TracklistReadiness.
+ * Use `create(TracklistReadinessSchema)` to create a new message.
+ */
+export const TracklistReadinessSchema: GenMessage<TracklistReadiness> = /*@__PURE__*/
+  messageDesc(file_dashboard, 0);
+
+/**
+ * A role an upcoming event still needs someone for.
+ *
+ * @generated from message musicclub.dashboard.VacantRole
+ */
+export type VacantRole = Message<"musicclub.dashboard.VacantRole"> & {
+  /**
+   * @generated from field: string event_id = 1;
+   */
+  eventId: string;
+
+  /**
+   * @generated from field: string event_title = 2;
+   */
+  eventTitle: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_at = 3;
+   */
+  startAt?: Timestamp;
+
+  /**
+   * Set for roles of a tracklist song, empty for roles the event itself
+   * requires.
+   *
+   * @generated from field: string song_id = 4;
+   */
+  songId: string;
+
+  /**
+   * @generated from field: string song_title = 5;
+   */
+  songTitle: string;
+
+  /**
+   * @generated from field: string role = 6;
+   */
+  role: string;
+};
+
+/**
+ * Describes the message musicclub.dashboard.VacantRole.
+ * Use `create(VacantRoleSchema)` to create a new message.
+ */
+export const VacantRoleSchema: GenMessage<VacantRole> = /*@__PURE__*/
+  messageDesc(file_dashboard, 1);
+
+/**
+ * @generated from message musicclub.dashboard.Dashboard
+ */
+export type Dashboard = Message<"musicclub.dashboard.Dashboard"> & {
+  /**
+   * The soonest upcoming event; unset when nothing is planned.
+   *
+   * @generated from field: musicclub.event.Event next_event = 1;
+   */
+  nextEvent?: Event;
+
+  /**
+   * @generated from field: musicclub.dashboard.TracklistReadiness next_event_readiness = 2;
+   */
+  nextEventReadiness?: TracklistReadiness;
+
+  /**
+   * Across upcoming events, soonest first; at most 50.
+   *
+   * @generated from field: repeated musicclub.dashboard.VacantRole vacant_roles = 3;
+   */
+  vacantRoles: VacantRole[];
+
+  /**
+   * Newest first.
+   *
+   * @generated from field: repeated musicclub.song.Song recent_songs = 4;
+   */
+  recentSongs: Song[];
+
+  /**
+   * Other members' activity since the feed was last marked seen.
+   *
+   * @generated from field: int32 unread_activity_count = 5;
+   */
+  unreadActivityCount: number;
+
+  /**
+   * The newest of those, at most 10.
+   *
+   * @generated from field: repeated musicclub.user.Activity unread_activity = 6;
+   */
+  unreadActivity: Activity[];
+};
+
+/**
+ * Describes the message musicclub.dashboard.Dashboard.
+ * Use `create(DashboardSchema)` to create a new message.
+ */
+export const DashboardSchema: GenMessage<Dashboard> = /*@__PURE__*/
+  messageDesc(file_dashboard, 2);
+
+/**
+ * Mini App home screen.
+ *
+ * @generated from service musicclub.dashboard.DashboardService
+ */
+export const DashboardService: GenService<{
+  /**
+   * Everything the home screen shows, in one call.
+   *
+   * @generated from rpc musicclub.dashboard.DashboardService.GetDashboard
+   */
+  getDashboard: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof DashboardSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_dashboard, 0);
+
@@ -0,0 +1,429 @@
+// @generated by protoc-gen-es v2.10.2 with parameter "target=ts,import_extension=ts"
+// @generated from file dues.proto (package musicclub.dues, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "./buf/validate/validate_pb.ts";
+import type { EmptySchema, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_empty, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Season } from "./season_pb.ts";
+import { file_season } from "./season_pb.ts";
+import type { User } from "./user_pb.ts";
+import { file_user } from "./user_pb.ts";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file dues.proto.
+ */
+export const file_dues: GenFile = /*@__PURE__*/
+  fileDesc("CgpkdWVzLnByb3RvEg5tdXNpY2NsdWIuZHVlcyKyAgoJRHVlc0VudHJ5EgoKAmlkGAEgASgJEiIKBHVzZXIYAiABKAsyFC5tdXNpY2NsdWIudXNlci5Vc2VyEisKBGtpbmQYAyABKA4yHS5tdXNpY2NsdWIuZHVlcy5EdWVzRW50cnlLaW5kEhQKDGFtb3VudF9jZW50cxgEIAEoAxITCgtkZXNjcmlwdGlvbhgFIAEoCRIRCglzZWFzb25faWQYBiABKAkSLwoLb2NjdXJyZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEikKC3JlY29yZGVkX2J5GAggASgLMhQubXVzaWNjbHViLnVzZXIuVXNlchIuCgpjcmVhdGVkX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIjCgtEdWVzRW50cnlJZBIUCgJpZBgBIAEoCUIIukgFcgOwAQEi3AEKFlJlY29yZER1ZXNFbnRyeVJlcXVlc3QSGQoHdXNlcl9pZBgBIAEoCUIIukgFcgOwAQESOAoEa2luZBgCIAEoDjIdLm11c2ljY2x1Yi5kdWVzLkR1ZXNFbnRyeUtpbmRCC7pICIIBAhAByAEBEhQKDGFtb3VudF9jZW50cxgDIAEoAxITCgtkZXNjcmlwdGlvbhgEIAEoCRIvCgtvY2N1cnJlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEQoJc2Vhc29uX2lkGAYgASgJIm0KFkxpc3REdWVzRW50cmllc1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIRCglzZWFzb25faWQYAiABKAkSEgoKcGFnZV90b2tlbhgDIAEoCRIbCglwYWdlX3NpemUYBCABKA1CCLpIBSoDGMgBInMKF0xpc3REdWVzRW50cmllc1Jlc3BvbnNlEioKB2VudHJpZXMYASADKAsyGS5tdXNpY2NsdWIuZHVlcy5EdWVzRW50cnkSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJEhMKC3RvdGFsX2NvdW50GAMgASgFInoKC0R1ZXNCYWxhbmNlEiIKBHVzZXIYASABKAsyFC5tdXNpY2NsdWIudXNlci5Vc2VyEhkKEWNvbnRyaWJ1dGVkX2NlbnRzGAIgASgDEhUKDWNoYXJnZWRfY2VudHMYAyABKAMSFQoNYmFsYW5jZV9jZW50cxgEIAEoAyJ7ChhMaXN0RHVlc0JhbGFuY2VzUmVzcG9uc2USLQoIYmFsYW5jZXMYASADKAsyGy5tdXNpY2NsdWIuZHVlcy5EdWVzQmFsYW5jZRIZChFjb250cmlidXRlZF9jZW50cxgCIAEoAxIVCg1jaGFyZ2VkX2NlbnRzGAMgASgDIi0KGFNlYXNvbkR1ZXNTdW1tYXJ5UmVxdWVzdBIRCglzZWFzb25faWQYASABKAkingEKEVNlYXNvbkR1ZXNTdW1tYXJ5EigKBnNlYXNvbhgBIAEoCzIYLm11c2ljY2x1Yi5zZWFzb24uU2Vhc29uEi0KCGJhbGFuY2VzGAIgAygLMhsubXVzaWNjbHViLmR1ZXMuRHVlc0JhbGFuY2USGQoRY29udHJpYnV0ZWRfY2VudHMYAyABKAMSFQoNY2hhcmdlZF9jZW50cxgEIAEoAypuCg1EdWVzRW50cnlLaW5kEh8KG0RVRVNfRU5UUllfS0lORF9VTlNQRUNJRklFRBAAEiAKHERVRVNfRU5UUllfS0lORF9DT05UUklCVVRJT04QARIaChZEVUVTX0VOVFJZX0tJTkRfQ0hBUkdFEAIykwQKC0R1ZXNTZXJ2aWNlElQKD1JlY29yZER1ZXNFbnRyeRImLm11c2ljY2x1Yi5kdWVzLlJlY29yZER1ZXNFbnRyeVJlcXVlc3QaGS5tdXNpY2NsdWIuZHVlcy5EdWVzRW50cnkSRgoPRGVsZXRlRHVlc0VudHJ5EhsubXVzaWNjbHViLmR1ZXMuRHVlc0VudHJ5SWQaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSYgoPTGlzdER1ZXNFbnRyaWVzEiYubXVzaWNjbHViLmR1ZXMuTGlzdER1ZXNFbnRyaWVzUmVxdWVzdBonLm11c2ljY2x1Yi5kdWVzLkxpc3REdWVzRW50cmllc1Jlc3BvbnNlEkcKEEdldE15RHVlc0JhbGFuY2USFi5nb29nbGUucHJvdG9idWYuRW1wdHkaGy5tdXNpY2NsdWIuZHVlcy5EdWVzQmFsYW5jZRJUChBMaXN0RHVlc0JhbGFuY2VzEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5GigubXVzaWNjbHViLmR1ZXMuTGlzdER1ZXNCYWxhbmNlc1Jlc3BvbnNlEmMKFEdldFNlYXNvbkR1ZXNTdW1tYXJ5EigubXVzaWNjbHViLmR1ZXMuU2Vhc29uRHVlc1N1bW1hcnlSZXF1ZXN0GiEubXVzaWNjbHViLmR1ZXMuU2Vhc29uRHVlc1N1bW1hcnlCHFoabXVzaWNjbHViYm90L2JhY2tlbmQvcHJvdG9iBnByb3RvMw", [file_buf_validate_validate, file_google_protobuf_empty, file_google_protobuf_timestamp, file_season, file_user]);
+
+/**
+ * @generated from message musicclub.dues.DuesEntry
+ */
+export type DuesEntry = Message<"musicclub.dues.DuesEntry"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: musicclub.user.User user = 2;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: musicclub.dues.DuesEntryKind kind = 3;
+   */
+  kind: DuesEntryKind;
+
+  /**
+   * In minor currency units (kopecks), always positive.
+   *
+   * @generated from field: int64 amount_cents = 4;
+   */
+  amountCents: bigint;
+
+  /**
+   * E.g. "Rehearsal room, March".
+   *
+   * @generated from field: string description = 5;
+   */
+  description: string;
+
+  /**
+   * Empty when the entry belongs to no season.
+   *
+   * @generated from field: string season_id = 6;
+   */
+  seasonId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp occurred_at = 7;
+   */
+  occurredAt?: Timestamp;
+
+  /**
+   * @generated from field: musicclub.user.User recorded_by = 8;
+   */
+  recordedBy?: User;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 9;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message musicclub.dues.DuesEntry.
+ * Use `create(DuesEntrySchema)` to create a new message.
+ */
+export const DuesEntrySchema: GenMessage<DuesEntry> = /*@__PURE__*/
+  messageDesc(file_dues, 0);
+
+/**
+ * @generated from message musicclub.dues.DuesEntryId
+ */
+export type DuesEntryId = Message<"musicclub.dues.DuesEntryId"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message musicclub.dues.DuesEntryId.
+ * Use `create(DuesEntryIdSchema)` to create a new message.
+ */
+export const DuesEntryIdSchema: GenMessage<DuesEntryId> = /*@__PURE__*/
+  messageDesc(file_dues, 1);
+
+/**
+ * @generated from message musicclub.dues.RecordDuesEntryRequest
+ */
+export type RecordDuesEntryRequest = Message<"musicclub.dues.RecordDuesEntryRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: musicclub.dues.DuesEntryKind kind = 2;
+   */
+  kind: DuesEntryKind;
+
+  /**
+   * @generated from field: int64 amount_cents = 3;
+   */
+  amountCents: bigint;
+
+  /**
+   * @generated from field: string description = 4;
+   */
+  description: string;
+
+  /**
+   * Defaults to now.
+   *
+   * @generated from field: google.protobuf.Timestamp occurred_at = 5;
+   */
+  occurredAt?: Timestamp;
+
+  /**
+   * Defaults to the season running at occurred_at.
+   *
+   * @generated from field: string season_id = 6;
+   */
+  seasonId: string;
+};
+
+/**
+ * Describes the message musicclub.dues.RecordDuesEntryRequest.
+ * Use `create(RecordDuesEntryRequestSchema)` to create a new message.
+ */
+export const RecordDuesEntryRequestSchema: GenMessage<RecordDuesEntryRequest> = /*@__PURE__*/
+  messageDesc(file_dues, 2);
+
+/**
+ * @generated from message musicclub.dues.ListDuesEntriesRequest
+ */
+export type ListDuesEntriesRequest = Message<"musicclub.dues.ListDuesEntriesRequest"> & {
+  /**
+   * Defaults to the current user.
+   *
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * Optional season filter.
+   *
+   * @generated from field: string season_id = 2;
+   */
+  seasonId: string;
+
+  /**
+   * Pagination cursor (opaque to client).
+   *
+   * @generated from field: string page_token = 3;
+   */
+  pageToken: string;
+
+  /**
+   * @generated from field: uint32 page_size = 4;
+   */
+  pageSize: number;
+};
+
+/**
+ * Describes the message musicclub.dues.ListDuesEntriesRequest.
+ * Use `create(ListDuesEntriesRequestSchema)` to create a new message.
+ */
+export const ListDuesEntriesRequestSchema: GenMessage<ListDuesEntriesRequest> = /*@__PURE__*/
+  messageDesc(file_dues, 3);
+
+/**
+ * @generated from message musicclub.dues.ListDuesEntriesResponse
+ */
+export type ListDuesEntriesResponse = Message<"musicclub.dues.ListDuesEntriesResponse"> & {
+  /**
+   * @generated from field: repeated musicclub.dues.DuesEntry entries = 1;
+   */
+  entries: DuesEntry[];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+
+  /**
+   * @generated from field: int32 total_count = 3;
+   */
+  totalCount: number;
+};
+
+/**
+ * Describes the message musicclub.dues.ListDuesEntriesResponse.
+ * Use `create(ListDuesEntriesResponseSchema)` to create a new message.
+ */
+export const ListDuesEntriesResponseSchema: GenMessage<ListDuesEntriesResponse> = /*@__PURE__*/
+  messageDesc(file_dues, 4);
+
+/**
+ * @generated from message musicclub.dues.DuesBalance
+ */
+export type DuesBalance = Message<"musicclub.dues.DuesBalance"> & {
+  /**
+   * @generated from field: musicclub.user.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: int64 contributed_cents = 2;
+   */
+  contributedCents: bigint;
+
+  /**
+   * @generated from field: int64 charged_cents = 3;
+   */
+  chargedCents: bigint;
+
+  /**
+   * contributed minus charged: negative means the member owes the club.
+   *
+   * @generated from field: int64 balance_cents = 4;
+   */
+  balanceCents: bigint;
+};
+
+/**
+ * Describes the message musicclub.dues.DuesBalance.
+ * Use `create(DuesBalanceSchema)` to create a new message.
+ */
+export const DuesBalanceSchema: GenMessage<DuesBalance> = /*@__PURE__*/
+  messageDesc(file_dues, 5);
+
+/**
+ * @generated from message musicclub.dues.ListDuesBalancesResponse
+ */
+export type ListDuesBalancesResponse = Message<"musicclub.dues.ListDuesBalancesResponse"> & {
+  /**
+   * @generated from field: repeated musicclub.dues.DuesBalance balances = 1;
+   */
+  balances: DuesBalance[];
+
+  /**
+   * Sums over all members.
+   *
+   * @generated from field: int64 contributed_cents = 2;
+   */
+  contributedCents: bigint;
+
+  /**
+   * @generated from field: int64 charged_cents = 3;
+   */
+  chargedCents: bigint;
+};
+
+/**
+ * Describes the message musicclub.dues.ListDuesBalancesResponse.
+ * Use `create(ListDuesBalancesResponseSchema)` to create a new message.
+ */
+export const ListDuesBalancesResponseSchema: GenMessage<ListDuesBalancesResponse> = /*@__PURE__*/
+  messageDesc(file_dues, 6);
+
+/**
+ * @generated from message musicclub.dues.SeasonDuesSummaryRequest
+ */
+export type SeasonDuesSummaryRequest = Message<"musicclub.dues.SeasonDuesSummaryRequest"> & {
+  /**
+   * @generated from field: string season_id = 1;
+   */
+  seasonId: string;
+};
+
+/**
+ * Describes the message musicclub.dues.SeasonDuesSummaryRequest.
+ * Use `create(SeasonDuesSummaryRequestSchema)` to create a new message.
+ */
+export const SeasonDuesSummaryRequestSchema: GenMessage<SeasonDuesSummaryRequest> = /*@__PURE__*/
+  messageDesc(file_dues, 7);
+
+/**
+ * @generated from message musicclub.dues.SeasonDuesSummary
+ */
+export type SeasonDuesSummary = Message<"musicclub.dues.SeasonDuesSummary"> & {
+  /**
+   * @generated from field: musicclub.season.Season season = 1;
+   */
+  season?: Season;
+
+  /**
+   * Members with entries in the season, lowest balance first.
+   *
+   * @generated from field: repeated musicclub.dues.DuesBalance balances = 2;
+   */
+  balances: DuesBalance[];
+
+  /**
+   * @generated from field: int64 contributed_cents = 3;
+   */
+  contributedCents: bigint;
+
+  /**
+   * @generated from field: int64 charged_cents = 4;
+   */
+  chargedCents: bigint;
+};
+
+/**
+ * Describes the message musicclub.dues.SeasonDuesSummary.
+ * Use `create(SeasonDuesSummarySchema)` to create a new message.
+ */
+export const SeasonDuesSummarySchema: GenMessage<SeasonDuesSummary> = /*@__PURE__*/
+  messageDesc(file_dues, 8);
+
+/**
+ * @generated from enum musicclub.dues.DuesEntryKind
+ */
+export enum DuesEntryKind {
+  /**
+   * @generated from enum value: DUES_ENTRY_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Money the member paid in.
+   *
+   * @generated from enum value: DUES_ENTRY_KIND_CONTRIBUTION = 1;
+   */
+  CONTRIBUTION = 1,
+
+  /**
+   * Money the member owes, e.g. their part of the rent.
+   *
+   * @generated from enum value: DUES_ENTRY_KIND_CHARGE = 2;
+   */
+  CHARGE = 2,
+}
+
+/**
+ * Describes the enum musicclub.dues.DuesEntryKind.
+ */
+export const DuesEntryKindSchema: GenEnum<DuesEntryKind> = /*@__PURE__*/
+  enumDesc(file_dues, 0);
+
+/**
+ * Club dues ledger kept by admins by hand: what members paid in and what
+ * they were charged. Nothing here moves money.
+ *
+ * @generated from service musicclub.dues.DuesService
+ */
+export const DuesService: GenService<{
+  /**
+   * Admins only.
+   *
+   * @generated from rpc musicclub.dues.DuesService.RecordDuesEntry
+   */
+  recordDuesEntry: {
+    methodKind: "unary";
+    input: typeof RecordDuesEntryRequestSchema;
+    output: typeof DuesEntrySchema;
+  },
+  /**
+   * Admins only.
+   *
+   * @generated from rpc musicclub.dues.DuesService.DeleteDuesEntry
+   */
+  deleteDuesEntry: {
+    methodKind: "unary";
+    input: typeof DuesEntryIdSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * Entries, latest first. Members may list their own; anyone else's needs
+   * admin rights.
+   *
+   * @generated from rpc musicclub.dues.DuesService.ListDuesEntries
+   */
+  listDuesEntries: {
+    methodKind: "unary";
+    input: typeof ListDuesEntriesRequestSchema;
+    output: typeof ListDuesEntriesResponseSchema;
+  },
+  /**
+   * The current user's all-time balance.
+   *
+   * @generated from rpc musicclub.dues.DuesService.GetMyDuesBalance
+   */
+  getMyDuesBalance: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof DuesBalanceSchema;
+  },
+  /**
+   * All-time balance of every member with entries, lowest first. Admins
+   * only.
+   *
+   * @generated from rpc musicclub.dues.DuesService.ListDuesBalances
+   */
+  listDuesBalances: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof ListDuesBalancesResponseSchema;
+  },
+  /**
+   * Totals of the season's entries, per member and for the club. Admins
+   * only.
+   *
+   * @generated from rpc musicclub.dues.DuesService.GetSeasonDuesSummary
+   */
+  getSeasonDuesSummary: {
+    methodKind: "unary";
+    input: typeof SeasonDuesSummaryRequestSchema;
+    output: typeof SeasonDuesSummarySchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_dues, 0);
+
@@ -2,22 +2,27 @@
 // @generated from file event.proto (package musicclub.event, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
-import type { EmptySchema, Timestamp } from "@bufbuild/protobuf/wkt";
-import { file_google_protobuf_empty, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "./buf/validate/validate_pb.ts";
+import type { EmptySchema, FieldMask, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import type { RoleAssignment } from "./song_pb.ts";
 import { file_song } from "./song_pb.ts";
+import type { User } from "./user_pb.ts";
 import { file_user } from "./user_pb.ts";
 import type { PermissionSet } from "./permissions_pb.ts";
 import { file_permissions } from "./permissions_pb.ts";
+import type { GeoPoint, Venue } from "./venue_pb.ts";
+import { file_venue } from "./venue_pb.ts";
+import { file_validation } from "./validation_pb.ts";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file event.proto.
  */
 export const file_event: GenFile = /*@__PURE__*/
-  fileDesc("CgtldmVudC5wcm90bxIPbXVzaWNjbHViLmV2ZW50IhUKB0V2ZW50SWQSCgoCaWQYASABKAkidAoRTGlzdEV2ZW50c1JlcXVlc3QSKAoEZnJvbRgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASJgoCdG8YAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg0KBWxpbWl0GAMgASgNIjwKEkxpc3RFdmVudHNSZXNwb25zZRImCgZldmVudHMYASADKAsyFi5tdXNpY2NsdWIuZXZlbnQuRXZlbnQimQEKBUV2ZW50EgoKAmlkGAEgASgJEg0KBXRpdGxlGAIgASgJEiwKCHN0YXJ0X2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIQCghsb2NhdGlvbhgEIAEoCRIZChFub3RpZnlfZGF5X2JlZm9yZRgFIAEoCBIaChJub3RpZnlfaG91cl9iZWZvcmUYBiABKAgi1QEKDEV2ZW50RGV0YWlscxIlCgVldmVudBgBIAEoCzIWLm11c2ljY2x1Yi5ldmVudC5FdmVudBItCgl0cmFja2xpc3QYAiABKAsyGi5tdXNpY2NsdWIuZXZlbnQuVHJhY2tsaXN0EjQKDHBhcnRpY2lwYW50cxgDIAMoCzIeLm11c2ljY2x1Yi5zb25nLlJvbGVBc3NpZ25tZW50EjkKC3Blcm1pc3Npb25zGAQgASgLMiQubXVzaWNjbHViLnBlcm1pc3Npb25zLlBlcm1pc3Npb25TZXQiNgoJVHJhY2tsaXN0EikKBWl0ZW1zGAEgAygLMhoubXVzaWNjbHViLmV2ZW50LlRyYWNrSXRlbSJYCglUcmFja0l0ZW0SDQoFb3JkZXIYASABKA0SDwoHc29uZ19pZBgCIAEoCRIUCgxjdXN0b21fdGl0bGUYAyABKAkSFQoNY3VzdG9tX2FydGlzdBgEIAEoCSLJAQoSQ3JlYXRlRXZlbnRSZXF1ZXN0Eg0KBXRpdGxlGAEgASgJEiwKCHN0YXJ0X2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIQCghsb2NhdGlvbhgDIAEoCRIZChFub3RpZnlfZGF5X2JlZm9yZRgEIAEoCBIaChJub3RpZnlfaG91cl9iZWZvcmUYBSABKAgSLQoJdHJhY2tsaXN0GAYgASgLMhoubXVzaWNjbHViLmV2ZW50LlRyYWNrbGlzdCKmAQoSVXBkYXRlRXZlbnRSZXF1ZXN0EgoKAmlkGAEgASgJEg0KBXRpdGxlGAIgASgJEiwKCHN0YXJ0X2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIQCghsb2NhdGlvbhgEIAEoCRIZChFub3RpZnlfZGF5X2JlZm9yZRgFIAEoCBIaChJub3RpZnlfaG91cl9iZWZvcmUYBiABKAgiVgoTU2V0VHJhY2tsaXN0UmVxdWVzdBIQCghldmVudF9pZBgBIAEoCRItCgl0cmFja2xpc3QYAiABKAsyGi5tdXNpY2NsdWIuZXZlbnQuVHJhY2tsaXN0MuYDCgxFdmVudFNlcnZpY2USVQoKTGlzdEV2ZW50cxIiLm11c2ljY2x1Yi5ldmVudC5MaXN0RXZlbnRzUmVxdWVzdBojLm11c2ljY2x1Yi5ldmVudC5MaXN0RXZlbnRzUmVzcG9uc2USQwoIR2V0RXZlbnQSGC5tdXNpY2NsdWIuZXZlbnQuRXZlbnRJZBodLm11c2ljY2x1Yi5ldmVudC5FdmVudERldGFpbHMSUQoLQ3JlYXRlRXZlbnQSIy5tdXNpY2NsdWIuZXZlbnQuQ3JlYXRlRXZlbnRSZXF1ZXN0Gh0ubXVzaWNjbHViLmV2ZW50LkV2ZW50RGV0YWlscxJRCgtVcGRhdGVFdmVudBIjLm11c2ljY2x1Yi5ldmVudC5VcGRhdGVFdmVudFJlcXVlc3QaHS5tdXNpY2NsdWIuZXZlbnQuRXZlbnREZXRhaWxzEj8KC0RlbGV0ZUV2ZW50EhgubXVzaWNjbHViLmV2ZW50LkV2ZW50SWQaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSUwoMU2V0VHJhY2tsaXN0EiQubXVzaWNjbHViLmV2ZW50LlNldFRyYWNrbGlzdFJlcXVlc3QaHS5tdXNpY2NsdWIuZXZlbnQuRXZlbnREZXRhaWxzQhxaGm11c2ljY2x1YmJvdC9iYWNrZW5kL3Byb3RvYgZwcm90bzM", [file_google_protobuf_empty, file_google_protobuf_timestamp, file_song, file_user, file_permissions]);
+  fileDesc("CgtldmVudC5wcm90bxIPbXVzaWNjbHViLmV2ZW50Ih8KB0V2ZW50SWQSFAoCaWQYASABKAlCCLpIBXIDsAEBIjYKEUV2ZW50T3duZXJSZXF1ZXN0EhAKCGV2ZW50X2lkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkiNgoSQ2FuY2VsRXZlbnRSZXF1ZXN0EhAKCGV2ZW50X2lkGAEgASgJEg4KBnJlYXNvbhgCIAEoCSK3AgoRTGlzdEV2ZW50c1JlcXVlc3QSKAoEZnJvbRgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASJgoCdG8YAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhcKBWxpbWl0GAMgASgNQgi6SAUqAxjIARI1Cgt0aW1lX2ZpbHRlchgEIAEoDjIgLm11c2ljY2x1Yi5ldmVudC5FdmVudFRpbWVGaWx0ZXISEgoKcGFnZV90b2tlbhgFIAEoCRIbCglwYWdlX3NpemUYBiABKA1CCLpIBSoDGMgBEh0KCHZlbnVlX2lkGAcgASgJQgu6SAhyA7ABAdgBARIeCglzZWFzb25faWQYCCABKAlCC7pICHIDsAEB2AEBEhAKCGFyY2hpdmVkGAkgASgIIlUKEkxpc3RFdmVudHNSZXNwb25zZRImCgZldmVudHMYASADKAsyFi5tdXNpY2NsdWIuZXZlbnQuRXZlbnQSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIswGCgVFdmVudBIKCgJpZBgBIAEoCRINCgV0aXRsZRgCIAEoCRIsCghzdGFydF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEAoIbG9jYXRpb24YBCABKAkSGQoRbm90aWZ5X2RheV9iZWZvcmUYBSABKAgSGgoSbm90aWZ5X2hvdXJfYmVmb3JlGAYgASgIEg8KB3ZlcnNpb24YByABKAMSGQoRcGFydGljaXBhbnRfY291bnQYCCABKAUSEwoLdHJhY2tfY291bnQYCSABKAUSEQoJc2VyaWVzX2lkGAogASgJEhIKCnJlY3VycmVuY2UYCyABKAkSGAoQbWF4X3BhcnRpY2lwYW50cxgMIAEoBRIWCg5yZXF1aXJlZF9yb2xlcxgNIAMoCRIYChBhdHRlbmRhbmNlX2NvdW50GA4gASgFEhAKCHZlbnVlX2lkGA8gASgJEhkKEXRpbWVfc2xvdF9taW51dGVzGBAgASgFEjAKDGNvbXBsZXRlZF9hdBgRIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASHgoWbm90aWZ5X29mZnNldHNfbWludXRlcxgSIAMoBRIwCgxjYW5jZWxsZWRfYXQYEyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhUKDWNhbmNlbF9yZWFzb24YFCABKAkSEAoIdGltZXpvbmUYFSABKAkSFgoObG9jYWxfc3RhcnRfYXQYFiABKAkSEQoJc2Vhc29uX2lkGBcgASgJEhkKEXRyYWNrX2dhcF9zZWNvbmRzGBggASgFEh0KFWN1cnJlbnRfdHJhY2tfaXRlbV9pZBgZIAEoCRI8ChhjdXJyZW50X3RyYWNrX3N0YXJ0ZWRfYXQYGiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCmRlbGV0ZWRfYXQYGyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KC2Nvb3JkaW5hdGVzGBwgASgLMhkubXVzaWNjbHViLnZlbnVlLkdlb1BvaW50Eg0KBXRoZW1lGB0gASgJEhEKCXRoZW1lX3RhZxgeIAEoCSKwBgoMRXZlbnREZXRhaWxzEiUKBWV2ZW50GAEgASgLMhYubXVzaWNjbHViLmV2ZW50LkV2ZW50Ei0KCXRyYWNrbGlzdBgCIAEoCzIaLm11c2ljY2x1Yi5ldmVudC5UcmFja2xpc3QSNAoMcGFydGljaXBhbnRzGAMgAygLMh4ubXVzaWNjbHViLnNvbmcuUm9sZUFzc2lnbm1lbnQSOQoLcGVybWlzc2lvbnMYBCABKAsyJC5tdXNpY2NsdWIucGVybWlzc2lvbnMuUGVybWlzc2lvblNldBIyCgxyc3ZwX3N1bW1hcnkYBSABKAsyHC5tdXNpY2NsdWIuZXZlbnQuUnN2cFN1bW1hcnkSLAoHbXlfcnN2cBgGIAEoDjIbLm11c2ljY2x1Yi5ldmVudC5Sc3ZwU3RhdHVzEiQKBXJzdnBzGAcgAygLMhUubXVzaWNjbHViLmV2ZW50LlJzdnASEgoKc3BvdHNfbGVmdBgIIAEoBRIPCgdpY3NfdXJsGAkgASgJEi4KCnJlaGVhcnNhbHMYCiADKAsyGi5tdXNpY2NsdWIuZXZlbnQuUmVoZWFyc2FsEi8KCmF0dGVuZGFuY2UYCyADKAsyGy5tdXNpY2NsdWIuZXZlbnQuQXR0ZW5kYW5jZRISCgpjaGVja2VkX2luGAwgASgIEiUKBXZlbnVlGA0gASgLMhYubXVzaWNjbHViLnZlbnVlLlZlbnVlEi0KB2xpbmV1cHMYDiADKAsyHC5tdXNpY2NsdWIuZXZlbnQuVHJhY2tMaW5ldXASOAoPZmVlZGJhY2tfc3VydmV5GA8gASgLMh8ubXVzaWNjbHViLmV2ZW50LkZlZWRiYWNrU3VydmV5EjEKCWVxdWlwbWVudBgQIAMoCzIeLm11c2ljY2x1Yi5ldmVudC5FcXVpcG1lbnRJdGVtEiQKBXJpZGVzGBEgAygLMhUubXVzaWNjbHViLmV2ZW50LlJpZGUSJAoGb3duZXJzGBIgAygLMhQubXVzaWNjbHViLnVzZXIuVXNlchIQCghjYW5fZWRpdBgTIAEoCBIWCg5kaXJlY3Rpb25zX3VybBgUIAEoCSJZCgtUcmFja0xpbmV1cBIVCg10cmFja19pdGVtX2lkGAEgASgJEjMKC2Fzc2lnbm1lbnRzGAIgAygLMh4ubXVzaWNjbHViLnNvbmcuUm9sZUFzc2lnbm1lbnQiYwoKQXR0ZW5kYW5jZRIiCgR1c2VyGAEgASgLMhQubXVzaWNjbHViLnVzZXIuVXNlchIxCg1jaGVja2VkX2luX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJRCgtSc3ZwU3VtbWFyeRINCgVnb2luZxgBIAEoBRINCgVtYXliZRgCIAEoBRIQCghkZWNsaW5lZBgDIAEoBRISCgp3YWl0bGlzdGVkGAQgASgFIocBCgRSc3ZwEiIKBHVzZXIYASABKAsyFC5tdXNpY2NsdWIudXNlci5Vc2VyEisKBnN0YXR1cxgCIAEoDjIbLm11c2ljY2x1Yi5ldmVudC5Sc3ZwU3RhdHVzEi4KCnVwZGF0ZWRfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIlkKDlNldFJzdnBSZXF1ZXN0EhoKCGV2ZW50X2lkGAEgASgJQgi6SAVyA7ABARIrCgZzdGF0dXMYAiABKA4yGy5tdXNpY2NsdWIuZXZlbnQuUnN2cFN0YXR1cyKrAQoJVHJhY2tsaXN0EikKBWl0ZW1zGAEgAygLMhoubXVzaWNjbHViLmV2ZW50LlRyYWNrSXRlbRIVCg10b3RhbF9zZWNvbmRzGAIgASgNEicKBHNldHMYAyADKAsyGS5tdXNpY2NsdWIuZXZlbnQuVHJhY2tTZXQSMwoId2FybmluZ3MYBCADKAsyIS5tdXNpY2NsdWIuZXZlbnQuVHJhY2tsaXN0V2FybmluZyKjAQoQVHJhY2tsaXN0V2FybmluZxIzCgRraW5kGAEgASgOMiUubXVzaWNjbHViLmV2ZW50LlRyYWNrbGlzdFdhcm5pbmdLaW5kEiIKBHVzZXIYAiABKAsyFC5tdXNpY2NsdWIudXNlci5Vc2VyEhYKDnRyYWNrX2l0ZW1faWRzGAMgAygJEg0KBXJvbGVzGAQgAygJEg8KB21lc3NhZ2UYBSABKAkiZgoIVHJhY2tTZXQSCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRIpCgVpdGVtcxgDIAMoCzIaLm11c2ljY2x1Yi5ldmVudC5UcmFja0l0ZW0SFQoNdG90YWxfc2Vjb25kcxgEIAEoDSLEAwoJVHJhY2tJdGVtEg0KBW9yZGVyGAEgASgNEg8KB3NvbmdfaWQYAiABKAkSFAoMY3VzdG9tX3RpdGxlGAMgASgJEhUKDWN1c3RvbV9hcnRpc3QYBCABKAkSCgoCaWQYBSABKAkSGAoQZHVyYXRpb25fc2Vjb25kcxgGIAEoDRIiChplZmZlY3RpdmVfZHVyYXRpb25fc2Vjb25kcxgHIAEoDRIXCg9lbmRzX2F0X3NlY29uZHMYCCABKA0SGQoRZXhjZWVkc190aW1lX3Nsb3QYCSABKAgSDgoGc2V0X2lkGAogASgJEhEKCXBlcmZvcm1lZBgLIAEoCBI/ChByZWhlYXJzYWxfc3RhdHVzGAwgASgOMiUubXVzaWNjbHViLmV2ZW50LlRyYWNrUmVoZWFyc2FsU3RhdHVzEg0KBW5vdGVzGA0gASgJEgsKA2tleRgOIAEoCRI0ChBwbGFubmVkX3N0YXJ0X2F0GA8gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI2ChJzY2hlZHVsZWRfc3RhcnRfYXQYECABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wInoKHlNldFRyYWNrUmVoZWFyc2FsU3RhdHVzUmVxdWVzdBIQCghldmVudF9pZBgBIAEoCRIPCgdpdGVtX2lkGAIgASgJEjUKBnN0YXR1cxgDIAEoDjIlLm11c2ljY2x1Yi5ldmVudC5UcmFja1JlaGVhcnNhbFN0YXR1cyJJChZTZXRDdXJyZW50VHJhY2tSZXF1ZXN0EhAKCGV2ZW50X2lkGAEgASgJEg8KB2l0ZW1faWQYAiABKAkSDAoEbmV4dBgDIAEoCCL/AwoSQ3JlYXRlRXZlbnRSZXF1ZXN0Eg0KBXRpdGxlGAEgASgJEiwKCHN0YXJ0X2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIQCghsb2NhdGlvbhgDIAEoCRIZChFub3RpZnlfZGF5X2JlZm9yZRgEIAEoCBIaChJub3RpZnlfaG91cl9iZWZvcmUYBSABKAgSLQoJdHJhY2tsaXN0GAYgASgLMhoubXVzaWNjbHViLmV2ZW50LlRyYWNrbGlzdBISCgpyZWN1cnJlbmNlGAcgASgJEhgKEG1heF9wYXJ0aWNpcGFudHMYCCABKAUSFgoOcmVxdWlyZWRfcm9sZXMYCSADKAkSEAoIdmVudWVfaWQYCiABKAkSGQoRdGltZV9zbG90X21pbnV0ZXMYCyABKAUSHgoWbm90aWZ5X29mZnNldHNfbWludXRlcxgMIAMoBRIQCgh0aW1lem9uZRgNIAEoCRIRCglzZWFzb25faWQYDiABKAkSGQoRdHJhY2tfZ2FwX3NlY29uZHMYDyABKAUSLgoLY29vcmRpbmF0ZXMYECABKAsyGS5tdXNpY2NsdWIudmVudWUuR2VvUG9pbnQSDwoHbWFwX3VybBgRIAEoCRINCgV0aGVtZRgSIAEoCRIRCgl0aGVtZV90YWcYEyABKAkifgoVRHVwbGljYXRlRXZlbnRSZXF1ZXN0EhAKCGV2ZW50X2lkGAEgASgJEiwKCHN0YXJ0X2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBINCgV0aXRsZRgDIAEoCRIWCg5jb3B5X3RyYWNrbGlzdBgEIAEoCCLXBAoSVXBkYXRlRXZlbnRSZXF1ZXN0EhQKAmlkGAEgASgJQgi6SAVyA7ABARINCgV0aXRsZRgCIAEoCRIsCghzdGFydF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEAoIbG9jYXRpb24YBCABKAkSGQoRbm90aWZ5X2RheV9iZWZvcmUYBSABKAgSGgoSbm90aWZ5X2hvdXJfYmVmb3JlGAYgASgIEiEKEGV4cGVjdGVkX3ZlcnNpb24YByABKANCB7pIBCICIAASLwoFc2NvcGUYCCABKA4yIC5tdXNpY2NsdWIuZXZlbnQuUmVjdXJyZW5jZVNjb3BlEhgKEG1heF9wYXJ0aWNpcGFudHMYCSABKAUSFgoOcmVxdWlyZWRfcm9sZXMYCiADKAkSEAoIdmVudWVfaWQYCyABKAkSGQoRdGltZV9zbG90X21pbnV0ZXMYDCABKAUSHgoWbm90aWZ5X29mZnNldHNfbWludXRlcxgNIAMoBRIQCgh0aW1lem9uZRgOIAEoCRIRCglzZWFzb25faWQYDyABKAkSGQoRdHJhY2tfZ2FwX3NlY29uZHMYECABKAUSLgoLY29vcmRpbmF0ZXMYESABKAsyGS5tdXNpY2NsdWIudmVudWUuR2VvUG9pbnQSDwoHbWFwX3VybBgSIAEoCRINCgV0aGVtZRgTIAEoCRIRCgl0aGVtZV90YWcYFCABKAkSLwoLdXBkYXRlX21hc2sYFSABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrIlYKE1NldFRyYWNrbGlzdFJlcXVlc3QSEAoIZXZlbnRfaWQYASABKAkSLQoJdHJhY2tsaXN0GAIgASgLMhoubXVzaWNjbHViLmV2ZW50LlRyYWNrbGlzdCJgChRDb3B5VHJhY2tsaXN0UmVxdWVzdBIXCg9zb3VyY2VfZXZlbnRfaWQYASABKAkSFwoPdGFyZ2V0X2V2ZW50X2lkGAIgASgJEhYKDm9ubHlfcGVyZm9ybWVkGAMgASgIInUKF1N1Z2dlc3RUcmFja2xpc3RSZXF1ZXN0EhAKCGV2ZW50X2lkGAEgASgJEg0KBWxpbWl0GAIgASgNEhcKD3dlaWdodF9ieV92b3RlcxgDIAEoCBIgChh3ZWlnaHRfYnlfbGFzdF9wZXJmb3JtZWQYBCABKAgiewoeUmVuZGVyRXZlbnRBbm5vdW5jZW1lbnRSZXF1ZXN0EhAKCGV2ZW50X2lkGAEgASgJEjMKBmZvcm1hdBgCIAEoDjIjLm11c2ljY2x1Yi5ldmVudC5Bbm5vdW5jZW1lbnRGb3JtYXQSEgoKaGlnaGxpZ2h0cxgDIAEoDSJWChFFdmVudEFubm91bmNlbWVudBIMCgR0ZXh0GAEgASgJEjMKBmZvcm1hdBgCIAEoDjIjLm11c2ljY2x1Yi5ldmVudC5Bbm5vdW5jZW1lbnRGb3JtYXQiYgoWRXhwb3J0VHJhY2tsaXN0UmVxdWVzdBIQCghldmVudF9pZBgBIAEoCRI2CgZmb3JtYXQYAiABKA4yJi5tdXNpY2NsdWIuZXZlbnQuVHJhY2tsaXN0RXhwb3J0Rm9ybWF0IkwKEUV4cG9ydGVkVHJhY2tsaXN0EhAKCGZpbGVuYW1lGAEgASgJEhQKDGNvbnRlbnRfdHlwZRgCIAEoCRIPCgdjb250ZW50GAMgASgMInYKFkluc2VydFRyYWNrSXRlbVJlcXVlc3QSEAoIZXZlbnRfaWQYASABKAkSKAoEaXRlbRgCIAEoCzIaLm11c2ljY2x1Yi5ldmVudC5UcmFja0l0ZW0SEAoIcG9zaXRpb24YAyABKA0SDgoGc2V0X2lkGAQgASgJIlsKFE1vdmVUcmFja0l0ZW1SZXF1ZXN0EhAKCGV2ZW50X2lkGAEgASgJEg8KB2l0ZW1faWQYAiABKAkSEAoIcG9zaXRpb24YAyABKA0SDgoGc2V0X2lkGAQgASgJIjEKDFRyYWNrSXRlbVJlZhIQCghldmVudF9pZBgBIAEoCRIPCgdpdGVtX2lkGAIgASgJIoUBChZVcGRhdGVUcmFja0l0ZW1SZXF1ZXN0EhAKCGV2ZW50X2lkGAEgASgJEigKBGl0ZW0YAiABKAsyGi5tdXNpY2NsdWIuZXZlbnQuVHJhY2tJdGVtEi8KC3VwZGF0ZV9tYXNrGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFzayIbCgxDYWxlbmRhckZlZWQSCwoDdXJsGAEgASgJIsMCCg1FdmVudFRlbXBsYXRlEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSFQoNdGl0bGVfcGF0dGVybhgDIAEoCRIQCghsb2NhdGlvbhgEIAEoCRIZChFub3RpZnlfZGF5X2JlZm9yZRgFIAEoCBIaChJub3RpZnlfaG91cl9iZWZvcmUYBiABKAgSGAoQbWF4X3BhcnRpY2lwYW50cxgHIAEoBRIWCg5yZXF1aXJlZF9yb2xlcxgIIAMoCRIMCgR1c2VzGAkgASgFEhAKCHZlbnVlX2lkGAogASgJEhkKEXRpbWVfc2xvdF9taW51dGVzGAsgASgFEh4KFm5vdGlmeV9vZmZzZXRzX21pbnV0ZXMYDCADKAUSEAoIdGltZXpvbmUYDSABKAkSGQoRdHJhY2tfZ2FwX3NlY29uZHMYDiABKAUiJwoPRXZlbnRUZW1wbGF0ZUlkEhQKAmlkGAEgASgJQgi6SAVyA7ABASJlChhTYXZlRXZlbnRUZW1wbGF0ZVJlcXVlc3QSGgoIZXZlbnRfaWQYASABKAlCCLpIBXIDsAEBEhYKBG5hbWUYAiABKAlCCLpIBXIDwD4BEhUKDXRpdGxlX3BhdHRlcm4YAyABKAkiTwoaTGlzdEV2ZW50VGVtcGxhdGVzUmVzcG9uc2USMQoJdGVtcGxhdGVzGAEgAygLMh4ubXVzaWNjbHViLmV2ZW50LkV2ZW50VGVtcGxhdGUicgoeQ3JlYXRlRXZlbnRGcm9tVGVtcGxhdGVSZXF1ZXN0EhMKC3RlbXBsYXRlX2lkGAEgASgJEiwKCHN0YXJ0X2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBINCgV0aXRsZRgDIAEoCSLSAgoJUmVoZWFyc2FsEgoKAmlkGAEgASgJEhAKCGV2ZW50X2lkGAIgASgJEiwKCHN0YXJ0X2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIqCgZlbmRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhAKCGxvY2F0aW9uGAUgASgJEg0KBW5vdGVzGAYgASgJEhYKDnRyYWNrX2l0ZW1faWRzGAcgAygJEhkKEW5vdGlmeV9kYXlfYmVmb3JlGAggASgIEhoKEm5vdGlmeV9ob3VyX2JlZm9yZRgJIAEoCBIpCgphdHRlbmRhbmNlGAogAygLMhUubXVzaWNjbHViLmV2ZW50LlJzdnASMgoNbXlfYXR0ZW5kYW5jZRgLIAEoDjIbLm11c2ljY2x1Yi5ldmVudC5Sc3ZwU3RhdHVzIiMKC1JlaGVhcnNhbElkEhQKAmlkGAEgASgJQgi6SAVyA7ABASLsAQoOUmVoZWFyc2FsSW5wdXQSEAoIZXZlbnRfaWQYASABKAkSLAoIc3RhcnRfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEioKBmVuZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEAoIbG9jYXRpb24YBCABKAkSDQoFbm90ZXMYBSABKAkSFgoOdHJhY2tfaXRlbV9pZHMYBiADKAkSGQoRbm90aWZ5X2RheV9iZWZvcmUYByABKAgSGgoSbm90aWZ5X2hvdXJfYmVmb3JlGAggASgIIlgKFlVwZGF0ZVJlaGVhcnNhbFJlcXVlc3QSCgoCaWQYASABKAkSMgoJcmVoZWFyc2FsGAIgASgLMh8ubXVzaWNjbHViLmV2ZW50LlJlaGVhcnNhbElucHV0ImIKHVNldFJlaGVhcnNhbEF0dGVuZGFuY2VSZXF1ZXN0EhQKDHJlaGVhcnNhbF9pZBgBIAEoCRIrCgZzdGF0dXMYAiABKA4yGy5tdXNpY2NsdWIuZXZlbnQuUnN2cFN0YXR1cyI5ChVHZXRDaGVja0luQ29kZVJlcXVlc3QSEAoIZXZlbnRfaWQYASABKAkSDgoGcm90YXRlGAIgASgIIjgKC0NoZWNrSW5Db2RlEgwKBGNvZGUYASABKAkSCwoDdXJsGAIgASgJEg4KBnFyX3BuZxgDIAEoDCIeCg5DaGVja0luUmVxdWVzdBIMCgRjb2RlGAEgASgJIqMBCg5GZWVkYmFja1N1cnZleRItCglvcGVuZWRfYXQYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi0KCWNsb3Nlc19hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDAoEb3BlbhgDIAEoCBIRCglteV9yYXRpbmcYBCABKA0SEgoKbXlfY29tbWVudBgFIAEoCSJcChlPcGVuRmVlZGJhY2tTdXJ2ZXlSZXF1ZXN0EhAKCGV2ZW50X2lkGAEgASgJEi0KCWNsb3Nlc19hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiSgoVU3VibWl0RmVlZGJhY2tSZXF1ZXN0EhAKCGV2ZW50X2lkGAEgASgJEg4KBnJhdGluZxgCIAEoDRIPCgdjb21tZW50GAMgASgJIocBCg5GZWVkYmFja0Fuc3dlchIiCgR1c2VyGAEgASgLMhQubXVzaWNjbHViLnVzZXIuVXNlchIOCgZyYXRpbmcYAiABKA0SDwoHY29tbWVudBgDIAEoCRIwCgxzdWJtaXR0ZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIsgBCg9GZWVkYmFja1Jlc3VsdHMSEAoIZXZlbnRfaWQYASABKAkSLwoGc3VydmV5GAIgASgLMh8ubXVzaWNjbHViLmV2ZW50LkZlZWRiYWNrU3VydmV5EhEKCXJlc3BvbnNlcxgDIAEoDRIWCg5hdmVyYWdlX3JhdGluZxgEIAEoARIVCg1yYXRpbmdfY291bnRzGAUgAygNEjAKB2Fuc3dlcnMYBiADKAsyHy5tdXNpY2NsdWIuZXZlbnQuRmVlZGJhY2tBbnN3ZXIiuAEKDUVxdWlwbWVudEl0ZW0SCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRIQCghxdWFudGl0eRgDIAEoDRINCgVub3RlcxgEIAEoCRIlCgdicmluZ2VyGAUgASgLMhQubXVzaWNjbHViLnVzZXIuVXNlchIuCgpjaGVja2VkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIVCg1jcmVhdGVkX2J5X2lkGAcgASgJIicKD0VxdWlwbWVudEl0ZW1JZBIUCgJpZBgBIAEoCUIIukgFcgOwAQEibgoXQWRkRXF1aXBtZW50SXRlbVJlcXVlc3QSGgoIZXZlbnRfaWQYASABKAlCCLpIBXIDsAEBEhYKBG5hbWUYAiABKAlCCLpIBXIDwD4BEhAKCHF1YW50aXR5GAMgASgNEg0KBW5vdGVzGAQgASgJImsKGlVwZGF0ZUVxdWlwbWVudEl0ZW1SZXF1ZXN0EhQKAmlkGAEgASgJQgi6SAVyA7ABARIWCgRuYW1lGAIgASgJQgi6SAVyA8A+ARIQCghxdWFudGl0eRgDIAEoDRINCgVub3RlcxgEIAEoCSI+ChpTZXRFcXVpcG1lbnRCcmluZ2VyUmVxdWVzdBIPCgdpdGVtX2lkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkiPgoaU2V0RXF1aXBtZW50Q2hlY2tlZFJlcXVlc3QSDwoHaXRlbV9pZBgBIAEoCRIPCgdjaGVja2VkGAIgASgIIoECCgRSaWRlEgoKAmlkGAEgASgJEicKBGtpbmQYAiABKA4yGS5tdXNpY2NsdWIuZXZlbnQuUmlkZUtpbmQSIgoEdXNlchgDIAEoCzIULm11c2ljY2x1Yi51c2VyLlVzZXISDQoFc2VhdHMYBCABKA0SFQoNZnJvbV9sb2NhdGlvbhgFIAEoCRItCglkZXBhcnRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg0KBW5vdGVzGAcgASgJEigKCnBhc3NlbmdlcnMYCCADKAsyFC5tdXNpY2NsdWIudXNlci5Vc2VyEhIKCnNlYXRzX2xlZnQYCSABKA0iHgoGUmlkZUlkEhQKAmlkGAEgASgJQgi6SAVyA7ABASKwAQoPUG9zdFJpZGVSZXF1ZXN0EhAKCGV2ZW50X2lkGAEgASgJEicKBGtpbmQYAiABKA4yGS5tdXNpY2NsdWIuZXZlbnQuUmlkZUtpbmQSDQoFc2VhdHMYAyABKA0SFQoNZnJvbV9sb2NhdGlvbhgEIAEoCRItCglkZXBhcnRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg0KBW5vdGVzGAYgASgJIjoKF1NldFJpZGVQYXNzZW5nZXJSZXF1ZXN0Eg8KB3JpZGVfaWQYASABKAkSDgoGcmlkaW5nGAIgASgIItkBCgdFeHBlbnNlEgoKAmlkGAEgASgJEiMKBXBheWVyGAIgASgLMhQubXVzaWNjbHViLnVzZXIuVXNlchITCgtkZXNjcmlwdGlvbhgDIAEoCRIUCgxhbW91bnRfY2VudHMYBCABKAMSKwoNc3BsaXRfYmV0d2VlbhgFIAMoCzIULm11c2ljY2x1Yi51c2VyLlVzZXISFQoNY3JlYXRlZF9ieV9pZBgGIAEoCRIuCgpjcmVhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIhCglFeHBlbnNlSWQSFAoCaWQYASABKAlCCLpIBXIDsAEBIqoBChFBZGRFeHBlbnNlUmVxdWVzdBIaCghldmVudF9pZBgBIAEoCUIIukgFcgOwAQESHQoLZGVzY3JpcHRpb24YAiABKAlCCLpIBXIDwD4BEhQKDGFtb3VudF9jZW50cxgDIAEoAxIdCghwYXllcl9pZBgEIAEoCUILukgIcgOwAQHYAQESJQoOc3BsaXRfdXNlcl9pZHMYBSADKAlCDbpICpIBByIFcgOwAQEidAoORXhwZW5zZUJhbGFuY2USIgoEdXNlchgBIAEoCzIULm11c2ljY2x1Yi51c2VyLlVzZXISEgoKcGFpZF9jZW50cxgCIAEoAxITCgtzaGFyZV9jZW50cxgDIAEoAxIVCg1iYWxhbmNlX2NlbnRzGAQgASgDImgKClNldHRsZW1lbnQSIgoEZnJvbRgBIAEoCzIULm11c2ljY2x1Yi51c2VyLlVzZXISIAoCdG8YAiABKAsyFC5tdXNpY2NsdWIudXNlci5Vc2VyEhQKDGFtb3VudF9jZW50cxgDIAEoAyLIAQoORXhwZW5zZVN1bW1hcnkSEAoIZXZlbnRfaWQYASABKAkSKgoIZXhwZW5zZXMYAiADKAsyGC5tdXNpY2NsdWIuZXZlbnQuRXhwZW5zZRITCgt0b3RhbF9jZW50cxgDIAEoAxIxCghiYWxhbmNlcxgEIAMoCzIfLm11c2ljY2x1Yi5ldmVudC5FeHBlbnNlQmFsYW5jZRIwCgtzZXR0bGVtZW50cxgFIAMoCzIbLm11c2ljY2x1Yi5ldmVudC5TZXR0bGVtZW50Il8KG0NyZWF0ZUV2ZW50U2hhcmVMaW5rUmVxdWVzdBIQCghldmVudF9pZBgBIAEoCRIuCgpleHBpcmVzX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJNCg5FdmVudFNoYXJlTGluaxILCgN1cmwYASABKAkSLgoKZXhwaXJlc19hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAqaAoPRXZlbnRUaW1lRmlsdGVyEhkKFUVWRU5UX1RJTUVfRklMVEVSX0FMTBAAEh4KGkVWRU5UX1RJTUVfRklMVEVSX1VQQ09NSU5HEAESGgoWRVZFTlRfVElNRV9GSUxURVJfUEFTVBACKo0BCgpSc3ZwU3RhdHVzEhsKF1JTVlBfU1RBVFVTX1VOU1BFQ0lGSUVEEAASFQoRUlNWUF9TVEFUVVNfR09JTkcQARIVChFSU1ZQX1NUQVRVU19NQVlCRRACEhgKFFJTVlBfU1RBVFVTX0RFQ0xJTkVEEAMSGgoWUlNWUF9TVEFUVVNfV0FJVExJU1RFRBAEKrYBChRUcmFja2xpc3RXYXJuaW5nS2luZBImCiJUUkFDS0xJU1RfV0FSTklOR19LSU5EX1VOU1BFQ0lGSUVEEAASLAooVFJBQ0tMSVNUX1dBUk5JTkdfS0lORF9JTlNUUlVNRU5UX0NIQU5HRRABEiIKHlRSQUNLTElTVF9XQVJOSU5HX0tJTkRfT1ZFUkxBUBACEiQKIFRSQUNLTElTVF9XQVJOSU5HX0tJTkRfT0ZGX1RIRU1FEAMqsAEKFFRyYWNrUmVoZWFyc2FsU3RhdHVzEiYKIlRSQUNLX1JFSEVBUlNBTF9TVEFUVVNfVU5TUEVDSUZJRUQQABImCiJUUkFDS19SRUhFQVJTQUxfU1RBVFVTX05PVF9TVEFSVEVEEAESJgoiVFJBQ0tfUkVIRUFSU0FMX1NUQVRVU19JTl9QUk9HUkVTUxACEiAKHFRSQUNLX1JFSEVBUlNBTF9TVEFUVVNfUkVBRFkQAypYCg9SZWN1cnJlbmNlU2NvcGUSJAogUkVDVVJSRU5DRV9TQ09QRV9USElTX09DQ1VSUkVOQ0UQABIfChtSRUNVUlJFTkNFX1NDT1BFX0FMTF9GVVRVUkUQASpUChJBbm5vdW5jZW1lbnRGb3JtYXQSHAoYQU5OT1VOQ0VNRU5UX0ZPUk1BVF9IVE1MEAASIAocQU5OT1VOQ0VNRU5UX0ZPUk1BVF9NQVJLRE9XThABKloKFVRyYWNrbGlzdEV4cG9ydEZvcm1hdBIgChxUUkFDS0xJU1RfRVhQT1JUX0ZPUk1BVF9URVhUEAASHwobVFJBQ0tMSVNUX0VYUE9SVF9GT1JNQVRfUERGEAEqUQoIUmlkZUtpbmQSGQoVUklERV9LSU5EX1VOU1BFQ0lGSUVEEAASEwoPUklERV9LSU5EX09GRkVSEAESFQoRUklERV9LSU5EX1JFUVVFU1QQAjLFIgoMRXZlbnRTZXJ2aWNlElUKCkxpc3RFdmVudHMSIi5tdXNpY2NsdWIuZXZlbnQuTGlzdEV2ZW50c1JlcXVlc3QaIy5tdXNpY2NsdWIuZXZlbnQuTGlzdEV2ZW50c1Jlc3BvbnNlEkMKCEdldEV2ZW50EhgubXVzaWNjbHViLmV2ZW50LkV2ZW50SWQaHS5tdXNpY2NsdWIuZXZlbnQuRXZlbnREZXRhaWxzElEKC0NyZWF0ZUV2ZW50EiMubXVzaWNjbHViLmV2ZW50LkNyZWF0ZUV2ZW50UmVxdWVzdBodLm11c2ljY2x1Yi5ldmVudC5FdmVudERldGFpbHMSVwoORHVwbGljYXRlRXZlbnQSJi5tdXNpY2NsdWIuZXZlbnQuRHVwbGljYXRlRXZlbnRSZXF1ZXN0Gh0ubXVzaWNjbHViLmV2ZW50LkV2ZW50RGV0YWlscxJRCgtVcGRhdGVFdmVudBIjLm11c2ljY2x1Yi5ldmVudC5VcGRhdGVFdmVudFJlcXVlc3QaHS5tdXNpY2NsdWIuZXZlbnQuRXZlbnREZXRhaWxzEj8KC0RlbGV0ZUV2ZW50EhgubXVzaWNjbHViLmV2ZW50LkV2ZW50SWQaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSRwoMUmVzdG9yZUV2ZW50EhgubXVzaWNjbHViLmV2ZW50LkV2ZW50SWQaHS5tdXNpY2NsdWIuZXZlbnQuRXZlbnREZXRhaWxzEkgKDUNvbXBsZXRlRXZlbnQSGC5tdXNpY2NsdWIuZXZlbnQuRXZlbnRJZBodLm11c2ljY2x1Yi5ldmVudC5FdmVudERldGFpbHMSUQoLQ2FuY2VsRXZlbnQSIy5tdXNpY2NsdWIuZXZlbnQuQ2FuY2VsRXZlbnRSZXF1ZXN0Gh0ubXVzaWNjbHViLmV2ZW50LkV2ZW50RGV0YWlscxJSCg1BZGRFdmVudE93bmVyEiIubXVzaWNjbHViLmV2ZW50LkV2ZW50T3duZXJSZXF1ZXN0Gh0ubXVzaWNjbHViLmV2ZW50LkV2ZW50RGV0YWlscxJVChBSZW1vdmVFdmVudE93bmVyEiIubXVzaWNjbHViLmV2ZW50LkV2ZW50T3duZXJSZXF1ZXN0Gh0ubXVzaWNjbHViLmV2ZW50LkV2ZW50RGV0YWlscxJTCgxTZXRUcmFja2xpc3QSJC5tdXNpY2NsdWIuZXZlbnQuU2V0VHJhY2tsaXN0UmVxdWVzdBodLm11c2ljY2x1Yi5ldmVudC5FdmVudERldGFpbHMSRAoQUHVibGlzaFRyYWNrbGlzdBIYLm11c2ljY2x1Yi5ldmVudC5FdmVudElkGhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5ElUKDUNvcHlUcmFja2xpc3QSJS5tdXNpY2NsdWIuZXZlbnQuQ29weVRyYWNrbGlzdFJlcXVlc3QaHS5tdXNpY2NsdWIuZXZlbnQuRXZlbnREZXRhaWxzElgKEFN1Z2dlc3RUcmFja2xpc3QSKC5tdXNpY2NsdWIuZXZlbnQuU3VnZ2VzdFRyYWNrbGlzdFJlcXVlc3QaGi5tdXNpY2NsdWIuZXZlbnQuVHJhY2tsaXN0Em4KF1JlbmRlckV2ZW50QW5ub3VuY2VtZW50Ei8ubXVzaWNjbHViLmV2ZW50LlJlbmRlckV2ZW50QW5ub3VuY2VtZW50UmVxdWVzdBoiLm11c2ljY2x1Yi5ldmVudC5FdmVudEFubm91bmNlbWVudBJeCg9FeHBvcnRUcmFja2xpc3QSJy5tdXNpY2NsdWIuZXZlbnQuRXhwb3J0VHJhY2tsaXN0UmVxdWVzdBoiLm11c2ljY2x1Yi5ldmVudC5FeHBvcnRlZFRyYWNrbGlzdBJZCg9JbnNlcnRUcmFja0l0ZW0SJy5tdXNpY2NsdWIuZXZlbnQuSW5zZXJ0VHJhY2tJdGVtUmVxdWVzdBodLm11c2ljY2x1Yi5ldmVudC5FdmVudERldGFpbHMSVQoNTW92ZVRyYWNrSXRlbRIlLm11c2ljY2x1Yi5ldmVudC5Nb3ZlVHJhY2tJdGVtUmVxdWVzdBodLm11c2ljY2x1Yi5ldmVudC5FdmVudERldGFpbHMSTwoPUmVtb3ZlVHJhY2tJdGVtEh0ubXVzaWNjbHViLmV2ZW50LlRyYWNrSXRlbVJlZhodLm11c2ljY2x1Yi5ldmVudC5FdmVudERldGFpbHMSWQoPVXBkYXRlVHJhY2tJdGVtEicubXVzaWNjbHViLmV2ZW50LlVwZGF0ZVRyYWNrSXRlbVJlcXVlc3QaHS5tdXNpY2NsdWIuZXZlbnQuRXZlbnREZXRhaWxzEmkKF1NldFRyYWNrUmVoZWFyc2FsU3RhdHVzEi8ubXVzaWNjbHViLmV2ZW50LlNldFRyYWNrUmVoZWFyc2FsU3RhdHVzUmVxdWVzdBodLm11c2ljY2x1Yi5ldmVudC5FdmVudERldGFpbHMSWQoPU2V0Q3VycmVudFRyYWNrEicubXVzaWNjbHViLmV2ZW50LlNldEN1cnJlbnRUcmFja1JlcXVlc3QaHS5tdXNpY2NsdWIuZXZlbnQuRXZlbnREZXRhaWxzEkcKCldhdGNoRXZlbnQSGC5tdXNpY2NsdWIuZXZlbnQuRXZlbnRJZBodLm11c2ljY2x1Yi5ldmVudC5FdmVudERldGFpbHMwARJJCgdTZXRSc3ZwEh8ubXVzaWNjbHViLmV2ZW50LlNldFJzdnBSZXF1ZXN0Gh0ubXVzaWNjbHViLmV2ZW50LkV2ZW50RGV0YWlscxJICg9HZXRDYWxlbmRhckZlZWQSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaHS5tdXNpY2NsdWIuZXZlbnQuQ2FsZW5kYXJGZWVkEmUKFENyZWF0ZUV2ZW50U2hhcmVMaW5rEiwubXVzaWNjbHViLmV2ZW50LkNyZWF0ZUV2ZW50U2hhcmVMaW5rUmVxdWVzdBofLm11c2ljY2x1Yi5ldmVudC5FdmVudFNoYXJlTGluaxJeChFTYXZlRXZlbnRUZW1wbGF0ZRIpLm11c2ljY2x1Yi5ldmVudC5TYXZlRXZlbnRUZW1wbGF0ZVJlcXVlc3QaHi5tdXNpY2NsdWIuZXZlbnQuRXZlbnRUZW1wbGF0ZRJZChJMaXN0RXZlbnRUZW1wbGF0ZXMSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaKy5tdXNpY2NsdWIuZXZlbnQuTGlzdEV2ZW50VGVtcGxhdGVzUmVzcG9uc2USTwoTRGVsZXRlRXZlbnRUZW1wbGF0ZRIgLm11c2ljY2x1Yi5ldmVudC5FdmVudFRlbXBsYXRlSWQaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSaQoXQ3JlYXRlRXZlbnRGcm9tVGVtcGxhdGUSLy5tdXNpY2NsdWIuZXZlbnQuQ3JlYXRlRXZlbnRGcm9tVGVtcGxhdGVSZXF1ZXN0Gh0ubXVzaWNjbHViLmV2ZW50LkV2ZW50RGV0YWlscxJRCg9DcmVhdGVSZWhlYXJzYWwSHy5tdXNpY2NsdWIuZXZlbnQuUmVoZWFyc2FsSW5wdXQaHS5tdXNpY2NsdWIuZXZlbnQuRXZlbnREZXRhaWxzElkKD1VwZGF0ZVJlaGVhcnNhbBInLm11c2ljY2x1Yi5ldmVudC5VcGRhdGVSZWhlYXJzYWxSZXF1ZXN0Gh0ubXVzaWNjbHViLmV2ZW50LkV2ZW50RGV0YWlscxJHCg9EZWxldGVSZWhlYXJzYWwSHC5tdXNpY2NsdWIuZXZlbnQuUmVoZWFyc2FsSWQaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSZwoWU2V0UmVoZWFyc2FsQXR0ZW5kYW5jZRIuLm11c2ljY2x1Yi5ldmVudC5TZXRSZWhlYXJzYWxBdHRlbmRhbmNlUmVxdWVzdBodLm11c2ljY2x1Yi5ldmVudC5FdmVudERldGFpbHMSVgoOR2V0Q2hlY2tJbkNvZGUSJi5tdXNpY2NsdWIuZXZlbnQuR2V0Q2hlY2tJbkNvZGVSZXF1ZXN0GhwubXVzaWNjbHViLmV2ZW50LkNoZWNrSW5Db2RlEkkKB0NoZWNrSW4SHy5tdXNpY2NsdWIuZXZlbnQuQ2hlY2tJblJlcXVlc3QaHS5tdXNpY2NsdWIuZXZlbnQuRXZlbnREZXRhaWxzEl8KEk9wZW5GZWVkYmFja1N1cnZleRIqLm11c2ljY2x1Yi5ldmVudC5PcGVuRmVlZGJhY2tTdXJ2ZXlSZXF1ZXN0Gh0ubXVzaWNjbHViLmV2ZW50LkV2ZW50RGV0YWlscxJXCg5TdWJtaXRGZWVkYmFjaxImLm11c2ljY2x1Yi5ldmVudC5TdWJtaXRGZWVkYmFja1JlcXVlc3QaHS5tdXNpY2NsdWIuZXZlbnQuRXZlbnREZXRhaWxzElAKEkdldEZlZWRiYWNrUmVzdWx0cxIYLm11c2ljY2x1Yi5ldmVudC5FdmVudElkGiAubXVzaWNjbHViLmV2ZW50LkZlZWRiYWNrUmVzdWx0cxJbChBBZGRFcXVpcG1lbnRJdGVtEigubXVzaWNjbHViLmV2ZW50LkFkZEVxdWlwbWVudEl0ZW1SZXF1ZXN0Gh0ubXVzaWNjbHViLmV2ZW50LkV2ZW50RGV0YWlscxJhChNVcGRhdGVFcXVpcG1lbnRJdGVtEisubXVzaWNjbHViLmV2ZW50LlVwZGF0ZUVxdWlwbWVudEl0ZW1SZXF1ZXN0Gh0ubXVzaWNjbHViLmV2ZW50LkV2ZW50RGV0YWlscxJWChNSZW1vdmVFcXVpcG1lbnRJdGVtEiAubXVzaWNjbHViLmV2ZW50LkVxdWlwbWVudEl0ZW1JZBodLm11c2ljY2x1Yi5ldmVudC5FdmVudERldGFpbHMSYQoTU2V0RXF1aXBtZW50QnJpbmdlchIrLm11c2ljY2x1Yi5ldmVudC5TZXRFcXVpcG1lbnRCcmluZ2VyUmVxdWVzdBodLm11c2ljY2x1Yi5ldmVudC5FdmVudERldGFpbHMSYQoTU2V0RXF1aXBtZW50Q2hlY2tlZBIrLm11c2ljY2x1Yi5ldmVudC5TZXRFcXVpcG1lbnRDaGVja2VkUmVxdWVzdBodLm11c2ljY2x1Yi5ldmVudC5FdmVudERldGFpbHMSSwoIUG9zdFJpZGUSIC5tdXNpY2NsdWIuZXZlbnQuUG9zdFJpZGVSZXF1ZXN0Gh0ubXVzaWNjbHViLmV2ZW50LkV2ZW50RGV0YWlscxJECgpDYW5jZWxSaWRlEhcubXVzaWNjbHViLmV2ZW50LlJpZGVJZBodLm11c2ljY2x1Yi5ldmVudC5FdmVudERldGFpbHMSWwoQU2V0UmlkZVBhc3NlbmdlchIoLm11c2ljY2x1Yi5ldmVudC5TZXRSaWRlUGFzc2VuZ2VyUmVxdWVzdBodLm11c2ljY2x1Yi5ldmVudC5FdmVudERldGFpbHMSUQoKQWRkRXhwZW5zZRIiLm11c2ljY2x1Yi5ldmVudC5BZGRFeHBlbnNlUmVxdWVzdBofLm11c2ljY2x1Yi5ldmVudC5FeHBlbnNlU3VtbWFyeRJMCg1SZW1vdmVFeHBlbnNlEhoubXVzaWNjbHViLmV2ZW50LkV4cGVuc2VJZBofLm11c2ljY2x1Yi5ldmVudC5FeHBlbnNlU3VtbWFyeRJOChFHZXRFeHBlbnNlU3VtbWFyeRIYLm11c2ljY2x1Yi5ldmVudC5FdmVudElkGh8ubXVzaWNjbHViLmV2ZW50LkV4cGVuc2VTdW1tYXJ5QhxaGm11c2ljY2x1YmJvdC9iYWNrZW5kL3Byb3RvYgZwcm90bzM", [file_buf_validate_validate, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp, file_song, file_user, file_permissions, file_venue, file_validation]);
 
 /**
  * @generated from message musicclub.event.EventId
@@ -36,6 +41,52 @@ export type EventId = Message<"musicclub.event.EventId"> & {
 export const EventIdSchema: GenMessage<EventId> = /*@__PURE__*/
   messageDesc(file_event, 0);
 
+/**
+ * @generated from message musicclub.event.EventOwnerRequest
+ */
+export type EventOwnerRequest = Message<"musicclub.event.EventOwnerRequest"> & {
+  /**
+   * @generated from field: string event_id = 1;
+   */
+  eventId: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message musicclub.event.EventOwnerRequest.
+ * Use `create(EventOwnerRequestSchema)` to create a new message.
+ */
+export const EventOwnerRequestSchema: GenMessage<EventOwnerRequest> = /*@__PURE__*/
+  messageDesc(file_event, 1);
+
+/**
+ * @generated from message musicclub.event.CancelEventRequest
+ */
+export type CancelEventRequest = Message<"musicclub.event.CancelEventRequest"> & {
+  /**
+   * @generated from field: string event_id = 1;
+   */
+  eventId: string;
+
+  /**
+   * Optional; shown on the event and in the message to participants.
+   *
+   * @generated from field: string reason = 2;
+   */
+  reason: string;
+};
+
+/**
+ * Describes the message musicclub.event.CancelEventRequest.
+ * Use `create(CancelEventRequestSchema)` to create a new message.
+ */
+export const CancelEventRequestSchema: GenMessage<CancelEventRequest> = /*@__PURE__*/
+  messageDesc(file_event, 2);
+
 /**
  * @generated from message musicclub.event.ListEventsRequest
  */
@@ -51,9 +102,52 @@ export type ListEventsRequest = Message<"musicclub.event.ListEventsRequest"> & {
   to?: Timestamp;
 
   /**
+   * Deprecated: use page_size.
+   *
    * @generated from field: uint32 limit = 3;
    */
   limit: number;
+
+  /**
+   * Upcoming events are sorted soonest first, past events latest first.
+   *
+   * @generated from field: musicclub.event.EventTimeFilter time_filter = 4;
+   */
+  timeFilter: EventTimeFilter;
+
+  /**
+   * Pagination cursor (opaque to client).
+   *
+   * @generated from field: string page_token = 5;
+   */
+  pageToken: string;
+
+  /**
+   * @generated from field: uint32 page_size = 6;
+   */
+  pageSize: number;
+
+  /**
+   * Only events at this venue.
+   *
+   * @generated from field: string venue_id = 7;
+   */
+  venueId: string;
+
+  /**
+   * Only events of this season.
+   *
+   * @generated from field: string season_id = 8;
+   */
+  seasonId: string;
+
+  /**
+   * List archived (deleted) events instead of live ones (requires
+   * edit_events).
+   *
+   * @generated from field: bool archived = 9;
+   */
+  archived: boolean;
 };
 
 /**
@@ -61,7 +155,7 @@ export type ListEventsRequest = Message<"musicclub.event.ListEventsRequest"> & {
  * Use `create(ListEventsRequestSchema)` to create a new message.
  */
 export const ListEventsRequestSchema: GenMessage<ListEventsRequest> = /*@__PURE__*/
-  messageDesc(file_event, 1);
+  messageDesc(file_event, 3);
 
 /**
  * @generated from message musicclub.event.ListEventsResponse
@@ -71,6 +165,11 @@ export type ListEventsResponse = Message<"musicclub.event.ListEventsResponse"> &
    * @generated from field: repeated musicclub.event.Event events = 1;
    */
   events: Event[];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
 };
 
 /**
@@ -78,7 +177,7 @@ export type ListEventsResponse = Message<"musicclub.event.ListEventsResponse"> &
  * Use `create(ListEventsResponseSchema)` to create a new message.
  */
 export const ListEventsResponseSchema: GenMessage<ListEventsResponse> = /*@__PURE__*/
-  messageDesc(file_event, 2);
+  messageDesc(file_event, 4);
 
 /**
  * @generated from message musicclub.event.Event
@@ -115,262 +214,2945 @@ export type Event = Message<"musicclub.event.Event"> & {
    * @generated from field: bool notify_hour_before = 6;
    */
   notifyHourBefore: boolean;
-};
-
-/**
- * Describes the message musicclub.event.Event.
- * Use `create(EventSchema)` to create a new message.
- */
-export const EventSchema: GenMessage<Event> = /*@__PURE__*/
-  messageDesc(file_event, 3);
 
-/**
- * @generated from message musicclub.event.EventDetails
- */
-export type EventDetails = Message<"musicclub.event.EventDetails"> & {
   /**
-   * @generated from field: musicclub.event.Event event = 1;
+   * Row version, incremented on every update.
+   *
+   * @generated from field: int64 version = 7;
    */
-  event?: Event;
+  version: bigint;
 
   /**
-   * @generated from field: musicclub.event.Tracklist tracklist = 2;
+   * Number of distinct users taking part in the event.
+   *
+   * @generated from field: int32 participant_count = 8;
    */
-  tracklist?: Tracklist;
+  participantCount: number;
 
   /**
-   * @generated from field: repeated musicclub.song.RoleAssignment participants = 3;
+   * Number of items in the tracklist.
+   *
+   * @generated from field: int32 track_count = 9;
    */
-  participants: RoleAssignment[];
+  trackCount: number;
 
   /**
-   * @generated from field: musicclub.permissions.PermissionSet permissions = 4;
+   * Series this event is an occurrence of, empty for one-off events.
+   *
+   * @generated from field: string series_id = 10;
    */
-  permissions?: PermissionSet;
-};
-
-/**
- * Describes the message musicclub.event.EventDetails.
- * Use `create(EventDetailsSchema)` to create a new message.
- */
-export const EventDetailsSchema: GenMessage<EventDetails> = /*@__PURE__*/
-  messageDesc(file_event, 4);
+  seriesId: string;
 
-/**
- * @generated from message musicclub.event.Tracklist
- */
-export type Tracklist = Message<"musicclub.event.Tracklist"> & {
   /**
-   * @generated from field: repeated musicclub.event.TrackItem items = 1;
+   * RRULE of the series, e.g. "FREQ=WEEKLY;BYDAY=TH".
+   *
+   * @generated from field: string recurrence = 11;
    */
-  items: TrackItem[];
-};
+  recurrence: string;
 
-/**
- * Describes the message musicclub.event.Tracklist.
- * Use `create(TracklistSchema)` to create a new message.
- */
-export const TracklistSchema: GenMessage<Tracklist> = /*@__PURE__*/
-  messageDesc(file_event, 5);
+  /**
+   * Maximum number of people going, 0 for unlimited.
+   *
+   * @generated from field: int32 max_participants = 12;
+   */
+  maxParticipants: number;
 
-/**
- * @generated from message musicclub.event.TrackItem
- */
-export type TrackItem = Message<"musicclub.event.TrackItem"> & {
   /**
-   * @generated from field: uint32 order = 1;
+   * Roles the event needs covered (checklist for organizers).
+   *
+   * @generated from field: repeated string required_roles = 13;
    */
-  order: number;
+  requiredRoles: string[];
 
   /**
-   * Reference to a song in the catalog.
+   * Number of people who checked in at the event.
    *
-   * @generated from field: string song_id = 2;
+   * @generated from field: int32 attendance_count = 14;
    */
-  songId: string;
+  attendanceCount: number;
 
   /**
-   * If song is not in catalog, allow a custom title/artist.
+   * Venue the event takes place at; location falls back to its name.
    *
-   * @generated from field: string custom_title = 3;
+   * @generated from field: string venue_id = 15;
    */
-  customTitle: string;
+  venueId: string;
 
   /**
-   * @generated from field: string custom_artist = 4;
+   * Length of the slot the band has to play in, 0 if not limited.
+   *
+   * @generated from field: int32 time_slot_minutes = 16;
    */
-  customArtist: string;
-};
+  timeSlotMinutes: number;
 
-/**
- * Describes the message musicclub.event.TrackItem.
- * Use `create(TrackItemSchema)` to create a new message.
- */
-export const TrackItemSchema: GenMessage<TrackItem> = /*@__PURE__*/
-  messageDesc(file_event, 6);
+  /**
+   * When the event was marked as played; unset for upcoming events.
+   *
+   * @generated from field: google.protobuf.Timestamp completed_at = 17;
+   */
+  completedAt?: Timestamp;
 
-/**
- * @generated from message musicclub.event.CreateEventRequest
- */
-export type CreateEventRequest = Message<"musicclub.event.CreateEventRequest"> & {
   /**
-   * @generated from field: string title = 1;
+   * Reminders sent this many minutes before the start, largest first (e.g.
+   * 10080, 1440, 120). notify_day_before/notify_hour_before mirror 1440/60.
+   *
+   * @generated from field: repeated int32 notify_offsets_minutes = 18;
    */
-  title: string;
+  notifyOffsetsMinutes: number[];
 
   /**
-   * @generated from field: google.protobuf.Timestamp start_at = 2;
+   * Set for cancelled events, which stay visible with a badge.
+   *
+   * @generated from field: google.protobuf.Timestamp cancelled_at = 19;
    */
-  startAt?: Timestamp;
+  cancelledAt?: Timestamp;
 
   /**
-   * @generated from field: string location = 3;
+   * @generated from field: string cancel_reason = 20;
    */
-  location: string;
+  cancelReason: string;
 
   /**
-   * @generated from field: bool notify_day_before = 4;
+   * IANA timezone the event is planned in, e.g. "Europe/Moscow".
+   *
+   * @generated from field: string timezone = 21;
    */
-  notifyDayBefore: boolean;
+  timezone: string;
 
   /**
-   * @generated from field: bool notify_hour_before = 5;
+   * start_at in that timezone as RFC 3339, e.g. "2026-03-15T19:00:00+03:00".
+   *
+   * @generated from field: string local_start_at = 22;
    */
-  notifyHourBefore: boolean;
+  localStartAt: string;
 
   /**
-   * @generated from field: musicclub.event.Tracklist tracklist = 6;
+   * @generated from field: string season_id = 23;
    */
-  tracklist?: Tracklist;
-};
+  seasonId: string;
 
-/**
- * Describes the message musicclub.event.CreateEventRequest.
- * Use `create(CreateEventRequestSchema)` to create a new message.
- */
-export const CreateEventRequestSchema: GenMessage<CreateEventRequest> = /*@__PURE__*/
-  messageDesc(file_event, 7);
+  /**
+   * Pause between tracklist items used for scheduled start times.
+   *
+   * @generated from field: int32 track_gap_seconds = 24;
+   */
+  trackGapSeconds: number;
 
-/**
- * @generated from message musicclub.event.UpdateEventRequest
- */
-export type UpdateEventRequest = Message<"musicclub.event.UpdateEventRequest"> & {
   /**
-   * @generated from field: string id = 1;
+   * Tracklist item being played right now, empty outside of the show.
+   *
+   * @generated from field: string current_track_item_id = 25;
    */
-  id: string;
+  currentTrackItemId: string;
 
   /**
-   * @generated from field: string title = 2;
+   * @generated from field: google.protobuf.Timestamp current_track_started_at = 26;
    */
-  title: string;
+  currentTrackStartedAt?: Timestamp;
 
   /**
-   * @generated from field: google.protobuf.Timestamp start_at = 3;
+   * Set for archived events; only editors still see them.
+   *
+   * @generated from field: google.protobuf.Timestamp deleted_at = 27;
    */
-  startAt?: Timestamp;
+  deletedAt?: Timestamp;
 
   /**
-   * @generated from field: string location = 4;
+   * Where the event takes place when it isn't at its venue (or has none).
+   *
+   * @generated from field: musicclub.venue.GeoPoint coordinates = 28;
    */
-  location: string;
+  coordinates?: GeoPoint;
 
   /**
-   * @generated from field: bool notify_day_before = 5;
+   * Optional theme of the night, e.g. "90s covers night".
+   *
+   * @generated from field: string theme = 29;
    */
-  notifyDayBefore: boolean;
+  theme: string;
 
   /**
-   * @generated from field: bool notify_hour_before = 6;
+   * Song tag the theme expects; tracklist songs without it get warnings.
+   *
+   * @generated from field: string theme_tag = 30;
    */
-  notifyHourBefore: boolean;
+  themeTag: string;
 };
 
 /**
- * Describes the message musicclub.event.UpdateEventRequest.
- * Use `create(UpdateEventRequestSchema)` to create a new message.
+ * Describes the message musicclub.event.Event.
+ * Use `create(EventSchema)` to create a new message.
  */
-export const UpdateEventRequestSchema: GenMessage<UpdateEventRequest> = /*@__PURE__*/
-  messageDesc(file_event, 8);
+export const EventSchema: GenMessage<Event> = /*@__PURE__*/
+  messageDesc(file_event, 5);
 
 /**
- * @generated from message musicclub.event.SetTracklistRequest
+ * @generated from message musicclub.event.EventDetails
  */
-export type SetTracklistRequest = Message<"musicclub.event.SetTracklistRequest"> & {
+export type EventDetails = Message<"musicclub.event.EventDetails"> & {
   /**
-   * @generated from field: string event_id = 1;
+   * @generated from field: musicclub.event.Event event = 1;
    */
-  eventId: string;
+  event?: Event;
 
   /**
    * @generated from field: musicclub.event.Tracklist tracklist = 2;
    */
   tracklist?: Tracklist;
-};
 
-/**
- * Describes the message musicclub.event.SetTracklistRequest.
- * Use `create(SetTracklistRequestSchema)` to create a new message.
- */
-export const SetTracklistRequestSchema: GenMessage<SetTracklistRequest> = /*@__PURE__*/
-  messageDesc(file_event, 9);
+  /**
+   * @generated from field: repeated musicclub.song.RoleAssignment participants = 3;
+   */
+  participants: RoleAssignment[];
 
-/**
- * Provides CRUD functionality for events and tracklists.
- *
- * @generated from service musicclub.event.EventService
- */
-export const EventService: GenService<{
   /**
-   * Returns unpaginated list of events.
-   *
-   * @generated from rpc musicclub.event.EventService.ListEvents
+   * @generated from field: musicclub.permissions.PermissionSet permissions = 4;
    */
-  listEvents: {
-    methodKind: "unary";
-    input: typeof ListEventsRequestSchema;
-    output: typeof ListEventsResponseSchema;
-  },
+  permissions?: PermissionSet;
+
   /**
-   * Returns a single event with full details and tracklist.
-   *
-   * @generated from rpc musicclub.event.EventService.GetEvent
+   * @generated from field: musicclub.event.RsvpSummary rsvp_summary = 5;
    */
-  getEvent: {
-    methodKind: "unary";
-    input: typeof EventIdSchema;
-    output: typeof EventDetailsSchema;
-  },
+  rsvpSummary?: RsvpSummary;
+
   /**
-   * Create events (requires permissions).
+   * Current user's RSVP, unspecified if not answered.
    *
-   * @generated from rpc musicclub.event.EventService.CreateEvent
+   * @generated from field: musicclub.event.RsvpStatus my_rsvp = 6;
    */
-  createEvent: {
-    methodKind: "unary";
-    input: typeof CreateEventRequestSchema;
-    output: typeof EventDetailsSchema;
-  },
+  myRsvp: RsvpStatus;
+
   /**
-   * Update events (requires permissions).
+   * Everyone who answered, most recent first.
    *
-   * @generated from rpc musicclub.event.EventService.UpdateEvent
+   * @generated from field: repeated musicclub.event.Rsvp rsvps = 7;
    */
-  updateEvent: {
-    methodKind: "unary";
-    input: typeof UpdateEventRequestSchema;
-    output: typeof EventDetailsSchema;
-  },
+  rsvps: Rsvp[];
+
   /**
-   * Delete events (requires permissions).
+   * Free places left, -1 when the event has no capacity limit.
    *
-   * @generated from rpc musicclub.event.EventService.DeleteEvent
+   * @generated from field: int32 spots_left = 8;
    */
-  deleteEvent: {
+  spotsLeft: number;
+
+  /**
+   * Link to an .ics file for adding the event to a calendar app. It works
+   * without a login and expires after 30 days.
+   *
+   * @generated from field: string ics_url = 9;
+   */
+  icsUrl: string;
+
+  /**
+   * Rehearsals preparing for this event, soonest first.
+   *
+   * @generated from field: repeated musicclub.event.Rehearsal rehearsals = 10;
+   */
+  rehearsals: Rehearsal[];
+
+  /**
+   * Who actually showed up (checked in), earliest first.
+   *
+   * @generated from field: repeated musicclub.event.Attendance attendance = 11;
+   */
+  attendance: Attendance[];
+
+  /**
+   * Whether the current user has checked in.
+   *
+   * @generated from field: bool checked_in = 12;
+   */
+  checkedIn: boolean;
+
+  /**
+   * @generated from field: musicclub.venue.Venue venue = 13;
+   */
+  venue?: Venue;
+
+  /**
+   * Who plays each catalog song of the tracklist: the frozen snapshot for
+   * completed events, the songs' current assignments otherwise.
+   *
+   * @generated from field: repeated musicclub.event.TrackLineup lineups = 14;
+   */
+  lineups: TrackLineup[];
+
+  /**
+   * Unset until a feedback survey is opened.
+   *
+   * @generated from field: musicclub.event.FeedbackSurvey feedback_survey = 15;
+   */
+  feedbackSurvey?: FeedbackSurvey;
+
+  /**
+   * Equipment checklist in the order items were added.
+   *
+   * @generated from field: repeated musicclub.event.EquipmentItem equipment = 16;
+   */
+  equipment: EquipmentItem[];
+
+  /**
+   * Ride offers and requests, oldest first.
+   *
+   * @generated from field: repeated musicclub.event.Ride rides = 17;
+   */
+  rides: Ride[];
+
+  /**
+   * Organizers of this event, earliest first.
+   *
+   * @generated from field: repeated musicclub.user.User owners = 18;
+   */
+  owners: User[];
+
+  /**
+   * Whether the current user may edit the event: club-wide edit_events or
+   * being an owner.
+   *
+   * @generated from field: bool can_edit = 19;
+   */
+  canEdit: boolean;
+
+  /**
+   * Opens route planning in a maps app: to the event's coordinates, else
+   * the venue's, else its address or location text. Empty when there is
+   * nothing to route to.
+   *
+   * @generated from field: string directions_url = 20;
+   */
+  directionsUrl: string;
+};
+
+/**
+ * Describes the message musicclub.event.EventDetails.
+ * Use `create(EventDetailsSchema)` to create a new message.
+ */
+export const EventDetailsSchema: GenMessage<EventDetails> = /*@__PURE__*/
+  messageDesc(file_event, 6);
+
+/**
+ * @generated from message musicclub.event.TrackLineup
+ */
+export type TrackLineup = Message<"musicclub.event.TrackLineup"> & {
+  /**
+   * @generated from field: string track_item_id = 1;
+   */
+  trackItemId: string;
+
+  /**
+   * @generated from field: repeated musicclub.song.RoleAssignment assignments = 2;
+   */
+  assignments: RoleAssignment[];
+};
+
+/**
+ * Describes the message musicclub.event.TrackLineup.
+ * Use `create(TrackLineupSchema)` to create a new message.
+ */
+export const TrackLineupSchema: GenMessage<TrackLineup> = /*@__PURE__*/
+  messageDesc(file_event, 7);
+
+/**
+ * @generated from message musicclub.event.Attendance
+ */
+export type Attendance = Message<"musicclub.event.Attendance"> & {
+  /**
+   * @generated from field: musicclub.user.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp checked_in_at = 2;
+   */
+  checkedInAt?: Timestamp;
+};
+
+/**
+ * Describes the message musicclub.event.Attendance.
+ * Use `create(AttendanceSchema)` to create a new message.
+ */
+export const AttendanceSchema: GenMessage<Attendance> = /*@__PURE__*/
+  messageDesc(file_event, 8);
+
+/**
+ * @generated from message musicclub.event.RsvpSummary
+ */
+export type RsvpSummary = Message<"musicclub.event.RsvpSummary"> & {
+  /**
+   * @generated from field: int32 going = 1;
+   */
+  going: number;
+
+  /**
+   * @generated from field: int32 maybe = 2;
+   */
+  maybe: number;
+
+  /**
+   * @generated from field: int32 declined = 3;
+   */
+  declined: number;
+
+  /**
+   * @generated from field: int32 waitlisted = 4;
+   */
+  waitlisted: number;
+};
+
+/**
+ * Describes the message musicclub.event.RsvpSummary.
+ * Use `create(RsvpSummarySchema)` to create a new message.
+ */
+export const RsvpSummarySchema: GenMessage<RsvpSummary> = /*@__PURE__*/
+  messageDesc(file_event, 9);
+
+/**
+ * @generated from message musicclub.event.Rsvp
+ */
+export type Rsvp = Message<"musicclub.event.Rsvp"> & {
+  /**
+   * @generated from field: musicclub.user.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: musicclub.event.RsvpStatus status = 2;
+   */
+  status: RsvpStatus;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 3;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message musicclub.event.Rsvp.
+ * Use `create(RsvpSchema)` to create a new message.
+ */
+export const RsvpSchema: GenMessage<Rsvp> = /*@__PURE__*/
+  messageDesc(file_event, 10);
+
+/**
+ * @generated from message musicclub.event.SetRsvpRequest
+ */
+export type SetRsvpRequest = Message<"musicclub.event.SetRsvpRequest"> & {
+  /**
+   * @generated from field: string event_id = 1;
+   */
+  eventId: string;
+
+  /**
+   * @generated from field: musicclub.event.RsvpStatus status = 2;
+   */
+  status: RsvpStatus;
+};
+
+/**
+ * Describes the message musicclub.event.SetRsvpRequest.
+ * Use `create(SetRsvpRequestSchema)` to create a new message.
+ */
+export const SetRsvpRequestSchema: GenMessage<SetRsvpRequest> = /*@__PURE__*/
+  messageDesc(file_event, 11);
+
+/**
+ * @generated from message musicclub.event.Tracklist
+ */
+export type Tracklist = Message<"musicclub.event.Tracklist"> & {
+  /**
+   * All items in play order. SetTracklist with only items keeps the sets of
+   * existing items; new items go outside of any set.
+   *
+   * @generated from field: repeated musicclub.event.TrackItem items = 1;
+   */
+  items: TrackItem[];
+
+  /**
+   * Running time of the whole list in seconds (items of unknown length count
+   * as zero).
+   *
+   * @generated from field: uint32 total_seconds = 2;
+   */
+  totalSeconds: number;
+
+  /**
+   * Named sets in play order; empty if the list is not split. Items outside
+   * of any set come first as a set with an empty id. When sent to
+   * SetTracklist, sets replace both the stored sets and items.
+   *
+   * @generated from field: repeated musicclub.event.TrackSet sets = 3;
+   */
+  sets: TrackSet[];
+
+  /**
+   * Read-only lineup problems found in the list; saving still succeeds.
+   *
+   * @generated from field: repeated musicclub.event.TracklistWarning warnings = 4;
+   */
+  warnings: TracklistWarning[];
+};
+
+/**
+ * Describes the message musicclub.event.Tracklist.
+ * Use `create(TracklistSchema)` to create a new message.
+ */
+export const TracklistSchema: GenMessage<Tracklist> = /*@__PURE__*/
+  messageDesc(file_event, 12);
+
+/**
+ * @generated from message musicclub.event.TracklistWarning
+ */
+export type TracklistWarning = Message<"musicclub.event.TracklistWarning"> & {
+  /**
+   * @generated from field: musicclub.event.TracklistWarningKind kind = 1;
+   */
+  kind: TracklistWarningKind;
+
+  /**
+   * @generated from field: musicclub.user.User user = 2;
+   */
+  user?: User;
+
+  /**
+   * The items involved, in play order: two, or one for off-theme songs.
+   *
+   * @generated from field: repeated string track_item_ids = 3;
+   */
+  trackItemIds: string[];
+
+  /**
+   * The member's roles in those items, in the same order.
+   *
+   * @generated from field: repeated string roles = 4;
+   */
+  roles: string[];
+
+  /**
+   * Ready-to-show text, e.g. "Иван: bass в №3, guitar в №4".
+   *
+   * @generated from field: string message = 5;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message musicclub.event.TracklistWarning.
+ * Use `create(TracklistWarningSchema)` to create a new message.
+ */
+export const TracklistWarningSchema: GenMessage<TracklistWarning> = /*@__PURE__*/
+  messageDesc(file_event, 13);
+
+/**
+ * @generated from message musicclub.event.TrackSet
+ */
+export type TrackSet = Message<"musicclub.event.TrackSet"> & {
+  /**
+   * Empty for new sets.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * E.g. "Сет 1" or "Бис".
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: repeated musicclub.event.TrackItem items = 3;
+   */
+  items: TrackItem[];
+
+  /**
+   * Read-only running time of the set in seconds.
+   *
+   * @generated from field: uint32 total_seconds = 4;
+   */
+  totalSeconds: number;
+};
+
+/**
+ * Describes the message musicclub.event.TrackSet.
+ * Use `create(TrackSetSchema)` to create a new message.
+ */
+export const TrackSetSchema: GenMessage<TrackSet> = /*@__PURE__*/
+  messageDesc(file_event, 14);
+
+/**
+ * @generated from message musicclub.event.TrackItem
+ */
+export type TrackItem = Message<"musicclub.event.TrackItem"> & {
+  /**
+   * @generated from field: uint32 order = 1;
+   */
+  order: number;
+
+  /**
+   * Reference to a song in the catalog.
+   *
+   * @generated from field: string song_id = 2;
+   */
+  songId: string;
+
+  /**
+   * If song is not in catalog, allow a custom title/artist.
+   *
+   * @generated from field: string custom_title = 3;
+   */
+  customTitle: string;
+
+  /**
+   * @generated from field: string custom_artist = 4;
+   */
+  customArtist: string;
+
+  /**
+   * Stable item id; send it back on SetTracklist to keep links (performers,
+   * rehearsals) attached to the item. Empty for new items.
+   *
+   * @generated from field: string id = 5;
+   */
+  id: string;
+
+  /**
+   * Length override in seconds; 0 uses the song's duration.
+   *
+   * @generated from field: uint32 duration_seconds = 6;
+   */
+  durationSeconds: number;
+
+  /**
+   * Read-only: the length actually used (override or song duration, 0 if
+   * unknown) and the running time at the end of this item.
+   *
+   * @generated from field: uint32 effective_duration_seconds = 7;
+   */
+  effectiveDurationSeconds: number;
+
+  /**
+   * @generated from field: uint32 ends_at_seconds = 8;
+   */
+  endsAtSeconds: number;
+
+  /**
+   * Read-only: the item ends after the event's time slot.
+   *
+   * @generated from field: bool exceeds_time_slot = 9;
+   */
+  exceedsTimeSlot: boolean;
+
+  /**
+   * Read-only: the set the item belongs to, empty if none.
+   *
+   * @generated from field: string set_id = 10;
+   */
+  setId: string;
+
+  /**
+   * Whether the item was actually played; marked by organizers after the show.
+   *
+   * @generated from field: bool performed = 11;
+   */
+  performed: boolean;
+
+  /**
+   * Read-only here; changed with SetTrackRehearsalStatus and reset when the
+   * item's song changes.
+   *
+   * @generated from field: musicclub.event.TrackRehearsalStatus rehearsal_status = 12;
+   */
+  rehearsalStatus: TrackRehearsalStatus;
+
+  /**
+   * Performance notes, e.g. "capo 2, shortened outro".
+   *
+   * @generated from field: string notes = 13;
+   */
+  notes: string;
+
+  /**
+   * Key or transposition for this performance, e.g. "D" or "+2"; empty uses
+   * the song's key.
+   *
+   * @generated from field: string key = 14;
+   */
+  key: string;
+
+  /**
+   * Fixed stage time for this item; later items continue from it.
+   *
+   * @generated from field: google.protobuf.Timestamp planned_start_at = 15;
+   */
+  plannedStartAt?: Timestamp;
+
+  /**
+   * Read-only: planned_start_at, or the event start plus the running time
+   * and gaps of earlier items. Unset when the event has no start time.
+   *
+   * @generated from field: google.protobuf.Timestamp scheduled_start_at = 16;
+   */
+  scheduledStartAt?: Timestamp;
+};
+
+/**
+ * Describes the message musicclub.event.TrackItem.
+ * Use `create(TrackItemSchema)` to create a new message.
+ */
+export const TrackItemSchema: GenMessage<TrackItem> = /*@__PURE__*/
+  messageDesc(file_event, 15);
+
+/**
+ * @generated from message musicclub.event.SetTrackRehearsalStatusRequest
+ */
+export type SetTrackRehearsalStatusRequest = Message<"musicclub.event.SetTrackRehearsalStatusRequest"> & {
+  /**
+   * @generated from field: string event_id = 1;
+   */
+  eventId: string;
+
+  /**
+   * @generated from field: string item_id = 2;
+   */
+  itemId: string;
+
+  /**
+   * @generated from field: musicclub.event.TrackRehearsalStatus status = 3;
+   */
+  status: TrackRehearsalStatus;
+};
+
+/**
+ * Describes the message musicclub.event.SetTrackRehearsalStatusRequest.
+ * Use `create(SetTrackRehearsalStatusRequestSchema)` to create a new message.
+ */
+export const SetTrackRehearsalStatusRequestSchema: GenMessage<SetTrackRehearsalStatusRequest> = /*@__PURE__*/
+  messageDesc(file_event, 16);
+
+/**
+ * @generated from message musicclub.event.SetCurrentTrackRequest
+ */
+export type SetCurrentTrackRequest = Message<"musicclub.event.SetCurrentTrackRequest"> & {
+  /**
+   * @generated from field: string event_id = 1;
+   */
+  eventId: string;
+
+  /**
+   * Item to mark as playing; empty clears the pointer unless next is set.
+   *
+   * @generated from field: string item_id = 2;
+   */
+  itemId: string;
+
+  /**
+   * Advance to the item after the current one (the first item if none is
+   * playing); past the last item the pointer is cleared.
+   *
+   * @generated from field: bool next = 3;
+   */
+  next: boolean;
+};
+
+/**
+ * Describes the message musicclub.event.SetCurrentTrackRequest.
+ * Use `create(SetCurrentTrackRequestSchema)` to create a new message.
+ */
+export const SetCurrentTrackRequestSchema: GenMessage<SetCurrentTrackRequest> = /*@__PURE__*/
+  messageDesc(file_event, 17);
+
+/**
+ * @generated from message musicclub.event.CreateEventRequest
+ */
+export type CreateEventRequest = Message<"musicclub.event.CreateEventRequest"> & {
+  /**
+   * @generated from field: string title = 1;
+   */
+  title: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_at = 2;
+   */
+  startAt?: Timestamp;
+
+  /**
+   * @generated from field: string location = 3;
+   */
+  location: string;
+
+  /**
+   * @generated from field: bool notify_day_before = 4;
+   */
+  notifyDayBefore: boolean;
+
+  /**
+   * @generated from field: bool notify_hour_before = 5;
+   */
+  notifyHourBefore: boolean;
+
+  /**
+   * @generated from field: musicclub.event.Tracklist tracklist = 6;
+   */
+  tracklist?: Tracklist;
+
+  /**
+   * Optional RRULE (daily/weekly/monthly/yearly). Requires start_at; the
+   * returned event is the first occurrence.
+   *
+   * @generated from field: string recurrence = 7;
+   */
+  recurrence: string;
+
+  /**
+   * @generated from field: int32 max_participants = 8;
+   */
+  maxParticipants: number;
+
+  /**
+   * @generated from field: repeated string required_roles = 9;
+   */
+  requiredRoles: string[];
+
+  /**
+   * @generated from field: string venue_id = 10;
+   */
+  venueId: string;
+
+  /**
+   * @generated from field: int32 time_slot_minutes = 11;
+   */
+  timeSlotMinutes: number;
+
+  /**
+   * Reminder offsets in minutes; when empty, the notify_* flags are used.
+   *
+   * @generated from field: repeated int32 notify_offsets_minutes = 12;
+   */
+  notifyOffsetsMinutes: number[];
+
+  /**
+   * IANA timezone; the club's default when empty.
+   *
+   * @generated from field: string timezone = 13;
+   */
+  timezone: string;
+
+  /**
+   * @generated from field: string season_id = 14;
+   */
+  seasonId: string;
+
+  /**
+   * @generated from field: int32 track_gap_seconds = 15;
+   */
+  trackGapSeconds: number;
+
+  /**
+   * Where the event takes place; read from map_url or geocoded from
+   * location when unset. Not needed for events at a venue.
+   *
+   * @generated from field: musicclub.venue.GeoPoint coordinates = 16;
+   */
+  coordinates?: GeoPoint;
+
+  /**
+   * @generated from field: string map_url = 17;
+   */
+  mapUrl: string;
+
+  /**
+   * Theme of the event, or of every occurrence of a series.
+   *
+   * @generated from field: string theme = 18;
+   */
+  theme: string;
+
+  /**
+   * @generated from field: string theme_tag = 19;
+   */
+  themeTag: string;
+};
+
+/**
+ * Describes the message musicclub.event.CreateEventRequest.
+ * Use `create(CreateEventRequestSchema)` to create a new message.
+ */
+export const CreateEventRequestSchema: GenMessage<CreateEventRequest> = /*@__PURE__*/
+  messageDesc(file_event, 18);
+
+/**
+ * @generated from message musicclub.event.DuplicateEventRequest
+ */
+export type DuplicateEventRequest = Message<"musicclub.event.DuplicateEventRequest"> & {
+  /**
+   * @generated from field: string event_id = 1;
+   */
+  eventId: string;
+
+  /**
+   * Unset leaves the copy without a date.
+   *
+   * @generated from field: google.protobuf.Timestamp start_at = 2;
+   */
+  startAt?: Timestamp;
+
+  /**
+   * Overrides the copied title.
+   *
+   * @generated from field: string title = 3;
+   */
+  title: string;
+
+  /**
+   * Also copy the tracklist with its sets, notes and keys.
+   *
+   * @generated from field: bool copy_tracklist = 4;
+   */
+  copyTracklist: boolean;
+};
+
+/**
+ * Describes the message musicclub.event.DuplicateEventRequest.
+ * Use `create(DuplicateEventRequestSchema)` to create a new message.
+ */
+export const DuplicateEventRequestSchema: GenMessage<DuplicateEventRequest> = /*@__PURE__*/
+  messageDesc(file_event, 19);
+
+/**
+ * @generated from message musicclub.event.UpdateEventRequest
+ */
+export type UpdateEventRequest = Message<"musicclub.event.UpdateEventRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_at = 3;
+   */
+  startAt?: Timestamp;
+
+  /**
+   * @generated from field: string location = 4;
+   */
+  location: string;
+
+  /**
+   * @generated from field: bool notify_day_before = 5;
+   */
+  notifyDayBefore: boolean;
+
+  /**
+   * @generated from field: bool notify_hour_before = 6;
+   */
+  notifyHourBefore: boolean;
+
+  /**
+   * Version the client last saw; mismatch fails with ABORTED.
+   *
+   * @generated from field: int64 expected_version = 7;
+   */
+  expectedVersion: bigint;
+
+  /**
+   * For occurrences of a series: also apply the change (and the shift of
+   * start time) to all later occurrences and the series itself.
+   *
+   * @generated from field: musicclub.event.RecurrenceScope scope = 8;
+   */
+  scope: RecurrenceScope;
+
+  /**
+   * @generated from field: int32 max_participants = 9;
+   */
+  maxParticipants: number;
+
+  /**
+   * @generated from field: repeated string required_roles = 10;
+   */
+  requiredRoles: string[];
+
+  /**
+   * @generated from field: string venue_id = 11;
+   */
+  venueId: string;
+
+  /**
+   * @generated from field: int32 time_slot_minutes = 12;
+   */
+  timeSlotMinutes: number;
+
+  /**
+   * Reminder offsets in minutes; when empty, the notify_* flags are used.
+   *
+   * @generated from field: repeated int32 notify_offsets_minutes = 13;
+   */
+  notifyOffsetsMinutes: number[];
+
+  /**
+   * IANA timezone; the club's default when empty.
+   *
+   * @generated from field: string timezone = 14;
+   */
+  timezone: string;
+
+  /**
+   * @generated from field: string season_id = 15;
+   */
+  seasonId: string;
+
+  /**
+   * @generated from field: int32 track_gap_seconds = 16;
+   */
+  trackGapSeconds: number;
+
+  /**
+   * As in CreateEventRequest.
+   *
+   * @generated from field: musicclub.venue.GeoPoint coordinates = 17;
+   */
+  coordinates?: GeoPoint;
+
+  /**
+   * @generated from field: string map_url = 18;
+   */
+  mapUrl: string;
+
+  /**
+   * @generated from field: string theme = 19;
+   */
+  theme: string;
+
+  /**
+   * @generated from field: string theme_tag = 20;
+   */
+  themeTag: string;
+
+  /**
+   * Fields to overwrite, named as above. Empty mask replaces title, start_at,
+   * location and the notify_* flags only. Coordinates are looked up again
+   * when location or venue_id changes or coordinates or map_url is set; the flags switch
+   * their reminder among the stored offsets unless notify_offsets_minutes
+   * is set as well.
+   *
+   * @generated from field: google.protobuf.FieldMask update_mask = 21;
+   */
+  updateMask?: FieldMask;
+};
+
+/**
+ * Describes the message musicclub.event.UpdateEventRequest.
+ * Use `create(UpdateEventRequestSchema)` to create a new message.
+ */
+export const UpdateEventRequestSchema: GenMessage<UpdateEventRequest> = /*@__PURE__*/
+  messageDesc(file_event, 20);
+
+/**
+ * @generated from message musicclub.event.SetTracklistRequest
+ */
+export type SetTracklistRequest = Message<"musicclub.event.SetTracklistRequest"> & {
+  /**
+   * @generated from field: string event_id = 1;
+   */
+  eventId: string;
+
+  /**
+   * @generated from field: musicclub.event.Tracklist tracklist = 2;
+   */
+  tracklist?: Tracklist;
+};
+
+/**
+ * Describes the message musicclub.event.SetTracklistRequest.
+ * Use `create(SetTracklistRequestSchema)` to create a new message.
+ */
+export const SetTracklistRequestSchema: GenMessage<SetTracklistRequest> = /*@__PURE__*/
+  messageDesc(file_event, 21);
+
+/**
+ * @generated from message musicclub.event.CopyTracklistRequest
+ */
+export type CopyTracklistRequest = Message<"musicclub.event.CopyTracklistRequest"> & {
+  /**
+   * @generated from field: string source_event_id = 1;
+   */
+  sourceEventId: string;
+
+  /**
+   * @generated from field: string target_event_id = 2;
+   */
+  targetEventId: string;
+
+  /**
+   * Copy only items marked as performed.
+   *
+   * @generated from field: bool only_performed = 3;
+   */
+  onlyPerformed: boolean;
+};
+
+/**
+ * Describes the message musicclub.event.CopyTracklistRequest.
+ * Use `create(CopyTracklistRequestSchema)` to create a new message.
+ */
+export const CopyTracklistRequestSchema: GenMessage<CopyTracklistRequest> = /*@__PURE__*/
+  messageDesc(file_event, 22);
+
+/**
+ * @generated from message musicclub.event.SuggestTracklistRequest
+ */
+export type SuggestTracklistRequest = Message<"musicclub.event.SuggestTracklistRequest"> & {
+  /**
+   * @generated from field: string event_id = 1;
+   */
+  eventId: string;
+
+  /**
+   * Maximum number of songs; 0 fills the event's time slot, taking at most
+   * 50 songs.
+   *
+   * @generated from field: uint32 limit = 2;
+   */
+  limit: number;
+
+  /**
+   * Rank songs favorited by more members higher.
+   *
+   * @generated from field: bool weight_by_votes = 3;
+   */
+  weightByVotes: boolean;
+
+  /**
+   * Rank songs that were not played for a long time (or never) higher.
+   *
+   * @generated from field: bool weight_by_last_performed = 4;
+   */
+  weightByLastPerformed: boolean;
+};
+
+/**
+ * Describes the message musicclub.event.SuggestTracklistRequest.
+ * Use `create(SuggestTracklistRequestSchema)` to create a new message.
+ */
+export const SuggestTracklistRequestSchema: GenMessage<SuggestTracklistRequest> = /*@__PURE__*/
+  messageDesc(file_event, 23);
+
+/**
+ * @generated from message musicclub.event.RenderEventAnnouncementRequest
+ */
+export type RenderEventAnnouncementRequest = Message<"musicclub.event.RenderEventAnnouncementRequest"> & {
+  /**
+   * @generated from field: string event_id = 1;
+   */
+  eventId: string;
+
+  /**
+   * @generated from field: musicclub.event.AnnouncementFormat format = 2;
+   */
+  format: AnnouncementFormat;
+
+  /**
+   * How many tracks to list; 0 means 5.
+   *
+   * @generated from field: uint32 highlights = 3;
+   */
+  highlights: number;
+};
+
+/**
+ * Describes the message musicclub.event.RenderEventAnnouncementRequest.
+ * Use `create(RenderEventAnnouncementRequestSchema)` to create a new message.
+ */
+export const RenderEventAnnouncementRequestSchema: GenMessage<RenderEventAnnouncementRequest> = /*@__PURE__*/
+  messageDesc(file_event, 24);
+
+/**
+ * @generated from message musicclub.event.EventAnnouncement
+ */
+export type EventAnnouncement = Message<"musicclub.event.EventAnnouncement"> & {
+  /**
+   * @generated from field: string text = 1;
+   */
+  text: string;
+
+  /**
+   * @generated from field: musicclub.event.AnnouncementFormat format = 2;
+   */
+  format: AnnouncementFormat;
+};
+
+/**
+ * Describes the message musicclub.event.EventAnnouncement.
+ * Use `create(EventAnnouncementSchema)` to create a new message.
+ */
+export const EventAnnouncementSchema: GenMessage<EventAnnouncement> = /*@__PURE__*/
+  messageDesc(file_event, 25);
+
+/**
+ * @generated from message musicclub.event.ExportTracklistRequest
+ */
+export type ExportTracklistRequest = Message<"musicclub.event.ExportTracklistRequest"> & {
+  /**
+   * @generated from field: string event_id = 1;
+   */
+  eventId: string;
+
+  /**
+   * @generated from field: musicclub.event.TracklistExportFormat format = 2;
+   */
+  format: TracklistExportFormat;
+};
+
+/**
+ * Describes the message musicclub.event.ExportTracklistRequest.
+ * Use `create(ExportTracklistRequestSchema)` to create a new message.
+ */
+export const ExportTracklistRequestSchema: GenMessage<ExportTracklistRequest> = /*@__PURE__*/
+  messageDesc(file_event, 26);
+
+/**
+ * @generated from message musicclub.event.ExportedTracklist
+ */
+export type ExportedTracklist = Message<"musicclub.event.ExportedTracklist"> & {
+  /**
+   * @generated from field: string filename = 1;
+   */
+  filename: string;
+
+  /**
+   * @generated from field: string content_type = 2;
+   */
+  contentType: string;
+
+  /**
+   * @generated from field: bytes content = 3;
+   */
+  content: Uint8Array;
+};
+
+/**
+ * Describes the message musicclub.event.ExportedTracklist.
+ * Use `create(ExportedTracklistSchema)` to create a new message.
+ */
+export const ExportedTracklistSchema: GenMessage<ExportedTracklist> = /*@__PURE__*/
+  messageDesc(file_event, 27);
+
+/**
+ * @generated from message musicclub.event.InsertTrackItemRequest
+ */
+export type InsertTrackItemRequest = Message<"musicclub.event.InsertTrackItemRequest"> & {
+  /**
+   * @generated from field: string event_id = 1;
+   */
+  eventId: string;
+
+  /**
+   * order and id are ignored.
+   *
+   * @generated from field: musicclub.event.TrackItem item = 2;
+   */
+  item?: TrackItem;
+
+  /**
+   * 1-based position within the set; 0 or past the end appends.
+   *
+   * @generated from field: uint32 position = 3;
+   */
+  position: number;
+
+  /**
+   * Set to insert into; empty puts the item outside of any set.
+   *
+   * @generated from field: string set_id = 4;
+   */
+  setId: string;
+};
+
+/**
+ * Describes the message musicclub.event.InsertTrackItemRequest.
+ * Use `create(InsertTrackItemRequestSchema)` to create a new message.
+ */
+export const InsertTrackItemRequestSchema: GenMessage<InsertTrackItemRequest> = /*@__PURE__*/
+  messageDesc(file_event, 28);
+
+/**
+ * @generated from message musicclub.event.MoveTrackItemRequest
+ */
+export type MoveTrackItemRequest = Message<"musicclub.event.MoveTrackItemRequest"> & {
+  /**
+   * @generated from field: string event_id = 1;
+   */
+  eventId: string;
+
+  /**
+   * @generated from field: string item_id = 2;
+   */
+  itemId: string;
+
+  /**
+   * 1-based target position within the set; past the end moves the item
+   * last.
+   *
+   * @generated from field: uint32 position = 3;
+   */
+  position: number;
+
+  /**
+   * Set to move the item to; empty keeps its current set.
+   *
+   * @generated from field: string set_id = 4;
+   */
+  setId: string;
+};
+
+/**
+ * Describes the message musicclub.event.MoveTrackItemRequest.
+ * Use `create(MoveTrackItemRequestSchema)` to create a new message.
+ */
+export const MoveTrackItemRequestSchema: GenMessage<MoveTrackItemRequest> = /*@__PURE__*/
+  messageDesc(file_event, 29);
+
+/**
+ * @generated from message musicclub.event.TrackItemRef
+ */
+export type TrackItemRef = Message<"musicclub.event.TrackItemRef"> & {
+  /**
+   * @generated from field: string event_id = 1;
+   */
+  eventId: string;
+
+  /**
+   * @generated from field: string item_id = 2;
+   */
+  itemId: string;
+};
+
+/**
+ * Describes the message musicclub.event.TrackItemRef.
+ * Use `create(TrackItemRefSchema)` to create a new message.
+ */
+export const TrackItemRefSchema: GenMessage<TrackItemRef> = /*@__PURE__*/
+  messageDesc(file_event, 30);
+
+/**
+ * @generated from message musicclub.event.UpdateTrackItemRequest
+ */
+export type UpdateTrackItemRequest = Message<"musicclub.event.UpdateTrackItemRequest"> & {
+  /**
+   * @generated from field: string event_id = 1;
+   */
+  eventId: string;
+
+  /**
+   * Identified by item.id; order is ignored.
+   *
+   * @generated from field: musicclub.event.TrackItem item = 2;
+   */
+  item?: TrackItem;
+
+  /**
+   * Item fields to overwrite (song_id, custom_title, custom_artist,
+   * duration_seconds, performed, notes, key, planned_start_at). Empty mask
+   * replaces all of them except performed.
+   *
+   * @generated from field: google.protobuf.FieldMask update_mask = 3;
+   */
+  updateMask?: FieldMask;
+};
+
+/**
+ * Describes the message musicclub.event.UpdateTrackItemRequest.
+ * Use `create(UpdateTrackItemRequestSchema)` to create a new message.
+ */
+export const UpdateTrackItemRequestSchema: GenMessage<UpdateTrackItemRequest> = /*@__PURE__*/
+  messageDesc(file_event, 31);
+
+/**
+ * @generated from message musicclub.event.CalendarFeed
+ */
+export type CalendarFeed = Message<"musicclub.event.CalendarFeed"> & {
+  /**
+   * Secret subscription URL; anyone with it can read the feed.
+   *
+   * @generated from field: string url = 1;
+   */
+  url: string;
+};
+
+/**
+ * Describes the message musicclub.event.CalendarFeed.
+ * Use `create(CalendarFeedSchema)` to create a new message.
+ */
+export const CalendarFeedSchema: GenMessage<CalendarFeed> = /*@__PURE__*/
+  messageDesc(file_event, 32);
+
+/**
+ * @generated from message musicclub.event.EventTemplate
+ */
+export type EventTemplate = Message<"musicclub.event.EventTemplate"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * Title of created events; "{date}" becomes the start date and "{n}" the
+   * number of events created from this template so far, plus one.
+   *
+   * @generated from field: string title_pattern = 3;
+   */
+  titlePattern: string;
+
+  /**
+   * @generated from field: string location = 4;
+   */
+  location: string;
+
+  /**
+   * @generated from field: bool notify_day_before = 5;
+   */
+  notifyDayBefore: boolean;
+
+  /**
+   * @generated from field: bool notify_hour_before = 6;
+   */
+  notifyHourBefore: boolean;
+
+  /**
+   * @generated from field: int32 max_participants = 7;
+   */
+  maxParticipants: number;
+
+  /**
+   * @generated from field: repeated string required_roles = 8;
+   */
+  requiredRoles: string[];
+
+  /**
+   * How many events were created from the template.
+   *
+   * @generated from field: int32 uses = 9;
+   */
+  uses: number;
+
+  /**
+   * @generated from field: string venue_id = 10;
+   */
+  venueId: string;
+
+  /**
+   * @generated from field: int32 time_slot_minutes = 11;
+   */
+  timeSlotMinutes: number;
+
+  /**
+   * Reminder offsets in minutes; when empty, the notify_* flags are used.
+   *
+   * @generated from field: repeated int32 notify_offsets_minutes = 12;
+   */
+  notifyOffsetsMinutes: number[];
+
+  /**
+   * @generated from field: string timezone = 13;
+   */
+  timezone: string;
+
+  /**
+   * @generated from field: int32 track_gap_seconds = 14;
+   */
+  trackGapSeconds: number;
+};
+
+/**
+ * Describes the message musicclub.event.EventTemplate.
+ * Use `create(EventTemplateSchema)` to create a new message.
+ */
+export const EventTemplateSchema: GenMessage<EventTemplate> = /*@__PURE__*/
+  messageDesc(file_event, 33);
+
+/**
+ * @generated from message musicclub.event.EventTemplateId
+ */
+export type EventTemplateId = Message<"musicclub.event.EventTemplateId"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message musicclub.event.EventTemplateId.
+ * Use `create(EventTemplateIdSchema)` to create a new message.
+ */
+export const EventTemplateIdSchema: GenMessage<EventTemplateId> = /*@__PURE__*/
+  messageDesc(file_event, 34);
+
+/**
+ * @generated from message musicclub.event.SaveEventTemplateRequest
+ */
+export type SaveEventTemplateRequest = Message<"musicclub.event.SaveEventTemplateRequest"> & {
+  /**
+   * @generated from field: string event_id = 1;
+   */
+  eventId: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * Defaults to the event's title.
+   *
+   * @generated from field: string title_pattern = 3;
+   */
+  titlePattern: string;
+};
+
+/**
+ * Describes the message musicclub.event.SaveEventTemplateRequest.
+ * Use `create(SaveEventTemplateRequestSchema)` to create a new message.
+ */
+export const SaveEventTemplateRequestSchema: GenMessage<SaveEventTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_event, 35);
+
+/**
+ * @generated from message musicclub.event.ListEventTemplatesResponse
+ */
+export type ListEventTemplatesResponse = Message<"musicclub.event.ListEventTemplatesResponse"> & {
+  /**
+   * @generated from field: repeated musicclub.event.EventTemplate templates = 1;
+   */
+  templates: EventTemplate[];
+};
+
+/**
+ * Describes the message musicclub.event.ListEventTemplatesResponse.
+ * Use `create(ListEventTemplatesResponseSchema)` to create a new message.
+ */
+export const ListEventTemplatesResponseSchema: GenMessage<ListEventTemplatesResponse> = /*@__PURE__*/
+  messageDesc(file_event, 36);
+
+/**
+ * @generated from message musicclub.event.CreateEventFromTemplateRequest
+ */
+export type CreateEventFromTemplateRequest = Message<"musicclub.event.CreateEventFromTemplateRequest"> & {
+  /**
+   * @generated from field: string template_id = 1;
+   */
+  templateId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_at = 2;
+   */
+  startAt?: Timestamp;
+
+  /**
+   * Overrides the title produced from the pattern.
+   *
+   * @generated from field: string title = 3;
+   */
+  title: string;
+};
+
+/**
+ * Describes the message musicclub.event.CreateEventFromTemplateRequest.
+ * Use `create(CreateEventFromTemplateRequestSchema)` to create a new message.
+ */
+export const CreateEventFromTemplateRequestSchema: GenMessage<CreateEventFromTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_event, 37);
+
+/**
+ * @generated from message musicclub.event.Rehearsal
+ */
+export type Rehearsal = Message<"musicclub.event.Rehearsal"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string event_id = 2;
+   */
+  eventId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_at = 3;
+   */
+  startAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_at = 4;
+   */
+  endAt?: Timestamp;
+
+  /**
+   * @generated from field: string location = 5;
+   */
+  location: string;
+
+  /**
+   * @generated from field: string notes = 6;
+   */
+  notes: string;
+
+  /**
+   * Tracklist items (TrackItem.id) to be rehearsed.
+   *
+   * @generated from field: repeated string track_item_ids = 7;
+   */
+  trackItemIds: string[];
+
+  /**
+   * @generated from field: bool notify_day_before = 8;
+   */
+  notifyDayBefore: boolean;
+
+  /**
+   * @generated from field: bool notify_hour_before = 9;
+   */
+  notifyHourBefore: boolean;
+
+  /**
+   * Answers of members; waitlist does not apply to rehearsals.
+   *
+   * @generated from field: repeated musicclub.event.Rsvp attendance = 10;
+   */
+  attendance: Rsvp[];
+
+  /**
+   * @generated from field: musicclub.event.RsvpStatus my_attendance = 11;
+   */
+  myAttendance: RsvpStatus;
+};
+
+/**
+ * Describes the message musicclub.event.Rehearsal.
+ * Use `create(RehearsalSchema)` to create a new message.
+ */
+export const RehearsalSchema: GenMessage<Rehearsal> = /*@__PURE__*/
+  messageDesc(file_event, 38);
+
+/**
+ * @generated from message musicclub.event.RehearsalId
+ */
+export type RehearsalId = Message<"musicclub.event.RehearsalId"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message musicclub.event.RehearsalId.
+ * Use `create(RehearsalIdSchema)` to create a new message.
+ */
+export const RehearsalIdSchema: GenMessage<RehearsalId> = /*@__PURE__*/
+  messageDesc(file_event, 39);
+
+/**
+ * @generated from message musicclub.event.RehearsalInput
+ */
+export type RehearsalInput = Message<"musicclub.event.RehearsalInput"> & {
+  /**
+   * @generated from field: string event_id = 1;
+   */
+  eventId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_at = 2;
+   */
+  startAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_at = 3;
+   */
+  endAt?: Timestamp;
+
+  /**
+   * @generated from field: string location = 4;
+   */
+  location: string;
+
+  /**
+   * @generated from field: string notes = 5;
+   */
+  notes: string;
+
+  /**
+   * @generated from field: repeated string track_item_ids = 6;
+   */
+  trackItemIds: string[];
+
+  /**
+   * @generated from field: bool notify_day_before = 7;
+   */
+  notifyDayBefore: boolean;
+
+  /**
+   * @generated from field: bool notify_hour_before = 8;
+   */
+  notifyHourBefore: boolean;
+};
+
+/**
+ * Describes the message musicclub.event.RehearsalInput.
+ * Use `create(RehearsalInputSchema)` to create a new message.
+ */
+export const RehearsalInputSchema: GenMessage<RehearsalInput> = /*@__PURE__*/
+  messageDesc(file_event, 40);
+
+/**
+ * @generated from message musicclub.event.UpdateRehearsalRequest
+ */
+export type UpdateRehearsalRequest = Message<"musicclub.event.UpdateRehearsalRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * event_id is ignored; rehearsals can't move between events.
+   *
+   * @generated from field: musicclub.event.RehearsalInput rehearsal = 2;
+   */
+  rehearsal?: RehearsalInput;
+};
+
+/**
+ * Describes the message musicclub.event.UpdateRehearsalRequest.
+ * Use `create(UpdateRehearsalRequestSchema)` to create a new message.
+ */
+export const UpdateRehearsalRequestSchema: GenMessage<UpdateRehearsalRequest> = /*@__PURE__*/
+  messageDesc(file_event, 41);
+
+/**
+ * @generated from message musicclub.event.SetRehearsalAttendanceRequest
+ */
+export type SetRehearsalAttendanceRequest = Message<"musicclub.event.SetRehearsalAttendanceRequest"> & {
+  /**
+   * @generated from field: string rehearsal_id = 1;
+   */
+  rehearsalId: string;
+
+  /**
+   * @generated from field: musicclub.event.RsvpStatus status = 2;
+   */
+  status: RsvpStatus;
+};
+
+/**
+ * Describes the message musicclub.event.SetRehearsalAttendanceRequest.
+ * Use `create(SetRehearsalAttendanceRequestSchema)` to create a new message.
+ */
+export const SetRehearsalAttendanceRequestSchema: GenMessage<SetRehearsalAttendanceRequest> = /*@__PURE__*/
+  messageDesc(file_event, 42);
+
+/**
+ * @generated from message musicclub.event.GetCheckInCodeRequest
+ */
+export type GetCheckInCodeRequest = Message<"musicclub.event.GetCheckInCodeRequest"> & {
+  /**
+   * @generated from field: string event_id = 1;
+   */
+  eventId: string;
+
+  /**
+   * Invalidate the old code and issue a new one.
+   *
+   * @generated from field: bool rotate = 2;
+   */
+  rotate: boolean;
+};
+
+/**
+ * Describes the message musicclub.event.GetCheckInCodeRequest.
+ * Use `create(GetCheckInCodeRequestSchema)` to create a new message.
+ */
+export const GetCheckInCodeRequestSchema: GenMessage<GetCheckInCodeRequest> = /*@__PURE__*/
+  messageDesc(file_event, 43);
+
+/**
+ * @generated from message musicclub.event.CheckInCode
+ */
+export type CheckInCode = Message<"musicclub.event.CheckInCode"> & {
+  /**
+   * @generated from field: string code = 1;
+   */
+  code: string;
+
+  /**
+   * Mini App link carrying the code; this is what the QR encodes.
+   *
+   * @generated from field: string url = 2;
+   */
+  url: string;
+
+  /**
+   * PNG image of the QR code.
+   *
+   * @generated from field: bytes qr_png = 3;
+   */
+  qrPng: Uint8Array;
+};
+
+/**
+ * Describes the message musicclub.event.CheckInCode.
+ * Use `create(CheckInCodeSchema)` to create a new message.
+ */
+export const CheckInCodeSchema: GenMessage<CheckInCode> = /*@__PURE__*/
+  messageDesc(file_event, 44);
+
+/**
+ * @generated from message musicclub.event.CheckInRequest
+ */
+export type CheckInRequest = Message<"musicclub.event.CheckInRequest"> & {
+  /**
+   * @generated from field: string code = 1;
+   */
+  code: string;
+};
+
+/**
+ * Describes the message musicclub.event.CheckInRequest.
+ * Use `create(CheckInRequestSchema)` to create a new message.
+ */
+export const CheckInRequestSchema: GenMessage<CheckInRequest> = /*@__PURE__*/
+  messageDesc(file_event, 45);
+
+/**
+ * @generated from message musicclub.event.FeedbackSurvey
+ */
+export type FeedbackSurvey = Message<"musicclub.event.FeedbackSurvey"> & {
+  /**
+   * @generated from field: google.protobuf.Timestamp opened_at = 1;
+   */
+  openedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp closes_at = 2;
+   */
+  closesAt?: Timestamp;
+
+  /**
+   * @generated from field: bool open = 3;
+   */
+  open: boolean;
+
+  /**
+   * Current user's answer; 0 when not answered.
+   *
+   * @generated from field: uint32 my_rating = 4;
+   */
+  myRating: number;
+
+  /**
+   * @generated from field: string my_comment = 5;
+   */
+  myComment: string;
+};
+
+/**
+ * Describes the message musicclub.event.FeedbackSurvey.
+ * Use `create(FeedbackSurveySchema)` to create a new message.
+ */
+export const FeedbackSurveySchema: GenMessage<FeedbackSurvey> = /*@__PURE__*/
+  messageDesc(file_event, 46);
+
+/**
+ * @generated from message musicclub.event.OpenFeedbackSurveyRequest
+ */
+export type OpenFeedbackSurveyRequest = Message<"musicclub.event.OpenFeedbackSurveyRequest"> & {
+  /**
+   * @generated from field: string event_id = 1;
+   */
+  eventId: string;
+
+  /**
+   * Defaults to a week from now.
+   *
+   * @generated from field: google.protobuf.Timestamp closes_at = 2;
+   */
+  closesAt?: Timestamp;
+};
+
+/**
+ * Describes the message musicclub.event.OpenFeedbackSurveyRequest.
+ * Use `create(OpenFeedbackSurveyRequestSchema)` to create a new message.
+ */
+export const OpenFeedbackSurveyRequestSchema: GenMessage<OpenFeedbackSurveyRequest> = /*@__PURE__*/
+  messageDesc(file_event, 47);
+
+/**
+ * @generated from message musicclub.event.SubmitFeedbackRequest
+ */
+export type SubmitFeedbackRequest = Message<"musicclub.event.SubmitFeedbackRequest"> & {
+  /**
+   * @generated from field: string event_id = 1;
+   */
+  eventId: string;
+
+  /**
+   * 1 to 5.
+   *
+   * @generated from field: uint32 rating = 2;
+   */
+  rating: number;
+
+  /**
+   * @generated from field: string comment = 3;
+   */
+  comment: string;
+};
+
+/**
+ * Describes the message musicclub.event.SubmitFeedbackRequest.
+ * Use `create(SubmitFeedbackRequestSchema)` to create a new message.
+ */
+export const SubmitFeedbackRequestSchema: GenMessage<SubmitFeedbackRequest> = /*@__PURE__*/
+  messageDesc(file_event, 48);
+
+/**
+ * @generated from message musicclub.event.FeedbackAnswer
+ */
+export type FeedbackAnswer = Message<"musicclub.event.FeedbackAnswer"> & {
+  /**
+   * @generated from field: musicclub.user.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: uint32 rating = 2;
+   */
+  rating: number;
+
+  /**
+   * @generated from field: string comment = 3;
+   */
+  comment: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp submitted_at = 4;
+   */
+  submittedAt?: Timestamp;
+};
+
+/**
+ * Describes the message musicclub.event.FeedbackAnswer.
+ * Use `create(FeedbackAnswerSchema)` to create a new message.
+ */
+export const FeedbackAnswerSchema: GenMessage<FeedbackAnswer> = /*@__PURE__*/
+  messageDesc(file_event, 49);
+
+/**
+ * @generated from message musicclub.event.FeedbackResults
+ */
+export type FeedbackResults = Message<"musicclub.event.FeedbackResults"> & {
+  /**
+   * @generated from field: string event_id = 1;
+   */
+  eventId: string;
+
+  /**
+   * @generated from field: musicclub.event.FeedbackSurvey survey = 2;
+   */
+  survey?: FeedbackSurvey;
+
+  /**
+   * @generated from field: uint32 responses = 3;
+   */
+  responses: number;
+
+  /**
+   * @generated from field: double average_rating = 4;
+   */
+  averageRating: number;
+
+  /**
+   * Number of answers per rating, index 0 holding the 1-star ones.
+   *
+   * @generated from field: repeated uint32 rating_counts = 5;
+   */
+  ratingCounts: number[];
+
+  /**
+   * Most recent first.
+   *
+   * @generated from field: repeated musicclub.event.FeedbackAnswer answers = 6;
+   */
+  answers: FeedbackAnswer[];
+};
+
+/**
+ * Describes the message musicclub.event.FeedbackResults.
+ * Use `create(FeedbackResultsSchema)` to create a new message.
+ */
+export const FeedbackResultsSchema: GenMessage<FeedbackResults> = /*@__PURE__*/
+  messageDesc(file_event, 50);
+
+/**
+ * @generated from message musicclub.event.EquipmentItem
+ */
+export type EquipmentItem = Message<"musicclub.event.EquipmentItem"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * E.g. "Snare drum" or "XLR cable".
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: uint32 quantity = 3;
+   */
+  quantity: number;
+
+  /**
+   * @generated from field: string notes = 4;
+   */
+  notes: string;
+
+  /**
+   * Unset while nobody has taken the item.
+   *
+   * @generated from field: musicclub.user.User bringer = 5;
+   */
+  bringer?: User;
+
+  /**
+   * Set when the item was ticked off; cleared when the bringer changes.
+   *
+   * @generated from field: google.protobuf.Timestamp checked_at = 6;
+   */
+  checkedAt?: Timestamp;
+
+  /**
+   * @generated from field: string created_by_id = 7;
+   */
+  createdById: string;
+};
+
+/**
+ * Describes the message musicclub.event.EquipmentItem.
+ * Use `create(EquipmentItemSchema)` to create a new message.
+ */
+export const EquipmentItemSchema: GenMessage<EquipmentItem> = /*@__PURE__*/
+  messageDesc(file_event, 51);
+
+/**
+ * @generated from message musicclub.event.EquipmentItemId
+ */
+export type EquipmentItemId = Message<"musicclub.event.EquipmentItemId"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message musicclub.event.EquipmentItemId.
+ * Use `create(EquipmentItemIdSchema)` to create a new message.
+ */
+export const EquipmentItemIdSchema: GenMessage<EquipmentItemId> = /*@__PURE__*/
+  messageDesc(file_event, 52);
+
+/**
+ * @generated from message musicclub.event.AddEquipmentItemRequest
+ */
+export type AddEquipmentItemRequest = Message<"musicclub.event.AddEquipmentItemRequest"> & {
+  /**
+   * @generated from field: string event_id = 1;
+   */
+  eventId: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * Defaults to 1.
+   *
+   * @generated from field: uint32 quantity = 3;
+   */
+  quantity: number;
+
+  /**
+   * @generated from field: string notes = 4;
+   */
+  notes: string;
+};
+
+/**
+ * Describes the message musicclub.event.AddEquipmentItemRequest.
+ * Use `create(AddEquipmentItemRequestSchema)` to create a new message.
+ */
+export const AddEquipmentItemRequestSchema: GenMessage<AddEquipmentItemRequest> = /*@__PURE__*/
+  messageDesc(file_event, 53);
+
+/**
+ * @generated from message musicclub.event.UpdateEquipmentItemRequest
+ */
+export type UpdateEquipmentItemRequest = Message<"musicclub.event.UpdateEquipmentItemRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: uint32 quantity = 3;
+   */
+  quantity: number;
+
+  /**
+   * @generated from field: string notes = 4;
+   */
+  notes: string;
+};
+
+/**
+ * Describes the message musicclub.event.UpdateEquipmentItemRequest.
+ * Use `create(UpdateEquipmentItemRequestSchema)` to create a new message.
+ */
+export const UpdateEquipmentItemRequestSchema: GenMessage<UpdateEquipmentItemRequest> = /*@__PURE__*/
+  messageDesc(file_event, 54);
+
+/**
+ * @generated from message musicclub.event.SetEquipmentBringerRequest
+ */
+export type SetEquipmentBringerRequest = Message<"musicclub.event.SetEquipmentBringerRequest"> & {
+  /**
+   * @generated from field: string item_id = 1;
+   */
+  itemId: string;
+
+  /**
+   * Empty clears the bringer.
+   *
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message musicclub.event.SetEquipmentBringerRequest.
+ * Use `create(SetEquipmentBringerRequestSchema)` to create a new message.
+ */
+export const SetEquipmentBringerRequestSchema: GenMessage<SetEquipmentBringerRequest> = /*@__PURE__*/
+  messageDesc(file_event, 55);
+
+/**
+ * @generated from message musicclub.event.SetEquipmentCheckedRequest
+ */
+export type SetEquipmentCheckedRequest = Message<"musicclub.event.SetEquipmentCheckedRequest"> & {
+  /**
+   * @generated from field: string item_id = 1;
+   */
+  itemId: string;
+
+  /**
+   * @generated from field: bool checked = 2;
+   */
+  checked: boolean;
+};
+
+/**
+ * Describes the message musicclub.event.SetEquipmentCheckedRequest.
+ * Use `create(SetEquipmentCheckedRequestSchema)` to create a new message.
+ */
+export const SetEquipmentCheckedRequestSchema: GenMessage<SetEquipmentCheckedRequest> = /*@__PURE__*/
+  messageDesc(file_event, 56);
+
+/**
+ * @generated from message musicclub.event.Ride
+ */
+export type Ride = Message<"musicclub.event.Ride"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: musicclub.event.RideKind kind = 2;
+   */
+  kind: RideKind;
+
+  /**
+   * @generated from field: musicclub.user.User user = 3;
+   */
+  user?: User;
+
+  /**
+   * Seats offered or needed.
+   *
+   * @generated from field: uint32 seats = 4;
+   */
+  seats: number;
+
+  /**
+   * Where the ride starts or the passenger can be picked up.
+   *
+   * @generated from field: string from_location = 5;
+   */
+  fromLocation: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp depart_at = 6;
+   */
+  departAt?: Timestamp;
+
+  /**
+   * @generated from field: string notes = 7;
+   */
+  notes: string;
+
+  /**
+   * Offers only: who took a seat, earliest first, and what is left.
+   *
+   * @generated from field: repeated musicclub.user.User passengers = 8;
+   */
+  passengers: User[];
+
+  /**
+   * @generated from field: uint32 seats_left = 9;
+   */
+  seatsLeft: number;
+};
+
+/**
+ * Describes the message musicclub.event.Ride.
+ * Use `create(RideSchema)` to create a new message.
+ */
+export const RideSchema: GenMessage<Ride> = /*@__PURE__*/
+  messageDesc(file_event, 57);
+
+/**
+ * @generated from message musicclub.event.RideId
+ */
+export type RideId = Message<"musicclub.event.RideId"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message musicclub.event.RideId.
+ * Use `create(RideIdSchema)` to create a new message.
+ */
+export const RideIdSchema: GenMessage<RideId> = /*@__PURE__*/
+  messageDesc(file_event, 58);
+
+/**
+ * @generated from message musicclub.event.PostRideRequest
+ */
+export type PostRideRequest = Message<"musicclub.event.PostRideRequest"> & {
+  /**
+   * @generated from field: string event_id = 1;
+   */
+  eventId: string;
+
+  /**
+   * @generated from field: musicclub.event.RideKind kind = 2;
+   */
+  kind: RideKind;
+
+  /**
+   * Defaults to 1.
+   *
+   * @generated from field: uint32 seats = 3;
+   */
+  seats: number;
+
+  /**
+   * @generated from field: string from_location = 4;
+   */
+  fromLocation: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp depart_at = 5;
+   */
+  departAt?: Timestamp;
+
+  /**
+   * @generated from field: string notes = 6;
+   */
+  notes: string;
+};
+
+/**
+ * Describes the message musicclub.event.PostRideRequest.
+ * Use `create(PostRideRequestSchema)` to create a new message.
+ */
+export const PostRideRequestSchema: GenMessage<PostRideRequest> = /*@__PURE__*/
+  messageDesc(file_event, 59);
+
+/**
+ * @generated from message musicclub.event.SetRidePassengerRequest
+ */
+export type SetRidePassengerRequest = Message<"musicclub.event.SetRidePassengerRequest"> & {
+  /**
+   * An offer.
+   *
+   * @generated from field: string ride_id = 1;
+   */
+  rideId: string;
+
+  /**
+   * false gives the seat back.
+   *
+   * @generated from field: bool riding = 2;
+   */
+  riding: boolean;
+};
+
+/**
+ * Describes the message musicclub.event.SetRidePassengerRequest.
+ * Use `create(SetRidePassengerRequestSchema)` to create a new message.
+ */
+export const SetRidePassengerRequestSchema: GenMessage<SetRidePassengerRequest> = /*@__PURE__*/
+  messageDesc(file_event, 60);
+
+/**
+ * @generated from message musicclub.event.Expense
+ */
+export type Expense = Message<"musicclub.event.Expense"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: musicclub.user.User payer = 2;
+   */
+  payer?: User;
+
+  /**
+   * E.g. "Rehearsal room" or "Strings".
+   *
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  /**
+   * In minor currency units (kopecks).
+   *
+   * @generated from field: int64 amount_cents = 4;
+   */
+  amountCents: bigint;
+
+  /**
+   * People sharing the cost equally, the payer included if they take part.
+   *
+   * @generated from field: repeated musicclub.user.User split_between = 5;
+   */
+  splitBetween: User[];
+
+  /**
+   * @generated from field: string created_by_id = 6;
+   */
+  createdById: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message musicclub.event.Expense.
+ * Use `create(ExpenseSchema)` to create a new message.
+ */
+export const ExpenseSchema: GenMessage<Expense> = /*@__PURE__*/
+  messageDesc(file_event, 61);
+
+/**
+ * @generated from message musicclub.event.ExpenseId
+ */
+export type ExpenseId = Message<"musicclub.event.ExpenseId"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message musicclub.event.ExpenseId.
+ * Use `create(ExpenseIdSchema)` to create a new message.
+ */
+export const ExpenseIdSchema: GenMessage<ExpenseId> = /*@__PURE__*/
+  messageDesc(file_event, 62);
+
+/**
+ * @generated from message musicclub.event.AddExpenseRequest
+ */
+export type AddExpenseRequest = Message<"musicclub.event.AddExpenseRequest"> & {
+  /**
+   * @generated from field: string event_id = 1;
+   */
+  eventId: string;
+
+  /**
+   * @generated from field: string description = 2;
+   */
+  description: string;
+
+  /**
+   * @generated from field: int64 amount_cents = 3;
+   */
+  amountCents: bigint;
+
+  /**
+   * Defaults to the current user.
+   *
+   * @generated from field: string payer_id = 4;
+   */
+  payerId: string;
+
+  /**
+   * Defaults to the event's participants and the payer.
+   *
+   * @generated from field: repeated string split_user_ids = 5;
+   */
+  splitUserIds: string[];
+};
+
+/**
+ * Describes the message musicclub.event.AddExpenseRequest.
+ * Use `create(AddExpenseRequestSchema)` to create a new message.
+ */
+export const AddExpenseRequestSchema: GenMessage<AddExpenseRequest> = /*@__PURE__*/
+  messageDesc(file_event, 63);
+
+/**
+ * @generated from message musicclub.event.ExpenseBalance
+ */
+export type ExpenseBalance = Message<"musicclub.event.ExpenseBalance"> & {
+  /**
+   * @generated from field: musicclub.user.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: int64 paid_cents = 2;
+   */
+  paidCents: bigint;
+
+  /**
+   * The user's part of all expenses they share.
+   *
+   * @generated from field: int64 share_cents = 3;
+   */
+  shareCents: bigint;
+
+  /**
+   * paid minus share: positive means the user gets money back.
+   *
+   * @generated from field: int64 balance_cents = 4;
+   */
+  balanceCents: bigint;
+};
+
+/**
+ * Describes the message musicclub.event.ExpenseBalance.
+ * Use `create(ExpenseBalanceSchema)` to create a new message.
+ */
+export const ExpenseBalanceSchema: GenMessage<ExpenseBalance> = /*@__PURE__*/
+  messageDesc(file_event, 64);
+
+/**
+ * @generated from message musicclub.event.Settlement
+ */
+export type Settlement = Message<"musicclub.event.Settlement"> & {
+  /**
+   * @generated from field: musicclub.user.User from = 1;
+   */
+  from?: User;
+
+  /**
+   * @generated from field: musicclub.user.User to = 2;
+   */
+  to?: User;
+
+  /**
+   * @generated from field: int64 amount_cents = 3;
+   */
+  amountCents: bigint;
+};
+
+/**
+ * Describes the message musicclub.event.Settlement.
+ * Use `create(SettlementSchema)` to create a new message.
+ */
+export const SettlementSchema: GenMessage<Settlement> = /*@__PURE__*/
+  messageDesc(file_event, 65);
+
+/**
+ * @generated from message musicclub.event.ExpenseSummary
+ */
+export type ExpenseSummary = Message<"musicclub.event.ExpenseSummary"> & {
+  /**
+   * @generated from field: string event_id = 1;
+   */
+  eventId: string;
+
+  /**
+   * Oldest first.
+   *
+   * @generated from field: repeated musicclub.event.Expense expenses = 2;
+   */
+  expenses: Expense[];
+
+  /**
+   * @generated from field: int64 total_cents = 3;
+   */
+  totalCents: bigint;
+
+  /**
+   * Largest balance first.
+   *
+   * @generated from field: repeated musicclub.event.ExpenseBalance balances = 4;
+   */
+  balances: ExpenseBalance[];
+
+  /**
+   * Transfers that bring every balance to zero, largest debts settled
+   * first.
+   *
+   * @generated from field: repeated musicclub.event.Settlement settlements = 5;
+   */
+  settlements: Settlement[];
+};
+
+/**
+ * Describes the message musicclub.event.ExpenseSummary.
+ * Use `create(ExpenseSummarySchema)` to create a new message.
+ */
+export const ExpenseSummarySchema: GenMessage<ExpenseSummary> = /*@__PURE__*/
+  messageDesc(file_event, 66);
+
+/**
+ * @generated from message musicclub.event.CreateEventShareLinkRequest
+ */
+export type CreateEventShareLinkRequest = Message<"musicclub.event.CreateEventShareLinkRequest"> & {
+  /**
+   * @generated from field: string event_id = 1;
+   */
+  eventId: string;
+
+  /**
+   * Defaults to 30 days from now; at most a year ahead.
+   *
+   * @generated from field: google.protobuf.Timestamp expires_at = 2;
+   */
+  expiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message musicclub.event.CreateEventShareLinkRequest.
+ * Use `create(CreateEventShareLinkRequestSchema)` to create a new message.
+ */
+export const CreateEventShareLinkRequestSchema: GenMessage<CreateEventShareLinkRequest> = /*@__PURE__*/
+  messageDesc(file_event, 67);
+
+/**
+ * @generated from message musicclub.event.EventShareLink
+ */
+export type EventShareLink = Message<"musicclub.event.EventShareLink"> & {
+  /**
+   * @generated from field: string url = 1;
+   */
+  url: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 2;
+   */
+  expiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message musicclub.event.EventShareLink.
+ * Use `create(EventShareLinkSchema)` to create a new message.
+ */
+export const EventShareLinkSchema: GenMessage<EventShareLink> = /*@__PURE__*/
+  messageDesc(file_event, 68);
+
+/**
+ * @generated from enum musicclub.event.EventTimeFilter
+ */
+export enum EventTimeFilter {
+  /**
+   * @generated from enum value: EVENT_TIME_FILTER_ALL = 0;
+   */
+  ALL = 0,
+
+  /**
+   * @generated from enum value: EVENT_TIME_FILTER_UPCOMING = 1;
+   */
+  UPCOMING = 1,
+
+  /**
+   * @generated from enum value: EVENT_TIME_FILTER_PAST = 2;
+   */
+  PAST = 2,
+}
+
+/**
+ * Describes the enum musicclub.event.EventTimeFilter.
+ */
+export const EventTimeFilterSchema: GenEnum<EventTimeFilter> = /*@__PURE__*/
+  enumDesc(file_event, 0);
+
+/**
+ * @generated from enum musicclub.event.RsvpStatus
+ */
+export enum RsvpStatus {
+  /**
+   * @generated from enum value: RSVP_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: RSVP_STATUS_GOING = 1;
+   */
+  GOING = 1,
+
+  /**
+   * @generated from enum value: RSVP_STATUS_MAYBE = 2;
+   */
+  MAYBE = 2,
+
+  /**
+   * @generated from enum value: RSVP_STATUS_DECLINED = 3;
+   */
+  DECLINED = 3,
+
+  /**
+   * Asked to go while the event was full; promoted in order as spots free up.
+   *
+   * @generated from enum value: RSVP_STATUS_WAITLISTED = 4;
+   */
+  WAITLISTED = 4,
+}
+
+/**
+ * Describes the enum musicclub.event.RsvpStatus.
+ */
+export const RsvpStatusSchema: GenEnum<RsvpStatus> = /*@__PURE__*/
+  enumDesc(file_event, 1);
+
+/**
+ * @generated from enum musicclub.event.TracklistWarningKind
+ */
+export enum TracklistWarningKind {
+  /**
+   * @generated from enum value: TRACKLIST_WARNING_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The member plays back-to-back items on different instruments.
+   *
+   * @generated from enum value: TRACKLIST_WARNING_KIND_INSTRUMENT_CHANGE = 1;
+   */
+  INSTRUMENT_CHANGE = 1,
+
+  /**
+   * The member is in two items scheduled at overlapping times, e.g. sets
+   * with fixed start times that run into each other.
+   *
+   * @generated from enum value: TRACKLIST_WARNING_KIND_OVERLAP = 2;
+   */
+  OVERLAP = 2,
+
+  /**
+   * The item's song lacks the event's theme tag; user and roles are empty.
+   *
+   * @generated from enum value: TRACKLIST_WARNING_KIND_OFF_THEME = 3;
+   */
+  OFF_THEME = 3,
+}
+
+/**
+ * Describes the enum musicclub.event.TracklistWarningKind.
+ */
+export const TracklistWarningKindSchema: GenEnum<TracklistWarningKind> = /*@__PURE__*/
+  enumDesc(file_event, 2);
+
+/**
+ * @generated from enum musicclub.event.TrackRehearsalStatus
+ */
+export enum TrackRehearsalStatus {
+  /**
+   * @generated from enum value: TRACK_REHEARSAL_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: TRACK_REHEARSAL_STATUS_NOT_STARTED = 1;
+   */
+  NOT_STARTED = 1,
+
+  /**
+   * @generated from enum value: TRACK_REHEARSAL_STATUS_IN_PROGRESS = 2;
+   */
+  IN_PROGRESS = 2,
+
+  /**
+   * @generated from enum value: TRACK_REHEARSAL_STATUS_READY = 3;
+   */
+  READY = 3,
+}
+
+/**
+ * Describes the enum musicclub.event.TrackRehearsalStatus.
+ */
+export const TrackRehearsalStatusSchema: GenEnum<TrackRehearsalStatus> = /*@__PURE__*/
+  enumDesc(file_event, 3);
+
+/**
+ * @generated from enum musicclub.event.RecurrenceScope
+ */
+export enum RecurrenceScope {
+  /**
+   * @generated from enum value: RECURRENCE_SCOPE_THIS_OCCURRENCE = 0;
+   */
+  THIS_OCCURRENCE = 0,
+
+  /**
+   * @generated from enum value: RECURRENCE_SCOPE_ALL_FUTURE = 1;
+   */
+  ALL_FUTURE = 1,
+}
+
+/**
+ * Describes the enum musicclub.event.RecurrenceScope.
+ */
+export const RecurrenceScopeSchema: GenEnum<RecurrenceScope> = /*@__PURE__*/
+  enumDesc(file_event, 4);
+
+/**
+ * @generated from enum musicclub.event.AnnouncementFormat
+ */
+export enum AnnouncementFormat {
+  /**
+   * Telegram HTML parse mode.
+   *
+   * @generated from enum value: ANNOUNCEMENT_FORMAT_HTML = 0;
+   */
+  HTML = 0,
+
+  /**
+   * Telegram MarkdownV2 parse mode.
+   *
+   * @generated from enum value: ANNOUNCEMENT_FORMAT_MARKDOWN = 1;
+   */
+  MARKDOWN = 1,
+}
+
+/**
+ * Describes the enum musicclub.event.AnnouncementFormat.
+ */
+export const AnnouncementFormatSchema: GenEnum<AnnouncementFormat> = /*@__PURE__*/
+  enumDesc(file_event, 5);
+
+/**
+ * @generated from enum musicclub.event.TracklistExportFormat
+ */
+export enum TracklistExportFormat {
+  /**
+   * @generated from enum value: TRACKLIST_EXPORT_FORMAT_TEXT = 0;
+   */
+  TEXT = 0,
+
+  /**
+   * @generated from enum value: TRACKLIST_EXPORT_FORMAT_PDF = 1;
+   */
+  PDF = 1,
+}
+
+/**
+ * Describes the enum musicclub.event.TracklistExportFormat.
+ */
+export const TracklistExportFormatSchema: GenEnum<TracklistExportFormat> = /*@__PURE__*/
+  enumDesc(file_event, 6);
+
+/**
+ * @generated from enum musicclub.event.RideKind
+ */
+export enum RideKind {
+  /**
+   * @generated from enum value: RIDE_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The author drives and has free seats.
+   *
+   * @generated from enum value: RIDE_KIND_OFFER = 1;
+   */
+  OFFER = 1,
+
+  /**
+   * The author needs seats.
+   *
+   * @generated from enum value: RIDE_KIND_REQUEST = 2;
+   */
+  REQUEST = 2,
+}
+
+/**
+ * Describes the enum musicclub.event.RideKind.
+ */
+export const RideKindSchema: GenEnum<RideKind> = /*@__PURE__*/
+  enumDesc(file_event, 7);
+
+/**
+ * Provides CRUD functionality for events and tracklists. Per-event actions
+ * that require edit_events or edit_tracklists are also open to the event's
+ * owners.
+ *
+ * @generated from service musicclub.event.EventService
+ */
+export const EventService: GenService<{
+  /**
+   * Returns a paginated list of events with lightweight summaries.
+   *
+   * @generated from rpc musicclub.event.EventService.ListEvents
+   */
+  listEvents: {
+    methodKind: "unary";
+    input: typeof ListEventsRequestSchema;
+    output: typeof ListEventsResponseSchema;
+  },
+  /**
+   * Returns a single event with full details and tracklist.
+   *
+   * @generated from rpc musicclub.event.EventService.GetEvent
+   */
+  getEvent: {
+    methodKind: "unary";
+    input: typeof EventIdSchema;
+    output: typeof EventDetailsSchema;
+  },
+  /**
+   * Create events (requires edit_events or create_events); the creator
+   * becomes an owner.
+   *
+   * @generated from rpc musicclub.event.EventService.CreateEvent
+   */
+  createEvent: {
+    methodKind: "unary";
+    input: typeof CreateEventRequestSchema;
+    output: typeof EventDetailsSchema;
+  },
+  /**
+   * New one-off event with the settings of an existing one (title, venue,
+   * reminders, capacity, roles, timezone, season) on a new date, optionally
+   * with its tracklist (requires edit_events or create_events).
+   *
+   * @generated from rpc musicclub.event.EventService.DuplicateEvent
+   */
+  duplicateEvent: {
+    methodKind: "unary";
+    input: typeof DuplicateEventRequestSchema;
+    output: typeof EventDetailsSchema;
+  },
+  /**
+   * Update events (requires permissions).
+   *
+   * @generated from rpc musicclub.event.EventService.UpdateEvent
+   */
+  updateEvent: {
+    methodKind: "unary";
+    input: typeof UpdateEventRequestSchema;
+    output: typeof EventDetailsSchema;
+  },
+  /**
+   * Archive an event (requires permissions). Its tracklist and
+   * participation history are kept; archived events are hidden everywhere
+   * except ListEvents with archived set.
+   *
+   * @generated from rpc musicclub.event.EventService.DeleteEvent
+   */
+  deleteEvent: {
     methodKind: "unary";
     input: typeof EventIdSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * Bring an archived event back (requires edit_events).
+   *
+   * @generated from rpc musicclub.event.EventService.RestoreEvent
+   */
+  restoreEvent: {
+    methodKind: "unary";
+    input: typeof EventIdSchema;
+    output: typeof EventDetailsSchema;
+  },
+  /**
+   * Mark the event as played and freeze the lineup of every tracklist item
+   * (requires edit_events). Completing it again changes nothing.
+   *
+   * @generated from rpc musicclub.event.EventService.CompleteEvent
+   */
+  completeEvent: {
+    methodKind: "unary";
+    input: typeof EventIdSchema;
+    output: typeof EventDetailsSchema;
+  },
+  /**
+   * Mark the event as cancelled without deleting it, stop its reminders and
+   * tell participants via the bot (requires edit_events). Cancelling again
+   * changes nothing.
+   *
+   * @generated from rpc musicclub.event.EventService.CancelEvent
+   */
+  cancelEvent: {
+    methodKind: "unary";
+    input: typeof CancelEventRequestSchema;
+    output: typeof EventDetailsSchema;
+  },
+  /**
+   * Add or remove an organizer of the event (requires edit_events or being
+   * an owner).
+   *
+   * @generated from rpc musicclub.event.EventService.AddEventOwner
+   */
+  addEventOwner: {
+    methodKind: "unary";
+    input: typeof EventOwnerRequestSchema;
+    output: typeof EventDetailsSchema;
+  },
+  /**
+   * @generated from rpc musicclub.event.EventService.RemoveEventOwner
+   */
+  removeEventOwner: {
+    methodKind: "unary";
+    input: typeof EventOwnerRequestSchema;
+    output: typeof EventDetailsSchema;
+  },
   /**
    * Replace the entire tracklist in one call.
    *
@@ -381,6 +3163,408 @@ export const EventService: GenService<{
     input: typeof SetTracklistRequestSchema;
     output: typeof EventDetailsSchema;
   },
+  /**
+   * Post the tracklist, split into sets, to the club chat (requires
+   * edit_tracklists).
+   *
+   * @generated from rpc musicclub.event.EventService.PublishTracklist
+   */
+  publishTracklist: {
+    methodKind: "unary";
+    input: typeof EventIdSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * Append another event's tracklist (with its sets) to this event's one
+   * (requires edit_tracklists).
+   *
+   * @generated from rpc musicclub.event.EventService.CopyTracklist
+   */
+  copyTracklist: {
+    methodKind: "unary";
+    input: typeof CopyTracklistRequestSchema;
+    output: typeof EventDetailsSchema;
+  },
+  /**
+   * Propose a tracklist of songs with every role filled; nothing is saved,
+   * organizers tweak the result and store it with SetTracklist (requires
+   * edit_tracklists).
+   *
+   * @generated from rpc musicclub.event.EventService.SuggestTracklist
+   */
+  suggestTracklist: {
+    methodKind: "unary";
+    input: typeof SuggestTracklistRequestSchema;
+    output: typeof TracklistSchema;
+  },
+  /**
+   * Ready-to-post announcement with date, venue, lineup and tracklist
+   * highlights, for the bot and for copy-pasting to other channels.
+   *
+   * @generated from rpc musicclub.event.EventService.RenderEventAnnouncement
+   */
+  renderEventAnnouncement: {
+    methodKind: "unary";
+    input: typeof RenderEventAnnouncementRequestSchema;
+    output: typeof EventAnnouncementSchema;
+  },
+  /**
+   * Printable setlist with lineups and keys, for taping to the stage floor.
+   *
+   * @generated from rpc musicclub.event.EventService.ExportTracklist
+   */
+  exportTracklist: {
+    methodKind: "unary";
+    input: typeof ExportTracklistRequestSchema;
+    output: typeof ExportedTracklistSchema;
+  },
+  /**
+   * Single-item tracklist edits; the rest of the list is renumbered 1..n.
+   *
+   * @generated from rpc musicclub.event.EventService.InsertTrackItem
+   */
+  insertTrackItem: {
+    methodKind: "unary";
+    input: typeof InsertTrackItemRequestSchema;
+    output: typeof EventDetailsSchema;
+  },
+  /**
+   * @generated from rpc musicclub.event.EventService.MoveTrackItem
+   */
+  moveTrackItem: {
+    methodKind: "unary";
+    input: typeof MoveTrackItemRequestSchema;
+    output: typeof EventDetailsSchema;
+  },
+  /**
+   * @generated from rpc musicclub.event.EventService.RemoveTrackItem
+   */
+  removeTrackItem: {
+    methodKind: "unary";
+    input: typeof TrackItemRefSchema;
+    output: typeof EventDetailsSchema;
+  },
+  /**
+   * Changes the song or custom title/artist of an item, keeping its position.
+   *
+   * @generated from rpc musicclub.event.EventService.UpdateTrackItem
+   */
+  updateTrackItem: {
+    methodKind: "unary";
+    input: typeof UpdateTrackItemRequestSchema;
+    output: typeof EventDetailsSchema;
+  },
+  /**
+   * Mark how ready an item is; allowed to tracklist editors and to whoever
+   * plays the item's song.
+   *
+   * @generated from rpc musicclub.event.EventService.SetTrackRehearsalStatus
+   */
+  setTrackRehearsalStatus: {
+    methodKind: "unary";
+    input: typeof SetTrackRehearsalStatusRequestSchema;
+    output: typeof EventDetailsSchema;
+  },
+  /**
+   * Move the "now playing" pointer during the show (requires edit_events or
+   * edit_tracklists).
+   *
+   * @generated from rpc musicclub.event.EventService.SetCurrentTrack
+   */
+  setCurrentTrack: {
+    methodKind: "unary";
+    input: typeof SetCurrentTrackRequestSchema;
+    output: typeof EventDetailsSchema;
+  },
+  /**
+   * Streams event details: the current state first, then again on every
+   * change of the event, its tracklist or the now playing pointer.
+   *
+   * @generated from rpc musicclub.event.EventService.WatchEvent
+   */
+  watchEvent: {
+    methodKind: "server_streaming";
+    input: typeof EventIdSchema;
+    output: typeof EventDetailsSchema;
+  },
+  /**
+   * Set the current user's RSVP; RSVP_STATUS_UNSPECIFIED clears it. Going to
+   * a full event puts the user on the waitlist instead.
+   *
+   * @generated from rpc musicclub.event.EventService.SetRsvp
+   */
+  setRsvp: {
+    methodKind: "unary";
+    input: typeof SetRsvpRequestSchema;
+    output: typeof EventDetailsSchema;
+  },
+  /**
+   * Returns the current user's personal iCal feed URL with the events they
+   * take part in or plan to attend.
+   *
+   * @generated from rpc musicclub.event.EventService.GetCalendarFeed
+   */
+  getCalendarFeed: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof CalendarFeedSchema;
+  },
+  /**
+   * Mint a signed link to a public read-only page of the event (title,
+   * time, venue, tracklist) for guests without Telegram (requires
+   * edit_events).
+   *
+   * @generated from rpc musicclub.event.EventService.CreateEventShareLink
+   */
+  createEventShareLink: {
+    methodKind: "unary";
+    input: typeof CreateEventShareLinkRequestSchema;
+    output: typeof EventShareLinkSchema;
+  },
+  /**
+   * Save an existing event's shape as a reusable template (requires edit_events).
+   *
+   * @generated from rpc musicclub.event.EventService.SaveEventTemplate
+   */
+  saveEventTemplate: {
+    methodKind: "unary";
+    input: typeof SaveEventTemplateRequestSchema;
+    output: typeof EventTemplateSchema;
+  },
+  /**
+   * @generated from rpc musicclub.event.EventService.ListEventTemplates
+   */
+  listEventTemplates: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof ListEventTemplatesResponseSchema;
+  },
+  /**
+   * Delete a template (requires edit_events).
+   *
+   * @generated from rpc musicclub.event.EventService.DeleteEventTemplate
+   */
+  deleteEventTemplate: {
+    methodKind: "unary";
+    input: typeof EventTemplateIdSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * Create an event from a template (requires edit_events or create_events).
+   *
+   * @generated from rpc musicclub.event.EventService.CreateEventFromTemplate
+   */
+  createEventFromTemplate: {
+    methodKind: "unary";
+    input: typeof CreateEventFromTemplateRequestSchema;
+    output: typeof EventDetailsSchema;
+  },
+  /**
+   * Schedule a rehearsal for an event (requires edit_events).
+   *
+   * @generated from rpc musicclub.event.EventService.CreateRehearsal
+   */
+  createRehearsal: {
+    methodKind: "unary";
+    input: typeof RehearsalInputSchema;
+    output: typeof EventDetailsSchema;
+  },
+  /**
+   * Update a rehearsal (requires edit_events).
+   *
+   * @generated from rpc musicclub.event.EventService.UpdateRehearsal
+   */
+  updateRehearsal: {
+    methodKind: "unary";
+    input: typeof UpdateRehearsalRequestSchema;
+    output: typeof EventDetailsSchema;
+  },
+  /**
+   * Delete a rehearsal (requires edit_events).
+   *
+   * @generated from rpc musicclub.event.EventService.DeleteRehearsal
+   */
+  deleteRehearsal: {
+    methodKind: "unary";
+    input: typeof RehearsalIdSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * Set whether the current user comes to a rehearsal; unspecified clears it.
+   *
+   * @generated from rpc musicclub.event.EventService.SetRehearsalAttendance
+   */
+  setRehearsalAttendance: {
+    methodKind: "unary";
+    input: typeof SetRehearsalAttendanceRequestSchema;
+    output: typeof EventDetailsSchema;
+  },
+  /**
+   * Returns the event's check-in code and QR image, creating or rotating the
+   * code as requested (requires edit_events).
+   *
+   * @generated from rpc musicclub.event.EventService.GetCheckInCode
+   */
+  getCheckInCode: {
+    methodKind: "unary";
+    input: typeof GetCheckInCodeRequestSchema;
+    output: typeof CheckInCodeSchema;
+  },
+  /**
+   * Record that the current user is at the event; called by the Mini App
+   * after scanning the QR code.
+   *
+   * @generated from rpc musicclub.event.EventService.CheckIn
+   */
+  checkIn: {
+    methodKind: "unary";
+    input: typeof CheckInRequestSchema;
+    output: typeof EventDetailsSchema;
+  },
+  /**
+   * Open (or extend) the rating survey of a completed event; participants
+   * are invited via the bot (requires edit_events).
+   *
+   * @generated from rpc musicclub.event.EventService.OpenFeedbackSurvey
+   */
+  openFeedbackSurvey: {
+    methodKind: "unary";
+    input: typeof OpenFeedbackSurveyRequestSchema;
+    output: typeof EventDetailsSchema;
+  },
+  /**
+   * Rate the event as the current user; answering again replaces the answer.
+   *
+   * @generated from rpc musicclub.event.EventService.SubmitFeedback
+   */
+  submitFeedback: {
+    methodKind: "unary";
+    input: typeof SubmitFeedbackRequestSchema;
+    output: typeof EventDetailsSchema;
+  },
+  /**
+   * Aggregated survey answers (requires edit_events).
+   *
+   * @generated from rpc musicclub.event.EventService.GetFeedbackResults
+   */
+  getFeedbackResults: {
+    methodKind: "unary";
+    input: typeof EventIdSchema;
+    output: typeof FeedbackResultsSchema;
+  },
+  /**
+   * Add an item to the event's equipment checklist.
+   *
+   * @generated from rpc musicclub.event.EventService.AddEquipmentItem
+   */
+  addEquipmentItem: {
+    methodKind: "unary";
+    input: typeof AddEquipmentItemRequestSchema;
+    output: typeof EventDetailsSchema;
+  },
+  /**
+   * Change or remove an item (its author or edit_events).
+   *
+   * @generated from rpc musicclub.event.EventService.UpdateEquipmentItem
+   */
+  updateEquipmentItem: {
+    methodKind: "unary";
+    input: typeof UpdateEquipmentItemRequestSchema;
+    output: typeof EventDetailsSchema;
+  },
+  /**
+   * @generated from rpc musicclub.event.EventService.RemoveEquipmentItem
+   */
+  removeEquipmentItem: {
+    methodKind: "unary";
+    input: typeof EquipmentItemIdSchema;
+    output: typeof EventDetailsSchema;
+  },
+  /**
+   * Say who brings an item; members may take or drop items themselves,
+   * assigning others requires edit_events.
+   *
+   * @generated from rpc musicclub.event.EventService.SetEquipmentBringer
+   */
+  setEquipmentBringer: {
+    methodKind: "unary";
+    input: typeof SetEquipmentBringerRequestSchema;
+    output: typeof EventDetailsSchema;
+  },
+  /**
+   * Tick an item off as packed (its bringer or edit_events).
+   *
+   * @generated from rpc musicclub.event.EventService.SetEquipmentChecked
+   */
+  setEquipmentChecked: {
+    methodKind: "unary";
+    input: typeof SetEquipmentCheckedRequestSchema;
+    output: typeof EventDetailsSchema;
+  },
+  /**
+   * Offer seats or ask for a ride to the event; posting the same kind again
+   * replaces the current user's previous post. People asking for a ride get
+   * a bot message about new offers.
+   *
+   * @generated from rpc musicclub.event.EventService.PostRide
+   */
+  postRide: {
+    methodKind: "unary";
+    input: typeof PostRideRequestSchema;
+    output: typeof EventDetailsSchema;
+  },
+  /**
+   * Withdraw a post (its author or edit_events); passengers are dropped.
+   *
+   * @generated from rpc musicclub.event.EventService.CancelRide
+   */
+  cancelRide: {
+    methodKind: "unary";
+    input: typeof RideIdSchema;
+    output: typeof EventDetailsSchema;
+  },
+  /**
+   * Take or give back a seat of an offer; the driver and the passenger are
+   * told about the match via the bot.
+   *
+   * @generated from rpc musicclub.event.EventService.SetRidePassenger
+   */
+  setRidePassenger: {
+    methodKind: "unary";
+    input: typeof SetRidePassengerRequestSchema;
+    output: typeof EventDetailsSchema;
+  },
+  /**
+   * Record a cost of the event. Members record what they paid themselves;
+   * recording for someone else requires edit_events.
+   *
+   * @generated from rpc musicclub.event.EventService.AddExpense
+   */
+  addExpense: {
+    methodKind: "unary";
+    input: typeof AddExpenseRequestSchema;
+    output: typeof ExpenseSummarySchema;
+  },
+  /**
+   * Delete an expense (its author or edit_events).
+   *
+   * @generated from rpc musicclub.event.EventService.RemoveExpense
+   */
+  removeExpense: {
+    methodKind: "unary";
+    input: typeof ExpenseIdSchema;
+    output: typeof ExpenseSummarySchema;
+  },
+  /**
+   * Expenses with everyone's balance and the transfers that settle them.
+   *
+   * @generated from rpc musicclub.event.EventService.GetExpenseSummary
+   */
+  getExpenseSummary: {
+    methodKind: "unary";
+    input: typeof EventIdSchema;
+    output: typeof ExpenseSummarySchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_event, 0);
 
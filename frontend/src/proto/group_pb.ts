@@ -0,0 +1,261 @@
+// @generated by protoc-gen-es v2.10.2 with parameter "target=ts,import_extension=ts"
+// @generated from file group.proto (package musicclub.group, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "./buf/validate/validate_pb.ts";
+import type { EmptySchema } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_empty } from "@bufbuild/protobuf/wkt";
+import type { User } from "./user_pb.ts";
+import { file_user } from "./user_pb.ts";
+import { file_validation } from "./validation_pb.ts";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file group.proto.
+ */
+export const file_group: GenFile = /*@__PURE__*/
+  fileDesc("Cgtncm91cC5wcm90bxIPbXVzaWNjbHViLmdyb3VwIm0KBUdyb3VwEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSHwoXbWVudGlvbl9vbl9zb25nX2NoYW5nZXMYBCABKAgSFAoMbWVtYmVyX2NvdW50GAUgASgFIlwKDEdyb3VwRGV0YWlscxIlCgVncm91cBgBIAEoCzIWLm11c2ljY2x1Yi5ncm91cC5Hcm91cBIlCgdtZW1iZXJzGAIgAygLMhQubXVzaWNjbHViLnVzZXIuVXNlciIfCgdHcm91cElkEhQKAmlkGAEgASgJQgi6SAVyA7ABASI8ChJMaXN0R3JvdXBzUmVzcG9uc2USJgoGZ3JvdXBzGAEgAygLMhYubXVzaWNjbHViLmdyb3VwLkdyb3VwIloKCkdyb3VwSW5wdXQSFgoEbmFtZRgBIAEoCUIIukgFcgPAPgESEwoLZGVzY3JpcHRpb24YAiABKAkSHwoXbWVudGlvbl9vbl9zb25nX2NoYW5nZXMYAyABKAgiXgoSVXBkYXRlR3JvdXBSZXF1ZXN0EhQKAmlkGAEgASgJQgi6SAVyA7ABARIyCgVncm91cBgCIAEoCzIbLm11c2ljY2x1Yi5ncm91cC5Hcm91cElucHV0Qga6SAPIAQEiUgoTR3JvdXBNZW1iZXJzUmVxdWVzdBIaCghncm91cF9pZBgBIAEoCUIIukgFcgOwAQESHwoIdXNlcl9pZHMYAiADKAlCDbpICpIBByIFcgOwAQEysAQKDEdyb3VwU2VydmljZRJJCgpMaXN0R3JvdXBzEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5GiMubXVzaWNjbHViLmdyb3VwLkxpc3RHcm91cHNSZXNwb25zZRJDCghHZXRHcm91cBIYLm11c2ljY2x1Yi5ncm91cC5Hcm91cElkGh0ubXVzaWNjbHViLmdyb3VwLkdyb3VwRGV0YWlscxJJCgtDcmVhdGVHcm91cBIbLm11c2ljY2x1Yi5ncm91cC5Hcm91cElucHV0Gh0ubXVzaWNjbHViLmdyb3VwLkdyb3VwRGV0YWlscxJRCgtVcGRhdGVHcm91cBIjLm11c2ljY2x1Yi5ncm91cC5VcGRhdGVHcm91cFJlcXVlc3QaHS5tdXNpY2NsdWIuZ3JvdXAuR3JvdXBEZXRhaWxzEj8KC0RlbGV0ZUdyb3VwEhgubXVzaWNjbHViLmdyb3VwLkdyb3VwSWQaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSVgoPQWRkR3JvdXBNZW1iZXJzEiQubXVzaWNjbHViLmdyb3VwLkdyb3VwTWVtYmVyc1JlcXVlc3QaHS5tdXNpY2NsdWIuZ3JvdXAuR3JvdXBEZXRhaWxzElkKElJlbW92ZUdyb3VwTWVtYmVycxIkLm11c2ljY2x1Yi5ncm91cC5Hcm91cE1lbWJlcnNSZXF1ZXN0Gh0ubXVzaWNjbHViLmdyb3VwLkdyb3VwRGV0YWlsc0IcWhptdXNpY2NsdWJib3QvYmFja2VuZC9wcm90b2IGcHJvdG8z", [file_buf_validate_validate, file_google_protobuf_empty, file_user, file_validation]);
+
+/**
+ * @generated from message musicclub.group.Group
+ */
+export type Group = Message<"musicclub.group.Group"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  /**
+   * When set, the bot mentions the group's members in the club chat when a
+   * song they play in is edited.
+   *
+   * @generated from field: bool mention_on_song_changes = 4;
+   */
+  mentionOnSongChanges: boolean;
+
+  /**
+   * @generated from field: int32 member_count = 5;
+   */
+  memberCount: number;
+};
+
+/**
+ * Describes the message musicclub.group.Group.
+ * Use `create(GroupSchema)` to create a new message.
+ */
+export const GroupSchema: GenMessage<Group> = /*@__PURE__*/
+  messageDesc(file_group, 0);
+
+/**
+ * @generated from message musicclub.group.GroupDetails
+ */
+export type GroupDetails = Message<"musicclub.group.GroupDetails"> & {
+  /**
+   * @generated from field: musicclub.group.Group group = 1;
+   */
+  group?: Group;
+
+  /**
+   * @generated from field: repeated musicclub.user.User members = 2;
+   */
+  members: User[];
+};
+
+/**
+ * Describes the message musicclub.group.GroupDetails.
+ * Use `create(GroupDetailsSchema)` to create a new message.
+ */
+export const GroupDetailsSchema: GenMessage<GroupDetails> = /*@__PURE__*/
+  messageDesc(file_group, 1);
+
+/**
+ * @generated from message musicclub.group.GroupId
+ */
+export type GroupId = Message<"musicclub.group.GroupId"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message musicclub.group.GroupId.
+ * Use `create(GroupIdSchema)` to create a new message.
+ */
+export const GroupIdSchema: GenMessage<GroupId> = /*@__PURE__*/
+  messageDesc(file_group, 2);
+
+/**
+ * @generated from message musicclub.group.ListGroupsResponse
+ */
+export type ListGroupsResponse = Message<"musicclub.group.ListGroupsResponse"> & {
+  /**
+   * @generated from field: repeated musicclub.group.Group groups = 1;
+   */
+  groups: Group[];
+};
+
+/**
+ * Describes the message musicclub.group.ListGroupsResponse.
+ * Use `create(ListGroupsResponseSchema)` to create a new message.
+ */
+export const ListGroupsResponseSchema: GenMessage<ListGroupsResponse> = /*@__PURE__*/
+  messageDesc(file_group, 3);
+
+/**
+ * @generated from message musicclub.group.GroupInput
+ */
+export type GroupInput = Message<"musicclub.group.GroupInput"> & {
+  /**
+   * Unique, ignoring case.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 2;
+   */
+  description: string;
+
+  /**
+   * @generated from field: bool mention_on_song_changes = 3;
+   */
+  mentionOnSongChanges: boolean;
+};
+
+/**
+ * Describes the message musicclub.group.GroupInput.
+ * Use `create(GroupInputSchema)` to create a new message.
+ */
+export const GroupInputSchema: GenMessage<GroupInput> = /*@__PURE__*/
+  messageDesc(file_group, 4);
+
+/**
+ * @generated from message musicclub.group.UpdateGroupRequest
+ */
+export type UpdateGroupRequest = Message<"musicclub.group.UpdateGroupRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: musicclub.group.GroupInput group = 2;
+   */
+  group?: GroupInput;
+};
+
+/**
+ * Describes the message musicclub.group.UpdateGroupRequest.
+ * Use `create(UpdateGroupRequestSchema)` to create a new message.
+ */
+export const UpdateGroupRequestSchema: GenMessage<UpdateGroupRequest> = /*@__PURE__*/
+  messageDesc(file_group, 5);
+
+/**
+ * @generated from message musicclub.group.GroupMembersRequest
+ */
+export type GroupMembersRequest = Message<"musicclub.group.GroupMembersRequest"> & {
+  /**
+   * @generated from field: string group_id = 1;
+   */
+  groupId: string;
+
+  /**
+   * @generated from field: repeated string user_ids = 2;
+   */
+  userIds: string[];
+};
+
+/**
+ * Describes the message musicclub.group.GroupMembersRequest.
+ * Use `create(GroupMembersRequestSchema)` to create a new message.
+ */
+export const GroupMembersRequestSchema: GenMessage<GroupMembersRequest> = /*@__PURE__*/
+  messageDesc(file_group, 6);
+
+/**
+ * Member groups such as "vocals section" or "organizers". Anyone can see
+ * them; managing them requires manage_permissions.
+ *
+ * @generated from service musicclub.group.GroupService
+ */
+export const GroupService: GenService<{
+  /**
+   * Returns groups sorted by name.
+   *
+   * @generated from rpc musicclub.group.GroupService.ListGroups
+   */
+  listGroups: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof ListGroupsResponseSchema;
+  },
+  /**
+   * A group with its members sorted by display name.
+   *
+   * @generated from rpc musicclub.group.GroupService.GetGroup
+   */
+  getGroup: {
+    methodKind: "unary";
+    input: typeof GroupIdSchema;
+    output: typeof GroupDetailsSchema;
+  },
+  /**
+   * @generated from rpc musicclub.group.GroupService.CreateGroup
+   */
+  createGroup: {
+    methodKind: "unary";
+    input: typeof GroupInputSchema;
+    output: typeof GroupDetailsSchema;
+  },
+  /**
+   * @generated from rpc musicclub.group.GroupService.UpdateGroup
+   */
+  updateGroup: {
+    methodKind: "unary";
+    input: typeof UpdateGroupRequestSchema;
+    output: typeof GroupDetailsSchema;
+  },
+  /**
+   * @generated from rpc musicclub.group.GroupService.DeleteGroup
+   */
+  deleteGroup: {
+    methodKind: "unary";
+    input: typeof GroupIdSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * Adds members; ones already in the group are skipped.
+   *
+   * @generated from rpc musicclub.group.GroupService.AddGroupMembers
+   */
+  addGroupMembers: {
+    methodKind: "unary";
+    input: typeof GroupMembersRequestSchema;
+    output: typeof GroupDetailsSchema;
+  },
+  /**
+   * @generated from rpc musicclub.group.GroupService.RemoveGroupMembers
+   */
+  removeGroupMembers: {
+    methodKind: "unary";
+    input: typeof GroupMembersRequestSchema;
+    output: typeof GroupDetailsSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_group, 0);
+
@@ -2,18 +2,23 @@
 // @generated from file permissions.proto (package musicclub.permissions, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
-import { fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { EmptySchema } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_empty } from "@bufbuild/protobuf/wkt";
+import type { User } from "./user_pb.ts";
+import { file_user } from "./user_pb.ts";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file permissions.proto.
  */
 export const file_permissions: GenFile = /*@__PURE__*/
-  fileDesc("ChFwZXJtaXNzaW9ucy5wcm90bxIVbXVzaWNjbHViLnBlcm1pc3Npb25zIrUBCg1QZXJtaXNzaW9uU2V0EjQKBGpvaW4YASABKAsyJi5tdXNpY2NsdWIucGVybWlzc2lvbnMuSm9pblBlcm1pc3Npb25zEjUKBXNvbmdzGAIgASgLMiYubXVzaWNjbHViLnBlcm1pc3Npb25zLlNvbmdQZXJtaXNzaW9ucxI3CgZldmVudHMYAyABKAsyJy5tdXNpY2NsdWIucGVybWlzc2lvbnMuRXZlbnRQZXJtaXNzaW9ucyJRCg9Kb2luUGVybWlzc2lvbnMSHgoWZWRpdF9vd25fcGFydGljaXBhdGlvbhgBIAEoCBIeChZlZGl0X2FueV9wYXJ0aWNpcGF0aW9uGAIgASgIIkEKD1NvbmdQZXJtaXNzaW9ucxIWCg5lZGl0X293bl9zb25ncxgBIAEoCBIWCg5lZGl0X2FueV9zb25ncxgCIAEoCCJAChBFdmVudFBlcm1pc3Npb25zEhMKC2VkaXRfZXZlbnRzGAEgASgIEhcKD2VkaXRfdHJhY2tsaXN0cxgCIAEoCEIcWhptdXNpY2NsdWJib3QvYmFja2VuZC9wcm90b2IGcHJvdG8z");
+  fileDesc("ChFwZXJtaXNzaW9ucy5wcm90bxIVbXVzaWNjbHViLnBlcm1pc3Npb25zIvwBCg1QZXJtaXNzaW9uU2V0EjQKBGpvaW4YASABKAsyJi5tdXNpY2NsdWIucGVybWlzc2lvbnMuSm9pblBlcm1pc3Npb25zEjUKBXNvbmdzGAIgASgLMiYubXVzaWNjbHViLnBlcm1pc3Npb25zLlNvbmdQZXJtaXNzaW9ucxI3CgZldmVudHMYAyABKAsyJy5tdXNpY2NsdWIucGVybWlzc2lvbnMuRXZlbnRQZXJtaXNzaW9ucxINCgVyb2xlcxgEIAMoCRI2CgVhZG1pbhgFIAEoCzInLm11c2ljY2x1Yi5wZXJtaXNzaW9ucy5BZG1pblBlcm1pc3Npb25zIlEKD0pvaW5QZXJtaXNzaW9ucxIeChZlZGl0X293bl9wYXJ0aWNpcGF0aW9uGAEgASgIEh4KFmVkaXRfYW55X3BhcnRpY2lwYXRpb24YAiABKAgiQQoPU29uZ1Blcm1pc3Npb25zEhYKDmVkaXRfb3duX3NvbmdzGAEgASgIEhYKDmVkaXRfYW55X3NvbmdzGAIgASgIIlcKEEV2ZW50UGVybWlzc2lvbnMSEwoLZWRpdF9ldmVudHMYASABKAgSFwoPZWRpdF90cmFja2xpc3RzGAIgASgIEhUKDWNyZWF0ZV9ldmVudHMYAyABKAgiLgoQQWRtaW5QZXJtaXNzaW9ucxIaChJtYW5hZ2VfcGVybWlzc2lvbnMYASABKAgiZAoEUm9sZRIMCgRuYW1lGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJEjkKC3Blcm1pc3Npb25zGAMgASgLMiQubXVzaWNjbHViLnBlcm1pc3Npb25zLlBlcm1pc3Npb25TZXQiKQoWVXNlclBlcm1pc3Npb25zUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJIrQBCg9Vc2VyUGVybWlzc2lvbnMSIgoEdXNlchgBIAEoCzIULm11c2ljY2x1Yi51c2VyLlVzZXISDQoFcm9sZXMYAiADKAkSNQoHZ3JhbnRlZBgDIAEoCzIkLm11c2ljY2x1Yi5wZXJtaXNzaW9ucy5QZXJtaXNzaW9uU2V0EjcKCWVmZmVjdGl2ZRgEIAEoCzIkLm11c2ljY2x1Yi5wZXJtaXNzaW9ucy5QZXJtaXNzaW9uU2V0IoUBChxVcGRhdGVVc2VyUGVybWlzc2lvbnNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSDQoFcm9sZXMYAiADKAkSNQoHZ3JhbnRlZBgDIAEoCzIkLm11c2ljY2x1Yi5wZXJtaXNzaW9ucy5QZXJtaXNzaW9uU2V0Eg4KBnJlYXNvbhgEIAEoCSKlAQoPUGVybWlzc2lvblBhdGNoEhEKCWFkZF9yb2xlcxgBIAMoCRIUCgxyZW1vdmVfcm9sZXMYAiADKAkSMwoFZ3JhbnQYAyABKAsyJC5tdXNpY2NsdWIucGVybWlzc2lvbnMuUGVybWlzc2lvblNldBI0CgZyZXZva2UYBCABKAsyJC5tdXNpY2NsdWIucGVybWlzc2lvbnMuUGVybWlzc2lvblNldCJ4Ch1CYXRjaFVwZGF0ZVBlcm1pc3Npb25zUmVxdWVzdBIQCgh1c2VyX2lkcxgBIAMoCRI1CgVwYXRjaBgCIAEoCzImLm11c2ljY2x1Yi5wZXJtaXNzaW9ucy5QZXJtaXNzaW9uUGF0Y2gSDgoGcmVhc29uGAMgASgJIlcKHkJhdGNoVXBkYXRlUGVybWlzc2lvbnNSZXNwb25zZRI1CgV1c2VycxgBIAMoCzImLm11c2ljY2x1Yi5wZXJtaXNzaW9ucy5Vc2VyUGVybWlzc2lvbnMiQgoeTGlzdFVzZXJzV2l0aFBlcm1pc3Npb25SZXF1ZXN0EhIKCnBlcm1pc3Npb24YASABKAkSDAoEcm9sZRgCIAEoCSJYCh9MaXN0VXNlcnNXaXRoUGVybWlzc2lvblJlc3BvbnNlEjUKBXVzZXJzGAEgAygLMiYubXVzaWNjbHViLnBlcm1pc3Npb25zLlVzZXJQZXJtaXNzaW9ucyI/ChFMaXN0Um9sZXNSZXNwb25zZRIqCgVyb2xlcxgBIAMoCzIbLm11c2ljY2x1Yi5wZXJtaXNzaW9ucy5Sb2xlMtkEChJQZXJtaXNzaW9uc1NlcnZpY2USawoSR2V0VXNlclBlcm1pc3Npb25zEi0ubXVzaWNjbHViLnBlcm1pc3Npb25zLlVzZXJQZXJtaXNzaW9uc1JlcXVlc3QaJi5tdXNpY2NsdWIucGVybWlzc2lvbnMuVXNlclBlcm1pc3Npb25zEnQKFVVwZGF0ZVVzZXJQZXJtaXNzaW9ucxIzLm11c2ljY2x1Yi5wZXJtaXNzaW9ucy5VcGRhdGVVc2VyUGVybWlzc2lvbnNSZXF1ZXN0GiYubXVzaWNjbHViLnBlcm1pc3Npb25zLlVzZXJQZXJtaXNzaW9ucxKFAQoWQmF0Y2hVcGRhdGVQZXJtaXNzaW9ucxI0Lm11c2ljY2x1Yi5wZXJtaXNzaW9ucy5CYXRjaFVwZGF0ZVBlcm1pc3Npb25zUmVxdWVzdBo1Lm11c2ljY2x1Yi5wZXJtaXNzaW9ucy5CYXRjaFVwZGF0ZVBlcm1pc3Npb25zUmVzcG9uc2USiAEKF0xpc3RVc2Vyc1dpdGhQZXJtaXNzaW9uEjUubXVzaWNjbHViLnBlcm1pc3Npb25zLkxpc3RVc2Vyc1dpdGhQZXJtaXNzaW9uUmVxdWVzdBo2Lm11c2ljY2x1Yi5wZXJtaXNzaW9ucy5MaXN0VXNlcnNXaXRoUGVybWlzc2lvblJlc3BvbnNlEk0KCUxpc3RSb2xlcxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRooLm11c2ljY2x1Yi5wZXJtaXNzaW9ucy5MaXN0Um9sZXNSZXNwb25zZUIcWhptdXNpY2NsdWJib3QvYmFja2VuZC9wcm90b2IGcHJvdG8z", [file_google_protobuf_empty, file_user]);
 
 /**
- * Aggregated permissions for a user session.
+ * Aggregated permissions for a user session: the union of the user's roles
+ * and any permissions granted to them individually.
  *
  * @generated from message musicclub.permissions.PermissionSet
  */
@@ -32,6 +37,18 @@ export type PermissionSet = Message<"musicclub.permissions.PermissionSet"> & {
    * @generated from field: musicclub.permissions.EventPermissions events = 3;
    */
   events?: EventPermissions;
+
+  /**
+   * Names of the user's roles, e.g. "admin", "moderator", "member".
+   *
+   * @generated from field: repeated string roles = 4;
+   */
+  roles: string[];
+
+  /**
+   * @generated from field: musicclub.permissions.AdminPermissions admin = 5;
+   */
+  admin?: AdminPermissions;
 };
 
 /**
@@ -104,6 +121,14 @@ export type EventPermissions = Message<"musicclub.permissions.EventPermissions">
    * @generated from field: bool edit_tracklists = 2;
    */
   editTracklists: boolean;
+
+  /**
+   * Create events; the creator owns them and may manage them like an
+   * event editor.
+   *
+   * @generated from field: bool create_events = 3;
+   */
+  createEvents: boolean;
 };
 
 /**
@@ -113,3 +138,344 @@ export type EventPermissions = Message<"musicclub.permissions.EventPermissions">
 export const EventPermissionsSchema: GenMessage<EventPermissions> = /*@__PURE__*/
   messageDesc(file_permissions, 3);
 
+/**
+ * Rights around administering the club.
+ *
+ * @generated from message musicclub.permissions.AdminPermissions
+ */
+export type AdminPermissions = Message<"musicclub.permissions.AdminPermissions"> & {
+  /**
+   * Grant and revoke roles and permissions of any user.
+   *
+   * @generated from field: bool manage_permissions = 1;
+   */
+  managePermissions: boolean;
+};
+
+/**
+ * Describes the message musicclub.permissions.AdminPermissions.
+ * Use `create(AdminPermissionsSchema)` to create a new message.
+ */
+export const AdminPermissionsSchema: GenMessage<AdminPermissions> = /*@__PURE__*/
+  messageDesc(file_permissions, 4);
+
+/**
+ * @generated from message musicclub.permissions.Role
+ */
+export type Role = Message<"musicclub.permissions.Role"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 2;
+   */
+  description: string;
+
+  /**
+   * @generated from field: musicclub.permissions.PermissionSet permissions = 3;
+   */
+  permissions?: PermissionSet;
+};
+
+/**
+ * Describes the message musicclub.permissions.Role.
+ * Use `create(RoleSchema)` to create a new message.
+ */
+export const RoleSchema: GenMessage<Role> = /*@__PURE__*/
+  messageDesc(file_permissions, 5);
+
+/**
+ * @generated from message musicclub.permissions.UserPermissionsRequest
+ */
+export type UserPermissionsRequest = Message<"musicclub.permissions.UserPermissionsRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message musicclub.permissions.UserPermissionsRequest.
+ * Use `create(UserPermissionsRequestSchema)` to create a new message.
+ */
+export const UserPermissionsRequestSchema: GenMessage<UserPermissionsRequest> = /*@__PURE__*/
+  messageDesc(file_permissions, 6);
+
+/**
+ * @generated from message musicclub.permissions.UserPermissions
+ */
+export type UserPermissions = Message<"musicclub.permissions.UserPermissions"> & {
+  /**
+   * @generated from field: musicclub.user.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: repeated string roles = 2;
+   */
+  roles: string[];
+
+  /**
+   * Permissions granted one by one, on top of the roles.
+   *
+   * @generated from field: musicclub.permissions.PermissionSet granted = 3;
+   */
+  granted?: PermissionSet;
+
+  /**
+   * What the user can actually do: roles and individual grants combined.
+   *
+   * @generated from field: musicclub.permissions.PermissionSet effective = 4;
+   */
+  effective?: PermissionSet;
+};
+
+/**
+ * Describes the message musicclub.permissions.UserPermissions.
+ * Use `create(UserPermissionsSchema)` to create a new message.
+ */
+export const UserPermissionsSchema: GenMessage<UserPermissions> = /*@__PURE__*/
+  messageDesc(file_permissions, 7);
+
+/**
+ * @generated from message musicclub.permissions.UpdateUserPermissionsRequest
+ */
+export type UpdateUserPermissionsRequest = Message<"musicclub.permissions.UpdateUserPermissionsRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: repeated string roles = 2;
+   */
+  roles: string[];
+
+  /**
+   * @generated from field: musicclub.permissions.PermissionSet granted = 3;
+   */
+  granted?: PermissionSet;
+
+  /**
+   * Kept with the change, e.g. "new tracklist curator".
+   *
+   * @generated from field: string reason = 4;
+   */
+  reason: string;
+};
+
+/**
+ * Describes the message musicclub.permissions.UpdateUserPermissionsRequest.
+ * Use `create(UpdateUserPermissionsRequestSchema)` to create a new message.
+ */
+export const UpdateUserPermissionsRequestSchema: GenMessage<UpdateUserPermissionsRequest> = /*@__PURE__*/
+  messageDesc(file_permissions, 8);
+
+/**
+ * Changes relative to what a user already has. Permissions not set in
+ * grant or revoke, and roles not listed, are left alone.
+ *
+ * @generated from message musicclub.permissions.PermissionPatch
+ */
+export type PermissionPatch = Message<"musicclub.permissions.PermissionPatch"> & {
+  /**
+   * @generated from field: repeated string add_roles = 1;
+   */
+  addRoles: string[];
+
+  /**
+   * @generated from field: repeated string remove_roles = 2;
+   */
+  removeRoles: string[];
+
+  /**
+   * @generated from field: musicclub.permissions.PermissionSet grant = 3;
+   */
+  grant?: PermissionSet;
+
+  /**
+   * @generated from field: musicclub.permissions.PermissionSet revoke = 4;
+   */
+  revoke?: PermissionSet;
+};
+
+/**
+ * Describes the message musicclub.permissions.PermissionPatch.
+ * Use `create(PermissionPatchSchema)` to create a new message.
+ */
+export const PermissionPatchSchema: GenMessage<PermissionPatch> = /*@__PURE__*/
+  messageDesc(file_permissions, 9);
+
+/**
+ * @generated from message musicclub.permissions.BatchUpdatePermissionsRequest
+ */
+export type BatchUpdatePermissionsRequest = Message<"musicclub.permissions.BatchUpdatePermissionsRequest"> & {
+  /**
+   * At most 200 users.
+   *
+   * @generated from field: repeated string user_ids = 1;
+   */
+  userIds: string[];
+
+  /**
+   * @generated from field: musicclub.permissions.PermissionPatch patch = 2;
+   */
+  patch?: PermissionPatch;
+
+  /**
+   * Kept with each user's change.
+   *
+   * @generated from field: string reason = 3;
+   */
+  reason: string;
+};
+
+/**
+ * Describes the message musicclub.permissions.BatchUpdatePermissionsRequest.
+ * Use `create(BatchUpdatePermissionsRequestSchema)` to create a new message.
+ */
+export const BatchUpdatePermissionsRequestSchema: GenMessage<BatchUpdatePermissionsRequest> = /*@__PURE__*/
+  messageDesc(file_permissions, 10);
+
+/**
+ * @generated from message musicclub.permissions.BatchUpdatePermissionsResponse
+ */
+export type BatchUpdatePermissionsResponse = Message<"musicclub.permissions.BatchUpdatePermissionsResponse"> & {
+  /**
+   * The users in the order requested, duplicates dropped.
+   *
+   * @generated from field: repeated musicclub.permissions.UserPermissions users = 1;
+   */
+  users: UserPermissions[];
+};
+
+/**
+ * Describes the message musicclub.permissions.BatchUpdatePermissionsResponse.
+ * Use `create(BatchUpdatePermissionsResponseSchema)` to create a new message.
+ */
+export const BatchUpdatePermissionsResponseSchema: GenMessage<BatchUpdatePermissionsResponse> = /*@__PURE__*/
+  messageDesc(file_permissions, 11);
+
+/**
+ * @generated from message musicclub.permissions.ListUsersWithPermissionRequest
+ */
+export type ListUsersWithPermissionRequest = Message<"musicclub.permissions.ListUsersWithPermissionRequest"> & {
+  /**
+   * Permission as named in the schema, e.g. "edit_events".
+   *
+   * @generated from field: string permission = 1;
+   */
+  permission: string;
+
+  /**
+   * Role name instead of a permission.
+   *
+   * @generated from field: string role = 2;
+   */
+  role: string;
+};
+
+/**
+ * Describes the message musicclub.permissions.ListUsersWithPermissionRequest.
+ * Use `create(ListUsersWithPermissionRequestSchema)` to create a new message.
+ */
+export const ListUsersWithPermissionRequestSchema: GenMessage<ListUsersWithPermissionRequest> = /*@__PURE__*/
+  messageDesc(file_permissions, 12);
+
+/**
+ * @generated from message musicclub.permissions.ListUsersWithPermissionResponse
+ */
+export type ListUsersWithPermissionResponse = Message<"musicclub.permissions.ListUsersWithPermissionResponse"> & {
+  /**
+   * @generated from field: repeated musicclub.permissions.UserPermissions users = 1;
+   */
+  users: UserPermissions[];
+};
+
+/**
+ * Describes the message musicclub.permissions.ListUsersWithPermissionResponse.
+ * Use `create(ListUsersWithPermissionResponseSchema)` to create a new message.
+ */
+export const ListUsersWithPermissionResponseSchema: GenMessage<ListUsersWithPermissionResponse> = /*@__PURE__*/
+  messageDesc(file_permissions, 13);
+
+/**
+ * @generated from message musicclub.permissions.ListRolesResponse
+ */
+export type ListRolesResponse = Message<"musicclub.permissions.ListRolesResponse"> & {
+  /**
+   * @generated from field: repeated musicclub.permissions.Role roles = 1;
+   */
+  roles: Role[];
+};
+
+/**
+ * Describes the message musicclub.permissions.ListRolesResponse.
+ * Use `create(ListRolesResponseSchema)` to create a new message.
+ */
+export const ListRolesResponseSchema: GenMessage<ListRolesResponse> = /*@__PURE__*/
+  messageDesc(file_permissions, 14);
+
+/**
+ * Grants and revokes permissions (requires manage_permissions). Every change
+ * is recorded with who made it and why.
+ *
+ * @generated from service musicclub.permissions.PermissionsService
+ */
+export const PermissionsService: GenService<{
+  /**
+   * @generated from rpc musicclub.permissions.PermissionsService.GetUserPermissions
+   */
+  getUserPermissions: {
+    methodKind: "unary";
+    input: typeof UserPermissionsRequestSchema;
+    output: typeof UserPermissionsSchema;
+  },
+  /**
+   * Replaces the user's roles and individually granted permissions. The last
+   * user able to manage permissions can't lose that right.
+   *
+   * @generated from rpc musicclub.permissions.PermissionsService.UpdateUserPermissions
+   */
+  updateUserPermissions: {
+    methodKind: "unary";
+    input: typeof UpdateUserPermissionsRequestSchema;
+    output: typeof UserPermissionsSchema;
+  },
+  /**
+   * Applies the same patch to several users at once, e.g. granting event
+   * rights to an organizing team. All users change or none do.
+   *
+   * @generated from rpc musicclub.permissions.PermissionsService.BatchUpdatePermissions
+   */
+  batchUpdatePermissions: {
+    methodKind: "unary";
+    input: typeof BatchUpdatePermissionsRequestSchema;
+    output: typeof BatchUpdatePermissionsResponseSchema;
+  },
+  /**
+   * Users holding the permission (through a role or individually) or the
+   * role, sorted by display name.
+   *
+   * @generated from rpc musicclub.permissions.PermissionsService.ListUsersWithPermission
+   */
+  listUsersWithPermission: {
+    methodKind: "unary";
+    input: typeof ListUsersWithPermissionRequestSchema;
+    output: typeof ListUsersWithPermissionResponseSchema;
+  },
+  /**
+   * Roles with the permissions they bundle.
+   *
+   * @generated from rpc musicclub.permissions.PermissionsService.ListRoles
+   */
+  listRoles: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof ListRolesResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_permissions, 0);
+
@@ -0,0 +1,313 @@
+// @generated by protoc-gen-es v2.10.2 with parameter "target=ts,import_extension=ts"
+// @generated from file season.proto (package musicclub.season, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "./buf/validate/validate_pb.ts";
+import type { EmptySchema, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_empty, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import { file_validation } from "./validation_pb.ts";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file season.proto.
+ */
+export const file_season: GenFile = /*@__PURE__*/
+  fileDesc("CgxzZWFzb24ucHJvdG8SEG11c2ljY2x1Yi5zZWFzb24ikwEKBlNlYXNvbhIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEi0KCXN0YXJ0c19hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASKwoHZW5kc19hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEwoLZXZlbnRfY291bnQYBSABKAUiIAoIU2Vhc29uSWQSFAoCaWQYASABKAlCCLpIBXIDsAEBIkAKE0xpc3RTZWFzb25zUmVzcG9uc2USKQoHc2Vhc29ucxgBIAMoCzIYLm11c2ljY2x1Yi5zZWFzb24uU2Vhc29uIoEBCgtTZWFzb25JbnB1dBIWCgRuYW1lGAEgASgJQgi6SAVyA8A+ARItCglzdGFydHNfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEisKB2VuZHNfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wImIKE1VwZGF0ZVNlYXNvblJlcXVlc3QSFAoCaWQYASABKAlCCLpIBXIDsAEBEjUKBnNlYXNvbhgCIAEoCzIdLm11c2ljY2x1Yi5zZWFzb24uU2Vhc29uSW5wdXRCBrpIA8gBASJTCg5TZWFzb25Tb25nU3RhdBIPCgdzb25nX2lkGAEgASgJEg0KBXRpdGxlGAIgASgJEg4KBmFydGlzdBgDIAEoCRIRCglwZXJmb3JtZWQYBCABKA0isgIKC1NlYXNvblN0YXRzEigKBnNlYXNvbhgBIAEoCzIYLm11c2ljY2x1Yi5zZWFzb24uU2Vhc29uEhgKEGNvbXBsZXRlZF9ldmVudHMYAiABKA0SGAoQY2FuY2VsbGVkX2V2ZW50cxgDIAEoDRIYChB0cmFja3NfcGVyZm9ybWVkGAQgASgNEhQKDHVuaXF1ZV9zb25ncxgFIAEoDRISCgphdHRlbmRhbmNlGAYgASgNEhgKEHVuaXF1ZV9hdHRlbmRlZXMYByABKA0SGgoSZmVlZGJhY2tfcmVzcG9uc2VzGAggASgNEhYKDmF2ZXJhZ2VfcmF0aW5nGAkgASgBEjMKCXRvcF9zb25ncxgKIAMoCzIgLm11c2ljY2x1Yi5zZWFzb24uU2Vhc29uU29uZ1N0YXQyywMKDVNlYXNvblNlcnZpY2USTAoLTGlzdFNlYXNvbnMSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaJS5tdXNpY2NsdWIuc2Vhc29uLkxpc3RTZWFzb25zUmVzcG9uc2USQQoJR2V0U2Vhc29uEhoubXVzaWNjbHViLnNlYXNvbi5TZWFzb25JZBoYLm11c2ljY2x1Yi5zZWFzb24uU2Vhc29uEkcKDENyZWF0ZVNlYXNvbhIdLm11c2ljY2x1Yi5zZWFzb24uU2Vhc29uSW5wdXQaGC5tdXNpY2NsdWIuc2Vhc29uLlNlYXNvbhJPCgxVcGRhdGVTZWFzb24SJS5tdXNpY2NsdWIuc2Vhc29uLlVwZGF0ZVNlYXNvblJlcXVlc3QaGC5tdXNpY2NsdWIuc2Vhc29uLlNlYXNvbhJCCgxEZWxldGVTZWFzb24SGi5tdXNpY2NsdWIuc2Vhc29uLlNlYXNvbklkGhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5EksKDkdldFNlYXNvblN0YXRzEhoubXVzaWNjbHViLnNlYXNvbi5TZWFzb25JZBodLm11c2ljY2x1Yi5zZWFzb24uU2Vhc29uU3RhdHNCHFoabXVzaWNjbHViYm90L2JhY2tlbmQvcHJvdG9iBnByb3RvMw", [file_buf_validate_validate, file_google_protobuf_empty, file_google_protobuf_timestamp, file_validation]);
+
+/**
+ * @generated from message musicclub.season.Season
+ */
+export type Season = Message<"musicclub.season.Season"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * Optional bounds, informational only; events join a season explicitly.
+   *
+   * @generated from field: google.protobuf.Timestamp starts_at = 3;
+   */
+  startsAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp ends_at = 4;
+   */
+  endsAt?: Timestamp;
+
+  /**
+   * Number of events in the season.
+   *
+   * @generated from field: int32 event_count = 5;
+   */
+  eventCount: number;
+};
+
+/**
+ * Describes the message musicclub.season.Season.
+ * Use `create(SeasonSchema)` to create a new message.
+ */
+export const SeasonSchema: GenMessage<Season> = /*@__PURE__*/
+  messageDesc(file_season, 0);
+
+/**
+ * @generated from message musicclub.season.SeasonId
+ */
+export type SeasonId = Message<"musicclub.season.SeasonId"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message musicclub.season.SeasonId.
+ * Use `create(SeasonIdSchema)` to create a new message.
+ */
+export const SeasonIdSchema: GenMessage<SeasonId> = /*@__PURE__*/
+  messageDesc(file_season, 1);
+
+/**
+ * @generated from message musicclub.season.ListSeasonsResponse
+ */
+export type ListSeasonsResponse = Message<"musicclub.season.ListSeasonsResponse"> & {
+  /**
+   * @generated from field: repeated musicclub.season.Season seasons = 1;
+   */
+  seasons: Season[];
+};
+
+/**
+ * Describes the message musicclub.season.ListSeasonsResponse.
+ * Use `create(ListSeasonsResponseSchema)` to create a new message.
+ */
+export const ListSeasonsResponseSchema: GenMessage<ListSeasonsResponse> = /*@__PURE__*/
+  messageDesc(file_season, 2);
+
+/**
+ * @generated from message musicclub.season.SeasonInput
+ */
+export type SeasonInput = Message<"musicclub.season.SeasonInput"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp starts_at = 2;
+   */
+  startsAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp ends_at = 3;
+   */
+  endsAt?: Timestamp;
+};
+
+/**
+ * Describes the message musicclub.season.SeasonInput.
+ * Use `create(SeasonInputSchema)` to create a new message.
+ */
+export const SeasonInputSchema: GenMessage<SeasonInput> = /*@__PURE__*/
+  messageDesc(file_season, 3);
+
+/**
+ * @generated from message musicclub.season.UpdateSeasonRequest
+ */
+export type UpdateSeasonRequest = Message<"musicclub.season.UpdateSeasonRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: musicclub.season.SeasonInput season = 2;
+   */
+  season?: SeasonInput;
+};
+
+/**
+ * Describes the message musicclub.season.UpdateSeasonRequest.
+ * Use `create(UpdateSeasonRequestSchema)` to create a new message.
+ */
+export const UpdateSeasonRequestSchema: GenMessage<UpdateSeasonRequest> = /*@__PURE__*/
+  messageDesc(file_season, 4);
+
+/**
+ * @generated from message musicclub.season.SeasonSongStat
+ */
+export type SeasonSongStat = Message<"musicclub.season.SeasonSongStat"> & {
+  /**
+   * @generated from field: string song_id = 1;
+   */
+  songId: string;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string artist = 3;
+   */
+  artist: string;
+
+  /**
+   * Times the song was marked as performed during the season.
+   *
+   * @generated from field: uint32 performed = 4;
+   */
+  performed: number;
+};
+
+/**
+ * Describes the message musicclub.season.SeasonSongStat.
+ * Use `create(SeasonSongStatSchema)` to create a new message.
+ */
+export const SeasonSongStatSchema: GenMessage<SeasonSongStat> = /*@__PURE__*/
+  messageDesc(file_season, 5);
+
+/**
+ * @generated from message musicclub.season.SeasonStats
+ */
+export type SeasonStats = Message<"musicclub.season.SeasonStats"> & {
+  /**
+   * @generated from field: musicclub.season.Season season = 1;
+   */
+  season?: Season;
+
+  /**
+   * @generated from field: uint32 completed_events = 2;
+   */
+  completedEvents: number;
+
+  /**
+   * @generated from field: uint32 cancelled_events = 3;
+   */
+  cancelledEvents: number;
+
+  /**
+   * Tracklist items marked as performed.
+   *
+   * @generated from field: uint32 tracks_performed = 4;
+   */
+  tracksPerformed: number;
+
+  /**
+   * @generated from field: uint32 unique_songs = 5;
+   */
+  uniqueSongs: number;
+
+  /**
+   * Check-ins over all events, and how many different people they were.
+   *
+   * @generated from field: uint32 attendance = 6;
+   */
+  attendance: number;
+
+  /**
+   * @generated from field: uint32 unique_attendees = 7;
+   */
+  uniqueAttendees: number;
+
+  /**
+   * @generated from field: uint32 feedback_responses = 8;
+   */
+  feedbackResponses: number;
+
+  /**
+   * Average feedback rating; 0 without responses.
+   *
+   * @generated from field: double average_rating = 9;
+   */
+  averageRating: number;
+
+  /**
+   * Most performed songs, up to 10.
+   *
+   * @generated from field: repeated musicclub.season.SeasonSongStat top_songs = 10;
+   */
+  topSongs: SeasonSongStat[];
+};
+
+/**
+ * Describes the message musicclub.season.SeasonStats.
+ * Use `create(SeasonStatsSchema)` to create a new message.
+ */
+export const SeasonStatsSchema: GenMessage<SeasonStats> = /*@__PURE__*/
+  messageDesc(file_season, 6);
+
+/**
+ * Provides CRUD functionality for seasons grouping events, plus season totals.
+ *
+ * @generated from service musicclub.season.SeasonService
+ */
+export const SeasonService: GenService<{
+  /**
+   * Returns seasons, latest first.
+   *
+   * @generated from rpc musicclub.season.SeasonService.ListSeasons
+   */
+  listSeasons: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof ListSeasonsResponseSchema;
+  },
+  /**
+   * @generated from rpc musicclub.season.SeasonService.GetSeason
+   */
+  getSeason: {
+    methodKind: "unary";
+    input: typeof SeasonIdSchema;
+    output: typeof SeasonSchema;
+  },
+  /**
+   * Create seasons (requires edit_events).
+   *
+   * @generated from rpc musicclub.season.SeasonService.CreateSeason
+   */
+  createSeason: {
+    methodKind: "unary";
+    input: typeof SeasonInputSchema;
+    output: typeof SeasonSchema;
+  },
+  /**
+   * Update seasons (requires edit_events).
+   *
+   * @generated from rpc musicclub.season.SeasonService.UpdateSeason
+   */
+  updateSeason: {
+    methodKind: "unary";
+    input: typeof UpdateSeasonRequestSchema;
+    output: typeof SeasonSchema;
+  },
+  /**
+   * Delete seasons (requires edit_events); their events stay, without a season.
+   *
+   * @generated from rpc musicclub.season.SeasonService.DeleteSeason
+   */
+  deleteSeason: {
+    methodKind: "unary";
+    input: typeof SeasonIdSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * Totals over the season's events for end-of-season retrospectives.
+   *
+   * @generated from rpc musicclub.season.SeasonService.GetSeasonStats
+   */
+  getSeasonStats: {
+    methodKind: "unary";
+    input: typeof SeasonIdSchema;
+    output: typeof SeasonStatsSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_season, 0);
+
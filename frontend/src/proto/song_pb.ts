@@ -4,19 +4,21 @@
 
 import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
-import type { EmptySchema, Timestamp } from "@bufbuild/protobuf/wkt";
-import { file_google_protobuf_empty, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { EmptySchema, FieldMask, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import { file_buf_validate_validate } from "./buf/validate/validate_pb.ts";
 import type { User } from "./user_pb.ts";
 import { file_user } from "./user_pb.ts";
 import type { PermissionSet } from "./permissions_pb.ts";
 import { file_permissions } from "./permissions_pb.ts";
+import { file_validation } from "./validation_pb.ts";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file song.proto.
  */
 export const file_song: GenFile = /*@__PURE__*/
-  fileDesc("Cgpzb25nLnByb3RvEg5tdXNpY2NsdWIuc29uZyJIChBMaXN0U29uZ3NSZXF1ZXN0Eg0KBXF1ZXJ5GAEgASgJEhIKCnBhZ2VfdG9rZW4YAiABKAkSEQoJcGFnZV9zaXplGAMgASgNIlEKEUxpc3RTb25nc1Jlc3BvbnNlEiMKBXNvbmdzGAEgAygLMhQubXVzaWNjbHViLnNvbmcuU29uZxIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkiFAoGU29uZ0lkEgoKAmlkGAEgASgJItABCgRTb25nEgoKAmlkGAEgASgJEg0KBXRpdGxlGAIgASgJEg4KBmFydGlzdBgDIAEoCRImCgRsaW5rGAQgASgLMhgubXVzaWNjbHViLnNvbmcuU29uZ0xpbmsSEwoLZGVzY3JpcHRpb24YBSABKAkSFwoPYXZhaWxhYmxlX3JvbGVzGAYgAygJEhYKDmVkaXRhYmxlX2J5X21lGAcgASgIEhgKEGFzc2lnbm1lbnRfY291bnQYCCABKAUSFQoNdGh1bWJuYWlsX3VybBgJIAEoCSKhAQoLU29uZ0RldGFpbHMSIgoEc29uZxgBIAEoCzIULm11c2ljY2x1Yi5zb25nLlNvbmcSMwoLYXNzaWdubWVudHMYAiADKAsyHi5tdXNpY2NsdWIuc29uZy5Sb2xlQXNzaWdubWVudBI5CgtwZXJtaXNzaW9ucxgDIAEoCzIkLm11c2ljY2x1Yi5wZXJtaXNzaW9ucy5QZXJtaXNzaW9uU2V0IkMKCFNvbmdMaW5rEioKBGtpbmQYASABKA4yHC5tdXNpY2NsdWIuc29uZy5Tb25nTGlua1R5cGUSCwoDdXJsGAIgASgJInEKDlJvbGVBc3NpZ25tZW50EgwKBHJvbGUYASABKAkSIgoEdXNlchgCIAEoCzIULm11c2ljY2x1Yi51c2VyLlVzZXISLQoJam9pbmVkX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCKfAQoRQ3JlYXRlU29uZ1JlcXVlc3QSDQoFdGl0bGUYASABKAkSDgoGYXJ0aXN0GAIgASgJEiYKBGxpbmsYAyABKAsyGC5tdXNpY2NsdWIuc29uZy5Tb25nTGluaxITCgtkZXNjcmlwdGlvbhgEIAEoCRIXCg9hdmFpbGFibGVfcm9sZXMYBSADKAkSFQoNdGh1bWJuYWlsX3VybBgGIAEoCSKrAQoRVXBkYXRlU29uZ1JlcXVlc3QSCgoCaWQYASABKAkSDQoFdGl0bGUYAiABKAkSDgoGYXJ0aXN0GAMgASgJEiYKBGxpbmsYBCABKAsyGC5tdXNpY2NsdWIuc29uZy5Tb25nTGluaxITCgtkZXNjcmlwdGlvbhgFIAEoCRIXCg9hdmFpbGFibGVfcm9sZXMYBiADKAkSFQoNdGh1bWJuYWlsX3VybBgHIAEoCSIwCg9Kb2luUm9sZVJlcXVlc3QSDwoHc29uZ19pZBgBIAEoCRIMCgRyb2xlGAIgASgJIjEKEExlYXZlUm9sZVJlcXVlc3QSDwoHc29uZ19pZBgBIAEoCRIMCgRyb2xlGAIgASgJKoYBCgxTb25nTGlua1R5cGUSGgoWU09OR19MSU5LX1RZUEVfVU5LTk9XThAAEhoKFlNPTkdfTElOS19UWVBFX1lPVVRVQkUQARIfChtTT05HX0xJTktfVFlQRV9ZQU5ERVhfTVVTSUMQAhIdChlTT05HX0xJTktfVFlQRV9TT1VORENMT1VEEAMyjwQKC1NvbmdTZXJ2aWNlElAKCUxpc3RTb25ncxIgLm11c2ljY2x1Yi5zb25nLkxpc3RTb25nc1JlcXVlc3QaIS5tdXNpY2NsdWIuc29uZy5MaXN0U29uZ3NSZXNwb25zZRI+CgdHZXRTb25nEhYubXVzaWNjbHViLnNvbmcuU29uZ0lkGhsubXVzaWNjbHViLnNvbmcuU29uZ0RldGFpbHMSTAoKQ3JlYXRlU29uZxIhLm11c2ljY2x1Yi5zb25nLkNyZWF0ZVNvbmdSZXF1ZXN0GhsubXVzaWNjbHViLnNvbmcuU29uZ0RldGFpbHMSTAoKVXBkYXRlU29uZxIhLm11c2ljY2x1Yi5zb25nLlVwZGF0ZVNvbmdSZXF1ZXN0GhsubXVzaWNjbHViLnNvbmcuU29uZ0RldGFpbHMSPAoKRGVsZXRlU29uZxIWLm11c2ljY2x1Yi5zb25nLlNvbmdJZBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJICghKb2luUm9sZRIfLm11c2ljY2x1Yi5zb25nLkpvaW5Sb2xlUmVxdWVzdBobLm11c2ljY2x1Yi5zb25nLlNvbmdEZXRhaWxzEkoKCUxlYXZlUm9sZRIgLm11c2ljY2x1Yi5zb25nLkxlYXZlUm9sZVJlcXVlc3QaGy5tdXNpY2NsdWIuc29uZy5Tb25nRGV0YWlsc0IcWhptdXNpY2NsdWJib3QvYmFja2VuZC9wcm90b2IGcHJvdG8z", [file_google_protobuf_empty, file_google_protobuf_timestamp, file_user, file_permissions]);
+  fileDesc("Cgpzb25nLnByb3RvEg5tdXNpY2NsdWIuc29uZyKKAQoQTGlzdFNvbmdzUmVxdWVzdBINCgVxdWVyeRgBIAEoCRISCgpwYWdlX3Rva2VuGAIgASgJEhoKCXBhZ2Vfc2l6ZRgDIAEoDUIHukgEKgIYZBIWCg5mYXZvcml0ZXNfb25seRgEIAEoCBIfCg5tYXhfZGlmZmljdWx0eRgFIAEoDUIHukgEKgIYBSJRChFMaXN0U29uZ3NSZXNwb25zZRIjCgVzb25ncxgBIAMoCzIULm11c2ljY2x1Yi5zb25nLlNvbmcSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIh4KBlNvbmdJZBIUCgJpZBgBIAEoCUIIukgFcgOwAQEiNAoUQmF0Y2hHZXRTb25nc1JlcXVlc3QSHAoDaWRzGAEgAygJQg+6SAySAQkQZCIFcgOwAQEiQwoVQmF0Y2hHZXRTb25nc1Jlc3BvbnNlEioKBXNvbmdzGAEgAygLMhsubXVzaWNjbHViLnNvbmcuU29uZ0RldGFpbHMi0gIKBFNvbmcSCgoCaWQYASABKAkSDQoFdGl0bGUYAiABKAkSDgoGYXJ0aXN0GAMgASgJEiYKBGxpbmsYBCABKAsyGC5tdXNpY2NsdWIuc29uZy5Tb25nTGluaxITCgtkZXNjcmlwdGlvbhgFIAEoCRIXCg9hdmFpbGFibGVfcm9sZXMYBiADKAkSFgoOZWRpdGFibGVfYnlfbWUYByABKAgSGAoQYXNzaWdubWVudF9jb3VudBgIIAEoBRIVCg10aHVtYm5haWxfdXJsGAkgASgJEg8KB3ZlcnNpb24YCiABKAMSEwoLaXNfZmF2b3JpdGUYCyABKAgSEQoJaXNfcGlubmVkGAwgASgIEgwKBHRhZ3MYDSADKAkSEgoKZGlmZmljdWx0eRgOIAEoARIYChBkdXJhdGlvbl9zZWNvbmRzGA8gASgNEgsKA2tleRgQIAEoCSL/AQoLU29uZ0RldGFpbHMSIgoEc29uZxgBIAEoCzIULm11c2ljY2x1Yi5zb25nLlNvbmcSMwoLYXNzaWdubWVudHMYAiADKAsyHi5tdXNpY2NsdWIuc29uZy5Sb2xlQXNzaWdubWVudBI5CgtwZXJtaXNzaW9ucxgDIAEoCzIkLm11c2ljY2x1Yi5wZXJtaXNzaW9ucy5QZXJtaXNzaW9uU2V0EjcKD3JvbGVfZGlmZmljdWx0eRgEIAMoCzIeLm11c2ljY2x1Yi5zb25nLlJvbGVEaWZmaWN1bHR5EiMKBWRlbW9zGAUgAygLMhQubXVzaWNjbHViLnNvbmcuRGVtbyLEAQoERGVtbxIKCgJpZBgBIAEoCRIPCgdzb25nX2lkGAIgASgJEg0KBXRpdGxlGAMgASgJEhQKDGNvbnRlbnRfdHlwZRgEIAEoCRISCgpzaXplX2J5dGVzGAUgASgDEikKC3VwbG9hZGVkX2J5GAYgASgLMhQubXVzaWNjbHViLnVzZXIuVXNlchIuCgpjcmVhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBILCgN1cmwYCCABKAkiHgoGRGVtb0lkEhQKAmlkGAEgASgJQgi6SAVyA7ABASJvCgxEZW1vTWV0YWRhdGESGQoHc29uZ19pZBgBIAEoCUIIukgFcgOwAQESFwoFdGl0bGUYAiABKAlCCLpIBXIDwD4BEisKDGNvbnRlbnRfdHlwZRgDIAEoCUIVukgSchAyDig/aSleXHMqYXVkaW8vImEKEVVwbG9hZERlbW9SZXF1ZXN0EjAKCG1ldGFkYXRhGAEgASgLMhwubXVzaWNjbHViLnNvbmcuRGVtb01ldGFkYXRhSAASDwoFY2h1bmsYAiABKAxIAEIJCgdwYXlsb2FkIlMKDlJvbGVEaWZmaWN1bHR5EgwKBHJvbGUYASABKAkSDwoHYXZlcmFnZRgCIAEoARIPCgdyYXRpbmdzGAMgASgFEhEKCW15X3JhdGluZxgEIAEoDSJaCghTb25nTGluaxI0CgRraW5kGAEgASgOMhwubXVzaWNjbHViLnNvbmcuU29uZ0xpbmtUeXBlQgi6SAWCAQIQARIYCgN1cmwYAiABKAlCC7pICHIDiAEB2AEBInEKDlJvbGVBc3NpZ25tZW50EgwKBHJvbGUYASABKAkSIgoEdXNlchgCIAEoCzIULm11c2ljY2x1Yi51c2VyLlVzZXISLQoJam9pbmVkX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCL1AQoRQ3JlYXRlU29uZ1JlcXVlc3QSFwoFdGl0bGUYASABKAlCCLpIBXIDwD4BEhgKBmFydGlzdBgCIAEoCUIIukgFcgPAPgESJgoEbGluaxgDIAEoCzIYLm11c2ljY2x1Yi5zb25nLlNvbmdMaW5rEhMKC2Rlc2NyaXB0aW9uGAQgASgJEhcKD2F2YWlsYWJsZV9yb2xlcxgFIAMoCRIiCg10aHVtYm5haWxfdXJsGAYgASgJQgu6SAhyA4gBAdgBARIMCgR0YWdzGAcgAygJEhgKEGR1cmF0aW9uX3NlY29uZHMYCCABKA0SCwoDa2V5GAkgASgJIvQEChFVcGRhdGVTb25nUmVxdWVzdBIUCgJpZBgBIAEoCUIIukgFcgOwAQESDQoFdGl0bGUYAiABKAkSDgoGYXJ0aXN0GAMgASgJEiYKBGxpbmsYBCABKAsyGC5tdXNpY2NsdWIuc29uZy5Tb25nTGluaxITCgtkZXNjcmlwdGlvbhgFIAEoCRIXCg9hdmFpbGFibGVfcm9sZXMYBiADKAkSIgoNdGh1bWJuYWlsX3VybBgHIAEoCUILukgIcgOIAQHYAQESLwoLdXBkYXRlX21hc2sYCCABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrEiEKEGV4cGVjdGVkX3ZlcnNpb24YCSABKANCB7pIBCICIAASDAoEdGFncxgKIAMoCRIYChBkdXJhdGlvbl9zZWNvbmRzGAsgASgNEgsKA2tleRgMIAEoCTqmArpIogIajAEKEXVwZGF0ZV9zb25nLnRpdGxlEhF0aXRsZSBpcyByZXF1aXJlZBpkIShzaXplKHRoaXMudXBkYXRlX21hc2sucGF0aHMpID09IDAgfHwgJ3RpdGxlJyBpbiB0aGlzLnVwZGF0ZV9tYXNrLnBhdGhzKSB8fCB0aGlzLnRpdGxlLnRyaW0oKSAhPSAnJxqQAQoSdXBkYXRlX3NvbmcuYXJ0aXN0EhJhcnRpc3QgaXMgcmVxdWlyZWQaZiEoc2l6ZSh0aGlzLnVwZGF0ZV9tYXNrLnBhdGhzKSA9PSAwIHx8ICdhcnRpc3QnIGluIHRoaXMudXBkYXRlX21hc2sucGF0aHMpIHx8IHRoaXMuYXJ0aXN0LnRyaW0oKSAhPSAnJyJCChZHZXRSZWxhdGVkU29uZ3NSZXF1ZXN0EhkKB3NvbmdfaWQYASABKAlCCLpIBXIDsAEBEg0KBWxpbWl0GAIgASgNIkUKF0dldFJlbGF0ZWRTb25nc1Jlc3BvbnNlEioKBXNvbmdzGAEgAygLMhsubXVzaWNjbHViLnNvbmcuUmVsYXRlZFNvbmcicwoLUmVsYXRlZFNvbmcSIgoEc29uZxgBIAEoCzIULm11c2ljY2x1Yi5zb25nLlNvbmcSEwoLc2FtZV9hcnRpc3QYAiABKAgSEwoLc2hhcmVkX3RhZ3MYAyADKAkSFgoOc2hhcmVkX21lbWJlcnMYBCABKAUiQwoRTWVyZ2VSb2xlc1JlcXVlc3QSFgoEZnJvbRgBIAEoCUIIukgFcgPAPgESFgoEaW50bxgCIAEoCUIIukgFcgPAPgEiKwoSTWVyZ2VSb2xlc1Jlc3BvbnNlEhUKDXNvbmdzX3VwZGF0ZWQYASABKAUiYwoVUmF0ZURpZmZpY3VsdHlSZXF1ZXN0EhkKB3NvbmdfaWQYASABKAlCCLpIBXIDsAEBEhYKBHJvbGUYAiABKAlCCLpIBXIDwD4BEhcKBnJhdGluZxgDIAEoDUIHukgEKgIYBSJECg9Kb2luUm9sZVJlcXVlc3QSGQoHc29uZ19pZBgBIAEoCUIIukgFcgOwAQESFgoEcm9sZRgCIAEoCUIIukgFcgPAPgEiRQoQTGVhdmVSb2xlUmVxdWVzdBIZCgdzb25nX2lkGAEgASgJQgi6SAVyA7ABARIWCgRyb2xlGAIgASgJQgi6SAVyA8A+ASqGAQoMU29uZ0xpbmtUeXBlEhoKFlNPTkdfTElOS19UWVBFX1VOS05PV04QABIaChZTT05HX0xJTktfVFlQRV9ZT1VUVUJFEAESHwobU09OR19MSU5LX1RZUEVfWUFOREVYX01VU0lDEAISHQoZU09OR19MSU5LX1RZUEVfU09VTkRDTE9VRBADMpoLCgtTb25nU2VydmljZRJQCglMaXN0U29uZ3MSIC5tdXNpY2NsdWIuc29uZy5MaXN0U29uZ3NSZXF1ZXN0GiEubXVzaWNjbHViLnNvbmcuTGlzdFNvbmdzUmVzcG9uc2USPgoHR2V0U29uZxIWLm11c2ljY2x1Yi5zb25nLlNvbmdJZBobLm11c2ljY2x1Yi5zb25nLlNvbmdEZXRhaWxzElwKDUJhdGNoR2V0U29uZ3MSJC5tdXNpY2NsdWIuc29uZy5CYXRjaEdldFNvbmdzUmVxdWVzdBolLm11c2ljY2x1Yi5zb25nLkJhdGNoR2V0U29uZ3NSZXNwb25zZRJMCgpDcmVhdGVTb25nEiEubXVzaWNjbHViLnNvbmcuQ3JlYXRlU29uZ1JlcXVlc3QaGy5tdXNpY2NsdWIuc29uZy5Tb25nRGV0YWlscxJMCgpVcGRhdGVTb25nEiEubXVzaWNjbHViLnNvbmcuVXBkYXRlU29uZ1JlcXVlc3QaGy5tdXNpY2NsdWIuc29uZy5Tb25nRGV0YWlscxI8CgpEZWxldGVTb25nEhYubXVzaWNjbHViLnNvbmcuU29uZ0lkGhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5EkgKCEpvaW5Sb2xlEh8ubXVzaWNjbHViLnNvbmcuSm9pblJvbGVSZXF1ZXN0GhsubXVzaWNjbHViLnNvbmcuU29uZ0RldGFpbHMSSgoJTGVhdmVSb2xlEiAubXVzaWNjbHViLnNvbmcuTGVhdmVSb2xlUmVxdWVzdBobLm11c2ljY2x1Yi5zb25nLlNvbmdEZXRhaWxzEkIKCVdhdGNoU29uZxIWLm11c2ljY2x1Yi5zb25nLlNvbmdJZBobLm11c2ljY2x1Yi5zb25nLlNvbmdEZXRhaWxzMAESQwoMRmF2b3JpdGVTb25nEhYubXVzaWNjbHViLnNvbmcuU29uZ0lkGhsubXVzaWNjbHViLnNvbmcuU29uZ0RldGFpbHMSRQoOVW5mYXZvcml0ZVNvbmcSFi5tdXNpY2NsdWIuc29uZy5Tb25nSWQaGy5tdXNpY2NsdWIuc29uZy5Tb25nRGV0YWlscxI+CgdQaW5Tb25nEhYubXVzaWNjbHViLnNvbmcuU29uZ0lkGhsubXVzaWNjbHViLnNvbmcuU29uZ0RldGFpbHMSQAoJVW5waW5Tb25nEhYubXVzaWNjbHViLnNvbmcuU29uZ0lkGhsubXVzaWNjbHViLnNvbmcuU29uZ0RldGFpbHMSYgoPR2V0UmVsYXRlZFNvbmdzEiYubXVzaWNjbHViLnNvbmcuR2V0UmVsYXRlZFNvbmdzUmVxdWVzdBonLm11c2ljY2x1Yi5zb25nLkdldFJlbGF0ZWRTb25nc1Jlc3BvbnNlElMKCk1lcmdlUm9sZXMSIS5tdXNpY2NsdWIuc29uZy5NZXJnZVJvbGVzUmVxdWVzdBoiLm11c2ljY2x1Yi5zb25nLk1lcmdlUm9sZXNSZXNwb25zZRJUCg5SYXRlRGlmZmljdWx0eRIlLm11c2ljY2x1Yi5zb25nLlJhdGVEaWZmaWN1bHR5UmVxdWVzdBobLm11c2ljY2x1Yi5zb25nLlNvbmdEZXRhaWxzEkcKClVwbG9hZERlbW8SIS5tdXNpY2NsdWIuc29uZy5VcGxvYWREZW1vUmVxdWVzdBoULm11c2ljY2x1Yi5zb25nLkRlbW8oARI8CgpEZWxldGVEZW1vEhYubXVzaWNjbHViLnNvbmcuRGVtb0lkGhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5EkMKEUZvcndhcmREZW1vVG9DaGF0EhYubXVzaWNjbHViLnNvbmcuRGVtb0lkGhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5QhxaGm11c2ljY2x1YmJvdC9iYWNrZW5kL3Byb3RvYgZwcm90bzM", [file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp, file_buf_validate_validate, file_user, file_permissions, file_validation]);
 
 /**
  * @generated from message musicclub.song.ListSongsRequest
@@ -40,6 +42,20 @@ export type ListSongsRequest = Message<"musicclub.song.ListSongsRequest"> & {
    * @generated from field: uint32 page_size = 3;
    */
   pageSize: number;
+
+  /**
+   * Only return songs the current user marked as favorite.
+   *
+   * @generated from field: bool favorites_only = 4;
+   */
+  favoritesOnly: boolean;
+
+  /**
+   * Only return songs rated at most this hard (1-5); unrated songs are kept.
+   *
+   * @generated from field: uint32 max_difficulty = 5;
+   */
+  maxDifficulty: number;
 };
 
 /**
@@ -88,6 +104,44 @@ export type SongId = Message<"musicclub.song.SongId"> & {
 export const SongIdSchema: GenMessage<SongId> = /*@__PURE__*/
   messageDesc(file_song, 2);
 
+/**
+ * @generated from message musicclub.song.BatchGetSongsRequest
+ */
+export type BatchGetSongsRequest = Message<"musicclub.song.BatchGetSongsRequest"> & {
+  /**
+   * Song ids to load, at most 100; unknown ids are skipped.
+   *
+   * @generated from field: repeated string ids = 1;
+   */
+  ids: string[];
+};
+
+/**
+ * Describes the message musicclub.song.BatchGetSongsRequest.
+ * Use `create(BatchGetSongsRequestSchema)` to create a new message.
+ */
+export const BatchGetSongsRequestSchema: GenMessage<BatchGetSongsRequest> = /*@__PURE__*/
+  messageDesc(file_song, 3);
+
+/**
+ * @generated from message musicclub.song.BatchGetSongsResponse
+ */
+export type BatchGetSongsResponse = Message<"musicclub.song.BatchGetSongsResponse"> & {
+  /**
+   * Songs in the order they were requested.
+   *
+   * @generated from field: repeated musicclub.song.SongDetails songs = 1;
+   */
+  songs: SongDetails[];
+};
+
+/**
+ * Describes the message musicclub.song.BatchGetSongsResponse.
+ * Use `create(BatchGetSongsResponseSchema)` to create a new message.
+ */
+export const BatchGetSongsResponseSchema: GenMessage<BatchGetSongsResponse> = /*@__PURE__*/
+  messageDesc(file_song, 4);
+
 /**
  * @generated from message musicclub.song.Song
  */
@@ -142,6 +196,55 @@ export type Song = Message<"musicclub.song.Song"> & {
    * @generated from field: string thumbnail_url = 9;
    */
   thumbnailUrl: string;
+
+  /**
+   * Row version, incremented on every update.
+   *
+   * @generated from field: int64 version = 10;
+   */
+  version: bigint;
+
+  /**
+   * Whether current user marked this song as favorite.
+   *
+   * @generated from field: bool is_favorite = 11;
+   */
+  isFavorite: boolean;
+
+  /**
+   * Whether the song is pinned to the top of the catalog.
+   *
+   * @generated from field: bool is_pinned = 12;
+   */
+  isPinned: boolean;
+
+  /**
+   * Lowercase free-form tags (genre, era, mood).
+   *
+   * @generated from field: repeated string tags = 13;
+   */
+  tags: string[];
+
+  /**
+   * Average difficulty rating (1-5) across roles, 0 if nobody rated yet.
+   *
+   * @generated from field: double difficulty = 14;
+   */
+  difficulty: number;
+
+  /**
+   * Typical length in seconds, 0 if unknown; tracklists use it by default.
+   *
+   * @generated from field: uint32 duration_seconds = 15;
+   */
+  durationSeconds: number;
+
+  /**
+   * Musical key the club plays the song in, e.g. "Am".
+   *
+   * @generated from field: string key = 16;
+   */
+  key: string;
 };
 
 /**
@@ -149,7 +252,7 @@ export type Song = Message<"musicclub.song.Song"> & {
  * Use `create(SongSchema)` to create a new message.
  */
 export const SongSchema: GenMessage<Song> = /*@__PURE__*/
-  messageDesc(file_song, 3);
+  messageDesc(file_song, 5);
 
 /**
  * @generated from message musicclub.song.SongDetails
@@ -169,6 +272,18 @@ export type SongDetails = Message<"musicclub.song.SongDetails"> & {
    * @generated from field: musicclub.permissions.PermissionSet permissions = 3;
    */
   permissions?: PermissionSet;
+
+  /**
+   * @generated from field: repeated musicclub.song.RoleDifficulty role_difficulty = 4;
+   */
+  roleDifficulty: RoleDifficulty[];
+
+  /**
+   * Demo recordings, newest first.
+   *
+   * @generated from field: repeated musicclub.song.Demo demos = 5;
+   */
+  demos: Demo[];
 };
 
 /**
@@ -176,7 +291,170 @@ export type SongDetails = Message<"musicclub.song.SongDetails"> & {
  * Use `create(SongDetailsSchema)` to create a new message.
  */
 export const SongDetailsSchema: GenMessage<SongDetails> = /*@__PURE__*/
-  messageDesc(file_song, 4);
+  messageDesc(file_song, 6);
+
+/**
+ * @generated from message musicclub.song.Demo
+ */
+export type Demo = Message<"musicclub.song.Demo"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string song_id = 2;
+   */
+  songId: string;
+
+  /**
+   * @generated from field: string title = 3;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string content_type = 4;
+   */
+  contentType: string;
+
+  /**
+   * @generated from field: int64 size_bytes = 5;
+   */
+  sizeBytes: bigint;
+
+  /**
+   * @generated from field: musicclub.user.User uploaded_by = 6;
+   */
+  uploadedBy?: User;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * Direct download URL.
+   *
+   * @generated from field: string url = 8;
+   */
+  url: string;
+};
+
+/**
+ * Describes the message musicclub.song.Demo.
+ * Use `create(DemoSchema)` to create a new message.
+ */
+export const DemoSchema: GenMessage<Demo> = /*@__PURE__*/
+  messageDesc(file_song, 7);
+
+/**
+ * @generated from message musicclub.song.DemoId
+ */
+export type DemoId = Message<"musicclub.song.DemoId"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message musicclub.song.DemoId.
+ * Use `create(DemoIdSchema)` to create a new message.
+ */
+export const DemoIdSchema: GenMessage<DemoId> = /*@__PURE__*/
+  messageDesc(file_song, 8);
+
+/**
+ * @generated from message musicclub.song.DemoMetadata
+ */
+export type DemoMetadata = Message<"musicclub.song.DemoMetadata"> & {
+  /**
+   * @generated from field: string song_id = 1;
+   */
+  songId: string;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * Must be an audio/* MIME type.
+   *
+   * @generated from field: string content_type = 3;
+   */
+  contentType: string;
+};
+
+/**
+ * Describes the message musicclub.song.DemoMetadata.
+ * Use `create(DemoMetadataSchema)` to create a new message.
+ */
+export const DemoMetadataSchema: GenMessage<DemoMetadata> = /*@__PURE__*/
+  messageDesc(file_song, 9);
+
+/**
+ * @generated from message musicclub.song.UploadDemoRequest
+ */
+export type UploadDemoRequest = Message<"musicclub.song.UploadDemoRequest"> & {
+  /**
+   * @generated from oneof musicclub.song.UploadDemoRequest.payload
+   */
+  payload: {
+    /**
+     * @generated from field: musicclub.song.DemoMetadata metadata = 1;
+     */
+    value: DemoMetadata;
+    case: "metadata";
+  } | {
+    /**
+     * @generated from field: bytes chunk = 2;
+     */
+    value: Uint8Array;
+    case: "chunk";
+  } | { case: undefined; value?: undefined };
+};
+
+/**
+ * Describes the message musicclub.song.UploadDemoRequest.
+ * Use `create(UploadDemoRequestSchema)` to create a new message.
+ */
+export const UploadDemoRequestSchema: GenMessage<UploadDemoRequest> = /*@__PURE__*/
+  messageDesc(file_song, 10);
+
+/**
+ * @generated from message musicclub.song.RoleDifficulty
+ */
+export type RoleDifficulty = Message<"musicclub.song.RoleDifficulty"> & {
+  /**
+   * @generated from field: string role = 1;
+   */
+  role: string;
+
+  /**
+   * @generated from field: double average = 2;
+   */
+  average: number;
+
+  /**
+   * @generated from field: int32 ratings = 3;
+   */
+  ratings: number;
+
+  /**
+   * Current user's rating for this role, 0 if not rated.
+   *
+   * @generated from field: uint32 my_rating = 4;
+   */
+  myRating: number;
+};
+
+/**
+ * Describes the message musicclub.song.RoleDifficulty.
+ * Use `create(RoleDifficultySchema)` to create a new message.
+ */
+export const RoleDifficultySchema: GenMessage<RoleDifficulty> = /*@__PURE__*/
+  messageDesc(file_song, 11);
 
 /**
  * @generated from message musicclub.song.SongLink
@@ -198,7 +476,7 @@ export type SongLink = Message<"musicclub.song.SongLink"> & {
  * Use `create(SongLinkSchema)` to create a new message.
  */
 export const SongLinkSchema: GenMessage<SongLink> = /*@__PURE__*/
-  messageDesc(file_song, 5);
+  messageDesc(file_song, 12);
 
 /**
  * @generated from message musicclub.song.RoleAssignment
@@ -225,7 +503,7 @@ export type RoleAssignment = Message<"musicclub.song.RoleAssignment"> & {
  * Use `create(RoleAssignmentSchema)` to create a new message.
  */
 export const RoleAssignmentSchema: GenMessage<RoleAssignment> = /*@__PURE__*/
-  messageDesc(file_song, 6);
+  messageDesc(file_song, 13);
 
 /**
  * @generated from message musicclub.song.CreateSongRequest
@@ -260,6 +538,21 @@ export type CreateSongRequest = Message<"musicclub.song.CreateSongRequest"> & {
    * @generated from field: string thumbnail_url = 6;
    */
   thumbnailUrl: string;
+
+  /**
+   * @generated from field: repeated string tags = 7;
+   */
+  tags: string[];
+
+  /**
+   * @generated from field: uint32 duration_seconds = 8;
+   */
+  durationSeconds: number;
+
+  /**
+   * @generated from field: string key = 9;
+   */
+  key: string;
 };
 
 /**
@@ -267,7 +560,7 @@ export type CreateSongRequest = Message<"musicclub.song.CreateSongRequest"> & {
  * Use `create(CreateSongRequestSchema)` to create a new message.
  */
 export const CreateSongRequestSchema: GenMessage<CreateSongRequest> = /*@__PURE__*/
-  messageDesc(file_song, 7);
+  messageDesc(file_song, 14);
 
 /**
  * @generated from message musicclub.song.UpdateSongRequest
@@ -307,6 +600,38 @@ export type UpdateSongRequest = Message<"musicclub.song.UpdateSongRequest"> & {
    * @generated from field: string thumbnail_url = 7;
    */
   thumbnailUrl: string;
+
+  /**
+   * Fields to overwrite (title, artist, link, description, available_roles,
+   * thumbnail_url, tags, duration_seconds, key). Empty mask replaces all of
+   * them except tags, duration_seconds and key. A thumbnail taken from the
+   * old link follows a new link unless thumbnail_url is set as well.
+   *
+   * @generated from field: google.protobuf.FieldMask update_mask = 8;
+   */
+  updateMask?: FieldMask;
+
+  /**
+   * Version the client last saw; mismatch fails with ABORTED.
+   *
+   * @generated from field: int64 expected_version = 9;
+   */
+  expectedVersion: bigint;
+
+  /**
+   * @generated from field: repeated string tags = 10;
+   */
+  tags: string[];
+
+  /**
+   * @generated from field: uint32 duration_seconds = 11;
+   */
+  durationSeconds: number;
+
+  /**
+   * @generated from field: string key = 12;
+   */
+  key: string;
 };
 
 /**
@@ -314,7 +639,156 @@ export type UpdateSongRequest = Message<"musicclub.song.UpdateSongRequest"> & {
  * Use `create(UpdateSongRequestSchema)` to create a new message.
  */
 export const UpdateSongRequestSchema: GenMessage<UpdateSongRequest> = /*@__PURE__*/
-  messageDesc(file_song, 8);
+  messageDesc(file_song, 15);
+
+/**
+ * @generated from message musicclub.song.GetRelatedSongsRequest
+ */
+export type GetRelatedSongsRequest = Message<"musicclub.song.GetRelatedSongsRequest"> & {
+  /**
+   * @generated from field: string song_id = 1;
+   */
+  songId: string;
+
+  /**
+   * Defaults to 10; larger values are capped at 50.
+   *
+   * @generated from field: uint32 limit = 2;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message musicclub.song.GetRelatedSongsRequest.
+ * Use `create(GetRelatedSongsRequestSchema)` to create a new message.
+ */
+export const GetRelatedSongsRequestSchema: GenMessage<GetRelatedSongsRequest> = /*@__PURE__*/
+  messageDesc(file_song, 16);
+
+/**
+ * @generated from message musicclub.song.GetRelatedSongsResponse
+ */
+export type GetRelatedSongsResponse = Message<"musicclub.song.GetRelatedSongsResponse"> & {
+  /**
+   * @generated from field: repeated musicclub.song.RelatedSong songs = 1;
+   */
+  songs: RelatedSong[];
+};
+
+/**
+ * Describes the message musicclub.song.GetRelatedSongsResponse.
+ * Use `create(GetRelatedSongsResponseSchema)` to create a new message.
+ */
+export const GetRelatedSongsResponseSchema: GenMessage<GetRelatedSongsResponse> = /*@__PURE__*/
+  messageDesc(file_song, 17);
+
+/**
+ * @generated from message musicclub.song.RelatedSong
+ */
+export type RelatedSong = Message<"musicclub.song.RelatedSong"> & {
+  /**
+   * @generated from field: musicclub.song.Song song = 1;
+   */
+  song?: Song;
+
+  /**
+   * Why the song was suggested.
+   *
+   * @generated from field: bool same_artist = 2;
+   */
+  sameArtist: boolean;
+
+  /**
+   * @generated from field: repeated string shared_tags = 3;
+   */
+  sharedTags: string[];
+
+  /**
+   * @generated from field: int32 shared_members = 4;
+   */
+  sharedMembers: number;
+};
+
+/**
+ * Describes the message musicclub.song.RelatedSong.
+ * Use `create(RelatedSongSchema)` to create a new message.
+ */
+export const RelatedSongSchema: GenMessage<RelatedSong> = /*@__PURE__*/
+  messageDesc(file_song, 18);
+
+/**
+ * @generated from message musicclub.song.MergeRolesRequest
+ */
+export type MergeRolesRequest = Message<"musicclub.song.MergeRolesRequest"> & {
+  /**
+   * Role name to get rid of, matched case-insensitively.
+   *
+   * @generated from field: string from = 1;
+   */
+  from: string;
+
+  /**
+   * Canonical role name to keep.
+   *
+   * @generated from field: string into = 2;
+   */
+  into: string;
+};
+
+/**
+ * Describes the message musicclub.song.MergeRolesRequest.
+ * Use `create(MergeRolesRequestSchema)` to create a new message.
+ */
+export const MergeRolesRequestSchema: GenMessage<MergeRolesRequest> = /*@__PURE__*/
+  messageDesc(file_song, 19);
+
+/**
+ * @generated from message musicclub.song.MergeRolesResponse
+ */
+export type MergeRolesResponse = Message<"musicclub.song.MergeRolesResponse"> & {
+  /**
+   * Number of songs whose roles were rewritten.
+   *
+   * @generated from field: int32 songs_updated = 1;
+   */
+  songsUpdated: number;
+};
+
+/**
+ * Describes the message musicclub.song.MergeRolesResponse.
+ * Use `create(MergeRolesResponseSchema)` to create a new message.
+ */
+export const MergeRolesResponseSchema: GenMessage<MergeRolesResponse> = /*@__PURE__*/
+  messageDesc(file_song, 20);
+
+/**
+ * @generated from message musicclub.song.RateDifficultyRequest
+ */
+export type RateDifficultyRequest = Message<"musicclub.song.RateDifficultyRequest"> & {
+  /**
+   * @generated from field: string song_id = 1;
+   */
+  songId: string;
+
+  /**
+   * @generated from field: string role = 2;
+   */
+  role: string;
+
+  /**
+   * 1-5, or 0 to clear the rating.
+   *
+   * @generated from field: uint32 rating = 3;
+   */
+  rating: number;
+};
+
+/**
+ * Describes the message musicclub.song.RateDifficultyRequest.
+ * Use `create(RateDifficultyRequestSchema)` to create a new message.
+ */
+export const RateDifficultyRequestSchema: GenMessage<RateDifficultyRequest> = /*@__PURE__*/
+  messageDesc(file_song, 21);
 
 /**
  * @generated from message musicclub.song.JoinRoleRequest
@@ -336,7 +810,7 @@ export type JoinRoleRequest = Message<"musicclub.song.JoinRoleRequest"> & {
  * Use `create(JoinRoleRequestSchema)` to create a new message.
  */
 export const JoinRoleRequestSchema: GenMessage<JoinRoleRequest> = /*@__PURE__*/
-  messageDesc(file_song, 9);
+  messageDesc(file_song, 22);
 
 /**
  * @generated from message musicclub.song.LeaveRoleRequest
@@ -358,7 +832,7 @@ export type LeaveRoleRequest = Message<"musicclub.song.LeaveRoleRequest"> & {
  * Use `create(LeaveRoleRequestSchema)` to create a new message.
  */
 export const LeaveRoleRequestSchema: GenMessage<LeaveRoleRequest> = /*@__PURE__*/
-  messageDesc(file_song, 10);
+  messageDesc(file_song, 23);
 
 /**
  * @generated from enum musicclub.song.SongLinkType
@@ -417,6 +891,16 @@ export const SongService: GenService<{
     input: typeof SongIdSchema;
     output: typeof SongDetailsSchema;
   },
+  /**
+   * Returns several songs with full metadata and assignments in one call.
+   *
+   * @generated from rpc musicclub.song.SongService.BatchGetSongs
+   */
+  batchGetSongs: {
+    methodKind: "unary";
+    input: typeof BatchGetSongsRequestSchema;
+    output: typeof BatchGetSongsResponseSchema;
+  },
   /**
    * Create songs (requires permissions).
    *
@@ -467,6 +951,120 @@ export const SongService: GenService<{
     input: typeof LeaveRoleRequestSchema;
     output: typeof SongDetailsSchema;
   },
+  /**
+   * Streams song details: the current state first, then again on every
+   * change of the song, its roles or assignments.
+   *
+   * @generated from rpc musicclub.song.SongService.WatchSong
+   */
+  watchSong: {
+    methodKind: "server_streaming";
+    input: typeof SongIdSchema;
+    output: typeof SongDetailsSchema;
+  },
+  /**
+   * Add a song to the current user's favorites.
+   *
+   * @generated from rpc musicclub.song.SongService.FavoriteSong
+   */
+  favoriteSong: {
+    methodKind: "unary";
+    input: typeof SongIdSchema;
+    output: typeof SongDetailsSchema;
+  },
+  /**
+   * Remove a song from the current user's favorites.
+   *
+   * @generated from rpc musicclub.song.SongService.UnfavoriteSong
+   */
+  unfavoriteSong: {
+    methodKind: "unary";
+    input: typeof SongIdSchema;
+    output: typeof SongDetailsSchema;
+  },
+  /**
+   * Pin a song to the top of the catalog (requires edit_events).
+   *
+   * @generated from rpc musicclub.song.SongService.PinSong
+   */
+  pinSong: {
+    methodKind: "unary";
+    input: typeof SongIdSchema;
+    output: typeof SongDetailsSchema;
+  },
+  /**
+   * Unpin a song (requires edit_events).
+   *
+   * @generated from rpc musicclub.song.SongService.UnpinSong
+   */
+  unpinSong: {
+    methodKind: "unary";
+    input: typeof SongIdSchema;
+    output: typeof SongDetailsSchema;
+  },
+  /**
+   * Suggests songs by the same artist, with shared tags or shared lineups.
+   *
+   * @generated from rpc musicclub.song.SongService.GetRelatedSongs
+   */
+  getRelatedSongs: {
+    methodKind: "unary";
+    input: typeof GetRelatedSongsRequestSchema;
+    output: typeof GetRelatedSongsResponseSchema;
+  },
+  /**
+   * Folds one role spelling into another across songs, difficulty ratings,
+   * participants and the roles events require, and remembers it as an alias
+   * (requires edit_any_songs).
+   *
+   * @generated from rpc musicclub.song.SongService.MergeRoles
+   */
+  mergeRoles: {
+    methodKind: "unary";
+    input: typeof MergeRolesRequestSchema;
+    output: typeof MergeRolesResponseSchema;
+  },
+  /**
+   * Rate how hard a song is on your instrument (only for role participants).
+   *
+   * @generated from rpc musicclub.song.SongService.RateDifficulty
+   */
+  rateDifficulty: {
+    methodKind: "unary";
+    input: typeof RateDifficultyRequestSchema;
+    output: typeof SongDetailsSchema;
+  },
+  /**
+   * Upload a short demo recording. The first message carries metadata, the
+   * rest carry audio chunks (song editors and participants only).
+   *
+   * @generated from rpc musicclub.song.SongService.UploadDemo
+   */
+  uploadDemo: {
+    methodKind: "client_streaming";
+    input: typeof UploadDemoRequestSchema;
+    output: typeof DemoSchema;
+  },
+  /**
+   * Delete a demo recording (uploader or song editors).
+   *
+   * @generated from rpc musicclub.song.SongService.DeleteDemo
+   */
+  deleteDemo: {
+    methodKind: "unary";
+    input: typeof DemoIdSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * Post a demo recording to the club Telegram chat.
+   *
+   * @generated from rpc musicclub.song.SongService.ForwardDemoToChat
+   */
+  forwardDemoToChat: {
+    methodKind: "unary";
+    input: typeof DemoIdSchema;
+    output: typeof EmptySchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_song, 0);
 
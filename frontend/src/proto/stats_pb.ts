@@ -0,0 +1,631 @@
+// @generated by protoc-gen-es v2.10.2 with parameter "target=ts,import_extension=ts"
+// @generated from file stats.proto (package musicclub.stats, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "./buf/validate/validate_pb.ts";
+import type { EmptySchema, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_empty, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { User } from "./user_pb.ts";
+import { file_user } from "./user_pb.ts";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file stats.proto.
+ */
+export const file_stats: GenFile = /*@__PURE__*/
+  fileDesc("CgtzdGF0cy5wcm90bxIPbXVzaWNjbHViLnN0YXRzIr8BChVHZXRMZWFkZXJib2FyZFJlcXVlc3QSKAoEZnJvbRgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASJgoCdG8YAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhEKCXNlYXNvbl9pZBgDIAEoCRIyCgZtZXRyaWMYBCABKA4yIi5tdXNpY2NsdWIuc3RhdHMuTGVhZGVyYm9hcmRNZXRyaWMSDQoFbGltaXQYBSABKA0iiwEKEExlYWRlcmJvYXJkRW50cnkSDAoEcmFuaxgBIAEoDRIiCgR1c2VyGAIgASgLMhQubXVzaWNjbHViLnVzZXIuVXNlchIUCgxzb25nc19qb2luZWQYAyABKAUSFwoPZXZlbnRzX2F0dGVuZGVkGAQgASgFEhYKDnNvbmdzX3Byb3Bvc2VkGAUgASgFIpMBCgtMZWFkZXJib2FyZBIyCgdlbnRyaWVzGAEgAygLMiEubXVzaWNjbHViLnN0YXRzLkxlYWRlcmJvYXJkRW50cnkSKAoEZnJvbRgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASJgoCdG8YAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wItoBChRMaXN0U29uZ1N0YXRzUmVxdWVzdBIoCgRmcm9tGAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBImCgJ0bxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEQoJc2Vhc29uX2lkGAMgASgJEiwKBHNvcnQYBCABKA4yHi5tdXNpY2NsdWIuc3RhdHMuU29uZ1N0YXRzU29ydBISCgpwYWdlX3Rva2VuGAUgASgJEhsKCXBhZ2Vfc2l6ZRgGIAEoDUIIukgFKgMYyAEi2QEKCFNvbmdTdGF0Eg8KB3NvbmdfaWQYASABKAkSDQoFdGl0bGUYAiABKAkSDgoGYXJ0aXN0GAMgASgJEhcKD3RpbWVzX3BlcmZvcm1lZBgEIAEoBRIbChN1bmlxdWVfcGFydGljaXBhbnRzGAUgASgFEg0KBXZvdGVzGAYgASgFEjUKEWxhc3RfcGVyZm9ybWVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIhChlkYXlzX3NpbmNlX2xhc3RfcGVyZm9ybWVkGAggASgFIm8KFUxpc3RTb25nU3RhdHNSZXNwb25zZRIoCgVzb25ncxgBIAMoCzIZLm11c2ljY2x1Yi5zdGF0cy5Tb25nU3RhdBIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkSEwoLdG90YWxfY291bnQYAyABKAUigAEKGUdldEF0dGVuZGFuY2VTdGF0c1JlcXVlc3QSKAoEZnJvbRgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASJgoCdG8YAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhEKCXNlYXNvbl9pZBgDIAEoCSLcAQoPRXZlbnRBdHRlbmRhbmNlEhAKCGV2ZW50X2lkGAEgASgJEg0KBXRpdGxlGAIgASgJEiwKCHN0YXJ0X2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBINCgVnb2luZxgEIAEoBRINCgVtYXliZRgFIAEoBRIQCghkZWNsaW5lZBgGIAEoBRISCgp3YWl0bGlzdGVkGAcgASgFEhIKCmNoZWNrZWRfaW4YCCABKAUSEAoIbm9fc2hvd3MYCSABKAUSEAoId2Fsa19pbnMYCiABKAUiZgoRTW9udGhseUF0dGVuZGFuY2USDQoFbW9udGgYASABKAkSDgoGZXZlbnRzGAIgASgFEg0KBWdvaW5nGAMgASgFEhIKCmNoZWNrZWRfaW4YBCABKAUSDwoHdHVybm91dBgFIAEoASKrAQoPQXR0ZW5kYW5jZVN0YXRzEjAKBmV2ZW50cxgBIAMoCzIgLm11c2ljY2x1Yi5zdGF0cy5FdmVudEF0dGVuZGFuY2USMgoGbW9udGhzGAIgAygLMiIubXVzaWNjbHViLnN0YXRzLk1vbnRobHlBdHRlbmRhbmNlEg0KBWdvaW5nGAMgASgFEhIKCmNoZWNrZWRfaW4YBCABKAUSDwoHdHVybm91dBgFIAEoASKaAQoHTXlTdGF0cxIWCg5zb25nc19wcm9wb3NlZBgBIAEoBRISCgpyb2xlc19oZWxkGAIgASgFEhEKCXNlYXNvbl9pZBgDIAEoCRITCgtzZWFzb25fbmFtZRgEIAEoCRIjChtldmVudHNfYXR0ZW5kZWRfdGhpc19zZWFzb24YBSABKAUSFgoOY3VycmVudF9zdHJlYWsYBiABKAUqqwEKEUxlYWRlcmJvYXJkTWV0cmljEiIKHkxFQURFUkJPQVJEX01FVFJJQ19VTlNQRUNJRklFRBAAEiMKH0xFQURFUkJPQVJEX01FVFJJQ19TT05HU19KT0lORUQQARImCiJMRUFERVJCT0FSRF9NRVRSSUNfRVZFTlRTX0FUVEVOREVEEAISJQohTEVBREVSQk9BUkRfTUVUUklDX1NPTkdTX1BST1BPU0VEEAMqswEKDVNvbmdTdGF0c1NvcnQSHwobU09OR19TVEFUU19TT1JUX1VOU1BFQ0lGSUVEEAASGQoVU09OR19TVEFUU19TT1JUX1ZPVEVTEAESIAocU09OR19TVEFUU19TT1JUX1BBUlRJQ0lQQU5UUxACEiIKHlNPTkdfU1RBVFNfU09SVF9MQVNUX1BFUkZPUk1FRBADEiAKHFNPTkdfU1RBVFNfU09SVF9MRUFTVF9SRUNFTlQQBDLqAgoMU3RhdHNTZXJ2aWNlElYKDkdldExlYWRlcmJvYXJkEiYubXVzaWNjbHViLnN0YXRzLkdldExlYWRlcmJvYXJkUmVxdWVzdBocLm11c2ljY2x1Yi5zdGF0cy5MZWFkZXJib2FyZBJeCg1MaXN0U29uZ1N0YXRzEiUubXVzaWNjbHViLnN0YXRzLkxpc3RTb25nU3RhdHNSZXF1ZXN0GiYubXVzaWNjbHViLnN0YXRzLkxpc3RTb25nU3RhdHNSZXNwb25zZRJiChJHZXRBdHRlbmRhbmNlU3RhdHMSKi5tdXNpY2NsdWIuc3RhdHMuR2V0QXR0ZW5kYW5jZVN0YXRzUmVxdWVzdBogLm11c2ljY2x1Yi5zdGF0cy5BdHRlbmRhbmNlU3RhdHMSPgoKR2V0TXlTdGF0cxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRoYLm11c2ljY2x1Yi5zdGF0cy5NeVN0YXRzQhxaGm11c2ljY2x1YmJvdC9iYWNrZW5kL3Byb3RvYgZwcm90bzM", [file_buf_validate_validate, file_google_protobuf_empty, file_google_protobuf_timestamp, file_user]);
+
+/**
+ * @generated from message musicclub.stats.GetLeaderboardRequest
+ */
+export type GetLeaderboardRequest = Message<"musicclub.stats.GetLeaderboardRequest"> & {
+  /**
+   * Range start; unset counts from the beginning. Ranges are counted in
+   * whole UTC days.
+   *
+   * @generated from field: google.protobuf.Timestamp from = 1;
+   */
+  from?: Timestamp;
+
+  /**
+   * Range end (exclusive); unset counts up to now.
+   *
+   * @generated from field: google.protobuf.Timestamp to = 2;
+   */
+  to?: Timestamp;
+
+  /**
+   * Takes the range from the season instead of from/to.
+   *
+   * @generated from field: string season_id = 3;
+   */
+  seasonId: string;
+
+  /**
+   * @generated from field: musicclub.stats.LeaderboardMetric metric = 4;
+   */
+  metric: LeaderboardMetric;
+
+  /**
+   * Defaults to 10; larger values are capped at 100.
+   *
+   * @generated from field: uint32 limit = 5;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message musicclub.stats.GetLeaderboardRequest.
+ * Use `create(GetLeaderboardRequestSchema)` to create a new message.
+ */
+export const GetLeaderboardRequestSchema: GenMessage<GetLeaderboardRequest> = /*@__PURE__*/
+  messageDesc(file_stats, 0);
+
+/**
+ * @generated from message musicclub.stats.LeaderboardEntry
+ */
+export type LeaderboardEntry = Message<"musicclub.stats.LeaderboardEntry"> & {
+  /**
+   * 1-based; members with equal scores share a rank.
+   *
+   * @generated from field: uint32 rank = 1;
+   */
+  rank: number;
+
+  /**
+   * @generated from field: musicclub.user.User user = 2;
+   */
+  user?: User;
+
+  /**
+   * Distinct songs the member took a role in.
+   *
+   * @generated from field: int32 songs_joined = 3;
+   */
+  songsJoined: number;
+
+  /**
+   * Past events the member performed at or checked in to.
+   *
+   * @generated from field: int32 events_attended = 4;
+   */
+  eventsAttended: number;
+
+  /**
+   * Songs the member added to the catalog.
+   *
+   * @generated from field: int32 songs_proposed = 5;
+   */
+  songsProposed: number;
+};
+
+/**
+ * Describes the message musicclub.stats.LeaderboardEntry.
+ * Use `create(LeaderboardEntrySchema)` to create a new message.
+ */
+export const LeaderboardEntrySchema: GenMessage<LeaderboardEntry> = /*@__PURE__*/
+  messageDesc(file_stats, 1);
+
+/**
+ * @generated from message musicclub.stats.Leaderboard
+ */
+export type Leaderboard = Message<"musicclub.stats.Leaderboard"> & {
+  /**
+   * @generated from field: repeated musicclub.stats.LeaderboardEntry entries = 1;
+   */
+  entries: LeaderboardEntry[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp from = 2;
+   */
+  from?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp to = 3;
+   */
+  to?: Timestamp;
+};
+
+/**
+ * Describes the message musicclub.stats.Leaderboard.
+ * Use `create(LeaderboardSchema)` to create a new message.
+ */
+export const LeaderboardSchema: GenMessage<Leaderboard> = /*@__PURE__*/
+  messageDesc(file_stats, 2);
+
+/**
+ * @generated from message musicclub.stats.ListSongStatsRequest
+ */
+export type ListSongStatsRequest = Message<"musicclub.stats.ListSongStatsRequest"> & {
+  /**
+   * Limit times_performed and unique_participants to a range, as in
+   * GetLeaderboardRequest. Votes and last performance are all-time.
+   *
+   * @generated from field: google.protobuf.Timestamp from = 1;
+   */
+  from?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp to = 2;
+   */
+  to?: Timestamp;
+
+  /**
+   * @generated from field: string season_id = 3;
+   */
+  seasonId: string;
+
+  /**
+   * @generated from field: musicclub.stats.SongStatsSort sort = 4;
+   */
+  sort: SongStatsSort;
+
+  /**
+   * Pagination cursor (opaque to client).
+   *
+   * @generated from field: string page_token = 5;
+   */
+  pageToken: string;
+
+  /**
+   * @generated from field: uint32 page_size = 6;
+   */
+  pageSize: number;
+};
+
+/**
+ * Describes the message musicclub.stats.ListSongStatsRequest.
+ * Use `create(ListSongStatsRequestSchema)` to create a new message.
+ */
+export const ListSongStatsRequestSchema: GenMessage<ListSongStatsRequest> = /*@__PURE__*/
+  messageDesc(file_stats, 3);
+
+/**
+ * @generated from message musicclub.stats.SongStat
+ */
+export type SongStat = Message<"musicclub.stats.SongStat"> & {
+  /**
+   * @generated from field: string song_id = 1;
+   */
+  songId: string;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string artist = 3;
+   */
+  artist: string;
+
+  /**
+   * Tracklist items marked as performed.
+   *
+   * @generated from field: int32 times_performed = 4;
+   */
+  timesPerformed: number;
+
+  /**
+   * Distinct members who played it in those performances.
+   *
+   * @generated from field: int32 unique_participants = 5;
+   */
+  uniqueParticipants: number;
+
+  /**
+   * Members who favorited the song.
+   *
+   * @generated from field: int32 votes = 6;
+   */
+  votes: number;
+
+  /**
+   * Unset if the song was never performed.
+   *
+   * @generated from field: google.protobuf.Timestamp last_performed_at = 7;
+   */
+  lastPerformedAt?: Timestamp;
+
+  /**
+   * Whole days since last_performed_at; 0 when never performed.
+   *
+   * @generated from field: int32 days_since_last_performed = 8;
+   */
+  daysSinceLastPerformed: number;
+};
+
+/**
+ * Describes the message musicclub.stats.SongStat.
+ * Use `create(SongStatSchema)` to create a new message.
+ */
+export const SongStatSchema: GenMessage<SongStat> = /*@__PURE__*/
+  messageDesc(file_stats, 4);
+
+/**
+ * @generated from message musicclub.stats.ListSongStatsResponse
+ */
+export type ListSongStatsResponse = Message<"musicclub.stats.ListSongStatsResponse"> & {
+  /**
+   * @generated from field: repeated musicclub.stats.SongStat songs = 1;
+   */
+  songs: SongStat[];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+
+  /**
+   * @generated from field: int32 total_count = 3;
+   */
+  totalCount: number;
+};
+
+/**
+ * Describes the message musicclub.stats.ListSongStatsResponse.
+ * Use `create(ListSongStatsResponseSchema)` to create a new message.
+ */
+export const ListSongStatsResponseSchema: GenMessage<ListSongStatsResponse> = /*@__PURE__*/
+  messageDesc(file_stats, 5);
+
+/**
+ * @generated from message musicclub.stats.GetAttendanceStatsRequest
+ */
+export type GetAttendanceStatsRequest = Message<"musicclub.stats.GetAttendanceStatsRequest"> & {
+  /**
+   * Range of event start times, as in GetLeaderboardRequest.
+   *
+   * @generated from field: google.protobuf.Timestamp from = 1;
+   */
+  from?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp to = 2;
+   */
+  to?: Timestamp;
+
+  /**
+   * @generated from field: string season_id = 3;
+   */
+  seasonId: string;
+};
+
+/**
+ * Describes the message musicclub.stats.GetAttendanceStatsRequest.
+ * Use `create(GetAttendanceStatsRequestSchema)` to create a new message.
+ */
+export const GetAttendanceStatsRequestSchema: GenMessage<GetAttendanceStatsRequest> = /*@__PURE__*/
+  messageDesc(file_stats, 6);
+
+/**
+ * @generated from message musicclub.stats.EventAttendance
+ */
+export type EventAttendance = Message<"musicclub.stats.EventAttendance"> & {
+  /**
+   * @generated from field: string event_id = 1;
+   */
+  eventId: string;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_at = 3;
+   */
+  startAt?: Timestamp;
+
+  /**
+   * Final RSVP answers.
+   *
+   * @generated from field: int32 going = 4;
+   */
+  going: number;
+
+  /**
+   * @generated from field: int32 maybe = 5;
+   */
+  maybe: number;
+
+  /**
+   * @generated from field: int32 declined = 6;
+   */
+  declined: number;
+
+  /**
+   * @generated from field: int32 waitlisted = 7;
+   */
+  waitlisted: number;
+
+  /**
+   * @generated from field: int32 checked_in = 8;
+   */
+  checkedIn: number;
+
+  /**
+   * Answered going but never checked in.
+   *
+   * @generated from field: int32 no_shows = 9;
+   */
+  noShows: number;
+
+  /**
+   * Checked in without answering going.
+   *
+   * @generated from field: int32 walk_ins = 10;
+   */
+  walkIns: number;
+};
+
+/**
+ * Describes the message musicclub.stats.EventAttendance.
+ * Use `create(EventAttendanceSchema)` to create a new message.
+ */
+export const EventAttendanceSchema: GenMessage<EventAttendance> = /*@__PURE__*/
+  messageDesc(file_stats, 7);
+
+/**
+ * @generated from message musicclub.stats.MonthlyAttendance
+ */
+export type MonthlyAttendance = Message<"musicclub.stats.MonthlyAttendance"> & {
+  /**
+   * "2026-10", in the events' own timezones.
+   *
+   * @generated from field: string month = 1;
+   */
+  month: string;
+
+  /**
+   * @generated from field: int32 events = 2;
+   */
+  events: number;
+
+  /**
+   * @generated from field: int32 going = 3;
+   */
+  going: number;
+
+  /**
+   * @generated from field: int32 checked_in = 4;
+   */
+  checkedIn: number;
+
+  /**
+   * checked_in / going; 0 when nobody answered going.
+   *
+   * @generated from field: double turnout = 5;
+   */
+  turnout: number;
+};
+
+/**
+ * Describes the message musicclub.stats.MonthlyAttendance.
+ * Use `create(MonthlyAttendanceSchema)` to create a new message.
+ */
+export const MonthlyAttendanceSchema: GenMessage<MonthlyAttendance> = /*@__PURE__*/
+  messageDesc(file_stats, 8);
+
+/**
+ * @generated from message musicclub.stats.AttendanceStats
+ */
+export type AttendanceStats = Message<"musicclub.stats.AttendanceStats"> & {
+  /**
+   * Latest first.
+   *
+   * @generated from field: repeated musicclub.stats.EventAttendance events = 1;
+   */
+  events: EventAttendance[];
+
+  /**
+   * Oldest first.
+   *
+   * @generated from field: repeated musicclub.stats.MonthlyAttendance months = 2;
+   */
+  months: MonthlyAttendance[];
+
+  /**
+   * @generated from field: int32 going = 3;
+   */
+  going: number;
+
+  /**
+   * @generated from field: int32 checked_in = 4;
+   */
+  checkedIn: number;
+
+  /**
+   * @generated from field: double turnout = 5;
+   */
+  turnout: number;
+};
+
+/**
+ * Describes the message musicclub.stats.AttendanceStats.
+ * Use `create(AttendanceStatsSchema)` to create a new message.
+ */
+export const AttendanceStatsSchema: GenMessage<AttendanceStats> = /*@__PURE__*/
+  messageDesc(file_stats, 9);
+
+/**
+ * @generated from message musicclub.stats.MyStats
+ */
+export type MyStats = Message<"musicclub.stats.MyStats"> & {
+  /**
+   * Songs the user added to the catalog.
+   *
+   * @generated from field: int32 songs_proposed = 1;
+   */
+  songsProposed: number;
+
+  /**
+   * Song roles the user currently plays.
+   *
+   * @generated from field: int32 roles_held = 2;
+   */
+  rolesHeld: number;
+
+  /**
+   * The running season, if any: the latest one that has started and not
+   * ended.
+   *
+   * @generated from field: string season_id = 3;
+   */
+  seasonId: string;
+
+  /**
+   * @generated from field: string season_name = 4;
+   */
+  seasonName: string;
+
+  /**
+   * Past events of that season the user performed at or checked in to.
+   *
+   * @generated from field: int32 events_attended_this_season = 5;
+   */
+  eventsAttendedThisSeason: number;
+
+  /**
+   * Consecutive most recent club events the user attended.
+   *
+   * @generated from field: int32 current_streak = 6;
+   */
+  currentStreak: number;
+};
+
+/**
+ * Describes the message musicclub.stats.MyStats.
+ * Use `create(MyStatsSchema)` to create a new message.
+ */
+export const MyStatsSchema: GenMessage<MyStats> = /*@__PURE__*/
+  messageDesc(file_stats, 10);
+
+/**
+ * @generated from enum musicclub.stats.LeaderboardMetric
+ */
+export enum LeaderboardMetric {
+  /**
+   * All three metrics added up.
+   *
+   * @generated from enum value: LEADERBOARD_METRIC_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: LEADERBOARD_METRIC_SONGS_JOINED = 1;
+   */
+  SONGS_JOINED = 1,
+
+  /**
+   * @generated from enum value: LEADERBOARD_METRIC_EVENTS_ATTENDED = 2;
+   */
+  EVENTS_ATTENDED = 2,
+
+  /**
+   * @generated from enum value: LEADERBOARD_METRIC_SONGS_PROPOSED = 3;
+   */
+  SONGS_PROPOSED = 3,
+}
+
+/**
+ * Describes the enum musicclub.stats.LeaderboardMetric.
+ */
+export const LeaderboardMetricSchema: GenEnum<LeaderboardMetric> = /*@__PURE__*/
+  enumDesc(file_stats, 0);
+
+/**
+ * @generated from enum musicclub.stats.SongStatsSort
+ */
+export enum SongStatsSort {
+  /**
+   * Most performed first.
+   *
+   * @generated from enum value: SONG_STATS_SORT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SONG_STATS_SORT_VOTES = 1;
+   */
+  VOTES = 1,
+
+  /**
+   * @generated from enum value: SONG_STATS_SORT_PARTICIPANTS = 2;
+   */
+  PARTICIPANTS = 2,
+
+  /**
+   * Most recently performed first, never performed last.
+   *
+   * @generated from enum value: SONG_STATS_SORT_LAST_PERFORMED = 3;
+   */
+  LAST_PERFORMED = 3,
+
+  /**
+   * Longest rested first, never performed first of all.
+   *
+   * @generated from enum value: SONG_STATS_SORT_LEAST_RECENT = 4;
+   */
+  LEAST_RECENT = 4,
+}
+
+/**
+ * Describes the enum musicclub.stats.SongStatsSort.
+ */
+export const SongStatsSortSchema: GenEnum<SongStatsSort> = /*@__PURE__*/
+  enumDesc(file_stats, 1);
+
+/**
+ * Club statistics for the stats screen and season awards.
+ *
+ * @generated from service musicclub.stats.StatsService
+ */
+export const StatsService: GenService<{
+  /**
+   * Members ranked by participation within a time range. Figures come from
+   * a summary refreshed every few minutes, so they may lag slightly.
+   *
+   * @generated from rpc musicclub.stats.StatsService.GetLeaderboard
+   */
+  getLeaderboard: {
+    methodKind: "unary";
+    input: typeof GetLeaderboardRequestSchema;
+    output: typeof LeaderboardSchema;
+  },
+  /**
+   * Per-song popularity across the catalog, songs never played included.
+   *
+   * @generated from rpc musicclub.stats.StatsService.ListSongStats
+   */
+  listSongStats: {
+    methodKind: "unary";
+    input: typeof ListSongStatsRequestSchema;
+    output: typeof ListSongStatsResponseSchema;
+  },
+  /**
+   * RSVPs against check-ins for past events, per event and per month.
+   * Admins only.
+   *
+   * @generated from rpc musicclub.stats.StatsService.GetAttendanceStats
+   */
+  getAttendanceStats: {
+    methodKind: "unary";
+    input: typeof GetAttendanceStatsRequestSchema;
+    output: typeof AttendanceStatsSchema;
+  },
+  /**
+   * The current user's own numbers for the profile screen.
+   *
+   * @generated from rpc musicclub.stats.StatsService.GetMyStats
+   */
+  getMyStats: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof MyStatsSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_stats, 0);
+
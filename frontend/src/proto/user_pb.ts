@@ -2,15 +2,18 @@
 // @generated from file user.proto (package musicclub.user, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
-import { fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "./buf/validate/validate_pb.ts";
+import type { EmptySchema, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_empty, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file user.proto.
  */
 export const file_user: GenFile = /*@__PURE__*/
-  fileDesc("Cgp1c2VyLnByb3RvEg5tdXNpY2NsdWIudXNlciJjCgRVc2VyEgoKAmlkGAEgASgJEhQKDGRpc3BsYXlfbmFtZRgCIAEoCRIQCgh1c2VybmFtZRgDIAEoCRISCgphdmF0YXJfdXJsGAQgASgJEhMKC3RlbGVncmFtX2lkGAUgASgEQhxaGm11c2ljY2x1YmJvdC9iYWNrZW5kL3Byb3RvYgZwcm90bzM");
+  fileDesc("Cgp1c2VyLnByb3RvEg5tdXNpY2NsdWIudXNlciJjCgRVc2VyEgoKAmlkGAEgASgJEhQKDGRpc3BsYXlfbmFtZRgCIAEoCRIQCgh1c2VybmFtZRgDIAEoCRISCgphdmF0YXJfdXJsGAQgASgJEhMKC3RlbGVncmFtX2lkGAUgASgEIocBChJMaXN0TWVtYmVyc1JlcXVlc3QSDQoFcXVlcnkYASABKAkSEgoKaW5zdHJ1bWVudBgCIAEoCRIdCghncm91cF9pZBgFIAEoCUILukgIcgOwAQHYAQESEgoKcGFnZV90b2tlbhgDIAEoCRIbCglwYWdlX3NpemUYBCABKA1CCLpIBSoDGMgBInoKBk1lbWJlchIiCgR1c2VyGAEgASgLMhQubXVzaWNjbHViLnVzZXIuVXNlchITCgtpbnN0cnVtZW50cxgCIAMoCRISCgpzb25nX2NvdW50GAMgASgFEhMKC2V2ZW50X2NvdW50GAQgASgFEg4KBmdyb3VwcxgFIAMoCSJsChNMaXN0TWVtYmVyc1Jlc3BvbnNlEicKB21lbWJlcnMYASADKAsyFi5tdXNpY2NsdWIudXNlci5NZW1iZXISFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJEhMKC3RvdGFsX2NvdW50GAMgASgFIjIKFUdldFVzZXJQcm9maWxlUmVxdWVzdBIZCgd1c2VyX2lkGAEgASgJQgi6SAVyA7ABASJPCg9Qcm9maWxlU29uZ1JvbGUSDwoHc29uZ19pZBgBIAEoCRINCgV0aXRsZRgCIAEoCRIOCgZhcnRpc3QYAyABKAkSDAoEcm9sZRgEIAEoCSJsCgxQcm9maWxlRXZlbnQSEAoIZXZlbnRfaWQYASABKAkSDQoFdGl0bGUYAiABKAkSLAoIc3RhcnRfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg0KBXJvbGVzGAQgAygJIvQBCgtVc2VyUHJvZmlsZRIiCgR1c2VyGAEgASgLMhQubXVzaWNjbHViLnVzZXIuVXNlchILCgNiaW8YAiABKAkSFgoOaXNfY2hhdF9tZW1iZXIYAyABKAgSMAoMbWVtYmVyX3NpbmNlGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIzCgpzb25nX3JvbGVzGAUgAygLMh8ubXVzaWNjbHViLnVzZXIuUHJvZmlsZVNvbmdSb2xlEjUKD3VwY29taW5nX2V2ZW50cxgGIAMoCzIcLm11c2ljY2x1Yi51c2VyLlByb2ZpbGVFdmVudCKHAgoIQWN0aXZpdHkSCgoCaWQYASABKAkSIgoEdXNlchgCIAEoCzIULm11c2ljY2x1Yi51c2VyLlVzZXISKgoEa2luZBgDIAEoDjIcLm11c2ljY2x1Yi51c2VyLkFjdGl2aXR5S2luZBIPCgdzb25nX2lkGAQgASgJEhIKCnNvbmdfdGl0bGUYBSABKAkSEwoLc29uZ19hcnRpc3QYBiABKAkSEAoIZXZlbnRfaWQYByABKAkSEwoLZXZlbnRfdGl0bGUYCCABKAkSDgoGZGV0YWlsGAkgASgJEi4KCmNyZWF0ZWRfYXQYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wImQKE0xpc3RBY3Rpdml0eVJlcXVlc3QSHAoHdXNlcl9pZBgBIAEoCUILukgIcgOwAQHYAQESEgoKcGFnZV90b2tlbhgCIAEoCRIbCglwYWdlX3NpemUYAyABKA1CCLpIBSoDGMgBIl0KFExpc3RBY3Rpdml0eVJlc3BvbnNlEiwKCmFjdGl2aXRpZXMYASADKAsyGC5tdXNpY2NsdWIudXNlci5BY3Rpdml0eRIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkiRQoKRGF0YUV4cG9ydBIQCghmaWxlbmFtZRgBIAEoCRIPCgdjb250ZW50GAIgASgMEhQKDGNvbnRlbnRfdHlwZRgDIAEoCSJSChlEZWFjdGl2YXRlQWNjb3VudFJlc3BvbnNlEjUKEXJlYWN0aXZhdGVfYmVmb3JlGAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIzChJTZWFyY2hVc2Vyc1JlcXVlc3QSDgoGcHJlZml4GAEgASgJEg0KBWxpbWl0GAIgASgNIjoKE1NlYXJjaFVzZXJzUmVzcG9uc2USIwoFdXNlcnMYASADKAsyFC5tdXNpY2NsdWIudXNlci5Vc2VyIkoKFE5vdGlmaWNhdGlvblNldHRpbmdzEjIKBmRpZ2VzdBgBIAEoDjIiLm11c2ljY2x1Yi51c2VyLk5vdGlmaWNhdGlvbkRpZ2VzdCqIAQoMQWN0aXZpdHlLaW5kEh0KGUFDVElWSVRZX0tJTkRfVU5TUEVDSUZJRUQQABIcChhBQ1RJVklUWV9LSU5EX1NPTkdfQURERUQQARIdChlBQ1RJVklUWV9LSU5EX1JPTEVfSk9JTkVEEAISHAoYQUNUSVZJVFlfS0lORF9FVkVOVF9SU1ZQEAMqmwEKEk5vdGlmaWNhdGlvbkRpZ2VzdBIjCh9OT1RJRklDQVRJT05fRElHRVNUX1VOU1BFQ0lGSUVEEAASIQodTk9USUZJQ0FUSU9OX0RJR0VTVF9JTU1FRElBVEUQARIdChlOT1RJRklDQVRJT05fRElHRVNUX0RBSUxZEAISHgoaTk9USUZJQ0FUSU9OX0RJR0VTVF9XRUVLTFkQAzLYBgoLVXNlclNlcnZpY2USVgoLTGlzdE1lbWJlcnMSIi5tdXNpY2NsdWIudXNlci5MaXN0TWVtYmVyc1JlcXVlc3QaIy5tdXNpY2NsdWIudXNlci5MaXN0TWVtYmVyc1Jlc3BvbnNlElQKDkdldFVzZXJQcm9maWxlEiUubXVzaWNjbHViLnVzZXIuR2V0VXNlclByb2ZpbGVSZXF1ZXN0GhsubXVzaWNjbHViLnVzZXIuVXNlclByb2ZpbGUSWQoMTGlzdEFjdGl2aXR5EiMubXVzaWNjbHViLnVzZXIuTGlzdEFjdGl2aXR5UmVxdWVzdBokLm11c2ljY2x1Yi51c2VyLkxpc3RBY3Rpdml0eVJlc3BvbnNlEkIKEE1hcmtBY3Rpdml0eVNlZW4SFi5nb29nbGUucHJvdG9idWYuRW1wdHkaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSQgoMRXhwb3J0TXlEYXRhEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5GhoubXVzaWNjbHViLnVzZXIuRGF0YUV4cG9ydBJWChFEZWFjdGl2YXRlQWNjb3VudBIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRopLm11c2ljY2x1Yi51c2VyLkRlYWN0aXZhdGVBY2NvdW50UmVzcG9uc2USVgoLU2VhcmNoVXNlcnMSIi5tdXNpY2NsdWIudXNlci5TZWFyY2hVc2Vyc1JlcXVlc3QaIy5tdXNpY2NsdWIudXNlci5TZWFyY2hVc2Vyc1Jlc3BvbnNlEkUKD0V4cG9ydE15SGlzdG9yeRIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRoaLm11c2ljY2x1Yi51c2VyLkRhdGFFeHBvcnQSVwoXR2V0Tm90aWZpY2F0aW9uU2V0dGluZ3MSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaJC5tdXNpY2NsdWIudXNlci5Ob3RpZmljYXRpb25TZXR0aW5ncxJoChpVcGRhdGVOb3RpZmljYXRpb25TZXR0aW5ncxIkLm11c2ljY2x1Yi51c2VyLk5vdGlmaWNhdGlvblNldHRpbmdzGiQubXVzaWNjbHViLnVzZXIuTm90aWZpY2F0aW9uU2V0dGluZ3NCHFoabXVzaWNjbHViYm90L2JhY2tlbmQvcHJvdG9iBnByb3RvMw", [file_buf_validate_validate, file_google_protobuf_empty, file_google_protobuf_timestamp]);
 
 /**
  * Minimal user info for displaying assignments and ownership.
@@ -51,3 +54,670 @@ export type User = Message<"musicclub.user.User"> & {
 export const UserSchema: GenMessage<User> = /*@__PURE__*/
   messageDesc(file_user, 0);
 
+/**
+ * @generated from message musicclub.user.ListMembersRequest
+ */
+export type ListMembersRequest = Message<"musicclub.user.ListMembersRequest"> & {
+  /**
+   * Optional substring filter by username or display name.
+   *
+   * @generated from field: string query = 1;
+   */
+  query: string;
+
+  /**
+   * Only members playing this role in some song, e.g. "guitar".
+   *
+   * @generated from field: string instrument = 2;
+   */
+  instrument: string;
+
+  /**
+   * Only members of this group.
+   *
+   * @generated from field: string group_id = 5;
+   */
+  groupId: string;
+
+  /**
+   * Pagination cursor (opaque to client).
+   *
+   * @generated from field: string page_token = 3;
+   */
+  pageToken: string;
+
+  /**
+   * @generated from field: uint32 page_size = 4;
+   */
+  pageSize: number;
+};
+
+/**
+ * Describes the message musicclub.user.ListMembersRequest.
+ * Use `create(ListMembersRequestSchema)` to create a new message.
+ */
+export const ListMembersRequestSchema: GenMessage<ListMembersRequest> = /*@__PURE__*/
+  messageDesc(file_user, 1);
+
+/**
+ * @generated from message musicclub.user.Member
+ */
+export type Member = Message<"musicclub.user.Member"> & {
+  /**
+   * @generated from field: musicclub.user.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * Roles the member plays across the catalog, most played first.
+   *
+   * @generated from field: repeated string instruments = 2;
+   */
+  instruments: string[];
+
+  /**
+   * Catalog songs the member plays a role in.
+   *
+   * @generated from field: int32 song_count = 3;
+   */
+  songCount: number;
+
+  /**
+   * Events the member took part in.
+   *
+   * @generated from field: int32 event_count = 4;
+   */
+  eventCount: number;
+
+  /**
+   * Names of the groups the member is in, sorted.
+   *
+   * @generated from field: repeated string groups = 5;
+   */
+  groups: string[];
+};
+
+/**
+ * Describes the message musicclub.user.Member.
+ * Use `create(MemberSchema)` to create a new message.
+ */
+export const MemberSchema: GenMessage<Member> = /*@__PURE__*/
+  messageDesc(file_user, 2);
+
+/**
+ * @generated from message musicclub.user.ListMembersResponse
+ */
+export type ListMembersResponse = Message<"musicclub.user.ListMembersResponse"> & {
+  /**
+   * @generated from field: repeated musicclub.user.Member members = 1;
+   */
+  members: Member[];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+
+  /**
+   * Members matching the filters across all pages.
+   *
+   * @generated from field: int32 total_count = 3;
+   */
+  totalCount: number;
+};
+
+/**
+ * Describes the message musicclub.user.ListMembersResponse.
+ * Use `create(ListMembersResponseSchema)` to create a new message.
+ */
+export const ListMembersResponseSchema: GenMessage<ListMembersResponse> = /*@__PURE__*/
+  messageDesc(file_user, 3);
+
+/**
+ * @generated from message musicclub.user.GetUserProfileRequest
+ */
+export type GetUserProfileRequest = Message<"musicclub.user.GetUserProfileRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message musicclub.user.GetUserProfileRequest.
+ * Use `create(GetUserProfileRequestSchema)` to create a new message.
+ */
+export const GetUserProfileRequestSchema: GenMessage<GetUserProfileRequest> = /*@__PURE__*/
+  messageDesc(file_user, 4);
+
+/**
+ * A role the user plays in a catalog song.
+ *
+ * @generated from message musicclub.user.ProfileSongRole
+ */
+export type ProfileSongRole = Message<"musicclub.user.ProfileSongRole"> & {
+  /**
+   * @generated from field: string song_id = 1;
+   */
+  songId: string;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string artist = 3;
+   */
+  artist: string;
+
+  /**
+   * @generated from field: string role = 4;
+   */
+  role: string;
+};
+
+/**
+ * Describes the message musicclub.user.ProfileSongRole.
+ * Use `create(ProfileSongRoleSchema)` to create a new message.
+ */
+export const ProfileSongRoleSchema: GenMessage<ProfileSongRole> = /*@__PURE__*/
+  messageDesc(file_user, 5);
+
+/**
+ * An upcoming event the user takes part in.
+ *
+ * @generated from message musicclub.user.ProfileEvent
+ */
+export type ProfileEvent = Message<"musicclub.user.ProfileEvent"> & {
+  /**
+   * @generated from field: string event_id = 1;
+   */
+  eventId: string;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_at = 3;
+   */
+  startAt?: Timestamp;
+
+  /**
+   * Roles the user plays at the event.
+   *
+   * @generated from field: repeated string roles = 4;
+   */
+  roles: string[];
+};
+
+/**
+ * Describes the message musicclub.user.ProfileEvent.
+ * Use `create(ProfileEventSchema)` to create a new message.
+ */
+export const ProfileEventSchema: GenMessage<ProfileEvent> = /*@__PURE__*/
+  messageDesc(file_user, 6);
+
+/**
+ * @generated from message musicclub.user.UserProfile
+ */
+export type UserProfile = Message<"musicclub.user.UserProfile"> & {
+  /**
+   * @generated from field: musicclub.user.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: string bio = 2;
+   */
+  bio: string;
+
+  /**
+   * @generated from field: bool is_chat_member = 3;
+   */
+  isChatMember: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp member_since = 4;
+   */
+  memberSince?: Timestamp;
+
+  /**
+   * @generated from field: repeated musicclub.user.ProfileSongRole song_roles = 5;
+   */
+  songRoles: ProfileSongRole[];
+
+  /**
+   * Soonest first; cancelled and archived events are left out.
+   *
+   * @generated from field: repeated musicclub.user.ProfileEvent upcoming_events = 6;
+   */
+  upcomingEvents: ProfileEvent[];
+};
+
+/**
+ * Describes the message musicclub.user.UserProfile.
+ * Use `create(UserProfileSchema)` to create a new message.
+ */
+export const UserProfileSchema: GenMessage<UserProfile> = /*@__PURE__*/
+  messageDesc(file_user, 7);
+
+/**
+ * @generated from message musicclub.user.Activity
+ */
+export type Activity = Message<"musicclub.user.Activity"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: musicclub.user.User user = 2;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: musicclub.user.ActivityKind kind = 3;
+   */
+  kind: ActivityKind;
+
+  /**
+   * Set for song activity.
+   *
+   * @generated from field: string song_id = 4;
+   */
+  songId: string;
+
+  /**
+   * @generated from field: string song_title = 5;
+   */
+  songTitle: string;
+
+  /**
+   * @generated from field: string song_artist = 6;
+   */
+  songArtist: string;
+
+  /**
+   * Set for event activity.
+   *
+   * @generated from field: string event_id = 7;
+   */
+  eventId: string;
+
+  /**
+   * @generated from field: string event_title = 8;
+   */
+  eventTitle: string;
+
+  /**
+   * The role joined, or the RSVP answer ("going", "maybe", "declined",
+   * "waitlisted").
+   *
+   * @generated from field: string detail = 9;
+   */
+  detail: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 10;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message musicclub.user.Activity.
+ * Use `create(ActivitySchema)` to create a new message.
+ */
+export const ActivitySchema: GenMessage<Activity> = /*@__PURE__*/
+  messageDesc(file_user, 8);
+
+/**
+ * @generated from message musicclub.user.ListActivityRequest
+ */
+export type ListActivityRequest = Message<"musicclub.user.ListActivityRequest"> & {
+  /**
+   * Optional; empty lists everyone's activity.
+   *
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * Pagination cursor (opaque to client).
+   *
+   * @generated from field: string page_token = 2;
+   */
+  pageToken: string;
+
+  /**
+   * @generated from field: uint32 page_size = 3;
+   */
+  pageSize: number;
+};
+
+/**
+ * Describes the message musicclub.user.ListActivityRequest.
+ * Use `create(ListActivityRequestSchema)` to create a new message.
+ */
+export const ListActivityRequestSchema: GenMessage<ListActivityRequest> = /*@__PURE__*/
+  messageDesc(file_user, 9);
+
+/**
+ * @generated from message musicclub.user.ListActivityResponse
+ */
+export type ListActivityResponse = Message<"musicclub.user.ListActivityResponse"> & {
+  /**
+   * @generated from field: repeated musicclub.user.Activity activities = 1;
+   */
+  activities: Activity[];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message musicclub.user.ListActivityResponse.
+ * Use `create(ListActivityResponseSchema)` to create a new message.
+ */
+export const ListActivityResponseSchema: GenMessage<ListActivityResponse> = /*@__PURE__*/
+  messageDesc(file_user, 10);
+
+/**
+ * A file for the client to download.
+ *
+ * @generated from message musicclub.user.DataExport
+ */
+export type DataExport = Message<"musicclub.user.DataExport"> & {
+  /**
+   * Suggested file name, e.g. "musicclub-export-alice-2026-10-16.json".
+   *
+   * @generated from field: string filename = 1;
+   */
+  filename: string;
+
+  /**
+   * UTF-8 text in the format content_type names.
+   *
+   * @generated from field: bytes content = 2;
+   */
+  content: Uint8Array;
+
+  /**
+   * "application/json" or "text/csv".
+   *
+   * @generated from field: string content_type = 3;
+   */
+  contentType: string;
+};
+
+/**
+ * Describes the message musicclub.user.DataExport.
+ * Use `create(DataExportSchema)` to create a new message.
+ */
+export const DataExportSchema: GenMessage<DataExport> = /*@__PURE__*/
+  messageDesc(file_user, 11);
+
+/**
+ * @generated from message musicclub.user.DeactivateAccountResponse
+ */
+export type DeactivateAccountResponse = Message<"musicclub.user.DeactivateAccountResponse"> & {
+  /**
+   * @generated from field: google.protobuf.Timestamp reactivate_before = 1;
+   */
+  reactivateBefore?: Timestamp;
+};
+
+/**
+ * Describes the message musicclub.user.DeactivateAccountResponse.
+ * Use `create(DeactivateAccountResponseSchema)` to create a new message.
+ */
+export const DeactivateAccountResponseSchema: GenMessage<DeactivateAccountResponse> = /*@__PURE__*/
+  messageDesc(file_user, 12);
+
+/**
+ * @generated from message musicclub.user.SearchUsersRequest
+ */
+export type SearchUsersRequest = Message<"musicclub.user.SearchUsersRequest"> & {
+  /**
+   * What was typed after "@"; a leading "@" is ignored.
+   *
+   * @generated from field: string prefix = 1;
+   */
+  prefix: string;
+
+  /**
+   * Defaults to 10; larger values are capped at 50.
+   *
+   * @generated from field: uint32 limit = 2;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message musicclub.user.SearchUsersRequest.
+ * Use `create(SearchUsersRequestSchema)` to create a new message.
+ */
+export const SearchUsersRequestSchema: GenMessage<SearchUsersRequest> = /*@__PURE__*/
+  messageDesc(file_user, 13);
+
+/**
+ * @generated from message musicclub.user.SearchUsersResponse
+ */
+export type SearchUsersResponse = Message<"musicclub.user.SearchUsersResponse"> & {
+  /**
+   * Username matches first, then display name matches, then by name.
+   *
+   * @generated from field: repeated musicclub.user.User users = 1;
+   */
+  users: User[];
+};
+
+/**
+ * Describes the message musicclub.user.SearchUsersResponse.
+ * Use `create(SearchUsersResponseSchema)` to create a new message.
+ */
+export const SearchUsersResponseSchema: GenMessage<SearchUsersResponse> = /*@__PURE__*/
+  messageDesc(file_user, 14);
+
+/**
+ * @generated from message musicclub.user.NotificationSettings
+ */
+export type NotificationSettings = Message<"musicclub.user.NotificationSettings"> & {
+  /**
+   * @generated from field: musicclub.user.NotificationDigest digest = 1;
+   */
+  digest: NotificationDigest;
+};
+
+/**
+ * Describes the message musicclub.user.NotificationSettings.
+ * Use `create(NotificationSettingsSchema)` to create a new message.
+ */
+export const NotificationSettingsSchema: GenMessage<NotificationSettings> = /*@__PURE__*/
+  messageDesc(file_user, 15);
+
+/**
+ * @generated from enum musicclub.user.ActivityKind
+ */
+export enum ActivityKind {
+  /**
+   * @generated from enum value: ACTIVITY_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: ACTIVITY_KIND_SONG_ADDED = 1;
+   */
+  SONG_ADDED = 1,
+
+  /**
+   * @generated from enum value: ACTIVITY_KIND_ROLE_JOINED = 2;
+   */
+  ROLE_JOINED = 2,
+
+  /**
+   * @generated from enum value: ACTIVITY_KIND_EVENT_RSVP = 3;
+   */
+  EVENT_RSVP = 3,
+}
+
+/**
+ * Describes the enum musicclub.user.ActivityKind.
+ */
+export const ActivityKindSchema: GenEnum<ActivityKind> = /*@__PURE__*/
+  enumDesc(file_user, 0);
+
+/**
+ * @generated from enum musicclub.user.NotificationDigest
+ */
+export enum NotificationDigest {
+  /**
+   * Treated as IMMEDIATE.
+   *
+   * @generated from enum value: NOTIFICATION_DIGEST_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * One message per notification, right away.
+   *
+   * @generated from enum value: NOTIFICATION_DIGEST_IMMEDIATE = 1;
+   */
+  IMMEDIATE = 1,
+
+  /**
+   * Collected into one message every morning.
+   *
+   * @generated from enum value: NOTIFICATION_DIGEST_DAILY = 2;
+   */
+  DAILY = 2,
+
+  /**
+   * Collected into one message on Monday mornings.
+   *
+   * @generated from enum value: NOTIFICATION_DIGEST_WEEKLY = 3;
+   */
+  WEEKLY = 3,
+}
+
+/**
+ * Describes the enum musicclub.user.NotificationDigest.
+ */
+export const NotificationDigestSchema: GenEnum<NotificationDigest> = /*@__PURE__*/
+  enumDesc(file_user, 1);
+
+/**
+ * Club member directory.
+ *
+ * @generated from service musicclub.user.UserService
+ */
+export const UserService: GenService<{
+  /**
+   * Chat members sorted by display name.
+   *
+   * @generated from rpc musicclub.user.UserService.ListMembers
+   */
+  listMembers: {
+    methodKind: "unary";
+    input: typeof ListMembersRequestSchema;
+    output: typeof ListMembersResponseSchema;
+  },
+  /**
+   * A member's public profile with their song roles and upcoming events.
+   *
+   * @generated from rpc musicclub.user.UserService.GetUserProfile
+   */
+  getUserProfile: {
+    methodKind: "unary";
+    input: typeof GetUserProfileRequestSchema;
+    output: typeof UserProfileSchema;
+  },
+  /**
+   * What's happening in the club, newest first; optionally for one user.
+   *
+   * @generated from rpc musicclub.user.UserService.ListActivity
+   */
+  listActivity: {
+    methodKind: "unary";
+    input: typeof ListActivityRequestSchema;
+    output: typeof ListActivityResponseSchema;
+  },
+  /**
+   * Marks the feed as read up to now for the dashboard's unread count.
+   *
+   * @generated from rpc musicclub.user.UserService.MarkActivitySeen
+   */
+  markActivitySeen: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * Everything stored about the current user, as a JSON document.
+   *
+   * @generated from rpc musicclub.user.UserService.ExportMyData
+   */
+  exportMyData: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof DataExportSchema;
+  },
+  /**
+   * Hides the current user and drops them from upcoming events. Logging in
+   * again before reactivate_before undoes it.
+   *
+   * @generated from rpc musicclub.user.UserService.DeactivateAccount
+   */
+  deactivateAccount: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof DeactivateAccountResponseSchema;
+  },
+  /**
+   * Autocomplete for @-mentions: members whose username or a word of whose
+   * display name starts with the prefix.
+   *
+   * @generated from rpc musicclub.user.UserService.SearchUsers
+   */
+  searchUsers: {
+    methodKind: "unary";
+    input: typeof SearchUsersRequestSchema;
+    output: typeof SearchUsersResponseSchema;
+  },
+  /**
+   * The current user's song roles and past event performances as CSV.
+   *
+   * @generated from rpc musicclub.user.UserService.ExportMyHistory
+   */
+  exportMyHistory: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof DataExportSchema;
+  },
+  /**
+   * How the current user gets non-urgent notifications such as feedback
+   * requests and ride offers. Reminders and cancellations always come right
+   * away.
+   *
+   * @generated from rpc musicclub.user.UserService.GetNotificationSettings
+   */
+  getNotificationSettings: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof NotificationSettingsSchema;
+  },
+  /**
+   * @generated from rpc musicclub.user.UserService.UpdateNotificationSettings
+   */
+  updateNotificationSettings: {
+    methodKind: "unary";
+    input: typeof NotificationSettingsSchema;
+    output: typeof NotificationSettingsSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_user, 0);
+
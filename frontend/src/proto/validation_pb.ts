@@ -0,0 +1,23 @@
+// @generated by protoc-gen-es v2.10.2 with parameter "target=ts,import_extension=ts"
+// @generated from file validation.proto (package musicclub.validation, syntax proto2)
+/* eslint-disable */
+
+import type { GenExtension, GenFile } from "@bufbuild/protobuf/codegenv2";
+import { extDesc, fileDesc } from "@bufbuild/protobuf/codegenv2";
+import type { StringRules } from "./buf/validate/validate_pb.ts";
+import { file_buf_validate_validate } from "./buf/validate/validate_pb.ts";
+
+/**
+ * Describes the file validation.proto.
+ */
+export const file_validation: GenFile = /*@__PURE__*/
+  fileDesc("ChB2YWxpZGF0aW9uLnByb3RvEhRtdXNpY2NsdWIudmFsaWRhdGlvbjqBAQoJbm90X2JsYW5rEhkuYnVmLnZhbGlkYXRlLlN0cmluZ1J1bGVzGOgHIAEoCEJIwkhFCkMKEHN0cmluZy5ub3RfYmxhbmsaLyFydWxlIHx8IHRoaXMudHJpbSgpICE9ICcnID8gJycgOiAnaXMgcmVxdWlyZWQnUghub3RCbGFua0IcWhptdXNpY2NsdWJib3QvYmFja2VuZC9wcm90bw", [file_buf_validate_validate]);
+
+/**
+ * The string has something besides whitespace; handlers trim it.
+ *
+ * @generated from extension: optional bool not_blank = 1000;
+ */
+export const not_blank: GenExtension<StringRules, boolean> = /*@__PURE__*/
+  extDesc(file_validation, 0);
+
@@ -0,0 +1,266 @@
+// @generated by protoc-gen-es v2.10.2 with parameter "target=ts,import_extension=ts"
+// @generated from file venue.proto (package musicclub.venue, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "./buf/validate/validate_pb.ts";
+import type { EmptySchema } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_empty } from "@bufbuild/protobuf/wkt";
+import { file_validation } from "./validation_pb.ts";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file venue.proto.
+ */
+export const file_venue: GenFile = /*@__PURE__*/
+  fileDesc("Cgt2ZW51ZS5wcm90bxIPbXVzaWNjbHViLnZlbnVlIpcBCgVWZW51ZRIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEg8KB2FkZHJlc3MYAyABKAkSDwoHbWFwX3VybBgEIAEoCRINCgVub3RlcxgFIAEoCRITCgtldmVudF9jb3VudBgGIAEoBRIuCgtjb29yZGluYXRlcxgHIAEoCzIZLm11c2ljY2x1Yi52ZW51ZS5HZW9Qb2ludCIvCghHZW9Qb2ludBIQCghsYXRpdHVkZRgBIAEoARIRCglsb25naXR1ZGUYAiABKAEiHwoHVmVudWVJZBIUCgJpZBgBIAEoCUIIukgFcgOwAQEiIgoRTGlzdFZlbnVlc1JlcXVlc3QSDQoFcXVlcnkYASABKAkiPAoSTGlzdFZlbnVlc1Jlc3BvbnNlEiYKBnZlbnVlcxgBIAMoCzIWLm11c2ljY2x1Yi52ZW51ZS5WZW51ZSKSAQoKVmVudWVJbnB1dBIWCgRuYW1lGAEgASgJQgi6SAVyA8A+ARIPCgdhZGRyZXNzGAIgASgJEhwKB21hcF91cmwYAyABKAlCC7pICHIDiAEB2AEBEg0KBW5vdGVzGAQgASgJEi4KC2Nvb3JkaW5hdGVzGAUgASgLMhkubXVzaWNjbHViLnZlbnVlLkdlb1BvaW50Il4KElVwZGF0ZVZlbnVlUmVxdWVzdBIUCgJpZBgBIAEoCUIIukgFcgOwAQESMgoFdmVudWUYAiABKAsyGy5tdXNpY2NsdWIudmVudWUuVmVudWVJbnB1dEIGukgDyAEBMvQCCgxWZW51ZVNlcnZpY2USVQoKTGlzdFZlbnVlcxIiLm11c2ljY2x1Yi52ZW51ZS5MaXN0VmVudWVzUmVxdWVzdBojLm11c2ljY2x1Yi52ZW51ZS5MaXN0VmVudWVzUmVzcG9uc2USPAoIR2V0VmVudWUSGC5tdXNpY2NsdWIudmVudWUuVmVudWVJZBoWLm11c2ljY2x1Yi52ZW51ZS5WZW51ZRJCCgtDcmVhdGVWZW51ZRIbLm11c2ljY2x1Yi52ZW51ZS5WZW51ZUlucHV0GhYubXVzaWNjbHViLnZlbnVlLlZlbnVlEkoKC1VwZGF0ZVZlbnVlEiMubXVzaWNjbHViLnZlbnVlLlVwZGF0ZVZlbnVlUmVxdWVzdBoWLm11c2ljY2x1Yi52ZW51ZS5WZW51ZRI/CgtEZWxldGVWZW51ZRIYLm11c2ljY2x1Yi52ZW51ZS5WZW51ZUlkGhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5QhxaGm11c2ljY2x1YmJvdC9iYWNrZW5kL3Byb3RvYgZwcm90bzM", [file_buf_validate_validate, file_google_protobuf_empty, file_validation]);
+
+/**
+ * @generated from message musicclub.venue.Venue
+ */
+export type Venue = Message<"musicclub.venue.Venue"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string address = 3;
+   */
+  address: string;
+
+  /**
+   * Link to the venue on a map service.
+   *
+   * @generated from field: string map_url = 4;
+   */
+  mapUrl: string;
+
+  /**
+   * Load-in instructions, parking, contacts, etc.
+   *
+   * @generated from field: string notes = 5;
+   */
+  notes: string;
+
+  /**
+   * Number of events held at the venue.
+   *
+   * @generated from field: int32 event_count = 6;
+   */
+  eventCount: number;
+
+  /**
+   * Unset when neither the map link nor the address could be located.
+   *
+   * @generated from field: musicclub.venue.GeoPoint coordinates = 7;
+   */
+  coordinates?: GeoPoint;
+};
+
+/**
+ * Describes the message musicclub.venue.Venue.
+ * Use `create(VenueSchema)` to create a new message.
+ */
+export const VenueSchema: GenMessage<Venue> = /*@__PURE__*/
+  messageDesc(file_venue, 0);
+
+/**
+ * @generated from message musicclub.venue.GeoPoint
+ */
+export type GeoPoint = Message<"musicclub.venue.GeoPoint"> & {
+  /**
+   * @generated from field: double latitude = 1;
+   */
+  latitude: number;
+
+  /**
+   * @generated from field: double longitude = 2;
+   */
+  longitude: number;
+};
+
+/**
+ * Describes the message musicclub.venue.GeoPoint.
+ * Use `create(GeoPointSchema)` to create a new message.
+ */
+export const GeoPointSchema: GenMessage<GeoPoint> = /*@__PURE__*/
+  messageDesc(file_venue, 1);
+
+/**
+ * @generated from message musicclub.venue.VenueId
+ */
+export type VenueId = Message<"musicclub.venue.VenueId"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message musicclub.venue.VenueId.
+ * Use `create(VenueIdSchema)` to create a new message.
+ */
+export const VenueIdSchema: GenMessage<VenueId> = /*@__PURE__*/
+  messageDesc(file_venue, 2);
+
+/**
+ * @generated from message musicclub.venue.ListVenuesRequest
+ */
+export type ListVenuesRequest = Message<"musicclub.venue.ListVenuesRequest"> & {
+  /**
+   * Optional substring filter by name or address.
+   *
+   * @generated from field: string query = 1;
+   */
+  query: string;
+};
+
+/**
+ * Describes the message musicclub.venue.ListVenuesRequest.
+ * Use `create(ListVenuesRequestSchema)` to create a new message.
+ */
+export const ListVenuesRequestSchema: GenMessage<ListVenuesRequest> = /*@__PURE__*/
+  messageDesc(file_venue, 3);
+
+/**
+ * @generated from message musicclub.venue.ListVenuesResponse
+ */
+export type ListVenuesResponse = Message<"musicclub.venue.ListVenuesResponse"> & {
+  /**
+   * @generated from field: repeated musicclub.venue.Venue venues = 1;
+   */
+  venues: Venue[];
+};
+
+/**
+ * Describes the message musicclub.venue.ListVenuesResponse.
+ * Use `create(ListVenuesResponseSchema)` to create a new message.
+ */
+export const ListVenuesResponseSchema: GenMessage<ListVenuesResponse> = /*@__PURE__*/
+  messageDesc(file_venue, 4);
+
+/**
+ * @generated from message musicclub.venue.VenueInput
+ */
+export type VenueInput = Message<"musicclub.venue.VenueInput"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string address = 2;
+   */
+  address: string;
+
+  /**
+   * @generated from field: string map_url = 3;
+   */
+  mapUrl: string;
+
+  /**
+   * @generated from field: string notes = 4;
+   */
+  notes: string;
+
+  /**
+   * Taken from map_url or geocoded from the address when unset.
+   *
+   * @generated from field: musicclub.venue.GeoPoint coordinates = 5;
+   */
+  coordinates?: GeoPoint;
+};
+
+/**
+ * Describes the message musicclub.venue.VenueInput.
+ * Use `create(VenueInputSchema)` to create a new message.
+ */
+export const VenueInputSchema: GenMessage<VenueInput> = /*@__PURE__*/
+  messageDesc(file_venue, 5);
+
+/**
+ * @generated from message musicclub.venue.UpdateVenueRequest
+ */
+export type UpdateVenueRequest = Message<"musicclub.venue.UpdateVenueRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: musicclub.venue.VenueInput venue = 2;
+   */
+  venue?: VenueInput;
+};
+
+/**
+ * Describes the message musicclub.venue.UpdateVenueRequest.
+ * Use `create(UpdateVenueRequestSchema)` to create a new message.
+ */
+export const UpdateVenueRequestSchema: GenMessage<UpdateVenueRequest> = /*@__PURE__*/
+  messageDesc(file_venue, 6);
+
+/**
+ * Provides CRUD functionality for venues events take place at.
+ *
+ * @generated from service musicclub.venue.VenueService
+ */
+export const VenueService: GenService<{
+  /**
+   * Returns venues sorted by name.
+   *
+   * @generated from rpc musicclub.venue.VenueService.ListVenues
+   */
+  listVenues: {
+    methodKind: "unary";
+    input: typeof ListVenuesRequestSchema;
+    output: typeof ListVenuesResponseSchema;
+  },
+  /**
+   * @generated from rpc musicclub.venue.VenueService.GetVenue
+   */
+  getVenue: {
+    methodKind: "unary";
+    input: typeof VenueIdSchema;
+    output: typeof VenueSchema;
+  },
+  /**
+   * Create venues (requires edit_events).
+   *
+   * @generated from rpc musicclub.venue.VenueService.CreateVenue
+   */
+  createVenue: {
+    methodKind: "unary";
+    input: typeof VenueInputSchema;
+    output: typeof VenueSchema;
+  },
+  /**
+   * Update venues (requires edit_events).
+   *
+   * @generated from rpc musicclub.venue.VenueService.UpdateVenue
+   */
+  updateVenue: {
+    methodKind: "unary";
+    input: typeof UpdateVenueRequestSchema;
+    output: typeof VenueSchema;
+  },
+  /**
+   * Delete venues (requires edit_events); events keep their location text.
+   *
+   * @generated from rpc musicclub.venue.VenueService.DeleteVenue
+   */
+  deleteVenue: {
+    methodKind: "unary";
+    input: typeof VenueIdSchema;
+    output: typeof EmptySchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_venue, 0);
+
@@ -0,0 +1,473 @@
+// @generated by protoc-gen-es v2.10.2 with parameter "target=ts,import_extension=ts"
+// @generated from file voting.proto (package musicclub.voting, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "./buf/validate/validate_pb.ts";
+import type { EmptySchema, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_empty, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { EventDetailsSchema } from "./event_pb.ts";
+import { file_event } from "./event_pb.ts";
+import type { Song } from "./song_pb.ts";
+import { file_song } from "./song_pb.ts";
+import { file_validation } from "./validation_pb.ts";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file voting.proto.
+ */
+export const file_voting: GenFile = /*@__PURE__*/
+  fileDesc("Cgx2b3RpbmcucHJvdG8SEG11c2ljY2x1Yi52b3RpbmcijAIKC1ZvdGluZ1JvdW5kEgoKAmlkGAEgASgJEg0KBXRpdGxlGAIgASgJEhAKCGV2ZW50X2lkGAMgASgJEg0KBXNlYXRzGAQgASgNEi0KCWNsb3Nlc19hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDgoGY2xvc2VkGAYgASgIEigKCmNhbmRpZGF0ZXMYByADKAsyFC5tdXNpY2NsdWIuc29uZy5Tb25nEhQKDGJhbGxvdF9jb3VudBgIIAEoDRISCgpteV9yYW5raW5nGAkgAygJEi4KCmNyZWF0ZWRfYXQYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIiUKDVZvdGluZ1JvdW5kSWQSFAoCaWQYASABKAlCCLpIBXIDsAEBIl0KF0xpc3RWb3RpbmdSb3VuZHNSZXF1ZXN0EhEKCW9ubHlfb3BlbhgBIAEoCBISCgpwYWdlX3Rva2VuGAIgASgJEhsKCXBhZ2Vfc2l6ZRgDIAEoDUIIukgFKgMYyAEidwoYTGlzdFZvdGluZ1JvdW5kc1Jlc3BvbnNlEi0KBnJvdW5kcxgBIAMoCzIdLm11c2ljY2x1Yi52b3RpbmcuVm90aW5nUm91bmQSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJEhMKC3RvdGFsX2NvdW50GAMgASgFIqwBChhDcmVhdGVWb3RpbmdSb3VuZFJlcXVlc3QSFwoFdGl0bGUYASABKAlCCLpIBXIDwD4BEh0KCGV2ZW50X2lkGAIgASgJQgu6SAhyA7ABAdgBARINCgVzZWF0cxgDIAEoDRItCgljbG9zZXNfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhoKEmNhbmRpZGF0ZV9zb25nX2lkcxgFIAMoCSI3ChFDYXN0QmFsbG90UmVxdWVzdBIQCghyb3VuZF9pZBgBIAEoCRIQCghzb25nX2lkcxgCIAMoCSItCgtWb3RpbmdUYWxseRIPCgdzb25nX2lkGAEgASgJEg0KBXZvdGVzGAIgASgNImsKClJ1bm9mZlN0ZXASLgoHdGFsbGllcxgBIAMoCzIdLm11c2ljY2x1Yi52b3RpbmcuVm90aW5nVGFsbHkSGgoSZWxpbWluYXRlZF9zb25nX2lkGAIgASgJEhEKCWV4aGF1c3RlZBgDIAEoDSJYCgpWb3RpbmdTZWF0EgwKBHNlYXQYASABKA0SDwoHc29uZ19pZBgCIAEoCRIrCgVzdGVwcxgDIAMoCzIcLm11c2ljY2x1Yi52b3RpbmcuUnVub2ZmU3RlcCKgAQoNVm90aW5nUmVzdWx0cxIsCgVyb3VuZBgBIAEoCzIdLm11c2ljY2x1Yi52b3RpbmcuVm90aW5nUm91bmQSKwoFc2VhdHMYAiADKAsyHC5tdXNpY2NsdWIudm90aW5nLlZvdGluZ1NlYXQSNAoNZmlyc3RfY2hvaWNlcxgDIAMoCzIdLm11c2ljY2x1Yi52b3RpbmcuVm90aW5nVGFsbHkiOgoUU2VlZFRyYWNrbGlzdFJlcXVlc3QSEAoIcm91bmRfaWQYASABKAkSEAoIZXZlbnRfaWQYAiABKAkyzgUKDVZvdGluZ1NlcnZpY2USaQoQTGlzdFZvdGluZ1JvdW5kcxIpLm11c2ljY2x1Yi52b3RpbmcuTGlzdFZvdGluZ1JvdW5kc1JlcXVlc3QaKi5tdXNpY2NsdWIudm90aW5nLkxpc3RWb3RpbmdSb3VuZHNSZXNwb25zZRJQCg5HZXRWb3RpbmdSb3VuZBIfLm11c2ljY2x1Yi52b3RpbmcuVm90aW5nUm91bmRJZBodLm11c2ljY2x1Yi52b3RpbmcuVm90aW5nUm91bmQSXgoRQ3JlYXRlVm90aW5nUm91bmQSKi5tdXNpY2NsdWIudm90aW5nLkNyZWF0ZVZvdGluZ1JvdW5kUmVxdWVzdBodLm11c2ljY2x1Yi52b3RpbmcuVm90aW5nUm91bmQSUgoQQ2xvc2VWb3RpbmdSb3VuZBIfLm11c2ljY2x1Yi52b3RpbmcuVm90aW5nUm91bmRJZBodLm11c2ljY2x1Yi52b3RpbmcuVm90aW5nUm91bmQSTAoRRGVsZXRlVm90aW5nUm91bmQSHy5tdXNpY2NsdWIudm90aW5nLlZvdGluZ1JvdW5kSWQaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSUAoKQ2FzdEJhbGxvdBIjLm11c2ljY2x1Yi52b3RpbmcuQ2FzdEJhbGxvdFJlcXVlc3QaHS5tdXNpY2NsdWIudm90aW5nLlZvdGluZ1JvdW5kElQKEEdldFZvdGluZ1Jlc3VsdHMSHy5tdXNpY2NsdWIudm90aW5nLlZvdGluZ1JvdW5kSWQaHy5tdXNpY2NsdWIudm90aW5nLlZvdGluZ1Jlc3VsdHMSVgoNU2VlZFRyYWNrbGlzdBImLm11c2ljY2x1Yi52b3RpbmcuU2VlZFRyYWNrbGlzdFJlcXVlc3QaHS5tdXNpY2NsdWIuZXZlbnQuRXZlbnREZXRhaWxzQhxaGm11c2ljY2x1YmJvdC9iYWNrZW5kL3Byb3RvYgZwcm90bzM", [file_buf_validate_validate, file_google_protobuf_empty, file_google_protobuf_timestamp, file_event, file_song, file_validation]);
+
+/**
+ * @generated from message musicclub.voting.VotingRound
+ */
+export type VotingRound = Message<"musicclub.voting.VotingRound"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * Event the winners are meant for; empty if none.
+   *
+   * @generated from field: string event_id = 3;
+   */
+  eventId: string;
+
+  /**
+   * How many songs win.
+   *
+   * @generated from field: uint32 seats = 4;
+   */
+  seats: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp closes_at = 5;
+   */
+  closesAt?: Timestamp;
+
+  /**
+   * The deadline passed or an admin ended voting early.
+   *
+   * @generated from field: bool closed = 6;
+   */
+  closed: boolean;
+
+  /**
+   * In the order the admin listed them.
+   *
+   * @generated from field: repeated musicclub.song.Song candidates = 7;
+   */
+  candidates: Song[];
+
+  /**
+   * Members who cast a ballot.
+   *
+   * @generated from field: uint32 ballot_count = 8;
+   */
+  ballotCount: number;
+
+  /**
+   * Current user's ranking, first choice first; empty if not voted.
+   *
+   * @generated from field: repeated string my_ranking = 9;
+   */
+  myRanking: string[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 10;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message musicclub.voting.VotingRound.
+ * Use `create(VotingRoundSchema)` to create a new message.
+ */
+export const VotingRoundSchema: GenMessage<VotingRound> = /*@__PURE__*/
+  messageDesc(file_voting, 0);
+
+/**
+ * @generated from message musicclub.voting.VotingRoundId
+ */
+export type VotingRoundId = Message<"musicclub.voting.VotingRoundId"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message musicclub.voting.VotingRoundId.
+ * Use `create(VotingRoundIdSchema)` to create a new message.
+ */
+export const VotingRoundIdSchema: GenMessage<VotingRoundId> = /*@__PURE__*/
+  messageDesc(file_voting, 1);
+
+/**
+ * @generated from message musicclub.voting.ListVotingRoundsRequest
+ */
+export type ListVotingRoundsRequest = Message<"musicclub.voting.ListVotingRoundsRequest"> & {
+  /**
+   * Open rounds only.
+   *
+   * @generated from field: bool only_open = 1;
+   */
+  onlyOpen: boolean;
+
+  /**
+   * Pagination cursor (opaque to client).
+   *
+   * @generated from field: string page_token = 2;
+   */
+  pageToken: string;
+
+  /**
+   * @generated from field: uint32 page_size = 3;
+   */
+  pageSize: number;
+};
+
+/**
+ * Describes the message musicclub.voting.ListVotingRoundsRequest.
+ * Use `create(ListVotingRoundsRequestSchema)` to create a new message.
+ */
+export const ListVotingRoundsRequestSchema: GenMessage<ListVotingRoundsRequest> = /*@__PURE__*/
+  messageDesc(file_voting, 2);
+
+/**
+ * @generated from message musicclub.voting.ListVotingRoundsResponse
+ */
+export type ListVotingRoundsResponse = Message<"musicclub.voting.ListVotingRoundsResponse"> & {
+  /**
+   * @generated from field: repeated musicclub.voting.VotingRound rounds = 1;
+   */
+  rounds: VotingRound[];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+
+  /**
+   * @generated from field: int32 total_count = 3;
+   */
+  totalCount: number;
+};
+
+/**
+ * Describes the message musicclub.voting.ListVotingRoundsResponse.
+ * Use `create(ListVotingRoundsResponseSchema)` to create a new message.
+ */
+export const ListVotingRoundsResponseSchema: GenMessage<ListVotingRoundsResponse> = /*@__PURE__*/
+  messageDesc(file_voting, 3);
+
+/**
+ * @generated from message musicclub.voting.CreateVotingRoundRequest
+ */
+export type CreateVotingRoundRequest = Message<"musicclub.voting.CreateVotingRoundRequest"> & {
+  /**
+   * @generated from field: string title = 1;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string event_id = 2;
+   */
+  eventId: string;
+
+  /**
+   * Defaults to 1; at most the number of candidates.
+   *
+   * @generated from field: uint32 seats = 3;
+   */
+  seats: number;
+
+  /**
+   * Must be in the future.
+   *
+   * @generated from field: google.protobuf.Timestamp closes_at = 4;
+   */
+  closesAt?: Timestamp;
+
+  /**
+   * Catalog songs, at least two.
+   *
+   * @generated from field: repeated string candidate_song_ids = 5;
+   */
+  candidateSongIds: string[];
+};
+
+/**
+ * Describes the message musicclub.voting.CreateVotingRoundRequest.
+ * Use `create(CreateVotingRoundRequestSchema)` to create a new message.
+ */
+export const CreateVotingRoundRequestSchema: GenMessage<CreateVotingRoundRequest> = /*@__PURE__*/
+  messageDesc(file_voting, 4);
+
+/**
+ * @generated from message musicclub.voting.CastBallotRequest
+ */
+export type CastBallotRequest = Message<"musicclub.voting.CastBallotRequest"> & {
+  /**
+   * @generated from field: string round_id = 1;
+   */
+  roundId: string;
+
+  /**
+   * Candidates in order of preference; unranked ones count as least
+   * preferred. Empty withdraws the ballot.
+   *
+   * @generated from field: repeated string song_ids = 2;
+   */
+  songIds: string[];
+};
+
+/**
+ * Describes the message musicclub.voting.CastBallotRequest.
+ * Use `create(CastBallotRequestSchema)` to create a new message.
+ */
+export const CastBallotRequestSchema: GenMessage<CastBallotRequest> = /*@__PURE__*/
+  messageDesc(file_voting, 5);
+
+/**
+ * @generated from message musicclub.voting.VotingTally
+ */
+export type VotingTally = Message<"musicclub.voting.VotingTally"> & {
+  /**
+   * @generated from field: string song_id = 1;
+   */
+  songId: string;
+
+  /**
+   * @generated from field: uint32 votes = 2;
+   */
+  votes: number;
+};
+
+/**
+ * Describes the message musicclub.voting.VotingTally.
+ * Use `create(VotingTallySchema)` to create a new message.
+ */
+export const VotingTallySchema: GenMessage<VotingTally> = /*@__PURE__*/
+  messageDesc(file_voting, 6);
+
+/**
+ * One count of the runoff for a seat.
+ *
+ * @generated from message musicclub.voting.RunoffStep
+ */
+export type RunoffStep = Message<"musicclub.voting.RunoffStep"> & {
+  /**
+   * Candidates still in the race, most votes first.
+   *
+   * @generated from field: repeated musicclub.voting.VotingTally tallies = 1;
+   */
+  tallies: VotingTally[];
+
+  /**
+   * Dropped after this count; empty on the final count.
+   *
+   * @generated from field: string eliminated_song_id = 2;
+   */
+  eliminatedSongId: string;
+
+  /**
+   * Ballots with none of the remaining candidates ranked.
+   *
+   * @generated from field: uint32 exhausted = 3;
+   */
+  exhausted: number;
+};
+
+/**
+ * Describes the message musicclub.voting.RunoffStep.
+ * Use `create(RunoffStepSchema)` to create a new message.
+ */
+export const RunoffStepSchema: GenMessage<RunoffStep> = /*@__PURE__*/
+  messageDesc(file_voting, 7);
+
+/**
+ * @generated from message musicclub.voting.VotingSeat
+ */
+export type VotingSeat = Message<"musicclub.voting.VotingSeat"> & {
+  /**
+   * 1-based; seat 1 is the overall winner.
+   *
+   * @generated from field: uint32 seat = 1;
+   */
+  seat: number;
+
+  /**
+   * @generated from field: string song_id = 2;
+   */
+  songId: string;
+
+  /**
+   * How the seat was decided; later seats are counted again without the
+   * songs that already won.
+   *
+   * @generated from field: repeated musicclub.voting.RunoffStep steps = 3;
+   */
+  steps: RunoffStep[];
+};
+
+/**
+ * Describes the message musicclub.voting.VotingSeat.
+ * Use `create(VotingSeatSchema)` to create a new message.
+ */
+export const VotingSeatSchema: GenMessage<VotingSeat> = /*@__PURE__*/
+  messageDesc(file_voting, 8);
+
+/**
+ * @generated from message musicclub.voting.VotingResults
+ */
+export type VotingResults = Message<"musicclub.voting.VotingResults"> & {
+  /**
+   * @generated from field: musicclub.voting.VotingRound round = 1;
+   */
+  round?: VotingRound;
+
+  /**
+   * Up to round.seats, best first.
+   *
+   * @generated from field: repeated musicclub.voting.VotingSeat seats = 2;
+   */
+  seats: VotingSeat[];
+
+  /**
+   * First-choice votes per candidate, most first.
+   *
+   * @generated from field: repeated musicclub.voting.VotingTally first_choices = 3;
+   */
+  firstChoices: VotingTally[];
+};
+
+/**
+ * Describes the message musicclub.voting.VotingResults.
+ * Use `create(VotingResultsSchema)` to create a new message.
+ */
+export const VotingResultsSchema: GenMessage<VotingResults> = /*@__PURE__*/
+  messageDesc(file_voting, 9);
+
+/**
+ * @generated from message musicclub.voting.SeedTracklistRequest
+ */
+export type SeedTracklistRequest = Message<"musicclub.voting.SeedTracklistRequest"> & {
+  /**
+   * @generated from field: string round_id = 1;
+   */
+  roundId: string;
+
+  /**
+   * Defaults to the round's event.
+   *
+   * @generated from field: string event_id = 2;
+   */
+  eventId: string;
+};
+
+/**
+ * Describes the message musicclub.voting.SeedTracklistRequest.
+ * Use `create(SeedTracklistRequestSchema)` to create a new message.
+ */
+export const SeedTracklistRequestSchema: GenMessage<SeedTracklistRequest> = /*@__PURE__*/
+  messageDesc(file_voting, 10);
+
+/**
+ * Ranked-choice voting on songs, e.g. to pick an event's setlist. Members
+ * rank the candidates; winners are counted by instant runoff.
+ *
+ * @generated from service musicclub.voting.VotingService
+ */
+export const VotingService: GenService<{
+  /**
+   * Open rounds first, soonest deadline first, then closed ones, latest
+   * first.
+   *
+   * @generated from rpc musicclub.voting.VotingService.ListVotingRounds
+   */
+  listVotingRounds: {
+    methodKind: "unary";
+    input: typeof ListVotingRoundsRequestSchema;
+    output: typeof ListVotingRoundsResponseSchema;
+  },
+  /**
+   * @generated from rpc musicclub.voting.VotingService.GetVotingRound
+   */
+  getVotingRound: {
+    methodKind: "unary";
+    input: typeof VotingRoundIdSchema;
+    output: typeof VotingRoundSchema;
+  },
+  /**
+   * Admins only.
+   *
+   * @generated from rpc musicclub.voting.VotingService.CreateVotingRound
+   */
+  createVotingRound: {
+    methodKind: "unary";
+    input: typeof CreateVotingRoundRequestSchema;
+    output: typeof VotingRoundSchema;
+  },
+  /**
+   * Ends voting before the deadline. Admins only.
+   *
+   * @generated from rpc musicclub.voting.VotingService.CloseVotingRound
+   */
+  closeVotingRound: {
+    methodKind: "unary";
+    input: typeof VotingRoundIdSchema;
+    output: typeof VotingRoundSchema;
+  },
+  /**
+   * Admins only.
+   *
+   * @generated from rpc musicclub.voting.VotingService.DeleteVotingRound
+   */
+  deleteVotingRound: {
+    methodKind: "unary";
+    input: typeof VotingRoundIdSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * Replaces the current user's ranking while the round is open.
+   *
+   * @generated from rpc musicclub.voting.VotingService.CastBallot
+   */
+  castBallot: {
+    methodKind: "unary";
+    input: typeof CastBallotRequestSchema;
+    output: typeof VotingRoundSchema;
+  },
+  /**
+   * Counts the ballots; available once the round is closed.
+   *
+   * @generated from rpc musicclub.voting.VotingService.GetVotingResults
+   */
+  getVotingResults: {
+    methodKind: "unary";
+    input: typeof VotingRoundIdSchema;
+    output: typeof VotingResultsSchema;
+  },
+  /**
+   * Adds the winners not yet in the tracklist after the items outside of
+   * sets, best first (requires rights to edit the event's tracklist).
+   *
+   * @generated from rpc musicclub.voting.VotingService.SeedTracklist
+   */
+  seedTracklist: {
+    methodKind: "unary";
+    input: typeof SeedTracklistRequestSchema;
+    output: typeof EventDetailsSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_voting, 0);
+
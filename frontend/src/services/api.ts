@@ -73,6 +73,7 @@ export function createSong(payload: {
 
 export function updateSong(payload: {
 	id: string;
+	expectedVersion: bigint;
 	title: string;
 	artist: string;
 	description?: string;
@@ -84,6 +85,7 @@ export function updateSong(payload: {
 	return songClient.updateSong(
 		{
 			id: payload.id,
+			expectedVersion: payload.expectedVersion,
 			title: payload.title,
 			artist: payload.artist,
 			description: payload.description ?? "",
@@ -138,6 +140,7 @@ export function createEvent(payload: {
 
 export function updateEvent(payload: {
 	id: string;
+	expectedVersion: bigint;
 	title: string;
 	startAt?: Timestamp;
 	location?: string;
@@ -147,6 +150,7 @@ export function updateEvent(payload: {
 	return eventClient.updateEvent(
 		{
 			id: payload.id,
+			expectedVersion: payload.expectedVersion,
 			title: payload.title,
 			startAt: payload.startAt,
 			location: payload.location ?? "",